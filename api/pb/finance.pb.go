@@ -149,6 +149,7 @@ const (
 	PaymentStatus_PAYMENT_STATUS_PARTIALLY_PAID PaymentStatus = 2
 	PaymentStatus_PAYMENT_STATUS_PAID           PaymentStatus = 3
 	PaymentStatus_PAYMENT_STATUS_WRITEOFF       PaymentStatus = 4
+	PaymentStatus_PAYMENT_STATUS_OVERDUE        PaymentStatus = 5 // set by the dunning job
 )
 
 // Enum value maps for PaymentStatus.
@@ -159,6 +160,7 @@ var (
 		2: "PAYMENT_STATUS_PARTIALLY_PAID",
 		3: "PAYMENT_STATUS_PAID",
 		4: "PAYMENT_STATUS_WRITEOFF",
+		5: "PAYMENT_STATUS_OVERDUE",
 	}
 	PaymentStatus_value = map[string]int32{
 		"PAYMENT_STATUS_UNSPECIFIED":    0,
@@ -166,6 +168,7 @@ var (
 		"PAYMENT_STATUS_PARTIALLY_PAID": 2,
 		"PAYMENT_STATUS_PAID":           3,
 		"PAYMENT_STATUS_WRITEOFF":       4,
+		"PAYMENT_STATUS_OVERDUE":        5,
 	}
 )
 
@@ -462,6 +465,312 @@ func (AccountStatus) EnumDescriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{7}
 }
 
+type TemplateFrequency int32
+
+const (
+	TemplateFrequency_TEMPLATE_FREQUENCY_UNSPECIFIED TemplateFrequency = 0
+	TemplateFrequency_TEMPLATE_MONTHLY               TemplateFrequency = 1
+	TemplateFrequency_TEMPLATE_QUARTERLY             TemplateFrequency = 2
+	TemplateFrequency_TEMPLATE_YEARLY                TemplateFrequency = 3
+)
+
+// Enum value maps for TemplateFrequency.
+var (
+	TemplateFrequency_name = map[int32]string{
+		0: "TEMPLATE_FREQUENCY_UNSPECIFIED",
+		1: "TEMPLATE_MONTHLY",
+		2: "TEMPLATE_QUARTERLY",
+		3: "TEMPLATE_YEARLY",
+	}
+	TemplateFrequency_value = map[string]int32{
+		"TEMPLATE_FREQUENCY_UNSPECIFIED": 0,
+		"TEMPLATE_MONTHLY":               1,
+		"TEMPLATE_QUARTERLY":             2,
+		"TEMPLATE_YEARLY":                3,
+	}
+)
+
+func (x TemplateFrequency) Enum() *TemplateFrequency {
+	p := new(TemplateFrequency)
+	*p = x
+	return p
+}
+
+func (x TemplateFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TemplateFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_finance_proto_enumTypes[8].Descriptor()
+}
+
+func (TemplateFrequency) Type() protoreflect.EnumType {
+	return &file_finance_proto_enumTypes[8]
+}
+
+func (x TemplateFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TemplateFrequency.Descriptor instead.
+func (TemplateFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{8}
+}
+
+type JournalStatus int32
+
+const (
+	JournalStatus_JOURNAL_STATUS_UNSPECIFIED JournalStatus = 0
+	JournalStatus_JOURNAL_DRAFT              JournalStatus = 1 // editable, not in the ledger
+	JournalStatus_JOURNAL_POSTED             JournalStatus = 2 // immutable, projected to the ledger
+	JournalStatus_JOURNAL_REVERSED           JournalStatus = 3 // posted, then cancelled by a reversal entry
+)
+
+// Enum value maps for JournalStatus.
+var (
+	JournalStatus_name = map[int32]string{
+		0: "JOURNAL_STATUS_UNSPECIFIED",
+		1: "JOURNAL_DRAFT",
+		2: "JOURNAL_POSTED",
+		3: "JOURNAL_REVERSED",
+	}
+	JournalStatus_value = map[string]int32{
+		"JOURNAL_STATUS_UNSPECIFIED": 0,
+		"JOURNAL_DRAFT":              1,
+		"JOURNAL_POSTED":             2,
+		"JOURNAL_REVERSED":           3,
+	}
+)
+
+func (x JournalStatus) Enum() *JournalStatus {
+	p := new(JournalStatus)
+	*p = x
+	return p
+}
+
+func (x JournalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JournalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_finance_proto_enumTypes[9].Descriptor()
+}
+
+func (JournalStatus) Type() protoreflect.EnumType {
+	return &file_finance_proto_enumTypes[9]
+}
+
+func (x JournalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JournalStatus.Descriptor instead.
+func (JournalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{9}
+}
+
+type PeriodStatus int32
+
+const (
+	PeriodStatus_PERIOD_STATUS_UNSPECIFIED PeriodStatus = 0
+	PeriodStatus_PERIOD_OPEN               PeriodStatus = 1 // all postings allowed
+	PeriodStatus_PERIOD_SOFT_CLOSED        PeriodStatus = 2 // only ADJUSTMENT and YEAR_END_CLOSE journals
+	PeriodStatus_PERIOD_CLOSED             PeriodStatus = 3 // no document may be dated into the period
+)
+
+// Enum value maps for PeriodStatus.
+var (
+	PeriodStatus_name = map[int32]string{
+		0: "PERIOD_STATUS_UNSPECIFIED",
+		1: "PERIOD_OPEN",
+		2: "PERIOD_SOFT_CLOSED",
+		3: "PERIOD_CLOSED",
+	}
+	PeriodStatus_value = map[string]int32{
+		"PERIOD_STATUS_UNSPECIFIED": 0,
+		"PERIOD_OPEN":               1,
+		"PERIOD_SOFT_CLOSED":        2,
+		"PERIOD_CLOSED":             3,
+	}
+)
+
+func (x PeriodStatus) Enum() *PeriodStatus {
+	p := new(PeriodStatus)
+	*p = x
+	return p
+}
+
+func (x PeriodStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeriodStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_finance_proto_enumTypes[10].Descriptor()
+}
+
+func (PeriodStatus) Type() protoreflect.EnumType {
+	return &file_finance_proto_enumTypes[10]
+}
+
+func (x PeriodStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeriodStatus.Descriptor instead.
+func (PeriodStatus) EnumDescriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{10}
+}
+
+type PartyKind int32
+
+const (
+	PartyKind_PARTY_KIND_UNSPECIFIED PartyKind = 0
+	PartyKind_PARTY_CUSTOMER         PartyKind = 1
+	PartyKind_PARTY_VENDOR           PartyKind = 2
+	PartyKind_PARTY_BOTH             PartyKind = 3
+)
+
+// Enum value maps for PartyKind.
+var (
+	PartyKind_name = map[int32]string{
+		0: "PARTY_KIND_UNSPECIFIED",
+		1: "PARTY_CUSTOMER",
+		2: "PARTY_VENDOR",
+		3: "PARTY_BOTH",
+	}
+	PartyKind_value = map[string]int32{
+		"PARTY_KIND_UNSPECIFIED": 0,
+		"PARTY_CUSTOMER":         1,
+		"PARTY_VENDOR":           2,
+		"PARTY_BOTH":             3,
+	}
+)
+
+func (x PartyKind) Enum() *PartyKind {
+	p := new(PartyKind)
+	*p = x
+	return p
+}
+
+func (x PartyKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartyKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_finance_proto_enumTypes[11].Descriptor()
+}
+
+func (PartyKind) Type() protoreflect.EnumType {
+	return &file_finance_proto_enumTypes[11]
+}
+
+func (x PartyKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartyKind.Descriptor instead.
+func (PartyKind) EnumDescriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{11}
+}
+
+type AddressKind int32
+
+const (
+	AddressKind_ADDRESS_KIND_UNSPECIFIED AddressKind = 0
+	AddressKind_ADDRESS_BILLING          AddressKind = 1
+	AddressKind_ADDRESS_SHIPPING         AddressKind = 2
+)
+
+// Enum value maps for AddressKind.
+var (
+	AddressKind_name = map[int32]string{
+		0: "ADDRESS_KIND_UNSPECIFIED",
+		1: "ADDRESS_BILLING",
+		2: "ADDRESS_SHIPPING",
+	}
+	AddressKind_value = map[string]int32{
+		"ADDRESS_KIND_UNSPECIFIED": 0,
+		"ADDRESS_BILLING":          1,
+		"ADDRESS_SHIPPING":         2,
+	}
+)
+
+func (x AddressKind) Enum() *AddressKind {
+	p := new(AddressKind)
+	*p = x
+	return p
+}
+
+func (x AddressKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddressKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_finance_proto_enumTypes[12].Descriptor()
+}
+
+func (AddressKind) Type() protoreflect.EnumType {
+	return &file_finance_proto_enumTypes[12]
+}
+
+func (x AddressKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddressKind.Descriptor instead.
+func (AddressKind) EnumDescriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{12}
+}
+
+type CreditAction int32
+
+const (
+	CreditAction_CREDIT_ACTION_UNSPECIFIED CreditAction = 0
+	CreditAction_CREDIT_ACTION_BLOCK       CreditAction = 1
+	CreditAction_CREDIT_ACTION_APPROVAL    CreditAction = 2
+)
+
+// Enum value maps for CreditAction.
+var (
+	CreditAction_name = map[int32]string{
+		0: "CREDIT_ACTION_UNSPECIFIED",
+		1: "CREDIT_ACTION_BLOCK",
+		2: "CREDIT_ACTION_APPROVAL",
+	}
+	CreditAction_value = map[string]int32{
+		"CREDIT_ACTION_UNSPECIFIED": 0,
+		"CREDIT_ACTION_BLOCK":       1,
+		"CREDIT_ACTION_APPROVAL":    2,
+	}
+)
+
+func (x CreditAction) Enum() *CreditAction {
+	p := new(CreditAction)
+	*p = x
+	return p
+}
+
+func (x CreditAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreditAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_finance_proto_enumTypes[13].Descriptor()
+}
+
+func (CreditAction) Type() protoreflect.EnumType {
+	return &file_finance_proto_enumTypes[13]
+}
+
+func (x CreditAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreditAction.Descriptor instead.
+func (CreditAction) EnumDescriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{13}
+}
+
 type GstDocStatus_EInvoiceStatus int32
 
 const (
@@ -501,11 +810,11 @@ func (x GstDocStatus_EInvoiceStatus) String() string {
 }
 
 func (GstDocStatus_EInvoiceStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_finance_proto_enumTypes[8].Descriptor()
+	return file_finance_proto_enumTypes[14].Descriptor()
 }
 
 func (GstDocStatus_EInvoiceStatus) Type() protoreflect.EnumType {
-	return &file_finance_proto_enumTypes[8]
+	return &file_finance_proto_enumTypes[14]
 }
 
 func (x GstDocStatus_EInvoiceStatus) Number() protoreflect.EnumNumber {
@@ -559,11 +868,11 @@ func (x GstDocStatus_EWayStatus) String() string {
 }
 
 func (GstDocStatus_EWayStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_finance_proto_enumTypes[9].Descriptor()
+	return file_finance_proto_enumTypes[15].Descriptor()
 }
 
 func (GstDocStatus_EWayStatus) Type() protoreflect.EnumType {
-	return &file_finance_proto_enumTypes[9]
+	return &file_finance_proto_enumTypes[15]
 }
 
 func (x GstDocStatus_EWayStatus) Number() protoreflect.EnumNumber {
@@ -1169,6 +1478,7 @@ type GstDocStatus struct {
 	EwayValidUpto *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=eway_valid_upto,json=ewayValidUpto,proto3" json:"eway_valid_upto,omitempty"`
 	LastError     string                  `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
 	LastSyncedAt  *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=last_synced_at,json=lastSyncedAt,proto3" json:"last_synced_at,omitempty"`
+	SignedQrCode  string                  `protobuf:"bytes,10,opt,name=signed_qr_code,json=signedQrCode,proto3" json:"signed_qr_code,omitempty"` // from the IRP, printed as the e-invoice QR code
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1266,6 +1576,13 @@ func (x *GstDocStatus) GetLastSyncedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GstDocStatus) GetSignedQrCode() string {
+	if x != nil {
+		return x.SignedQrCode
+	}
+	return ""
+}
+
 type InvoiceItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1279,6 +1596,7 @@ type InvoiceItem struct {
 	Taxes         []*TaxLine             `protobuf:"bytes,11,rep,name=taxes,proto3" json:"taxes,omitempty"`
 	LineTotal     *money.Money           `protobuf:"bytes,9,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`             // subtotal + taxes
 	CostCenterId  string                 `protobuf:"bytes,10,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"` // base cost accounting
+	AccountId     string                 `protobuf:"bytes,12,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`            // revenue/expense account; the posting rule's when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1390,10 +1708,17 @@ func (x *InvoiceItem) GetCostCenterId() string {
 	return ""
 }
 
+func (x *InvoiceItem) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
 type Invoice struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	InvoiceNumber    string                 `protobuf:"bytes,2,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`
+	InvoiceNumber    string                 `protobuf:"bytes,2,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"` // assigned at issue when the type has a number series
 	Type             InvoiceType            `protobuf:"varint,3,opt,name=type,proto3,enum=finance.InvoiceType" json:"type,omitempty"`
 	InvoiceDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=invoice_date,json=invoiceDate,proto3" json:"invoice_date,omitempty"`
 	DueDate          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
@@ -1422,8 +1747,29 @@ type Invoice struct {
 	GrandTotal *money.Money `protobuf:"bytes,27,opt,name=grand_total,json=grandTotal,proto3" json:"grand_total,omitempty"` // final after discounts + taxes
 	Audit      *AuditFields `protobuf:"bytes,28,opt,name=audit,proto3" json:"audit,omitempty"`
 	// GST integration (optional)
-	Gst           *GstTaxRegime `protobuf:"bytes,29,opt,name=gst,proto3" json:"gst,omitempty"`
-	GstDocs       *GstDocStatus `protobuf:"bytes,30,opt,name=gst_docs,json=gstDocs,proto3" json:"gst_docs,omitempty"`
+	Gst     *GstTaxRegime `protobuf:"bytes,29,opt,name=gst,proto3" json:"gst,omitempty"`
+	GstDocs *GstDocStatus `protobuf:"bytes,30,opt,name=gst_docs,json=gstDocs,proto3" json:"gst_docs,omitempty"`
+	// Lifecycle stamps, output only; set by the status RPCs.
+	IssuedAt   *timestamppb.Timestamp `protobuf:"bytes,31,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	IssuedBy   string                 `protobuf:"bytes,32,opt,name=issued_by,json=issuedBy,proto3" json:"issued_by,omitempty"`
+	VoidedAt   *timestamppb.Timestamp `protobuf:"bytes,33,opt,name=voided_at,json=voidedAt,proto3" json:"voided_at,omitempty"`
+	VoidedBy   string                 `protobuf:"bytes,34,opt,name=voided_by,json=voidedBy,proto3" json:"voided_by,omitempty"`
+	VoidReason string                 `protobuf:"bytes,35,opt,name=void_reason,json=voidReason,proto3" json:"void_reason,omitempty"`
+	OverdueAt  *timestamppb.Timestamp `protobuf:"bytes,36,opt,name=overdue_at,json=overdueAt,proto3" json:"overdue_at,omitempty"`
+	OverdueBy  string                 `protobuf:"bytes,37,opt,name=overdue_by,json=overdueBy,proto3" json:"overdue_by,omitempty"`
+	// The ledger journal posted when the invoice was issued, output only.
+	JournalId string `protobuf:"bytes,38,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	// The party billed (sales) or billing us (purchase), from the party master.
+	PartyId string `protobuf:"bytes,40,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	// Set by ApproveInvoiceCredit on a draft, output only. Changing the draft
+	// withdraws the approval.
+	CreditApprovedBy string                 `protobuf:"bytes,41,opt,name=credit_approved_by,json=creditApprovedBy,proto3" json:"credit_approved_by,omitempty"`
+	CreditApprovedAt *timestamppb.Timestamp `protobuf:"bytes,42,opt,name=credit_approved_at,json=creditApprovedAt,proto3" json:"credit_approved_at,omitempty"`
+	// What the invoice's credit notes took off and its debit notes added, and
+	// the amount billed net of both. Output only.
+	CreditedTotal *money.Money `protobuf:"bytes,43,opt,name=credited_total,json=creditedTotal,proto3" json:"credited_total,omitempty"`
+	DebitedTotal  *money.Money `protobuf:"bytes,44,opt,name=debited_total,json=debitedTotal,proto3" json:"debited_total,omitempty"`
+	NetBilled     *money.Money `protobuf:"bytes,45,opt,name=net_billed,json=netBilled,proto3" json:"net_billed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1661,6 +2007,104 @@ func (x *Invoice) GetGstDocs() *GstDocStatus {
 	return nil
 }
 
+func (x *Invoice) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetIssuedBy() string {
+	if x != nil {
+		return x.IssuedBy
+	}
+	return ""
+}
+
+func (x *Invoice) GetVoidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VoidedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetVoidedBy() string {
+	if x != nil {
+		return x.VoidedBy
+	}
+	return ""
+}
+
+func (x *Invoice) GetVoidReason() string {
+	if x != nil {
+		return x.VoidReason
+	}
+	return ""
+}
+
+func (x *Invoice) GetOverdueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OverdueAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetOverdueBy() string {
+	if x != nil {
+		return x.OverdueBy
+	}
+	return ""
+}
+
+func (x *Invoice) GetJournalId() string {
+	if x != nil {
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *Invoice) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
+}
+
+func (x *Invoice) GetCreditApprovedBy() string {
+	if x != nil {
+		return x.CreditApprovedBy
+	}
+	return ""
+}
+
+func (x *Invoice) GetCreditApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreditApprovedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetCreditedTotal() *money.Money {
+	if x != nil {
+		return x.CreditedTotal
+	}
+	return nil
+}
+
+func (x *Invoice) GetDebitedTotal() *money.Money {
+	if x != nil {
+		return x.DebitedTotal
+	}
+	return nil
+}
+
+func (x *Invoice) GetNetBilled() *money.Money {
+	if x != nil {
+		return x.NetBilled
+	}
+	return nil
+}
+
 type CreateInvoiceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
@@ -1975,8 +2419,9 @@ func (x *ListInvoicesResponse) GetPage() *PageResponse {
 
 type SearchInvoicesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
-	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"` // free-text on number/party/po/etc.
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`   // filter: status, type, invoice_date, due_date, grand_total
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"` // full-text on invoice/PO number, party name or GSTIN, item name/HSN
+	Meta          *RequestMetadata       `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`   // organization searched
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2025,32 +2470,35 @@ func (x *SearchInvoicesRequest) GetQuery() string {
 	return ""
 }
 
-type CreditDebitNote struct {
+func (x *SearchInvoicesRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type IssueInvoiceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	InvoiceId     string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
-	Type          NoteType               `protobuf:"varint,3,opt,name=type,proto3,enum=finance.NoteType" json:"type,omitempty"`
-	Amount        *money.Money           `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	Audit         *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreditDebitNote) Reset() {
-	*x = CreditDebitNote{}
+func (x *IssueInvoiceRequest) Reset() {
+	*x = IssueInvoiceRequest{}
 	mi := &file_finance_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreditDebitNote) String() string {
+func (x *IssueInvoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreditDebitNote) ProtoMessage() {}
+func (*IssueInvoiceRequest) ProtoMessage() {}
 
-func (x *CreditDebitNote) ProtoReflect() protoreflect.Message {
+func (x *IssueInvoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2062,75 +2510,48 @@ func (x *CreditDebitNote) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreditDebitNote.ProtoReflect.Descriptor instead.
-func (*CreditDebitNote) Descriptor() ([]byte, []int) {
+// Deprecated: Use IssueInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*IssueInvoiceRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CreditDebitNote) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CreditDebitNote) GetInvoiceId() string {
-	if x != nil {
-		return x.InvoiceId
-	}
-	return ""
-}
-
-func (x *CreditDebitNote) GetType() NoteType {
-	if x != nil {
-		return x.Type
-	}
-	return NoteType_NOTE_TYPE_UNSPECIFIED
-}
-
-func (x *CreditDebitNote) GetAmount() *money.Money {
+func (x *IssueInvoiceRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Amount
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *CreditDebitNote) GetReason() string {
+func (x *IssueInvoiceRequest) GetId() string {
 	if x != nil {
-		return x.Reason
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreditDebitNote) GetAudit() *AuditFields {
-	if x != nil {
-		return x.Audit
-	}
-	return nil
-}
-
-type CreateCreditDebitNoteRequest struct {
+type VoidInvoiceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Note          *CreditDebitNote       `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // required
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCreditDebitNoteRequest) Reset() {
-	*x = CreateCreditDebitNoteRequest{}
+func (x *VoidInvoiceRequest) Reset() {
+	*x = VoidInvoiceRequest{}
 	mi := &file_finance_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCreditDebitNoteRequest) String() string {
+func (x *VoidInvoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCreditDebitNoteRequest) ProtoMessage() {}
+func (*VoidInvoiceRequest) ProtoMessage() {}
 
-func (x *CreateCreditDebitNoteRequest) ProtoReflect() protoreflect.Message {
+func (x *VoidInvoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2142,46 +2563,55 @@ func (x *CreateCreditDebitNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCreditDebitNoteRequest.ProtoReflect.Descriptor instead.
-func (*CreateCreditDebitNoteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoidInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*VoidInvoiceRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CreateCreditDebitNoteRequest) GetMeta() *RequestMetadata {
+func (x *VoidInvoiceRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *CreateCreditDebitNoteRequest) GetNote() *CreditDebitNote {
+func (x *VoidInvoiceRequest) GetId() string {
 	if x != nil {
-		return x.Note
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetCreditDebitNoteRequest struct {
+func (x *VoidInvoiceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MarkOverdueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // defaults to now; must be after due_date
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCreditDebitNoteRequest) Reset() {
-	*x = GetCreditDebitNoteRequest{}
+func (x *MarkOverdueRequest) Reset() {
+	*x = MarkOverdueRequest{}
 	mi := &file_finance_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCreditDebitNoteRequest) String() string {
+func (x *MarkOverdueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCreditDebitNoteRequest) ProtoMessage() {}
+func (*MarkOverdueRequest) ProtoMessage() {}
 
-func (x *GetCreditDebitNoteRequest) ProtoReflect() protoreflect.Message {
+func (x *MarkOverdueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2193,41 +2623,68 @@ func (x *GetCreditDebitNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCreditDebitNoteRequest.ProtoReflect.Descriptor instead.
-func (*GetCreditDebitNoteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarkOverdueRequest.ProtoReflect.Descriptor instead.
+func (*MarkOverdueRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetCreditDebitNoteRequest) GetId() string {
+func (x *MarkOverdueRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *MarkOverdueRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type UpdateCreditDebitNoteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Note          *CreditDebitNote       `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MarkOverdueRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
 }
 
-func (x *UpdateCreditDebitNoteRequest) Reset() {
-	*x = UpdateCreditDebitNoteRequest{}
+// Accounts an issued invoice of a type posts to. For SALES invoices the
+// control account is the receivable and the line account the revenue
+// account; PURCHASE invoices post to the payable and expense accounts with
+// every side mirrored. Tax accounts are required once an invoice carries
+// that tax; discounts and round-off go to the line account when unset.
+type InvoicePostingRule struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId    string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	InvoiceType       InvoiceType            `protobuf:"varint,2,opt,name=invoice_type,json=invoiceType,proto3,enum=finance.InvoiceType" json:"invoice_type,omitempty"` // SALES or PURCHASE
+	ControlAccountId  string                 `protobuf:"bytes,3,opt,name=control_account_id,json=controlAccountId,proto3" json:"control_account_id,omitempty"`
+	LineAccountId     string                 `protobuf:"bytes,4,opt,name=line_account_id,json=lineAccountId,proto3" json:"line_account_id,omitempty"`
+	CgstAccountId     string                 `protobuf:"bytes,5,opt,name=cgst_account_id,json=cgstAccountId,proto3" json:"cgst_account_id,omitempty"`
+	SgstAccountId     string                 `protobuf:"bytes,6,opt,name=sgst_account_id,json=sgstAccountId,proto3" json:"sgst_account_id,omitempty"`
+	IgstAccountId     string                 `protobuf:"bytes,7,opt,name=igst_account_id,json=igstAccountId,proto3" json:"igst_account_id,omitempty"`
+	OtherTaxAccountId string                 `protobuf:"bytes,8,opt,name=other_tax_account_id,json=otherTaxAccountId,proto3" json:"other_tax_account_id,omitempty"` // non-GST invoice taxes
+	DiscountAccountId string                 `protobuf:"bytes,9,opt,name=discount_account_id,json=discountAccountId,proto3" json:"discount_account_id,omitempty"`   // invoice-level discounts
+	RoundOffAccountId string                 `protobuf:"bytes,10,opt,name=round_off_account_id,json=roundOffAccountId,proto3" json:"round_off_account_id,omitempty"`
+	Audit             *AuditFields           `protobuf:"bytes,11,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InvoicePostingRule) Reset() {
+	*x = InvoicePostingRule{}
 	mi := &file_finance_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCreditDebitNoteRequest) String() string {
+func (x *InvoicePostingRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCreditDebitNoteRequest) ProtoMessage() {}
+func (*InvoicePostingRule) ProtoMessage() {}
 
-func (x *UpdateCreditDebitNoteRequest) ProtoReflect() protoreflect.Message {
+func (x *InvoicePostingRule) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2239,54 +2696,110 @@ func (x *UpdateCreditDebitNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCreditDebitNoteRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCreditDebitNoteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InvoicePostingRule.ProtoReflect.Descriptor instead.
+func (*InvoicePostingRule) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateCreditDebitNoteRequest) GetMeta() *RequestMetadata {
+func (x *InvoicePostingRule) GetOrganizationId() string {
 	if x != nil {
-		return x.Meta
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateCreditDebitNoteRequest) GetNote() *CreditDebitNote {
+func (x *InvoicePostingRule) GetInvoiceType() InvoiceType {
 	if x != nil {
-		return x.Note
+		return x.InvoiceType
 	}
-	return nil
+	return InvoiceType_INVOICE_TYPE_UNSPECIFIED
 }
 
-func (x *UpdateCreditDebitNoteRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *InvoicePostingRule) GetControlAccountId() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.ControlAccountId
+	}
+	return ""
+}
+
+func (x *InvoicePostingRule) GetLineAccountId() string {
+	if x != nil {
+		return x.LineAccountId
+	}
+	return ""
+}
+
+func (x *InvoicePostingRule) GetCgstAccountId() string {
+	if x != nil {
+		return x.CgstAccountId
+	}
+	return ""
+}
+
+func (x *InvoicePostingRule) GetSgstAccountId() string {
+	if x != nil {
+		return x.SgstAccountId
+	}
+	return ""
+}
+
+func (x *InvoicePostingRule) GetIgstAccountId() string {
+	if x != nil {
+		return x.IgstAccountId
+	}
+	return ""
+}
+
+func (x *InvoicePostingRule) GetOtherTaxAccountId() string {
+	if x != nil {
+		return x.OtherTaxAccountId
+	}
+	return ""
+}
+
+func (x *InvoicePostingRule) GetDiscountAccountId() string {
+	if x != nil {
+		return x.DiscountAccountId
+	}
+	return ""
+}
+
+func (x *InvoicePostingRule) GetRoundOffAccountId() string {
+	if x != nil {
+		return x.RoundOffAccountId
+	}
+	return ""
+}
+
+func (x *InvoicePostingRule) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
 	}
 	return nil
 }
 
-type DeleteCreditDebitNoteRequest struct {
+type SetInvoicePostingRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Rule          *InvoicePostingRule    `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCreditDebitNoteRequest) Reset() {
-	*x = DeleteCreditDebitNoteRequest{}
+func (x *SetInvoicePostingRuleRequest) Reset() {
+	*x = SetInvoicePostingRuleRequest{}
 	mi := &file_finance_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCreditDebitNoteRequest) String() string {
+func (x *SetInvoicePostingRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCreditDebitNoteRequest) ProtoMessage() {}
+func (*SetInvoicePostingRuleRequest) ProtoMessage() {}
 
-func (x *DeleteCreditDebitNoteRequest) ProtoReflect() protoreflect.Message {
+func (x *SetInvoicePostingRuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2298,46 +2811,47 @@ func (x *DeleteCreditDebitNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCreditDebitNoteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCreditDebitNoteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetInvoicePostingRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetInvoicePostingRuleRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DeleteCreditDebitNoteRequest) GetMeta() *RequestMetadata {
+func (x *SetInvoicePostingRuleRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *DeleteCreditDebitNoteRequest) GetId() string {
+func (x *SetInvoicePostingRuleRequest) GetRule() *InvoicePostingRule {
 	if x != nil {
-		return x.Id
+		return x.Rule
 	}
-	return ""
+	return nil
 }
 
-type ListCreditDebitNotesRequest struct {
+type GetInvoicePostingRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	InvoiceType   InvoiceType            `protobuf:"varint,2,opt,name=invoice_type,json=invoiceType,proto3,enum=finance.InvoiceType" json:"invoice_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCreditDebitNotesRequest) Reset() {
-	*x = ListCreditDebitNotesRequest{}
+func (x *GetInvoicePostingRuleRequest) Reset() {
+	*x = GetInvoicePostingRuleRequest{}
 	mi := &file_finance_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCreditDebitNotesRequest) String() string {
+func (x *GetInvoicePostingRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCreditDebitNotesRequest) ProtoMessage() {}
+func (*GetInvoicePostingRuleRequest) ProtoMessage() {}
 
-func (x *ListCreditDebitNotesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetInvoicePostingRuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2349,40 +2863,54 @@ func (x *ListCreditDebitNotesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCreditDebitNotesRequest.ProtoReflect.Descriptor instead.
-func (*ListCreditDebitNotesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInvoicePostingRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoicePostingRuleRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListCreditDebitNotesRequest) GetPage() *PageRequest {
+func (x *GetInvoicePostingRuleRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Page
+		return x.Meta
 	}
 	return nil
 }
 
-type ListCreditDebitNotesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notes         []*CreditDebitNote     `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetInvoicePostingRuleRequest) GetInvoiceType() InvoiceType {
+	if x != nil {
+		return x.InvoiceType
+	}
+	return InvoiceType_INVOICE_TYPE_UNSPECIFIED
 }
 
-func (x *ListCreditDebitNotesResponse) Reset() {
-	*x = ListCreditDebitNotesResponse{}
+// How an organization numbers its invoices of a type. The pattern may carry
+// {FY} (e.g. 2025-26) or {FY:2} (e.g. 25-26) and exactly one {SEQ} or
+// {SEQ:n}, the running number of the fiscal year zero-padded to n digits,
+// e.g. INV/{FY}/{SEQ:5}. Numbers are allocated without gaps when invoices
+// are issued.
+type InvoiceNumberSeries struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	InvoiceType    InvoiceType            `protobuf:"varint,2,opt,name=invoice_type,json=invoiceType,proto3,enum=finance.InvoiceType" json:"invoice_type,omitempty"`
+	Pattern        string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Audit          *AuditFields           `protobuf:"bytes,4,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InvoiceNumberSeries) Reset() {
+	*x = InvoiceNumberSeries{}
 	mi := &file_finance_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCreditDebitNotesResponse) String() string {
+func (x *InvoiceNumberSeries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCreditDebitNotesResponse) ProtoMessage() {}
+func (*InvoiceNumberSeries) ProtoMessage() {}
 
-func (x *ListCreditDebitNotesResponse) ProtoReflect() protoreflect.Message {
+func (x *InvoiceNumberSeries) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2394,51 +2922,71 @@ func (x *ListCreditDebitNotesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCreditDebitNotesResponse.ProtoReflect.Descriptor instead.
-func (*ListCreditDebitNotesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InvoiceNumberSeries.ProtoReflect.Descriptor instead.
+func (*InvoiceNumberSeries) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListCreditDebitNotesResponse) GetNotes() []*CreditDebitNote {
+func (x *InvoiceNumberSeries) GetOrganizationId() string {
 	if x != nil {
-		return x.Notes
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListCreditDebitNotesResponse) GetPage() *PageResponse {
+func (x *InvoiceNumberSeries) GetInvoiceType() InvoiceType {
 	if x != nil {
-		return x.Page
+		return x.InvoiceType
+	}
+	return InvoiceType_INVOICE_TYPE_UNSPECIFIED
+}
+
+func (x *InvoiceNumberSeries) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *InvoiceNumberSeries) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
 	}
 	return nil
 }
 
-type PaymentDue struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	InvoiceId     string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
-	AmountDue     *money.Money           `protobuf:"bytes,3,opt,name=amount_due,json=amountDue,proto3" json:"amount_due,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	Status        PaymentStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=finance.PaymentStatus" json:"status,omitempty"`
-	Audit         *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// What an organization prints on its invoices around the invoice itself.
+// Address, bank details and terms are printed line by line.
+type InvoiceTemplate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	CompanyName    string                 `protobuf:"bytes,2,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	Address        string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Logo           []byte                 `protobuf:"bytes,4,opt,name=logo,proto3" json:"logo,omitempty"` // PNG or JPEG, at most 512 KiB
+	BankDetails    string                 `protobuf:"bytes,5,opt,name=bank_details,json=bankDetails,proto3" json:"bank_details,omitempty"`
+	Terms          string                 `protobuf:"bytes,6,opt,name=terms,proto3" json:"terms,omitempty"`
+	Footer         string                 `protobuf:"bytes,7,opt,name=footer,proto3" json:"footer,omitempty"`
+	Audit          *AuditFields           `protobuf:"bytes,8,opt,name=audit,proto3" json:"audit,omitempty"`
+	CountryCode    string                 `protobuf:"bytes,9,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`           // ISO 3166-1 alpha-2, default IN
+	PeppolEndpoint string                 `protobuf:"bytes,10,opt,name=peppol_endpoint,json=peppolEndpoint,proto3" json:"peppol_endpoint,omitempty"` // "<ISO 6523 scheme>:<id>", e.g. 0088:5790000435975
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *PaymentDue) Reset() {
-	*x = PaymentDue{}
+func (x *InvoiceTemplate) Reset() {
+	*x = InvoiceTemplate{}
 	mi := &file_finance_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentDue) String() string {
+func (x *InvoiceTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentDue) ProtoMessage() {}
+func (*InvoiceTemplate) ProtoMessage() {}
 
-func (x *PaymentDue) ProtoReflect() protoreflect.Message {
+func (x *InvoiceTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2450,75 +2998,103 @@ func (x *PaymentDue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentDue.ProtoReflect.Descriptor instead.
-func (*PaymentDue) Descriptor() ([]byte, []int) {
+// Deprecated: Use InvoiceTemplate.ProtoReflect.Descriptor instead.
+func (*InvoiceTemplate) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *PaymentDue) GetId() string {
+func (x *InvoiceTemplate) GetOrganizationId() string {
 	if x != nil {
-		return x.Id
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *PaymentDue) GetInvoiceId() string {
+func (x *InvoiceTemplate) GetCompanyName() string {
 	if x != nil {
-		return x.InvoiceId
+		return x.CompanyName
 	}
 	return ""
 }
 
-func (x *PaymentDue) GetAmountDue() *money.Money {
+func (x *InvoiceTemplate) GetAddress() string {
 	if x != nil {
-		return x.AmountDue
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *PaymentDue) GetDueDate() *timestamppb.Timestamp {
+func (x *InvoiceTemplate) GetLogo() []byte {
 	if x != nil {
-		return x.DueDate
+		return x.Logo
 	}
 	return nil
 }
 
-func (x *PaymentDue) GetStatus() PaymentStatus {
+func (x *InvoiceTemplate) GetBankDetails() string {
 	if x != nil {
-		return x.Status
+		return x.BankDetails
 	}
-	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *PaymentDue) GetAudit() *AuditFields {
+func (x *InvoiceTemplate) GetTerms() string {
+	if x != nil {
+		return x.Terms
+	}
+	return ""
+}
+
+func (x *InvoiceTemplate) GetFooter() string {
+	if x != nil {
+		return x.Footer
+	}
+	return ""
+}
+
+func (x *InvoiceTemplate) GetAudit() *AuditFields {
 	if x != nil {
 		return x.Audit
 	}
 	return nil
 }
 
-type CreatePaymentDueRequest struct {
+func (x *InvoiceTemplate) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *InvoiceTemplate) GetPeppolEndpoint() string {
+	if x != nil {
+		return x.PeppolEndpoint
+	}
+	return ""
+}
+
+type SetInvoiceTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Due           *PaymentDue            `protobuf:"bytes,2,opt,name=due,proto3" json:"due,omitempty"`
+	Template      *InvoiceTemplate       `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePaymentDueRequest) Reset() {
-	*x = CreatePaymentDueRequest{}
+func (x *SetInvoiceTemplateRequest) Reset() {
+	*x = SetInvoiceTemplateRequest{}
 	mi := &file_finance_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePaymentDueRequest) String() string {
+func (x *SetInvoiceTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePaymentDueRequest) ProtoMessage() {}
+func (*SetInvoiceTemplateRequest) ProtoMessage() {}
 
-func (x *CreatePaymentDueRequest) ProtoReflect() protoreflect.Message {
+func (x *SetInvoiceTemplateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2530,46 +3106,46 @@ func (x *CreatePaymentDueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePaymentDueRequest.ProtoReflect.Descriptor instead.
-func (*CreatePaymentDueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetInvoiceTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SetInvoiceTemplateRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CreatePaymentDueRequest) GetMeta() *RequestMetadata {
+func (x *SetInvoiceTemplateRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *CreatePaymentDueRequest) GetDue() *PaymentDue {
+func (x *SetInvoiceTemplateRequest) GetTemplate() *InvoiceTemplate {
 	if x != nil {
-		return x.Due
+		return x.Template
 	}
 	return nil
 }
 
-type GetPaymentDueRequest struct {
+type GetInvoiceTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPaymentDueRequest) Reset() {
-	*x = GetPaymentDueRequest{}
+func (x *GetInvoiceTemplateRequest) Reset() {
+	*x = GetInvoiceTemplateRequest{}
 	mi := &file_finance_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPaymentDueRequest) String() string {
+func (x *GetInvoiceTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPaymentDueRequest) ProtoMessage() {}
+func (*GetInvoiceTemplateRequest) ProtoMessage() {}
 
-func (x *GetPaymentDueRequest) ProtoReflect() protoreflect.Message {
+func (x *GetInvoiceTemplateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2581,41 +3157,40 @@ func (x *GetPaymentDueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPaymentDueRequest.ProtoReflect.Descriptor instead.
-func (*GetPaymentDueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInvoiceTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceTemplateRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetPaymentDueRequest) GetId() string {
+func (x *GetInvoiceTemplateRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Id
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-type UpdatePaymentDueRequest struct {
+type RenderInvoiceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Due           *PaymentDue            `protobuf:"bytes,2,opt,name=due,proto3" json:"due,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePaymentDueRequest) Reset() {
-	*x = UpdatePaymentDueRequest{}
+func (x *RenderInvoiceRequest) Reset() {
+	*x = RenderInvoiceRequest{}
 	mi := &file_finance_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePaymentDueRequest) String() string {
+func (x *RenderInvoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePaymentDueRequest) ProtoMessage() {}
+func (*RenderInvoiceRequest) ProtoMessage() {}
 
-func (x *UpdatePaymentDueRequest) ProtoReflect() protoreflect.Message {
+func (x *RenderInvoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2627,54 +3202,51 @@ func (x *UpdatePaymentDueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePaymentDueRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePaymentDueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenderInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*RenderInvoiceRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpdatePaymentDueRequest) GetMeta() *RequestMetadata {
+func (x *RenderInvoiceRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *UpdatePaymentDueRequest) GetDue() *PaymentDue {
-	if x != nil {
-		return x.Due
-	}
-	return nil
-}
-
-func (x *UpdatePaymentDueRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *RenderInvoiceRequest) GetId() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type DeletePaymentDueRequest struct {
+// A rendered invoice. The same revision of an invoice renders to the same
+// bytes as long as the organization's template is unchanged.
+type RenderedInvoice struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // application/pdf, or application/xml for e-invoices
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Revision      int32                  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"` // the invoice revision rendered
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePaymentDueRequest) Reset() {
-	*x = DeletePaymentDueRequest{}
+func (x *RenderedInvoice) Reset() {
+	*x = RenderedInvoice{}
 	mi := &file_finance_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePaymentDueRequest) String() string {
+func (x *RenderedInvoice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePaymentDueRequest) ProtoMessage() {}
+func (*RenderedInvoice) ProtoMessage() {}
 
-func (x *DeletePaymentDueRequest) ProtoReflect() protoreflect.Message {
+func (x *RenderedInvoice) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2686,50 +3258,61 @@ func (x *DeletePaymentDueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePaymentDueRequest.ProtoReflect.Descriptor instead.
-func (*DeletePaymentDueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenderedInvoice.ProtoReflect.Descriptor instead.
+func (*RenderedInvoice) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *DeletePaymentDueRequest) GetMeta() *RequestMetadata {
+func (x *RenderedInvoice) GetFileName() string {
 	if x != nil {
-		return x.Meta
+		return x.FileName
 	}
-	return nil
+	return ""
 }
 
-func (x *DeletePaymentDueRequest) GetId() string {
+func (x *RenderedInvoice) GetContentType() string {
 	if x != nil {
-		return x.Id
+		return x.ContentType
 	}
 	return ""
 }
 
-type MarkPaymentAsPaidRequest struct {
+func (x *RenderedInvoice) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *RenderedInvoice) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type ExportInvoiceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                   // PaymentDue id
-	AmountPaid    *money.Money           `protobuf:"bytes,3,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"` // partial or full
-	PaidAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
-	Reference     string                 `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"` // bank txn / UTR / cheque
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarkPaymentAsPaidRequest) Reset() {
-	*x = MarkPaymentAsPaidRequest{}
+func (x *ExportInvoiceRequest) Reset() {
+	*x = ExportInvoiceRequest{}
 	mi := &file_finance_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarkPaymentAsPaidRequest) String() string {
+func (x *ExportInvoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkPaymentAsPaidRequest) ProtoMessage() {}
+func (*ExportInvoiceRequest) ProtoMessage() {}
 
-func (x *MarkPaymentAsPaidRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportInvoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2741,67 +3324,49 @@ func (x *MarkPaymentAsPaidRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkPaymentAsPaidRequest.ProtoReflect.Descriptor instead.
-func (*MarkPaymentAsPaidRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*ExportInvoiceRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *MarkPaymentAsPaidRequest) GetMeta() *RequestMetadata {
+func (x *ExportInvoiceRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *MarkPaymentAsPaidRequest) GetId() string {
+func (x *ExportInvoiceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *MarkPaymentAsPaidRequest) GetAmountPaid() *money.Money {
-	if x != nil {
-		return x.AmountPaid
-	}
-	return nil
-}
-
-func (x *MarkPaymentAsPaidRequest) GetPaidAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.PaidAt
-	}
-	return nil
-}
-
-func (x *MarkPaymentAsPaidRequest) GetReference() string {
-	if x != nil {
-		return x.Reference
-	}
-	return ""
-}
-
-type ListPaymentDuesRequest struct {
+// An inbound UBL 2.1 invoice, recorded as a DRAFT purchase invoice from the
+// vendor party its seller matches, or a new one.
+type ImportInvoiceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // UBL Invoice XML
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPaymentDuesRequest) Reset() {
-	*x = ListPaymentDuesRequest{}
+func (x *ImportInvoiceRequest) Reset() {
+	*x = ImportInvoiceRequest{}
 	mi := &file_finance_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPaymentDuesRequest) String() string {
+func (x *ImportInvoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPaymentDuesRequest) ProtoMessage() {}
+func (*ImportInvoiceRequest) ProtoMessage() {}
 
-func (x *ListPaymentDuesRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportInvoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2813,40 +3378,47 @@ func (x *ListPaymentDuesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPaymentDuesRequest.ProtoReflect.Descriptor instead.
-func (*ListPaymentDuesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*ImportInvoiceRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListPaymentDuesRequest) GetPage() *PageRequest {
+func (x *ImportInvoiceRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Page
+		return x.Meta
 	}
 	return nil
 }
 
-type ListPaymentDuesResponse struct {
+func (x *ImportInvoiceRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type SetInvoiceNumberSeriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Dues          []*PaymentDue          `protobuf:"bytes,1,rep,name=dues,proto3" json:"dues,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Series        *InvoiceNumberSeries   `protobuf:"bytes,2,opt,name=series,proto3" json:"series,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPaymentDuesResponse) Reset() {
-	*x = ListPaymentDuesResponse{}
+func (x *SetInvoiceNumberSeriesRequest) Reset() {
+	*x = SetInvoiceNumberSeriesRequest{}
 	mi := &file_finance_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPaymentDuesResponse) String() string {
+func (x *SetInvoiceNumberSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPaymentDuesResponse) ProtoMessage() {}
+func (*SetInvoiceNumberSeriesRequest) ProtoMessage() {}
 
-func (x *ListPaymentDuesResponse) ProtoReflect() protoreflect.Message {
+func (x *SetInvoiceNumberSeriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2858,51 +3430,47 @@ func (x *ListPaymentDuesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPaymentDuesResponse.ProtoReflect.Descriptor instead.
-func (*ListPaymentDuesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetInvoiceNumberSeriesRequest.ProtoReflect.Descriptor instead.
+func (*SetInvoiceNumberSeriesRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListPaymentDuesResponse) GetDues() []*PaymentDue {
+func (x *SetInvoiceNumberSeriesRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Dues
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListPaymentDuesResponse) GetPage() *PageResponse {
+func (x *SetInvoiceNumberSeriesRequest) GetSeries() *InvoiceNumberSeries {
 	if x != nil {
-		return x.Page
+		return x.Series
 	}
 	return nil
 }
 
-type BankAccount struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	AccountNumber   string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	IfscOrSwift     string                 `protobuf:"bytes,4,opt,name=ifsc_or_swift,json=ifscOrSwift,proto3" json:"ifsc_or_swift,omitempty"`
-	LedgerAccountId string                 `protobuf:"bytes,5,opt,name=ledger_account_id,json=ledgerAccountId,proto3" json:"ledger_account_id,omitempty"` // link to COA account
-	Audit           *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetInvoiceNumberSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	InvoiceType   InvoiceType            `protobuf:"varint,2,opt,name=invoice_type,json=invoiceType,proto3,enum=finance.InvoiceType" json:"invoice_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BankAccount) Reset() {
-	*x = BankAccount{}
+func (x *GetInvoiceNumberSeriesRequest) Reset() {
+	*x = GetInvoiceNumberSeriesRequest{}
 	mi := &file_finance_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BankAccount) String() string {
+func (x *GetInvoiceNumberSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BankAccount) ProtoMessage() {}
+func (*GetInvoiceNumberSeriesRequest) ProtoMessage() {}
 
-func (x *BankAccount) ProtoReflect() protoreflect.Message {
+func (x *GetInvoiceNumberSeriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2914,76 +3482,131 @@ func (x *BankAccount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BankAccount.ProtoReflect.Descriptor instead.
-func (*BankAccount) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInvoiceNumberSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceNumberSeriesRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *BankAccount) GetId() string {
+func (x *GetInvoiceNumberSeriesRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Id
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-func (x *BankAccount) GetName() string {
+func (x *GetInvoiceNumberSeriesRequest) GetInvoiceType() InvoiceType {
+	if x != nil {
+		return x.InvoiceType
+	}
+	return InvoiceType_INVOICE_TYPE_UNSPECIFIED
+}
+
+type PartyContact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Designation   string                 `protobuf:"bytes,4,opt,name=designation,proto3" json:"designation,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,5,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"` // at most one per party
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartyContact) Reset() {
+	*x = PartyContact{}
+	mi := &file_finance_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartyContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartyContact) ProtoMessage() {}
+
+func (x *PartyContact) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartyContact.ProtoReflect.Descriptor instead.
+func (*PartyContact) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PartyContact) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *BankAccount) GetAccountNumber() string {
+func (x *PartyContact) GetEmail() string {
 	if x != nil {
-		return x.AccountNumber
+		return x.Email
 	}
 	return ""
 }
 
-func (x *BankAccount) GetIfscOrSwift() string {
+func (x *PartyContact) GetPhone() string {
 	if x != nil {
-		return x.IfscOrSwift
+		return x.Phone
 	}
 	return ""
 }
 
-func (x *BankAccount) GetLedgerAccountId() string {
+func (x *PartyContact) GetDesignation() string {
 	if x != nil {
-		return x.LedgerAccountId
+		return x.Designation
 	}
 	return ""
 }
 
-func (x *BankAccount) GetAudit() *AuditFields {
+func (x *PartyContact) GetIsPrimary() bool {
 	if x != nil {
-		return x.Audit
+		return x.IsPrimary
 	}
-	return nil
+	return false
 }
 
-type CreateBankAccountRequest struct {
+type PartyAddress struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Account       *BankAccount           `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Kind          AddressKind            `protobuf:"varint,1,opt,name=kind,proto3,enum=finance.AddressKind" json:"kind,omitempty"`
+	Line1         string                 `protobuf:"bytes,2,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2         string                 `protobuf:"bytes,3,opt,name=line2,proto3" json:"line2,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	State         string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	StateCode     string                 `protobuf:"bytes,6,opt,name=state_code,json=stateCode,proto3" json:"state_code,omitempty"` // GST state code, e.g. "27"
+	PostalCode    string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,8,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"` // ISO 3166-1 alpha-2, defaults to IN
+	IsDefault     bool                   `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`      // at most one per kind
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBankAccountRequest) Reset() {
-	*x = CreateBankAccountRequest{}
-	mi := &file_finance_proto_msgTypes[35]
+func (x *PartyAddress) Reset() {
+	*x = PartyAddress{}
+	mi := &file_finance_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBankAccountRequest) String() string {
+func (x *PartyAddress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBankAccountRequest) ProtoMessage() {}
+func (*PartyAddress) ProtoMessage() {}
 
-func (x *CreateBankAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[35]
+func (x *PartyAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2994,92 +3617,97 @@ func (x *CreateBankAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBankAccountRequest.ProtoReflect.Descriptor instead.
-func (*CreateBankAccountRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use PartyAddress.ProtoReflect.Descriptor instead.
+func (*PartyAddress) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CreateBankAccountRequest) GetMeta() *RequestMetadata {
+func (x *PartyAddress) GetKind() AddressKind {
 	if x != nil {
-		return x.Meta
+		return x.Kind
 	}
-	return nil
+	return AddressKind_ADDRESS_KIND_UNSPECIFIED
 }
 
-func (x *CreateBankAccountRequest) GetAccount() *BankAccount {
+func (x *PartyAddress) GetLine1() string {
 	if x != nil {
-		return x.Account
+		return x.Line1
 	}
-	return nil
+	return ""
 }
 
-type GetBankAccountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PartyAddress) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
 }
 
-func (x *GetBankAccountRequest) Reset() {
-	*x = GetBankAccountRequest{}
-	mi := &file_finance_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PartyAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
 }
 
-func (x *GetBankAccountRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PartyAddress) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
 }
 
-func (*GetBankAccountRequest) ProtoMessage() {}
-
-func (x *GetBankAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[36]
+func (x *PartyAddress) GetStateCode() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StateCode
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetBankAccountRequest.ProtoReflect.Descriptor instead.
-func (*GetBankAccountRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{36}
+func (x *PartyAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
 }
 
-func (x *GetBankAccountRequest) GetId() string {
+func (x *PartyAddress) GetCountryCode() string {
 	if x != nil {
-		return x.Id
+		return x.CountryCode
 	}
 	return ""
 }
 
-type UpdateBankAccountRequest struct {
+func (x *PartyAddress) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type PartyTaxRegistration struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Account       *BankAccount           `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	TaxType       string                 `protobuf:"bytes,1,opt,name=tax_type,json=taxType,proto3" json:"tax_type,omitempty"` // GSTIN, or another scheme's name
+	Number        string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	StateCode     string                 `protobuf:"bytes,3,opt,name=state_code,json=stateCode,proto3" json:"state_code,omitempty"` // a GSTIN's defaults to its first two digits
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBankAccountRequest) Reset() {
-	*x = UpdateBankAccountRequest{}
+func (x *PartyTaxRegistration) Reset() {
+	*x = PartyTaxRegistration{}
 	mi := &file_finance_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBankAccountRequest) String() string {
+func (x *PartyTaxRegistration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBankAccountRequest) ProtoMessage() {}
+func (*PartyTaxRegistration) ProtoMessage() {}
 
-func (x *UpdateBankAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *PartyTaxRegistration) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3091,54 +3719,58 @@ func (x *UpdateBankAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBankAccountRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBankAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PartyTaxRegistration.ProtoReflect.Descriptor instead.
+func (*PartyTaxRegistration) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UpdateBankAccountRequest) GetMeta() *RequestMetadata {
+func (x *PartyTaxRegistration) GetTaxType() string {
 	if x != nil {
-		return x.Meta
+		return x.TaxType
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateBankAccountRequest) GetAccount() *BankAccount {
+func (x *PartyTaxRegistration) GetNumber() string {
 	if x != nil {
-		return x.Account
+		return x.Number
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateBankAccountRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *PartyTaxRegistration) GetStateCode() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.StateCode
 	}
-	return nil
+	return ""
 }
 
-type DeleteBankAccountRequest struct {
+type PartyBankAccount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	AccountName   string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Ifsc          string                 `protobuf:"bytes,3,opt,name=ifsc,proto3" json:"ifsc,omitempty"`
+	BankName      string                 `protobuf:"bytes,4,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	Branch        string                 `protobuf:"bytes,5,opt,name=branch,proto3" json:"branch,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,6,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"` // at most one per party
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBankAccountRequest) Reset() {
-	*x = DeleteBankAccountRequest{}
+func (x *PartyBankAccount) Reset() {
+	*x = PartyBankAccount{}
 	mi := &file_finance_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBankAccountRequest) String() string {
+func (x *PartyBankAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBankAccountRequest) ProtoMessage() {}
+func (*PartyBankAccount) ProtoMessage() {}
 
-func (x *DeleteBankAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *PartyBankAccount) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3150,92 +3782,94 @@ func (x *DeleteBankAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBankAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBankAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PartyBankAccount.ProtoReflect.Descriptor instead.
+func (*PartyBankAccount) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *DeleteBankAccountRequest) GetMeta() *RequestMetadata {
+func (x *PartyBankAccount) GetAccountName() string {
 	if x != nil {
-		return x.Meta
+		return x.AccountName
 	}
-	return nil
+	return ""
 }
 
-func (x *DeleteBankAccountRequest) GetId() string {
+func (x *PartyBankAccount) GetAccountNumber() string {
 	if x != nil {
-		return x.Id
+		return x.AccountNumber
 	}
 	return ""
 }
 
-type ListBankAccountsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListBankAccountsRequest) Reset() {
-	*x = ListBankAccountsRequest{}
-	mi := &file_finance_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListBankAccountsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PartyBankAccount) GetIfsc() string {
+	if x != nil {
+		return x.Ifsc
+	}
+	return ""
 }
 
-func (*ListBankAccountsRequest) ProtoMessage() {}
-
-func (x *ListBankAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[39]
+func (x *PartyBankAccount) GetBankName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BankName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListBankAccountsRequest.ProtoReflect.Descriptor instead.
-func (*ListBankAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{39}
+func (x *PartyBankAccount) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
 }
 
-func (x *ListBankAccountsRequest) GetPage() *PageRequest {
+func (x *PartyBankAccount) GetIsPrimary() bool {
 	if x != nil {
-		return x.Page
+		return x.IsPrimary
 	}
-	return nil
+	return false
 }
 
-type ListBankAccountsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Accounts      []*BankAccount         `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Party struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Id               string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId   string                  `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Kind             PartyKind               `protobuf:"varint,3,opt,name=kind,proto3,enum=finance.PartyKind" json:"kind,omitempty"`
+	Name             string                  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	LegalName        string                  `protobuf:"bytes,5,opt,name=legal_name,json=legalName,proto3" json:"legal_name,omitempty"`
+	Pan              string                  `protobuf:"bytes,6,opt,name=pan,proto3" json:"pan,omitempty"`                                                // must match the PAN inside each GSTIN
+	DefaultCurrency  string                  `protobuf:"bytes,7,opt,name=default_currency,json=defaultCurrency,proto3" json:"default_currency,omitempty"` // ISO 4217
+	PaymentTermsDays int32                   `protobuf:"varint,8,opt,name=payment_terms_days,json=paymentTermsDays,proto3" json:"payment_terms_days,omitempty"`
+	Active           bool                    `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	Contacts         []*PartyContact         `protobuf:"bytes,10,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	Addresses        []*PartyAddress         `protobuf:"bytes,11,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	TaxRegistrations []*PartyTaxRegistration `protobuf:"bytes,12,rep,name=tax_registrations,json=taxRegistrations,proto3" json:"tax_registrations,omitempty"`
+	BankAccounts     []*PartyBankAccount     `protobuf:"bytes,13,rep,name=bank_accounts,json=bankAccounts,proto3" json:"bank_accounts,omitempty"`
+	Audit            *AuditFields            `protobuf:"bytes,14,opt,name=audit,proto3" json:"audit,omitempty"`
+	// Credit control, for customers. Without a credit limit only a credit
+	// hold stops invoices from being issued to the party.
+	CreditLimit      *money.Money `protobuf:"bytes,15,opt,name=credit_limit,json=creditLimit,proto3" json:"credit_limit,omitempty"` // in default_currency
+	CreditHold       bool         `protobuf:"varint,16,opt,name=credit_hold,json=creditHold,proto3" json:"credit_hold,omitempty"`
+	CreditHoldReason string       `protobuf:"bytes,17,opt,name=credit_hold_reason,json=creditHoldReason,proto3" json:"credit_hold_reason,omitempty"`
+	PeppolEndpoint   string       `protobuf:"bytes,18,opt,name=peppol_endpoint,json=peppolEndpoint,proto3" json:"peppol_endpoint,omitempty"` // "<ISO 6523 scheme>:<id>", for e-invoices
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListBankAccountsResponse) Reset() {
-	*x = ListBankAccountsResponse{}
-	mi := &file_finance_proto_msgTypes[40]
+func (x *Party) Reset() {
+	*x = Party{}
+	mi := &file_finance_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBankAccountsResponse) String() string {
+func (x *Party) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBankAccountsResponse) ProtoMessage() {}
+func (*Party) ProtoMessage() {}
 
-func (x *ListBankAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[40]
+func (x *Party) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3246,165 +3880,160 @@ func (x *ListBankAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBankAccountsResponse.ProtoReflect.Descriptor instead.
-func (*ListBankAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use Party.ProtoReflect.Descriptor instead.
+func (*Party) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ListBankAccountsResponse) GetAccounts() []*BankAccount {
+func (x *Party) GetId() string {
 	if x != nil {
-		return x.Accounts
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListBankAccountsResponse) GetPage() *PageResponse {
+func (x *Party) GetOrganizationId() string {
 	if x != nil {
-		return x.Page
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-type BankTransaction struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // statement line id
-	BankAccountId        string                 `protobuf:"bytes,2,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
-	Amount               *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	TransactionDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
-	Description          string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Reference            string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"` // UTR/Cheque/etc.
-	Reconciled           bool                   `protobuf:"varint,7,opt,name=reconciled,proto3" json:"reconciled,omitempty"`
-	MatchedReferenceType string                 `protobuf:"bytes,8,opt,name=matched_reference_type,json=matchedReferenceType,proto3" json:"matched_reference_type,omitempty"` // "INVOICE","PAYMENT","JOURNAL"
-	MatchedReferenceId   string                 `protobuf:"bytes,9,opt,name=matched_reference_id,json=matchedReferenceId,proto3" json:"matched_reference_id,omitempty"`
-	Audit                *AuditFields           `protobuf:"bytes,10,opt,name=audit,proto3" json:"audit,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *Party) GetKind() PartyKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PartyKind_PARTY_KIND_UNSPECIFIED
 }
 
-func (x *BankTransaction) Reset() {
-	*x = BankTransaction{}
-	mi := &file_finance_proto_msgTypes[41]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Party) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *BankTransaction) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Party) GetLegalName() string {
+	if x != nil {
+		return x.LegalName
+	}
+	return ""
 }
 
-func (*BankTransaction) ProtoMessage() {}
+func (x *Party) GetPan() string {
+	if x != nil {
+		return x.Pan
+	}
+	return ""
+}
 
-func (x *BankTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[41]
+func (x *Party) GetDefaultCurrency() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DefaultCurrency
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use BankTransaction.ProtoReflect.Descriptor instead.
-func (*BankTransaction) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{41}
+func (x *Party) GetPaymentTermsDays() int32 {
+	if x != nil {
+		return x.PaymentTermsDays
+	}
+	return 0
 }
 
-func (x *BankTransaction) GetId() string {
+func (x *Party) GetActive() bool {
 	if x != nil {
-		return x.Id
+		return x.Active
 	}
-	return ""
+	return false
 }
 
-func (x *BankTransaction) GetBankAccountId() string {
+func (x *Party) GetContacts() []*PartyContact {
 	if x != nil {
-		return x.BankAccountId
+		return x.Contacts
 	}
-	return ""
+	return nil
 }
 
-func (x *BankTransaction) GetAmount() *money.Money {
+func (x *Party) GetAddresses() []*PartyAddress {
 	if x != nil {
-		return x.Amount
+		return x.Addresses
 	}
 	return nil
 }
 
-func (x *BankTransaction) GetTransactionDate() *timestamppb.Timestamp {
+func (x *Party) GetTaxRegistrations() []*PartyTaxRegistration {
 	if x != nil {
-		return x.TransactionDate
+		return x.TaxRegistrations
 	}
 	return nil
 }
 
-func (x *BankTransaction) GetDescription() string {
+func (x *Party) GetBankAccounts() []*PartyBankAccount {
 	if x != nil {
-		return x.Description
+		return x.BankAccounts
 	}
-	return ""
+	return nil
 }
 
-func (x *BankTransaction) GetReference() string {
+func (x *Party) GetAudit() *AuditFields {
 	if x != nil {
-		return x.Reference
+		return x.Audit
 	}
-	return ""
+	return nil
 }
 
-func (x *BankTransaction) GetReconciled() bool {
+func (x *Party) GetCreditLimit() *money.Money {
 	if x != nil {
-		return x.Reconciled
+		return x.CreditLimit
 	}
-	return false
+	return nil
 }
 
-func (x *BankTransaction) GetMatchedReferenceType() string {
+func (x *Party) GetCreditHold() bool {
 	if x != nil {
-		return x.MatchedReferenceType
+		return x.CreditHold
 	}
-	return ""
+	return false
 }
 
-func (x *BankTransaction) GetMatchedReferenceId() string {
+func (x *Party) GetCreditHoldReason() string {
 	if x != nil {
-		return x.MatchedReferenceId
+		return x.CreditHoldReason
 	}
 	return ""
 }
 
-func (x *BankTransaction) GetAudit() *AuditFields {
+func (x *Party) GetPeppolEndpoint() string {
 	if x != nil {
-		return x.Audit
+		return x.PeppolEndpoint
 	}
-	return nil
+	return ""
 }
 
-type ImportBankTransactionsRequest struct {
+type CreatePartyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	BankAccountId string                 `protobuf:"bytes,2,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
-	Transactions  []*BankTransaction     `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"` // from CSV/OFX adapter
+	Party         *Party                 `protobuf:"bytes,2,opt,name=party,proto3" json:"party,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportBankTransactionsRequest) Reset() {
-	*x = ImportBankTransactionsRequest{}
-	mi := &file_finance_proto_msgTypes[42]
+func (x *CreatePartyRequest) Reset() {
+	*x = CreatePartyRequest{}
+	mi := &file_finance_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportBankTransactionsRequest) String() string {
+func (x *CreatePartyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportBankTransactionsRequest) ProtoMessage() {}
+func (*CreatePartyRequest) ProtoMessage() {}
 
-func (x *ImportBankTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[42]
+func (x *CreatePartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3415,55 +4044,48 @@ func (x *ImportBankTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportBankTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*ImportBankTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use CreatePartyRequest.ProtoReflect.Descriptor instead.
+func (*CreatePartyRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ImportBankTransactionsRequest) GetMeta() *RequestMetadata {
+func (x *CreatePartyRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ImportBankTransactionsRequest) GetBankAccountId() string {
-	if x != nil {
-		return x.BankAccountId
-	}
-	return ""
-}
-
-func (x *ImportBankTransactionsRequest) GetTransactions() []*BankTransaction {
+func (x *CreatePartyRequest) GetParty() *Party {
 	if x != nil {
-		return x.Transactions
+		return x.Party
 	}
 	return nil
 }
 
-type ImportBankTransactionsResponse struct {
+type GetPartyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Imported      int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
-	Skipped       int32                  `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportBankTransactionsResponse) Reset() {
-	*x = ImportBankTransactionsResponse{}
-	mi := &file_finance_proto_msgTypes[43]
+func (x *GetPartyRequest) Reset() {
+	*x = GetPartyRequest{}
+	mi := &file_finance_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportBankTransactionsResponse) String() string {
+func (x *GetPartyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportBankTransactionsResponse) ProtoMessage() {}
+func (*GetPartyRequest) ProtoMessage() {}
 
-func (x *ImportBankTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[43]
+func (x *GetPartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3474,48 +4096,49 @@ func (x *ImportBankTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportBankTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*ImportBankTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetPartyRequest.ProtoReflect.Descriptor instead.
+func (*GetPartyRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ImportBankTransactionsResponse) GetImported() int32 {
+func (x *GetPartyRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Imported
+		return x.Meta
 	}
-	return 0
+	return nil
 }
 
-func (x *ImportBankTransactionsResponse) GetSkipped() int32 {
+func (x *GetPartyRequest) GetId() string {
 	if x != nil {
-		return x.Skipped
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type ListBankTransactionsRequest struct {
+type UpdatePartyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BankAccountId string                 `protobuf:"bytes,1,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
-	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Party         *Party                 `protobuf:"bytes,2,opt,name=party,proto3" json:"party,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBankTransactionsRequest) Reset() {
-	*x = ListBankTransactionsRequest{}
-	mi := &file_finance_proto_msgTypes[44]
+func (x *UpdatePartyRequest) Reset() {
+	*x = UpdatePartyRequest{}
+	mi := &file_finance_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBankTransactionsRequest) String() string {
+func (x *UpdatePartyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBankTransactionsRequest) ProtoMessage() {}
+func (*UpdatePartyRequest) ProtoMessage() {}
 
-func (x *ListBankTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[44]
+func (x *UpdatePartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3526,48 +4149,55 @@ func (x *ListBankTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBankTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListBankTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use UpdatePartyRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePartyRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListBankTransactionsRequest) GetBankAccountId() string {
+func (x *UpdatePartyRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.BankAccountId
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-func (x *ListBankTransactionsRequest) GetPage() *PageRequest {
+func (x *UpdatePartyRequest) GetParty() *Party {
 	if x != nil {
-		return x.Page
+		return x.Party
 	}
 	return nil
 }
 
-type ListBankTransactionsResponse struct {
+func (x *UpdatePartyRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeletePartyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*BankTransaction     `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBankTransactionsResponse) Reset() {
-	*x = ListBankTransactionsResponse{}
-	mi := &file_finance_proto_msgTypes[45]
+func (x *DeletePartyRequest) Reset() {
+	*x = DeletePartyRequest{}
+	mi := &file_finance_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBankTransactionsResponse) String() string {
+func (x *DeletePartyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBankTransactionsResponse) ProtoMessage() {}
+func (*DeletePartyRequest) ProtoMessage() {}
 
-func (x *ListBankTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[45]
+func (x *DeletePartyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3578,51 +4208,50 @@ func (x *ListBankTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBankTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListBankTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use DeletePartyRequest.ProtoReflect.Descriptor instead.
+func (*DeletePartyRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ListBankTransactionsResponse) GetTransactions() []*BankTransaction {
+func (x *DeletePartyRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Transactions
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListBankTransactionsResponse) GetPage() *PageResponse {
+func (x *DeletePartyRequest) GetId() string {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ReconcileTransactionRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Meta              *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	BankTransactionId string                 `protobuf:"bytes,2,opt,name=bank_transaction_id,json=bankTransactionId,proto3" json:"bank_transaction_id,omitempty"`
-	LedgerEntryId     string                 `protobuf:"bytes,3,opt,name=ledger_entry_id,json=ledgerEntryId,proto3" json:"ledger_entry_id,omitempty"` // or invoice/payment/journal id
-	Amount            *money.Money           `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	TransactionDate   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ListPartiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`                         // organization_id is required
+	Kind          PartyKind              `protobuf:"varint,2,opt,name=kind,proto3,enum=finance.PartyKind" json:"kind,omitempty"` // CUSTOMER and VENDOR include BOTH
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`                       // name, legal name or tax registration number
+	Page          *PageRequest           `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReconcileTransactionRequest) Reset() {
-	*x = ReconcileTransactionRequest{}
-	mi := &file_finance_proto_msgTypes[46]
+func (x *ListPartiesRequest) Reset() {
+	*x = ListPartiesRequest{}
+	mi := &file_finance_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReconcileTransactionRequest) String() string {
+func (x *ListPartiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconcileTransactionRequest) ProtoMessage() {}
+func (*ListPartiesRequest) ProtoMessage() {}
 
-func (x *ReconcileTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[46]
+func (x *ListPartiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3633,70 +4262,62 @@ func (x *ReconcileTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconcileTransactionRequest.ProtoReflect.Descriptor instead.
-func (*ReconcileTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ListPartiesRequest.ProtoReflect.Descriptor instead.
+func (*ListPartiesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ReconcileTransactionRequest) GetMeta() *RequestMetadata {
+func (x *ListPartiesRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *ReconcileTransactionRequest) GetBankTransactionId() string {
+func (x *ListPartiesRequest) GetKind() PartyKind {
 	if x != nil {
-		return x.BankTransactionId
+		return x.Kind
 	}
-	return ""
+	return PartyKind_PARTY_KIND_UNSPECIFIED
 }
 
-func (x *ReconcileTransactionRequest) GetLedgerEntryId() string {
+func (x *ListPartiesRequest) GetQuery() string {
 	if x != nil {
-		return x.LedgerEntryId
+		return x.Query
 	}
 	return ""
 }
 
-func (x *ReconcileTransactionRequest) GetAmount() *money.Money {
-	if x != nil {
-		return x.Amount
-	}
-	return nil
-}
-
-func (x *ReconcileTransactionRequest) GetTransactionDate() *timestamppb.Timestamp {
+func (x *ListPartiesRequest) GetPage() *PageRequest {
 	if x != nil {
-		return x.TransactionDate
+		return x.Page
 	}
 	return nil
 }
 
-type Reconciliation struct {
+type ListPartiesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Matched       bool                   `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "MATCHED", "PARTIAL_MATCH", "UNMATCHED"
-	MatchReason   string                 `protobuf:"bytes,3,opt,name=match_reason,json=matchReason,proto3" json:"match_reason,omitempty"`
+	Parties       []*Party               `protobuf:"bytes,1,rep,name=parties,proto3" json:"parties,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Reconciliation) Reset() {
-	*x = Reconciliation{}
-	mi := &file_finance_proto_msgTypes[47]
+func (x *ListPartiesResponse) Reset() {
+	*x = ListPartiesResponse{}
+	mi := &file_finance_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Reconciliation) String() string {
+func (x *ListPartiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reconciliation) ProtoMessage() {}
+func (*ListPartiesResponse) ProtoMessage() {}
 
-func (x *Reconciliation) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[47]
+func (x *ListPartiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3707,62 +4328,50 @@ func (x *Reconciliation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reconciliation.ProtoReflect.Descriptor instead.
-func (*Reconciliation) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *Reconciliation) GetMatched() bool {
-	if x != nil {
-		return x.Matched
-	}
-	return false
+// Deprecated: Use ListPartiesResponse.ProtoReflect.Descriptor instead.
+func (*ListPartiesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Reconciliation) GetStatus() string {
+func (x *ListPartiesResponse) GetParties() []*Party {
 	if x != nil {
-		return x.Status
+		return x.Parties
 	}
-	return ""
+	return nil
 }
 
-func (x *Reconciliation) GetMatchReason() string {
+func (x *ListPartiesResponse) GetPage() *PageResponse {
 	if x != nil {
-		return x.MatchReason
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-// Chart of Accounts
-type Account struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code               string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // unique short code
-	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type               AccountType            `protobuf:"varint,4,opt,name=type,proto3,enum=finance.AccountType" json:"type,omitempty"`
-	ParentId           string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // for hierarchy
-	Status             AccountStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=finance.AccountStatus" json:"status,omitempty"`
-	AllowManualJournal bool                   `protobuf:"varint,7,opt,name=allow_manual_journal,json=allowManualJournal,proto3" json:"allow_manual_journal,omitempty"`
-	Audit              *AuditFields           `protobuf:"bytes,8,opt,name=audit,proto3" json:"audit,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type CreditPolicy struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId   string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ThresholdPercent string                 `protobuf:"bytes,2,opt,name=threshold_percent,json=thresholdPercent,proto3" json:"threshold_percent,omitempty"` // of the credit limit, e.g. "90"; defaults to 100
+	Action           CreditAction           `protobuf:"varint,3,opt,name=action,proto3,enum=finance.CreditAction" json:"action,omitempty"`                  // defaults to BLOCK
+	Audit            *AuditFields           `protobuf:"bytes,4,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Account) Reset() {
-	*x = Account{}
-	mi := &file_finance_proto_msgTypes[48]
+func (x *CreditPolicy) Reset() {
+	*x = CreditPolicy{}
+	mi := &file_finance_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Account) String() string {
+func (x *CreditPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account) ProtoMessage() {}
+func (*CreditPolicy) ProtoMessage() {}
 
-func (x *Account) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[48]
+func (x *CreditPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3773,141 +4382,169 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account.ProtoReflect.Descriptor instead.
-func (*Account) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use CreditPolicy.ProtoReflect.Descriptor instead.
+func (*CreditPolicy) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *Account) GetId() string {
+func (x *CreditPolicy) GetOrganizationId() string {
 	if x != nil {
-		return x.Id
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *Account) GetCode() string {
+func (x *CreditPolicy) GetThresholdPercent() string {
 	if x != nil {
-		return x.Code
+		return x.ThresholdPercent
 	}
 	return ""
 }
 
-func (x *Account) GetName() string {
+func (x *CreditPolicy) GetAction() CreditAction {
 	if x != nil {
-		return x.Name
+		return x.Action
 	}
-	return ""
+	return CreditAction_CREDIT_ACTION_UNSPECIFIED
 }
 
-func (x *Account) GetType() AccountType {
+func (x *CreditPolicy) GetAudit() *AuditFields {
 	if x != nil {
-		return x.Type
+		return x.Audit
 	}
-	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *Account) GetParentId() string {
+type CreditExposure struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PartyId          string                 `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	CreditLimit      *money.Money           `protobuf:"bytes,2,opt,name=credit_limit,json=creditLimit,proto3" json:"credit_limit,omitempty"` // unset without a limit
+	CreditHold       bool                   `protobuf:"varint,3,opt,name=credit_hold,json=creditHold,proto3" json:"credit_hold,omitempty"`
+	OpenDues         *money.Money           `protobuf:"bytes,4,opt,name=open_dues,json=openDues,proto3" json:"open_dues,omitempty"`
+	DraftInvoices    *money.Money           `protobuf:"bytes,5,opt,name=draft_invoices,json=draftInvoices,proto3" json:"draft_invoices,omitempty"`
+	UnappliedCredits *money.Money           `protobuf:"bytes,6,opt,name=unapplied_credits,json=unappliedCredits,proto3" json:"unapplied_credits,omitempty"`
+	Exposure         *money.Money           `protobuf:"bytes,7,opt,name=exposure,proto3" json:"exposure,omitempty"`   // open_dues + draft_invoices - unapplied_credits
+	Threshold        *money.Money           `protobuf:"bytes,8,opt,name=threshold,proto3" json:"threshold,omitempty"` // credit_limit scaled by the policy's threshold_percent
+	Breached         bool                   `protobuf:"varint,9,opt,name=breached,proto3" json:"breached,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreditExposure) Reset() {
+	*x = CreditExposure{}
+	mi := &file_finance_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditExposure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditExposure) ProtoMessage() {}
+
+func (x *CreditExposure) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[47]
 	if x != nil {
-		return x.ParentId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Account) GetStatus() AccountStatus {
+// Deprecated: Use CreditExposure.ProtoReflect.Descriptor instead.
+func (*CreditExposure) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreditExposure) GetPartyId() string {
 	if x != nil {
-		return x.Status
+		return x.PartyId
 	}
-	return AccountStatus_ACCOUNT_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *Account) GetAllowManualJournal() bool {
+func (x *CreditExposure) GetCreditLimit() *money.Money {
 	if x != nil {
-		return x.AllowManualJournal
+		return x.CreditLimit
 	}
-	return false
+	return nil
 }
 
-func (x *Account) GetAudit() *AuditFields {
+func (x *CreditExposure) GetCreditHold() bool {
 	if x != nil {
-		return x.Audit
+		return x.CreditHold
 	}
-	return nil
-}
-
-type CreateAccountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Account       *Account               `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return false
 }
 
-func (x *CreateAccountRequest) Reset() {
-	*x = CreateAccountRequest{}
-	mi := &file_finance_proto_msgTypes[49]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreditExposure) GetOpenDues() *money.Money {
+	if x != nil {
+		return x.OpenDues
+	}
+	return nil
 }
 
-func (x *CreateAccountRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreditExposure) GetDraftInvoices() *money.Money {
+	if x != nil {
+		return x.DraftInvoices
+	}
+	return nil
 }
 
-func (*CreateAccountRequest) ProtoMessage() {}
-
-func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[49]
+func (x *CreditExposure) GetUnappliedCredits() *money.Money {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UnappliedCredits
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
-func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{49}
+func (x *CreditExposure) GetExposure() *money.Money {
+	if x != nil {
+		return x.Exposure
+	}
+	return nil
 }
 
-func (x *CreateAccountRequest) GetMeta() *RequestMetadata {
+func (x *CreditExposure) GetThreshold() *money.Money {
 	if x != nil {
-		return x.Meta
+		return x.Threshold
 	}
 	return nil
 }
 
-func (x *CreateAccountRequest) GetAccount() *Account {
+func (x *CreditExposure) GetBreached() bool {
 	if x != nil {
-		return x.Account
+		return x.Breached
 	}
-	return nil
+	return false
 }
 
-type GetAccountRequest struct {
+type GetCreditPolicyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAccountRequest) Reset() {
-	*x = GetAccountRequest{}
-	mi := &file_finance_proto_msgTypes[50]
+func (x *GetCreditPolicyRequest) Reset() {
+	*x = GetCreditPolicyRequest{}
+	mi := &file_finance_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAccountRequest) String() string {
+func (x *GetCreditPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccountRequest) ProtoMessage() {}
+func (*GetCreditPolicyRequest) ProtoMessage() {}
 
-func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[50]
+func (x *GetCreditPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3918,42 +4555,41 @@ func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
-func (*GetAccountRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use GetCreditPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetAccountRequest) GetId() string {
+func (x *GetCreditPolicyRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Id
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-type UpdateAccountRequest struct {
+type SetCreditPolicyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Account       *Account               `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Policy        *CreditPolicy          `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAccountRequest) Reset() {
-	*x = UpdateAccountRequest{}
-	mi := &file_finance_proto_msgTypes[51]
+func (x *SetCreditPolicyRequest) Reset() {
+	*x = SetCreditPolicyRequest{}
+	mi := &file_finance_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAccountRequest) String() string {
+func (x *SetCreditPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAccountRequest) ProtoMessage() {}
+func (*SetCreditPolicyRequest) ProtoMessage() {}
 
-func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[51]
+func (x *SetCreditPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3964,55 +4600,48 @@ func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use SetCreditPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetCreditPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *UpdateAccountRequest) GetMeta() *RequestMetadata {
+func (x *SetCreditPolicyRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *UpdateAccountRequest) GetAccount() *Account {
-	if x != nil {
-		return x.Account
-	}
-	return nil
-}
-
-func (x *UpdateAccountRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *SetCreditPolicyRequest) GetPolicy() *CreditPolicy {
 	if x != nil {
-		return x.UpdateMask
+		return x.Policy
 	}
 	return nil
 }
 
-type DeleteAccountRequest struct {
+type GetCreditExposureRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	PartyId       string                 `protobuf:"bytes,2,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAccountRequest) Reset() {
-	*x = DeleteAccountRequest{}
-	mi := &file_finance_proto_msgTypes[52]
+func (x *GetCreditExposureRequest) Reset() {
+	*x = GetCreditExposureRequest{}
+	mi := &file_finance_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAccountRequest) String() string {
+func (x *GetCreditExposureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountRequest) ProtoMessage() {}
+func (*GetCreditExposureRequest) ProtoMessage() {}
 
-func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[52]
+func (x *GetCreditExposureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4023,47 +4652,48 @@ func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GetCreditExposureRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditExposureRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DeleteAccountRequest) GetMeta() *RequestMetadata {
+func (x *GetCreditExposureRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *DeleteAccountRequest) GetId() string {
+func (x *GetCreditExposureRequest) GetPartyId() string {
 	if x != nil {
-		return x.Id
+		return x.PartyId
 	}
 	return ""
 }
 
-type ListAccountsRequest struct {
+type ApproveInvoiceCreditRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	InvoiceId     string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAccountsRequest) Reset() {
-	*x = ListAccountsRequest{}
-	mi := &file_finance_proto_msgTypes[53]
+func (x *ApproveInvoiceCreditRequest) Reset() {
+	*x = ApproveInvoiceCreditRequest{}
+	mi := &file_finance_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAccountsRequest) String() string {
+func (x *ApproveInvoiceCreditRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAccountsRequest) ProtoMessage() {}
+func (*ApproveInvoiceCreditRequest) ProtoMessage() {}
 
-func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[53]
+func (x *ApproveInvoiceCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4074,41 +4704,51 @@ func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
-func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use ApproveInvoiceCreditRequest.ProtoReflect.Descriptor instead.
+func (*ApproveInvoiceCreditRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ListAccountsRequest) GetPage() *PageRequest {
+func (x *ApproveInvoiceCreditRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Page
+		return x.Meta
 	}
 	return nil
 }
 
-type ListAccountsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ApproveInvoiceCreditRequest) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
 }
 
-func (x *ListAccountsResponse) Reset() {
-	*x = ListAccountsResponse{}
-	mi := &file_finance_proto_msgTypes[54]
+// A reminder stage: a reminder goes out days_from_due days after an open
+// invoice's due date, or before it when negative.
+type DunningStage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DaysFromDue     int32                  `protobuf:"varint,1,opt,name=days_from_due,json=daysFromDue,proto3" json:"days_from_due,omitempty"`
+	EscalationLevel int32                  `protobuf:"varint,2,opt,name=escalation_level,json=escalationLevel,proto3" json:"escalation_level,omitempty"` // never lower than an earlier stage's
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DunningStage) Reset() {
+	*x = DunningStage{}
+	mi := &file_finance_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAccountsResponse) String() string {
+func (x *DunningStage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAccountsResponse) ProtoMessage() {}
+func (*DunningStage) ProtoMessage() {}
 
-func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[54]
+func (x *DunningStage) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4119,52 +4759,55 @@ func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
-func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use DunningStage.ProtoReflect.Descriptor instead.
+func (*DunningStage) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListAccountsResponse) GetAccounts() []*Account {
+func (x *DunningStage) GetDaysFromDue() int32 {
 	if x != nil {
-		return x.Accounts
+		return x.DaysFromDue
 	}
-	return nil
+	return 0
 }
 
-func (x *ListAccountsResponse) GetPage() *PageResponse {
+func (x *DunningStage) GetEscalationLevel() int32 {
 	if x != nil {
-		return x.Page
+		return x.EscalationLevel
 	}
-	return nil
+	return 0
 }
 
-// Double-entry Journal (preferred write path)
-type JournalLine struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Side          LedgerSide             `protobuf:"varint,2,opt,name=side,proto3,enum=finance.LedgerSide" json:"side,omitempty"` // DR/CR
-	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	CostCenterId  string                 `protobuf:"bytes,4,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DunningStage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *JournalLine) Reset() {
-	*x = JournalLine{}
-	mi := &file_finance_proto_msgTypes[55]
+type DunningSchedule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Stages         []*DunningStage        `protobuf:"bytes,2,rep,name=stages,proto3" json:"stages,omitempty"` // ordered by days_from_due
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DunningSchedule) Reset() {
+	*x = DunningSchedule{}
+	mi := &file_finance_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JournalLine) String() string {
+func (x *DunningSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JournalLine) ProtoMessage() {}
+func (*DunningSchedule) ProtoMessage() {}
 
-func (x *JournalLine) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[55]
+func (x *DunningSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4175,75 +4818,55 @@ func (x *JournalLine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JournalLine.ProtoReflect.Descriptor instead.
-func (*JournalLine) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use DunningSchedule.ProtoReflect.Descriptor instead.
+func (*DunningSchedule) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *JournalLine) GetAccountId() string {
+func (x *DunningSchedule) GetOrganizationId() string {
 	if x != nil {
-		return x.AccountId
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *JournalLine) GetSide() LedgerSide {
-	if x != nil {
-		return x.Side
-	}
-	return LedgerSide_LEDGER_SIDE_UNSPECIFIED
-}
-
-func (x *JournalLine) GetAmount() *money.Money {
+func (x *DunningSchedule) GetStages() []*DunningStage {
 	if x != nil {
-		return x.Amount
+		return x.Stages
 	}
 	return nil
 }
 
-func (x *JournalLine) GetCostCenterId() string {
-	if x != nil {
-		return x.CostCenterId
-	}
-	return ""
-}
-
-func (x *JournalLine) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-type JournalEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	JournalDate   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=journal_date,json=journalDate,proto3" json:"journal_date,omitempty"`
-	Reference     string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"` // external ref/UTR/DocNo
-	Memo          string                 `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
-	Lines         []*JournalLine         `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`                             // MUST balance (sum DR == sum CR)
-	SourceType    string                 `protobuf:"bytes,6,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"` // INVOICE/PAYMENT/ADJUSTMENT
-	SourceId      string                 `protobuf:"bytes,7,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
-	Audit         *AuditFields           `protobuf:"bytes,8,opt,name=audit,proto3" json:"audit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// No reminders go out for a held invoice, or for any invoice of a held
+// party. Exactly one of invoice_id and party_id is set.
+type DunningHold struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	InvoiceId      string                 `protobuf:"bytes,3,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"` // required
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	PartyId        string                 `protobuf:"bytes,8,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *JournalEntry) Reset() {
-	*x = JournalEntry{}
-	mi := &file_finance_proto_msgTypes[56]
+func (x *DunningHold) Reset() {
+	*x = DunningHold{}
+	mi := &file_finance_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JournalEntry) String() string {
+func (x *DunningHold) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JournalEntry) ProtoMessage() {}
+func (*DunningHold) ProtoMessage() {}
 
-func (x *JournalEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[56]
+func (x *DunningHold) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4254,90 +4877,167 @@ func (x *JournalEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JournalEntry.ProtoReflect.Descriptor instead.
-func (*JournalEntry) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use DunningHold.ProtoReflect.Descriptor instead.
+func (*DunningHold) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *JournalEntry) GetId() string {
+func (x *DunningHold) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *JournalEntry) GetJournalDate() *timestamppb.Timestamp {
+func (x *DunningHold) GetOrganizationId() string {
 	if x != nil {
-		return x.JournalDate
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-func (x *JournalEntry) GetReference() string {
+func (x *DunningHold) GetInvoiceId() string {
 	if x != nil {
-		return x.Reference
+		return x.InvoiceId
 	}
 	return ""
 }
 
-func (x *JournalEntry) GetMemo() string {
+func (x *DunningHold) GetReason() string {
 	if x != nil {
-		return x.Memo
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *JournalEntry) GetLines() []*JournalLine {
+func (x *DunningHold) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Lines
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *JournalEntry) GetSourceType() string {
+func (x *DunningHold) GetCreatedBy() string {
 	if x != nil {
-		return x.SourceType
+		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *JournalEntry) GetSourceId() string {
+func (x *DunningHold) GetPartyId() string {
 	if x != nil {
-		return x.SourceId
+		return x.PartyId
 	}
 	return ""
 }
 
-func (x *JournalEntry) GetAudit() *AuditFields {
+type DunningReminder struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId       string                 `protobuf:"bytes,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	InvoiceNumber   string                 `protobuf:"bytes,2,opt,name=invoice_number,json=invoiceNumber,proto3" json:"invoice_number,omitempty"`
+	DaysFromDue     int32                  `protobuf:"varint,4,opt,name=days_from_due,json=daysFromDue,proto3" json:"days_from_due,omitempty"`
+	EscalationLevel int32                  `protobuf:"varint,5,opt,name=escalation_level,json=escalationLevel,proto3" json:"escalation_level,omitempty"`
+	Stage           string                 `protobuf:"bytes,6,opt,name=stage,proto3" json:"stage,omitempty"`
+	PartyId         string                 `protobuf:"bytes,7,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DunningReminder) Reset() {
+	*x = DunningReminder{}
+	mi := &file_finance_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DunningReminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DunningReminder) ProtoMessage() {}
+
+func (x *DunningReminder) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[55]
 	if x != nil {
-		return x.Audit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type CreateJournalEntryRequest struct {
+// Deprecated: Use DunningReminder.ProtoReflect.Descriptor instead.
+func (*DunningReminder) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DunningReminder) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *DunningReminder) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *DunningReminder) GetDaysFromDue() int32 {
+	if x != nil {
+		return x.DaysFromDue
+	}
+	return 0
+}
+
+func (x *DunningReminder) GetEscalationLevel() int32 {
+	if x != nil {
+		return x.EscalationLevel
+	}
+	return 0
+}
+
+func (x *DunningReminder) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *DunningReminder) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
+}
+
+type SetDunningScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Entry         *JournalEntry          `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	Schedule      *DunningSchedule       `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateJournalEntryRequest) Reset() {
-	*x = CreateJournalEntryRequest{}
-	mi := &file_finance_proto_msgTypes[57]
+func (x *SetDunningScheduleRequest) Reset() {
+	*x = SetDunningScheduleRequest{}
+	mi := &file_finance_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateJournalEntryRequest) String() string {
+func (x *SetDunningScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJournalEntryRequest) ProtoMessage() {}
+func (*SetDunningScheduleRequest) ProtoMessage() {}
 
-func (x *CreateJournalEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[57]
+func (x *SetDunningScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4348,46 +5048,91 @@ func (x *CreateJournalEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJournalEntryRequest.ProtoReflect.Descriptor instead.
-func (*CreateJournalEntryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use SetDunningScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetDunningScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateJournalEntryRequest) GetMeta() *RequestMetadata {
+func (x *SetDunningScheduleRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *CreateJournalEntryRequest) GetEntry() *JournalEntry {
+func (x *SetDunningScheduleRequest) GetSchedule() *DunningSchedule {
 	if x != nil {
-		return x.Entry
+		return x.Schedule
 	}
 	return nil
 }
 
-type GetJournalEntryRequest struct {
+type GetDunningScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetJournalEntryRequest) Reset() {
-	*x = GetJournalEntryRequest{}
+func (x *GetDunningScheduleRequest) Reset() {
+	*x = GetDunningScheduleRequest{}
+	mi := &file_finance_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDunningScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDunningScheduleRequest) ProtoMessage() {}
+
+func (x *GetDunningScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDunningScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetDunningScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetDunningScheduleRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type PlaceDunningHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Hold          *DunningHold           `protobuf:"bytes,2,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceDunningHoldRequest) Reset() {
+	*x = PlaceDunningHoldRequest{}
 	mi := &file_finance_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetJournalEntryRequest) String() string {
+func (x *PlaceDunningHoldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJournalEntryRequest) ProtoMessage() {}
+func (*PlaceDunningHoldRequest) ProtoMessage() {}
 
-func (x *GetJournalEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *PlaceDunningHoldRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4399,41 +5144,48 @@ func (x *GetJournalEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJournalEntryRequest.ProtoReflect.Descriptor instead.
-func (*GetJournalEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlaceDunningHoldRequest.ProtoReflect.Descriptor instead.
+func (*PlaceDunningHoldRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetJournalEntryRequest) GetId() string {
+func (x *PlaceDunningHoldRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Id
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-type UpdateJournalEntryRequest struct {
+func (x *PlaceDunningHoldRequest) GetHold() *DunningHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type ReleaseDunningHoldRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Entry         *JournalEntry          `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	InvoiceId     string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"` // exactly one of invoice_id and party_id
+	PartyId       string                 `protobuf:"bytes,4,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateJournalEntryRequest) Reset() {
-	*x = UpdateJournalEntryRequest{}
+func (x *ReleaseDunningHoldRequest) Reset() {
+	*x = ReleaseDunningHoldRequest{}
 	mi := &file_finance_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateJournalEntryRequest) String() string {
+func (x *ReleaseDunningHoldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJournalEntryRequest) ProtoMessage() {}
+func (*ReleaseDunningHoldRequest) ProtoMessage() {}
 
-func (x *UpdateJournalEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *ReleaseDunningHoldRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4445,54 +5197,53 @@ func (x *UpdateJournalEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJournalEntryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateJournalEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleaseDunningHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseDunningHoldRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *UpdateJournalEntryRequest) GetMeta() *RequestMetadata {
+func (x *ReleaseDunningHoldRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *UpdateJournalEntryRequest) GetEntry() *JournalEntry {
+func (x *ReleaseDunningHoldRequest) GetInvoiceId() string {
 	if x != nil {
-		return x.Entry
+		return x.InvoiceId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateJournalEntryRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *ReleaseDunningHoldRequest) GetPartyId() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.PartyId
 	}
-	return nil
+	return ""
 }
 
-type DeleteJournalEntryRequest struct {
+type ListDunningHoldsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteJournalEntryRequest) Reset() {
-	*x = DeleteJournalEntryRequest{}
+func (x *ListDunningHoldsRequest) Reset() {
+	*x = ListDunningHoldsRequest{}
 	mi := &file_finance_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteJournalEntryRequest) String() string {
+func (x *ListDunningHoldsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJournalEntryRequest) ProtoMessage() {}
+func (*ListDunningHoldsRequest) ProtoMessage() {}
 
-func (x *DeleteJournalEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDunningHoldsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4504,46 +5255,39 @@ func (x *DeleteJournalEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJournalEntryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteJournalEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDunningHoldsRequest.ProtoReflect.Descriptor instead.
+func (*ListDunningHoldsRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *DeleteJournalEntryRequest) GetMeta() *RequestMetadata {
+func (x *ListDunningHoldsRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *DeleteJournalEntryRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-type ListJournalEntriesRequest struct {
+type ListDunningHoldsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Holds         []*DunningHold         `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListJournalEntriesRequest) Reset() {
-	*x = ListJournalEntriesRequest{}
+func (x *ListDunningHoldsResponse) Reset() {
+	*x = ListDunningHoldsResponse{}
 	mi := &file_finance_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJournalEntriesRequest) String() string {
+func (x *ListDunningHoldsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJournalEntriesRequest) ProtoMessage() {}
+func (*ListDunningHoldsResponse) ProtoMessage() {}
 
-func (x *ListJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDunningHoldsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4555,40 +5299,40 @@ func (x *ListJournalEntriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJournalEntriesRequest.ProtoReflect.Descriptor instead.
-func (*ListJournalEntriesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDunningHoldsResponse.ProtoReflect.Descriptor instead.
+func (*ListDunningHoldsResponse) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ListJournalEntriesRequest) GetPage() *PageRequest {
+func (x *ListDunningHoldsResponse) GetHolds() []*DunningHold {
 	if x != nil {
-		return x.Page
+		return x.Holds
 	}
 	return nil
 }
 
-type ListJournalEntriesResponse struct {
+type RunDunningRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entries       []*JournalEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // defaults to now
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListJournalEntriesResponse) Reset() {
-	*x = ListJournalEntriesResponse{}
+func (x *RunDunningRequest) Reset() {
+	*x = RunDunningRequest{}
 	mi := &file_finance_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJournalEntriesResponse) String() string {
+func (x *RunDunningRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJournalEntriesResponse) ProtoMessage() {}
+func (*RunDunningRequest) ProtoMessage() {}
 
-func (x *ListJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+func (x *RunDunningRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4600,56 +5344,49 @@ func (x *ListJournalEntriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJournalEntriesResponse.ProtoReflect.Descriptor instead.
-func (*ListJournalEntriesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunDunningRequest.ProtoReflect.Descriptor instead.
+func (*RunDunningRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ListJournalEntriesResponse) GetEntries() []*JournalEntry {
+func (x *RunDunningRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Entries
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListJournalEntriesResponse) GetPage() *PageResponse {
+func (x *RunDunningRequest) GetAsOf() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Page
+		return x.AsOf
 	}
 	return nil
 }
 
-// (Derived) Ledger view - append-only projection from Journal
-type LedgerEntry struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AccountId       string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Side            LedgerSide             `protobuf:"varint,4,opt,name=side,proto3,enum=finance.LedgerSide" json:"side,omitempty"`
-	Amount          *money.Money           `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	TransactionDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
-	CostCenterId    string                 `protobuf:"bytes,7,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
-	ReferenceType   string                 `protobuf:"bytes,8,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"` // "INVOICE"/"PAYMENT"/"JOURNAL"
-	ReferenceId     string                 `protobuf:"bytes,9,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	Audit           *AuditFields           `protobuf:"bytes,10,opt,name=audit,proto3" json:"audit,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type RunDunningResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	OverduePaymentDueIds []string               `protobuf:"bytes,1,rep,name=overdue_payment_due_ids,json=overduePaymentDueIds,proto3" json:"overdue_payment_due_ids,omitempty"`
+	OverdueInvoiceIds    []string               `protobuf:"bytes,2,rep,name=overdue_invoice_ids,json=overdueInvoiceIds,proto3" json:"overdue_invoice_ids,omitempty"`
+	Reminders            []*DunningReminder     `protobuf:"bytes,3,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	Failures             []string               `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures,omitempty"` // steps that failed; the rest of the run is kept
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *LedgerEntry) Reset() {
-	*x = LedgerEntry{}
+func (x *RunDunningResponse) Reset() {
+	*x = RunDunningResponse{}
 	mi := &file_finance_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LedgerEntry) String() string {
+func (x *RunDunningResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LedgerEntry) ProtoMessage() {}
+func (*RunDunningResponse) ProtoMessage() {}
 
-func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+func (x *RunDunningResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4661,147 +5398,207 @@ func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
-func (*LedgerEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunDunningResponse.ProtoReflect.Descriptor instead.
+func (*RunDunningResponse) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *LedgerEntry) GetId() string {
+func (x *RunDunningResponse) GetOverduePaymentDueIds() []string {
 	if x != nil {
-		return x.Id
+		return x.OverduePaymentDueIds
 	}
-	return ""
+	return nil
 }
 
-func (x *LedgerEntry) GetAccountId() string {
+func (x *RunDunningResponse) GetOverdueInvoiceIds() []string {
 	if x != nil {
-		return x.AccountId
+		return x.OverdueInvoiceIds
 	}
-	return ""
+	return nil
 }
 
-func (x *LedgerEntry) GetDescription() string {
+func (x *RunDunningResponse) GetReminders() []*DunningReminder {
 	if x != nil {
-		return x.Description
+		return x.Reminders
 	}
-	return ""
+	return nil
 }
 
-func (x *LedgerEntry) GetSide() LedgerSide {
+func (x *RunDunningResponse) GetFailures() []string {
 	if x != nil {
-		return x.Side
+		return x.Failures
 	}
-	return LedgerSide_LEDGER_SIDE_UNSPECIFIED
+	return nil
 }
 
-func (x *LedgerEntry) GetAmount() *money.Money {
-	if x != nil {
-		return x.Amount
-	}
-	return nil
+// Bills a copy of the template invoice (its party, items, taxes and
+// discounts) once per billing period, in advance, dated on the period's
+// first day. Periods run every 1, 3 or 12 months from billing_day; the first
+// starts on the latest billing_day on or before start_date. With prorate, a
+// first or last period cut short by start_date or end_date bills its lines
+// for the share of the period's days it covers.
+type RecurringInvoice struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId    string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // the template invoice's
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TemplateInvoiceId string                 `protobuf:"bytes,4,opt,name=template_invoice_id,json=templateInvoiceId,proto3" json:"template_invoice_id,omitempty"`
+	Frequency         TemplateFrequency      `protobuf:"varint,5,opt,name=frequency,proto3,enum=finance.TemplateFrequency" json:"frequency,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`           // optional
+	BillingDay        int32                  `protobuf:"varint,8,opt,name=billing_day,json=billingDay,proto3" json:"billing_day,omitempty"` // 1-28
+	Prorate           bool                   `protobuf:"varint,9,opt,name=prorate,proto3" json:"prorate,omitempty"`
+	AutoIssue         bool                   `protobuf:"varint,10,opt,name=auto_issue,json=autoIssue,proto3" json:"auto_issue,omitempty"` // needs a number series for the invoice type
+	Active            bool                   `protobuf:"varint,11,opt,name=active,proto3" json:"active,omitempty"`
+	BilledThrough     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=billed_through,json=billedThrough,proto3" json:"billed_through,omitempty"` // output only
+	Audit             *AuditFields           `protobuf:"bytes,13,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *LedgerEntry) GetTransactionDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.TransactionDate
-	}
-	return nil
+func (x *RecurringInvoice) Reset() {
+	*x = RecurringInvoice{}
+	mi := &file_finance_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *LedgerEntry) GetCostCenterId() string {
+func (x *RecurringInvoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringInvoice) ProtoMessage() {}
+
+func (x *RecurringInvoice) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[64]
 	if x != nil {
-		return x.CostCenterId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringInvoice.ProtoReflect.Descriptor instead.
+func (*RecurringInvoice) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RecurringInvoice) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *LedgerEntry) GetReferenceType() string {
+func (x *RecurringInvoice) GetOrganizationId() string {
 	if x != nil {
-		return x.ReferenceType
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *LedgerEntry) GetReferenceId() string {
+func (x *RecurringInvoice) GetName() string {
 	if x != nil {
-		return x.ReferenceId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *LedgerEntry) GetAudit() *AuditFields {
+func (x *RecurringInvoice) GetTemplateInvoiceId() string {
 	if x != nil {
-		return x.Audit
+		return x.TemplateInvoiceId
+	}
+	return ""
+}
+
+func (x *RecurringInvoice) GetFrequency() TemplateFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return TemplateFrequency_TEMPLATE_FREQUENCY_UNSPECIFIED
+}
+
+func (x *RecurringInvoice) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
 	}
 	return nil
 }
 
-type ListLedgerEntriesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RecurringInvoice) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
 }
 
-func (x *ListLedgerEntriesRequest) Reset() {
-	*x = ListLedgerEntriesRequest{}
-	mi := &file_finance_proto_msgTypes[64]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RecurringInvoice) GetBillingDay() int32 {
+	if x != nil {
+		return x.BillingDay
+	}
+	return 0
 }
 
-func (x *ListLedgerEntriesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RecurringInvoice) GetProrate() bool {
+	if x != nil {
+		return x.Prorate
+	}
+	return false
 }
 
-func (*ListLedgerEntriesRequest) ProtoMessage() {}
+func (x *RecurringInvoice) GetAutoIssue() bool {
+	if x != nil {
+		return x.AutoIssue
+	}
+	return false
+}
 
-func (x *ListLedgerEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[64]
+func (x *RecurringInvoice) GetActive() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Active
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ListLedgerEntriesRequest.ProtoReflect.Descriptor instead.
-func (*ListLedgerEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{64}
+func (x *RecurringInvoice) GetBilledThrough() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BilledThrough
+	}
+	return nil
 }
 
-func (x *ListLedgerEntriesRequest) GetPage() *PageRequest {
+func (x *RecurringInvoice) GetAudit() *AuditFields {
 	if x != nil {
-		return x.Page
+		return x.Audit
 	}
 	return nil
 }
 
-type ListLedgerEntriesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entries       []*LedgerEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateRecurringInvoiceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	RecurringInvoice *RecurringInvoice      `protobuf:"bytes,2,opt,name=recurring_invoice,json=recurringInvoice,proto3" json:"recurring_invoice,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListLedgerEntriesResponse) Reset() {
-	*x = ListLedgerEntriesResponse{}
+func (x *CreateRecurringInvoiceRequest) Reset() {
+	*x = CreateRecurringInvoiceRequest{}
 	mi := &file_finance_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLedgerEntriesResponse) String() string {
+func (x *CreateRecurringInvoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLedgerEntriesResponse) ProtoMessage() {}
+func (*CreateRecurringInvoiceRequest) ProtoMessage() {}
 
-func (x *ListLedgerEntriesResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateRecurringInvoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4813,50 +5610,47 @@ func (x *ListLedgerEntriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLedgerEntriesResponse.ProtoReflect.Descriptor instead.
-func (*ListLedgerEntriesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRecurringInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecurringInvoiceRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ListLedgerEntriesResponse) GetEntries() []*LedgerEntry {
+func (x *CreateRecurringInvoiceRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Entries
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListLedgerEntriesResponse) GetPage() *PageResponse {
+func (x *CreateRecurringInvoiceRequest) GetRecurringInvoice() *RecurringInvoice {
 	if x != nil {
-		return x.Page
+		return x.RecurringInvoice
 	}
 	return nil
 }
 
-type Budget struct {
+type GetRecurringInvoiceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	TotalAmount   *money.Money           `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // consider enum later (DRAFT/ACTIVE/CLOSED)
-	Audit         *AuditFields           `protobuf:"bytes,5,opt,name=audit,proto3" json:"audit,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Budget) Reset() {
-	*x = Budget{}
+func (x *GetRecurringInvoiceRequest) Reset() {
+	*x = GetRecurringInvoiceRequest{}
 	mi := &file_finance_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Budget) String() string {
+func (x *GetRecurringInvoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Budget) ProtoMessage() {}
+func (*GetRecurringInvoiceRequest) ProtoMessage() {}
 
-func (x *Budget) ProtoReflect() protoreflect.Message {
+func (x *GetRecurringInvoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4868,68 +5662,48 @@ func (x *Budget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
-func (*Budget) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRecurringInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetRecurringInvoiceRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Budget) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Budget) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Budget) GetTotalAmount() *money.Money {
+func (x *GetRecurringInvoiceRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.TotalAmount
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *Budget) GetStatus() string {
+func (x *GetRecurringInvoiceRequest) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Budget) GetAudit() *AuditFields {
-	if x != nil {
-		return x.Audit
-	}
-	return nil
-}
-
-type CreateBudgetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Budget        *Budget                `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateRecurringInvoiceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Meta             *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	RecurringInvoice *RecurringInvoice      `protobuf:"bytes,2,opt,name=recurring_invoice,json=recurringInvoice,proto3" json:"recurring_invoice,omitempty"`
+	UpdateMask       *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CreateBudgetRequest) Reset() {
-	*x = CreateBudgetRequest{}
+func (x *UpdateRecurringInvoiceRequest) Reset() {
+	*x = UpdateRecurringInvoiceRequest{}
 	mi := &file_finance_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBudgetRequest) String() string {
+func (x *UpdateRecurringInvoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBudgetRequest) ProtoMessage() {}
+func (*UpdateRecurringInvoiceRequest) ProtoMessage() {}
 
-func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateRecurringInvoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4941,46 +5715,54 @@ func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBudgetRequest.ProtoReflect.Descriptor instead.
-func (*CreateBudgetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateRecurringInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecurringInvoiceRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CreateBudgetRequest) GetMeta() *RequestMetadata {
+func (x *UpdateRecurringInvoiceRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *CreateBudgetRequest) GetBudget() *Budget {
+func (x *UpdateRecurringInvoiceRequest) GetRecurringInvoice() *RecurringInvoice {
 	if x != nil {
-		return x.Budget
+		return x.RecurringInvoice
 	}
 	return nil
 }
 
-type GetBudgetRequest struct {
+func (x *UpdateRecurringInvoiceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteRecurringInvoiceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBudgetRequest) Reset() {
-	*x = GetBudgetRequest{}
+func (x *DeleteRecurringInvoiceRequest) Reset() {
+	*x = DeleteRecurringInvoiceRequest{}
 	mi := &file_finance_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBudgetRequest) String() string {
+func (x *DeleteRecurringInvoiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBudgetRequest) ProtoMessage() {}
+func (*DeleteRecurringInvoiceRequest) ProtoMessage() {}
 
-func (x *GetBudgetRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteRecurringInvoiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4992,41 +5774,46 @@ func (x *GetBudgetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBudgetRequest.ProtoReflect.Descriptor instead.
-func (*GetBudgetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteRecurringInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecurringInvoiceRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetBudgetRequest) GetId() string {
+func (x *DeleteRecurringInvoiceRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DeleteRecurringInvoiceRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type UpdateBudgetRequest struct {
+type ListRecurringInvoicesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Budget        *Budget                `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBudgetRequest) Reset() {
-	*x = UpdateBudgetRequest{}
+func (x *ListRecurringInvoicesRequest) Reset() {
+	*x = ListRecurringInvoicesRequest{}
 	mi := &file_finance_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBudgetRequest) String() string {
+func (x *ListRecurringInvoicesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBudgetRequest) ProtoMessage() {}
+func (*ListRecurringInvoicesRequest) ProtoMessage() {}
 
-func (x *UpdateBudgetRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRecurringInvoicesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5038,54 +5825,40 @@ func (x *UpdateBudgetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBudgetRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBudgetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRecurringInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecurringInvoicesRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UpdateBudgetRequest) GetMeta() *RequestMetadata {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-func (x *UpdateBudgetRequest) GetBudget() *Budget {
-	if x != nil {
-		return x.Budget
-	}
-	return nil
-}
-
-func (x *UpdateBudgetRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *ListRecurringInvoicesRequest) GetPage() *PageRequest {
 	if x != nil {
-		return x.UpdateMask
+		return x.Page
 	}
 	return nil
 }
 
-type DeleteBudgetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListRecurringInvoicesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RecurringInvoices []*RecurringInvoice    `protobuf:"bytes,1,rep,name=recurring_invoices,json=recurringInvoices,proto3" json:"recurring_invoices,omitempty"`
+	Page              *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *DeleteBudgetRequest) Reset() {
-	*x = DeleteBudgetRequest{}
+func (x *ListRecurringInvoicesResponse) Reset() {
+	*x = ListRecurringInvoicesResponse{}
 	mi := &file_finance_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBudgetRequest) String() string {
+func (x *ListRecurringInvoicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBudgetRequest) ProtoMessage() {}
+func (*ListRecurringInvoicesResponse) ProtoMessage() {}
 
-func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRecurringInvoicesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5097,46 +5870,47 @@ func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBudgetRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRecurringInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurringInvoicesResponse) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *DeleteBudgetRequest) GetMeta() *RequestMetadata {
+func (x *ListRecurringInvoicesResponse) GetRecurringInvoices() []*RecurringInvoice {
 	if x != nil {
-		return x.Meta
+		return x.RecurringInvoices
 	}
 	return nil
 }
 
-func (x *DeleteBudgetRequest) GetId() string {
+func (x *ListRecurringInvoicesResponse) GetPage() *PageResponse {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-type ListBudgetsRequest struct {
+type GenerateRecurringInvoicesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // defaults to now
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBudgetsRequest) Reset() {
-	*x = ListBudgetsRequest{}
+func (x *GenerateRecurringInvoicesRequest) Reset() {
+	*x = GenerateRecurringInvoicesRequest{}
 	mi := &file_finance_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBudgetsRequest) String() string {
+func (x *GenerateRecurringInvoicesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBudgetsRequest) ProtoMessage() {}
+func (*GenerateRecurringInvoicesRequest) ProtoMessage() {}
 
-func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
+func (x *GenerateRecurringInvoicesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5148,40 +5922,49 @@ func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBudgetsRequest.ProtoReflect.Descriptor instead.
-func (*ListBudgetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateRecurringInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRecurringInvoicesRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ListBudgetsRequest) GetPage() *PageRequest {
+func (x *GenerateRecurringInvoicesRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Page
+		return x.Meta
 	}
 	return nil
 }
 
-type ListBudgetsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GenerateRecurringInvoicesRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
 }
 
-func (x *ListBudgetsResponse) Reset() {
-	*x = ListBudgetsResponse{}
+type GeneratedInvoice struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RecurringInvoiceId string                 `protobuf:"bytes,1,opt,name=recurring_invoice_id,json=recurringInvoiceId,proto3" json:"recurring_invoice_id,omitempty"`
+	PeriodStart        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // the days billed, both inclusive
+	PeriodEnd          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	Invoice            *Invoice               `protobuf:"bytes,4,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GeneratedInvoice) Reset() {
+	*x = GeneratedInvoice{}
 	mi := &file_finance_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBudgetsResponse) String() string {
+func (x *GeneratedInvoice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBudgetsResponse) ProtoMessage() {}
+func (*GeneratedInvoice) ProtoMessage() {}
 
-func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
+func (x *GeneratedInvoice) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5193,52 +5976,61 @@ func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBudgetsResponse.ProtoReflect.Descriptor instead.
-func (*ListBudgetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GeneratedInvoice.ProtoReflect.Descriptor instead.
+func (*GeneratedInvoice) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListBudgetsResponse) GetBudgets() []*Budget {
+func (x *GeneratedInvoice) GetRecurringInvoiceId() string {
 	if x != nil {
-		return x.Budgets
+		return x.RecurringInvoiceId
+	}
+	return ""
+}
+
+func (x *GeneratedInvoice) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
 	}
 	return nil
 }
 
-func (x *ListBudgetsResponse) GetPage() *PageResponse {
+func (x *GeneratedInvoice) GetPeriodEnd() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Page
+		return x.PeriodEnd
 	}
 	return nil
 }
 
-type BudgetAllocation struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	BudgetId        string                 `protobuf:"bytes,2,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
-	DepartmentId    string                 `protobuf:"bytes,3,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
-	AllocatedAmount *money.Money           `protobuf:"bytes,4,opt,name=allocated_amount,json=allocatedAmount,proto3" json:"allocated_amount,omitempty"`
-	SpentAmount     *money.Money           `protobuf:"bytes,5,opt,name=spent_amount,json=spentAmount,proto3" json:"spent_amount,omitempty"`
-	RemainingAmount *money.Money           `protobuf:"bytes,6,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
-	Audit           *AuditFields           `protobuf:"bytes,7,opt,name=audit,proto3" json:"audit,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GeneratedInvoice) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
 }
 
-func (x *BudgetAllocation) Reset() {
-	*x = BudgetAllocation{}
+type GenerateRecurringInvoicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoices      []*GeneratedInvoice    `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	Failures      []string               `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"` // recurring invoices stopped at a period that could not be billed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRecurringInvoicesResponse) Reset() {
+	*x = GenerateRecurringInvoicesResponse{}
 	mi := &file_finance_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BudgetAllocation) String() string {
+func (x *GenerateRecurringInvoicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BudgetAllocation) ProtoMessage() {}
+func (*GenerateRecurringInvoicesResponse) ProtoMessage() {}
 
-func (x *BudgetAllocation) ProtoReflect() protoreflect.Message {
+func (x *GenerateRecurringInvoicesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5250,83 +6042,159 @@ func (x *BudgetAllocation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BudgetAllocation.ProtoReflect.Descriptor instead.
-func (*BudgetAllocation) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateRecurringInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*GenerateRecurringInvoicesResponse) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *BudgetAllocation) GetId() string {
+func (x *GenerateRecurringInvoicesResponse) GetInvoices() []*GeneratedInvoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+func (x *GenerateRecurringInvoicesResponse) GetFailures() []string {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type CreditDebitNote struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InvoiceId string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Type      NoteType               `protobuf:"varint,3,opt,name=type,proto3,enum=finance.NoteType" json:"type,omitempty"`
+	Amount    *money.Money           `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason    string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Audit     *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
+	// How the note was applied to its invoice, output only: the journal it
+	// posted, the due a debit note raised and when. Notes created before notes
+	// were applied have none of these.
+	JournalId     string                 `protobuf:"bytes,7,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	PaymentDueId  string                 `protobuf:"bytes,8,opt,name=payment_due_id,json=paymentDueId,proto3" json:"payment_due_id,omitempty"`
+	AppliedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditDebitNote) Reset() {
+	*x = CreditDebitNote{}
+	mi := &file_finance_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditDebitNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditDebitNote) ProtoMessage() {}
+
+func (x *CreditDebitNote) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditDebitNote.ProtoReflect.Descriptor instead.
+func (*CreditDebitNote) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreditDebitNote) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *BudgetAllocation) GetBudgetId() string {
+func (x *CreditDebitNote) GetInvoiceId() string {
 	if x != nil {
-		return x.BudgetId
+		return x.InvoiceId
 	}
 	return ""
 }
 
-func (x *BudgetAllocation) GetDepartmentId() string {
+func (x *CreditDebitNote) GetType() NoteType {
 	if x != nil {
-		return x.DepartmentId
+		return x.Type
 	}
-	return ""
+	return NoteType_NOTE_TYPE_UNSPECIFIED
 }
 
-func (x *BudgetAllocation) GetAllocatedAmount() *money.Money {
+func (x *CreditDebitNote) GetAmount() *money.Money {
 	if x != nil {
-		return x.AllocatedAmount
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *BudgetAllocation) GetSpentAmount() *money.Money {
+func (x *CreditDebitNote) GetReason() string {
 	if x != nil {
-		return x.SpentAmount
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *BudgetAllocation) GetRemainingAmount() *money.Money {
+func (x *CreditDebitNote) GetAudit() *AuditFields {
 	if x != nil {
-		return x.RemainingAmount
+		return x.Audit
 	}
 	return nil
 }
 
-func (x *BudgetAllocation) GetAudit() *AuditFields {
+func (x *CreditDebitNote) GetJournalId() string {
 	if x != nil {
-		return x.Audit
+		return x.JournalId
+	}
+	return ""
+}
+
+func (x *CreditDebitNote) GetPaymentDueId() string {
+	if x != nil {
+		return x.PaymentDueId
+	}
+	return ""
+}
+
+func (x *CreditDebitNote) GetAppliedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedAt
 	}
 	return nil
 }
 
-type AllocateBudgetRequest struct {
+type CreateCreditDebitNoteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Allocation    *BudgetAllocation      `protobuf:"bytes,2,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	Note          *CreditDebitNote       `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AllocateBudgetRequest) Reset() {
-	*x = AllocateBudgetRequest{}
-	mi := &file_finance_proto_msgTypes[74]
+func (x *CreateCreditDebitNoteRequest) Reset() {
+	*x = CreateCreditDebitNoteRequest{}
+	mi := &file_finance_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllocateBudgetRequest) String() string {
+func (x *CreateCreditDebitNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllocateBudgetRequest) ProtoMessage() {}
+func (*CreateCreditDebitNoteRequest) ProtoMessage() {}
 
-func (x *AllocateBudgetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[74]
+func (x *CreateCreditDebitNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5337,47 +6205,47 @@ func (x *AllocateBudgetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllocateBudgetRequest.ProtoReflect.Descriptor instead.
-func (*AllocateBudgetRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use CreateCreditDebitNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateCreditDebitNoteRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *AllocateBudgetRequest) GetMeta() *RequestMetadata {
+func (x *CreateCreditDebitNoteRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *AllocateBudgetRequest) GetAllocation() *BudgetAllocation {
+func (x *CreateCreditDebitNoteRequest) GetNote() *CreditDebitNote {
 	if x != nil {
-		return x.Allocation
+		return x.Note
 	}
 	return nil
 }
 
-type GetBudgetAllocationRequest struct {
+type GetCreditDebitNoteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBudgetAllocationRequest) Reset() {
-	*x = GetBudgetAllocationRequest{}
-	mi := &file_finance_proto_msgTypes[75]
+func (x *GetCreditDebitNoteRequest) Reset() {
+	*x = GetCreditDebitNoteRequest{}
+	mi := &file_finance_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBudgetAllocationRequest) String() string {
+func (x *GetCreditDebitNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBudgetAllocationRequest) ProtoMessage() {}
+func (*GetCreditDebitNoteRequest) ProtoMessage() {}
 
-func (x *GetBudgetAllocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[75]
+func (x *GetCreditDebitNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5388,42 +6256,42 @@ func (x *GetBudgetAllocationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBudgetAllocationRequest.ProtoReflect.Descriptor instead.
-func (*GetBudgetAllocationRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use GetCreditDebitNoteRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditDebitNoteRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetBudgetAllocationRequest) GetId() string {
+func (x *GetCreditDebitNoteRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type UpdateBudgetAllocationRequest struct {
+type UpdateCreditDebitNoteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Allocation    *BudgetAllocation      `protobuf:"bytes,2,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	Note          *CreditDebitNote       `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
 	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBudgetAllocationRequest) Reset() {
-	*x = UpdateBudgetAllocationRequest{}
-	mi := &file_finance_proto_msgTypes[76]
+func (x *UpdateCreditDebitNoteRequest) Reset() {
+	*x = UpdateCreditDebitNoteRequest{}
+	mi := &file_finance_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBudgetAllocationRequest) String() string {
+func (x *UpdateCreditDebitNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBudgetAllocationRequest) ProtoMessage() {}
+func (*UpdateCreditDebitNoteRequest) ProtoMessage() {}
 
-func (x *UpdateBudgetAllocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[76]
+func (x *UpdateCreditDebitNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5434,33 +6302,33 @@ func (x *UpdateBudgetAllocationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBudgetAllocationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBudgetAllocationRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use UpdateCreditDebitNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCreditDebitNoteRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *UpdateBudgetAllocationRequest) GetMeta() *RequestMetadata {
+func (x *UpdateCreditDebitNoteRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *UpdateBudgetAllocationRequest) GetAllocation() *BudgetAllocation {
+func (x *UpdateCreditDebitNoteRequest) GetNote() *CreditDebitNote {
 	if x != nil {
-		return x.Allocation
+		return x.Note
 	}
 	return nil
 }
 
-func (x *UpdateBudgetAllocationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *UpdateCreditDebitNoteRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.UpdateMask
 	}
 	return nil
 }
 
-type DeleteBudgetAllocationRequest struct {
+type DeleteCreditDebitNoteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -5468,21 +6336,21 @@ type DeleteBudgetAllocationRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBudgetAllocationRequest) Reset() {
-	*x = DeleteBudgetAllocationRequest{}
-	mi := &file_finance_proto_msgTypes[77]
+func (x *DeleteCreditDebitNoteRequest) Reset() {
+	*x = DeleteCreditDebitNoteRequest{}
+	mi := &file_finance_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBudgetAllocationRequest) String() string {
+func (x *DeleteCreditDebitNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBudgetAllocationRequest) ProtoMessage() {}
+func (*DeleteCreditDebitNoteRequest) ProtoMessage() {}
 
-func (x *DeleteBudgetAllocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[77]
+func (x *DeleteCreditDebitNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5493,47 +6361,47 @@ func (x *DeleteBudgetAllocationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBudgetAllocationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBudgetAllocationRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use DeleteCreditDebitNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCreditDebitNoteRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *DeleteBudgetAllocationRequest) GetMeta() *RequestMetadata {
+func (x *DeleteCreditDebitNoteRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *DeleteBudgetAllocationRequest) GetId() string {
+func (x *DeleteCreditDebitNoteRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListBudgetAllocationsRequest struct {
+type ListCreditDebitNotesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBudgetAllocationsRequest) Reset() {
-	*x = ListBudgetAllocationsRequest{}
-	mi := &file_finance_proto_msgTypes[78]
+func (x *ListCreditDebitNotesRequest) Reset() {
+	*x = ListCreditDebitNotesRequest{}
+	mi := &file_finance_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBudgetAllocationsRequest) String() string {
+func (x *ListCreditDebitNotesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBudgetAllocationsRequest) ProtoMessage() {}
+func (*ListCreditDebitNotesRequest) ProtoMessage() {}
 
-func (x *ListBudgetAllocationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[78]
+func (x *ListCreditDebitNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5544,41 +6412,41 @@ func (x *ListBudgetAllocationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBudgetAllocationsRequest.ProtoReflect.Descriptor instead.
-func (*ListBudgetAllocationsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use ListCreditDebitNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListCreditDebitNotesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ListBudgetAllocationsRequest) GetPage() *PageRequest {
+func (x *ListCreditDebitNotesRequest) GetPage() *PageRequest {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type ListBudgetAllocationsResponse struct {
+type ListCreditDebitNotesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Allocations   []*BudgetAllocation    `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	Notes         []*CreditDebitNote     `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
 	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBudgetAllocationsResponse) Reset() {
-	*x = ListBudgetAllocationsResponse{}
-	mi := &file_finance_proto_msgTypes[79]
+func (x *ListCreditDebitNotesResponse) Reset() {
+	*x = ListCreditDebitNotesResponse{}
+	mi := &file_finance_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBudgetAllocationsResponse) String() string {
+func (x *ListCreditDebitNotesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBudgetAllocationsResponse) ProtoMessage() {}
+func (*ListCreditDebitNotesResponse) ProtoMessage() {}
 
-func (x *ListBudgetAllocationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[79]
+func (x *ListCreditDebitNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5589,47 +6457,52 @@ func (x *ListBudgetAllocationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBudgetAllocationsResponse.ProtoReflect.Descriptor instead.
-func (*ListBudgetAllocationsResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use ListCreditDebitNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListCreditDebitNotesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *ListBudgetAllocationsResponse) GetAllocations() []*BudgetAllocation {
+func (x *ListCreditDebitNotesResponse) GetNotes() []*CreditDebitNote {
 	if x != nil {
-		return x.Allocations
+		return x.Notes
 	}
 	return nil
 }
 
-func (x *ListBudgetAllocationsResponse) GetPage() *PageResponse {
+func (x *ListCreditDebitNotesResponse) GetPage() *PageResponse {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type BudgetComparisonRequest struct {
+type PaymentDue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BudgetId      string                 `protobuf:"bytes,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InvoiceId     string                 `protobuf:"bytes,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	AmountDue     *money.Money           `protobuf:"bytes,3,opt,name=amount_due,json=amountDue,proto3" json:"amount_due,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Status        PaymentStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=finance.PaymentStatus" json:"status,omitempty"`
+	Audit         *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BudgetComparisonRequest) Reset() {
-	*x = BudgetComparisonRequest{}
-	mi := &file_finance_proto_msgTypes[80]
+func (x *PaymentDue) Reset() {
+	*x = PaymentDue{}
+	mi := &file_finance_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BudgetComparisonRequest) String() string {
+func (x *PaymentDue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BudgetComparisonRequest) ProtoMessage() {}
+func (*PaymentDue) ProtoMessage() {}
 
-func (x *BudgetComparisonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[80]
+func (x *PaymentDue) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5640,120 +6513,75 @@ func (x *BudgetComparisonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BudgetComparisonRequest.ProtoReflect.Descriptor instead.
-func (*BudgetComparisonRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use PaymentDue.ProtoReflect.Descriptor instead.
+func (*PaymentDue) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *BudgetComparisonRequest) GetBudgetId() string {
+func (x *PaymentDue) GetId() string {
 	if x != nil {
-		return x.BudgetId
+		return x.Id
 	}
 	return ""
 }
 
-type BudgetComparisonResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	BudgetId        string                 `protobuf:"bytes,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
-	TotalBudget     *money.Money           `protobuf:"bytes,2,opt,name=total_budget,json=totalBudget,proto3" json:"total_budget,omitempty"`
-	TotalAllocated  *money.Money           `protobuf:"bytes,3,opt,name=total_allocated,json=totalAllocated,proto3" json:"total_allocated,omitempty"`
-	TotalSpent      *money.Money           `protobuf:"bytes,4,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
-	RemainingBudget *money.Money           `protobuf:"bytes,5,opt,name=remaining_budget,json=remainingBudget,proto3" json:"remaining_budget,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *BudgetComparisonResponse) Reset() {
-	*x = BudgetComparisonResponse{}
-	mi := &file_finance_proto_msgTypes[81]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *BudgetComparisonResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BudgetComparisonResponse) ProtoMessage() {}
-
-func (x *BudgetComparisonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[81]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BudgetComparisonResponse.ProtoReflect.Descriptor instead.
-func (*BudgetComparisonResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{81}
-}
-
-func (x *BudgetComparisonResponse) GetBudgetId() string {
+func (x *PaymentDue) GetInvoiceId() string {
 	if x != nil {
-		return x.BudgetId
+		return x.InvoiceId
 	}
 	return ""
 }
 
-func (x *BudgetComparisonResponse) GetTotalBudget() *money.Money {
+func (x *PaymentDue) GetAmountDue() *money.Money {
 	if x != nil {
-		return x.TotalBudget
+		return x.AmountDue
 	}
 	return nil
 }
 
-func (x *BudgetComparisonResponse) GetTotalAllocated() *money.Money {
+func (x *PaymentDue) GetDueDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TotalAllocated
+		return x.DueDate
 	}
 	return nil
 }
 
-func (x *BudgetComparisonResponse) GetTotalSpent() *money.Money {
+func (x *PaymentDue) GetStatus() PaymentStatus {
 	if x != nil {
-		return x.TotalSpent
+		return x.Status
 	}
-	return nil
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
 }
 
-func (x *BudgetComparisonResponse) GetRemainingBudget() *money.Money {
+func (x *PaymentDue) GetAudit() *AuditFields {
 	if x != nil {
-		return x.RemainingBudget
+		return x.Audit
 	}
 	return nil
 }
 
-type ExpenseRate struct {
+type CreatePaymentDueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // "LABOR","MATERIAL","OPEX","CAPEX"...
-	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	ExpenseDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"`
-	CostCenterId  string                 `protobuf:"bytes,5,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
-	Audit         *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Due           *PaymentDue            `protobuf:"bytes,2,opt,name=due,proto3" json:"due,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExpenseRate) Reset() {
-	*x = ExpenseRate{}
+func (x *CreatePaymentDueRequest) Reset() {
+	*x = CreatePaymentDueRequest{}
 	mi := &file_finance_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExpenseRate) String() string {
+func (x *CreatePaymentDueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpenseRate) ProtoMessage() {}
+func (*CreatePaymentDueRequest) ProtoMessage() {}
 
-func (x *ExpenseRate) ProtoReflect() protoreflect.Message {
+func (x *CreatePaymentDueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5765,75 +6593,46 @@ func (x *ExpenseRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpenseRate.ProtoReflect.Descriptor instead.
-func (*ExpenseRate) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreatePaymentDueRequest.ProtoReflect.Descriptor instead.
+func (*CreatePaymentDueRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *ExpenseRate) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ExpenseRate) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (x *ExpenseRate) GetAmount() *money.Money {
-	if x != nil {
-		return x.Amount
-	}
-	return nil
-}
-
-func (x *ExpenseRate) GetExpenseDate() *timestamppb.Timestamp {
+func (x *CreatePaymentDueRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.ExpenseDate
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *ExpenseRate) GetCostCenterId() string {
-	if x != nil {
-		return x.CostCenterId
-	}
-	return ""
-}
-
-func (x *ExpenseRate) GetAudit() *AuditFields {
+func (x *CreatePaymentDueRequest) GetDue() *PaymentDue {
 	if x != nil {
-		return x.Audit
+		return x.Due
 	}
 	return nil
 }
 
-type CreateExpenseRateRequest struct {
+type GetPaymentDueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ExpenseRate   *ExpenseRate           `protobuf:"bytes,2,opt,name=expense_rate,json=expenseRate,proto3" json:"expense_rate,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateExpenseRateRequest) Reset() {
-	*x = CreateExpenseRateRequest{}
+func (x *GetPaymentDueRequest) Reset() {
+	*x = GetPaymentDueRequest{}
 	mi := &file_finance_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateExpenseRateRequest) String() string {
+func (x *GetPaymentDueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExpenseRateRequest) ProtoMessage() {}
+func (*GetPaymentDueRequest) ProtoMessage() {}
 
-func (x *CreateExpenseRateRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPaymentDueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5845,46 +6644,41 @@ func (x *CreateExpenseRateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExpenseRateRequest.ProtoReflect.Descriptor instead.
-func (*CreateExpenseRateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPaymentDueRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentDueRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *CreateExpenseRateRequest) GetMeta() *RequestMetadata {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
-
-func (x *CreateExpenseRateRequest) GetExpenseRate() *ExpenseRate {
+func (x *GetPaymentDueRequest) GetId() string {
 	if x != nil {
-		return x.ExpenseRate
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetExpenseRateRequest struct {
+type UpdatePaymentDueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Due           *PaymentDue            `protobuf:"bytes,2,opt,name=due,proto3" json:"due,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExpenseRateRequest) Reset() {
-	*x = GetExpenseRateRequest{}
+func (x *UpdatePaymentDueRequest) Reset() {
+	*x = UpdatePaymentDueRequest{}
 	mi := &file_finance_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExpenseRateRequest) String() string {
+func (x *UpdatePaymentDueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExpenseRateRequest) ProtoMessage() {}
+func (*UpdatePaymentDueRequest) ProtoMessage() {}
 
-func (x *GetExpenseRateRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdatePaymentDueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5896,41 +6690,54 @@ func (x *GetExpenseRateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExpenseRateRequest.ProtoReflect.Descriptor instead.
-func (*GetExpenseRateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdatePaymentDueRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePaymentDueRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetExpenseRateRequest) GetId() string {
+func (x *UpdatePaymentDueRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Id
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-type UpdateExpenseRateRequest struct {
+func (x *UpdatePaymentDueRequest) GetDue() *PaymentDue {
+	if x != nil {
+		return x.Due
+	}
+	return nil
+}
+
+func (x *UpdatePaymentDueRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeletePaymentDueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	ExpenseRate   *ExpenseRate           `protobuf:"bytes,2,opt,name=expense_rate,json=expenseRate,proto3" json:"expense_rate,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExpenseRateRequest) Reset() {
-	*x = UpdateExpenseRateRequest{}
+func (x *DeletePaymentDueRequest) Reset() {
+	*x = DeletePaymentDueRequest{}
 	mi := &file_finance_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExpenseRateRequest) String() string {
+func (x *DeletePaymentDueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExpenseRateRequest) ProtoMessage() {}
+func (*DeletePaymentDueRequest) ProtoMessage() {}
 
-func (x *UpdateExpenseRateRequest) ProtoReflect() protoreflect.Message {
+func (x *DeletePaymentDueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5942,54 +6749,50 @@ func (x *UpdateExpenseRateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExpenseRateRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExpenseRateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeletePaymentDueRequest.ProtoReflect.Descriptor instead.
+func (*DeletePaymentDueRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *UpdateExpenseRateRequest) GetMeta() *RequestMetadata {
+func (x *DeletePaymentDueRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *UpdateExpenseRateRequest) GetExpenseRate() *ExpenseRate {
-	if x != nil {
-		return x.ExpenseRate
-	}
-	return nil
-}
-
-func (x *UpdateExpenseRateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *DeletePaymentDueRequest) GetId() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type DeleteExpenseRateRequest struct {
+type MarkPaymentAsPaidRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                   // PaymentDue id
+	AmountPaid    *money.Money           `protobuf:"bytes,3,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"` // partial or full
+	PaidAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	Reference     string                 `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"` // bank txn / UTR / cheque
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExpenseRateRequest) Reset() {
-	*x = DeleteExpenseRateRequest{}
+func (x *MarkPaymentAsPaidRequest) Reset() {
+	*x = MarkPaymentAsPaidRequest{}
 	mi := &file_finance_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExpenseRateRequest) String() string {
+func (x *MarkPaymentAsPaidRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExpenseRateRequest) ProtoMessage() {}
+func (*MarkPaymentAsPaidRequest) ProtoMessage() {}
 
-func (x *DeleteExpenseRateRequest) ProtoReflect() protoreflect.Message {
+func (x *MarkPaymentAsPaidRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6001,46 +6804,67 @@ func (x *DeleteExpenseRateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExpenseRateRequest.ProtoReflect.Descriptor instead.
-func (*DeleteExpenseRateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarkPaymentAsPaidRequest.ProtoReflect.Descriptor instead.
+func (*MarkPaymentAsPaidRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *DeleteExpenseRateRequest) GetMeta() *RequestMetadata {
+func (x *MarkPaymentAsPaidRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *DeleteExpenseRateRequest) GetId() string {
+func (x *MarkPaymentAsPaidRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListExpensesRateRequest struct {
+func (x *MarkPaymentAsPaidRequest) GetAmountPaid() *money.Money {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return nil
+}
+
+func (x *MarkPaymentAsPaidRequest) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *MarkPaymentAsPaidRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type ListPaymentDuesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExpensesRateRequest) Reset() {
-	*x = ListExpensesRateRequest{}
+func (x *ListPaymentDuesRequest) Reset() {
+	*x = ListPaymentDuesRequest{}
 	mi := &file_finance_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExpensesRateRequest) String() string {
+func (x *ListPaymentDuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExpensesRateRequest) ProtoMessage() {}
+func (*ListPaymentDuesRequest) ProtoMessage() {}
 
-func (x *ListExpensesRateRequest) ProtoReflect() protoreflect.Message {
+func (x *ListPaymentDuesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6052,40 +6876,40 @@ func (x *ListExpensesRateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExpensesRateRequest.ProtoReflect.Descriptor instead.
-func (*ListExpensesRateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPaymentDuesRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentDuesRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *ListExpensesRateRequest) GetPage() *PageRequest {
+func (x *ListPaymentDuesRequest) GetPage() *PageRequest {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type ListExpensesRateResponse struct {
+type ListPaymentDuesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExpenseRate   []*ExpenseRate         `protobuf:"bytes,1,rep,name=expense_rate,json=expenseRate,proto3" json:"expense_rate,omitempty"`
+	Dues          []*PaymentDue          `protobuf:"bytes,1,rep,name=dues,proto3" json:"dues,omitempty"`
 	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExpensesRateResponse) Reset() {
-	*x = ListExpensesRateResponse{}
+func (x *ListPaymentDuesResponse) Reset() {
+	*x = ListPaymentDuesResponse{}
 	mi := &file_finance_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExpensesRateResponse) String() string {
+func (x *ListPaymentDuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExpensesRateResponse) ProtoMessage() {}
+func (*ListPaymentDuesResponse) ProtoMessage() {}
 
-func (x *ListExpensesRateResponse) ProtoReflect() protoreflect.Message {
+func (x *ListPaymentDuesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6097,49 +6921,51 @@ func (x *ListExpensesRateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExpensesRateResponse.ProtoReflect.Descriptor instead.
-func (*ListExpensesRateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPaymentDuesResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentDuesResponse) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *ListExpensesRateResponse) GetExpenseRate() []*ExpenseRate {
+func (x *ListPaymentDuesResponse) GetDues() []*PaymentDue {
 	if x != nil {
-		return x.ExpenseRate
+		return x.Dues
 	}
 	return nil
 }
 
-func (x *ListExpensesRateResponse) GetPage() *PageResponse {
+func (x *ListPaymentDuesResponse) GetPage() *PageResponse {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type CostCenter struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Audit         *AuditFields           `protobuf:"bytes,4,opt,name=audit,proto3" json:"audit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BankAccount struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AccountNumber   string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	IfscOrSwift     string                 `protobuf:"bytes,4,opt,name=ifsc_or_swift,json=ifscOrSwift,proto3" json:"ifsc_or_swift,omitempty"`
+	LedgerAccountId string                 `protobuf:"bytes,5,opt,name=ledger_account_id,json=ledgerAccountId,proto3" json:"ledger_account_id,omitempty"` // link to COA account
+	Audit           *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CostCenter) Reset() {
-	*x = CostCenter{}
+func (x *BankAccount) Reset() {
+	*x = BankAccount{}
 	mi := &file_finance_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CostCenter) String() string {
+func (x *BankAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CostCenter) ProtoMessage() {}
+func (*BankAccount) ProtoMessage() {}
 
-func (x *CostCenter) ProtoReflect() protoreflect.Message {
+func (x *BankAccount) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6151,61 +6977,75 @@ func (x *CostCenter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CostCenter.ProtoReflect.Descriptor instead.
-func (*CostCenter) Descriptor() ([]byte, []int) {
+// Deprecated: Use BankAccount.ProtoReflect.Descriptor instead.
+func (*BankAccount) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *CostCenter) GetId() string {
+func (x *BankAccount) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CostCenter) GetName() string {
+func (x *BankAccount) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CostCenter) GetDescription() string {
+func (x *BankAccount) GetAccountNumber() string {
 	if x != nil {
-		return x.Description
+		return x.AccountNumber
 	}
 	return ""
 }
 
-func (x *CostCenter) GetAudit() *AuditFields {
+func (x *BankAccount) GetIfscOrSwift() string {
+	if x != nil {
+		return x.IfscOrSwift
+	}
+	return ""
+}
+
+func (x *BankAccount) GetLedgerAccountId() string {
+	if x != nil {
+		return x.LedgerAccountId
+	}
+	return ""
+}
+
+func (x *BankAccount) GetAudit() *AuditFields {
 	if x != nil {
 		return x.Audit
 	}
 	return nil
 }
 
-type CreateCostCenterRequest struct {
+type CreateBankAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Center        *CostCenter            `protobuf:"bytes,2,opt,name=center,proto3" json:"center,omitempty"`
+	Account       *BankAccount           `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCostCenterRequest) Reset() {
-	*x = CreateCostCenterRequest{}
+func (x *CreateBankAccountRequest) Reset() {
+	*x = CreateBankAccountRequest{}
 	mi := &file_finance_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCostCenterRequest) String() string {
+func (x *CreateBankAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCostCenterRequest) ProtoMessage() {}
+func (*CreateBankAccountRequest) ProtoMessage() {}
 
-func (x *CreateCostCenterRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateBankAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6217,46 +7057,46 @@ func (x *CreateCostCenterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCostCenterRequest.ProtoReflect.Descriptor instead.
-func (*CreateCostCenterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateBankAccountRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *CreateCostCenterRequest) GetMeta() *RequestMetadata {
+func (x *CreateBankAccountRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *CreateCostCenterRequest) GetCenter() *CostCenter {
+func (x *CreateBankAccountRequest) GetAccount() *BankAccount {
 	if x != nil {
-		return x.Center
+		return x.Account
 	}
 	return nil
 }
 
-type GetCostCenterRequest struct {
+type GetBankAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCostCenterRequest) Reset() {
-	*x = GetCostCenterRequest{}
+func (x *GetBankAccountRequest) Reset() {
+	*x = GetBankAccountRequest{}
 	mi := &file_finance_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCostCenterRequest) String() string {
+func (x *GetBankAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCostCenterRequest) ProtoMessage() {}
+func (*GetBankAccountRequest) ProtoMessage() {}
 
-func (x *GetCostCenterRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBankAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6268,41 +7108,41 @@ func (x *GetCostCenterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCostCenterRequest.ProtoReflect.Descriptor instead.
-func (*GetCostCenterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetBankAccountRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *GetCostCenterRequest) GetId() string {
+func (x *GetBankAccountRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type UpdateCostCenterRequest struct {
+type UpdateBankAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Center        *CostCenter            `protobuf:"bytes,2,opt,name=center,proto3" json:"center,omitempty"`
+	Account       *BankAccount           `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCostCenterRequest) Reset() {
-	*x = UpdateCostCenterRequest{}
+func (x *UpdateBankAccountRequest) Reset() {
+	*x = UpdateBankAccountRequest{}
 	mi := &file_finance_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCostCenterRequest) String() string {
+func (x *UpdateBankAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCostCenterRequest) ProtoMessage() {}
+func (*UpdateBankAccountRequest) ProtoMessage() {}
 
-func (x *UpdateCostCenterRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateBankAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6314,33 +7154,33 @@ func (x *UpdateCostCenterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCostCenterRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCostCenterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBankAccountRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *UpdateCostCenterRequest) GetMeta() *RequestMetadata {
+func (x *UpdateBankAccountRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *UpdateCostCenterRequest) GetCenter() *CostCenter {
+func (x *UpdateBankAccountRequest) GetAccount() *BankAccount {
 	if x != nil {
-		return x.Center
+		return x.Account
 	}
 	return nil
 }
 
-func (x *UpdateCostCenterRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *UpdateBankAccountRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.UpdateMask
 	}
 	return nil
 }
 
-type DeleteCostCenterRequest struct {
+type DeleteBankAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -6348,20 +7188,20 @@ type DeleteCostCenterRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCostCenterRequest) Reset() {
-	*x = DeleteCostCenterRequest{}
+func (x *DeleteBankAccountRequest) Reset() {
+	*x = DeleteBankAccountRequest{}
 	mi := &file_finance_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCostCenterRequest) String() string {
+func (x *DeleteBankAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCostCenterRequest) ProtoMessage() {}
+func (*DeleteBankAccountRequest) ProtoMessage() {}
 
-func (x *DeleteCostCenterRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteBankAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6373,46 +7213,46 @@ func (x *DeleteCostCenterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCostCenterRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCostCenterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBankAccountRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *DeleteCostCenterRequest) GetMeta() *RequestMetadata {
+func (x *DeleteBankAccountRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *DeleteCostCenterRequest) GetId() string {
+func (x *DeleteBankAccountRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListCostCentersRequest struct {
+type ListBankAccountsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCostCentersRequest) Reset() {
-	*x = ListCostCentersRequest{}
+func (x *ListBankAccountsRequest) Reset() {
+	*x = ListBankAccountsRequest{}
 	mi := &file_finance_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCostCentersRequest) String() string {
+func (x *ListBankAccountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCostCentersRequest) ProtoMessage() {}
+func (*ListBankAccountsRequest) ProtoMessage() {}
 
-func (x *ListCostCentersRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBankAccountsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6424,40 +7264,40 @@ func (x *ListCostCentersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCostCentersRequest.ProtoReflect.Descriptor instead.
-func (*ListCostCentersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBankAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListBankAccountsRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *ListCostCentersRequest) GetPage() *PageRequest {
+func (x *ListBankAccountsRequest) GetPage() *PageRequest {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type ListCostCentersResponse struct {
+type ListBankAccountsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Centers       []*CostCenter          `protobuf:"bytes,1,rep,name=centers,proto3" json:"centers,omitempty"`
+	Accounts      []*BankAccount         `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
 	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCostCentersResponse) Reset() {
-	*x = ListCostCentersResponse{}
+func (x *ListBankAccountsResponse) Reset() {
+	*x = ListBankAccountsResponse{}
 	mi := &file_finance_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCostCentersResponse) String() string {
+func (x *ListBankAccountsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCostCentersResponse) ProtoMessage() {}
+func (*ListBankAccountsResponse) ProtoMessage() {}
 
-func (x *ListCostCentersResponse) ProtoReflect() protoreflect.Message {
+func (x *ListBankAccountsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6469,51 +7309,55 @@ func (x *ListCostCentersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCostCentersResponse.ProtoReflect.Descriptor instead.
-func (*ListCostCentersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBankAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListBankAccountsResponse) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *ListCostCentersResponse) GetCenters() []*CostCenter {
+func (x *ListBankAccountsResponse) GetAccounts() []*BankAccount {
 	if x != nil {
-		return x.Centers
+		return x.Accounts
 	}
 	return nil
 }
 
-func (x *ListCostCentersResponse) GetPage() *PageResponse {
+func (x *ListBankAccountsResponse) GetPage() *PageResponse {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type CostAllocation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CostCenterId  string                 `protobuf:"bytes,2,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
-	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	ReferenceType string                 `protobuf:"bytes,4,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"` // INVOICE/EXPENSE/JOURNAL/etc.
-	ReferenceId   string                 `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	Audit         *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BankTransaction struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // statement line id
+	BankAccountId        string                 `protobuf:"bytes,2,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
+	Amount               *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	TransactionDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	Description          string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Reference            string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"` // UTR/Cheque/etc.
+	Reconciled           bool                   `protobuf:"varint,7,opt,name=reconciled,proto3" json:"reconciled,omitempty"`
+	MatchedReferenceType string                 `protobuf:"bytes,8,opt,name=matched_reference_type,json=matchedReferenceType,proto3" json:"matched_reference_type,omitempty"` // "INVOICE","PAYMENT","JOURNAL"
+	MatchedReferenceId   string                 `protobuf:"bytes,9,opt,name=matched_reference_id,json=matchedReferenceId,proto3" json:"matched_reference_id,omitempty"`
+	Audit                *AuditFields           `protobuf:"bytes,10,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *CostAllocation) Reset() {
-	*x = CostAllocation{}
+func (x *BankTransaction) Reset() {
+	*x = BankTransaction{}
 	mi := &file_finance_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CostAllocation) String() string {
+func (x *BankTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CostAllocation) ProtoMessage() {}
+func (*BankTransaction) ProtoMessage() {}
 
-func (x *CostAllocation) ProtoReflect() protoreflect.Message {
+func (x *BankTransaction) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6525,78 +7369,104 @@ func (x *CostAllocation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CostAllocation.ProtoReflect.Descriptor instead.
-func (*CostAllocation) Descriptor() ([]byte, []int) {
+// Deprecated: Use BankTransaction.ProtoReflect.Descriptor instead.
+func (*BankTransaction) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *CostAllocation) GetId() string {
+func (x *BankTransaction) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CostAllocation) GetCostCenterId() string {
+func (x *BankTransaction) GetBankAccountId() string {
 	if x != nil {
-		return x.CostCenterId
+		return x.BankAccountId
 	}
 	return ""
 }
 
-func (x *CostAllocation) GetAmount() *money.Money {
+func (x *BankTransaction) GetAmount() *money.Money {
 	if x != nil {
 		return x.Amount
 	}
 	return nil
 }
 
-func (x *CostAllocation) GetReferenceType() string {
+func (x *BankTransaction) GetTransactionDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ReferenceType
+		return x.TransactionDate
+	}
+	return nil
+}
+
+func (x *BankTransaction) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (x *CostAllocation) GetReferenceId() string {
+func (x *BankTransaction) GetReference() string {
 	if x != nil {
-		return x.ReferenceId
+		return x.Reference
 	}
 	return ""
 }
 
-func (x *CostAllocation) GetAudit() *AuditFields {
+func (x *BankTransaction) GetReconciled() bool {
+	if x != nil {
+		return x.Reconciled
+	}
+	return false
+}
+
+func (x *BankTransaction) GetMatchedReferenceType() string {
+	if x != nil {
+		return x.MatchedReferenceType
+	}
+	return ""
+}
+
+func (x *BankTransaction) GetMatchedReferenceId() string {
+	if x != nil {
+		return x.MatchedReferenceId
+	}
+	return ""
+}
+
+func (x *BankTransaction) GetAudit() *AuditFields {
 	if x != nil {
 		return x.Audit
 	}
 	return nil
 }
 
-type AllocateCostRequest struct {
+type ImportBankTransactionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	CostCenterId  string                 `protobuf:"bytes,2,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
-	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	ReferenceType string                 `protobuf:"bytes,4,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"`
-	ReferenceId   string                 `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	BankAccountId string                 `protobuf:"bytes,2,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
+	Transactions  []*BankTransaction     `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"` // from CSV/OFX adapter
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AllocateCostRequest) Reset() {
-	*x = AllocateCostRequest{}
+func (x *ImportBankTransactionsRequest) Reset() {
+	*x = ImportBankTransactionsRequest{}
 	mi := &file_finance_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllocateCostRequest) String() string {
+func (x *ImportBankTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllocateCostRequest) ProtoMessage() {}
+func (*ImportBankTransactionsRequest) ProtoMessage() {}
 
-func (x *AllocateCostRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportBankTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6608,67 +7478,54 @@ func (x *AllocateCostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllocateCostRequest.ProtoReflect.Descriptor instead.
-func (*AllocateCostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportBankTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ImportBankTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *AllocateCostRequest) GetMeta() *RequestMetadata {
+func (x *ImportBankTransactionsRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *AllocateCostRequest) GetCostCenterId() string {
+func (x *ImportBankTransactionsRequest) GetBankAccountId() string {
 	if x != nil {
-		return x.CostCenterId
+		return x.BankAccountId
 	}
 	return ""
 }
 
-func (x *AllocateCostRequest) GetAmount() *money.Money {
+func (x *ImportBankTransactionsRequest) GetTransactions() []*BankTransaction {
 	if x != nil {
-		return x.Amount
+		return x.Transactions
 	}
 	return nil
 }
 
-func (x *AllocateCostRequest) GetReferenceType() string {
-	if x != nil {
-		return x.ReferenceType
-	}
-	return ""
-}
-
-func (x *AllocateCostRequest) GetReferenceId() string {
-	if x != nil {
-		return x.ReferenceId
-	}
-	return ""
-}
-
-type AllocateCostResponse struct {
+type ImportBankTransactionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Allocation    *CostAllocation        `protobuf:"bytes,1,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	Imported      int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Skipped       int32                  `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AllocateCostResponse) Reset() {
-	*x = AllocateCostResponse{}
+func (x *ImportBankTransactionsResponse) Reset() {
+	*x = ImportBankTransactionsResponse{}
 	mi := &file_finance_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllocateCostResponse) String() string {
+func (x *ImportBankTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllocateCostResponse) ProtoMessage() {}
+func (*ImportBankTransactionsResponse) ProtoMessage() {}
 
-func (x *AllocateCostResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportBankTransactionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6680,39 +7537,47 @@ func (x *AllocateCostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllocateCostResponse.ProtoReflect.Descriptor instead.
-func (*AllocateCostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportBankTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ImportBankTransactionsResponse) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *AllocateCostResponse) GetAllocation() *CostAllocation {
+func (x *ImportBankTransactionsResponse) GetImported() int32 {
 	if x != nil {
-		return x.Allocation
+		return x.Imported
 	}
-	return nil
+	return 0
 }
 
-type ListCostAllocationsRequest struct {
+func (x *ImportBankTransactionsResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type ListBankTransactionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	BankAccountId string                 `protobuf:"bytes,1,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCostAllocationsRequest) Reset() {
-	*x = ListCostAllocationsRequest{}
+func (x *ListBankTransactionsRequest) Reset() {
+	*x = ListBankTransactionsRequest{}
 	mi := &file_finance_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCostAllocationsRequest) String() string {
+func (x *ListBankTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCostAllocationsRequest) ProtoMessage() {}
+func (*ListBankTransactionsRequest) ProtoMessage() {}
 
-func (x *ListCostAllocationsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBankTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6724,11 +7589,5273 @@ func (x *ListCostAllocationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCostAllocationsRequest.ProtoReflect.Descriptor instead.
-func (*ListCostAllocationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBankTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListBankTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{99}
 }
 
+func (x *ListBankTransactionsRequest) GetBankAccountId() string {
+	if x != nil {
+		return x.BankAccountId
+	}
+	return ""
+}
+
+func (x *ListBankTransactionsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListBankTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*BankTransaction     `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBankTransactionsResponse) Reset() {
+	*x = ListBankTransactionsResponse{}
+	mi := &file_finance_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBankTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBankTransactionsResponse) ProtoMessage() {}
+
+func (x *ListBankTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBankTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListBankTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListBankTransactionsResponse) GetTransactions() []*BankTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *ListBankTransactionsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ReconcileTransactionRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Meta              *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	BankTransactionId string                 `protobuf:"bytes,2,opt,name=bank_transaction_id,json=bankTransactionId,proto3" json:"bank_transaction_id,omitempty"`
+	LedgerEntryId     string                 `protobuf:"bytes,3,opt,name=ledger_entry_id,json=ledgerEntryId,proto3" json:"ledger_entry_id,omitempty"` // or invoice/payment/journal id
+	Amount            *money.Money           `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	TransactionDate   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReconcileTransactionRequest) Reset() {
+	*x = ReconcileTransactionRequest{}
+	mi := &file_finance_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileTransactionRequest) ProtoMessage() {}
+
+func (x *ReconcileTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ReconcileTransactionRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ReconcileTransactionRequest) GetBankTransactionId() string {
+	if x != nil {
+		return x.BankTransactionId
+	}
+	return ""
+}
+
+func (x *ReconcileTransactionRequest) GetLedgerEntryId() string {
+	if x != nil {
+		return x.LedgerEntryId
+	}
+	return ""
+}
+
+func (x *ReconcileTransactionRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ReconcileTransactionRequest) GetTransactionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return nil
+}
+
+type Reconciliation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matched       bool                   `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // "MATCHED", "PARTIAL_MATCH", "UNMATCHED"
+	MatchReason   string                 `protobuf:"bytes,3,opt,name=match_reason,json=matchReason,proto3" json:"match_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reconciliation) Reset() {
+	*x = Reconciliation{}
+	mi := &file_finance_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reconciliation) ProtoMessage() {}
+
+func (x *Reconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reconciliation.ProtoReflect.Descriptor instead.
+func (*Reconciliation) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Reconciliation) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *Reconciliation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Reconciliation) GetMatchReason() string {
+	if x != nil {
+		return x.MatchReason
+	}
+	return ""
+}
+
+// Chart of Accounts
+type Account struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code               string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // unique short code
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type               AccountType            `protobuf:"varint,4,opt,name=type,proto3,enum=finance.AccountType" json:"type,omitempty"`
+	ParentId           string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // for hierarchy
+	Status             AccountStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=finance.AccountStatus" json:"status,omitempty"`
+	AllowManualJournal bool                   `protobuf:"varint,7,opt,name=allow_manual_journal,json=allowManualJournal,proto3" json:"allow_manual_journal,omitempty"`
+	Audit              *AuditFields           `protobuf:"bytes,8,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_finance_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *Account) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Account) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetType() AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *Account) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Account) GetStatus() AccountStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AccountStatus_ACCOUNT_STATUS_UNSPECIFIED
+}
+
+func (x *Account) GetAllowManualJournal() bool {
+	if x != nil {
+		return x.AllowManualJournal
+	}
+	return false
+}
+
+func (x *Account) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Account       *Account               `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_finance_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *CreateAccountRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateAccountRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type GetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_finance_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Account       *Account               `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountRequest) Reset() {
+	*x = UpdateAccountRequest{}
+	mi := &file_finance_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountRequest) ProtoMessage() {}
+
+func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *UpdateAccountRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateAccountRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *UpdateAccountRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_finance_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *DeleteAccountRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DeleteAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsRequest) Reset() {
+	*x = ListAccountsRequest{}
+	mi := &file_finance_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsRequest) ProtoMessage() {}
+
+func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListAccountsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
+	mi := &file_finance_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsResponse) ProtoMessage() {}
+
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ListAccountsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// Double-entry Journal (preferred write path)
+type JournalLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Side             LedgerSide             `protobuf:"varint,2,opt,name=side,proto3,enum=finance.LedgerSide" json:"side,omitempty"` // DR/CR
+	Amount           *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`                      // transaction currency; empty currency_code means functional
+	CostCenterId     string                 `protobuf:"bytes,4,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ExchangeRate     string                 `protobuf:"bytes,6,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`             // functional units per transaction unit; latest quoted rate when empty
+	FunctionalAmount *money.Money           `protobuf:"bytes,7,opt,name=functional_amount,json=functionalAmount,proto3" json:"functional_amount,omitempty"` // output only: amount x exchange_rate
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *JournalLine) Reset() {
+	*x = JournalLine{}
+	mi := &file_finance_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalLine) ProtoMessage() {}
+
+func (x *JournalLine) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalLine.ProtoReflect.Descriptor instead.
+func (*JournalLine) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *JournalLine) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *JournalLine) GetSide() LedgerSide {
+	if x != nil {
+		return x.Side
+	}
+	return LedgerSide_LEDGER_SIDE_UNSPECIFIED
+}
+
+func (x *JournalLine) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *JournalLine) GetCostCenterId() string {
+	if x != nil {
+		return x.CostCenterId
+	}
+	return ""
+}
+
+func (x *JournalLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JournalLine) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *JournalLine) GetFunctionalAmount() *money.Money {
+	if x != nil {
+		return x.FunctionalAmount
+	}
+	return nil
+}
+
+type JournalEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JournalDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=journal_date,json=journalDate,proto3" json:"journal_date,omitempty"`
+	Reference       string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"` // external ref/UTR/DocNo
+	Memo            string                 `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	Lines           []*JournalLine         `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`                             // MUST balance (sum DR == sum CR)
+	SourceType      string                 `protobuf:"bytes,6,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"` // INVOICE/PAYMENT/ADJUSTMENT
+	SourceId        string                 `protobuf:"bytes,7,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Audit           *AuditFields           `protobuf:"bytes,8,opt,name=audit,proto3" json:"audit,omitempty"`
+	Status          JournalStatus          `protobuf:"varint,9,opt,name=status,proto3,enum=finance.JournalStatus" json:"status,omitempty"`                 // create as DRAFT (default) or POSTED
+	ReversalOfId    string                 `protobuf:"bytes,10,opt,name=reversal_of_id,json=reversalOfId,proto3" json:"reversal_of_id,omitempty"`          // set on a reversal entry
+	ReversedById    string                 `protobuf:"bytes,11,opt,name=reversed_by_id,json=reversedById,proto3" json:"reversed_by_id,omitempty"`          // set on a reversed entry
+	AutoReverseDate *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=auto_reverse_date,json=autoReverseDate,proto3" json:"auto_reverse_date,omitempty"` // reversed on this date once POSTED
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JournalEntry) Reset() {
+	*x = JournalEntry{}
+	mi := &file_finance_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalEntry) ProtoMessage() {}
+
+func (x *JournalEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalEntry.ProtoReflect.Descriptor instead.
+func (*JournalEntry) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *JournalEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetJournalDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JournalDate
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetLines() []*JournalLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetStatus() JournalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JournalStatus_JOURNAL_STATUS_UNSPECIFIED
+}
+
+func (x *JournalEntry) GetReversalOfId() string {
+	if x != nil {
+		return x.ReversalOfId
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetReversedById() string {
+	if x != nil {
+		return x.ReversedById
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetAutoReverseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AutoReverseDate
+	}
+	return nil
+}
+
+type CreateJournalEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Entry         *JournalEntry          `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateJournalEntryRequest) Reset() {
+	*x = CreateJournalEntryRequest{}
+	mi := &file_finance_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJournalEntryRequest) ProtoMessage() {}
+
+func (x *CreateJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *CreateJournalEntryRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateJournalEntryRequest) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GetJournalEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalEntryRequest) Reset() {
+	*x = GetJournalEntryRequest{}
+	mi := &file_finance_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalEntryRequest) ProtoMessage() {}
+
+func (x *GetJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetJournalEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateJournalEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Entry         *JournalEntry          `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateJournalEntryRequest) Reset() {
+	*x = UpdateJournalEntryRequest{}
+	mi := &file_finance_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJournalEntryRequest) ProtoMessage() {}
+
+func (x *UpdateJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *UpdateJournalEntryRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateJournalEntryRequest) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *UpdateJournalEntryRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteJournalEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJournalEntryRequest) Reset() {
+	*x = DeleteJournalEntryRequest{}
+	mi := &file_finance_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJournalEntryRequest) ProtoMessage() {}
+
+func (x *DeleteJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DeleteJournalEntryRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DeleteJournalEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReverseJournalEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"` // POSTED entry to reverse
+	ReversalDate  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=reversal_date,json=reversalDate,proto3" json:"reversal_date,omitempty"`
+	Memo          string                 `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseJournalEntryRequest) Reset() {
+	*x = ReverseJournalEntryRequest{}
+	mi := &file_finance_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseJournalEntryRequest) ProtoMessage() {}
+
+func (x *ReverseJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*ReverseJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ReverseJournalEntryRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ReverseJournalEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReverseJournalEntryRequest) GetReversalDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReversalDate
+	}
+	return nil
+}
+
+func (x *ReverseJournalEntryRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+type ListJournalEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalEntriesRequest) Reset() {
+	*x = ListJournalEntriesRequest{}
+	mi := &file_finance_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntriesRequest) ProtoMessage() {}
+
+func (x *ListJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListJournalEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListJournalEntriesRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListJournalEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*JournalEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalEntriesResponse) Reset() {
+	*x = ListJournalEntriesResponse{}
+	mi := &file_finance_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntriesResponse) ProtoMessage() {}
+
+func (x *ListJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListJournalEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListJournalEntriesResponse) GetEntries() []*JournalEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListJournalEntriesResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// (Derived) Ledger view - append-only projection from Journal
+type LedgerEntry struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId        string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Side             LedgerSide             `protobuf:"varint,4,opt,name=side,proto3,enum=finance.LedgerSide" json:"side,omitempty"`
+	Amount           *money.Money           `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	TransactionDate  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	CostCenterId     string                 `protobuf:"bytes,7,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
+	ReferenceType    string                 `protobuf:"bytes,8,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"` // "INVOICE"/"PAYMENT"/"JOURNAL"
+	ReferenceId      string                 `protobuf:"bytes,9,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Audit            *AuditFields           `protobuf:"bytes,10,opt,name=audit,proto3" json:"audit,omitempty"`
+	FunctionalAmount *money.Money           `protobuf:"bytes,11,opt,name=functional_amount,json=functionalAmount,proto3" json:"functional_amount,omitempty"` // amount is in the transaction currency
+	ExchangeRate     string                 `protobuf:"bytes,12,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_finance_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *LedgerEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetSide() LedgerSide {
+	if x != nil {
+		return x.Side
+	}
+	return LedgerSide_LEDGER_SIDE_UNSPECIFIED
+}
+
+func (x *LedgerEntry) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetTransactionDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetCostCenterId() string {
+	if x != nil {
+		return x.CostCenterId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetReferenceType() string {
+	if x != nil {
+		return x.ReferenceType
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetFunctionalAmount() *money.Money {
+	if x != nil {
+		return x.FunctionalAmount
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+type ListLedgerEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLedgerEntriesRequest) Reset() {
+	*x = ListLedgerEntriesRequest{}
+	mi := &file_finance_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLedgerEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLedgerEntriesRequest) ProtoMessage() {}
+
+func (x *ListLedgerEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLedgerEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListLedgerEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListLedgerEntriesRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListLedgerEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LedgerEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLedgerEntriesResponse) Reset() {
+	*x = ListLedgerEntriesResponse{}
+	mi := &file_finance_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLedgerEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLedgerEntriesResponse) ProtoMessage() {}
+
+func (x *ListLedgerEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLedgerEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListLedgerEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListLedgerEntriesResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListLedgerEntriesResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// Balances are signed by the account's normal side: ASSET and EXPENSE
+// accounts are positive when in debit, all other types when in credit.
+type AccountBalance struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountCode    string                 `protobuf:"bytes,2,opt,name=account_code,json=accountCode,proto3" json:"account_code,omitempty"`
+	AccountName    string                 `protobuf:"bytes,3,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	AccountType    AccountType            `protobuf:"varint,4,opt,name=account_type,json=accountType,proto3,enum=finance.AccountType" json:"account_type,omitempty"`
+	FromDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	OpeningBalance *money.Money           `protobuf:"bytes,7,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"` // as at the start of from_date
+	TotalDebits    *money.Money           `protobuf:"bytes,8,opt,name=total_debits,json=totalDebits,proto3" json:"total_debits,omitempty"`
+	TotalCredits   *money.Money           `protobuf:"bytes,9,opt,name=total_credits,json=totalCredits,proto3" json:"total_credits,omitempty"`
+	ClosingBalance *money.Money           `protobuf:"bytes,10,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"` // as at the end of to_date
+	Currencies     []*CurrencyBalance     `protobuf:"bytes,11,rep,name=currencies,proto3" json:"currencies,omitempty"`                               // the amounts above by transaction currency
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AccountBalance) Reset() {
+	*x = AccountBalance{}
+	mi := &file_finance_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalance) ProtoMessage() {}
+
+func (x *AccountBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalance.ProtoReflect.Descriptor instead.
+func (*AccountBalance) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *AccountBalance) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetAccountCode() string {
+	if x != nil {
+		return x.AccountCode
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *AccountBalance) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToDate
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetOpeningBalance() *money.Money {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetTotalDebits() *money.Money {
+	if x != nil {
+		return x.TotalDebits
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetTotalCredits() *money.Money {
+	if x != nil {
+		return x.TotalCredits
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetClosingBalance() *money.Money {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetCurrencies() []*CurrencyBalance {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+// CurrencyBalance is the part of an AccountBalance transacted in one currency,
+// in that currency.
+type CurrencyBalance struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode   string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	OpeningBalance *money.Money           `protobuf:"bytes,2,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	TotalDebits    *money.Money           `protobuf:"bytes,3,opt,name=total_debits,json=totalDebits,proto3" json:"total_debits,omitempty"`
+	TotalCredits   *money.Money           `protobuf:"bytes,4,opt,name=total_credits,json=totalCredits,proto3" json:"total_credits,omitempty"`
+	ClosingBalance *money.Money           `protobuf:"bytes,5,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CurrencyBalance) Reset() {
+	*x = CurrencyBalance{}
+	mi := &file_finance_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyBalance) ProtoMessage() {}
+
+func (x *CurrencyBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyBalance.ProtoReflect.Descriptor instead.
+func (*CurrencyBalance) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CurrencyBalance) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CurrencyBalance) GetOpeningBalance() *money.Money {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return nil
+}
+
+func (x *CurrencyBalance) GetTotalDebits() *money.Money {
+	if x != nil {
+		return x.TotalDebits
+	}
+	return nil
+}
+
+func (x *CurrencyBalance) GetTotalCredits() *money.Money {
+	if x != nil {
+		return x.TotalCredits
+	}
+	return nil
+}
+
+func (x *CurrencyBalance) GetClosingBalance() *money.Money {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return nil
+}
+
+type GetAccountBalanceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	FromDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`                   // unset: from the first posting
+	ToDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`                         // inclusive; the "as of" date
+	OrganizationId string                 `protobuf:"bytes,4,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // unset: journals without one
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAccountBalanceRequest) Reset() {
+	*x = GetAccountBalanceRequest{}
+	mi := &file_finance_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalanceRequest) ProtoMessage() {}
+
+func (x *GetAccountBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetAccountBalanceRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetAccountBalanceRequest) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
+	}
+	return nil
+}
+
+func (x *GetAccountBalanceRequest) GetToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToDate
+	}
+	return nil
+}
+
+func (x *GetAccountBalanceRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListAccountBalancesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountIds     []string               `protobuf:"bytes,1,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"` // empty: every account
+	FromDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,4,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // unset: journals without one
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAccountBalancesRequest) Reset() {
+	*x = ListAccountBalancesRequest{}
+	mi := &file_finance_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountBalancesRequest) ProtoMessage() {}
+
+func (x *ListAccountBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountBalancesRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListAccountBalancesRequest) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *ListAccountBalancesRequest) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
+	}
+	return nil
+}
+
+func (x *ListAccountBalancesRequest) GetToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToDate
+	}
+	return nil
+}
+
+func (x *ListAccountBalancesRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListAccountBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*AccountBalance      `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountBalancesResponse) Reset() {
+	*x = ListAccountBalancesResponse{}
+	mi := &file_finance_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountBalancesResponse) ProtoMessage() {}
+
+func (x *ListAccountBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountBalancesResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListAccountBalancesResponse) GetBalances() []*AccountBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+// A node of the chart of accounts. balance is rolled up over the account and
+// all of its descendants.
+type AccountTreeNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Balance       *AccountBalance        `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Children      []*AccountTreeNode     `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountTreeNode) Reset() {
+	*x = AccountTreeNode{}
+	mi := &file_finance_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountTreeNode) ProtoMessage() {}
+
+func (x *AccountTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountTreeNode.ProtoReflect.Descriptor instead.
+func (*AccountTreeNode) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *AccountTreeNode) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountTreeNode) GetBalance() *AccountBalance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *AccountTreeNode) GetChildren() []*AccountTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type GetAccountTreeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FromDate       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`                   // unset: from the first posting
+	ToDate         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`                         // unset: today
+	OrganizationId string                 `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"` // unset: journals without one
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAccountTreeRequest) Reset() {
+	*x = GetAccountTreeRequest{}
+	mi := &file_finance_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountTreeRequest) ProtoMessage() {}
+
+func (x *GetAccountTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountTreeRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetAccountTreeRequest) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
+	}
+	return nil
+}
+
+func (x *GetAccountTreeRequest) GetToDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToDate
+	}
+	return nil
+}
+
+func (x *GetAccountTreeRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type GetAccountTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roots         []*AccountTreeNode     `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountTreeResponse) Reset() {
+	*x = GetAccountTreeResponse{}
+	mi := &file_finance_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountTreeResponse) ProtoMessage() {}
+
+func (x *GetAccountTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountTreeResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetAccountTreeResponse) GetRoots() []*AccountTreeNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+// Recurring journal. Each occurrence falls on day_of_month (clamped to the
+// month's last day) every 1, 3 or 12 months from start_date and is generated
+// once, as a draft or, with auto_post, already posted.
+type JournalTemplate struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Reference         string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"` // defaults to name on generated entries
+	Memo              string                 `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	Lines             []*JournalLine         `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"` // MUST balance
+	Frequency         TemplateFrequency      `protobuf:"varint,6,opt,name=frequency,proto3,enum=finance.TemplateFrequency" json:"frequency,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`             // optional
+	DayOfMonth        int32                  `protobuf:"varint,9,opt,name=day_of_month,json=dayOfMonth,proto3" json:"day_of_month,omitempty"` // 1-31
+	AutoPost          bool                   `protobuf:"varint,10,opt,name=auto_post,json=autoPost,proto3" json:"auto_post,omitempty"`
+	AdjustmentPercent string                 `protobuf:"bytes,11,opt,name=adjustment_percent,json=adjustmentPercent,proto3" json:"adjustment_percent,omitempty"` // compounded per full year since start_date, e.g. "5"
+	Active            bool                   `protobuf:"varint,12,opt,name=active,proto3" json:"active,omitempty"`
+	LastOccurrence    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_occurrence,json=lastOccurrence,proto3" json:"last_occurrence,omitempty"` // output only
+	OrganizationId    string                 `protobuf:"bytes,14,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Audit             *AuditFields           `protobuf:"bytes,15,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *JournalTemplate) Reset() {
+	*x = JournalTemplate{}
+	mi := &file_finance_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalTemplate) ProtoMessage() {}
+
+func (x *JournalTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalTemplate.ProtoReflect.Descriptor instead.
+func (*JournalTemplate) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *JournalTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JournalTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JournalTemplate) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *JournalTemplate) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *JournalTemplate) GetLines() []*JournalLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *JournalTemplate) GetFrequency() TemplateFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return TemplateFrequency_TEMPLATE_FREQUENCY_UNSPECIFIED
+}
+
+func (x *JournalTemplate) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *JournalTemplate) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *JournalTemplate) GetDayOfMonth() int32 {
+	if x != nil {
+		return x.DayOfMonth
+	}
+	return 0
+}
+
+func (x *JournalTemplate) GetAutoPost() bool {
+	if x != nil {
+		return x.AutoPost
+	}
+	return false
+}
+
+func (x *JournalTemplate) GetAdjustmentPercent() string {
+	if x != nil {
+		return x.AdjustmentPercent
+	}
+	return ""
+}
+
+func (x *JournalTemplate) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *JournalTemplate) GetLastOccurrence() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastOccurrence
+	}
+	return nil
+}
+
+func (x *JournalTemplate) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *JournalTemplate) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type CreateJournalTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Template      *JournalTemplate       `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateJournalTemplateRequest) Reset() {
+	*x = CreateJournalTemplateRequest{}
+	mi := &file_finance_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJournalTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJournalTemplateRequest) ProtoMessage() {}
+
+func (x *CreateJournalTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJournalTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateJournalTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *CreateJournalTemplateRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateJournalTemplateRequest) GetTemplate() *JournalTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetJournalTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalTemplateRequest) Reset() {
+	*x = GetJournalTemplateRequest{}
+	mi := &file_finance_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalTemplateRequest) ProtoMessage() {}
+
+func (x *GetJournalTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetJournalTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetJournalTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateJournalTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Template      *JournalTemplate       `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateJournalTemplateRequest) Reset() {
+	*x = UpdateJournalTemplateRequest{}
+	mi := &file_finance_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJournalTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJournalTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateJournalTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJournalTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJournalTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *UpdateJournalTemplateRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateJournalTemplateRequest) GetTemplate() *JournalTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *UpdateJournalTemplateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteJournalTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJournalTemplateRequest) Reset() {
+	*x = DeleteJournalTemplateRequest{}
+	mi := &file_finance_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJournalTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJournalTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteJournalTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJournalTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJournalTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *DeleteJournalTemplateRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DeleteJournalTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListJournalTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalTemplatesRequest) Reset() {
+	*x = ListJournalTemplatesRequest{}
+	mi := &file_finance_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalTemplatesRequest) ProtoMessage() {}
+
+func (x *ListJournalTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListJournalTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ListJournalTemplatesRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListJournalTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*JournalTemplate     `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalTemplatesResponse) Reset() {
+	*x = ListJournalTemplatesResponse{}
+	mi := &file_finance_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalTemplatesResponse) ProtoMessage() {}
+
+func (x *ListJournalTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListJournalTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ListJournalTemplatesResponse) GetTemplates() []*JournalTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListJournalTemplatesResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GenerateRecurringJournalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRecurringJournalsRequest) Reset() {
+	*x = GenerateRecurringJournalsRequest{}
+	mi := &file_finance_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRecurringJournalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRecurringJournalsRequest) ProtoMessage() {}
+
+func (x *GenerateRecurringJournalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRecurringJournalsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRecurringJournalsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GenerateRecurringJournalsRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GenerateRecurringJournalsRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type GenerateRecurringJournalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*JournalEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Failures      []string               `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"` // templates stopped at an occurrence that could not be created
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRecurringJournalsResponse) Reset() {
+	*x = GenerateRecurringJournalsResponse{}
+	mi := &file_finance_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRecurringJournalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRecurringJournalsResponse) ProtoMessage() {}
+
+func (x *GenerateRecurringJournalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRecurringJournalsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateRecurringJournalsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GenerateRecurringJournalsResponse) GetEntries() []*JournalEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GenerateRecurringJournalsResponse) GetFailures() []string {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// Bulk journal import. The file has a header row; see ImportJournalEntries.
+type ImportJournalEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"` // the extension selects the format: .csv or .xlsx
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // validate and report only
+	Post          bool                   `protobuf:"varint,5,opt,name=post,proto3" json:"post,omitempty"`                   // create the entries POSTED instead of DRAFT
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJournalEntriesRequest) Reset() {
+	*x = ImportJournalEntriesRequest{}
+	mi := &file_finance_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJournalEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJournalEntriesRequest) ProtoMessage() {}
+
+func (x *ImportJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJournalEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ImportJournalEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ImportJournalEntriesRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ImportJournalEntriesRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ImportJournalEntriesRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportJournalEntriesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportJournalEntriesRequest) GetPost() bool {
+	if x != nil {
+		return x.Post
+	}
+	return false
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // 1-based row in the file, header included
+	Reference     string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Field         string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_finance_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportJournalEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       int32                  `protobuf:"varint,1,opt,name=entries,proto3" json:"entries,omitempty"`
+	Lines         int32                  `protobuf:"varint,2,opt,name=lines,proto3" json:"lines,omitempty"`
+	Committed     bool                   `protobuf:"varint,3,opt,name=committed,proto3" json:"committed,omitempty"` // false on dry runs and whenever errors is non-empty
+	Errors        []*ImportRowError      `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJournalEntriesResponse) Reset() {
+	*x = ImportJournalEntriesResponse{}
+	mi := &file_finance_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJournalEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJournalEntriesResponse) ProtoMessage() {}
+
+func (x *ImportJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJournalEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ImportJournalEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ImportJournalEntriesResponse) GetEntries() int32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *ImportJournalEntriesResponse) GetLines() int32 {
+	if x != nil {
+		return x.Lines
+	}
+	return 0
+}
+
+func (x *ImportJournalEntriesResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *ImportJournalEntriesResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// One calendar per organization. A fiscal year is labelled by the calendar
+// year it starts in: with year_start_month = 4, FY 2025 is Apr 2025 - Mar 2026.
+type FiscalCalendar struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId            string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	YearStartMonth            int32                  `protobuf:"varint,2,opt,name=year_start_month,json=yearStartMonth,proto3" json:"year_start_month,omitempty"`                                   // 1-12
+	RetainedEarningsAccountId string                 `protobuf:"bytes,3,opt,name=retained_earnings_account_id,json=retainedEarningsAccountId,proto3" json:"retained_earnings_account_id,omitempty"` // target of the year-end close
+	Audit                     *AuditFields           `protobuf:"bytes,4,opt,name=audit,proto3" json:"audit,omitempty"`
+	FunctionalCurrency        string                 `protobuf:"bytes,5,opt,name=functional_currency,json=functionalCurrency,proto3" json:"functional_currency,omitempty"` // ISO 4217; server default when empty
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *FiscalCalendar) Reset() {
+	*x = FiscalCalendar{}
+	mi := &file_finance_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FiscalCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FiscalCalendar) ProtoMessage() {}
+
+func (x *FiscalCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FiscalCalendar.ProtoReflect.Descriptor instead.
+func (*FiscalCalendar) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *FiscalCalendar) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *FiscalCalendar) GetYearStartMonth() int32 {
+	if x != nil {
+		return x.YearStartMonth
+	}
+	return 0
+}
+
+func (x *FiscalCalendar) GetRetainedEarningsAccountId() string {
+	if x != nil {
+		return x.RetainedEarningsAccountId
+	}
+	return ""
+}
+
+func (x *FiscalCalendar) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+func (x *FiscalCalendar) GetFunctionalCurrency() string {
+	if x != nil {
+		return x.FunctionalCurrency
+	}
+	return ""
+}
+
+type FiscalPeriod struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	FiscalYear     int32                  `protobuf:"varint,3,opt,name=fiscal_year,json=fiscalYear,proto3" json:"fiscal_year,omitempty"`
+	PeriodNumber   int32                  `protobuf:"varint,4,opt,name=period_number,json=periodNumber,proto3" json:"period_number,omitempty"` // 1-12
+	StartDate      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Status         PeriodStatus           `protobuf:"varint,7,opt,name=status,proto3,enum=finance.PeriodStatus" json:"status,omitempty"`
+	ClosedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	ClosedBy       string                 `protobuf:"bytes,9,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FiscalPeriod) Reset() {
+	*x = FiscalPeriod{}
+	mi := &file_finance_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FiscalPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FiscalPeriod) ProtoMessage() {}
+
+func (x *FiscalPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FiscalPeriod.ProtoReflect.Descriptor instead.
+func (*FiscalPeriod) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *FiscalPeriod) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FiscalPeriod) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *FiscalPeriod) GetFiscalYear() int32 {
+	if x != nil {
+		return x.FiscalYear
+	}
+	return 0
+}
+
+func (x *FiscalPeriod) GetPeriodNumber() int32 {
+	if x != nil {
+		return x.PeriodNumber
+	}
+	return 0
+}
+
+func (x *FiscalPeriod) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *FiscalPeriod) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *FiscalPeriod) GetStatus() PeriodStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PeriodStatus_PERIOD_STATUS_UNSPECIFIED
+}
+
+func (x *FiscalPeriod) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *FiscalPeriod) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+type SetFiscalCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Calendar      *FiscalCalendar        `protobuf:"bytes,2,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFiscalCalendarRequest) Reset() {
+	*x = SetFiscalCalendarRequest{}
+	mi := &file_finance_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFiscalCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFiscalCalendarRequest) ProtoMessage() {}
+
+func (x *SetFiscalCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFiscalCalendarRequest.ProtoReflect.Descriptor instead.
+func (*SetFiscalCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *SetFiscalCalendarRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SetFiscalCalendarRequest) GetCalendar() *FiscalCalendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+type CreateFiscalYearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	FiscalYear    int32                  `protobuf:"varint,2,opt,name=fiscal_year,json=fiscalYear,proto3" json:"fiscal_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFiscalYearRequest) Reset() {
+	*x = CreateFiscalYearRequest{}
+	mi := &file_finance_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFiscalYearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFiscalYearRequest) ProtoMessage() {}
+
+func (x *CreateFiscalYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFiscalYearRequest.ProtoReflect.Descriptor instead.
+func (*CreateFiscalYearRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *CreateFiscalYearRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateFiscalYearRequest) GetFiscalYear() int32 {
+	if x != nil {
+		return x.FiscalYear
+	}
+	return 0
+}
+
+type ListFiscalPeriodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	FiscalYear    int32                  `protobuf:"varint,2,opt,name=fiscal_year,json=fiscalYear,proto3" json:"fiscal_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFiscalPeriodsRequest) Reset() {
+	*x = ListFiscalPeriodsRequest{}
+	mi := &file_finance_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFiscalPeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFiscalPeriodsRequest) ProtoMessage() {}
+
+func (x *ListFiscalPeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFiscalPeriodsRequest.ProtoReflect.Descriptor instead.
+func (*ListFiscalPeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ListFiscalPeriodsRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ListFiscalPeriodsRequest) GetFiscalYear() int32 {
+	if x != nil {
+		return x.FiscalYear
+	}
+	return 0
+}
+
+type ListFiscalPeriodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Periods       []*FiscalPeriod        `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFiscalPeriodsResponse) Reset() {
+	*x = ListFiscalPeriodsResponse{}
+	mi := &file_finance_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFiscalPeriodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFiscalPeriodsResponse) ProtoMessage() {}
+
+func (x *ListFiscalPeriodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFiscalPeriodsResponse.ProtoReflect.Descriptor instead.
+func (*ListFiscalPeriodsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ListFiscalPeriodsResponse) GetPeriods() []*FiscalPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type OpenPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenPeriodRequest) Reset() {
+	*x = OpenPeriodRequest{}
+	mi := &file_finance_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenPeriodRequest) ProtoMessage() {}
+
+func (x *OpenPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenPeriodRequest.ProtoReflect.Descriptor instead.
+func (*OpenPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *OpenPeriodRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *OpenPeriodRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ClosePeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Soft          bool                   `protobuf:"varint,3,opt,name=soft,proto3" json:"soft,omitempty"` // SOFT_CLOSED instead of CLOSED
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosePeriodRequest) Reset() {
+	*x = ClosePeriodRequest{}
+	mi := &file_finance_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosePeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePeriodRequest) ProtoMessage() {}
+
+func (x *ClosePeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePeriodRequest.ProtoReflect.Descriptor instead.
+func (*ClosePeriodRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ClosePeriodRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ClosePeriodRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClosePeriodRequest) GetSoft() bool {
+	if x != nil {
+		return x.Soft
+	}
+	return false
+}
+
+type CloseFiscalYearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	FiscalYear    int32                  `protobuf:"varint,2,opt,name=fiscal_year,json=fiscalYear,proto3" json:"fiscal_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseFiscalYearRequest) Reset() {
+	*x = CloseFiscalYearRequest{}
+	mi := &file_finance_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseFiscalYearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseFiscalYearRequest) ProtoMessage() {}
+
+func (x *CloseFiscalYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseFiscalYearRequest.ProtoReflect.Descriptor instead.
+func (*CloseFiscalYearRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *CloseFiscalYearRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CloseFiscalYearRequest) GetFiscalYear() int32 {
+	if x != nil {
+		return x.FiscalYear
+	}
+	return 0
+}
+
+type CloseFiscalYearResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClosingEntry  *JournalEntry          `protobuf:"bytes,1,opt,name=closing_entry,json=closingEntry,proto3" json:"closing_entry,omitempty"` // unset when there was nothing to close
+	Periods       []*FiscalPeriod        `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseFiscalYearResponse) Reset() {
+	*x = CloseFiscalYearResponse{}
+	mi := &file_finance_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseFiscalYearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseFiscalYearResponse) ProtoMessage() {}
+
+func (x *CloseFiscalYearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseFiscalYearResponse.ProtoReflect.Descriptor instead.
+func (*CloseFiscalYearResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *CloseFiscalYearResponse) GetClosingEntry() *JournalEntry {
+	if x != nil {
+		return x.ClosingEntry
+	}
+	return nil
+}
+
+func (x *CloseFiscalYearResponse) GetPeriods() []*FiscalPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type Budget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TotalAmount   *money.Money           `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // consider enum later (DRAFT/ACTIVE/CLOSED)
+	Audit         *AuditFields           `protobuf:"bytes,5,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_finance_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *Budget) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Budget) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Budget) GetTotalAmount() *money.Money {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return nil
+}
+
+func (x *Budget) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Budget) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type CreateBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Budget        *Budget                `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBudgetRequest) Reset() {
+	*x = CreateBudgetRequest{}
+	mi := &file_finance_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBudgetRequest) ProtoMessage() {}
+
+func (x *CreateBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBudgetRequest.ProtoReflect.Descriptor instead.
+func (*CreateBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *CreateBudgetRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateBudgetRequest) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type GetBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetRequest) Reset() {
+	*x = GetBudgetRequest{}
+	mi := &file_finance_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetRequest) ProtoMessage() {}
+
+func (x *GetBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *GetBudgetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Budget        *Budget                `protobuf:"bytes,2,opt,name=budget,proto3" json:"budget,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBudgetRequest) Reset() {
+	*x = UpdateBudgetRequest{}
+	mi := &file_finance_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBudgetRequest) ProtoMessage() {}
+
+func (x *UpdateBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBudgetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *UpdateBudgetRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateBudgetRequest) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *UpdateBudgetRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetRequest) Reset() {
+	*x = DeleteBudgetRequest{}
+	mi := &file_finance_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *DeleteBudgetRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DeleteBudgetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsRequest) Reset() {
+	*x = ListBudgetsRequest{}
+	mi := &file_finance_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsRequest) ProtoMessage() {}
+
+func (x *ListBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ListBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *ListBudgetsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetsResponse) Reset() {
+	*x = ListBudgetsResponse{}
+	mi := &file_finance_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetsResponse) ProtoMessage() {}
+
+func (x *ListBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ListBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *ListBudgetsResponse) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+func (x *ListBudgetsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type BudgetAllocation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BudgetId        string                 `protobuf:"bytes,2,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	DepartmentId    string                 `protobuf:"bytes,3,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	AllocatedAmount *money.Money           `protobuf:"bytes,4,opt,name=allocated_amount,json=allocatedAmount,proto3" json:"allocated_amount,omitempty"`
+	SpentAmount     *money.Money           `protobuf:"bytes,5,opt,name=spent_amount,json=spentAmount,proto3" json:"spent_amount,omitempty"`
+	RemainingAmount *money.Money           `protobuf:"bytes,6,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"`
+	Audit           *AuditFields           `protobuf:"bytes,7,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BudgetAllocation) Reset() {
+	*x = BudgetAllocation{}
+	mi := &file_finance_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetAllocation) ProtoMessage() {}
+
+func (x *BudgetAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetAllocation.ProtoReflect.Descriptor instead.
+func (*BudgetAllocation) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *BudgetAllocation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BudgetAllocation) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+func (x *BudgetAllocation) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *BudgetAllocation) GetAllocatedAmount() *money.Money {
+	if x != nil {
+		return x.AllocatedAmount
+	}
+	return nil
+}
+
+func (x *BudgetAllocation) GetSpentAmount() *money.Money {
+	if x != nil {
+		return x.SpentAmount
+	}
+	return nil
+}
+
+func (x *BudgetAllocation) GetRemainingAmount() *money.Money {
+	if x != nil {
+		return x.RemainingAmount
+	}
+	return nil
+}
+
+func (x *BudgetAllocation) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type AllocateBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Allocation    *BudgetAllocation      `protobuf:"bytes,2,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocateBudgetRequest) Reset() {
+	*x = AllocateBudgetRequest{}
+	mi := &file_finance_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateBudgetRequest) ProtoMessage() {}
+
+func (x *AllocateBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateBudgetRequest.ProtoReflect.Descriptor instead.
+func (*AllocateBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *AllocateBudgetRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *AllocateBudgetRequest) GetAllocation() *BudgetAllocation {
+	if x != nil {
+		return x.Allocation
+	}
+	return nil
+}
+
+type GetBudgetAllocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetAllocationRequest) Reset() {
+	*x = GetBudgetAllocationRequest{}
+	mi := &file_finance_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetAllocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetAllocationRequest) ProtoMessage() {}
+
+func (x *GetBudgetAllocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetAllocationRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetAllocationRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *GetBudgetAllocationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateBudgetAllocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Allocation    *BudgetAllocation      `protobuf:"bytes,2,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBudgetAllocationRequest) Reset() {
+	*x = UpdateBudgetAllocationRequest{}
+	mi := &file_finance_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBudgetAllocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBudgetAllocationRequest) ProtoMessage() {}
+
+func (x *UpdateBudgetAllocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBudgetAllocationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBudgetAllocationRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *UpdateBudgetAllocationRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateBudgetAllocationRequest) GetAllocation() *BudgetAllocation {
+	if x != nil {
+		return x.Allocation
+	}
+	return nil
+}
+
+func (x *UpdateBudgetAllocationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteBudgetAllocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBudgetAllocationRequest) Reset() {
+	*x = DeleteBudgetAllocationRequest{}
+	mi := &file_finance_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBudgetAllocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBudgetAllocationRequest) ProtoMessage() {}
+
+func (x *DeleteBudgetAllocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBudgetAllocationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBudgetAllocationRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *DeleteBudgetAllocationRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DeleteBudgetAllocationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListBudgetAllocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetAllocationsRequest) Reset() {
+	*x = ListBudgetAllocationsRequest{}
+	mi := &file_finance_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetAllocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetAllocationsRequest) ProtoMessage() {}
+
+func (x *ListBudgetAllocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetAllocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListBudgetAllocationsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *ListBudgetAllocationsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListBudgetAllocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allocations   []*BudgetAllocation    `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetAllocationsResponse) Reset() {
+	*x = ListBudgetAllocationsResponse{}
+	mi := &file_finance_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetAllocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetAllocationsResponse) ProtoMessage() {}
+
+func (x *ListBudgetAllocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetAllocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListBudgetAllocationsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *ListBudgetAllocationsResponse) GetAllocations() []*BudgetAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *ListBudgetAllocationsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type BudgetComparisonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BudgetId      string                 `protobuf:"bytes,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetComparisonRequest) Reset() {
+	*x = BudgetComparisonRequest{}
+	mi := &file_finance_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetComparisonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetComparisonRequest) ProtoMessage() {}
+
+func (x *BudgetComparisonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetComparisonRequest.ProtoReflect.Descriptor instead.
+func (*BudgetComparisonRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *BudgetComparisonRequest) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+type BudgetComparisonResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BudgetId        string                 `protobuf:"bytes,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	TotalBudget     *money.Money           `protobuf:"bytes,2,opt,name=total_budget,json=totalBudget,proto3" json:"total_budget,omitempty"`
+	TotalAllocated  *money.Money           `protobuf:"bytes,3,opt,name=total_allocated,json=totalAllocated,proto3" json:"total_allocated,omitempty"`
+	TotalSpent      *money.Money           `protobuf:"bytes,4,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
+	RemainingBudget *money.Money           `protobuf:"bytes,5,opt,name=remaining_budget,json=remainingBudget,proto3" json:"remaining_budget,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BudgetComparisonResponse) Reset() {
+	*x = BudgetComparisonResponse{}
+	mi := &file_finance_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetComparisonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetComparisonResponse) ProtoMessage() {}
+
+func (x *BudgetComparisonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetComparisonResponse.ProtoReflect.Descriptor instead.
+func (*BudgetComparisonResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *BudgetComparisonResponse) GetBudgetId() string {
+	if x != nil {
+		return x.BudgetId
+	}
+	return ""
+}
+
+func (x *BudgetComparisonResponse) GetTotalBudget() *money.Money {
+	if x != nil {
+		return x.TotalBudget
+	}
+	return nil
+}
+
+func (x *BudgetComparisonResponse) GetTotalAllocated() *money.Money {
+	if x != nil {
+		return x.TotalAllocated
+	}
+	return nil
+}
+
+func (x *BudgetComparisonResponse) GetTotalSpent() *money.Money {
+	if x != nil {
+		return x.TotalSpent
+	}
+	return nil
+}
+
+func (x *BudgetComparisonResponse) GetRemainingBudget() *money.Money {
+	if x != nil {
+		return x.RemainingBudget
+	}
+	return nil
+}
+
+type ExpenseRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // "LABOR","MATERIAL","OPEX","CAPEX"...
+	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExpenseDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expense_date,json=expenseDate,proto3" json:"expense_date,omitempty"`
+	CostCenterId  string                 `protobuf:"bytes,5,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
+	Audit         *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseRate) Reset() {
+	*x = ExpenseRate{}
+	mi := &file_finance_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseRate) ProtoMessage() {}
+
+func (x *ExpenseRate) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseRate.ProtoReflect.Descriptor instead.
+func (*ExpenseRate) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *ExpenseRate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExpenseRate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ExpenseRate) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ExpenseRate) GetExpenseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpenseDate
+	}
+	return nil
+}
+
+func (x *ExpenseRate) GetCostCenterId() string {
+	if x != nil {
+		return x.CostCenterId
+	}
+	return ""
+}
+
+func (x *ExpenseRate) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type CreateExpenseRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ExpenseRate   *ExpenseRate           `protobuf:"bytes,2,opt,name=expense_rate,json=expenseRate,proto3" json:"expense_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExpenseRateRequest) Reset() {
+	*x = CreateExpenseRateRequest{}
+	mi := &file_finance_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpenseRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpenseRateRequest) ProtoMessage() {}
+
+func (x *CreateExpenseRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpenseRateRequest.ProtoReflect.Descriptor instead.
+func (*CreateExpenseRateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *CreateExpenseRateRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateExpenseRateRequest) GetExpenseRate() *ExpenseRate {
+	if x != nil {
+		return x.ExpenseRate
+	}
+	return nil
+}
+
+type GetExpenseRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpenseRateRequest) Reset() {
+	*x = GetExpenseRateRequest{}
+	mi := &file_finance_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpenseRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpenseRateRequest) ProtoMessage() {}
+
+func (x *GetExpenseRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpenseRateRequest.ProtoReflect.Descriptor instead.
+func (*GetExpenseRateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *GetExpenseRateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateExpenseRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	ExpenseRate   *ExpenseRate           `protobuf:"bytes,2,opt,name=expense_rate,json=expenseRate,proto3" json:"expense_rate,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExpenseRateRequest) Reset() {
+	*x = UpdateExpenseRateRequest{}
+	mi := &file_finance_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpenseRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpenseRateRequest) ProtoMessage() {}
+
+func (x *UpdateExpenseRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpenseRateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExpenseRateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *UpdateExpenseRateRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateExpenseRateRequest) GetExpenseRate() *ExpenseRate {
+	if x != nil {
+		return x.ExpenseRate
+	}
+	return nil
+}
+
+func (x *UpdateExpenseRateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteExpenseRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseRateRequest) Reset() {
+	*x = DeleteExpenseRateRequest{}
+	mi := &file_finance_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseRateRequest) ProtoMessage() {}
+
+func (x *DeleteExpenseRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseRateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseRateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *DeleteExpenseRateRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DeleteExpenseRateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListExpensesRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpensesRateRequest) Reset() {
+	*x = ListExpensesRateRequest{}
+	mi := &file_finance_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpensesRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpensesRateRequest) ProtoMessage() {}
+
+func (x *ListExpensesRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpensesRateRequest.ProtoReflect.Descriptor instead.
+func (*ListExpensesRateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ListExpensesRateRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListExpensesRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseRate   []*ExpenseRate         `protobuf:"bytes,1,rep,name=expense_rate,json=expenseRate,proto3" json:"expense_rate,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpensesRateResponse) Reset() {
+	*x = ListExpensesRateResponse{}
+	mi := &file_finance_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpensesRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpensesRateResponse) ProtoMessage() {}
+
+func (x *ListExpensesRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpensesRateResponse.ProtoReflect.Descriptor instead.
+func (*ListExpensesRateResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ListExpensesRateResponse) GetExpenseRate() []*ExpenseRate {
+	if x != nil {
+		return x.ExpenseRate
+	}
+	return nil
+}
+
+func (x *ListExpensesRateResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type CostCenter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Audit         *AuditFields           `protobuf:"bytes,4,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CostCenter) Reset() {
+	*x = CostCenter{}
+	mi := &file_finance_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CostCenter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostCenter) ProtoMessage() {}
+
+func (x *CostCenter) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostCenter.ProtoReflect.Descriptor instead.
+func (*CostCenter) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *CostCenter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CostCenter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CostCenter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CostCenter) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type CreateCostCenterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Center        *CostCenter            `protobuf:"bytes,2,opt,name=center,proto3" json:"center,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCostCenterRequest) Reset() {
+	*x = CreateCostCenterRequest{}
+	mi := &file_finance_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCostCenterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCostCenterRequest) ProtoMessage() {}
+
+func (x *CreateCostCenterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCostCenterRequest.ProtoReflect.Descriptor instead.
+func (*CreateCostCenterRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *CreateCostCenterRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CreateCostCenterRequest) GetCenter() *CostCenter {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+type GetCostCenterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCostCenterRequest) Reset() {
+	*x = GetCostCenterRequest{}
+	mi := &file_finance_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCostCenterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCostCenterRequest) ProtoMessage() {}
+
+func (x *GetCostCenterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCostCenterRequest.ProtoReflect.Descriptor instead.
+func (*GetCostCenterRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *GetCostCenterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateCostCenterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Center        *CostCenter            `protobuf:"bytes,2,opt,name=center,proto3" json:"center,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCostCenterRequest) Reset() {
+	*x = UpdateCostCenterRequest{}
+	mi := &file_finance_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCostCenterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCostCenterRequest) ProtoMessage() {}
+
+func (x *UpdateCostCenterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCostCenterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCostCenterRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *UpdateCostCenterRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *UpdateCostCenterRequest) GetCenter() *CostCenter {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *UpdateCostCenterRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteCostCenterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCostCenterRequest) Reset() {
+	*x = DeleteCostCenterRequest{}
+	mi := &file_finance_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCostCenterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCostCenterRequest) ProtoMessage() {}
+
+func (x *DeleteCostCenterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCostCenterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCostCenterRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *DeleteCostCenterRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DeleteCostCenterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCostCentersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCostCentersRequest) Reset() {
+	*x = ListCostCentersRequest{}
+	mi := &file_finance_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCostCentersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCostCentersRequest) ProtoMessage() {}
+
+func (x *ListCostCentersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCostCentersRequest.ProtoReflect.Descriptor instead.
+func (*ListCostCentersRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *ListCostCentersRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListCostCentersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Centers       []*CostCenter          `protobuf:"bytes,1,rep,name=centers,proto3" json:"centers,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCostCentersResponse) Reset() {
+	*x = ListCostCentersResponse{}
+	mi := &file_finance_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCostCentersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCostCentersResponse) ProtoMessage() {}
+
+func (x *ListCostCentersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCostCentersResponse.ProtoReflect.Descriptor instead.
+func (*ListCostCentersResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *ListCostCentersResponse) GetCenters() []*CostCenter {
+	if x != nil {
+		return x.Centers
+	}
+	return nil
+}
+
+func (x *ListCostCentersResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type CostAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CostCenterId  string                 `protobuf:"bytes,2,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ReferenceType string                 `protobuf:"bytes,4,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"` // INVOICE/EXPENSE/JOURNAL/etc.
+	ReferenceId   string                 `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Audit         *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CostAllocation) Reset() {
+	*x = CostAllocation{}
+	mi := &file_finance_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CostAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostAllocation) ProtoMessage() {}
+
+func (x *CostAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostAllocation.ProtoReflect.Descriptor instead.
+func (*CostAllocation) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *CostAllocation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CostAllocation) GetCostCenterId() string {
+	if x != nil {
+		return x.CostCenterId
+	}
+	return ""
+}
+
+func (x *CostAllocation) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CostAllocation) GetReferenceType() string {
+	if x != nil {
+		return x.ReferenceType
+	}
+	return ""
+}
+
+func (x *CostAllocation) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *CostAllocation) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type AllocateCostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	CostCenterId  string                 `protobuf:"bytes,2,opt,name=cost_center_id,json=costCenterId,proto3" json:"cost_center_id,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ReferenceType string                 `protobuf:"bytes,4,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"`
+	ReferenceId   string                 `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocateCostRequest) Reset() {
+	*x = AllocateCostRequest{}
+	mi := &file_finance_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateCostRequest) ProtoMessage() {}
+
+func (x *AllocateCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateCostRequest.ProtoReflect.Descriptor instead.
+func (*AllocateCostRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *AllocateCostRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *AllocateCostRequest) GetCostCenterId() string {
+	if x != nil {
+		return x.CostCenterId
+	}
+	return ""
+}
+
+func (x *AllocateCostRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *AllocateCostRequest) GetReferenceType() string {
+	if x != nil {
+		return x.ReferenceType
+	}
+	return ""
+}
+
+func (x *AllocateCostRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+type AllocateCostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allocation    *CostAllocation        `protobuf:"bytes,1,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocateCostResponse) Reset() {
+	*x = AllocateCostResponse{}
+	mi := &file_finance_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateCostResponse) ProtoMessage() {}
+
+func (x *AllocateCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateCostResponse.ProtoReflect.Descriptor instead.
+func (*AllocateCostResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *AllocateCostResponse) GetAllocation() *CostAllocation {
+	if x != nil {
+		return x.Allocation
+	}
+	return nil
+}
+
+type ListCostAllocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCostAllocationsRequest) Reset() {
+	*x = ListCostAllocationsRequest{}
+	mi := &file_finance_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCostAllocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCostAllocationsRequest) ProtoMessage() {}
+
+func (x *ListCostAllocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCostAllocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListCostAllocationsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{185}
+}
+
 func (x *ListCostAllocationsRequest) GetPage() *PageRequest {
 	if x != nil {
 		return x.Page
@@ -6736,29 +12863,269 @@ func (x *ListCostAllocationsRequest) GetPage() *PageRequest {
 	return nil
 }
 
-type ListCostAllocationsResponse struct {
+type ListCostAllocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allocations   []*CostAllocation      `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCostAllocationsResponse) Reset() {
+	*x = ListCostAllocationsResponse{}
+	mi := &file_finance_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCostAllocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCostAllocationsResponse) ProtoMessage() {}
+
+func (x *ListCostAllocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCostAllocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListCostAllocationsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *ListCostAllocationsResponse) GetAllocations() []*CostAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *ListCostAllocationsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Details       string                 `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // e.g., INVOICE
+	ResourceId    string                 `protobuf:"bytes,7,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_finance_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+type RecordAuditEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Event         *AuditEvent            `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAuditEventRequest) Reset() {
+	*x = RecordAuditEventRequest{}
+	mi := &file_finance_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAuditEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAuditEventRequest) ProtoMessage() {}
+
+func (x *RecordAuditEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAuditEventRequest.ProtoReflect.Descriptor instead.
+func (*RecordAuditEventRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *RecordAuditEventRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *RecordAuditEventRequest) GetEvent() *AuditEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_finance_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *ListAuditEventsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListAuditEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Allocations   []*CostAllocation      `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCostAllocationsResponse) Reset() {
-	*x = ListCostAllocationsResponse{}
-	mi := &file_finance_proto_msgTypes[100]
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_finance_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCostAllocationsResponse) String() string {
+func (x *ListAuditEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCostAllocationsResponse) ProtoMessage() {}
+func (*ListAuditEventsResponse) ProtoMessage() {}
 
-func (x *ListCostAllocationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[100]
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6769,53 +13136,47 @@ func (x *ListCostAllocationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCostAllocationsResponse.ProtoReflect.Descriptor instead.
-func (*ListCostAllocationsResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *ListCostAllocationsResponse) GetAllocations() []*CostAllocation {
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
 	if x != nil {
-		return x.Allocations
+		return x.Events
 	}
 	return nil
 }
 
-func (x *ListCostAllocationsResponse) GetPage() *PageResponse {
+func (x *ListAuditEventsResponse) GetPage() *PageResponse {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type AuditEvent struct {
+type GetAuditEventByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Details       string                 `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
-	ResourceType  string                 `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // e.g., INVOICE
-	ResourceId    string                 `protobuf:"bytes,7,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditEvent) Reset() {
-	*x = AuditEvent{}
-	mi := &file_finance_proto_msgTypes[101]
+func (x *GetAuditEventByIdRequest) Reset() {
+	*x = GetAuditEventByIdRequest{}
+	mi := &file_finance_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditEvent) String() string {
+func (x *GetAuditEventByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditEvent) ProtoMessage() {}
+func (*GetAuditEventByIdRequest) ProtoMessage() {}
 
-func (x *AuditEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[101]
+func (x *GetAuditEventByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6826,83 +13187,269 @@ func (x *AuditEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
-func (*AuditEvent) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use GetAuditEventByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditEventByIdRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *AuditEvent) GetId() string {
+func (x *GetAuditEventByIdRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetUserId() string {
+type FilterAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // optional
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                                 // optional
+	ResourceType  string                 `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // optional
+	ResourceId    string                 `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`       // optional
+	FromDate      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`             // optional
+	ToDate        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`                   // optional
+	Page          *PageRequest           `protobuf:"bytes,7,opt,name=page,proto3" json:"page,omitempty"`                                     // optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterAuditEventsRequest) Reset() {
+	*x = FilterAuditEventsRequest{}
+	mi := &file_finance_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterAuditEventsRequest) ProtoMessage() {}
+
+func (x *FilterAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*FilterAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *FilterAuditEventsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetAction() string {
+func (x *FilterAuditEventsRequest) GetAction() string {
 	if x != nil {
 		return x.Action
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetTimestamp() *timestamppb.Timestamp {
+func (x *FilterAuditEventsRequest) GetResourceType() string {
 	if x != nil {
-		return x.Timestamp
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *FilterAuditEventsRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *FilterAuditEventsRequest) GetFromDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromDate
 	}
 	return nil
 }
 
-func (x *AuditEvent) GetDetails() string {
+func (x *FilterAuditEventsRequest) GetToDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Details
+		return x.ToDate
+	}
+	return nil
+}
+
+func (x *FilterAuditEventsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type FilterAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterAuditEventsResponse) Reset() {
+	*x = FilterAuditEventsResponse{}
+	mi := &file_finance_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterAuditEventsResponse) ProtoMessage() {}
+
+func (x *FilterAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*FilterAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *FilterAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *FilterAuditEventsResponse) GetPage() *PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type Accrual struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	AccrualDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=accrual_date,json=accrualDate,proto3" json:"accrual_date,omitempty"`
+	AccountId     string                 `protobuf:"bytes,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // target account
+	Audit         *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Accrual) Reset() {
+	*x = Accrual{}
+	mi := &file_finance_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Accrual) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Accrual) ProtoMessage() {}
+
+func (x *Accrual) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Accrual.ProtoReflect.Descriptor instead.
+func (*Accrual) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *Accrual) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetResourceType() string {
+func (x *Accrual) GetDescription() string {
 	if x != nil {
-		return x.ResourceType
+		return x.Description
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetResourceId() string {
+func (x *Accrual) GetAmount() *money.Money {
 	if x != nil {
-		return x.ResourceId
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Accrual) GetAccrualDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccrualDate
+	}
+	return nil
+}
+
+func (x *Accrual) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
 	}
 	return ""
 }
 
-type RecordAuditEventRequest struct {
+func (x *Accrual) GetAudit() *AuditFields {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type CreateAccrualRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Event         *AuditEvent            `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Accrual       *Accrual               `protobuf:"bytes,2,opt,name=accrual,proto3" json:"accrual,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecordAuditEventRequest) Reset() {
-	*x = RecordAuditEventRequest{}
-	mi := &file_finance_proto_msgTypes[102]
+func (x *CreateAccrualRequest) Reset() {
+	*x = CreateAccrualRequest{}
+	mi := &file_finance_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecordAuditEventRequest) String() string {
+func (x *CreateAccrualRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordAuditEventRequest) ProtoMessage() {}
+func (*CreateAccrualRequest) ProtoMessage() {}
 
-func (x *RecordAuditEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[102]
+func (x *CreateAccrualRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6913,47 +13460,47 @@ func (x *RecordAuditEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordAuditEventRequest.ProtoReflect.Descriptor instead.
-func (*RecordAuditEventRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use CreateAccrualRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccrualRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *RecordAuditEventRequest) GetMeta() *RequestMetadata {
+func (x *CreateAccrualRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *RecordAuditEventRequest) GetEvent() *AuditEvent {
+func (x *CreateAccrualRequest) GetAccrual() *Accrual {
 	if x != nil {
-		return x.Event
+		return x.Accrual
 	}
 	return nil
 }
 
-type ListAuditEventsRequest struct {
+type GetAccrualByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAuditEventsRequest) Reset() {
-	*x = ListAuditEventsRequest{}
-	mi := &file_finance_proto_msgTypes[103]
+func (x *GetAccrualByIdRequest) Reset() {
+	*x = GetAccrualByIdRequest{}
+	mi := &file_finance_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAuditEventsRequest) String() string {
+func (x *GetAccrualByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAuditEventsRequest) ProtoMessage() {}
+func (*GetAccrualByIdRequest) ProtoMessage() {}
 
-func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[103]
+func (x *GetAccrualByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6964,41 +13511,42 @@ func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use GetAccrualByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetAccrualByIdRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *ListAuditEventsRequest) GetPage() *PageRequest {
+func (x *GetAccrualByIdRequest) GetId() string {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ListAuditEventsResponse struct {
+type UpdateAccrualRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Accrual       *Accrual               `protobuf:"bytes,2,opt,name=accrual,proto3" json:"accrual,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAuditEventsResponse) Reset() {
-	*x = ListAuditEventsResponse{}
-	mi := &file_finance_proto_msgTypes[104]
+func (x *UpdateAccrualRequest) Reset() {
+	*x = UpdateAccrualRequest{}
+	mi := &file_finance_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAuditEventsResponse) String() string {
+func (x *UpdateAccrualRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAuditEventsResponse) ProtoMessage() {}
+func (*UpdateAccrualRequest) ProtoMessage() {}
 
-func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[104]
+func (x *UpdateAccrualRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7009,47 +13557,55 @@ func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use UpdateAccrualRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccrualRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+func (x *UpdateAccrualRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Events
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListAuditEventsResponse) GetPage() *PageResponse {
+func (x *UpdateAccrualRequest) GetAccrual() *Accrual {
 	if x != nil {
-		return x.Page
+		return x.Accrual
 	}
 	return nil
 }
 
-type GetAuditEventByIdRequest struct {
+func (x *UpdateAccrualRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteAccrualRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAuditEventByIdRequest) Reset() {
-	*x = GetAuditEventByIdRequest{}
-	mi := &file_finance_proto_msgTypes[105]
+func (x *DeleteAccrualRequest) Reset() {
+	*x = DeleteAccrualRequest{}
+	mi := &file_finance_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAuditEventByIdRequest) String() string {
+func (x *DeleteAccrualRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAuditEventByIdRequest) ProtoMessage() {}
+func (*DeleteAccrualRequest) ProtoMessage() {}
 
-func (x *GetAuditEventByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[105]
+func (x *DeleteAccrualRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7060,46 +13616,47 @@ func (x *GetAuditEventByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAuditEventByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetAuditEventByIdRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use DeleteAccrualRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccrualRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *GetAuditEventByIdRequest) GetId() string {
+func (x *DeleteAccrualRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *DeleteAccrualRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type FilterAuditEventsRequest struct {
+type ListAccrualsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // optional
-	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                                 // optional
-	ResourceType  string                 `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // optional
-	ResourceId    string                 `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`       // optional
-	FromDate      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`             // optional
-	ToDate        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`                   // optional
-	Page          *PageRequest           `protobuf:"bytes,7,opt,name=page,proto3" json:"page,omitempty"`                                     // optional
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FilterAuditEventsRequest) Reset() {
-	*x = FilterAuditEventsRequest{}
-	mi := &file_finance_proto_msgTypes[106]
+func (x *ListAccrualsRequest) Reset() {
+	*x = ListAccrualsRequest{}
+	mi := &file_finance_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FilterAuditEventsRequest) String() string {
+func (x *ListAccrualsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterAuditEventsRequest) ProtoMessage() {}
+func (*ListAccrualsRequest) ProtoMessage() {}
 
-func (x *FilterAuditEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[106]
+func (x *ListAccrualsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7110,83 +13667,41 @@ func (x *FilterAuditEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterAuditEventsRequest.ProtoReflect.Descriptor instead.
-func (*FilterAuditEventsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{106}
-}
-
-func (x *FilterAuditEventsRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *FilterAuditEventsRequest) GetAction() string {
-	if x != nil {
-		return x.Action
-	}
-	return ""
-}
-
-func (x *FilterAuditEventsRequest) GetResourceType() string {
-	if x != nil {
-		return x.ResourceType
-	}
-	return ""
-}
-
-func (x *FilterAuditEventsRequest) GetResourceId() string {
-	if x != nil {
-		return x.ResourceId
-	}
-	return ""
-}
-
-func (x *FilterAuditEventsRequest) GetFromDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.FromDate
-	}
-	return nil
-}
-
-func (x *FilterAuditEventsRequest) GetToDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ToDate
-	}
-	return nil
+// Deprecated: Use ListAccrualsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccrualsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *FilterAuditEventsRequest) GetPage() *PageRequest {
+func (x *ListAccrualsRequest) GetPage() *PageRequest {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type FilterAuditEventsResponse struct {
+type ListAccrualsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Accruals      []*Accrual             `protobuf:"bytes,1,rep,name=accruals,proto3" json:"accruals,omitempty"`
 	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FilterAuditEventsResponse) Reset() {
-	*x = FilterAuditEventsResponse{}
-	mi := &file_finance_proto_msgTypes[107]
+func (x *ListAccrualsResponse) Reset() {
+	*x = ListAccrualsResponse{}
+	mi := &file_finance_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FilterAuditEventsResponse) String() string {
+func (x *ListAccrualsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterAuditEventsResponse) ProtoMessage() {}
+func (*ListAccrualsResponse) ProtoMessage() {}
 
-func (x *FilterAuditEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[107]
+func (x *ListAccrualsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7197,52 +13712,54 @@ func (x *FilterAuditEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterAuditEventsResponse.ProtoReflect.Descriptor instead.
-func (*FilterAuditEventsResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use ListAccrualsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccrualsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *FilterAuditEventsResponse) GetEvents() []*AuditEvent {
+func (x *ListAccrualsResponse) GetAccruals() []*Accrual {
 	if x != nil {
-		return x.Events
+		return x.Accruals
 	}
 	return nil
 }
 
-func (x *FilterAuditEventsResponse) GetPage() *PageResponse {
+func (x *ListAccrualsResponse) GetPage() *PageResponse {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type Accrual struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	AccrualDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=accrual_date,json=accrualDate,proto3" json:"accrual_date,omitempty"`
-	AccountId     string                 `protobuf:"bytes,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // target account
-	Audit         *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Automation rules (optional extension point)
+type AllocationRule struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Basis               string                 `protobuf:"bytes,3,opt,name=basis,proto3" json:"basis,omitempty"` // "ACTIVITY","HEADCOUNT","REVENUE","MACHINE_HOURS"
+	SourceAccountId     string                 `protobuf:"bytes,4,opt,name=source_account_id,json=sourceAccountId,proto3" json:"source_account_id,omitempty"`
+	TargetCostCenterIds []string               `protobuf:"bytes,5,rep,name=target_cost_center_ids,json=targetCostCenterIds,proto3" json:"target_cost_center_ids,omitempty"`
+	Formula             string                 `protobuf:"bytes,6,opt,name=formula,proto3" json:"formula,omitempty"` // DSL or expression
+	Audit               *AuditFields           `protobuf:"bytes,7,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *Accrual) Reset() {
-	*x = Accrual{}
-	mi := &file_finance_proto_msgTypes[108]
+func (x *AllocationRule) Reset() {
+	*x = AllocationRule{}
+	mi := &file_finance_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Accrual) String() string {
+func (x *AllocationRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Accrual) ProtoMessage() {}
+func (*AllocationRule) ProtoMessage() {}
 
-func (x *Accrual) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[108]
+func (x *AllocationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7253,76 +13770,83 @@ func (x *Accrual) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Accrual.ProtoReflect.Descriptor instead.
-func (*Accrual) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use AllocationRule.ProtoReflect.Descriptor instead.
+func (*AllocationRule) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *AllocationRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *Accrual) GetId() string {
+func (x *AllocationRule) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Accrual) GetDescription() string {
+func (x *AllocationRule) GetBasis() string {
 	if x != nil {
-		return x.Description
+		return x.Basis
 	}
 	return ""
 }
 
-func (x *Accrual) GetAmount() *money.Money {
+func (x *AllocationRule) GetSourceAccountId() string {
 	if x != nil {
-		return x.Amount
+		return x.SourceAccountId
 	}
-	return nil
+	return ""
 }
 
-func (x *Accrual) GetAccrualDate() *timestamppb.Timestamp {
+func (x *AllocationRule) GetTargetCostCenterIds() []string {
 	if x != nil {
-		return x.AccrualDate
+		return x.TargetCostCenterIds
 	}
 	return nil
 }
 
-func (x *Accrual) GetAccountId() string {
+func (x *AllocationRule) GetFormula() string {
 	if x != nil {
-		return x.AccountId
+		return x.Formula
 	}
 	return ""
 }
 
-func (x *Accrual) GetAudit() *AuditFields {
+func (x *AllocationRule) GetAudit() *AuditFields {
 	if x != nil {
 		return x.Audit
 	}
 	return nil
 }
 
-type CreateAccrualRequest struct {
+type CreateAllocationRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Accrual       *Accrual               `protobuf:"bytes,2,opt,name=accrual,proto3" json:"accrual,omitempty"`
+	Rule          *AllocationRule        `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAccrualRequest) Reset() {
-	*x = CreateAccrualRequest{}
-	mi := &file_finance_proto_msgTypes[109]
+func (x *CreateAllocationRuleRequest) Reset() {
+	*x = CreateAllocationRuleRequest{}
+	mi := &file_finance_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAccrualRequest) String() string {
+func (x *CreateAllocationRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAccrualRequest) ProtoMessage() {}
+func (*CreateAllocationRuleRequest) ProtoMessage() {}
 
-func (x *CreateAccrualRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[109]
+func (x *CreateAllocationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7333,47 +13857,47 @@ func (x *CreateAccrualRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAccrualRequest.ProtoReflect.Descriptor instead.
-func (*CreateAccrualRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use CreateAllocationRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAllocationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *CreateAccrualRequest) GetMeta() *RequestMetadata {
+func (x *CreateAllocationRuleRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *CreateAccrualRequest) GetAccrual() *Accrual {
+func (x *CreateAllocationRuleRequest) GetRule() *AllocationRule {
 	if x != nil {
-		return x.Accrual
+		return x.Rule
 	}
 	return nil
 }
 
-type GetAccrualByIdRequest struct {
+type GetAllocationRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAccrualByIdRequest) Reset() {
-	*x = GetAccrualByIdRequest{}
-	mi := &file_finance_proto_msgTypes[110]
+func (x *GetAllocationRuleRequest) Reset() {
+	*x = GetAllocationRuleRequest{}
+	mi := &file_finance_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAccrualByIdRequest) String() string {
+func (x *GetAllocationRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccrualByIdRequest) ProtoMessage() {}
+func (*GetAllocationRuleRequest) ProtoMessage() {}
 
-func (x *GetAccrualByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[110]
+func (x *GetAllocationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7384,42 +13908,42 @@ func (x *GetAccrualByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccrualByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetAccrualByIdRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use GetAllocationRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetAllocationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *GetAccrualByIdRequest) GetId() string {
+func (x *GetAllocationRuleRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type UpdateAccrualRequest struct {
+type UpdateAllocationRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Accrual       *Accrual               `protobuf:"bytes,2,opt,name=accrual,proto3" json:"accrual,omitempty"`
+	Rule          *AllocationRule        `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
 	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAccrualRequest) Reset() {
-	*x = UpdateAccrualRequest{}
-	mi := &file_finance_proto_msgTypes[111]
+func (x *UpdateAllocationRuleRequest) Reset() {
+	*x = UpdateAllocationRuleRequest{}
+	mi := &file_finance_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAccrualRequest) String() string {
+func (x *UpdateAllocationRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAccrualRequest) ProtoMessage() {}
+func (*UpdateAllocationRuleRequest) ProtoMessage() {}
 
-func (x *UpdateAccrualRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[111]
+func (x *UpdateAllocationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7430,33 +13954,33 @@ func (x *UpdateAccrualRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAccrualRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAccrualRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use UpdateAllocationRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAllocationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *UpdateAccrualRequest) GetMeta() *RequestMetadata {
+func (x *UpdateAllocationRuleRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *UpdateAccrualRequest) GetAccrual() *Accrual {
+func (x *UpdateAllocationRuleRequest) GetRule() *AllocationRule {
 	if x != nil {
-		return x.Accrual
+		return x.Rule
 	}
 	return nil
 }
 
-func (x *UpdateAccrualRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *UpdateAllocationRuleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.UpdateMask
 	}
 	return nil
 }
 
-type DeleteAccrualRequest struct {
+type DeleteAllocationRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -7464,21 +13988,21 @@ type DeleteAccrualRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAccrualRequest) Reset() {
-	*x = DeleteAccrualRequest{}
-	mi := &file_finance_proto_msgTypes[112]
+func (x *DeleteAllocationRuleRequest) Reset() {
+	*x = DeleteAllocationRuleRequest{}
+	mi := &file_finance_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAccrualRequest) String() string {
+func (x *DeleteAllocationRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccrualRequest) ProtoMessage() {}
+func (*DeleteAllocationRuleRequest) ProtoMessage() {}
 
-func (x *DeleteAccrualRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[112]
+func (x *DeleteAllocationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7489,47 +14013,47 @@ func (x *DeleteAccrualRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccrualRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAccrualRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use DeleteAllocationRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAllocationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *DeleteAccrualRequest) GetMeta() *RequestMetadata {
+func (x *DeleteAllocationRuleRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *DeleteAccrualRequest) GetId() string {
+func (x *DeleteAllocationRuleRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListAccrualsRequest struct {
+type ListAllocationRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAccrualsRequest) Reset() {
-	*x = ListAccrualsRequest{}
-	mi := &file_finance_proto_msgTypes[113]
+func (x *ListAllocationRulesRequest) Reset() {
+	*x = ListAllocationRulesRequest{}
+	mi := &file_finance_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAccrualsRequest) String() string {
+func (x *ListAllocationRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAccrualsRequest) ProtoMessage() {}
+func (*ListAllocationRulesRequest) ProtoMessage() {}
 
-func (x *ListAccrualsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[113]
+func (x *ListAllocationRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7540,186 +14064,93 @@ func (x *ListAccrualsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAccrualsRequest.ProtoReflect.Descriptor instead.
-func (*ListAccrualsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use ListAllocationRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAllocationRulesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *ListAccrualsRequest) GetPage() *PageRequest {
+func (x *ListAllocationRulesRequest) GetPage() *PageRequest {
 	if x != nil {
 		return x.Page
 	}
 	return nil
 }
 
-type ListAccrualsResponse struct {
+type ListAllocationRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Accruals      []*Accrual             `protobuf:"bytes,1,rep,name=accruals,proto3" json:"accruals,omitempty"`
+	Rules         []*AllocationRule      `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAccrualsResponse) Reset() {
-	*x = ListAccrualsResponse{}
-	mi := &file_finance_proto_msgTypes[114]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListAccrualsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListAccrualsResponse) ProtoMessage() {}
-
-func (x *ListAccrualsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[114]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListAccrualsResponse.ProtoReflect.Descriptor instead.
-func (*ListAccrualsResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{114}
-}
-
-func (x *ListAccrualsResponse) GetAccruals() []*Accrual {
-	if x != nil {
-		return x.Accruals
-	}
-	return nil
-}
-
-func (x *ListAccrualsResponse) GetPage() *PageResponse {
-	if x != nil {
-		return x.Page
-	}
-	return nil
-}
-
-// Automation rules (optional extension point)
-type AllocationRule struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Basis               string                 `protobuf:"bytes,3,opt,name=basis,proto3" json:"basis,omitempty"` // "ACTIVITY","HEADCOUNT","REVENUE","MACHINE_HOURS"
-	SourceAccountId     string                 `protobuf:"bytes,4,opt,name=source_account_id,json=sourceAccountId,proto3" json:"source_account_id,omitempty"`
-	TargetCostCenterIds []string               `protobuf:"bytes,5,rep,name=target_cost_center_ids,json=targetCostCenterIds,proto3" json:"target_cost_center_ids,omitempty"`
-	Formula             string                 `protobuf:"bytes,6,opt,name=formula,proto3" json:"formula,omitempty"` // DSL or expression
-	Audit               *AuditFields           `protobuf:"bytes,7,opt,name=audit,proto3" json:"audit,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
-}
-
-func (x *AllocationRule) Reset() {
-	*x = AllocationRule{}
-	mi := &file_finance_proto_msgTypes[115]
+func (x *ListAllocationRulesResponse) Reset() {
+	*x = ListAllocationRulesResponse{}
+	mi := &file_finance_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllocationRule) String() string {
+func (x *ListAllocationRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllocationRule) ProtoMessage() {}
-
-func (x *AllocationRule) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[115]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AllocationRule.ProtoReflect.Descriptor instead.
-func (*AllocationRule) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{115}
-}
-
-func (x *AllocationRule) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *AllocationRule) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *AllocationRule) GetBasis() string {
-	if x != nil {
-		return x.Basis
-	}
-	return ""
-}
+func (*ListAllocationRulesResponse) ProtoMessage() {}
 
-func (x *AllocationRule) GetSourceAccountId() string {
+func (x *ListAllocationRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[207]
 	if x != nil {
-		return x.SourceAccountId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AllocationRule) GetTargetCostCenterIds() []string {
-	if x != nil {
-		return x.TargetCostCenterIds
-	}
-	return nil
+// Deprecated: Use ListAllocationRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAllocationRulesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *AllocationRule) GetFormula() string {
+func (x *ListAllocationRulesResponse) GetRules() []*AllocationRule {
 	if x != nil {
-		return x.Formula
+		return x.Rules
 	}
-	return ""
+	return nil
 }
 
-func (x *AllocationRule) GetAudit() *AuditFields {
+func (x *ListAllocationRulesResponse) GetPage() *PageResponse {
 	if x != nil {
-		return x.Audit
+		return x.Page
 	}
 	return nil
 }
 
-type CreateAllocationRuleRequest struct {
+type ReportPeriod struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Rule          *AllocationRule        `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAllocationRuleRequest) Reset() {
-	*x = CreateAllocationRuleRequest{}
-	mi := &file_finance_proto_msgTypes[116]
+func (x *ReportPeriod) Reset() {
+	*x = ReportPeriod{}
+	mi := &file_finance_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAllocationRuleRequest) String() string {
+func (x *ReportPeriod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAllocationRuleRequest) ProtoMessage() {}
+func (*ReportPeriod) ProtoMessage() {}
 
-func (x *CreateAllocationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[116]
+func (x *ReportPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7730,47 +14161,49 @@ func (x *CreateAllocationRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAllocationRuleRequest.ProtoReflect.Descriptor instead.
-func (*CreateAllocationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use ReportPeriod.ProtoReflect.Descriptor instead.
+func (*ReportPeriod) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *CreateAllocationRuleRequest) GetMeta() *RequestMetadata {
+func (x *ReportPeriod) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Meta
+		return x.StartDate
 	}
 	return nil
 }
 
-func (x *CreateAllocationRuleRequest) GetRule() *AllocationRule {
+func (x *ReportPeriod) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Rule
+		return x.EndDate
 	}
 	return nil
 }
 
-type GetAllocationRuleRequest struct {
+type ProfitLossReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TotalRevenue  *money.Money           `protobuf:"bytes,1,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
+	TotalExpenses *money.Money           `protobuf:"bytes,2,opt,name=total_expenses,json=totalExpenses,proto3" json:"total_expenses,omitempty"`
+	NetProfit     *money.Money           `protobuf:"bytes,3,opt,name=net_profit,json=netProfit,proto3" json:"net_profit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllocationRuleRequest) Reset() {
-	*x = GetAllocationRuleRequest{}
-	mi := &file_finance_proto_msgTypes[117]
+func (x *ProfitLossReport) Reset() {
+	*x = ProfitLossReport{}
+	mi := &file_finance_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllocationRuleRequest) String() string {
+func (x *ProfitLossReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllocationRuleRequest) ProtoMessage() {}
+func (*ProfitLossReport) ProtoMessage() {}
 
-func (x *GetAllocationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[117]
+func (x *ProfitLossReport) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7781,42 +14214,56 @@ func (x *GetAllocationRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllocationRuleRequest.ProtoReflect.Descriptor instead.
-func (*GetAllocationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use ProfitLossReport.ProtoReflect.Descriptor instead.
+func (*ProfitLossReport) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *GetAllocationRuleRequest) GetId() string {
+func (x *ProfitLossReport) GetTotalRevenue() *money.Money {
 	if x != nil {
-		return x.Id
+		return x.TotalRevenue
 	}
-	return ""
+	return nil
 }
 
-type UpdateAllocationRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Rule          *AllocationRule        `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ProfitLossReport) GetTotalExpenses() *money.Money {
+	if x != nil {
+		return x.TotalExpenses
+	}
+	return nil
 }
 
-func (x *UpdateAllocationRuleRequest) Reset() {
-	*x = UpdateAllocationRuleRequest{}
-	mi := &file_finance_proto_msgTypes[118]
+func (x *ProfitLossReport) GetNetProfit() *money.Money {
+	if x != nil {
+		return x.NetProfit
+	}
+	return nil
+}
+
+type BalanceSheetReport struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TotalAssets      *money.Money           `protobuf:"bytes,1,opt,name=total_assets,json=totalAssets,proto3" json:"total_assets,omitempty"`
+	TotalLiabilities *money.Money           `protobuf:"bytes,2,opt,name=total_liabilities,json=totalLiabilities,proto3" json:"total_liabilities,omitempty"`
+	NetWorth         *money.Money           `protobuf:"bytes,3,opt,name=net_worth,json=netWorth,proto3" json:"net_worth,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BalanceSheetReport) Reset() {
+	*x = BalanceSheetReport{}
+	mi := &file_finance_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAllocationRuleRequest) String() string {
+func (x *BalanceSheetReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAllocationRuleRequest) ProtoMessage() {}
+func (*BalanceSheetReport) ProtoMessage() {}
 
-func (x *UpdateAllocationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[118]
+func (x *BalanceSheetReport) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7827,55 +14274,54 @@ func (x *UpdateAllocationRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAllocationRuleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAllocationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use BalanceSheetReport.ProtoReflect.Descriptor instead.
+func (*BalanceSheetReport) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *UpdateAllocationRuleRequest) GetMeta() *RequestMetadata {
+func (x *BalanceSheetReport) GetTotalAssets() *money.Money {
 	if x != nil {
-		return x.Meta
+		return x.TotalAssets
 	}
 	return nil
 }
 
-func (x *UpdateAllocationRuleRequest) GetRule() *AllocationRule {
+func (x *BalanceSheetReport) GetTotalLiabilities() *money.Money {
 	if x != nil {
-		return x.Rule
+		return x.TotalLiabilities
 	}
 	return nil
 }
 
-func (x *UpdateAllocationRuleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *BalanceSheetReport) GetNetWorth() *money.Money {
 	if x != nil {
-		return x.UpdateMask
+		return x.NetWorth
 	}
 	return nil
 }
 
-type DeleteAllocationRuleRequest struct {
+type TrialBalanceReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Entries       []*LedgerEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAllocationRuleRequest) Reset() {
-	*x = DeleteAllocationRuleRequest{}
-	mi := &file_finance_proto_msgTypes[119]
+func (x *TrialBalanceReport) Reset() {
+	*x = TrialBalanceReport{}
+	mi := &file_finance_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAllocationRuleRequest) String() string {
+func (x *TrialBalanceReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAllocationRuleRequest) ProtoMessage() {}
+func (*TrialBalanceReport) ProtoMessage() {}
 
-func (x *DeleteAllocationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[119]
+func (x *TrialBalanceReport) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7886,47 +14332,41 @@ func (x *DeleteAllocationRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAllocationRuleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAllocationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use TrialBalanceReport.ProtoReflect.Descriptor instead.
+func (*TrialBalanceReport) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *DeleteAllocationRuleRequest) GetMeta() *RequestMetadata {
+func (x *TrialBalanceReport) GetEntries() []*LedgerEntry {
 	if x != nil {
-		return x.Meta
+		return x.Entries
 	}
 	return nil
 }
 
-func (x *DeleteAllocationRuleRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-type ListAllocationRulesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ReportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Period         *ReportPeriod          `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListAllocationRulesRequest) Reset() {
-	*x = ListAllocationRulesRequest{}
-	mi := &file_finance_proto_msgTypes[120]
+func (x *ReportRequest) Reset() {
+	*x = ReportRequest{}
+	mi := &file_finance_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllocationRulesRequest) String() string {
+func (x *ReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllocationRulesRequest) ProtoMessage() {}
+func (*ReportRequest) ProtoMessage() {}
 
-func (x *ListAllocationRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[120]
+func (x *ReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7937,41 +14377,48 @@ func (x *ListAllocationRulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllocationRulesRequest.ProtoReflect.Descriptor instead.
-func (*ListAllocationRulesRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
+func (*ReportRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *ListAllocationRulesRequest) GetPage() *PageRequest {
+func (x *ReportRequest) GetPeriod() *ReportPeriod {
 	if x != nil {
-		return x.Page
+		return x.Period
 	}
 	return nil
 }
 
-type ListAllocationRulesResponse struct {
+func (x *ReportRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ComplianceReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rules         []*AllocationRule      `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Period        *ReportPeriod          `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Jurisdiction  string                 `protobuf:"bytes,2,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"` // "IN-GST", "US-GAAP", "IFRS"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllocationRulesResponse) Reset() {
-	*x = ListAllocationRulesResponse{}
-	mi := &file_finance_proto_msgTypes[121]
+func (x *ComplianceReportRequest) Reset() {
+	*x = ComplianceReportRequest{}
+	mi := &file_finance_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllocationRulesResponse) String() string {
+func (x *ComplianceReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllocationRulesResponse) ProtoMessage() {}
+func (*ComplianceReportRequest) ProtoMessage() {}
 
-func (x *ListAllocationRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[121]
+func (x *ComplianceReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7982,48 +14429,47 @@ func (x *ListAllocationRulesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllocationRulesResponse.ProtoReflect.Descriptor instead.
-func (*ListAllocationRulesResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use ComplianceReportRequest.ProtoReflect.Descriptor instead.
+func (*ComplianceReportRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *ListAllocationRulesResponse) GetRules() []*AllocationRule {
+func (x *ComplianceReportRequest) GetPeriod() *ReportPeriod {
 	if x != nil {
-		return x.Rules
+		return x.Period
 	}
 	return nil
 }
 
-func (x *ListAllocationRulesResponse) GetPage() *PageResponse {
+func (x *ComplianceReportRequest) GetJurisdiction() string {
 	if x != nil {
-		return x.Page
+		return x.Jurisdiction
 	}
-	return nil
+	return ""
 }
 
-type ReportPeriod struct {
+type ComplianceReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Details       string                 `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportPeriod) Reset() {
-	*x = ReportPeriod{}
-	mi := &file_finance_proto_msgTypes[122]
+func (x *ComplianceReport) Reset() {
+	*x = ComplianceReport{}
+	mi := &file_finance_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportPeriod) String() string {
+func (x *ComplianceReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportPeriod) ProtoMessage() {}
+func (*ComplianceReport) ProtoMessage() {}
 
-func (x *ReportPeriod) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[122]
+func (x *ComplianceReport) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8034,49 +14480,43 @@ func (x *ReportPeriod) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportPeriod.ProtoReflect.Descriptor instead.
-func (*ReportPeriod) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{122}
-}
-
-func (x *ReportPeriod) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
+// Deprecated: Use ComplianceReport.ProtoReflect.Descriptor instead.
+func (*ComplianceReport) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *ReportPeriod) GetEndDate() *timestamppb.Timestamp {
+func (x *ComplianceReport) GetDetails() string {
 	if x != nil {
-		return x.EndDate
+		return x.Details
 	}
-	return nil
+	return ""
 }
 
-type ProfitLossReport struct {
+type Consolidation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TotalRevenue  *money.Money           `protobuf:"bytes,1,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
-	TotalExpenses *money.Money           `protobuf:"bytes,2,opt,name=total_expenses,json=totalExpenses,proto3" json:"total_expenses,omitempty"`
-	NetProfit     *money.Money           `protobuf:"bytes,3,opt,name=net_profit,json=netProfit,proto3" json:"net_profit,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntityIds     []string               `protobuf:"bytes,2,rep,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	Period        *ReportPeriod          `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Report        string                 `protobuf:"bytes,4,opt,name=report,proto3" json:"report,omitempty"` // JSON/text report
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfitLossReport) Reset() {
-	*x = ProfitLossReport{}
-	mi := &file_finance_proto_msgTypes[123]
+func (x *Consolidation) Reset() {
+	*x = Consolidation{}
+	mi := &file_finance_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfitLossReport) String() string {
+func (x *Consolidation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfitLossReport) ProtoMessage() {}
+func (*Consolidation) ProtoMessage() {}
 
-func (x *ProfitLossReport) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[123]
+func (x *Consolidation) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8087,56 +14527,61 @@ func (x *ProfitLossReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfitLossReport.ProtoReflect.Descriptor instead.
-func (*ProfitLossReport) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{123}
+// Deprecated: Use Consolidation.ProtoReflect.Descriptor instead.
+func (*Consolidation) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{215}
 }
 
-func (x *ProfitLossReport) GetTotalRevenue() *money.Money {
+func (x *Consolidation) GetId() string {
 	if x != nil {
-		return x.TotalRevenue
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ProfitLossReport) GetTotalExpenses() *money.Money {
+func (x *Consolidation) GetEntityIds() []string {
 	if x != nil {
-		return x.TotalExpenses
+		return x.EntityIds
 	}
 	return nil
 }
 
-func (x *ProfitLossReport) GetNetProfit() *money.Money {
+func (x *Consolidation) GetPeriod() *ReportPeriod {
 	if x != nil {
-		return x.NetProfit
+		return x.Period
 	}
 	return nil
 }
 
-type BalanceSheetReport struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	TotalAssets      *money.Money           `protobuf:"bytes,1,opt,name=total_assets,json=totalAssets,proto3" json:"total_assets,omitempty"`
-	TotalLiabilities *money.Money           `protobuf:"bytes,2,opt,name=total_liabilities,json=totalLiabilities,proto3" json:"total_liabilities,omitempty"`
-	NetWorth         *money.Money           `protobuf:"bytes,3,opt,name=net_worth,json=netWorth,proto3" json:"net_worth,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *Consolidation) GetReport() string {
+	if x != nil {
+		return x.Report
+	}
+	return ""
 }
 
-func (x *BalanceSheetReport) Reset() {
-	*x = BalanceSheetReport{}
-	mi := &file_finance_proto_msgTypes[124]
+type CreateConsolidationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consolidation *Consolidation         `protobuf:"bytes,1,opt,name=consolidation,proto3" json:"consolidation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConsolidationRequest) Reset() {
+	*x = CreateConsolidationRequest{}
+	mi := &file_finance_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BalanceSheetReport) String() string {
+func (x *CreateConsolidationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BalanceSheetReport) ProtoMessage() {}
+func (*CreateConsolidationRequest) ProtoMessage() {}
 
-func (x *BalanceSheetReport) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[124]
+func (x *CreateConsolidationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8147,54 +14592,40 @@ func (x *BalanceSheetReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BalanceSheetReport.ProtoReflect.Descriptor instead.
-func (*BalanceSheetReport) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{124}
-}
-
-func (x *BalanceSheetReport) GetTotalAssets() *money.Money {
-	if x != nil {
-		return x.TotalAssets
-	}
-	return nil
-}
-
-func (x *BalanceSheetReport) GetTotalLiabilities() *money.Money {
-	if x != nil {
-		return x.TotalLiabilities
-	}
-	return nil
+// Deprecated: Use CreateConsolidationRequest.ProtoReflect.Descriptor instead.
+func (*CreateConsolidationRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *BalanceSheetReport) GetNetWorth() *money.Money {
+func (x *CreateConsolidationRequest) GetConsolidation() *Consolidation {
 	if x != nil {
-		return x.NetWorth
+		return x.Consolidation
 	}
 	return nil
 }
 
-type TrialBalanceReport struct {
+type GetConsolidationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entries       []*LedgerEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TrialBalanceReport) Reset() {
-	*x = TrialBalanceReport{}
-	mi := &file_finance_proto_msgTypes[125]
+func (x *GetConsolidationRequest) Reset() {
+	*x = GetConsolidationRequest{}
+	mi := &file_finance_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TrialBalanceReport) String() string {
+func (x *GetConsolidationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrialBalanceReport) ProtoMessage() {}
+func (*GetConsolidationRequest) ProtoMessage() {}
 
-func (x *TrialBalanceReport) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[125]
+func (x *GetConsolidationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8205,41 +14636,42 @@ func (x *TrialBalanceReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrialBalanceReport.ProtoReflect.Descriptor instead.
-func (*TrialBalanceReport) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{125}
+// Deprecated: Use GetConsolidationRequest.ProtoReflect.Descriptor instead.
+func (*GetConsolidationRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{217}
 }
 
-func (x *TrialBalanceReport) GetEntries() []*LedgerEntry {
+func (x *GetConsolidationRequest) GetId() string {
 	if x != nil {
-		return x.Entries
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ReportRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Period         *ReportPeriod          `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
-	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListConsolidationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Period        *ReportPeriod          `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	EntityIds     []string               `protobuf:"bytes,3,rep,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportRequest) Reset() {
-	*x = ReportRequest{}
-	mi := &file_finance_proto_msgTypes[126]
+func (x *ListConsolidationsRequest) Reset() {
+	*x = ListConsolidationsRequest{}
+	mi := &file_finance_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportRequest) String() string {
+func (x *ListConsolidationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportRequest) ProtoMessage() {}
+func (*ListConsolidationsRequest) ProtoMessage() {}
 
-func (x *ReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[126]
+func (x *ListConsolidationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8250,48 +14682,55 @@ func (x *ReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
-func (*ReportRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{126}
+// Deprecated: Use ListConsolidationsRequest.ProtoReflect.Descriptor instead.
+func (*ListConsolidationsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *ReportRequest) GetPeriod() *ReportPeriod {
+func (x *ListConsolidationsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListConsolidationsRequest) GetPeriod() *ReportPeriod {
 	if x != nil {
 		return x.Period
 	}
 	return nil
 }
 
-func (x *ReportRequest) GetOrganizationId() string {
+func (x *ListConsolidationsRequest) GetEntityIds() []string {
 	if x != nil {
-		return x.OrganizationId
+		return x.EntityIds
 	}
-	return ""
+	return nil
 }
 
-type ComplianceReportRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Period        *ReportPeriod          `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
-	Jurisdiction  string                 `protobuf:"bytes,2,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"` // "IN-GST", "US-GAAP", "IFRS"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListConsolidationsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Consolidations []*Consolidation       `protobuf:"bytes,1,rep,name=consolidations,proto3" json:"consolidations,omitempty"`
+	Page           *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ComplianceReportRequest) Reset() {
-	*x = ComplianceReportRequest{}
-	mi := &file_finance_proto_msgTypes[127]
+func (x *ListConsolidationsResponse) Reset() {
+	*x = ListConsolidationsResponse{}
+	mi := &file_finance_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ComplianceReportRequest) String() string {
+func (x *ListConsolidationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComplianceReportRequest) ProtoMessage() {}
+func (*ListConsolidationsResponse) ProtoMessage() {}
 
-func (x *ComplianceReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[127]
+func (x *ListConsolidationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8302,47 +14741,47 @@ func (x *ComplianceReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComplianceReportRequest.ProtoReflect.Descriptor instead.
-func (*ComplianceReportRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use ListConsolidationsResponse.ProtoReflect.Descriptor instead.
+func (*ListConsolidationsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *ComplianceReportRequest) GetPeriod() *ReportPeriod {
+func (x *ListConsolidationsResponse) GetConsolidations() []*Consolidation {
 	if x != nil {
-		return x.Period
+		return x.Consolidations
 	}
 	return nil
 }
 
-func (x *ComplianceReportRequest) GetJurisdiction() string {
+func (x *ListConsolidationsResponse) GetPage() *PageResponse {
 	if x != nil {
-		return x.Jurisdiction
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-type ComplianceReport struct {
+type DeleteConsolidationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Details       string                 `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ComplianceReport) Reset() {
-	*x = ComplianceReport{}
-	mi := &file_finance_proto_msgTypes[128]
+func (x *DeleteConsolidationRequest) Reset() {
+	*x = DeleteConsolidationRequest{}
+	mi := &file_finance_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ComplianceReport) String() string {
+func (x *DeleteConsolidationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComplianceReport) ProtoMessage() {}
-
-func (x *ComplianceReport) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[128]
+func (*DeleteConsolidationRequest) ProtoMessage() {}
+
+func (x *DeleteConsolidationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8353,43 +14792,41 @@ func (x *ComplianceReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComplianceReport.ProtoReflect.Descriptor instead.
-func (*ComplianceReport) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use DeleteConsolidationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConsolidationRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{220}
 }
 
-func (x *ComplianceReport) GetDetails() string {
+func (x *DeleteConsolidationRequest) GetId() string {
 	if x != nil {
-		return x.Details
+		return x.Id
 	}
 	return ""
 }
 
-type Consolidation struct {
+type ConsolidationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EntityIds     []string               `protobuf:"bytes,2,rep,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
-	Period        *ReportPeriod          `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
-	Report        string                 `protobuf:"bytes,4,opt,name=report,proto3" json:"report,omitempty"` // JSON/text report
+	EntityIds     []string               `protobuf:"bytes,1,rep,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	Period        *ReportPeriod          `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Consolidation) Reset() {
-	*x = Consolidation{}
-	mi := &file_finance_proto_msgTypes[129]
+func (x *ConsolidationRequest) Reset() {
+	*x = ConsolidationRequest{}
+	mi := &file_finance_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Consolidation) String() string {
+func (x *ConsolidationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Consolidation) ProtoMessage() {}
+func (*ConsolidationRequest) ProtoMessage() {}
 
-func (x *Consolidation) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[129]
+func (x *ConsolidationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8400,61 +14837,48 @@ func (x *Consolidation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Consolidation.ProtoReflect.Descriptor instead.
-func (*Consolidation) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{129}
-}
-
-func (x *Consolidation) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ConsolidationRequest.ProtoReflect.Descriptor instead.
+func (*ConsolidationRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{221}
 }
 
-func (x *Consolidation) GetEntityIds() []string {
+func (x *ConsolidationRequest) GetEntityIds() []string {
 	if x != nil {
 		return x.EntityIds
 	}
 	return nil
 }
 
-func (x *Consolidation) GetPeriod() *ReportPeriod {
+func (x *ConsolidationRequest) GetPeriod() *ReportPeriod {
 	if x != nil {
 		return x.Period
 	}
 	return nil
 }
 
-func (x *Consolidation) GetReport() string {
-	if x != nil {
-		return x.Report
-	}
-	return ""
-}
-
-type CreateConsolidationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Consolidation *Consolidation         `protobuf:"bytes,1,opt,name=consolidation,proto3" json:"consolidation,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ConsolidationResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ConsolidatedReport string                 `protobuf:"bytes,1,opt,name=consolidated_report,json=consolidatedReport,proto3" json:"consolidated_report,omitempty"` // could be JSON/text
+	Consolidations     []*Consolidation       `protobuf:"bytes,2,rep,name=consolidations,proto3" json:"consolidations,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CreateConsolidationRequest) Reset() {
-	*x = CreateConsolidationRequest{}
-	mi := &file_finance_proto_msgTypes[130]
+func (x *ConsolidationResponse) Reset() {
+	*x = ConsolidationResponse{}
+	mi := &file_finance_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateConsolidationRequest) String() string {
+func (x *ConsolidationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateConsolidationRequest) ProtoMessage() {}
+func (*ConsolidationResponse) ProtoMessage() {}
 
-func (x *CreateConsolidationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[130]
+func (x *ConsolidationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8465,40 +14889,53 @@ func (x *CreateConsolidationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateConsolidationRequest.ProtoReflect.Descriptor instead.
-func (*CreateConsolidationRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{130}
+// Deprecated: Use ConsolidationResponse.ProtoReflect.Descriptor instead.
+func (*ConsolidationResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{222}
 }
 
-func (x *CreateConsolidationRequest) GetConsolidation() *Consolidation {
+func (x *ConsolidationResponse) GetConsolidatedReport() string {
 	if x != nil {
-		return x.Consolidation
+		return x.ConsolidatedReport
+	}
+	return ""
+}
+
+func (x *ConsolidationResponse) GetConsolidations() []*Consolidation {
+	if x != nil {
+		return x.Consolidations
 	}
 	return nil
 }
 
-type GetConsolidationRequest struct {
+type ExchangeRate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`    // e.g., "USD"
+	QuoteCurrency string                 `protobuf:"bytes,3,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"` // e.g., "INR"
+	Rate          float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`                                      // quote per base (e.g., 83.50)
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Audit         *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
+	RateType      string                 `protobuf:"bytes,7,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"` // SPOT (default), CLOSING or AVERAGE
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetConsolidationRequest) Reset() {
-	*x = GetConsolidationRequest{}
-	mi := &file_finance_proto_msgTypes[131]
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_finance_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConsolidationRequest) String() string {
+func (x *ExchangeRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConsolidationRequest) ProtoMessage() {}
+func (*ExchangeRate) ProtoMessage() {}
 
-func (x *GetConsolidationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[131]
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8509,101 +14946,83 @@ func (x *GetConsolidationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConsolidationRequest.ProtoReflect.Descriptor instead.
-func (*GetConsolidationRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{131}
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *GetConsolidationRequest) GetId() string {
+func (x *ExchangeRate) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListConsolidationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
-	Period        *ReportPeriod          `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
-	EntityIds     []string               `protobuf:"bytes,3,rep,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListConsolidationsRequest) Reset() {
-	*x = ListConsolidationsRequest{}
-	mi := &file_finance_proto_msgTypes[132]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListConsolidationsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExchangeRate) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
 }
 
-func (*ListConsolidationsRequest) ProtoMessage() {}
-
-func (x *ListConsolidationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[132]
+func (x *ExchangeRate) GetQuoteCurrency() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.QuoteCurrency
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListConsolidationsRequest.ProtoReflect.Descriptor instead.
-func (*ListConsolidationsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{132}
+func (x *ExchangeRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
 }
 
-func (x *ListConsolidationsRequest) GetPage() *PageRequest {
+func (x *ExchangeRate) GetAsOf() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Page
+		return x.AsOf
 	}
 	return nil
 }
 
-func (x *ListConsolidationsRequest) GetPeriod() *ReportPeriod {
+func (x *ExchangeRate) GetAudit() *AuditFields {
 	if x != nil {
-		return x.Period
+		return x.Audit
 	}
 	return nil
 }
 
-func (x *ListConsolidationsRequest) GetEntityIds() []string {
+func (x *ExchangeRate) GetRateType() string {
 	if x != nil {
-		return x.EntityIds
+		return x.RateType
 	}
-	return nil
+	return ""
 }
 
-type ListConsolidationsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Consolidations []*Consolidation       `protobuf:"bytes,1,rep,name=consolidations,proto3" json:"consolidations,omitempty"`
-	Page           *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CreateExchangeRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Rate          *ExchangeRate          `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListConsolidationsResponse) Reset() {
-	*x = ListConsolidationsResponse{}
-	mi := &file_finance_proto_msgTypes[133]
+func (x *CreateExchangeRateRequest) Reset() {
+	*x = CreateExchangeRateRequest{}
+	mi := &file_finance_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListConsolidationsResponse) String() string {
+func (x *CreateExchangeRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConsolidationsResponse) ProtoMessage() {}
+func (*CreateExchangeRateRequest) ProtoMessage() {}
 
-func (x *ListConsolidationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[133]
+func (x *CreateExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8614,47 +15033,47 @@ func (x *ListConsolidationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConsolidationsResponse.ProtoReflect.Descriptor instead.
-func (*ListConsolidationsResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{133}
+// Deprecated: Use CreateExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*CreateExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *ListConsolidationsResponse) GetConsolidations() []*Consolidation {
+func (x *CreateExchangeRateRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Consolidations
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListConsolidationsResponse) GetPage() *PageResponse {
+func (x *CreateExchangeRateRequest) GetRate() *ExchangeRate {
 	if x != nil {
-		return x.Page
+		return x.Rate
 	}
 	return nil
 }
 
-type DeleteConsolidationRequest struct {
+type GetExchangeRateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteConsolidationRequest) Reset() {
-	*x = DeleteConsolidationRequest{}
-	mi := &file_finance_proto_msgTypes[134]
+func (x *GetExchangeRateRequest) Reset() {
+	*x = GetExchangeRateRequest{}
+	mi := &file_finance_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteConsolidationRequest) String() string {
+func (x *GetExchangeRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteConsolidationRequest) ProtoMessage() {}
+func (*GetExchangeRateRequest) ProtoMessage() {}
 
-func (x *DeleteConsolidationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[134]
+func (x *GetExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8665,41 +15084,42 @@ func (x *DeleteConsolidationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteConsolidationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteConsolidationRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use GetExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *DeleteConsolidationRequest) GetId() string {
+func (x *GetExchangeRateRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ConsolidationRequest struct {
+type UpdateExchangeRateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EntityIds     []string               `protobuf:"bytes,1,rep,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
-	Period        *ReportPeriod          `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Rate          *ExchangeRate          `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConsolidationRequest) Reset() {
-	*x = ConsolidationRequest{}
-	mi := &file_finance_proto_msgTypes[135]
+func (x *UpdateExchangeRateRequest) Reset() {
+	*x = UpdateExchangeRateRequest{}
+	mi := &file_finance_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConsolidationRequest) String() string {
+func (x *UpdateExchangeRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsolidationRequest) ProtoMessage() {}
+func (*UpdateExchangeRateRequest) ProtoMessage() {}
 
-func (x *ConsolidationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[135]
+func (x *UpdateExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8710,48 +15130,55 @@ func (x *ConsolidationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsolidationRequest.ProtoReflect.Descriptor instead.
-func (*ConsolidationRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{135}
+// Deprecated: Use UpdateExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *UpdateExchangeRateRequest) GetMeta() *RequestMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
 }
 
-func (x *ConsolidationRequest) GetEntityIds() []string {
+func (x *UpdateExchangeRateRequest) GetRate() *ExchangeRate {
 	if x != nil {
-		return x.EntityIds
+		return x.Rate
 	}
 	return nil
 }
 
-func (x *ConsolidationRequest) GetPeriod() *ReportPeriod {
+func (x *UpdateExchangeRateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Period
+		return x.UpdateMask
 	}
 	return nil
 }
 
-type ConsolidationResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ConsolidatedReport string                 `protobuf:"bytes,1,opt,name=consolidated_report,json=consolidatedReport,proto3" json:"consolidated_report,omitempty"` // could be JSON/text
-	Consolidations     []*Consolidation       `protobuf:"bytes,2,rep,name=consolidations,proto3" json:"consolidations,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type DeleteExchangeRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConsolidationResponse) Reset() {
-	*x = ConsolidationResponse{}
-	mi := &file_finance_proto_msgTypes[136]
+func (x *DeleteExchangeRateRequest) Reset() {
+	*x = DeleteExchangeRateRequest{}
+	mi := &file_finance_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConsolidationResponse) String() string {
+func (x *DeleteExchangeRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsolidationResponse) ProtoMessage() {}
+func (*DeleteExchangeRateRequest) ProtoMessage() {}
 
-func (x *ConsolidationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[136]
+func (x *DeleteExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8762,52 +15189,49 @@ func (x *ConsolidationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsolidationResponse.ProtoReflect.Descriptor instead.
-func (*ConsolidationResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{136}
+// Deprecated: Use DeleteExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{227}
 }
 
-func (x *ConsolidationResponse) GetConsolidatedReport() string {
+func (x *DeleteExchangeRateRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.ConsolidatedReport
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-func (x *ConsolidationResponse) GetConsolidations() []*Consolidation {
+func (x *DeleteExchangeRateRequest) GetId() string {
 	if x != nil {
-		return x.Consolidations
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ExchangeRate struct {
+type ListExchangeRatesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	BaseCurrency  string                 `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`    // e.g., "USD"
-	QuoteCurrency string                 `protobuf:"bytes,3,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"` // e.g., "INR"
-	Rate          float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`                                      // quote per base (e.g., 83.50)
-	AsOf          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
-	Audit         *AuditFields           `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty"`
+	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,3,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExchangeRate) Reset() {
-	*x = ExchangeRate{}
-	mi := &file_finance_proto_msgTypes[137]
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_finance_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExchangeRate) String() string {
+func (x *ListExchangeRatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExchangeRate) ProtoMessage() {}
+func (*ListExchangeRatesRequest) ProtoMessage() {}
 
-func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[137]
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8818,76 +15242,108 @@ func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
-func (*ExchangeRate) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{137}
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{228}
 }
 
-func (x *ExchangeRate) GetId() string {
+func (x *ListExchangeRatesRequest) GetPage() *PageRequest {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-func (x *ExchangeRate) GetBaseCurrency() string {
+func (x *ListExchangeRatesRequest) GetBaseCurrency() string {
 	if x != nil {
 		return x.BaseCurrency
 	}
 	return ""
 }
 
-func (x *ExchangeRate) GetQuoteCurrency() string {
+func (x *ListExchangeRatesRequest) GetQuoteCurrency() string {
 	if x != nil {
 		return x.QuoteCurrency
 	}
 	return ""
 }
 
-func (x *ExchangeRate) GetRate() float64 {
+type ListExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesResponse) Reset() {
+	*x = ListExchangeRatesResponse{}
+	mi := &file_finance_proto_msgTypes[229]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[229]
 	if x != nil {
-		return x.Rate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ExchangeRate) GetAsOf() *timestamppb.Timestamp {
+// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *ListExchangeRatesResponse) GetRates() []*ExchangeRate {
 	if x != nil {
-		return x.AsOf
+		return x.Rates
 	}
 	return nil
 }
 
-func (x *ExchangeRate) GetAudit() *AuditFields {
+func (x *ListExchangeRatesResponse) GetPage() *PageResponse {
 	if x != nil {
-		return x.Audit
+		return x.Page
 	}
 	return nil
 }
 
-type CreateExchangeRateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Rate          *ExchangeRate          `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ConvertMoneyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Amount         *money.Money           `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	TargetCurrency string                 `protobuf:"bytes,2,opt,name=target_currency,json=targetCurrency,proto3" json:"target_currency,omitempty"`
+	AsOf           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateExchangeRateRequest) Reset() {
-	*x = CreateExchangeRateRequest{}
-	mi := &file_finance_proto_msgTypes[138]
+func (x *ConvertMoneyRequest) Reset() {
+	*x = ConvertMoneyRequest{}
+	mi := &file_finance_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateExchangeRateRequest) String() string {
+func (x *ConvertMoneyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExchangeRateRequest) ProtoMessage() {}
+func (*ConvertMoneyRequest) ProtoMessage() {}
 
-func (x *CreateExchangeRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[138]
+func (x *ConvertMoneyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8898,47 +15354,55 @@ func (x *CreateExchangeRateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExchangeRateRequest.ProtoReflect.Descriptor instead.
-func (*CreateExchangeRateRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{138}
+// Deprecated: Use ConvertMoneyRequest.ProtoReflect.Descriptor instead.
+func (*ConvertMoneyRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{230}
 }
 
-func (x *CreateExchangeRateRequest) GetMeta() *RequestMetadata {
+func (x *ConvertMoneyRequest) GetAmount() *money.Money {
 	if x != nil {
-		return x.Meta
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *CreateExchangeRateRequest) GetRate() *ExchangeRate {
+func (x *ConvertMoneyRequest) GetTargetCurrency() string {
 	if x != nil {
-		return x.Rate
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+func (x *ConvertMoneyRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
 	}
 	return nil
 }
 
-type GetExchangeRateRequest struct {
+type ConvertMoneyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Converted     *money.Money           `protobuf:"bytes,1,opt,name=converted,proto3" json:"converted,omitempty"`
+	RateUsed      float64                `protobuf:"fixed64,2,opt,name=rate_used,json=rateUsed,proto3" json:"rate_used,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangeRateRequest) Reset() {
-	*x = GetExchangeRateRequest{}
-	mi := &file_finance_proto_msgTypes[139]
+func (x *ConvertMoneyResponse) Reset() {
+	*x = ConvertMoneyResponse{}
+	mi := &file_finance_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangeRateRequest) String() string {
+func (x *ConvertMoneyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangeRateRequest) ProtoMessage() {}
+func (*ConvertMoneyResponse) ProtoMessage() {}
 
-func (x *GetExchangeRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[139]
+func (x *ConvertMoneyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8949,42 +15413,54 @@ func (x *GetExchangeRateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangeRateRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangeRateRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{139}
+// Deprecated: Use ConvertMoneyResponse.ProtoReflect.Descriptor instead.
+func (*ConvertMoneyResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{231}
 }
 
-func (x *GetExchangeRateRequest) GetId() string {
+func (x *ConvertMoneyResponse) GetConverted() *money.Money {
 	if x != nil {
-		return x.Id
+		return x.Converted
 	}
-	return ""
+	return nil
 }
 
-type UpdateExchangeRateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Rate          *ExchangeRate          `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ConvertMoneyResponse) GetRateUsed() float64 {
+	if x != nil {
+		return x.RateUsed
+	}
+	return 0
 }
 
-func (x *UpdateExchangeRateRequest) Reset() {
-	*x = UpdateExchangeRateRequest{}
-	mi := &file_finance_proto_msgTypes[140]
+// Accounts the period-end revaluation books to. Ledger accounts of
+// foreign-currency bank accounts and the receivable/payable accounts of open
+// payment dues are revalued without being listed in account_ids.
+type FxRevaluationSettings struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId          string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UnrealizedGainAccountId string                 `protobuf:"bytes,2,opt,name=unrealized_gain_account_id,json=unrealizedGainAccountId,proto3" json:"unrealized_gain_account_id,omitempty"`
+	UnrealizedLossAccountId string                 `protobuf:"bytes,3,opt,name=unrealized_loss_account_id,json=unrealizedLossAccountId,proto3" json:"unrealized_loss_account_id,omitempty"`
+	AccountIds              []string               `protobuf:"bytes,4,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"` // further ledger accounts to revalue
+	Audit                   *AuditFields           `protobuf:"bytes,5,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *FxRevaluationSettings) Reset() {
+	*x = FxRevaluationSettings{}
+	mi := &file_finance_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExchangeRateRequest) String() string {
+func (x *FxRevaluationSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExchangeRateRequest) ProtoMessage() {}
+func (*FxRevaluationSettings) ProtoMessage() {}
 
-func (x *UpdateExchangeRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[140]
+func (x *FxRevaluationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8995,55 +15471,69 @@ func (x *UpdateExchangeRateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExchangeRateRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExchangeRateRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{140}
+// Deprecated: Use FxRevaluationSettings.ProtoReflect.Descriptor instead.
+func (*FxRevaluationSettings) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{232}
 }
 
-func (x *UpdateExchangeRateRequest) GetMeta() *RequestMetadata {
+func (x *FxRevaluationSettings) GetOrganizationId() string {
 	if x != nil {
-		return x.Meta
+		return x.OrganizationId
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateExchangeRateRequest) GetRate() *ExchangeRate {
+func (x *FxRevaluationSettings) GetUnrealizedGainAccountId() string {
 	if x != nil {
-		return x.Rate
+		return x.UnrealizedGainAccountId
+	}
+	return ""
+}
+
+func (x *FxRevaluationSettings) GetUnrealizedLossAccountId() string {
+	if x != nil {
+		return x.UnrealizedLossAccountId
+	}
+	return ""
+}
+
+func (x *FxRevaluationSettings) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
 	}
 	return nil
 }
 
-func (x *UpdateExchangeRateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *FxRevaluationSettings) GetAudit() *AuditFields {
 	if x != nil {
-		return x.UpdateMask
+		return x.Audit
 	}
 	return nil
 }
 
-type DeleteExchangeRateRequest struct {
+type SetFxRevaluationSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Settings      *FxRevaluationSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExchangeRateRequest) Reset() {
-	*x = DeleteExchangeRateRequest{}
-	mi := &file_finance_proto_msgTypes[141]
+func (x *SetFxRevaluationSettingsRequest) Reset() {
+	*x = SetFxRevaluationSettingsRequest{}
+	mi := &file_finance_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExchangeRateRequest) String() string {
+func (x *SetFxRevaluationSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExchangeRateRequest) ProtoMessage() {}
+func (*SetFxRevaluationSettingsRequest) ProtoMessage() {}
 
-func (x *DeleteExchangeRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[141]
+func (x *SetFxRevaluationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9054,49 +15544,47 @@ func (x *DeleteExchangeRateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExchangeRateRequest.ProtoReflect.Descriptor instead.
-func (*DeleteExchangeRateRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{141}
+// Deprecated: Use SetFxRevaluationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetFxRevaluationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{233}
 }
 
-func (x *DeleteExchangeRateRequest) GetMeta() *RequestMetadata {
+func (x *SetFxRevaluationSettingsRequest) GetMeta() *RequestMetadata {
 	if x != nil {
 		return x.Meta
 	}
 	return nil
 }
 
-func (x *DeleteExchangeRateRequest) GetId() string {
+func (x *SetFxRevaluationSettingsRequest) GetSettings() *FxRevaluationSettings {
 	if x != nil {
-		return x.Id
+		return x.Settings
 	}
-	return ""
+	return nil
 }
 
-type ListExchangeRatesRequest struct {
+type GetFxRevaluationSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
-	BaseCurrency  string                 `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
-	QuoteCurrency string                 `protobuf:"bytes,3,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExchangeRatesRequest) Reset() {
-	*x = ListExchangeRatesRequest{}
-	mi := &file_finance_proto_msgTypes[142]
+func (x *GetFxRevaluationSettingsRequest) Reset() {
+	*x = GetFxRevaluationSettingsRequest{}
+	mi := &file_finance_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExchangeRatesRequest) String() string {
+func (x *GetFxRevaluationSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExchangeRatesRequest) ProtoMessage() {}
+func (*GetFxRevaluationSettingsRequest) ProtoMessage() {}
 
-func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[142]
+func (x *GetFxRevaluationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9107,55 +15595,42 @@ func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
-func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{142}
+// Deprecated: Use GetFxRevaluationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetFxRevaluationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{234}
 }
 
-func (x *ListExchangeRatesRequest) GetPage() *PageRequest {
+func (x *GetFxRevaluationSettingsRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Page
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListExchangeRatesRequest) GetBaseCurrency() string {
-	if x != nil {
-		return x.BaseCurrency
-	}
-	return ""
-}
-
-func (x *ListExchangeRatesRequest) GetQuoteCurrency() string {
-	if x != nil {
-		return x.QuoteCurrency
-	}
-	return ""
-}
-
-type ListExchangeRatesResponse struct {
+type RevalueForeignCurrencyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
-	Page          *PageResponse          `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	Meta          *RequestMetadata       `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	RateType      string                 `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"` // CLOSING when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListExchangeRatesResponse) Reset() {
-	*x = ListExchangeRatesResponse{}
-	mi := &file_finance_proto_msgTypes[143]
+func (x *RevalueForeignCurrencyRequest) Reset() {
+	*x = RevalueForeignCurrencyRequest{}
+	mi := &file_finance_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListExchangeRatesResponse) String() string {
+func (x *RevalueForeignCurrencyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListExchangeRatesResponse) ProtoMessage() {}
+func (*RevalueForeignCurrencyRequest) ProtoMessage() {}
 
-func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[143]
+func (x *RevalueForeignCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9166,49 +15641,64 @@ func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
-func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{143}
+// Deprecated: Use RevalueForeignCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*RevalueForeignCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{235}
 }
 
-func (x *ListExchangeRatesResponse) GetRates() []*ExchangeRate {
+func (x *RevalueForeignCurrencyRequest) GetMeta() *RequestMetadata {
 	if x != nil {
-		return x.Rates
+		return x.Meta
 	}
 	return nil
 }
 
-func (x *ListExchangeRatesResponse) GetPage() *PageResponse {
+func (x *RevalueForeignCurrencyRequest) GetAsOf() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Page
+		return x.AsOf
 	}
 	return nil
 }
 
-type ConvertMoneyRequest struct {
+func (x *RevalueForeignCurrencyRequest) GetRateType() string {
+	if x != nil {
+		return x.RateType
+	}
+	return ""
+}
+
+// Amounts are debit-positive: a receivable or asset balance is positive, a
+// payable negative. difference is revalued_amount - carrying_amount.
+type FxRevaluationItem struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Amount         *money.Money           `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	TargetCurrency string                 `protobuf:"bytes,2,opt,name=target_currency,json=targetCurrency,proto3" json:"target_currency,omitempty"`
-	AsOf           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Source         string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // ACCOUNT, BANK_ACCOUNT or PAYMENT_DUE
+	SourceId       string                 `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	AccountId      string                 `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Balance        *money.Money           `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`                                     // in the item's currency
+	CarryingAmount *money.Money           `protobuf:"bytes,6,opt,name=carrying_amount,json=carryingAmount,proto3" json:"carrying_amount,omitempty"` // functional, at booked rates
+	Rate           string                 `protobuf:"bytes,7,opt,name=rate,proto3" json:"rate,omitempty"`
+	RevaluedAmount *money.Money           `protobuf:"bytes,8,opt,name=revalued_amount,json=revaluedAmount,proto3" json:"revalued_amount,omitempty"` // functional, at rate
+	Difference     *money.Money           `protobuf:"bytes,9,opt,name=difference,proto3" json:"difference,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ConvertMoneyRequest) Reset() {
-	*x = ConvertMoneyRequest{}
-	mi := &file_finance_proto_msgTypes[144]
+func (x *FxRevaluationItem) Reset() {
+	*x = FxRevaluationItem{}
+	mi := &file_finance_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConvertMoneyRequest) String() string {
+func (x *FxRevaluationItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConvertMoneyRequest) ProtoMessage() {}
+func (*FxRevaluationItem) ProtoMessage() {}
 
-func (x *ConvertMoneyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[144]
+func (x *FxRevaluationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9219,55 +15709,100 @@ func (x *ConvertMoneyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConvertMoneyRequest.ProtoReflect.Descriptor instead.
-func (*ConvertMoneyRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{144}
+// Deprecated: Use FxRevaluationItem.ProtoReflect.Descriptor instead.
+func (*FxRevaluationItem) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{236}
 }
 
-func (x *ConvertMoneyRequest) GetAmount() *money.Money {
+func (x *FxRevaluationItem) GetSource() string {
 	if x != nil {
-		return x.Amount
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FxRevaluationItem) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *FxRevaluationItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FxRevaluationItem) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *FxRevaluationItem) GetBalance() *money.Money {
+	if x != nil {
+		return x.Balance
 	}
 	return nil
 }
 
-func (x *ConvertMoneyRequest) GetTargetCurrency() string {
+func (x *FxRevaluationItem) GetCarryingAmount() *money.Money {
 	if x != nil {
-		return x.TargetCurrency
+		return x.CarryingAmount
+	}
+	return nil
+}
+
+func (x *FxRevaluationItem) GetRate() string {
+	if x != nil {
+		return x.Rate
 	}
 	return ""
 }
 
-func (x *ConvertMoneyRequest) GetAsOf() *timestamppb.Timestamp {
+func (x *FxRevaluationItem) GetRevaluedAmount() *money.Money {
 	if x != nil {
-		return x.AsOf
+		return x.RevaluedAmount
 	}
 	return nil
 }
 
-type ConvertMoneyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Converted     *money.Money           `protobuf:"bytes,1,opt,name=converted,proto3" json:"converted,omitempty"`
-	RateUsed      float64                `protobuf:"fixed64,2,opt,name=rate_used,json=rateUsed,proto3" json:"rate_used,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FxRevaluationItem) GetDifference() *money.Money {
+	if x != nil {
+		return x.Difference
+	}
+	return nil
 }
 
-func (x *ConvertMoneyResponse) Reset() {
-	*x = ConvertMoneyResponse{}
-	mi := &file_finance_proto_msgTypes[145]
+type RevalueForeignCurrencyResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AsOf               *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	RateType           string                 `protobuf:"bytes,2,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
+	FunctionalCurrency string                 `protobuf:"bytes,3,opt,name=functional_currency,json=functionalCurrency,proto3" json:"functional_currency,omitempty"`
+	Items              []*FxRevaluationItem   `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Journal            *JournalEntry          `protobuf:"bytes,5,opt,name=journal,proto3" json:"journal,omitempty"` // DRAFT, auto-reversing; unset when nothing changed
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RevalueForeignCurrencyResponse) Reset() {
+	*x = RevalueForeignCurrencyResponse{}
+	mi := &file_finance_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConvertMoneyResponse) String() string {
+func (x *RevalueForeignCurrencyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConvertMoneyResponse) ProtoMessage() {}
+func (*RevalueForeignCurrencyResponse) ProtoMessage() {}
 
-func (x *ConvertMoneyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[145]
+func (x *RevalueForeignCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9278,23 +15813,44 @@ func (x *ConvertMoneyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConvertMoneyResponse.ProtoReflect.Descriptor instead.
-func (*ConvertMoneyResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{145}
+// Deprecated: Use RevalueForeignCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*RevalueForeignCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{237}
 }
 
-func (x *ConvertMoneyResponse) GetConverted() *money.Money {
+func (x *RevalueForeignCurrencyResponse) GetAsOf() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Converted
+		return x.AsOf
 	}
 	return nil
 }
 
-func (x *ConvertMoneyResponse) GetRateUsed() float64 {
+func (x *RevalueForeignCurrencyResponse) GetRateType() string {
 	if x != nil {
-		return x.RateUsed
+		return x.RateType
 	}
-	return 0
+	return ""
+}
+
+func (x *RevalueForeignCurrencyResponse) GetFunctionalCurrency() string {
+	if x != nil {
+		return x.FunctionalCurrency
+	}
+	return ""
+}
+
+func (x *RevalueForeignCurrencyResponse) GetItems() []*FxRevaluationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RevalueForeignCurrencyResponse) GetJournal() *JournalEntry {
+	if x != nil {
+		return x.Journal
+	}
+	return nil
 }
 
 type CashFlowForecastRequest struct {
@@ -9306,7 +15862,7 @@ type CashFlowForecastRequest struct {
 
 func (x *CashFlowForecastRequest) Reset() {
 	*x = CashFlowForecastRequest{}
-	mi := &file_finance_proto_msgTypes[146]
+	mi := &file_finance_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9318,7 +15874,7 @@ func (x *CashFlowForecastRequest) String() string {
 func (*CashFlowForecastRequest) ProtoMessage() {}
 
 func (x *CashFlowForecastRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[146]
+	mi := &file_finance_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9331,7 +15887,7 @@ func (x *CashFlowForecastRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CashFlowForecastRequest.ProtoReflect.Descriptor instead.
 func (*CashFlowForecastRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{146}
+	return file_finance_proto_rawDescGZIP(), []int{238}
 }
 
 func (x *CashFlowForecastRequest) GetPeriod() *ReportPeriod {
@@ -9350,7 +15906,7 @@ type CashFlowForecastResponse struct {
 
 func (x *CashFlowForecastResponse) Reset() {
 	*x = CashFlowForecastResponse{}
-	mi := &file_finance_proto_msgTypes[147]
+	mi := &file_finance_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9362,7 +15918,7 @@ func (x *CashFlowForecastResponse) String() string {
 func (*CashFlowForecastResponse) ProtoMessage() {}
 
 func (x *CashFlowForecastResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[147]
+	mi := &file_finance_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9375,7 +15931,7 @@ func (x *CashFlowForecastResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CashFlowForecastResponse.ProtoReflect.Descriptor instead.
 func (*CashFlowForecastResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{147}
+	return file_finance_proto_rawDescGZIP(), []int{239}
 }
 
 func (x *CashFlowForecastResponse) GetForecastDetails() string {
@@ -9398,7 +15954,7 @@ type FinanceInvoiceCreatedEvent struct {
 
 func (x *FinanceInvoiceCreatedEvent) Reset() {
 	*x = FinanceInvoiceCreatedEvent{}
-	mi := &file_finance_proto_msgTypes[148]
+	mi := &file_finance_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9410,7 +15966,7 @@ func (x *FinanceInvoiceCreatedEvent) String() string {
 func (*FinanceInvoiceCreatedEvent) ProtoMessage() {}
 
 func (x *FinanceInvoiceCreatedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[148]
+	mi := &file_finance_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9423,7 +15979,7 @@ func (x *FinanceInvoiceCreatedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinanceInvoiceCreatedEvent.ProtoReflect.Descriptor instead.
 func (*FinanceInvoiceCreatedEvent) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{148}
+	return file_finance_proto_rawDescGZIP(), []int{240}
 }
 
 func (x *FinanceInvoiceCreatedEvent) GetInvoiceId() string {
@@ -9475,7 +16031,7 @@ type FinancePaymentReceivedEvent struct {
 
 func (x *FinancePaymentReceivedEvent) Reset() {
 	*x = FinancePaymentReceivedEvent{}
-	mi := &file_finance_proto_msgTypes[149]
+	mi := &file_finance_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9487,7 +16043,7 @@ func (x *FinancePaymentReceivedEvent) String() string {
 func (*FinancePaymentReceivedEvent) ProtoMessage() {}
 
 func (x *FinancePaymentReceivedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[149]
+	mi := &file_finance_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9500,7 +16056,7 @@ func (x *FinancePaymentReceivedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinancePaymentReceivedEvent.ProtoReflect.Descriptor instead.
 func (*FinancePaymentReceivedEvent) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{149}
+	return file_finance_proto_rawDescGZIP(), []int{241}
 }
 
 func (x *FinancePaymentReceivedEvent) GetPaymentDueId() string {
@@ -9558,7 +16114,7 @@ type InventoryCostPostedEvent struct {
 
 func (x *InventoryCostPostedEvent) Reset() {
 	*x = InventoryCostPostedEvent{}
-	mi := &file_finance_proto_msgTypes[150]
+	mi := &file_finance_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9570,7 +16126,7 @@ func (x *InventoryCostPostedEvent) String() string {
 func (*InventoryCostPostedEvent) ProtoMessage() {}
 
 func (x *InventoryCostPostedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[150]
+	mi := &file_finance_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9583,7 +16139,7 @@ func (x *InventoryCostPostedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InventoryCostPostedEvent.ProtoReflect.Descriptor instead.
 func (*InventoryCostPostedEvent) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{150}
+	return file_finance_proto_rawDescGZIP(), []int{242}
 }
 
 func (x *InventoryCostPostedEvent) GetReferenceType() string {
@@ -9634,7 +16190,7 @@ type PayrollPostedEvent struct {
 
 func (x *PayrollPostedEvent) Reset() {
 	*x = PayrollPostedEvent{}
-	mi := &file_finance_proto_msgTypes[151]
+	mi := &file_finance_proto_msgTypes[243]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9646,7 +16202,7 @@ func (x *PayrollPostedEvent) String() string {
 func (*PayrollPostedEvent) ProtoMessage() {}
 
 func (x *PayrollPostedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[151]
+	mi := &file_finance_proto_msgTypes[243]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9659,7 +16215,7 @@ func (x *PayrollPostedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayrollPostedEvent.ProtoReflect.Descriptor instead.
 func (*PayrollPostedEvent) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{151}
+	return file_finance_proto_rawDescGZIP(), []int{243}
 }
 
 func (x *PayrollPostedEvent) GetPayrollRunId() string {
@@ -9703,13 +16259,14 @@ type VendorBillApprovedEvent struct {
 	Amount         *money.Money           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	ApprovedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,4,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	PartyId        string                 `protobuf:"bytes,5,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"` // the vendor, from the party master
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *VendorBillApprovedEvent) Reset() {
 	*x = VendorBillApprovedEvent{}
-	mi := &file_finance_proto_msgTypes[152]
+	mi := &file_finance_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9721,7 +16278,7 @@ func (x *VendorBillApprovedEvent) String() string {
 func (*VendorBillApprovedEvent) ProtoMessage() {}
 
 func (x *VendorBillApprovedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[152]
+	mi := &file_finance_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9734,7 +16291,7 @@ func (x *VendorBillApprovedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VendorBillApprovedEvent.ProtoReflect.Descriptor instead.
 func (*VendorBillApprovedEvent) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{152}
+	return file_finance_proto_rawDescGZIP(), []int{244}
 }
 
 func (x *VendorBillApprovedEvent) GetVendorBillId() string {
@@ -9765,6 +16322,13 @@ func (x *VendorBillApprovedEvent) GetOrganizationId() string {
 	return ""
 }
 
+func (x *VendorBillApprovedEvent) GetPartyId() string {
+	if x != nil {
+		return x.PartyId
+	}
+	return ""
+}
+
 var File_finance_proto protoreflect.FileDescriptor
 
 const file_finance_proto_rawDesc = "" +
@@ -9818,7 +16382,7 @@ const file_finance_proto_rawDesc = "" +
 	"\fGstTaxRegime\x12\x14\n" +
 	"\x05gstin\x18\x01 \x01(\tR\x05gstin\x12&\n" +
 	"\x0fplace_of_supply\x18\x02 \x01(\tR\rplaceOfSupply\x12%\n" +
-	"\x0ereverse_charge\x18\x03 \x01(\bR\rreverseCharge\"\xca\x05\n" +
+	"\x0ereverse_charge\x18\x03 \x01(\bR\rreverseCharge\"\xf0\x05\n" +
 	"\fGstDocStatus\x12M\n" +
 	"\x0feinvoice_status\x18\x01 \x01(\x0e2$.finance.GstDocStatus.EInvoiceStatusR\x0eeinvoiceStatus\x12\x10\n" +
 	"\x03irn\x18\x02 \x01(\tR\x03irn\x12\x15\n" +
@@ -9831,7 +16395,9 @@ const file_finance_proto_rawDesc = "" +
 	"\x0feway_valid_upto\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rewayValidUpto\x12\x1d\n" +
 	"\n" +
 	"last_error\x18\b \x01(\tR\tlastError\x12@\n" +
-	"\x0elast_synced_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\flastSyncedAt\"x\n" +
+	"\x0elast_synced_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\flastSyncedAt\x12$\n" +
+	"\x0esigned_qr_code\x18\n" +
+	" \x01(\tR\fsignedQrCode\"x\n" +
 	"\x0eEInvoiceStatus\x12\x1b\n" +
 	"\x17EINV_STATUS_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fEINV_PENDING\x10\x01\x12\x12\n" +
@@ -9845,7 +16411,7 @@ const file_finance_proto_rawDesc = "" +
 	"\x0eEWAY_GENERATED\x10\x02\x12\x10\n" +
 	"\fEWAY_EXPIRED\x10\x03\x12\x12\n" +
 	"\x0eEWAY_CANCELLED\x10\x04\x12\x0f\n" +
-	"\vEWAY_FAILED\x10\x05\"\x9f\x03\n" +
+	"\vEWAY_FAILED\x10\x05\"\xbe\x03\n" +
 	"\vInvoiceItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -9860,8 +16426,9 @@ const file_finance_proto_rawDesc = "" +
 	"\n" +
 	"line_total\x18\t \x01(\v2\x12.google.type.MoneyR\tlineTotal\x12$\n" +
 	"\x0ecost_center_id\x18\n" +
-	" \x01(\tR\fcostCenterId\"\xba\n" +
+	" \x01(\tR\fcostCenterId\x12\x1d\n" +
 	"\n" +
+	"account_id\x18\f \x01(\tR\taccountId\"\xcd\x0f\n" +
 	"\aInvoice\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0einvoice_number\x18\x02 \x01(\tR\rinvoiceNumber\x12(\n" +
@@ -9895,7 +16462,26 @@ const file_finance_proto_rawDesc = "" +
 	"grandTotal\x12*\n" +
 	"\x05audit\x18\x1c \x01(\v2\x14.finance.AuditFieldsR\x05audit\x12'\n" +
 	"\x03gst\x18\x1d \x01(\v2\x15.finance.GstTaxRegimeR\x03gst\x120\n" +
-	"\bgst_docs\x18\x1e \x01(\v2\x15.finance.GstDocStatusR\agstDocs\"p\n" +
+	"\bgst_docs\x18\x1e \x01(\v2\x15.finance.GstDocStatusR\agstDocs\x127\n" +
+	"\tissued_at\x18\x1f \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x12\x1b\n" +
+	"\tissued_by\x18  \x01(\tR\bissuedBy\x127\n" +
+	"\tvoided_at\x18! \x01(\v2\x1a.google.protobuf.TimestampR\bvoidedAt\x12\x1b\n" +
+	"\tvoided_by\x18\" \x01(\tR\bvoidedBy\x12\x1f\n" +
+	"\vvoid_reason\x18# \x01(\tR\n" +
+	"voidReason\x129\n" +
+	"\n" +
+	"overdue_at\x18$ \x01(\v2\x1a.google.protobuf.TimestampR\toverdueAt\x12\x1d\n" +
+	"\n" +
+	"overdue_by\x18% \x01(\tR\toverdueBy\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18& \x01(\tR\tjournalId\x12\x19\n" +
+	"\bparty_id\x18( \x01(\tR\apartyId\x12,\n" +
+	"\x12credit_approved_by\x18) \x01(\tR\x10creditApprovedBy\x12H\n" +
+	"\x12credit_approved_at\x18* \x01(\v2\x1a.google.protobuf.TimestampR\x10creditApprovedAt\x129\n" +
+	"\x0ecredited_total\x18+ \x01(\v2\x12.google.type.MoneyR\rcreditedTotal\x127\n" +
+	"\rdebited_total\x18, \x01(\v2\x12.google.type.MoneyR\fdebitedTotal\x121\n" +
+	"\n" +
+	"net_billed\x18- \x01(\v2\x12.google.type.MoneyR\tnetBilledJ\x04\b'\x10(R\vcustomer_id\"p\n" +
 	"\x14CreateInvoiceRequest\x12,\n" +
 	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12*\n" +
 	"\ainvoice\x18\x02 \x01(\v2\x10.finance.InvoiceR\ainvoice\"Q\n" +
@@ -9914,10 +16500,288 @@ const file_finance_proto_rawDesc = "" +
 	"\x04page\x18\x01 \x01(\v2\x14.finance.PageRequestR\x04page\"o\n" +
 	"\x14ListInvoicesResponse\x12,\n" +
 	"\binvoices\x18\x01 \x03(\v2\x10.finance.InvoiceR\binvoices\x12)\n" +
-	"\x04page\x18\x02 \x01(\v2\x15.finance.PageResponseR\x04page\"W\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.finance.PageResponseR\x04page\"\x85\x01\n" +
 	"\x15SearchInvoicesRequest\x12(\n" +
 	"\x04page\x18\x01 \x01(\v2\x14.finance.PageRequestR\x04page\x12\x14\n" +
-	"\x05query\x18\x02 \x01(\tR\x05query\"\xd7\x01\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12,\n" +
+	"\x04meta\x18\x03 \x01(\v2\x18.finance.RequestMetadataR\x04meta\"S\n" +
+	"\x13IssueInvoiceRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"j\n" +
+	"\x12VoidInvoiceRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x83\x01\n" +
+	"\x12MarkOverdueRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12/\n" +
+	"\x05as_of\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\x82\x04\n" +
+	"\x12InvoicePostingRule\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x127\n" +
+	"\finvoice_type\x18\x02 \x01(\x0e2\x14.finance.InvoiceTypeR\vinvoiceType\x12,\n" +
+	"\x12control_account_id\x18\x03 \x01(\tR\x10controlAccountId\x12&\n" +
+	"\x0fline_account_id\x18\x04 \x01(\tR\rlineAccountId\x12&\n" +
+	"\x0fcgst_account_id\x18\x05 \x01(\tR\rcgstAccountId\x12&\n" +
+	"\x0fsgst_account_id\x18\x06 \x01(\tR\rsgstAccountId\x12&\n" +
+	"\x0figst_account_id\x18\a \x01(\tR\rigstAccountId\x12/\n" +
+	"\x14other_tax_account_id\x18\b \x01(\tR\x11otherTaxAccountId\x12.\n" +
+	"\x13discount_account_id\x18\t \x01(\tR\x11discountAccountId\x12/\n" +
+	"\x14round_off_account_id\x18\n" +
+	" \x01(\tR\x11roundOffAccountId\x12*\n" +
+	"\x05audit\x18\v \x01(\v2\x14.finance.AuditFieldsR\x05audit\"}\n" +
+	"\x1cSetInvoicePostingRuleRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12/\n" +
+	"\x04rule\x18\x02 \x01(\v2\x1b.finance.InvoicePostingRuleR\x04rule\"\x85\x01\n" +
+	"\x1cGetInvoicePostingRuleRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x127\n" +
+	"\finvoice_type\x18\x02 \x01(\x0e2\x14.finance.InvoiceTypeR\vinvoiceType\"\xbd\x01\n" +
+	"\x13InvoiceNumberSeries\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x127\n" +
+	"\finvoice_type\x18\x02 \x01(\x0e2\x14.finance.InvoiceTypeR\vinvoiceType\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x12*\n" +
+	"\x05audit\x18\x04 \x01(\v2\x14.finance.AuditFieldsR\x05audit\"\xd4\x02\n" +
+	"\x0fInvoiceTemplate\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12!\n" +
+	"\fcompany_name\x18\x02 \x01(\tR\vcompanyName\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04logo\x18\x04 \x01(\fR\x04logo\x12!\n" +
+	"\fbank_details\x18\x05 \x01(\tR\vbankDetails\x12\x14\n" +
+	"\x05terms\x18\x06 \x01(\tR\x05terms\x12\x16\n" +
+	"\x06footer\x18\a \x01(\tR\x06footer\x12*\n" +
+	"\x05audit\x18\b \x01(\v2\x14.finance.AuditFieldsR\x05audit\x12!\n" +
+	"\fcountry_code\x18\t \x01(\tR\vcountryCode\x12'\n" +
+	"\x0fpeppol_endpoint\x18\n" +
+	" \x01(\tR\x0epeppolEndpoint\"\x7f\n" +
+	"\x19SetInvoiceTemplateRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x124\n" +
+	"\btemplate\x18\x02 \x01(\v2\x18.finance.InvoiceTemplateR\btemplate\"I\n" +
+	"\x19GetInvoiceTemplateRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\"T\n" +
+	"\x14RenderInvoiceRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x87\x01\n" +
+	"\x0fRenderedInvoice\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12\x1a\n" +
+	"\brevision\x18\x04 \x01(\x05R\brevision\"T\n" +
+	"\x14ExportInvoiceRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"^\n" +
+	"\x14ImportInvoiceRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"\x83\x01\n" +
+	"\x1dSetInvoiceNumberSeriesRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x124\n" +
+	"\x06series\x18\x02 \x01(\v2\x1c.finance.InvoiceNumberSeriesR\x06series\"\x86\x01\n" +
+	"\x1dGetInvoiceNumberSeriesRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x127\n" +
+	"\finvoice_type\x18\x02 \x01(\x0e2\x14.finance.InvoiceTypeR\vinvoiceType\"\x8f\x01\n" +
+	"\fPartyContact\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12 \n" +
+	"\vdesignation\x18\x04 \x01(\tR\vdesignation\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x05 \x01(\bR\tisPrimary\"\x90\x02\n" +
+	"\fPartyAddress\x12(\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.finance.AddressKindR\x04kind\x12\x14\n" +
+	"\x05line1\x18\x02 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x03 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"state_code\x18\x06 \x01(\tR\tstateCode\x12\x1f\n" +
+	"\vpostal_code\x18\a \x01(\tR\n" +
+	"postalCode\x12!\n" +
+	"\fcountry_code\x18\b \x01(\tR\vcountryCode\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\t \x01(\bR\tisDefault\"h\n" +
+	"\x14PartyTaxRegistration\x12\x19\n" +
+	"\btax_type\x18\x01 \x01(\tR\ataxType\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\x12\x1d\n" +
+	"\n" +
+	"state_code\x18\x03 \x01(\tR\tstateCode\"\xc4\x01\n" +
+	"\x10PartyBankAccount\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12%\n" +
+	"\x0eaccount_number\x18\x02 \x01(\tR\raccountNumber\x12\x12\n" +
+	"\x04ifsc\x18\x03 \x01(\tR\x04ifsc\x12\x1b\n" +
+	"\tbank_name\x18\x04 \x01(\tR\bbankName\x12\x16\n" +
+	"\x06branch\x18\x05 \x01(\tR\x06branch\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x06 \x01(\bR\tisPrimary\"\xed\x05\n" +
+	"\x05Party\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12&\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x12.finance.PartyKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"legal_name\x18\x05 \x01(\tR\tlegalName\x12\x10\n" +
+	"\x03pan\x18\x06 \x01(\tR\x03pan\x12)\n" +
+	"\x10default_currency\x18\a \x01(\tR\x0fdefaultCurrency\x12,\n" +
+	"\x12payment_terms_days\x18\b \x01(\x05R\x10paymentTermsDays\x12\x16\n" +
+	"\x06active\x18\t \x01(\bR\x06active\x121\n" +
+	"\bcontacts\x18\n" +
+	" \x03(\v2\x15.finance.PartyContactR\bcontacts\x123\n" +
+	"\taddresses\x18\v \x03(\v2\x15.finance.PartyAddressR\taddresses\x12J\n" +
+	"\x11tax_registrations\x18\f \x03(\v2\x1d.finance.PartyTaxRegistrationR\x10taxRegistrations\x12>\n" +
+	"\rbank_accounts\x18\r \x03(\v2\x19.finance.PartyBankAccountR\fbankAccounts\x12*\n" +
+	"\x05audit\x18\x0e \x01(\v2\x14.finance.AuditFieldsR\x05audit\x125\n" +
+	"\fcredit_limit\x18\x0f \x01(\v2\x12.google.type.MoneyR\vcreditLimit\x12\x1f\n" +
+	"\vcredit_hold\x18\x10 \x01(\bR\n" +
+	"creditHold\x12,\n" +
+	"\x12credit_hold_reason\x18\x11 \x01(\tR\x10creditHoldReason\x12'\n" +
+	"\x0fpeppol_endpoint\x18\x12 \x01(\tR\x0epeppolEndpoint\"h\n" +
+	"\x12CreatePartyRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12$\n" +
+	"\x05party\x18\x02 \x01(\v2\x0e.finance.PartyR\x05party\"O\n" +
+	"\x0fGetPartyRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xa5\x01\n" +
+	"\x12UpdatePartyRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12$\n" +
+	"\x05party\x18\x02 \x01(\v2\x0e.finance.PartyR\x05party\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"R\n" +
+	"\x12DeletePartyRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xaa\x01\n" +
+	"\x12ListPartiesRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12&\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x12.finance.PartyKindR\x04kind\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12(\n" +
+	"\x04page\x18\x04 \x01(\v2\x14.finance.PageRequestR\x04page\"j\n" +
+	"\x13ListPartiesResponse\x12(\n" +
+	"\aparties\x18\x01 \x03(\v2\x0e.finance.PartyR\aparties\x12)\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.finance.PageResponseR\x04page\"\xbf\x01\n" +
+	"\fCreditPolicy\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12+\n" +
+	"\x11threshold_percent\x18\x02 \x01(\tR\x10thresholdPercent\x12-\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x15.finance.CreditActionR\x06action\x12*\n" +
+	"\x05audit\x18\x04 \x01(\v2\x14.finance.AuditFieldsR\x05audit\"\xae\x03\n" +
+	"\x0eCreditExposure\x12\x19\n" +
+	"\bparty_id\x18\x01 \x01(\tR\apartyId\x125\n" +
+	"\fcredit_limit\x18\x02 \x01(\v2\x12.google.type.MoneyR\vcreditLimit\x12\x1f\n" +
+	"\vcredit_hold\x18\x03 \x01(\bR\n" +
+	"creditHold\x12/\n" +
+	"\topen_dues\x18\x04 \x01(\v2\x12.google.type.MoneyR\bopenDues\x129\n" +
+	"\x0edraft_invoices\x18\x05 \x01(\v2\x12.google.type.MoneyR\rdraftInvoices\x12?\n" +
+	"\x11unapplied_credits\x18\x06 \x01(\v2\x12.google.type.MoneyR\x10unappliedCredits\x12.\n" +
+	"\bexposure\x18\a \x01(\v2\x12.google.type.MoneyR\bexposure\x120\n" +
+	"\tthreshold\x18\b \x01(\v2\x12.google.type.MoneyR\tthreshold\x12\x1a\n" +
+	"\bbreached\x18\t \x01(\bR\bbreached\"F\n" +
+	"\x16GetCreditPolicyRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\"u\n" +
+	"\x16SetCreditPolicyRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12-\n" +
+	"\x06policy\x18\x02 \x01(\v2\x15.finance.CreditPolicyR\x06policy\"c\n" +
+	"\x18GetCreditExposureRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x19\n" +
+	"\bparty_id\x18\x02 \x01(\tR\apartyId\"j\n" +
+	"\x1bApproveInvoiceCreditRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\tR\tinvoiceId\"q\n" +
+	"\fDunningStage\x12\"\n" +
+	"\rdays_from_due\x18\x01 \x01(\x05R\vdaysFromDue\x12)\n" +
+	"\x10escalation_level\x18\x02 \x01(\x05R\x0fescalationLevel\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"i\n" +
+	"\x0fDunningSchedule\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12-\n" +
+	"\x06stages\x18\x02 \x03(\v2\x15.finance.DunningStageR\x06stages\"\x85\x02\n" +
+	"\vDunningHold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x03 \x01(\tR\tinvoiceId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x12\x19\n" +
+	"\bparty_id\x18\b \x01(\tR\apartyIdJ\x04\b\x04\x10\x05R\vcustomer_id\"\xea\x01\n" +
+	"\x0fDunningReminder\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\tR\tinvoiceId\x12%\n" +
+	"\x0einvoice_number\x18\x02 \x01(\tR\rinvoiceNumber\x12\"\n" +
+	"\rdays_from_due\x18\x04 \x01(\x05R\vdaysFromDue\x12)\n" +
+	"\x10escalation_level\x18\x05 \x01(\x05R\x0fescalationLevel\x12\x14\n" +
+	"\x05stage\x18\x06 \x01(\tR\x05stage\x12\x19\n" +
+	"\bparty_id\x18\a \x01(\tR\apartyIdJ\x04\b\x03\x10\x04R\vcustomer_id\"\x7f\n" +
+	"\x19SetDunningScheduleRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x124\n" +
+	"\bschedule\x18\x02 \x01(\v2\x18.finance.DunningScheduleR\bschedule\"I\n" +
+	"\x19GetDunningScheduleRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\"q\n" +
+	"\x17PlaceDunningHoldRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12(\n" +
+	"\x04hold\x18\x02 \x01(\v2\x14.finance.DunningHoldR\x04hold\"\x96\x01\n" +
+	"\x19ReleaseDunningHoldRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\tR\tinvoiceId\x12\x19\n" +
+	"\bparty_id\x18\x04 \x01(\tR\apartyIdJ\x04\b\x03\x10\x04R\vcustomer_id\"G\n" +
+	"\x17ListDunningHoldsRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\"F\n" +
+	"\x18ListDunningHoldsResponse\x12*\n" +
+	"\x05holds\x18\x01 \x03(\v2\x14.finance.DunningHoldR\x05holds\"r\n" +
+	"\x11RunDunningRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\xcf\x01\n" +
+	"\x12RunDunningResponse\x125\n" +
+	"\x17overdue_payment_due_ids\x18\x01 \x03(\tR\x14overduePaymentDueIds\x12.\n" +
+	"\x13overdue_invoice_ids\x18\x02 \x03(\tR\x11overdueInvoiceIds\x126\n" +
+	"\treminders\x18\x03 \x03(\v2\x18.finance.DunningReminderR\treminders\x12\x1a\n" +
+	"\bfailures\x18\x04 \x03(\tR\bfailures\"\x9c\x04\n" +
+	"\x10RecurringInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12.\n" +
+	"\x13template_invoice_id\x18\x04 \x01(\tR\x11templateInvoiceId\x128\n" +
+	"\tfrequency\x18\x05 \x01(\x0e2\x1a.finance.TemplateFrequencyR\tfrequency\x129\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1f\n" +
+	"\vbilling_day\x18\b \x01(\x05R\n" +
+	"billingDay\x12\x18\n" +
+	"\aprorate\x18\t \x01(\bR\aprorate\x12\x1d\n" +
+	"\n" +
+	"auto_issue\x18\n" +
+	" \x01(\bR\tautoIssue\x12\x16\n" +
+	"\x06active\x18\v \x01(\bR\x06active\x12A\n" +
+	"\x0ebilled_through\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rbilledThrough\x12*\n" +
+	"\x05audit\x18\r \x01(\v2\x14.finance.AuditFieldsR\x05audit\"\x95\x01\n" +
+	"\x1dCreateRecurringInvoiceRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12F\n" +
+	"\x11recurring_invoice\x18\x02 \x01(\v2\x19.finance.RecurringInvoiceR\x10recurringInvoice\"Z\n" +
+	"\x1aGetRecurringInvoiceRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xd2\x01\n" +
+	"\x1dUpdateRecurringInvoiceRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12F\n" +
+	"\x11recurring_invoice\x18\x02 \x01(\v2\x19.finance.RecurringInvoiceR\x10recurringInvoice\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"]\n" +
+	"\x1dDeleteRecurringInvoiceRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"H\n" +
+	"\x1cListRecurringInvoicesRequest\x12(\n" +
+	"\x04page\x18\x01 \x01(\v2\x14.finance.PageRequestR\x04page\"\x94\x01\n" +
+	"\x1dListRecurringInvoicesResponse\x12H\n" +
+	"\x12recurring_invoices\x18\x01 \x03(\v2\x19.finance.RecurringInvoiceR\x11recurringInvoices\x12)\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.finance.PageResponseR\x04page\"\x81\x01\n" +
+	" GenerateRecurringInvoicesRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\xea\x01\n" +
+	"\x10GeneratedInvoice\x120\n" +
+	"\x14recurring_invoice_id\x18\x01 \x01(\tR\x12recurringInvoiceId\x12=\n" +
+	"\fperiod_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12*\n" +
+	"\ainvoice\x18\x04 \x01(\v2\x10.finance.InvoiceR\ainvoice\"v\n" +
+	"!GenerateRecurringInvoicesResponse\x125\n" +
+	"\binvoices\x18\x01 \x03(\v2\x19.finance.GeneratedInvoiceR\binvoices\x12\x1a\n" +
+	"\bfailures\x18\x02 \x03(\tR\bfailures\"\xd7\x02\n" +
 	"\x0fCreditDebitNote\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -9925,7 +16789,12 @@ const file_finance_proto_rawDesc = "" +
 	"\x04type\x18\x03 \x01(\x0e2\x11.finance.NoteTypeR\x04type\x12*\n" +
 	"\x06amount\x18\x04 \x01(\v2\x12.google.type.MoneyR\x06amount\x12\x16\n" +
 	"\x06reason\x18\x05 \x01(\tR\x06reason\x12*\n" +
-	"\x05audit\x18\x06 \x01(\v2\x14.finance.AuditFieldsR\x05audit\"z\n" +
+	"\x05audit\x18\x06 \x01(\v2\x14.finance.AuditFieldsR\x05audit\x12\x1d\n" +
+	"\n" +
+	"journal_id\x18\a \x01(\tR\tjournalId\x12$\n" +
+	"\x0epayment_due_id\x18\b \x01(\tR\fpaymentDueId\x129\n" +
+	"\n" +
+	"applied_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tappliedAt\"z\n" +
 	"\x1cCreateCreditDebitNoteRequest\x12,\n" +
 	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12,\n" +
 	"\x04note\x18\x02 \x01(\v2\x18.finance.CreditDebitNoteR\x04note\"+\n" +
@@ -10067,14 +16936,16 @@ const file_finance_proto_rawDesc = "" +
 	"\x04page\x18\x01 \x01(\v2\x14.finance.PageRequestR\x04page\"o\n" +
 	"\x14ListAccountsResponse\x12,\n" +
 	"\baccounts\x18\x01 \x03(\v2\x10.finance.AccountR\baccounts\x12)\n" +
-	"\x04page\x18\x02 \x01(\v2\x15.finance.PageResponseR\x04page\"\xc9\x01\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.finance.PageResponseR\x04page\"\xaf\x02\n" +
 	"\vJournalLine\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12'\n" +
 	"\x04side\x18\x02 \x01(\x0e2\x13.finance.LedgerSideR\x04side\x12*\n" +
 	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x12$\n" +
 	"\x0ecost_center_id\x18\x04 \x01(\tR\fcostCenterId\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xa5\x02\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12#\n" +
+	"\rexchange_rate\x18\x06 \x01(\tR\fexchangeRate\x12?\n" +
+	"\x11functional_amount\x18\a \x01(\v2\x12.google.type.MoneyR\x10functionalAmount\"\xe9\x03\n" +
 	"\fJournalEntry\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
 	"\fjournal_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vjournalDate\x12\x1c\n" +
@@ -10084,7 +16955,12 @@ const file_finance_proto_rawDesc = "" +
 	"\vsource_type\x18\x06 \x01(\tR\n" +
 	"sourceType\x12\x1b\n" +
 	"\tsource_id\x18\a \x01(\tR\bsourceId\x12*\n" +
-	"\x05audit\x18\b \x01(\v2\x14.finance.AuditFieldsR\x05audit\"v\n" +
+	"\x05audit\x18\b \x01(\v2\x14.finance.AuditFieldsR\x05audit\x12.\n" +
+	"\x06status\x18\t \x01(\x0e2\x16.finance.JournalStatusR\x06status\x12$\n" +
+	"\x0ereversal_of_id\x18\n" +
+	" \x01(\tR\freversalOfId\x12$\n" +
+	"\x0ereversed_by_id\x18\v \x01(\tR\freversedById\x12F\n" +
+	"\x11auto_reverse_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x0fautoReverseDate\"v\n" +
 	"\x19CreateJournalEntryRequest\x12,\n" +
 	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12+\n" +
 	"\x05entry\x18\x02 \x01(\v2\x15.finance.JournalEntryR\x05entry\"(\n" +
@@ -10097,12 +16973,17 @@ const file_finance_proto_rawDesc = "" +
 	"updateMask\"Y\n" +
 	"\x19DeleteJournalEntryRequest\x12,\n" +
 	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"E\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xaf\x01\n" +
+	"\x1aReverseJournalEntryRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12?\n" +
+	"\rreversal_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\freversalDate\x12\x12\n" +
+	"\x04memo\x18\x04 \x01(\tR\x04memo\"E\n" +
 	"\x19ListJournalEntriesRequest\x12(\n" +
 	"\x04page\x18\x01 \x01(\v2\x14.finance.PageRequestR\x04page\"x\n" +
 	"\x1aListJournalEntriesResponse\x12/\n" +
 	"\aentries\x18\x01 \x03(\v2\x15.finance.JournalEntryR\aentries\x12)\n" +
-	"\x04page\x18\x02 \x01(\v2\x15.finance.PageResponseR\x04page\"\x96\x03\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.finance.PageResponseR\x04page\"\xfc\x03\n" +
 	"\vLedgerEntry\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -10115,12 +16996,164 @@ const file_finance_proto_rawDesc = "" +
 	"\x0ereference_type\x18\b \x01(\tR\rreferenceType\x12!\n" +
 	"\freference_id\x18\t \x01(\tR\vreferenceId\x12*\n" +
 	"\x05audit\x18\n" +
-	" \x01(\v2\x14.finance.AuditFieldsR\x05audit\"D\n" +
+	" \x01(\v2\x14.finance.AuditFieldsR\x05audit\x12?\n" +
+	"\x11functional_amount\x18\v \x01(\v2\x12.google.type.MoneyR\x10functionalAmount\x12#\n" +
+	"\rexchange_rate\x18\f \x01(\tR\fexchangeRate\"D\n" +
 	"\x18ListLedgerEntriesRequest\x12(\n" +
 	"\x04page\x18\x01 \x01(\v2\x14.finance.PageRequestR\x04page\"v\n" +
 	"\x19ListLedgerEntriesResponse\x12.\n" +
 	"\aentries\x18\x01 \x03(\v2\x14.finance.LedgerEntryR\aentries\x12)\n" +
-	"\x04page\x18\x02 \x01(\v2\x15.finance.PageResponseR\x04page\"\xa7\x01\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.finance.PageResponseR\x04page\"\xc0\x04\n" +
+	"\x0eAccountBalance\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12!\n" +
+	"\faccount_code\x18\x02 \x01(\tR\vaccountCode\x12!\n" +
+	"\faccount_name\x18\x03 \x01(\tR\vaccountName\x127\n" +
+	"\faccount_type\x18\x04 \x01(\x0e2\x14.finance.AccountTypeR\vaccountType\x127\n" +
+	"\tfrom_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bfromDate\x123\n" +
+	"\ato_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06toDate\x12;\n" +
+	"\x0fopening_balance\x18\a \x01(\v2\x12.google.type.MoneyR\x0eopeningBalance\x125\n" +
+	"\ftotal_debits\x18\b \x01(\v2\x12.google.type.MoneyR\vtotalDebits\x127\n" +
+	"\rtotal_credits\x18\t \x01(\v2\x12.google.type.MoneyR\ftotalCredits\x12;\n" +
+	"\x0fclosing_balance\x18\n" +
+	" \x01(\v2\x12.google.type.MoneyR\x0eclosingBalance\x128\n" +
+	"\n" +
+	"currencies\x18\v \x03(\v2\x18.finance.CurrencyBalanceR\n" +
+	"currencies\"\xa0\x02\n" +
+	"\x0fCurrencyBalance\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12;\n" +
+	"\x0fopening_balance\x18\x02 \x01(\v2\x12.google.type.MoneyR\x0eopeningBalance\x125\n" +
+	"\ftotal_debits\x18\x03 \x01(\v2\x12.google.type.MoneyR\vtotalDebits\x127\n" +
+	"\rtotal_credits\x18\x04 \x01(\v2\x12.google.type.MoneyR\ftotalCredits\x12;\n" +
+	"\x0fclosing_balance\x18\x05 \x01(\v2\x12.google.type.MoneyR\x0eclosingBalance\"\xd0\x01\n" +
+	"\x18GetAccountBalanceRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x127\n" +
+	"\tfrom_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromDate\x123\n" +
+	"\ato_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06toDate\x12'\n" +
+	"\x0forganization_id\x18\x04 \x01(\tR\x0eorganizationId\"\xd4\x01\n" +
+	"\x1aListAccountBalancesRequest\x12\x1f\n" +
+	"\vaccount_ids\x18\x01 \x03(\tR\n" +
+	"accountIds\x127\n" +
+	"\tfrom_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromDate\x123\n" +
+	"\ato_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06toDate\x12'\n" +
+	"\x0forganization_id\x18\x04 \x01(\tR\x0eorganizationId\"R\n" +
+	"\x1bListAccountBalancesResponse\x123\n" +
+	"\bbalances\x18\x01 \x03(\v2\x17.finance.AccountBalanceR\bbalances\"\xa6\x01\n" +
+	"\x0fAccountTreeNode\x12*\n" +
+	"\aaccount\x18\x01 \x01(\v2\x10.finance.AccountR\aaccount\x121\n" +
+	"\abalance\x18\x02 \x01(\v2\x17.finance.AccountBalanceR\abalance\x124\n" +
+	"\bchildren\x18\x03 \x03(\v2\x18.finance.AccountTreeNodeR\bchildren\"\xae\x01\n" +
+	"\x15GetAccountTreeRequest\x127\n" +
+	"\tfrom_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bfromDate\x123\n" +
+	"\ato_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06toDate\x12'\n" +
+	"\x0forganization_id\x18\x03 \x01(\tR\x0eorganizationId\"H\n" +
+	"\x16GetAccountTreeResponse\x12.\n" +
+	"\x05roots\x18\x01 \x03(\v2\x18.finance.AccountTreeNodeR\x05roots\"\xdf\x04\n" +
+	"\x0fJournalTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\x12\x12\n" +
+	"\x04memo\x18\x04 \x01(\tR\x04memo\x12*\n" +
+	"\x05lines\x18\x05 \x03(\v2\x14.finance.JournalLineR\x05lines\x128\n" +
+	"\tfrequency\x18\x06 \x01(\x0e2\x1a.finance.TemplateFrequencyR\tfrequency\x129\n" +
+	"\n" +
+	"start_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12 \n" +
+	"\fday_of_month\x18\t \x01(\x05R\n" +
+	"dayOfMonth\x12\x1b\n" +
+	"\tauto_post\x18\n" +
+	" \x01(\bR\bautoPost\x12-\n" +
+	"\x12adjustment_percent\x18\v \x01(\tR\x11adjustmentPercent\x12\x16\n" +
+	"\x06active\x18\f \x01(\bR\x06active\x12C\n" +
+	"\x0flast_occurrence\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x0elastOccurrence\x12'\n" +
+	"\x0forganization_id\x18\x0e \x01(\tR\x0eorganizationId\x12*\n" +
+	"\x05audit\x18\x0f \x01(\v2\x14.finance.AuditFieldsR\x05audit\"\x82\x01\n" +
+	"\x1cCreateJournalTemplateRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x124\n" +
+	"\btemplate\x18\x02 \x01(\v2\x18.finance.JournalTemplateR\btemplate\"+\n" +
+	"\x19GetJournalTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xbf\x01\n" +
+	"\x1cUpdateJournalTemplateRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x124\n" +
+	"\btemplate\x18\x02 \x01(\v2\x18.finance.JournalTemplateR\btemplate\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\\\n" +
+	"\x1cDeleteJournalTemplateRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"G\n" +
+	"\x1bListJournalTemplatesRequest\x12(\n" +
+	"\x04page\x18\x01 \x01(\v2\x14.finance.PageRequestR\x04page\"\x81\x01\n" +
+	"\x1cListJournalTemplatesResponse\x126\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x18.finance.JournalTemplateR\ttemplates\x12)\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.finance.PageResponseR\x04page\"\x81\x01\n" +
+	" GenerateRecurringJournalsRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"p\n" +
+	"!GenerateRecurringJournalsResponse\x12/\n" +
+	"\aentries\x18\x01 \x03(\v2\x15.finance.JournalEntryR\aentries\x12\x1a\n" +
+	"\bfailures\x18\x02 \x03(\tR\bfailures\"\xae\x01\n" +
+	"\x1bImportJournalEntriesRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x12\n" +
+	"\x04post\x18\x05 \x01(\bR\x04post\"p\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x9d\x01\n" +
+	"\x1cImportJournalEntriesResponse\x12\x18\n" +
+	"\aentries\x18\x01 \x01(\x05R\aentries\x12\x14\n" +
+	"\x05lines\x18\x02 \x01(\x05R\x05lines\x12\x1c\n" +
+	"\tcommitted\x18\x03 \x01(\bR\tcommitted\x12/\n" +
+	"\x06errors\x18\x04 \x03(\v2\x17.finance.ImportRowErrorR\x06errors\"\x81\x02\n" +
+	"\x0eFiscalCalendar\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12(\n" +
+	"\x10year_start_month\x18\x02 \x01(\x05R\x0eyearStartMonth\x12?\n" +
+	"\x1cretained_earnings_account_id\x18\x03 \x01(\tR\x19retainedEarningsAccountId\x12*\n" +
+	"\x05audit\x18\x04 \x01(\v2\x14.finance.AuditFieldsR\x05audit\x12/\n" +
+	"\x13functional_currency\x18\x05 \x01(\tR\x12functionalCurrency\"\x84\x03\n" +
+	"\fFiscalPeriod\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vfiscal_year\x18\x03 \x01(\x05R\n" +
+	"fiscalYear\x12#\n" +
+	"\rperiod_number\x18\x04 \x01(\x05R\fperiodNumber\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12-\n" +
+	"\x06status\x18\a \x01(\x0e2\x15.finance.PeriodStatusR\x06status\x127\n" +
+	"\tclosed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12\x1b\n" +
+	"\tclosed_by\x18\t \x01(\tR\bclosedBy\"}\n" +
+	"\x18SetFiscalCalendarRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x123\n" +
+	"\bcalendar\x18\x02 \x01(\v2\x17.finance.FiscalCalendarR\bcalendar\"h\n" +
+	"\x17CreateFiscalYearRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x1f\n" +
+	"\vfiscal_year\x18\x02 \x01(\x05R\n" +
+	"fiscalYear\"i\n" +
+	"\x18ListFiscalPeriodsRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x1f\n" +
+	"\vfiscal_year\x18\x02 \x01(\x05R\n" +
+	"fiscalYear\"L\n" +
+	"\x19ListFiscalPeriodsResponse\x12/\n" +
+	"\aperiods\x18\x01 \x03(\v2\x15.finance.FiscalPeriodR\aperiods\"Q\n" +
+	"\x11OpenPeriodRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"f\n" +
+	"\x12ClosePeriodRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04soft\x18\x03 \x01(\bR\x04soft\"g\n" +
+	"\x16CloseFiscalYearRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12\x1f\n" +
+	"\vfiscal_year\x18\x02 \x01(\x05R\n" +
+	"fiscalYear\"\x86\x01\n" +
+	"\x17CloseFiscalYearResponse\x12:\n" +
+	"\rclosing_entry\x18\x01 \x01(\v2\x15.finance.JournalEntryR\fclosingEntry\x12/\n" +
+	"\aperiods\x18\x02 \x03(\v2\x15.finance.FiscalPeriodR\aperiods\"\xa7\x01\n" +
 	"\x06Budget\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
@@ -10388,14 +17421,15 @@ const file_finance_proto_rawDesc = "" +
 	"\x06period\x18\x02 \x01(\v2\x15.finance.ReportPeriodR\x06period\"\x88\x01\n" +
 	"\x15ConsolidationResponse\x12/\n" +
 	"\x13consolidated_report\x18\x01 \x01(\tR\x12consolidatedReport\x12>\n" +
-	"\x0econsolidations\x18\x02 \x03(\v2\x16.finance.ConsolidationR\x0econsolidations\"\xdb\x01\n" +
+	"\x0econsolidations\x18\x02 \x03(\v2\x16.finance.ConsolidationR\x0econsolidations\"\xf8\x01\n" +
 	"\fExchangeRate\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\rbase_currency\x18\x02 \x01(\tR\fbaseCurrency\x12%\n" +
 	"\x0equote_currency\x18\x03 \x01(\tR\rquoteCurrency\x12\x12\n" +
 	"\x04rate\x18\x04 \x01(\x01R\x04rate\x12/\n" +
 	"\x05as_of\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12*\n" +
-	"\x05audit\x18\x06 \x01(\v2\x14.finance.AuditFieldsR\x05audit\"t\n" +
+	"\x05audit\x18\x06 \x01(\v2\x14.finance.AuditFieldsR\x05audit\x12\x1b\n" +
+	"\trate_type\x18\a \x01(\tR\brateType\"t\n" +
 	"\x19CreateExchangeRateRequest\x12,\n" +
 	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12)\n" +
 	"\x04rate\x18\x02 \x01(\v2\x15.finance.ExchangeRateR\x04rate\"(\n" +
@@ -10422,7 +17456,42 @@ const file_finance_proto_rawDesc = "" +
 	"\x05as_of\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"e\n" +
 	"\x14ConvertMoneyResponse\x120\n" +
 	"\tconverted\x18\x01 \x01(\v2\x12.google.type.MoneyR\tconverted\x12\x1b\n" +
-	"\trate_used\x18\x02 \x01(\x01R\brateUsed\"H\n" +
+	"\trate_used\x18\x02 \x01(\x01R\brateUsed\"\x87\x02\n" +
+	"\x15FxRevaluationSettings\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12;\n" +
+	"\x1aunrealized_gain_account_id\x18\x02 \x01(\tR\x17unrealizedGainAccountId\x12;\n" +
+	"\x1aunrealized_loss_account_id\x18\x03 \x01(\tR\x17unrealizedLossAccountId\x12\x1f\n" +
+	"\vaccount_ids\x18\x04 \x03(\tR\n" +
+	"accountIds\x12*\n" +
+	"\x05audit\x18\x05 \x01(\v2\x14.finance.AuditFieldsR\x05audit\"\x8b\x01\n" +
+	"\x1fSetFxRevaluationSettingsRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12:\n" +
+	"\bsettings\x18\x02 \x01(\v2\x1e.finance.FxRevaluationSettingsR\bsettings\"O\n" +
+	"\x1fGetFxRevaluationSettingsRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\"\x9b\x01\n" +
+	"\x1dRevalueForeignCurrencyRequest\x12,\n" +
+	"\x04meta\x18\x01 \x01(\v2\x18.finance.RequestMetadataR\x04meta\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x1b\n" +
+	"\trate_type\x18\x03 \x01(\tR\brateType\"\xf9\x02\n" +
+	"\x11FxRevaluationItem\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\tR\taccountId\x12,\n" +
+	"\abalance\x18\x05 \x01(\v2\x12.google.type.MoneyR\abalance\x12;\n" +
+	"\x0fcarrying_amount\x18\x06 \x01(\v2\x12.google.type.MoneyR\x0ecarryingAmount\x12\x12\n" +
+	"\x04rate\x18\a \x01(\tR\x04rate\x12;\n" +
+	"\x0frevalued_amount\x18\b \x01(\v2\x12.google.type.MoneyR\x0erevaluedAmount\x122\n" +
+	"\n" +
+	"difference\x18\t \x01(\v2\x12.google.type.MoneyR\n" +
+	"difference\"\x82\x02\n" +
+	"\x1eRevalueForeignCurrencyResponse\x12/\n" +
+	"\x05as_of\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x1b\n" +
+	"\trate_type\x18\x02 \x01(\tR\brateType\x12/\n" +
+	"\x13functional_currency\x18\x03 \x01(\tR\x12functionalCurrency\x120\n" +
+	"\x05items\x18\x04 \x03(\v2\x1a.finance.FxRevaluationItemR\x05items\x12/\n" +
+	"\ajournal\x18\x05 \x01(\v2\x15.finance.JournalEntryR\ajournal\"H\n" +
 	"\x17CashFlowForecastRequest\x12-\n" +
 	"\x06period\x18\x01 \x01(\v2\x15.finance.ReportPeriodR\x06period\"E\n" +
 	"\x18CashFlowForecastResponse\x12)\n" +
@@ -10455,13 +17524,14 @@ const file_finance_proto_rawDesc = "" +
 	"totalGross\x12/\n" +
 	"\ttotal_net\x18\x03 \x01(\v2\x12.google.type.MoneyR\btotalNet\x125\n" +
 	"\brun_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\arunDate\x12'\n" +
-	"\x0forganization_id\x18\x05 \x01(\tR\x0eorganizationId\"\xd1\x01\n" +
+	"\x0forganization_id\x18\x05 \x01(\tR\x0eorganizationId\"\xec\x01\n" +
 	"\x17VendorBillApprovedEvent\x12$\n" +
 	"\x0evendor_bill_id\x18\x01 \x01(\tR\fvendorBillId\x12*\n" +
 	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\x12;\n" +
 	"\vapproved_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"approvedAt\x12'\n" +
-	"\x0forganization_id\x18\x04 \x01(\tR\x0eorganizationId*\x93\x01\n" +
+	"\x0forganization_id\x18\x04 \x01(\tR\x0eorganizationId\x12\x19\n" +
+	"\bparty_id\x18\x05 \x01(\tR\apartyId*\x93\x01\n" +
 	"\vInvoiceType\x12\x1c\n" +
 	"\x18INVOICE_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12INVOICE_TYPE_SALES\x10\x01\x12\x19\n" +
@@ -10475,13 +17545,14 @@ const file_finance_proto_rawDesc = "" +
 	"\x1dINVOICE_STATUS_PARTIALLY_PAID\x10\x03\x12\x17\n" +
 	"\x13INVOICE_STATUS_PAID\x10\x04\x12\x17\n" +
 	"\x13INVOICE_STATUS_VOID\x10\x05\x12\x1a\n" +
-	"\x16INVOICE_STATUS_OVERDUE\x10\x06*\xa0\x01\n" +
+	"\x16INVOICE_STATUS_OVERDUE\x10\x06*\xbc\x01\n" +
 	"\rPaymentStatus\x12\x1e\n" +
 	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12PAYMENT_STATUS_DUE\x10\x01\x12!\n" +
 	"\x1dPAYMENT_STATUS_PARTIALLY_PAID\x10\x02\x12\x17\n" +
 	"\x13PAYMENT_STATUS_PAID\x10\x03\x12\x1b\n" +
-	"\x17PAYMENT_STATUS_WRITEOFF\x10\x04*P\n" +
+	"\x17PAYMENT_STATUS_WRITEOFF\x10\x04\x12\x1a\n" +
+	"\x16PAYMENT_STATUS_OVERDUE\x10\x05*P\n" +
 	"\bNoteType\x12\x19\n" +
 	"\x15NOTE_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10NOTE_TYPE_CREDIT\x10\x01\x12\x13\n" +
@@ -10510,7 +17581,37 @@ const file_finance_proto_rawDesc = "" +
 	"\x1aACCOUNT_STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eACCOUNT_ACTIVE\x10\x01\x12\x14\n" +
 	"\x10ACCOUNT_INACTIVE\x10\x02\x12\x14\n" +
-	"\x10ACCOUNT_ARCHIVED\x10\x032\xb6\x03\n" +
+	"\x10ACCOUNT_ARCHIVED\x10\x03*z\n" +
+	"\x11TemplateFrequency\x12\"\n" +
+	"\x1eTEMPLATE_FREQUENCY_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TEMPLATE_MONTHLY\x10\x01\x12\x16\n" +
+	"\x12TEMPLATE_QUARTERLY\x10\x02\x12\x13\n" +
+	"\x0fTEMPLATE_YEARLY\x10\x03*l\n" +
+	"\rJournalStatus\x12\x1e\n" +
+	"\x1aJOURNAL_STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rJOURNAL_DRAFT\x10\x01\x12\x12\n" +
+	"\x0eJOURNAL_POSTED\x10\x02\x12\x14\n" +
+	"\x10JOURNAL_REVERSED\x10\x03*i\n" +
+	"\fPeriodStatus\x12\x1d\n" +
+	"\x19PERIOD_STATUS_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vPERIOD_OPEN\x10\x01\x12\x16\n" +
+	"\x12PERIOD_SOFT_CLOSED\x10\x02\x12\x11\n" +
+	"\rPERIOD_CLOSED\x10\x03*]\n" +
+	"\tPartyKind\x12\x1a\n" +
+	"\x16PARTY_KIND_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0ePARTY_CUSTOMER\x10\x01\x12\x10\n" +
+	"\fPARTY_VENDOR\x10\x02\x12\x0e\n" +
+	"\n" +
+	"PARTY_BOTH\x10\x03*V\n" +
+	"\vAddressKind\x12\x1c\n" +
+	"\x18ADDRESS_KIND_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fADDRESS_BILLING\x10\x01\x12\x14\n" +
+	"\x10ADDRESS_SHIPPING\x10\x02*b\n" +
+	"\fCreditAction\x12\x1d\n" +
+	"\x19CREDIT_ACTION_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13CREDIT_ACTION_BLOCK\x10\x01\x12\x1a\n" +
+	"\x16CREDIT_ACTION_APPROVAL\x10\x022\xea\n" +
+	"\n" +
 	"\x0eInvoiceService\x12@\n" +
 	"\rCreateInvoice\x12\x1d.finance.CreateInvoiceRequest\x1a\x10.finance.Invoice\x12:\n" +
 	"\n" +
@@ -10518,7 +17619,45 @@ const file_finance_proto_rawDesc = "" +
 	"\fListInvoices\x12\x1c.finance.ListInvoicesRequest\x1a\x1d.finance.ListInvoicesResponse\x12O\n" +
 	"\x0eSearchInvoices\x12\x1e.finance.SearchInvoicesRequest\x1a\x1d.finance.ListInvoicesResponse\x12@\n" +
 	"\rUpdateInvoice\x12\x1d.finance.UpdateInvoiceRequest\x1a\x10.finance.Invoice\x12F\n" +
-	"\rDeleteInvoice\x12\x1d.finance.DeleteInvoiceRequest\x1a\x16.google.protobuf.Empty2\xdd\x03\n" +
+	"\rDeleteInvoice\x12\x1d.finance.DeleteInvoiceRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\fIssueInvoice\x12\x1c.finance.IssueInvoiceRequest\x1a\x10.finance.Invoice\x12<\n" +
+	"\vVoidInvoice\x12\x1b.finance.VoidInvoiceRequest\x1a\x10.finance.Invoice\x12<\n" +
+	"\vMarkOverdue\x12\x1b.finance.MarkOverdueRequest\x1a\x10.finance.Invoice\x12[\n" +
+	"\x15SetInvoicePostingRule\x12%.finance.SetInvoicePostingRuleRequest\x1a\x1b.finance.InvoicePostingRule\x12[\n" +
+	"\x15GetInvoicePostingRule\x12%.finance.GetInvoicePostingRuleRequest\x1a\x1b.finance.InvoicePostingRule\x12^\n" +
+	"\x16SetInvoiceNumberSeries\x12&.finance.SetInvoiceNumberSeriesRequest\x1a\x1c.finance.InvoiceNumberSeries\x12^\n" +
+	"\x16GetInvoiceNumberSeries\x12&.finance.GetInvoiceNumberSeriesRequest\x1a\x1c.finance.InvoiceNumberSeries\x12H\n" +
+	"\rRenderInvoice\x12\x1d.finance.RenderInvoiceRequest\x1a\x18.finance.RenderedInvoice\x12R\n" +
+	"\x12SetInvoiceTemplate\x12\".finance.SetInvoiceTemplateRequest\x1a\x18.finance.InvoiceTemplate\x12R\n" +
+	"\x12GetInvoiceTemplate\x12\".finance.GetInvoiceTemplateRequest\x1a\x18.finance.InvoiceTemplate\x12H\n" +
+	"\rExportInvoice\x12\x1d.finance.ExportInvoiceRequest\x1a\x18.finance.RenderedInvoice\x12@\n" +
+	"\rImportInvoice\x12\x1d.finance.ImportInvoiceRequest\x1a\x10.finance.Invoice2\xca\x02\n" +
+	"\fPartyService\x12:\n" +
+	"\vCreateParty\x12\x1b.finance.CreatePartyRequest\x1a\x0e.finance.Party\x124\n" +
+	"\bGetParty\x12\x18.finance.GetPartyRequest\x1a\x0e.finance.Party\x12:\n" +
+	"\vUpdateParty\x12\x1b.finance.UpdatePartyRequest\x1a\x0e.finance.Party\x12B\n" +
+	"\vDeleteParty\x12\x1b.finance.DeletePartyRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\vListParties\x12\x1b.finance.ListPartiesRequest\x1a\x1c.finance.ListPartiesResponse2\xd3\x02\n" +
+	"\x14CreditControlService\x12I\n" +
+	"\x0fGetCreditPolicy\x12\x1f.finance.GetCreditPolicyRequest\x1a\x15.finance.CreditPolicy\x12I\n" +
+	"\x0fSetCreditPolicy\x12\x1f.finance.SetCreditPolicyRequest\x1a\x15.finance.CreditPolicy\x12O\n" +
+	"\x11GetCreditExposure\x12!.finance.GetCreditExposureRequest\x1a\x17.finance.CreditExposure\x12T\n" +
+	"\x14ApproveInvoiceCredit\x12$.finance.ApproveInvoiceCreditRequest\x1a\x16.google.protobuf.Empty2\xf6\x03\n" +
+	"\x0eDunningService\x12R\n" +
+	"\x12SetDunningSchedule\x12\".finance.SetDunningScheduleRequest\x1a\x18.finance.DunningSchedule\x12R\n" +
+	"\x12GetDunningSchedule\x12\".finance.GetDunningScheduleRequest\x1a\x18.finance.DunningSchedule\x12J\n" +
+	"\x10PlaceDunningHold\x12 .finance.PlaceDunningHoldRequest\x1a\x14.finance.DunningHold\x12P\n" +
+	"\x12ReleaseDunningHold\x12\".finance.ReleaseDunningHoldRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
+	"\x10ListDunningHolds\x12 .finance.ListDunningHoldsRequest\x1a!.finance.ListDunningHoldsResponse\x12E\n" +
+	"\n" +
+	"RunDunning\x12\x1a.finance.RunDunningRequest\x1a\x1b.finance.RunDunningResponse2\xe0\x04\n" +
+	"\x17RecurringInvoiceService\x12[\n" +
+	"\x16CreateRecurringInvoice\x12&.finance.CreateRecurringInvoiceRequest\x1a\x19.finance.RecurringInvoice\x12U\n" +
+	"\x13GetRecurringInvoice\x12#.finance.GetRecurringInvoiceRequest\x1a\x19.finance.RecurringInvoice\x12[\n" +
+	"\x16UpdateRecurringInvoice\x12&.finance.UpdateRecurringInvoiceRequest\x1a\x19.finance.RecurringInvoice\x12X\n" +
+	"\x16DeleteRecurringInvoice\x12&.finance.DeleteRecurringInvoiceRequest\x1a\x16.google.protobuf.Empty\x12f\n" +
+	"\x15ListRecurringInvoices\x12%.finance.ListRecurringInvoicesRequest\x1a&.finance.ListRecurringInvoicesResponse\x12r\n" +
+	"\x19GenerateRecurringInvoices\x12).finance.GenerateRecurringInvoicesRequest\x1a*.finance.GenerateRecurringInvoicesResponse2\xdd\x03\n" +
 	"\x16CreditDebitNoteService\x12X\n" +
 	"\x15CreateCreditDebitNote\x12%.finance.CreateCreditDebitNoteRequest\x1a\x18.finance.CreditDebitNote\x12R\n" +
 	"\x12GetCreditDebitNote\x12\".finance.GetCreditDebitNoteRequest\x1a\x18.finance.CreditDebitNote\x12c\n" +
@@ -10540,7 +17679,7 @@ const file_finance_proto_rawDesc = "" +
 	"\x16ImportBankTransactions\x12&.finance.ImportBankTransactionsRequest\x1a'.finance.ImportBankTransactionsResponse\x12c\n" +
 	"\x14ListBankTransactions\x12$.finance.ListBankTransactionsRequest\x1a%.finance.ListBankTransactionsResponse2r\n" +
 	"\x19BankReconciliationService\x12U\n" +
-	"\x14ReconcileTransaction\x12$.finance.ReconcileTransactionRequest\x1a\x17.finance.Reconciliation2\xde\x06\n" +
+	"\x14ReconcileTransaction\x12$.finance.ReconcileTransactionRequest\x1a\x17.finance.Reconciliation2\xd5\x0e\n" +
 	"\rLedgerService\x12@\n" +
 	"\rCreateAccount\x12\x1d.finance.CreateAccountRequest\x1a\x10.finance.Account\x12:\n" +
 	"\n" +
@@ -10552,8 +17691,27 @@ const file_finance_proto_rawDesc = "" +
 	"\x0fGetJournalEntry\x12\x1f.finance.GetJournalEntryRequest\x1a\x15.finance.JournalEntry\x12O\n" +
 	"\x12UpdateJournalEntry\x12\".finance.UpdateJournalEntryRequest\x1a\x15.finance.JournalEntry\x12P\n" +
 	"\x12DeleteJournalEntry\x12\".finance.DeleteJournalEntryRequest\x1a\x16.google.protobuf.Empty\x12]\n" +
-	"\x12ListJournalEntries\x12\".finance.ListJournalEntriesRequest\x1a#.finance.ListJournalEntriesResponse\x12Z\n" +
-	"\x11ListLedgerEntries\x12!.finance.ListLedgerEntriesRequest\x1a\".finance.ListLedgerEntriesResponse2\xd6\x02\n" +
+	"\x12ListJournalEntries\x12\".finance.ListJournalEntriesRequest\x1a#.finance.ListJournalEntriesResponse\x12Q\n" +
+	"\x13ReverseJournalEntry\x12#.finance.ReverseJournalEntryRequest\x1a\x15.finance.JournalEntry\x12Z\n" +
+	"\x11ListLedgerEntries\x12!.finance.ListLedgerEntriesRequest\x1a\".finance.ListLedgerEntriesResponse\x12O\n" +
+	"\x11GetAccountBalance\x12!.finance.GetAccountBalanceRequest\x1a\x17.finance.AccountBalance\x12`\n" +
+	"\x13ListAccountBalances\x12#.finance.ListAccountBalancesRequest\x1a$.finance.ListAccountBalancesResponse\x12Q\n" +
+	"\x0eGetAccountTree\x12\x1e.finance.GetAccountTreeRequest\x1a\x1f.finance.GetAccountTreeResponse\x12X\n" +
+	"\x15CreateJournalTemplate\x12%.finance.CreateJournalTemplateRequest\x1a\x18.finance.JournalTemplate\x12R\n" +
+	"\x12GetJournalTemplate\x12\".finance.GetJournalTemplateRequest\x1a\x18.finance.JournalTemplate\x12X\n" +
+	"\x15UpdateJournalTemplate\x12%.finance.UpdateJournalTemplateRequest\x1a\x18.finance.JournalTemplate\x12V\n" +
+	"\x15DeleteJournalTemplate\x12%.finance.DeleteJournalTemplateRequest\x1a\x16.google.protobuf.Empty\x12c\n" +
+	"\x14ListJournalTemplates\x12$.finance.ListJournalTemplatesRequest\x1a%.finance.ListJournalTemplatesResponse\x12r\n" +
+	"\x19GenerateRecurringJournals\x12).finance.GenerateRecurringJournalsRequest\x1a*.finance.GenerateRecurringJournalsResponse\x12c\n" +
+	"\x14ImportJournalEntries\x12$.finance.ImportJournalEntriesRequest\x1a%.finance.ImportJournalEntriesResponse2\xf6\x03\n" +
+	"\x13FiscalPeriodService\x12O\n" +
+	"\x11SetFiscalCalendar\x12!.finance.SetFiscalCalendarRequest\x1a\x17.finance.FiscalCalendar\x12X\n" +
+	"\x10CreateFiscalYear\x12 .finance.CreateFiscalYearRequest\x1a\".finance.ListFiscalPeriodsResponse\x12Z\n" +
+	"\x11ListFiscalPeriods\x12!.finance.ListFiscalPeriodsRequest\x1a\".finance.ListFiscalPeriodsResponse\x12?\n" +
+	"\n" +
+	"OpenPeriod\x12\x1a.finance.OpenPeriodRequest\x1a\x15.finance.FiscalPeriod\x12A\n" +
+	"\vClosePeriod\x12\x1b.finance.ClosePeriodRequest\x1a\x15.finance.FiscalPeriod\x12T\n" +
+	"\x0fCloseFiscalYear\x12\x1f.finance.CloseFiscalYearRequest\x1a .finance.CloseFiscalYearResponse2\xd6\x02\n" +
 	"\rBudgetService\x12=\n" +
 	"\fCreateBudget\x12\x1c.finance.CreateBudgetRequest\x1a\x0f.finance.Budget\x127\n" +
 	"\tGetBudget\x12\x19.finance.GetBudgetRequest\x1a\x0f.finance.Budget\x12=\n" +
@@ -10611,14 +17769,17 @@ const file_finance_proto_rawDesc = "" +
 	"\x13CreateConsolidation\x12#.finance.CreateConsolidationRequest\x1a\x16.finance.Consolidation\x12L\n" +
 	"\x10GetConsolidation\x12 .finance.GetConsolidationRequest\x1a\x16.finance.Consolidation\x12]\n" +
 	"\x12ListConsolidations\x12\".finance.ListConsolidationsRequest\x1a#.finance.ListConsolidationsResponse\x12R\n" +
-	"\x13DeleteConsolidation\x12#.finance.DeleteConsolidationRequest\x1a\x16.google.protobuf.Empty2\xf3\x03\n" +
+	"\x13DeleteConsolidation\x12#.finance.DeleteConsolidationRequest\x1a\x16.google.protobuf.Empty2\xaa\x06\n" +
 	"\tFxService\x12O\n" +
 	"\x12CreateExchangeRate\x12\".finance.CreateExchangeRateRequest\x1a\x15.finance.ExchangeRate\x12I\n" +
 	"\x0fGetExchangeRate\x12\x1f.finance.GetExchangeRateRequest\x1a\x15.finance.ExchangeRate\x12O\n" +
 	"\x12UpdateExchangeRate\x12\".finance.UpdateExchangeRateRequest\x1a\x15.finance.ExchangeRate\x12P\n" +
 	"\x12DeleteExchangeRate\x12\".finance.DeleteExchangeRateRequest\x1a\x16.google.protobuf.Empty\x12Z\n" +
 	"\x11ListExchangeRates\x12!.finance.ListExchangeRatesRequest\x1a\".finance.ListExchangeRatesResponse\x12K\n" +
-	"\fConvertMoney\x12\x1c.finance.ConvertMoneyRequest\x1a\x1d.finance.ConvertMoneyResponse2\x94\x02\n" +
+	"\fConvertMoney\x12\x1c.finance.ConvertMoneyRequest\x1a\x1d.finance.ConvertMoneyResponse\x12d\n" +
+	"\x18SetFxRevaluationSettings\x12(.finance.SetFxRevaluationSettingsRequest\x1a\x1e.finance.FxRevaluationSettings\x12d\n" +
+	"\x18GetFxRevaluationSettings\x12(.finance.GetFxRevaluationSettingsRequest\x1a\x1e.finance.FxRevaluationSettings\x12i\n" +
+	"\x16RevalueForeignCurrency\x12&.finance.RevalueForeignCurrencyRequest\x1a'.finance.RevalueForeignCurrencyResponse2\x94\x02\n" +
 	"\x0fCashFlowService\x12W\n" +
 	"\x10GenerateForecast\x12 .finance.CashFlowForecastRequest\x1a!.finance.CashFlowForecastResponse\x12R\n" +
 	"\vGetForecast\x12 .finance.CashFlowForecastRequest\x1a!.finance.CashFlowForecastResponse\x12T\n" +
@@ -10642,641 +17803,1021 @@ func file_finance_proto_rawDescGZIP() []byte {
 	return file_finance_proto_rawDescData
 }
 
-var file_finance_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
-var file_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 153)
+var file_finance_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 245)
 var file_finance_proto_goTypes = []any{
-	(InvoiceType)(0),                       // 0: finance.InvoiceType
-	(InvoiceStatus)(0),                     // 1: finance.InvoiceStatus
-	(PaymentStatus)(0),                     // 2: finance.PaymentStatus
-	(NoteType)(0),                          // 3: finance.NoteType
-	(TaxType)(0),                           // 4: finance.TaxType
-	(LedgerSide)(0),                        // 5: finance.LedgerSide
-	(AccountType)(0),                       // 6: finance.AccountType
-	(AccountStatus)(0),                     // 7: finance.AccountStatus
-	(GstDocStatus_EInvoiceStatus)(0),       // 8: finance.GstDocStatus.EInvoiceStatus
-	(GstDocStatus_EWayStatus)(0),           // 9: finance.GstDocStatus.EWayStatus
-	(*RequestMetadata)(nil),                // 10: finance.RequestMetadata
-	(*AuditFields)(nil),                    // 11: finance.AuditFields
-	(*PageRequest)(nil),                    // 12: finance.PageRequest
-	(*PageResponse)(nil),                   // 13: finance.PageResponse
-	(*Id)(nil),                             // 14: finance.Id
-	(*TaxLine)(nil),                        // 15: finance.TaxLine
-	(*Discount)(nil),                       // 16: finance.Discount
-	(*GstBreakup)(nil),                     // 17: finance.GstBreakup
-	(*GstTaxRegime)(nil),                   // 18: finance.GstTaxRegime
-	(*GstDocStatus)(nil),                   // 19: finance.GstDocStatus
-	(*InvoiceItem)(nil),                    // 20: finance.InvoiceItem
-	(*Invoice)(nil),                        // 21: finance.Invoice
-	(*CreateInvoiceRequest)(nil),           // 22: finance.CreateInvoiceRequest
-	(*GetInvoiceRequest)(nil),              // 23: finance.GetInvoiceRequest
-	(*UpdateInvoiceRequest)(nil),           // 24: finance.UpdateInvoiceRequest
-	(*DeleteInvoiceRequest)(nil),           // 25: finance.DeleteInvoiceRequest
-	(*ListInvoicesRequest)(nil),            // 26: finance.ListInvoicesRequest
-	(*ListInvoicesResponse)(nil),           // 27: finance.ListInvoicesResponse
-	(*SearchInvoicesRequest)(nil),          // 28: finance.SearchInvoicesRequest
-	(*CreditDebitNote)(nil),                // 29: finance.CreditDebitNote
-	(*CreateCreditDebitNoteRequest)(nil),   // 30: finance.CreateCreditDebitNoteRequest
-	(*GetCreditDebitNoteRequest)(nil),      // 31: finance.GetCreditDebitNoteRequest
-	(*UpdateCreditDebitNoteRequest)(nil),   // 32: finance.UpdateCreditDebitNoteRequest
-	(*DeleteCreditDebitNoteRequest)(nil),   // 33: finance.DeleteCreditDebitNoteRequest
-	(*ListCreditDebitNotesRequest)(nil),    // 34: finance.ListCreditDebitNotesRequest
-	(*ListCreditDebitNotesResponse)(nil),   // 35: finance.ListCreditDebitNotesResponse
-	(*PaymentDue)(nil),                     // 36: finance.PaymentDue
-	(*CreatePaymentDueRequest)(nil),        // 37: finance.CreatePaymentDueRequest
-	(*GetPaymentDueRequest)(nil),           // 38: finance.GetPaymentDueRequest
-	(*UpdatePaymentDueRequest)(nil),        // 39: finance.UpdatePaymentDueRequest
-	(*DeletePaymentDueRequest)(nil),        // 40: finance.DeletePaymentDueRequest
-	(*MarkPaymentAsPaidRequest)(nil),       // 41: finance.MarkPaymentAsPaidRequest
-	(*ListPaymentDuesRequest)(nil),         // 42: finance.ListPaymentDuesRequest
-	(*ListPaymentDuesResponse)(nil),        // 43: finance.ListPaymentDuesResponse
-	(*BankAccount)(nil),                    // 44: finance.BankAccount
-	(*CreateBankAccountRequest)(nil),       // 45: finance.CreateBankAccountRequest
-	(*GetBankAccountRequest)(nil),          // 46: finance.GetBankAccountRequest
-	(*UpdateBankAccountRequest)(nil),       // 47: finance.UpdateBankAccountRequest
-	(*DeleteBankAccountRequest)(nil),       // 48: finance.DeleteBankAccountRequest
-	(*ListBankAccountsRequest)(nil),        // 49: finance.ListBankAccountsRequest
-	(*ListBankAccountsResponse)(nil),       // 50: finance.ListBankAccountsResponse
-	(*BankTransaction)(nil),                // 51: finance.BankTransaction
-	(*ImportBankTransactionsRequest)(nil),  // 52: finance.ImportBankTransactionsRequest
-	(*ImportBankTransactionsResponse)(nil), // 53: finance.ImportBankTransactionsResponse
-	(*ListBankTransactionsRequest)(nil),    // 54: finance.ListBankTransactionsRequest
-	(*ListBankTransactionsResponse)(nil),   // 55: finance.ListBankTransactionsResponse
-	(*ReconcileTransactionRequest)(nil),    // 56: finance.ReconcileTransactionRequest
-	(*Reconciliation)(nil),                 // 57: finance.Reconciliation
-	(*Account)(nil),                        // 58: finance.Account
-	(*CreateAccountRequest)(nil),           // 59: finance.CreateAccountRequest
-	(*GetAccountRequest)(nil),              // 60: finance.GetAccountRequest
-	(*UpdateAccountRequest)(nil),           // 61: finance.UpdateAccountRequest
-	(*DeleteAccountRequest)(nil),           // 62: finance.DeleteAccountRequest
-	(*ListAccountsRequest)(nil),            // 63: finance.ListAccountsRequest
-	(*ListAccountsResponse)(nil),           // 64: finance.ListAccountsResponse
-	(*JournalLine)(nil),                    // 65: finance.JournalLine
-	(*JournalEntry)(nil),                   // 66: finance.JournalEntry
-	(*CreateJournalEntryRequest)(nil),      // 67: finance.CreateJournalEntryRequest
-	(*GetJournalEntryRequest)(nil),         // 68: finance.GetJournalEntryRequest
-	(*UpdateJournalEntryRequest)(nil),      // 69: finance.UpdateJournalEntryRequest
-	(*DeleteJournalEntryRequest)(nil),      // 70: finance.DeleteJournalEntryRequest
-	(*ListJournalEntriesRequest)(nil),      // 71: finance.ListJournalEntriesRequest
-	(*ListJournalEntriesResponse)(nil),     // 72: finance.ListJournalEntriesResponse
-	(*LedgerEntry)(nil),                    // 73: finance.LedgerEntry
-	(*ListLedgerEntriesRequest)(nil),       // 74: finance.ListLedgerEntriesRequest
-	(*ListLedgerEntriesResponse)(nil),      // 75: finance.ListLedgerEntriesResponse
-	(*Budget)(nil),                         // 76: finance.Budget
-	(*CreateBudgetRequest)(nil),            // 77: finance.CreateBudgetRequest
-	(*GetBudgetRequest)(nil),               // 78: finance.GetBudgetRequest
-	(*UpdateBudgetRequest)(nil),            // 79: finance.UpdateBudgetRequest
-	(*DeleteBudgetRequest)(nil),            // 80: finance.DeleteBudgetRequest
-	(*ListBudgetsRequest)(nil),             // 81: finance.ListBudgetsRequest
-	(*ListBudgetsResponse)(nil),            // 82: finance.ListBudgetsResponse
-	(*BudgetAllocation)(nil),               // 83: finance.BudgetAllocation
-	(*AllocateBudgetRequest)(nil),          // 84: finance.AllocateBudgetRequest
-	(*GetBudgetAllocationRequest)(nil),     // 85: finance.GetBudgetAllocationRequest
-	(*UpdateBudgetAllocationRequest)(nil),  // 86: finance.UpdateBudgetAllocationRequest
-	(*DeleteBudgetAllocationRequest)(nil),  // 87: finance.DeleteBudgetAllocationRequest
-	(*ListBudgetAllocationsRequest)(nil),   // 88: finance.ListBudgetAllocationsRequest
-	(*ListBudgetAllocationsResponse)(nil),  // 89: finance.ListBudgetAllocationsResponse
-	(*BudgetComparisonRequest)(nil),        // 90: finance.BudgetComparisonRequest
-	(*BudgetComparisonResponse)(nil),       // 91: finance.BudgetComparisonResponse
-	(*ExpenseRate)(nil),                    // 92: finance.ExpenseRate
-	(*CreateExpenseRateRequest)(nil),       // 93: finance.CreateExpenseRateRequest
-	(*GetExpenseRateRequest)(nil),          // 94: finance.GetExpenseRateRequest
-	(*UpdateExpenseRateRequest)(nil),       // 95: finance.UpdateExpenseRateRequest
-	(*DeleteExpenseRateRequest)(nil),       // 96: finance.DeleteExpenseRateRequest
-	(*ListExpensesRateRequest)(nil),        // 97: finance.ListExpensesRateRequest
-	(*ListExpensesRateResponse)(nil),       // 98: finance.ListExpensesRateResponse
-	(*CostCenter)(nil),                     // 99: finance.CostCenter
-	(*CreateCostCenterRequest)(nil),        // 100: finance.CreateCostCenterRequest
-	(*GetCostCenterRequest)(nil),           // 101: finance.GetCostCenterRequest
-	(*UpdateCostCenterRequest)(nil),        // 102: finance.UpdateCostCenterRequest
-	(*DeleteCostCenterRequest)(nil),        // 103: finance.DeleteCostCenterRequest
-	(*ListCostCentersRequest)(nil),         // 104: finance.ListCostCentersRequest
-	(*ListCostCentersResponse)(nil),        // 105: finance.ListCostCentersResponse
-	(*CostAllocation)(nil),                 // 106: finance.CostAllocation
-	(*AllocateCostRequest)(nil),            // 107: finance.AllocateCostRequest
-	(*AllocateCostResponse)(nil),           // 108: finance.AllocateCostResponse
-	(*ListCostAllocationsRequest)(nil),     // 109: finance.ListCostAllocationsRequest
-	(*ListCostAllocationsResponse)(nil),    // 110: finance.ListCostAllocationsResponse
-	(*AuditEvent)(nil),                     // 111: finance.AuditEvent
-	(*RecordAuditEventRequest)(nil),        // 112: finance.RecordAuditEventRequest
-	(*ListAuditEventsRequest)(nil),         // 113: finance.ListAuditEventsRequest
-	(*ListAuditEventsResponse)(nil),        // 114: finance.ListAuditEventsResponse
-	(*GetAuditEventByIdRequest)(nil),       // 115: finance.GetAuditEventByIdRequest
-	(*FilterAuditEventsRequest)(nil),       // 116: finance.FilterAuditEventsRequest
-	(*FilterAuditEventsResponse)(nil),      // 117: finance.FilterAuditEventsResponse
-	(*Accrual)(nil),                        // 118: finance.Accrual
-	(*CreateAccrualRequest)(nil),           // 119: finance.CreateAccrualRequest
-	(*GetAccrualByIdRequest)(nil),          // 120: finance.GetAccrualByIdRequest
-	(*UpdateAccrualRequest)(nil),           // 121: finance.UpdateAccrualRequest
-	(*DeleteAccrualRequest)(nil),           // 122: finance.DeleteAccrualRequest
-	(*ListAccrualsRequest)(nil),            // 123: finance.ListAccrualsRequest
-	(*ListAccrualsResponse)(nil),           // 124: finance.ListAccrualsResponse
-	(*AllocationRule)(nil),                 // 125: finance.AllocationRule
-	(*CreateAllocationRuleRequest)(nil),    // 126: finance.CreateAllocationRuleRequest
-	(*GetAllocationRuleRequest)(nil),       // 127: finance.GetAllocationRuleRequest
-	(*UpdateAllocationRuleRequest)(nil),    // 128: finance.UpdateAllocationRuleRequest
-	(*DeleteAllocationRuleRequest)(nil),    // 129: finance.DeleteAllocationRuleRequest
-	(*ListAllocationRulesRequest)(nil),     // 130: finance.ListAllocationRulesRequest
-	(*ListAllocationRulesResponse)(nil),    // 131: finance.ListAllocationRulesResponse
-	(*ReportPeriod)(nil),                   // 132: finance.ReportPeriod
-	(*ProfitLossReport)(nil),               // 133: finance.ProfitLossReport
-	(*BalanceSheetReport)(nil),             // 134: finance.BalanceSheetReport
-	(*TrialBalanceReport)(nil),             // 135: finance.TrialBalanceReport
-	(*ReportRequest)(nil),                  // 136: finance.ReportRequest
-	(*ComplianceReportRequest)(nil),        // 137: finance.ComplianceReportRequest
-	(*ComplianceReport)(nil),               // 138: finance.ComplianceReport
-	(*Consolidation)(nil),                  // 139: finance.Consolidation
-	(*CreateConsolidationRequest)(nil),     // 140: finance.CreateConsolidationRequest
-	(*GetConsolidationRequest)(nil),        // 141: finance.GetConsolidationRequest
-	(*ListConsolidationsRequest)(nil),      // 142: finance.ListConsolidationsRequest
-	(*ListConsolidationsResponse)(nil),     // 143: finance.ListConsolidationsResponse
-	(*DeleteConsolidationRequest)(nil),     // 144: finance.DeleteConsolidationRequest
-	(*ConsolidationRequest)(nil),           // 145: finance.ConsolidationRequest
-	(*ConsolidationResponse)(nil),          // 146: finance.ConsolidationResponse
-	(*ExchangeRate)(nil),                   // 147: finance.ExchangeRate
-	(*CreateExchangeRateRequest)(nil),      // 148: finance.CreateExchangeRateRequest
-	(*GetExchangeRateRequest)(nil),         // 149: finance.GetExchangeRateRequest
-	(*UpdateExchangeRateRequest)(nil),      // 150: finance.UpdateExchangeRateRequest
-	(*DeleteExchangeRateRequest)(nil),      // 151: finance.DeleteExchangeRateRequest
-	(*ListExchangeRatesRequest)(nil),       // 152: finance.ListExchangeRatesRequest
-	(*ListExchangeRatesResponse)(nil),      // 153: finance.ListExchangeRatesResponse
-	(*ConvertMoneyRequest)(nil),            // 154: finance.ConvertMoneyRequest
-	(*ConvertMoneyResponse)(nil),           // 155: finance.ConvertMoneyResponse
-	(*CashFlowForecastRequest)(nil),        // 156: finance.CashFlowForecastRequest
-	(*CashFlowForecastResponse)(nil),       // 157: finance.CashFlowForecastResponse
-	(*FinanceInvoiceCreatedEvent)(nil),     // 158: finance.FinanceInvoiceCreatedEvent
-	(*FinancePaymentReceivedEvent)(nil),    // 159: finance.FinancePaymentReceivedEvent
-	(*InventoryCostPostedEvent)(nil),       // 160: finance.InventoryCostPostedEvent
-	(*PayrollPostedEvent)(nil),             // 161: finance.PayrollPostedEvent
-	(*VendorBillApprovedEvent)(nil),        // 162: finance.VendorBillApprovedEvent
-	(*timestamppb.Timestamp)(nil),          // 163: google.protobuf.Timestamp
-	(*money.Money)(nil),                    // 164: google.type.Money
-	(*fieldmaskpb.FieldMask)(nil),          // 165: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                  // 166: google.protobuf.Empty
+	(InvoiceType)(0),                          // 0: finance.InvoiceType
+	(InvoiceStatus)(0),                        // 1: finance.InvoiceStatus
+	(PaymentStatus)(0),                        // 2: finance.PaymentStatus
+	(NoteType)(0),                             // 3: finance.NoteType
+	(TaxType)(0),                              // 4: finance.TaxType
+	(LedgerSide)(0),                           // 5: finance.LedgerSide
+	(AccountType)(0),                          // 6: finance.AccountType
+	(AccountStatus)(0),                        // 7: finance.AccountStatus
+	(TemplateFrequency)(0),                    // 8: finance.TemplateFrequency
+	(JournalStatus)(0),                        // 9: finance.JournalStatus
+	(PeriodStatus)(0),                         // 10: finance.PeriodStatus
+	(PartyKind)(0),                            // 11: finance.PartyKind
+	(AddressKind)(0),                          // 12: finance.AddressKind
+	(CreditAction)(0),                         // 13: finance.CreditAction
+	(GstDocStatus_EInvoiceStatus)(0),          // 14: finance.GstDocStatus.EInvoiceStatus
+	(GstDocStatus_EWayStatus)(0),              // 15: finance.GstDocStatus.EWayStatus
+	(*RequestMetadata)(nil),                   // 16: finance.RequestMetadata
+	(*AuditFields)(nil),                       // 17: finance.AuditFields
+	(*PageRequest)(nil),                       // 18: finance.PageRequest
+	(*PageResponse)(nil),                      // 19: finance.PageResponse
+	(*Id)(nil),                                // 20: finance.Id
+	(*TaxLine)(nil),                           // 21: finance.TaxLine
+	(*Discount)(nil),                          // 22: finance.Discount
+	(*GstBreakup)(nil),                        // 23: finance.GstBreakup
+	(*GstTaxRegime)(nil),                      // 24: finance.GstTaxRegime
+	(*GstDocStatus)(nil),                      // 25: finance.GstDocStatus
+	(*InvoiceItem)(nil),                       // 26: finance.InvoiceItem
+	(*Invoice)(nil),                           // 27: finance.Invoice
+	(*CreateInvoiceRequest)(nil),              // 28: finance.CreateInvoiceRequest
+	(*GetInvoiceRequest)(nil),                 // 29: finance.GetInvoiceRequest
+	(*UpdateInvoiceRequest)(nil),              // 30: finance.UpdateInvoiceRequest
+	(*DeleteInvoiceRequest)(nil),              // 31: finance.DeleteInvoiceRequest
+	(*ListInvoicesRequest)(nil),               // 32: finance.ListInvoicesRequest
+	(*ListInvoicesResponse)(nil),              // 33: finance.ListInvoicesResponse
+	(*SearchInvoicesRequest)(nil),             // 34: finance.SearchInvoicesRequest
+	(*IssueInvoiceRequest)(nil),               // 35: finance.IssueInvoiceRequest
+	(*VoidInvoiceRequest)(nil),                // 36: finance.VoidInvoiceRequest
+	(*MarkOverdueRequest)(nil),                // 37: finance.MarkOverdueRequest
+	(*InvoicePostingRule)(nil),                // 38: finance.InvoicePostingRule
+	(*SetInvoicePostingRuleRequest)(nil),      // 39: finance.SetInvoicePostingRuleRequest
+	(*GetInvoicePostingRuleRequest)(nil),      // 40: finance.GetInvoicePostingRuleRequest
+	(*InvoiceNumberSeries)(nil),               // 41: finance.InvoiceNumberSeries
+	(*InvoiceTemplate)(nil),                   // 42: finance.InvoiceTemplate
+	(*SetInvoiceTemplateRequest)(nil),         // 43: finance.SetInvoiceTemplateRequest
+	(*GetInvoiceTemplateRequest)(nil),         // 44: finance.GetInvoiceTemplateRequest
+	(*RenderInvoiceRequest)(nil),              // 45: finance.RenderInvoiceRequest
+	(*RenderedInvoice)(nil),                   // 46: finance.RenderedInvoice
+	(*ExportInvoiceRequest)(nil),              // 47: finance.ExportInvoiceRequest
+	(*ImportInvoiceRequest)(nil),              // 48: finance.ImportInvoiceRequest
+	(*SetInvoiceNumberSeriesRequest)(nil),     // 49: finance.SetInvoiceNumberSeriesRequest
+	(*GetInvoiceNumberSeriesRequest)(nil),     // 50: finance.GetInvoiceNumberSeriesRequest
+	(*PartyContact)(nil),                      // 51: finance.PartyContact
+	(*PartyAddress)(nil),                      // 52: finance.PartyAddress
+	(*PartyTaxRegistration)(nil),              // 53: finance.PartyTaxRegistration
+	(*PartyBankAccount)(nil),                  // 54: finance.PartyBankAccount
+	(*Party)(nil),                             // 55: finance.Party
+	(*CreatePartyRequest)(nil),                // 56: finance.CreatePartyRequest
+	(*GetPartyRequest)(nil),                   // 57: finance.GetPartyRequest
+	(*UpdatePartyRequest)(nil),                // 58: finance.UpdatePartyRequest
+	(*DeletePartyRequest)(nil),                // 59: finance.DeletePartyRequest
+	(*ListPartiesRequest)(nil),                // 60: finance.ListPartiesRequest
+	(*ListPartiesResponse)(nil),               // 61: finance.ListPartiesResponse
+	(*CreditPolicy)(nil),                      // 62: finance.CreditPolicy
+	(*CreditExposure)(nil),                    // 63: finance.CreditExposure
+	(*GetCreditPolicyRequest)(nil),            // 64: finance.GetCreditPolicyRequest
+	(*SetCreditPolicyRequest)(nil),            // 65: finance.SetCreditPolicyRequest
+	(*GetCreditExposureRequest)(nil),          // 66: finance.GetCreditExposureRequest
+	(*ApproveInvoiceCreditRequest)(nil),       // 67: finance.ApproveInvoiceCreditRequest
+	(*DunningStage)(nil),                      // 68: finance.DunningStage
+	(*DunningSchedule)(nil),                   // 69: finance.DunningSchedule
+	(*DunningHold)(nil),                       // 70: finance.DunningHold
+	(*DunningReminder)(nil),                   // 71: finance.DunningReminder
+	(*SetDunningScheduleRequest)(nil),         // 72: finance.SetDunningScheduleRequest
+	(*GetDunningScheduleRequest)(nil),         // 73: finance.GetDunningScheduleRequest
+	(*PlaceDunningHoldRequest)(nil),           // 74: finance.PlaceDunningHoldRequest
+	(*ReleaseDunningHoldRequest)(nil),         // 75: finance.ReleaseDunningHoldRequest
+	(*ListDunningHoldsRequest)(nil),           // 76: finance.ListDunningHoldsRequest
+	(*ListDunningHoldsResponse)(nil),          // 77: finance.ListDunningHoldsResponse
+	(*RunDunningRequest)(nil),                 // 78: finance.RunDunningRequest
+	(*RunDunningResponse)(nil),                // 79: finance.RunDunningResponse
+	(*RecurringInvoice)(nil),                  // 80: finance.RecurringInvoice
+	(*CreateRecurringInvoiceRequest)(nil),     // 81: finance.CreateRecurringInvoiceRequest
+	(*GetRecurringInvoiceRequest)(nil),        // 82: finance.GetRecurringInvoiceRequest
+	(*UpdateRecurringInvoiceRequest)(nil),     // 83: finance.UpdateRecurringInvoiceRequest
+	(*DeleteRecurringInvoiceRequest)(nil),     // 84: finance.DeleteRecurringInvoiceRequest
+	(*ListRecurringInvoicesRequest)(nil),      // 85: finance.ListRecurringInvoicesRequest
+	(*ListRecurringInvoicesResponse)(nil),     // 86: finance.ListRecurringInvoicesResponse
+	(*GenerateRecurringInvoicesRequest)(nil),  // 87: finance.GenerateRecurringInvoicesRequest
+	(*GeneratedInvoice)(nil),                  // 88: finance.GeneratedInvoice
+	(*GenerateRecurringInvoicesResponse)(nil), // 89: finance.GenerateRecurringInvoicesResponse
+	(*CreditDebitNote)(nil),                   // 90: finance.CreditDebitNote
+	(*CreateCreditDebitNoteRequest)(nil),      // 91: finance.CreateCreditDebitNoteRequest
+	(*GetCreditDebitNoteRequest)(nil),         // 92: finance.GetCreditDebitNoteRequest
+	(*UpdateCreditDebitNoteRequest)(nil),      // 93: finance.UpdateCreditDebitNoteRequest
+	(*DeleteCreditDebitNoteRequest)(nil),      // 94: finance.DeleteCreditDebitNoteRequest
+	(*ListCreditDebitNotesRequest)(nil),       // 95: finance.ListCreditDebitNotesRequest
+	(*ListCreditDebitNotesResponse)(nil),      // 96: finance.ListCreditDebitNotesResponse
+	(*PaymentDue)(nil),                        // 97: finance.PaymentDue
+	(*CreatePaymentDueRequest)(nil),           // 98: finance.CreatePaymentDueRequest
+	(*GetPaymentDueRequest)(nil),              // 99: finance.GetPaymentDueRequest
+	(*UpdatePaymentDueRequest)(nil),           // 100: finance.UpdatePaymentDueRequest
+	(*DeletePaymentDueRequest)(nil),           // 101: finance.DeletePaymentDueRequest
+	(*MarkPaymentAsPaidRequest)(nil),          // 102: finance.MarkPaymentAsPaidRequest
+	(*ListPaymentDuesRequest)(nil),            // 103: finance.ListPaymentDuesRequest
+	(*ListPaymentDuesResponse)(nil),           // 104: finance.ListPaymentDuesResponse
+	(*BankAccount)(nil),                       // 105: finance.BankAccount
+	(*CreateBankAccountRequest)(nil),          // 106: finance.CreateBankAccountRequest
+	(*GetBankAccountRequest)(nil),             // 107: finance.GetBankAccountRequest
+	(*UpdateBankAccountRequest)(nil),          // 108: finance.UpdateBankAccountRequest
+	(*DeleteBankAccountRequest)(nil),          // 109: finance.DeleteBankAccountRequest
+	(*ListBankAccountsRequest)(nil),           // 110: finance.ListBankAccountsRequest
+	(*ListBankAccountsResponse)(nil),          // 111: finance.ListBankAccountsResponse
+	(*BankTransaction)(nil),                   // 112: finance.BankTransaction
+	(*ImportBankTransactionsRequest)(nil),     // 113: finance.ImportBankTransactionsRequest
+	(*ImportBankTransactionsResponse)(nil),    // 114: finance.ImportBankTransactionsResponse
+	(*ListBankTransactionsRequest)(nil),       // 115: finance.ListBankTransactionsRequest
+	(*ListBankTransactionsResponse)(nil),      // 116: finance.ListBankTransactionsResponse
+	(*ReconcileTransactionRequest)(nil),       // 117: finance.ReconcileTransactionRequest
+	(*Reconciliation)(nil),                    // 118: finance.Reconciliation
+	(*Account)(nil),                           // 119: finance.Account
+	(*CreateAccountRequest)(nil),              // 120: finance.CreateAccountRequest
+	(*GetAccountRequest)(nil),                 // 121: finance.GetAccountRequest
+	(*UpdateAccountRequest)(nil),              // 122: finance.UpdateAccountRequest
+	(*DeleteAccountRequest)(nil),              // 123: finance.DeleteAccountRequest
+	(*ListAccountsRequest)(nil),               // 124: finance.ListAccountsRequest
+	(*ListAccountsResponse)(nil),              // 125: finance.ListAccountsResponse
+	(*JournalLine)(nil),                       // 126: finance.JournalLine
+	(*JournalEntry)(nil),                      // 127: finance.JournalEntry
+	(*CreateJournalEntryRequest)(nil),         // 128: finance.CreateJournalEntryRequest
+	(*GetJournalEntryRequest)(nil),            // 129: finance.GetJournalEntryRequest
+	(*UpdateJournalEntryRequest)(nil),         // 130: finance.UpdateJournalEntryRequest
+	(*DeleteJournalEntryRequest)(nil),         // 131: finance.DeleteJournalEntryRequest
+	(*ReverseJournalEntryRequest)(nil),        // 132: finance.ReverseJournalEntryRequest
+	(*ListJournalEntriesRequest)(nil),         // 133: finance.ListJournalEntriesRequest
+	(*ListJournalEntriesResponse)(nil),        // 134: finance.ListJournalEntriesResponse
+	(*LedgerEntry)(nil),                       // 135: finance.LedgerEntry
+	(*ListLedgerEntriesRequest)(nil),          // 136: finance.ListLedgerEntriesRequest
+	(*ListLedgerEntriesResponse)(nil),         // 137: finance.ListLedgerEntriesResponse
+	(*AccountBalance)(nil),                    // 138: finance.AccountBalance
+	(*CurrencyBalance)(nil),                   // 139: finance.CurrencyBalance
+	(*GetAccountBalanceRequest)(nil),          // 140: finance.GetAccountBalanceRequest
+	(*ListAccountBalancesRequest)(nil),        // 141: finance.ListAccountBalancesRequest
+	(*ListAccountBalancesResponse)(nil),       // 142: finance.ListAccountBalancesResponse
+	(*AccountTreeNode)(nil),                   // 143: finance.AccountTreeNode
+	(*GetAccountTreeRequest)(nil),             // 144: finance.GetAccountTreeRequest
+	(*GetAccountTreeResponse)(nil),            // 145: finance.GetAccountTreeResponse
+	(*JournalTemplate)(nil),                   // 146: finance.JournalTemplate
+	(*CreateJournalTemplateRequest)(nil),      // 147: finance.CreateJournalTemplateRequest
+	(*GetJournalTemplateRequest)(nil),         // 148: finance.GetJournalTemplateRequest
+	(*UpdateJournalTemplateRequest)(nil),      // 149: finance.UpdateJournalTemplateRequest
+	(*DeleteJournalTemplateRequest)(nil),      // 150: finance.DeleteJournalTemplateRequest
+	(*ListJournalTemplatesRequest)(nil),       // 151: finance.ListJournalTemplatesRequest
+	(*ListJournalTemplatesResponse)(nil),      // 152: finance.ListJournalTemplatesResponse
+	(*GenerateRecurringJournalsRequest)(nil),  // 153: finance.GenerateRecurringJournalsRequest
+	(*GenerateRecurringJournalsResponse)(nil), // 154: finance.GenerateRecurringJournalsResponse
+	(*ImportJournalEntriesRequest)(nil),       // 155: finance.ImportJournalEntriesRequest
+	(*ImportRowError)(nil),                    // 156: finance.ImportRowError
+	(*ImportJournalEntriesResponse)(nil),      // 157: finance.ImportJournalEntriesResponse
+	(*FiscalCalendar)(nil),                    // 158: finance.FiscalCalendar
+	(*FiscalPeriod)(nil),                      // 159: finance.FiscalPeriod
+	(*SetFiscalCalendarRequest)(nil),          // 160: finance.SetFiscalCalendarRequest
+	(*CreateFiscalYearRequest)(nil),           // 161: finance.CreateFiscalYearRequest
+	(*ListFiscalPeriodsRequest)(nil),          // 162: finance.ListFiscalPeriodsRequest
+	(*ListFiscalPeriodsResponse)(nil),         // 163: finance.ListFiscalPeriodsResponse
+	(*OpenPeriodRequest)(nil),                 // 164: finance.OpenPeriodRequest
+	(*ClosePeriodRequest)(nil),                // 165: finance.ClosePeriodRequest
+	(*CloseFiscalYearRequest)(nil),            // 166: finance.CloseFiscalYearRequest
+	(*CloseFiscalYearResponse)(nil),           // 167: finance.CloseFiscalYearResponse
+	(*Budget)(nil),                            // 168: finance.Budget
+	(*CreateBudgetRequest)(nil),               // 169: finance.CreateBudgetRequest
+	(*GetBudgetRequest)(nil),                  // 170: finance.GetBudgetRequest
+	(*UpdateBudgetRequest)(nil),               // 171: finance.UpdateBudgetRequest
+	(*DeleteBudgetRequest)(nil),               // 172: finance.DeleteBudgetRequest
+	(*ListBudgetsRequest)(nil),                // 173: finance.ListBudgetsRequest
+	(*ListBudgetsResponse)(nil),               // 174: finance.ListBudgetsResponse
+	(*BudgetAllocation)(nil),                  // 175: finance.BudgetAllocation
+	(*AllocateBudgetRequest)(nil),             // 176: finance.AllocateBudgetRequest
+	(*GetBudgetAllocationRequest)(nil),        // 177: finance.GetBudgetAllocationRequest
+	(*UpdateBudgetAllocationRequest)(nil),     // 178: finance.UpdateBudgetAllocationRequest
+	(*DeleteBudgetAllocationRequest)(nil),     // 179: finance.DeleteBudgetAllocationRequest
+	(*ListBudgetAllocationsRequest)(nil),      // 180: finance.ListBudgetAllocationsRequest
+	(*ListBudgetAllocationsResponse)(nil),     // 181: finance.ListBudgetAllocationsResponse
+	(*BudgetComparisonRequest)(nil),           // 182: finance.BudgetComparisonRequest
+	(*BudgetComparisonResponse)(nil),          // 183: finance.BudgetComparisonResponse
+	(*ExpenseRate)(nil),                       // 184: finance.ExpenseRate
+	(*CreateExpenseRateRequest)(nil),          // 185: finance.CreateExpenseRateRequest
+	(*GetExpenseRateRequest)(nil),             // 186: finance.GetExpenseRateRequest
+	(*UpdateExpenseRateRequest)(nil),          // 187: finance.UpdateExpenseRateRequest
+	(*DeleteExpenseRateRequest)(nil),          // 188: finance.DeleteExpenseRateRequest
+	(*ListExpensesRateRequest)(nil),           // 189: finance.ListExpensesRateRequest
+	(*ListExpensesRateResponse)(nil),          // 190: finance.ListExpensesRateResponse
+	(*CostCenter)(nil),                        // 191: finance.CostCenter
+	(*CreateCostCenterRequest)(nil),           // 192: finance.CreateCostCenterRequest
+	(*GetCostCenterRequest)(nil),              // 193: finance.GetCostCenterRequest
+	(*UpdateCostCenterRequest)(nil),           // 194: finance.UpdateCostCenterRequest
+	(*DeleteCostCenterRequest)(nil),           // 195: finance.DeleteCostCenterRequest
+	(*ListCostCentersRequest)(nil),            // 196: finance.ListCostCentersRequest
+	(*ListCostCentersResponse)(nil),           // 197: finance.ListCostCentersResponse
+	(*CostAllocation)(nil),                    // 198: finance.CostAllocation
+	(*AllocateCostRequest)(nil),               // 199: finance.AllocateCostRequest
+	(*AllocateCostResponse)(nil),              // 200: finance.AllocateCostResponse
+	(*ListCostAllocationsRequest)(nil),        // 201: finance.ListCostAllocationsRequest
+	(*ListCostAllocationsResponse)(nil),       // 202: finance.ListCostAllocationsResponse
+	(*AuditEvent)(nil),                        // 203: finance.AuditEvent
+	(*RecordAuditEventRequest)(nil),           // 204: finance.RecordAuditEventRequest
+	(*ListAuditEventsRequest)(nil),            // 205: finance.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),           // 206: finance.ListAuditEventsResponse
+	(*GetAuditEventByIdRequest)(nil),          // 207: finance.GetAuditEventByIdRequest
+	(*FilterAuditEventsRequest)(nil),          // 208: finance.FilterAuditEventsRequest
+	(*FilterAuditEventsResponse)(nil),         // 209: finance.FilterAuditEventsResponse
+	(*Accrual)(nil),                           // 210: finance.Accrual
+	(*CreateAccrualRequest)(nil),              // 211: finance.CreateAccrualRequest
+	(*GetAccrualByIdRequest)(nil),             // 212: finance.GetAccrualByIdRequest
+	(*UpdateAccrualRequest)(nil),              // 213: finance.UpdateAccrualRequest
+	(*DeleteAccrualRequest)(nil),              // 214: finance.DeleteAccrualRequest
+	(*ListAccrualsRequest)(nil),               // 215: finance.ListAccrualsRequest
+	(*ListAccrualsResponse)(nil),              // 216: finance.ListAccrualsResponse
+	(*AllocationRule)(nil),                    // 217: finance.AllocationRule
+	(*CreateAllocationRuleRequest)(nil),       // 218: finance.CreateAllocationRuleRequest
+	(*GetAllocationRuleRequest)(nil),          // 219: finance.GetAllocationRuleRequest
+	(*UpdateAllocationRuleRequest)(nil),       // 220: finance.UpdateAllocationRuleRequest
+	(*DeleteAllocationRuleRequest)(nil),       // 221: finance.DeleteAllocationRuleRequest
+	(*ListAllocationRulesRequest)(nil),        // 222: finance.ListAllocationRulesRequest
+	(*ListAllocationRulesResponse)(nil),       // 223: finance.ListAllocationRulesResponse
+	(*ReportPeriod)(nil),                      // 224: finance.ReportPeriod
+	(*ProfitLossReport)(nil),                  // 225: finance.ProfitLossReport
+	(*BalanceSheetReport)(nil),                // 226: finance.BalanceSheetReport
+	(*TrialBalanceReport)(nil),                // 227: finance.TrialBalanceReport
+	(*ReportRequest)(nil),                     // 228: finance.ReportRequest
+	(*ComplianceReportRequest)(nil),           // 229: finance.ComplianceReportRequest
+	(*ComplianceReport)(nil),                  // 230: finance.ComplianceReport
+	(*Consolidation)(nil),                     // 231: finance.Consolidation
+	(*CreateConsolidationRequest)(nil),        // 232: finance.CreateConsolidationRequest
+	(*GetConsolidationRequest)(nil),           // 233: finance.GetConsolidationRequest
+	(*ListConsolidationsRequest)(nil),         // 234: finance.ListConsolidationsRequest
+	(*ListConsolidationsResponse)(nil),        // 235: finance.ListConsolidationsResponse
+	(*DeleteConsolidationRequest)(nil),        // 236: finance.DeleteConsolidationRequest
+	(*ConsolidationRequest)(nil),              // 237: finance.ConsolidationRequest
+	(*ConsolidationResponse)(nil),             // 238: finance.ConsolidationResponse
+	(*ExchangeRate)(nil),                      // 239: finance.ExchangeRate
+	(*CreateExchangeRateRequest)(nil),         // 240: finance.CreateExchangeRateRequest
+	(*GetExchangeRateRequest)(nil),            // 241: finance.GetExchangeRateRequest
+	(*UpdateExchangeRateRequest)(nil),         // 242: finance.UpdateExchangeRateRequest
+	(*DeleteExchangeRateRequest)(nil),         // 243: finance.DeleteExchangeRateRequest
+	(*ListExchangeRatesRequest)(nil),          // 244: finance.ListExchangeRatesRequest
+	(*ListExchangeRatesResponse)(nil),         // 245: finance.ListExchangeRatesResponse
+	(*ConvertMoneyRequest)(nil),               // 246: finance.ConvertMoneyRequest
+	(*ConvertMoneyResponse)(nil),              // 247: finance.ConvertMoneyResponse
+	(*FxRevaluationSettings)(nil),             // 248: finance.FxRevaluationSettings
+	(*SetFxRevaluationSettingsRequest)(nil),   // 249: finance.SetFxRevaluationSettingsRequest
+	(*GetFxRevaluationSettingsRequest)(nil),   // 250: finance.GetFxRevaluationSettingsRequest
+	(*RevalueForeignCurrencyRequest)(nil),     // 251: finance.RevalueForeignCurrencyRequest
+	(*FxRevaluationItem)(nil),                 // 252: finance.FxRevaluationItem
+	(*RevalueForeignCurrencyResponse)(nil),    // 253: finance.RevalueForeignCurrencyResponse
+	(*CashFlowForecastRequest)(nil),           // 254: finance.CashFlowForecastRequest
+	(*CashFlowForecastResponse)(nil),          // 255: finance.CashFlowForecastResponse
+	(*FinanceInvoiceCreatedEvent)(nil),        // 256: finance.FinanceInvoiceCreatedEvent
+	(*FinancePaymentReceivedEvent)(nil),       // 257: finance.FinancePaymentReceivedEvent
+	(*InventoryCostPostedEvent)(nil),          // 258: finance.InventoryCostPostedEvent
+	(*PayrollPostedEvent)(nil),                // 259: finance.PayrollPostedEvent
+	(*VendorBillApprovedEvent)(nil),           // 260: finance.VendorBillApprovedEvent
+	(*timestamppb.Timestamp)(nil),             // 261: google.protobuf.Timestamp
+	(*money.Money)(nil),                       // 262: google.type.Money
+	(*fieldmaskpb.FieldMask)(nil),             // 263: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                     // 264: google.protobuf.Empty
 }
 var file_finance_proto_depIdxs = []int32{
-	163, // 0: finance.AuditFields.created_at:type_name -> google.protobuf.Timestamp
-	163, // 1: finance.AuditFields.updated_at:type_name -> google.protobuf.Timestamp
+	261, // 0: finance.AuditFields.created_at:type_name -> google.protobuf.Timestamp
+	261, // 1: finance.AuditFields.updated_at:type_name -> google.protobuf.Timestamp
 	4,   // 2: finance.TaxLine.type:type_name -> finance.TaxType
-	164, // 3: finance.TaxLine.amount:type_name -> google.type.Money
-	164, // 4: finance.Discount.amount:type_name -> google.type.Money
-	164, // 5: finance.GstBreakup.taxable_amount:type_name -> google.type.Money
-	164, // 6: finance.GstBreakup.cgst:type_name -> google.type.Money
-	164, // 7: finance.GstBreakup.sgst:type_name -> google.type.Money
-	164, // 8: finance.GstBreakup.igst:type_name -> google.type.Money
-	164, // 9: finance.GstBreakup.total_gst:type_name -> google.type.Money
-	8,   // 10: finance.GstDocStatus.einvoice_status:type_name -> finance.GstDocStatus.EInvoiceStatus
-	163, // 11: finance.GstDocStatus.ack_date:type_name -> google.protobuf.Timestamp
-	9,   // 12: finance.GstDocStatus.eway_status:type_name -> finance.GstDocStatus.EWayStatus
-	163, // 13: finance.GstDocStatus.eway_valid_upto:type_name -> google.protobuf.Timestamp
-	163, // 14: finance.GstDocStatus.last_synced_at:type_name -> google.protobuf.Timestamp
-	164, // 15: finance.InvoiceItem.unit_price:type_name -> google.type.Money
-	164, // 16: finance.InvoiceItem.line_subtotal:type_name -> google.type.Money
-	16,  // 17: finance.InvoiceItem.discounts:type_name -> finance.Discount
-	15,  // 18: finance.InvoiceItem.taxes:type_name -> finance.TaxLine
-	164, // 19: finance.InvoiceItem.line_total:type_name -> google.type.Money
+	262, // 3: finance.TaxLine.amount:type_name -> google.type.Money
+	262, // 4: finance.Discount.amount:type_name -> google.type.Money
+	262, // 5: finance.GstBreakup.taxable_amount:type_name -> google.type.Money
+	262, // 6: finance.GstBreakup.cgst:type_name -> google.type.Money
+	262, // 7: finance.GstBreakup.sgst:type_name -> google.type.Money
+	262, // 8: finance.GstBreakup.igst:type_name -> google.type.Money
+	262, // 9: finance.GstBreakup.total_gst:type_name -> google.type.Money
+	14,  // 10: finance.GstDocStatus.einvoice_status:type_name -> finance.GstDocStatus.EInvoiceStatus
+	261, // 11: finance.GstDocStatus.ack_date:type_name -> google.protobuf.Timestamp
+	15,  // 12: finance.GstDocStatus.eway_status:type_name -> finance.GstDocStatus.EWayStatus
+	261, // 13: finance.GstDocStatus.eway_valid_upto:type_name -> google.protobuf.Timestamp
+	261, // 14: finance.GstDocStatus.last_synced_at:type_name -> google.protobuf.Timestamp
+	262, // 15: finance.InvoiceItem.unit_price:type_name -> google.type.Money
+	262, // 16: finance.InvoiceItem.line_subtotal:type_name -> google.type.Money
+	22,  // 17: finance.InvoiceItem.discounts:type_name -> finance.Discount
+	21,  // 18: finance.InvoiceItem.taxes:type_name -> finance.TaxLine
+	262, // 19: finance.InvoiceItem.line_total:type_name -> google.type.Money
 	0,   // 20: finance.Invoice.type:type_name -> finance.InvoiceType
-	163, // 21: finance.Invoice.invoice_date:type_name -> google.protobuf.Timestamp
-	163, // 22: finance.Invoice.due_date:type_name -> google.protobuf.Timestamp
-	163, // 23: finance.Invoice.delivery_date:type_name -> google.protobuf.Timestamp
+	261, // 21: finance.Invoice.invoice_date:type_name -> google.protobuf.Timestamp
+	261, // 22: finance.Invoice.due_date:type_name -> google.protobuf.Timestamp
+	261, // 23: finance.Invoice.delivery_date:type_name -> google.protobuf.Timestamp
 	1,   // 24: finance.Invoice.status:type_name -> finance.InvoiceStatus
-	163, // 25: finance.Invoice.challan_date:type_name -> google.protobuf.Timestamp
-	163, // 26: finance.Invoice.against_invoice_date:type_name -> google.protobuf.Timestamp
-	20,  // 27: finance.Invoice.items:type_name -> finance.InvoiceItem
-	164, // 28: finance.Invoice.subtotal:type_name -> google.type.Money
-	16,  // 29: finance.Invoice.discounts:type_name -> finance.Discount
-	15,  // 30: finance.Invoice.taxes:type_name -> finance.TaxLine
-	17,  // 31: finance.Invoice.gst_breakup:type_name -> finance.GstBreakup
-	164, // 32: finance.Invoice.grand_total:type_name -> google.type.Money
-	11,  // 33: finance.Invoice.audit:type_name -> finance.AuditFields
-	18,  // 34: finance.Invoice.gst:type_name -> finance.GstTaxRegime
-	19,  // 35: finance.Invoice.gst_docs:type_name -> finance.GstDocStatus
-	10,  // 36: finance.CreateInvoiceRequest.meta:type_name -> finance.RequestMetadata
-	21,  // 37: finance.CreateInvoiceRequest.invoice:type_name -> finance.Invoice
-	10,  // 38: finance.GetInvoiceRequest.meta:type_name -> finance.RequestMetadata
-	10,  // 39: finance.UpdateInvoiceRequest.meta:type_name -> finance.RequestMetadata
-	21,  // 40: finance.UpdateInvoiceRequest.invoice:type_name -> finance.Invoice
-	165, // 41: finance.UpdateInvoiceRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 42: finance.DeleteInvoiceRequest.meta:type_name -> finance.RequestMetadata
-	12,  // 43: finance.ListInvoicesRequest.page:type_name -> finance.PageRequest
-	21,  // 44: finance.ListInvoicesResponse.invoices:type_name -> finance.Invoice
-	13,  // 45: finance.ListInvoicesResponse.page:type_name -> finance.PageResponse
-	12,  // 46: finance.SearchInvoicesRequest.page:type_name -> finance.PageRequest
-	3,   // 47: finance.CreditDebitNote.type:type_name -> finance.NoteType
-	164, // 48: finance.CreditDebitNote.amount:type_name -> google.type.Money
-	11,  // 49: finance.CreditDebitNote.audit:type_name -> finance.AuditFields
-	10,  // 50: finance.CreateCreditDebitNoteRequest.meta:type_name -> finance.RequestMetadata
-	29,  // 51: finance.CreateCreditDebitNoteRequest.note:type_name -> finance.CreditDebitNote
-	10,  // 52: finance.UpdateCreditDebitNoteRequest.meta:type_name -> finance.RequestMetadata
-	29,  // 53: finance.UpdateCreditDebitNoteRequest.note:type_name -> finance.CreditDebitNote
-	165, // 54: finance.UpdateCreditDebitNoteRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 55: finance.DeleteCreditDebitNoteRequest.meta:type_name -> finance.RequestMetadata
-	12,  // 56: finance.ListCreditDebitNotesRequest.page:type_name -> finance.PageRequest
-	29,  // 57: finance.ListCreditDebitNotesResponse.notes:type_name -> finance.CreditDebitNote
-	13,  // 58: finance.ListCreditDebitNotesResponse.page:type_name -> finance.PageResponse
-	164, // 59: finance.PaymentDue.amount_due:type_name -> google.type.Money
-	163, // 60: finance.PaymentDue.due_date:type_name -> google.protobuf.Timestamp
-	2,   // 61: finance.PaymentDue.status:type_name -> finance.PaymentStatus
-	11,  // 62: finance.PaymentDue.audit:type_name -> finance.AuditFields
-	10,  // 63: finance.CreatePaymentDueRequest.meta:type_name -> finance.RequestMetadata
-	36,  // 64: finance.CreatePaymentDueRequest.due:type_name -> finance.PaymentDue
-	10,  // 65: finance.UpdatePaymentDueRequest.meta:type_name -> finance.RequestMetadata
-	36,  // 66: finance.UpdatePaymentDueRequest.due:type_name -> finance.PaymentDue
-	165, // 67: finance.UpdatePaymentDueRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 68: finance.DeletePaymentDueRequest.meta:type_name -> finance.RequestMetadata
-	10,  // 69: finance.MarkPaymentAsPaidRequest.meta:type_name -> finance.RequestMetadata
-	164, // 70: finance.MarkPaymentAsPaidRequest.amount_paid:type_name -> google.type.Money
-	163, // 71: finance.MarkPaymentAsPaidRequest.paid_at:type_name -> google.protobuf.Timestamp
-	12,  // 72: finance.ListPaymentDuesRequest.page:type_name -> finance.PageRequest
-	36,  // 73: finance.ListPaymentDuesResponse.dues:type_name -> finance.PaymentDue
-	13,  // 74: finance.ListPaymentDuesResponse.page:type_name -> finance.PageResponse
-	11,  // 75: finance.BankAccount.audit:type_name -> finance.AuditFields
-	10,  // 76: finance.CreateBankAccountRequest.meta:type_name -> finance.RequestMetadata
-	44,  // 77: finance.CreateBankAccountRequest.account:type_name -> finance.BankAccount
-	10,  // 78: finance.UpdateBankAccountRequest.meta:type_name -> finance.RequestMetadata
-	44,  // 79: finance.UpdateBankAccountRequest.account:type_name -> finance.BankAccount
-	165, // 80: finance.UpdateBankAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 81: finance.DeleteBankAccountRequest.meta:type_name -> finance.RequestMetadata
-	12,  // 82: finance.ListBankAccountsRequest.page:type_name -> finance.PageRequest
-	44,  // 83: finance.ListBankAccountsResponse.accounts:type_name -> finance.BankAccount
-	13,  // 84: finance.ListBankAccountsResponse.page:type_name -> finance.PageResponse
-	164, // 85: finance.BankTransaction.amount:type_name -> google.type.Money
-	163, // 86: finance.BankTransaction.transaction_date:type_name -> google.protobuf.Timestamp
-	11,  // 87: finance.BankTransaction.audit:type_name -> finance.AuditFields
-	10,  // 88: finance.ImportBankTransactionsRequest.meta:type_name -> finance.RequestMetadata
-	51,  // 89: finance.ImportBankTransactionsRequest.transactions:type_name -> finance.BankTransaction
-	12,  // 90: finance.ListBankTransactionsRequest.page:type_name -> finance.PageRequest
-	51,  // 91: finance.ListBankTransactionsResponse.transactions:type_name -> finance.BankTransaction
-	13,  // 92: finance.ListBankTransactionsResponse.page:type_name -> finance.PageResponse
-	10,  // 93: finance.ReconcileTransactionRequest.meta:type_name -> finance.RequestMetadata
-	164, // 94: finance.ReconcileTransactionRequest.amount:type_name -> google.type.Money
-	163, // 95: finance.ReconcileTransactionRequest.transaction_date:type_name -> google.protobuf.Timestamp
-	6,   // 96: finance.Account.type:type_name -> finance.AccountType
-	7,   // 97: finance.Account.status:type_name -> finance.AccountStatus
-	11,  // 98: finance.Account.audit:type_name -> finance.AuditFields
-	10,  // 99: finance.CreateAccountRequest.meta:type_name -> finance.RequestMetadata
-	58,  // 100: finance.CreateAccountRequest.account:type_name -> finance.Account
-	10,  // 101: finance.UpdateAccountRequest.meta:type_name -> finance.RequestMetadata
-	58,  // 102: finance.UpdateAccountRequest.account:type_name -> finance.Account
-	165, // 103: finance.UpdateAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 104: finance.DeleteAccountRequest.meta:type_name -> finance.RequestMetadata
-	12,  // 105: finance.ListAccountsRequest.page:type_name -> finance.PageRequest
-	58,  // 106: finance.ListAccountsResponse.accounts:type_name -> finance.Account
-	13,  // 107: finance.ListAccountsResponse.page:type_name -> finance.PageResponse
-	5,   // 108: finance.JournalLine.side:type_name -> finance.LedgerSide
-	164, // 109: finance.JournalLine.amount:type_name -> google.type.Money
-	163, // 110: finance.JournalEntry.journal_date:type_name -> google.protobuf.Timestamp
-	65,  // 111: finance.JournalEntry.lines:type_name -> finance.JournalLine
-	11,  // 112: finance.JournalEntry.audit:type_name -> finance.AuditFields
-	10,  // 113: finance.CreateJournalEntryRequest.meta:type_name -> finance.RequestMetadata
-	66,  // 114: finance.CreateJournalEntryRequest.entry:type_name -> finance.JournalEntry
-	10,  // 115: finance.UpdateJournalEntryRequest.meta:type_name -> finance.RequestMetadata
-	66,  // 116: finance.UpdateJournalEntryRequest.entry:type_name -> finance.JournalEntry
-	165, // 117: finance.UpdateJournalEntryRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 118: finance.DeleteJournalEntryRequest.meta:type_name -> finance.RequestMetadata
-	12,  // 119: finance.ListJournalEntriesRequest.page:type_name -> finance.PageRequest
-	66,  // 120: finance.ListJournalEntriesResponse.entries:type_name -> finance.JournalEntry
-	13,  // 121: finance.ListJournalEntriesResponse.page:type_name -> finance.PageResponse
-	5,   // 122: finance.LedgerEntry.side:type_name -> finance.LedgerSide
-	164, // 123: finance.LedgerEntry.amount:type_name -> google.type.Money
-	163, // 124: finance.LedgerEntry.transaction_date:type_name -> google.protobuf.Timestamp
-	11,  // 125: finance.LedgerEntry.audit:type_name -> finance.AuditFields
-	12,  // 126: finance.ListLedgerEntriesRequest.page:type_name -> finance.PageRequest
-	73,  // 127: finance.ListLedgerEntriesResponse.entries:type_name -> finance.LedgerEntry
-	13,  // 128: finance.ListLedgerEntriesResponse.page:type_name -> finance.PageResponse
-	164, // 129: finance.Budget.total_amount:type_name -> google.type.Money
-	11,  // 130: finance.Budget.audit:type_name -> finance.AuditFields
-	10,  // 131: finance.CreateBudgetRequest.meta:type_name -> finance.RequestMetadata
-	76,  // 132: finance.CreateBudgetRequest.budget:type_name -> finance.Budget
-	10,  // 133: finance.UpdateBudgetRequest.meta:type_name -> finance.RequestMetadata
-	76,  // 134: finance.UpdateBudgetRequest.budget:type_name -> finance.Budget
-	165, // 135: finance.UpdateBudgetRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 136: finance.DeleteBudgetRequest.meta:type_name -> finance.RequestMetadata
-	12,  // 137: finance.ListBudgetsRequest.page:type_name -> finance.PageRequest
-	76,  // 138: finance.ListBudgetsResponse.budgets:type_name -> finance.Budget
-	13,  // 139: finance.ListBudgetsResponse.page:type_name -> finance.PageResponse
-	164, // 140: finance.BudgetAllocation.allocated_amount:type_name -> google.type.Money
-	164, // 141: finance.BudgetAllocation.spent_amount:type_name -> google.type.Money
-	164, // 142: finance.BudgetAllocation.remaining_amount:type_name -> google.type.Money
-	11,  // 143: finance.BudgetAllocation.audit:type_name -> finance.AuditFields
-	10,  // 144: finance.AllocateBudgetRequest.meta:type_name -> finance.RequestMetadata
-	83,  // 145: finance.AllocateBudgetRequest.allocation:type_name -> finance.BudgetAllocation
-	10,  // 146: finance.UpdateBudgetAllocationRequest.meta:type_name -> finance.RequestMetadata
-	83,  // 147: finance.UpdateBudgetAllocationRequest.allocation:type_name -> finance.BudgetAllocation
-	165, // 148: finance.UpdateBudgetAllocationRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 149: finance.DeleteBudgetAllocationRequest.meta:type_name -> finance.RequestMetadata
-	12,  // 150: finance.ListBudgetAllocationsRequest.page:type_name -> finance.PageRequest
-	83,  // 151: finance.ListBudgetAllocationsResponse.allocations:type_name -> finance.BudgetAllocation
-	13,  // 152: finance.ListBudgetAllocationsResponse.page:type_name -> finance.PageResponse
-	164, // 153: finance.BudgetComparisonResponse.total_budget:type_name -> google.type.Money
-	164, // 154: finance.BudgetComparisonResponse.total_allocated:type_name -> google.type.Money
-	164, // 155: finance.BudgetComparisonResponse.total_spent:type_name -> google.type.Money
-	164, // 156: finance.BudgetComparisonResponse.remaining_budget:type_name -> google.type.Money
-	164, // 157: finance.ExpenseRate.amount:type_name -> google.type.Money
-	163, // 158: finance.ExpenseRate.expense_date:type_name -> google.protobuf.Timestamp
-	11,  // 159: finance.ExpenseRate.audit:type_name -> finance.AuditFields
-	10,  // 160: finance.CreateExpenseRateRequest.meta:type_name -> finance.RequestMetadata
-	92,  // 161: finance.CreateExpenseRateRequest.expense_rate:type_name -> finance.ExpenseRate
-	10,  // 162: finance.UpdateExpenseRateRequest.meta:type_name -> finance.RequestMetadata
-	92,  // 163: finance.UpdateExpenseRateRequest.expense_rate:type_name -> finance.ExpenseRate
-	165, // 164: finance.UpdateExpenseRateRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 165: finance.DeleteExpenseRateRequest.meta:type_name -> finance.RequestMetadata
-	12,  // 166: finance.ListExpensesRateRequest.page:type_name -> finance.PageRequest
-	92,  // 167: finance.ListExpensesRateResponse.expense_rate:type_name -> finance.ExpenseRate
-	13,  // 168: finance.ListExpensesRateResponse.page:type_name -> finance.PageResponse
-	11,  // 169: finance.CostCenter.audit:type_name -> finance.AuditFields
-	10,  // 170: finance.CreateCostCenterRequest.meta:type_name -> finance.RequestMetadata
-	99,  // 171: finance.CreateCostCenterRequest.center:type_name -> finance.CostCenter
-	10,  // 172: finance.UpdateCostCenterRequest.meta:type_name -> finance.RequestMetadata
-	99,  // 173: finance.UpdateCostCenterRequest.center:type_name -> finance.CostCenter
-	165, // 174: finance.UpdateCostCenterRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 175: finance.DeleteCostCenterRequest.meta:type_name -> finance.RequestMetadata
-	12,  // 176: finance.ListCostCentersRequest.page:type_name -> finance.PageRequest
-	99,  // 177: finance.ListCostCentersResponse.centers:type_name -> finance.CostCenter
-	13,  // 178: finance.ListCostCentersResponse.page:type_name -> finance.PageResponse
-	164, // 179: finance.CostAllocation.amount:type_name -> google.type.Money
-	11,  // 180: finance.CostAllocation.audit:type_name -> finance.AuditFields
-	10,  // 181: finance.AllocateCostRequest.meta:type_name -> finance.RequestMetadata
-	164, // 182: finance.AllocateCostRequest.amount:type_name -> google.type.Money
-	106, // 183: finance.AllocateCostResponse.allocation:type_name -> finance.CostAllocation
-	12,  // 184: finance.ListCostAllocationsRequest.page:type_name -> finance.PageRequest
-	106, // 185: finance.ListCostAllocationsResponse.allocations:type_name -> finance.CostAllocation
-	13,  // 186: finance.ListCostAllocationsResponse.page:type_name -> finance.PageResponse
-	163, // 187: finance.AuditEvent.timestamp:type_name -> google.protobuf.Timestamp
-	10,  // 188: finance.RecordAuditEventRequest.meta:type_name -> finance.RequestMetadata
-	111, // 189: finance.RecordAuditEventRequest.event:type_name -> finance.AuditEvent
-	12,  // 190: finance.ListAuditEventsRequest.page:type_name -> finance.PageRequest
-	111, // 191: finance.ListAuditEventsResponse.events:type_name -> finance.AuditEvent
-	13,  // 192: finance.ListAuditEventsResponse.page:type_name -> finance.PageResponse
-	163, // 193: finance.FilterAuditEventsRequest.from_date:type_name -> google.protobuf.Timestamp
-	163, // 194: finance.FilterAuditEventsRequest.to_date:type_name -> google.protobuf.Timestamp
-	12,  // 195: finance.FilterAuditEventsRequest.page:type_name -> finance.PageRequest
-	111, // 196: finance.FilterAuditEventsResponse.events:type_name -> finance.AuditEvent
-	13,  // 197: finance.FilterAuditEventsResponse.page:type_name -> finance.PageResponse
-	164, // 198: finance.Accrual.amount:type_name -> google.type.Money
-	163, // 199: finance.Accrual.accrual_date:type_name -> google.protobuf.Timestamp
-	11,  // 200: finance.Accrual.audit:type_name -> finance.AuditFields
-	10,  // 201: finance.CreateAccrualRequest.meta:type_name -> finance.RequestMetadata
-	118, // 202: finance.CreateAccrualRequest.accrual:type_name -> finance.Accrual
-	10,  // 203: finance.UpdateAccrualRequest.meta:type_name -> finance.RequestMetadata
-	118, // 204: finance.UpdateAccrualRequest.accrual:type_name -> finance.Accrual
-	165, // 205: finance.UpdateAccrualRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 206: finance.DeleteAccrualRequest.meta:type_name -> finance.RequestMetadata
-	12,  // 207: finance.ListAccrualsRequest.page:type_name -> finance.PageRequest
-	118, // 208: finance.ListAccrualsResponse.accruals:type_name -> finance.Accrual
-	13,  // 209: finance.ListAccrualsResponse.page:type_name -> finance.PageResponse
-	11,  // 210: finance.AllocationRule.audit:type_name -> finance.AuditFields
-	10,  // 211: finance.CreateAllocationRuleRequest.meta:type_name -> finance.RequestMetadata
-	125, // 212: finance.CreateAllocationRuleRequest.rule:type_name -> finance.AllocationRule
-	10,  // 213: finance.UpdateAllocationRuleRequest.meta:type_name -> finance.RequestMetadata
-	125, // 214: finance.UpdateAllocationRuleRequest.rule:type_name -> finance.AllocationRule
-	165, // 215: finance.UpdateAllocationRuleRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 216: finance.DeleteAllocationRuleRequest.meta:type_name -> finance.RequestMetadata
-	12,  // 217: finance.ListAllocationRulesRequest.page:type_name -> finance.PageRequest
-	125, // 218: finance.ListAllocationRulesResponse.rules:type_name -> finance.AllocationRule
-	13,  // 219: finance.ListAllocationRulesResponse.page:type_name -> finance.PageResponse
-	163, // 220: finance.ReportPeriod.start_date:type_name -> google.protobuf.Timestamp
-	163, // 221: finance.ReportPeriod.end_date:type_name -> google.protobuf.Timestamp
-	164, // 222: finance.ProfitLossReport.total_revenue:type_name -> google.type.Money
-	164, // 223: finance.ProfitLossReport.total_expenses:type_name -> google.type.Money
-	164, // 224: finance.ProfitLossReport.net_profit:type_name -> google.type.Money
-	164, // 225: finance.BalanceSheetReport.total_assets:type_name -> google.type.Money
-	164, // 226: finance.BalanceSheetReport.total_liabilities:type_name -> google.type.Money
-	164, // 227: finance.BalanceSheetReport.net_worth:type_name -> google.type.Money
-	73,  // 228: finance.TrialBalanceReport.entries:type_name -> finance.LedgerEntry
-	132, // 229: finance.ReportRequest.period:type_name -> finance.ReportPeriod
-	132, // 230: finance.ComplianceReportRequest.period:type_name -> finance.ReportPeriod
-	132, // 231: finance.Consolidation.period:type_name -> finance.ReportPeriod
-	139, // 232: finance.CreateConsolidationRequest.consolidation:type_name -> finance.Consolidation
-	12,  // 233: finance.ListConsolidationsRequest.page:type_name -> finance.PageRequest
-	132, // 234: finance.ListConsolidationsRequest.period:type_name -> finance.ReportPeriod
-	139, // 235: finance.ListConsolidationsResponse.consolidations:type_name -> finance.Consolidation
-	13,  // 236: finance.ListConsolidationsResponse.page:type_name -> finance.PageResponse
-	132, // 237: finance.ConsolidationRequest.period:type_name -> finance.ReportPeriod
-	139, // 238: finance.ConsolidationResponse.consolidations:type_name -> finance.Consolidation
-	163, // 239: finance.ExchangeRate.as_of:type_name -> google.protobuf.Timestamp
-	11,  // 240: finance.ExchangeRate.audit:type_name -> finance.AuditFields
-	10,  // 241: finance.CreateExchangeRateRequest.meta:type_name -> finance.RequestMetadata
-	147, // 242: finance.CreateExchangeRateRequest.rate:type_name -> finance.ExchangeRate
-	10,  // 243: finance.UpdateExchangeRateRequest.meta:type_name -> finance.RequestMetadata
-	147, // 244: finance.UpdateExchangeRateRequest.rate:type_name -> finance.ExchangeRate
-	165, // 245: finance.UpdateExchangeRateRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10,  // 246: finance.DeleteExchangeRateRequest.meta:type_name -> finance.RequestMetadata
-	12,  // 247: finance.ListExchangeRatesRequest.page:type_name -> finance.PageRequest
-	147, // 248: finance.ListExchangeRatesResponse.rates:type_name -> finance.ExchangeRate
-	13,  // 249: finance.ListExchangeRatesResponse.page:type_name -> finance.PageResponse
-	164, // 250: finance.ConvertMoneyRequest.amount:type_name -> google.type.Money
-	163, // 251: finance.ConvertMoneyRequest.as_of:type_name -> google.protobuf.Timestamp
-	164, // 252: finance.ConvertMoneyResponse.converted:type_name -> google.type.Money
-	132, // 253: finance.CashFlowForecastRequest.period:type_name -> finance.ReportPeriod
-	163, // 254: finance.FinanceInvoiceCreatedEvent.invoice_date:type_name -> google.protobuf.Timestamp
-	164, // 255: finance.FinanceInvoiceCreatedEvent.total:type_name -> google.type.Money
-	164, // 256: finance.FinancePaymentReceivedEvent.amount_paid:type_name -> google.type.Money
-	163, // 257: finance.FinancePaymentReceivedEvent.paid_at:type_name -> google.protobuf.Timestamp
-	164, // 258: finance.InventoryCostPostedEvent.amount:type_name -> google.type.Money
-	164, // 259: finance.PayrollPostedEvent.total_gross:type_name -> google.type.Money
-	164, // 260: finance.PayrollPostedEvent.total_net:type_name -> google.type.Money
-	163, // 261: finance.PayrollPostedEvent.run_date:type_name -> google.protobuf.Timestamp
-	164, // 262: finance.VendorBillApprovedEvent.amount:type_name -> google.type.Money
-	163, // 263: finance.VendorBillApprovedEvent.approved_at:type_name -> google.protobuf.Timestamp
-	22,  // 264: finance.InvoiceService.CreateInvoice:input_type -> finance.CreateInvoiceRequest
-	23,  // 265: finance.InvoiceService.GetInvoice:input_type -> finance.GetInvoiceRequest
-	26,  // 266: finance.InvoiceService.ListInvoices:input_type -> finance.ListInvoicesRequest
-	28,  // 267: finance.InvoiceService.SearchInvoices:input_type -> finance.SearchInvoicesRequest
-	24,  // 268: finance.InvoiceService.UpdateInvoice:input_type -> finance.UpdateInvoiceRequest
-	25,  // 269: finance.InvoiceService.DeleteInvoice:input_type -> finance.DeleteInvoiceRequest
-	30,  // 270: finance.CreditDebitNoteService.CreateCreditDebitNote:input_type -> finance.CreateCreditDebitNoteRequest
-	31,  // 271: finance.CreditDebitNoteService.GetCreditDebitNote:input_type -> finance.GetCreditDebitNoteRequest
-	34,  // 272: finance.CreditDebitNoteService.ListCreditDebitNotes:input_type -> finance.ListCreditDebitNotesRequest
-	32,  // 273: finance.CreditDebitNoteService.UpdateCreditDebitNote:input_type -> finance.UpdateCreditDebitNoteRequest
-	33,  // 274: finance.CreditDebitNoteService.DeleteCreditDebitNote:input_type -> finance.DeleteCreditDebitNoteRequest
-	37,  // 275: finance.PaymentService.CreatePaymentDue:input_type -> finance.CreatePaymentDueRequest
-	38,  // 276: finance.PaymentService.GetPaymentDue:input_type -> finance.GetPaymentDueRequest
-	39,  // 277: finance.PaymentService.UpdatePaymentDue:input_type -> finance.UpdatePaymentDueRequest
-	40,  // 278: finance.PaymentService.DeletePaymentDue:input_type -> finance.DeletePaymentDueRequest
-	41,  // 279: finance.PaymentService.MarkPaymentAsPaid:input_type -> finance.MarkPaymentAsPaidRequest
-	42,  // 280: finance.PaymentService.ListPaymentDues:input_type -> finance.ListPaymentDuesRequest
-	45,  // 281: finance.PaymentService.CreateBankAccount:input_type -> finance.CreateBankAccountRequest
-	46,  // 282: finance.PaymentService.GetBankAccount:input_type -> finance.GetBankAccountRequest
-	47,  // 283: finance.PaymentService.UpdateBankAccount:input_type -> finance.UpdateBankAccountRequest
-	48,  // 284: finance.PaymentService.DeleteBankAccount:input_type -> finance.DeleteBankAccountRequest
-	49,  // 285: finance.PaymentService.ListBankAccounts:input_type -> finance.ListBankAccountsRequest
-	52,  // 286: finance.PaymentService.ImportBankTransactions:input_type -> finance.ImportBankTransactionsRequest
-	54,  // 287: finance.PaymentService.ListBankTransactions:input_type -> finance.ListBankTransactionsRequest
-	56,  // 288: finance.BankReconciliationService.ReconcileTransaction:input_type -> finance.ReconcileTransactionRequest
-	59,  // 289: finance.LedgerService.CreateAccount:input_type -> finance.CreateAccountRequest
-	60,  // 290: finance.LedgerService.GetAccount:input_type -> finance.GetAccountRequest
-	61,  // 291: finance.LedgerService.UpdateAccount:input_type -> finance.UpdateAccountRequest
-	62,  // 292: finance.LedgerService.DeleteAccount:input_type -> finance.DeleteAccountRequest
-	63,  // 293: finance.LedgerService.ListAccounts:input_type -> finance.ListAccountsRequest
-	67,  // 294: finance.LedgerService.CreateJournalEntry:input_type -> finance.CreateJournalEntryRequest
-	68,  // 295: finance.LedgerService.GetJournalEntry:input_type -> finance.GetJournalEntryRequest
-	69,  // 296: finance.LedgerService.UpdateJournalEntry:input_type -> finance.UpdateJournalEntryRequest
-	70,  // 297: finance.LedgerService.DeleteJournalEntry:input_type -> finance.DeleteJournalEntryRequest
-	71,  // 298: finance.LedgerService.ListJournalEntries:input_type -> finance.ListJournalEntriesRequest
-	74,  // 299: finance.LedgerService.ListLedgerEntries:input_type -> finance.ListLedgerEntriesRequest
-	77,  // 300: finance.BudgetService.CreateBudget:input_type -> finance.CreateBudgetRequest
-	78,  // 301: finance.BudgetService.GetBudget:input_type -> finance.GetBudgetRequest
-	79,  // 302: finance.BudgetService.UpdateBudget:input_type -> finance.UpdateBudgetRequest
-	80,  // 303: finance.BudgetService.DeleteBudget:input_type -> finance.DeleteBudgetRequest
-	81,  // 304: finance.BudgetService.ListBudgets:input_type -> finance.ListBudgetsRequest
-	84,  // 305: finance.BudgetAllocationService.AllocateBudget:input_type -> finance.AllocateBudgetRequest
-	85,  // 306: finance.BudgetAllocationService.GetBudgetAllocation:input_type -> finance.GetBudgetAllocationRequest
-	86,  // 307: finance.BudgetAllocationService.UpdateBudgetAllocation:input_type -> finance.UpdateBudgetAllocationRequest
-	87,  // 308: finance.BudgetAllocationService.DeleteBudgetAllocation:input_type -> finance.DeleteBudgetAllocationRequest
-	88,  // 309: finance.BudgetAllocationService.ListBudgetAllocations:input_type -> finance.ListBudgetAllocationsRequest
-	90,  // 310: finance.BudgetComparisonService.GetBudgetComparisonReport:input_type -> finance.BudgetComparisonRequest
-	93,  // 311: finance.ExpenseRateService.CreateExpenseRate:input_type -> finance.CreateExpenseRateRequest
-	94,  // 312: finance.ExpenseRateService.GetExpenseRate:input_type -> finance.GetExpenseRateRequest
-	95,  // 313: finance.ExpenseRateService.UpdateExpenseRate:input_type -> finance.UpdateExpenseRateRequest
-	96,  // 314: finance.ExpenseRateService.DeleteExpenseRate:input_type -> finance.DeleteExpenseRateRequest
-	97,  // 315: finance.ExpenseRateService.ListExpensesRate:input_type -> finance.ListExpensesRateRequest
-	100, // 316: finance.CostAccountingService.CreateCostCenter:input_type -> finance.CreateCostCenterRequest
-	101, // 317: finance.CostAccountingService.GetCostCenter:input_type -> finance.GetCostCenterRequest
-	102, // 318: finance.CostAccountingService.UpdateCostCenter:input_type -> finance.UpdateCostCenterRequest
-	103, // 319: finance.CostAccountingService.DeleteCostCenter:input_type -> finance.DeleteCostCenterRequest
-	104, // 320: finance.CostAccountingService.ListCostCenters:input_type -> finance.ListCostCentersRequest
-	107, // 321: finance.CostAccountingService.AllocateCost:input_type -> finance.AllocateCostRequest
-	109, // 322: finance.CostAccountingService.ListCostAllocations:input_type -> finance.ListCostAllocationsRequest
-	112, // 323: finance.AuditTrailService.RecordAuditEvent:input_type -> finance.RecordAuditEventRequest
-	113, // 324: finance.AuditTrailService.ListAuditEvents:input_type -> finance.ListAuditEventsRequest
-	115, // 325: finance.AuditTrailService.GetAuditEventById:input_type -> finance.GetAuditEventByIdRequest
-	116, // 326: finance.AuditTrailService.FilterAuditEvents:input_type -> finance.FilterAuditEventsRequest
-	119, // 327: finance.AccrualService.CreateAccrual:input_type -> finance.CreateAccrualRequest
-	120, // 328: finance.AccrualService.GetAccrualById:input_type -> finance.GetAccrualByIdRequest
-	121, // 329: finance.AccrualService.UpdateAccrual:input_type -> finance.UpdateAccrualRequest
-	122, // 330: finance.AccrualService.DeleteAccrual:input_type -> finance.DeleteAccrualRequest
-	123, // 331: finance.AccrualService.ListAccruals:input_type -> finance.ListAccrualsRequest
-	126, // 332: finance.AllocationAutomationService.CreateAllocationRule:input_type -> finance.CreateAllocationRuleRequest
-	127, // 333: finance.AllocationAutomationService.GetAllocationRule:input_type -> finance.GetAllocationRuleRequest
-	128, // 334: finance.AllocationAutomationService.UpdateAllocationRule:input_type -> finance.UpdateAllocationRuleRequest
-	129, // 335: finance.AllocationAutomationService.DeleteAllocationRule:input_type -> finance.DeleteAllocationRuleRequest
-	130, // 336: finance.AllocationAutomationService.ListAllocationRules:input_type -> finance.ListAllocationRulesRequest
-	136, // 337: finance.FinancialReportService.GenerateProfitLossReport:input_type -> finance.ReportRequest
-	136, // 338: finance.FinancialReportService.GenerateBalanceSheetReport:input_type -> finance.ReportRequest
-	136, // 339: finance.FinancialReportService.GenerateTrialBalanceReport:input_type -> finance.ReportRequest
-	137, // 340: finance.FinancialReportService.GenerateComplianceReport:input_type -> finance.ComplianceReportRequest
-	137, // 341: finance.FinancialComplianceService.GenerateComplianceReport:input_type -> finance.ComplianceReportRequest
-	145, // 342: finance.ConsolidationService.ConsolidateEntities:input_type -> finance.ConsolidationRequest
-	140, // 343: finance.ConsolidationService.CreateConsolidation:input_type -> finance.CreateConsolidationRequest
-	141, // 344: finance.ConsolidationService.GetConsolidation:input_type -> finance.GetConsolidationRequest
-	142, // 345: finance.ConsolidationService.ListConsolidations:input_type -> finance.ListConsolidationsRequest
-	144, // 346: finance.ConsolidationService.DeleteConsolidation:input_type -> finance.DeleteConsolidationRequest
-	148, // 347: finance.FxService.CreateExchangeRate:input_type -> finance.CreateExchangeRateRequest
-	149, // 348: finance.FxService.GetExchangeRate:input_type -> finance.GetExchangeRateRequest
-	150, // 349: finance.FxService.UpdateExchangeRate:input_type -> finance.UpdateExchangeRateRequest
-	151, // 350: finance.FxService.DeleteExchangeRate:input_type -> finance.DeleteExchangeRateRequest
-	152, // 351: finance.FxService.ListExchangeRates:input_type -> finance.ListExchangeRatesRequest
-	154, // 352: finance.FxService.ConvertMoney:input_type -> finance.ConvertMoneyRequest
-	156, // 353: finance.CashFlowService.GenerateForecast:input_type -> finance.CashFlowForecastRequest
-	156, // 354: finance.CashFlowService.GetForecast:input_type -> finance.CashFlowForecastRequest
-	156, // 355: finance.CashFlowService.ListForecasts:input_type -> finance.CashFlowForecastRequest
-	158, // 356: finance.FinanceEventPublisher.PublishInvoiceCreated:input_type -> finance.FinanceInvoiceCreatedEvent
-	159, // 357: finance.FinanceEventPublisher.PublishPaymentReceived:input_type -> finance.FinancePaymentReceivedEvent
-	160, // 358: finance.FinanceEventPublisher.PublishInventoryCostPosted:input_type -> finance.InventoryCostPostedEvent
-	161, // 359: finance.FinanceEventPublisher.PublishPayrollPosted:input_type -> finance.PayrollPostedEvent
-	162, // 360: finance.FinanceEventPublisher.PublishVendorBillApproved:input_type -> finance.VendorBillApprovedEvent
-	21,  // 361: finance.InvoiceService.CreateInvoice:output_type -> finance.Invoice
-	21,  // 362: finance.InvoiceService.GetInvoice:output_type -> finance.Invoice
-	27,  // 363: finance.InvoiceService.ListInvoices:output_type -> finance.ListInvoicesResponse
-	27,  // 364: finance.InvoiceService.SearchInvoices:output_type -> finance.ListInvoicesResponse
-	21,  // 365: finance.InvoiceService.UpdateInvoice:output_type -> finance.Invoice
-	166, // 366: finance.InvoiceService.DeleteInvoice:output_type -> google.protobuf.Empty
-	29,  // 367: finance.CreditDebitNoteService.CreateCreditDebitNote:output_type -> finance.CreditDebitNote
-	29,  // 368: finance.CreditDebitNoteService.GetCreditDebitNote:output_type -> finance.CreditDebitNote
-	35,  // 369: finance.CreditDebitNoteService.ListCreditDebitNotes:output_type -> finance.ListCreditDebitNotesResponse
-	29,  // 370: finance.CreditDebitNoteService.UpdateCreditDebitNote:output_type -> finance.CreditDebitNote
-	166, // 371: finance.CreditDebitNoteService.DeleteCreditDebitNote:output_type -> google.protobuf.Empty
-	36,  // 372: finance.PaymentService.CreatePaymentDue:output_type -> finance.PaymentDue
-	36,  // 373: finance.PaymentService.GetPaymentDue:output_type -> finance.PaymentDue
-	36,  // 374: finance.PaymentService.UpdatePaymentDue:output_type -> finance.PaymentDue
-	166, // 375: finance.PaymentService.DeletePaymentDue:output_type -> google.protobuf.Empty
-	36,  // 376: finance.PaymentService.MarkPaymentAsPaid:output_type -> finance.PaymentDue
-	43,  // 377: finance.PaymentService.ListPaymentDues:output_type -> finance.ListPaymentDuesResponse
-	44,  // 378: finance.PaymentService.CreateBankAccount:output_type -> finance.BankAccount
-	44,  // 379: finance.PaymentService.GetBankAccount:output_type -> finance.BankAccount
-	44,  // 380: finance.PaymentService.UpdateBankAccount:output_type -> finance.BankAccount
-	166, // 381: finance.PaymentService.DeleteBankAccount:output_type -> google.protobuf.Empty
-	50,  // 382: finance.PaymentService.ListBankAccounts:output_type -> finance.ListBankAccountsResponse
-	53,  // 383: finance.PaymentService.ImportBankTransactions:output_type -> finance.ImportBankTransactionsResponse
-	55,  // 384: finance.PaymentService.ListBankTransactions:output_type -> finance.ListBankTransactionsResponse
-	57,  // 385: finance.BankReconciliationService.ReconcileTransaction:output_type -> finance.Reconciliation
-	58,  // 386: finance.LedgerService.CreateAccount:output_type -> finance.Account
-	58,  // 387: finance.LedgerService.GetAccount:output_type -> finance.Account
-	58,  // 388: finance.LedgerService.UpdateAccount:output_type -> finance.Account
-	166, // 389: finance.LedgerService.DeleteAccount:output_type -> google.protobuf.Empty
-	64,  // 390: finance.LedgerService.ListAccounts:output_type -> finance.ListAccountsResponse
-	66,  // 391: finance.LedgerService.CreateJournalEntry:output_type -> finance.JournalEntry
-	66,  // 392: finance.LedgerService.GetJournalEntry:output_type -> finance.JournalEntry
-	66,  // 393: finance.LedgerService.UpdateJournalEntry:output_type -> finance.JournalEntry
-	166, // 394: finance.LedgerService.DeleteJournalEntry:output_type -> google.protobuf.Empty
-	72,  // 395: finance.LedgerService.ListJournalEntries:output_type -> finance.ListJournalEntriesResponse
-	75,  // 396: finance.LedgerService.ListLedgerEntries:output_type -> finance.ListLedgerEntriesResponse
-	76,  // 397: finance.BudgetService.CreateBudget:output_type -> finance.Budget
-	76,  // 398: finance.BudgetService.GetBudget:output_type -> finance.Budget
-	76,  // 399: finance.BudgetService.UpdateBudget:output_type -> finance.Budget
-	166, // 400: finance.BudgetService.DeleteBudget:output_type -> google.protobuf.Empty
-	82,  // 401: finance.BudgetService.ListBudgets:output_type -> finance.ListBudgetsResponse
-	83,  // 402: finance.BudgetAllocationService.AllocateBudget:output_type -> finance.BudgetAllocation
-	83,  // 403: finance.BudgetAllocationService.GetBudgetAllocation:output_type -> finance.BudgetAllocation
-	83,  // 404: finance.BudgetAllocationService.UpdateBudgetAllocation:output_type -> finance.BudgetAllocation
-	166, // 405: finance.BudgetAllocationService.DeleteBudgetAllocation:output_type -> google.protobuf.Empty
-	89,  // 406: finance.BudgetAllocationService.ListBudgetAllocations:output_type -> finance.ListBudgetAllocationsResponse
-	91,  // 407: finance.BudgetComparisonService.GetBudgetComparisonReport:output_type -> finance.BudgetComparisonResponse
-	92,  // 408: finance.ExpenseRateService.CreateExpenseRate:output_type -> finance.ExpenseRate
-	92,  // 409: finance.ExpenseRateService.GetExpenseRate:output_type -> finance.ExpenseRate
-	92,  // 410: finance.ExpenseRateService.UpdateExpenseRate:output_type -> finance.ExpenseRate
-	166, // 411: finance.ExpenseRateService.DeleteExpenseRate:output_type -> google.protobuf.Empty
-	98,  // 412: finance.ExpenseRateService.ListExpensesRate:output_type -> finance.ListExpensesRateResponse
-	99,  // 413: finance.CostAccountingService.CreateCostCenter:output_type -> finance.CostCenter
-	99,  // 414: finance.CostAccountingService.GetCostCenter:output_type -> finance.CostCenter
-	99,  // 415: finance.CostAccountingService.UpdateCostCenter:output_type -> finance.CostCenter
-	166, // 416: finance.CostAccountingService.DeleteCostCenter:output_type -> google.protobuf.Empty
-	105, // 417: finance.CostAccountingService.ListCostCenters:output_type -> finance.ListCostCentersResponse
-	108, // 418: finance.CostAccountingService.AllocateCost:output_type -> finance.AllocateCostResponse
-	110, // 419: finance.CostAccountingService.ListCostAllocations:output_type -> finance.ListCostAllocationsResponse
-	111, // 420: finance.AuditTrailService.RecordAuditEvent:output_type -> finance.AuditEvent
-	114, // 421: finance.AuditTrailService.ListAuditEvents:output_type -> finance.ListAuditEventsResponse
-	111, // 422: finance.AuditTrailService.GetAuditEventById:output_type -> finance.AuditEvent
-	117, // 423: finance.AuditTrailService.FilterAuditEvents:output_type -> finance.FilterAuditEventsResponse
-	118, // 424: finance.AccrualService.CreateAccrual:output_type -> finance.Accrual
-	118, // 425: finance.AccrualService.GetAccrualById:output_type -> finance.Accrual
-	118, // 426: finance.AccrualService.UpdateAccrual:output_type -> finance.Accrual
-	166, // 427: finance.AccrualService.DeleteAccrual:output_type -> google.protobuf.Empty
-	124, // 428: finance.AccrualService.ListAccruals:output_type -> finance.ListAccrualsResponse
-	125, // 429: finance.AllocationAutomationService.CreateAllocationRule:output_type -> finance.AllocationRule
-	125, // 430: finance.AllocationAutomationService.GetAllocationRule:output_type -> finance.AllocationRule
-	125, // 431: finance.AllocationAutomationService.UpdateAllocationRule:output_type -> finance.AllocationRule
-	166, // 432: finance.AllocationAutomationService.DeleteAllocationRule:output_type -> google.protobuf.Empty
-	131, // 433: finance.AllocationAutomationService.ListAllocationRules:output_type -> finance.ListAllocationRulesResponse
-	133, // 434: finance.FinancialReportService.GenerateProfitLossReport:output_type -> finance.ProfitLossReport
-	134, // 435: finance.FinancialReportService.GenerateBalanceSheetReport:output_type -> finance.BalanceSheetReport
-	135, // 436: finance.FinancialReportService.GenerateTrialBalanceReport:output_type -> finance.TrialBalanceReport
-	138, // 437: finance.FinancialReportService.GenerateComplianceReport:output_type -> finance.ComplianceReport
-	138, // 438: finance.FinancialComplianceService.GenerateComplianceReport:output_type -> finance.ComplianceReport
-	146, // 439: finance.ConsolidationService.ConsolidateEntities:output_type -> finance.ConsolidationResponse
-	139, // 440: finance.ConsolidationService.CreateConsolidation:output_type -> finance.Consolidation
-	139, // 441: finance.ConsolidationService.GetConsolidation:output_type -> finance.Consolidation
-	143, // 442: finance.ConsolidationService.ListConsolidations:output_type -> finance.ListConsolidationsResponse
-	166, // 443: finance.ConsolidationService.DeleteConsolidation:output_type -> google.protobuf.Empty
-	147, // 444: finance.FxService.CreateExchangeRate:output_type -> finance.ExchangeRate
-	147, // 445: finance.FxService.GetExchangeRate:output_type -> finance.ExchangeRate
-	147, // 446: finance.FxService.UpdateExchangeRate:output_type -> finance.ExchangeRate
-	166, // 447: finance.FxService.DeleteExchangeRate:output_type -> google.protobuf.Empty
-	153, // 448: finance.FxService.ListExchangeRates:output_type -> finance.ListExchangeRatesResponse
-	155, // 449: finance.FxService.ConvertMoney:output_type -> finance.ConvertMoneyResponse
-	157, // 450: finance.CashFlowService.GenerateForecast:output_type -> finance.CashFlowForecastResponse
-	157, // 451: finance.CashFlowService.GetForecast:output_type -> finance.CashFlowForecastResponse
-	157, // 452: finance.CashFlowService.ListForecasts:output_type -> finance.CashFlowForecastResponse
-	166, // 453: finance.FinanceEventPublisher.PublishInvoiceCreated:output_type -> google.protobuf.Empty
-	166, // 454: finance.FinanceEventPublisher.PublishPaymentReceived:output_type -> google.protobuf.Empty
-	166, // 455: finance.FinanceEventPublisher.PublishInventoryCostPosted:output_type -> google.protobuf.Empty
-	166, // 456: finance.FinanceEventPublisher.PublishPayrollPosted:output_type -> google.protobuf.Empty
-	166, // 457: finance.FinanceEventPublisher.PublishVendorBillApproved:output_type -> google.protobuf.Empty
-	361, // [361:458] is the sub-list for method output_type
-	264, // [264:361] is the sub-list for method input_type
-	264, // [264:264] is the sub-list for extension type_name
-	264, // [264:264] is the sub-list for extension extendee
-	0,   // [0:264] is the sub-list for field type_name
+	261, // 25: finance.Invoice.challan_date:type_name -> google.protobuf.Timestamp
+	261, // 26: finance.Invoice.against_invoice_date:type_name -> google.protobuf.Timestamp
+	26,  // 27: finance.Invoice.items:type_name -> finance.InvoiceItem
+	262, // 28: finance.Invoice.subtotal:type_name -> google.type.Money
+	22,  // 29: finance.Invoice.discounts:type_name -> finance.Discount
+	21,  // 30: finance.Invoice.taxes:type_name -> finance.TaxLine
+	23,  // 31: finance.Invoice.gst_breakup:type_name -> finance.GstBreakup
+	262, // 32: finance.Invoice.grand_total:type_name -> google.type.Money
+	17,  // 33: finance.Invoice.audit:type_name -> finance.AuditFields
+	24,  // 34: finance.Invoice.gst:type_name -> finance.GstTaxRegime
+	25,  // 35: finance.Invoice.gst_docs:type_name -> finance.GstDocStatus
+	261, // 36: finance.Invoice.issued_at:type_name -> google.protobuf.Timestamp
+	261, // 37: finance.Invoice.voided_at:type_name -> google.protobuf.Timestamp
+	261, // 38: finance.Invoice.overdue_at:type_name -> google.protobuf.Timestamp
+	261, // 39: finance.Invoice.credit_approved_at:type_name -> google.protobuf.Timestamp
+	262, // 40: finance.Invoice.credited_total:type_name -> google.type.Money
+	262, // 41: finance.Invoice.debited_total:type_name -> google.type.Money
+	262, // 42: finance.Invoice.net_billed:type_name -> google.type.Money
+	16,  // 43: finance.CreateInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	27,  // 44: finance.CreateInvoiceRequest.invoice:type_name -> finance.Invoice
+	16,  // 45: finance.GetInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 46: finance.UpdateInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	27,  // 47: finance.UpdateInvoiceRequest.invoice:type_name -> finance.Invoice
+	263, // 48: finance.UpdateInvoiceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 49: finance.DeleteInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 50: finance.ListInvoicesRequest.page:type_name -> finance.PageRequest
+	27,  // 51: finance.ListInvoicesResponse.invoices:type_name -> finance.Invoice
+	19,  // 52: finance.ListInvoicesResponse.page:type_name -> finance.PageResponse
+	18,  // 53: finance.SearchInvoicesRequest.page:type_name -> finance.PageRequest
+	16,  // 54: finance.SearchInvoicesRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 55: finance.IssueInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 56: finance.VoidInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 57: finance.MarkOverdueRequest.meta:type_name -> finance.RequestMetadata
+	261, // 58: finance.MarkOverdueRequest.as_of:type_name -> google.protobuf.Timestamp
+	0,   // 59: finance.InvoicePostingRule.invoice_type:type_name -> finance.InvoiceType
+	17,  // 60: finance.InvoicePostingRule.audit:type_name -> finance.AuditFields
+	16,  // 61: finance.SetInvoicePostingRuleRequest.meta:type_name -> finance.RequestMetadata
+	38,  // 62: finance.SetInvoicePostingRuleRequest.rule:type_name -> finance.InvoicePostingRule
+	16,  // 63: finance.GetInvoicePostingRuleRequest.meta:type_name -> finance.RequestMetadata
+	0,   // 64: finance.GetInvoicePostingRuleRequest.invoice_type:type_name -> finance.InvoiceType
+	0,   // 65: finance.InvoiceNumberSeries.invoice_type:type_name -> finance.InvoiceType
+	17,  // 66: finance.InvoiceNumberSeries.audit:type_name -> finance.AuditFields
+	17,  // 67: finance.InvoiceTemplate.audit:type_name -> finance.AuditFields
+	16,  // 68: finance.SetInvoiceTemplateRequest.meta:type_name -> finance.RequestMetadata
+	42,  // 69: finance.SetInvoiceTemplateRequest.template:type_name -> finance.InvoiceTemplate
+	16,  // 70: finance.GetInvoiceTemplateRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 71: finance.RenderInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 72: finance.ExportInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 73: finance.ImportInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 74: finance.SetInvoiceNumberSeriesRequest.meta:type_name -> finance.RequestMetadata
+	41,  // 75: finance.SetInvoiceNumberSeriesRequest.series:type_name -> finance.InvoiceNumberSeries
+	16,  // 76: finance.GetInvoiceNumberSeriesRequest.meta:type_name -> finance.RequestMetadata
+	0,   // 77: finance.GetInvoiceNumberSeriesRequest.invoice_type:type_name -> finance.InvoiceType
+	12,  // 78: finance.PartyAddress.kind:type_name -> finance.AddressKind
+	11,  // 79: finance.Party.kind:type_name -> finance.PartyKind
+	51,  // 80: finance.Party.contacts:type_name -> finance.PartyContact
+	52,  // 81: finance.Party.addresses:type_name -> finance.PartyAddress
+	53,  // 82: finance.Party.tax_registrations:type_name -> finance.PartyTaxRegistration
+	54,  // 83: finance.Party.bank_accounts:type_name -> finance.PartyBankAccount
+	17,  // 84: finance.Party.audit:type_name -> finance.AuditFields
+	262, // 85: finance.Party.credit_limit:type_name -> google.type.Money
+	16,  // 86: finance.CreatePartyRequest.meta:type_name -> finance.RequestMetadata
+	55,  // 87: finance.CreatePartyRequest.party:type_name -> finance.Party
+	16,  // 88: finance.GetPartyRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 89: finance.UpdatePartyRequest.meta:type_name -> finance.RequestMetadata
+	55,  // 90: finance.UpdatePartyRequest.party:type_name -> finance.Party
+	263, // 91: finance.UpdatePartyRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 92: finance.DeletePartyRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 93: finance.ListPartiesRequest.meta:type_name -> finance.RequestMetadata
+	11,  // 94: finance.ListPartiesRequest.kind:type_name -> finance.PartyKind
+	18,  // 95: finance.ListPartiesRequest.page:type_name -> finance.PageRequest
+	55,  // 96: finance.ListPartiesResponse.parties:type_name -> finance.Party
+	19,  // 97: finance.ListPartiesResponse.page:type_name -> finance.PageResponse
+	13,  // 98: finance.CreditPolicy.action:type_name -> finance.CreditAction
+	17,  // 99: finance.CreditPolicy.audit:type_name -> finance.AuditFields
+	262, // 100: finance.CreditExposure.credit_limit:type_name -> google.type.Money
+	262, // 101: finance.CreditExposure.open_dues:type_name -> google.type.Money
+	262, // 102: finance.CreditExposure.draft_invoices:type_name -> google.type.Money
+	262, // 103: finance.CreditExposure.unapplied_credits:type_name -> google.type.Money
+	262, // 104: finance.CreditExposure.exposure:type_name -> google.type.Money
+	262, // 105: finance.CreditExposure.threshold:type_name -> google.type.Money
+	16,  // 106: finance.GetCreditPolicyRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 107: finance.SetCreditPolicyRequest.meta:type_name -> finance.RequestMetadata
+	62,  // 108: finance.SetCreditPolicyRequest.policy:type_name -> finance.CreditPolicy
+	16,  // 109: finance.GetCreditExposureRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 110: finance.ApproveInvoiceCreditRequest.meta:type_name -> finance.RequestMetadata
+	68,  // 111: finance.DunningSchedule.stages:type_name -> finance.DunningStage
+	261, // 112: finance.DunningHold.created_at:type_name -> google.protobuf.Timestamp
+	16,  // 113: finance.SetDunningScheduleRequest.meta:type_name -> finance.RequestMetadata
+	69,  // 114: finance.SetDunningScheduleRequest.schedule:type_name -> finance.DunningSchedule
+	16,  // 115: finance.GetDunningScheduleRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 116: finance.PlaceDunningHoldRequest.meta:type_name -> finance.RequestMetadata
+	70,  // 117: finance.PlaceDunningHoldRequest.hold:type_name -> finance.DunningHold
+	16,  // 118: finance.ReleaseDunningHoldRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 119: finance.ListDunningHoldsRequest.meta:type_name -> finance.RequestMetadata
+	70,  // 120: finance.ListDunningHoldsResponse.holds:type_name -> finance.DunningHold
+	16,  // 121: finance.RunDunningRequest.meta:type_name -> finance.RequestMetadata
+	261, // 122: finance.RunDunningRequest.as_of:type_name -> google.protobuf.Timestamp
+	71,  // 123: finance.RunDunningResponse.reminders:type_name -> finance.DunningReminder
+	8,   // 124: finance.RecurringInvoice.frequency:type_name -> finance.TemplateFrequency
+	261, // 125: finance.RecurringInvoice.start_date:type_name -> google.protobuf.Timestamp
+	261, // 126: finance.RecurringInvoice.end_date:type_name -> google.protobuf.Timestamp
+	261, // 127: finance.RecurringInvoice.billed_through:type_name -> google.protobuf.Timestamp
+	17,  // 128: finance.RecurringInvoice.audit:type_name -> finance.AuditFields
+	16,  // 129: finance.CreateRecurringInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	80,  // 130: finance.CreateRecurringInvoiceRequest.recurring_invoice:type_name -> finance.RecurringInvoice
+	16,  // 131: finance.GetRecurringInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 132: finance.UpdateRecurringInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	80,  // 133: finance.UpdateRecurringInvoiceRequest.recurring_invoice:type_name -> finance.RecurringInvoice
+	263, // 134: finance.UpdateRecurringInvoiceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 135: finance.DeleteRecurringInvoiceRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 136: finance.ListRecurringInvoicesRequest.page:type_name -> finance.PageRequest
+	80,  // 137: finance.ListRecurringInvoicesResponse.recurring_invoices:type_name -> finance.RecurringInvoice
+	19,  // 138: finance.ListRecurringInvoicesResponse.page:type_name -> finance.PageResponse
+	16,  // 139: finance.GenerateRecurringInvoicesRequest.meta:type_name -> finance.RequestMetadata
+	261, // 140: finance.GenerateRecurringInvoicesRequest.as_of:type_name -> google.protobuf.Timestamp
+	261, // 141: finance.GeneratedInvoice.period_start:type_name -> google.protobuf.Timestamp
+	261, // 142: finance.GeneratedInvoice.period_end:type_name -> google.protobuf.Timestamp
+	27,  // 143: finance.GeneratedInvoice.invoice:type_name -> finance.Invoice
+	88,  // 144: finance.GenerateRecurringInvoicesResponse.invoices:type_name -> finance.GeneratedInvoice
+	3,   // 145: finance.CreditDebitNote.type:type_name -> finance.NoteType
+	262, // 146: finance.CreditDebitNote.amount:type_name -> google.type.Money
+	17,  // 147: finance.CreditDebitNote.audit:type_name -> finance.AuditFields
+	261, // 148: finance.CreditDebitNote.applied_at:type_name -> google.protobuf.Timestamp
+	16,  // 149: finance.CreateCreditDebitNoteRequest.meta:type_name -> finance.RequestMetadata
+	90,  // 150: finance.CreateCreditDebitNoteRequest.note:type_name -> finance.CreditDebitNote
+	16,  // 151: finance.UpdateCreditDebitNoteRequest.meta:type_name -> finance.RequestMetadata
+	90,  // 152: finance.UpdateCreditDebitNoteRequest.note:type_name -> finance.CreditDebitNote
+	263, // 153: finance.UpdateCreditDebitNoteRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 154: finance.DeleteCreditDebitNoteRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 155: finance.ListCreditDebitNotesRequest.page:type_name -> finance.PageRequest
+	90,  // 156: finance.ListCreditDebitNotesResponse.notes:type_name -> finance.CreditDebitNote
+	19,  // 157: finance.ListCreditDebitNotesResponse.page:type_name -> finance.PageResponse
+	262, // 158: finance.PaymentDue.amount_due:type_name -> google.type.Money
+	261, // 159: finance.PaymentDue.due_date:type_name -> google.protobuf.Timestamp
+	2,   // 160: finance.PaymentDue.status:type_name -> finance.PaymentStatus
+	17,  // 161: finance.PaymentDue.audit:type_name -> finance.AuditFields
+	16,  // 162: finance.CreatePaymentDueRequest.meta:type_name -> finance.RequestMetadata
+	97,  // 163: finance.CreatePaymentDueRequest.due:type_name -> finance.PaymentDue
+	16,  // 164: finance.UpdatePaymentDueRequest.meta:type_name -> finance.RequestMetadata
+	97,  // 165: finance.UpdatePaymentDueRequest.due:type_name -> finance.PaymentDue
+	263, // 166: finance.UpdatePaymentDueRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 167: finance.DeletePaymentDueRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 168: finance.MarkPaymentAsPaidRequest.meta:type_name -> finance.RequestMetadata
+	262, // 169: finance.MarkPaymentAsPaidRequest.amount_paid:type_name -> google.type.Money
+	261, // 170: finance.MarkPaymentAsPaidRequest.paid_at:type_name -> google.protobuf.Timestamp
+	18,  // 171: finance.ListPaymentDuesRequest.page:type_name -> finance.PageRequest
+	97,  // 172: finance.ListPaymentDuesResponse.dues:type_name -> finance.PaymentDue
+	19,  // 173: finance.ListPaymentDuesResponse.page:type_name -> finance.PageResponse
+	17,  // 174: finance.BankAccount.audit:type_name -> finance.AuditFields
+	16,  // 175: finance.CreateBankAccountRequest.meta:type_name -> finance.RequestMetadata
+	105, // 176: finance.CreateBankAccountRequest.account:type_name -> finance.BankAccount
+	16,  // 177: finance.UpdateBankAccountRequest.meta:type_name -> finance.RequestMetadata
+	105, // 178: finance.UpdateBankAccountRequest.account:type_name -> finance.BankAccount
+	263, // 179: finance.UpdateBankAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 180: finance.DeleteBankAccountRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 181: finance.ListBankAccountsRequest.page:type_name -> finance.PageRequest
+	105, // 182: finance.ListBankAccountsResponse.accounts:type_name -> finance.BankAccount
+	19,  // 183: finance.ListBankAccountsResponse.page:type_name -> finance.PageResponse
+	262, // 184: finance.BankTransaction.amount:type_name -> google.type.Money
+	261, // 185: finance.BankTransaction.transaction_date:type_name -> google.protobuf.Timestamp
+	17,  // 186: finance.BankTransaction.audit:type_name -> finance.AuditFields
+	16,  // 187: finance.ImportBankTransactionsRequest.meta:type_name -> finance.RequestMetadata
+	112, // 188: finance.ImportBankTransactionsRequest.transactions:type_name -> finance.BankTransaction
+	18,  // 189: finance.ListBankTransactionsRequest.page:type_name -> finance.PageRequest
+	112, // 190: finance.ListBankTransactionsResponse.transactions:type_name -> finance.BankTransaction
+	19,  // 191: finance.ListBankTransactionsResponse.page:type_name -> finance.PageResponse
+	16,  // 192: finance.ReconcileTransactionRequest.meta:type_name -> finance.RequestMetadata
+	262, // 193: finance.ReconcileTransactionRequest.amount:type_name -> google.type.Money
+	261, // 194: finance.ReconcileTransactionRequest.transaction_date:type_name -> google.protobuf.Timestamp
+	6,   // 195: finance.Account.type:type_name -> finance.AccountType
+	7,   // 196: finance.Account.status:type_name -> finance.AccountStatus
+	17,  // 197: finance.Account.audit:type_name -> finance.AuditFields
+	16,  // 198: finance.CreateAccountRequest.meta:type_name -> finance.RequestMetadata
+	119, // 199: finance.CreateAccountRequest.account:type_name -> finance.Account
+	16,  // 200: finance.UpdateAccountRequest.meta:type_name -> finance.RequestMetadata
+	119, // 201: finance.UpdateAccountRequest.account:type_name -> finance.Account
+	263, // 202: finance.UpdateAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 203: finance.DeleteAccountRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 204: finance.ListAccountsRequest.page:type_name -> finance.PageRequest
+	119, // 205: finance.ListAccountsResponse.accounts:type_name -> finance.Account
+	19,  // 206: finance.ListAccountsResponse.page:type_name -> finance.PageResponse
+	5,   // 207: finance.JournalLine.side:type_name -> finance.LedgerSide
+	262, // 208: finance.JournalLine.amount:type_name -> google.type.Money
+	262, // 209: finance.JournalLine.functional_amount:type_name -> google.type.Money
+	261, // 210: finance.JournalEntry.journal_date:type_name -> google.protobuf.Timestamp
+	126, // 211: finance.JournalEntry.lines:type_name -> finance.JournalLine
+	17,  // 212: finance.JournalEntry.audit:type_name -> finance.AuditFields
+	9,   // 213: finance.JournalEntry.status:type_name -> finance.JournalStatus
+	261, // 214: finance.JournalEntry.auto_reverse_date:type_name -> google.protobuf.Timestamp
+	16,  // 215: finance.CreateJournalEntryRequest.meta:type_name -> finance.RequestMetadata
+	127, // 216: finance.CreateJournalEntryRequest.entry:type_name -> finance.JournalEntry
+	16,  // 217: finance.UpdateJournalEntryRequest.meta:type_name -> finance.RequestMetadata
+	127, // 218: finance.UpdateJournalEntryRequest.entry:type_name -> finance.JournalEntry
+	263, // 219: finance.UpdateJournalEntryRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 220: finance.DeleteJournalEntryRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 221: finance.ReverseJournalEntryRequest.meta:type_name -> finance.RequestMetadata
+	261, // 222: finance.ReverseJournalEntryRequest.reversal_date:type_name -> google.protobuf.Timestamp
+	18,  // 223: finance.ListJournalEntriesRequest.page:type_name -> finance.PageRequest
+	127, // 224: finance.ListJournalEntriesResponse.entries:type_name -> finance.JournalEntry
+	19,  // 225: finance.ListJournalEntriesResponse.page:type_name -> finance.PageResponse
+	5,   // 226: finance.LedgerEntry.side:type_name -> finance.LedgerSide
+	262, // 227: finance.LedgerEntry.amount:type_name -> google.type.Money
+	261, // 228: finance.LedgerEntry.transaction_date:type_name -> google.protobuf.Timestamp
+	17,  // 229: finance.LedgerEntry.audit:type_name -> finance.AuditFields
+	262, // 230: finance.LedgerEntry.functional_amount:type_name -> google.type.Money
+	18,  // 231: finance.ListLedgerEntriesRequest.page:type_name -> finance.PageRequest
+	135, // 232: finance.ListLedgerEntriesResponse.entries:type_name -> finance.LedgerEntry
+	19,  // 233: finance.ListLedgerEntriesResponse.page:type_name -> finance.PageResponse
+	6,   // 234: finance.AccountBalance.account_type:type_name -> finance.AccountType
+	261, // 235: finance.AccountBalance.from_date:type_name -> google.protobuf.Timestamp
+	261, // 236: finance.AccountBalance.to_date:type_name -> google.protobuf.Timestamp
+	262, // 237: finance.AccountBalance.opening_balance:type_name -> google.type.Money
+	262, // 238: finance.AccountBalance.total_debits:type_name -> google.type.Money
+	262, // 239: finance.AccountBalance.total_credits:type_name -> google.type.Money
+	262, // 240: finance.AccountBalance.closing_balance:type_name -> google.type.Money
+	139, // 241: finance.AccountBalance.currencies:type_name -> finance.CurrencyBalance
+	262, // 242: finance.CurrencyBalance.opening_balance:type_name -> google.type.Money
+	262, // 243: finance.CurrencyBalance.total_debits:type_name -> google.type.Money
+	262, // 244: finance.CurrencyBalance.total_credits:type_name -> google.type.Money
+	262, // 245: finance.CurrencyBalance.closing_balance:type_name -> google.type.Money
+	261, // 246: finance.GetAccountBalanceRequest.from_date:type_name -> google.protobuf.Timestamp
+	261, // 247: finance.GetAccountBalanceRequest.to_date:type_name -> google.protobuf.Timestamp
+	261, // 248: finance.ListAccountBalancesRequest.from_date:type_name -> google.protobuf.Timestamp
+	261, // 249: finance.ListAccountBalancesRequest.to_date:type_name -> google.protobuf.Timestamp
+	138, // 250: finance.ListAccountBalancesResponse.balances:type_name -> finance.AccountBalance
+	119, // 251: finance.AccountTreeNode.account:type_name -> finance.Account
+	138, // 252: finance.AccountTreeNode.balance:type_name -> finance.AccountBalance
+	143, // 253: finance.AccountTreeNode.children:type_name -> finance.AccountTreeNode
+	261, // 254: finance.GetAccountTreeRequest.from_date:type_name -> google.protobuf.Timestamp
+	261, // 255: finance.GetAccountTreeRequest.to_date:type_name -> google.protobuf.Timestamp
+	143, // 256: finance.GetAccountTreeResponse.roots:type_name -> finance.AccountTreeNode
+	126, // 257: finance.JournalTemplate.lines:type_name -> finance.JournalLine
+	8,   // 258: finance.JournalTemplate.frequency:type_name -> finance.TemplateFrequency
+	261, // 259: finance.JournalTemplate.start_date:type_name -> google.protobuf.Timestamp
+	261, // 260: finance.JournalTemplate.end_date:type_name -> google.protobuf.Timestamp
+	261, // 261: finance.JournalTemplate.last_occurrence:type_name -> google.protobuf.Timestamp
+	17,  // 262: finance.JournalTemplate.audit:type_name -> finance.AuditFields
+	16,  // 263: finance.CreateJournalTemplateRequest.meta:type_name -> finance.RequestMetadata
+	146, // 264: finance.CreateJournalTemplateRequest.template:type_name -> finance.JournalTemplate
+	16,  // 265: finance.UpdateJournalTemplateRequest.meta:type_name -> finance.RequestMetadata
+	146, // 266: finance.UpdateJournalTemplateRequest.template:type_name -> finance.JournalTemplate
+	263, // 267: finance.UpdateJournalTemplateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 268: finance.DeleteJournalTemplateRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 269: finance.ListJournalTemplatesRequest.page:type_name -> finance.PageRequest
+	146, // 270: finance.ListJournalTemplatesResponse.templates:type_name -> finance.JournalTemplate
+	19,  // 271: finance.ListJournalTemplatesResponse.page:type_name -> finance.PageResponse
+	16,  // 272: finance.GenerateRecurringJournalsRequest.meta:type_name -> finance.RequestMetadata
+	261, // 273: finance.GenerateRecurringJournalsRequest.as_of:type_name -> google.protobuf.Timestamp
+	127, // 274: finance.GenerateRecurringJournalsResponse.entries:type_name -> finance.JournalEntry
+	16,  // 275: finance.ImportJournalEntriesRequest.meta:type_name -> finance.RequestMetadata
+	156, // 276: finance.ImportJournalEntriesResponse.errors:type_name -> finance.ImportRowError
+	17,  // 277: finance.FiscalCalendar.audit:type_name -> finance.AuditFields
+	261, // 278: finance.FiscalPeriod.start_date:type_name -> google.protobuf.Timestamp
+	261, // 279: finance.FiscalPeriod.end_date:type_name -> google.protobuf.Timestamp
+	10,  // 280: finance.FiscalPeriod.status:type_name -> finance.PeriodStatus
+	261, // 281: finance.FiscalPeriod.closed_at:type_name -> google.protobuf.Timestamp
+	16,  // 282: finance.SetFiscalCalendarRequest.meta:type_name -> finance.RequestMetadata
+	158, // 283: finance.SetFiscalCalendarRequest.calendar:type_name -> finance.FiscalCalendar
+	16,  // 284: finance.CreateFiscalYearRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 285: finance.ListFiscalPeriodsRequest.meta:type_name -> finance.RequestMetadata
+	159, // 286: finance.ListFiscalPeriodsResponse.periods:type_name -> finance.FiscalPeriod
+	16,  // 287: finance.OpenPeriodRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 288: finance.ClosePeriodRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 289: finance.CloseFiscalYearRequest.meta:type_name -> finance.RequestMetadata
+	127, // 290: finance.CloseFiscalYearResponse.closing_entry:type_name -> finance.JournalEntry
+	159, // 291: finance.CloseFiscalYearResponse.periods:type_name -> finance.FiscalPeriod
+	262, // 292: finance.Budget.total_amount:type_name -> google.type.Money
+	17,  // 293: finance.Budget.audit:type_name -> finance.AuditFields
+	16,  // 294: finance.CreateBudgetRequest.meta:type_name -> finance.RequestMetadata
+	168, // 295: finance.CreateBudgetRequest.budget:type_name -> finance.Budget
+	16,  // 296: finance.UpdateBudgetRequest.meta:type_name -> finance.RequestMetadata
+	168, // 297: finance.UpdateBudgetRequest.budget:type_name -> finance.Budget
+	263, // 298: finance.UpdateBudgetRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 299: finance.DeleteBudgetRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 300: finance.ListBudgetsRequest.page:type_name -> finance.PageRequest
+	168, // 301: finance.ListBudgetsResponse.budgets:type_name -> finance.Budget
+	19,  // 302: finance.ListBudgetsResponse.page:type_name -> finance.PageResponse
+	262, // 303: finance.BudgetAllocation.allocated_amount:type_name -> google.type.Money
+	262, // 304: finance.BudgetAllocation.spent_amount:type_name -> google.type.Money
+	262, // 305: finance.BudgetAllocation.remaining_amount:type_name -> google.type.Money
+	17,  // 306: finance.BudgetAllocation.audit:type_name -> finance.AuditFields
+	16,  // 307: finance.AllocateBudgetRequest.meta:type_name -> finance.RequestMetadata
+	175, // 308: finance.AllocateBudgetRequest.allocation:type_name -> finance.BudgetAllocation
+	16,  // 309: finance.UpdateBudgetAllocationRequest.meta:type_name -> finance.RequestMetadata
+	175, // 310: finance.UpdateBudgetAllocationRequest.allocation:type_name -> finance.BudgetAllocation
+	263, // 311: finance.UpdateBudgetAllocationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 312: finance.DeleteBudgetAllocationRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 313: finance.ListBudgetAllocationsRequest.page:type_name -> finance.PageRequest
+	175, // 314: finance.ListBudgetAllocationsResponse.allocations:type_name -> finance.BudgetAllocation
+	19,  // 315: finance.ListBudgetAllocationsResponse.page:type_name -> finance.PageResponse
+	262, // 316: finance.BudgetComparisonResponse.total_budget:type_name -> google.type.Money
+	262, // 317: finance.BudgetComparisonResponse.total_allocated:type_name -> google.type.Money
+	262, // 318: finance.BudgetComparisonResponse.total_spent:type_name -> google.type.Money
+	262, // 319: finance.BudgetComparisonResponse.remaining_budget:type_name -> google.type.Money
+	262, // 320: finance.ExpenseRate.amount:type_name -> google.type.Money
+	261, // 321: finance.ExpenseRate.expense_date:type_name -> google.protobuf.Timestamp
+	17,  // 322: finance.ExpenseRate.audit:type_name -> finance.AuditFields
+	16,  // 323: finance.CreateExpenseRateRequest.meta:type_name -> finance.RequestMetadata
+	184, // 324: finance.CreateExpenseRateRequest.expense_rate:type_name -> finance.ExpenseRate
+	16,  // 325: finance.UpdateExpenseRateRequest.meta:type_name -> finance.RequestMetadata
+	184, // 326: finance.UpdateExpenseRateRequest.expense_rate:type_name -> finance.ExpenseRate
+	263, // 327: finance.UpdateExpenseRateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 328: finance.DeleteExpenseRateRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 329: finance.ListExpensesRateRequest.page:type_name -> finance.PageRequest
+	184, // 330: finance.ListExpensesRateResponse.expense_rate:type_name -> finance.ExpenseRate
+	19,  // 331: finance.ListExpensesRateResponse.page:type_name -> finance.PageResponse
+	17,  // 332: finance.CostCenter.audit:type_name -> finance.AuditFields
+	16,  // 333: finance.CreateCostCenterRequest.meta:type_name -> finance.RequestMetadata
+	191, // 334: finance.CreateCostCenterRequest.center:type_name -> finance.CostCenter
+	16,  // 335: finance.UpdateCostCenterRequest.meta:type_name -> finance.RequestMetadata
+	191, // 336: finance.UpdateCostCenterRequest.center:type_name -> finance.CostCenter
+	263, // 337: finance.UpdateCostCenterRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 338: finance.DeleteCostCenterRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 339: finance.ListCostCentersRequest.page:type_name -> finance.PageRequest
+	191, // 340: finance.ListCostCentersResponse.centers:type_name -> finance.CostCenter
+	19,  // 341: finance.ListCostCentersResponse.page:type_name -> finance.PageResponse
+	262, // 342: finance.CostAllocation.amount:type_name -> google.type.Money
+	17,  // 343: finance.CostAllocation.audit:type_name -> finance.AuditFields
+	16,  // 344: finance.AllocateCostRequest.meta:type_name -> finance.RequestMetadata
+	262, // 345: finance.AllocateCostRequest.amount:type_name -> google.type.Money
+	198, // 346: finance.AllocateCostResponse.allocation:type_name -> finance.CostAllocation
+	18,  // 347: finance.ListCostAllocationsRequest.page:type_name -> finance.PageRequest
+	198, // 348: finance.ListCostAllocationsResponse.allocations:type_name -> finance.CostAllocation
+	19,  // 349: finance.ListCostAllocationsResponse.page:type_name -> finance.PageResponse
+	261, // 350: finance.AuditEvent.timestamp:type_name -> google.protobuf.Timestamp
+	16,  // 351: finance.RecordAuditEventRequest.meta:type_name -> finance.RequestMetadata
+	203, // 352: finance.RecordAuditEventRequest.event:type_name -> finance.AuditEvent
+	18,  // 353: finance.ListAuditEventsRequest.page:type_name -> finance.PageRequest
+	203, // 354: finance.ListAuditEventsResponse.events:type_name -> finance.AuditEvent
+	19,  // 355: finance.ListAuditEventsResponse.page:type_name -> finance.PageResponse
+	261, // 356: finance.FilterAuditEventsRequest.from_date:type_name -> google.protobuf.Timestamp
+	261, // 357: finance.FilterAuditEventsRequest.to_date:type_name -> google.protobuf.Timestamp
+	18,  // 358: finance.FilterAuditEventsRequest.page:type_name -> finance.PageRequest
+	203, // 359: finance.FilterAuditEventsResponse.events:type_name -> finance.AuditEvent
+	19,  // 360: finance.FilterAuditEventsResponse.page:type_name -> finance.PageResponse
+	262, // 361: finance.Accrual.amount:type_name -> google.type.Money
+	261, // 362: finance.Accrual.accrual_date:type_name -> google.protobuf.Timestamp
+	17,  // 363: finance.Accrual.audit:type_name -> finance.AuditFields
+	16,  // 364: finance.CreateAccrualRequest.meta:type_name -> finance.RequestMetadata
+	210, // 365: finance.CreateAccrualRequest.accrual:type_name -> finance.Accrual
+	16,  // 366: finance.UpdateAccrualRequest.meta:type_name -> finance.RequestMetadata
+	210, // 367: finance.UpdateAccrualRequest.accrual:type_name -> finance.Accrual
+	263, // 368: finance.UpdateAccrualRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 369: finance.DeleteAccrualRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 370: finance.ListAccrualsRequest.page:type_name -> finance.PageRequest
+	210, // 371: finance.ListAccrualsResponse.accruals:type_name -> finance.Accrual
+	19,  // 372: finance.ListAccrualsResponse.page:type_name -> finance.PageResponse
+	17,  // 373: finance.AllocationRule.audit:type_name -> finance.AuditFields
+	16,  // 374: finance.CreateAllocationRuleRequest.meta:type_name -> finance.RequestMetadata
+	217, // 375: finance.CreateAllocationRuleRequest.rule:type_name -> finance.AllocationRule
+	16,  // 376: finance.UpdateAllocationRuleRequest.meta:type_name -> finance.RequestMetadata
+	217, // 377: finance.UpdateAllocationRuleRequest.rule:type_name -> finance.AllocationRule
+	263, // 378: finance.UpdateAllocationRuleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 379: finance.DeleteAllocationRuleRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 380: finance.ListAllocationRulesRequest.page:type_name -> finance.PageRequest
+	217, // 381: finance.ListAllocationRulesResponse.rules:type_name -> finance.AllocationRule
+	19,  // 382: finance.ListAllocationRulesResponse.page:type_name -> finance.PageResponse
+	261, // 383: finance.ReportPeriod.start_date:type_name -> google.protobuf.Timestamp
+	261, // 384: finance.ReportPeriod.end_date:type_name -> google.protobuf.Timestamp
+	262, // 385: finance.ProfitLossReport.total_revenue:type_name -> google.type.Money
+	262, // 386: finance.ProfitLossReport.total_expenses:type_name -> google.type.Money
+	262, // 387: finance.ProfitLossReport.net_profit:type_name -> google.type.Money
+	262, // 388: finance.BalanceSheetReport.total_assets:type_name -> google.type.Money
+	262, // 389: finance.BalanceSheetReport.total_liabilities:type_name -> google.type.Money
+	262, // 390: finance.BalanceSheetReport.net_worth:type_name -> google.type.Money
+	135, // 391: finance.TrialBalanceReport.entries:type_name -> finance.LedgerEntry
+	224, // 392: finance.ReportRequest.period:type_name -> finance.ReportPeriod
+	224, // 393: finance.ComplianceReportRequest.period:type_name -> finance.ReportPeriod
+	224, // 394: finance.Consolidation.period:type_name -> finance.ReportPeriod
+	231, // 395: finance.CreateConsolidationRequest.consolidation:type_name -> finance.Consolidation
+	18,  // 396: finance.ListConsolidationsRequest.page:type_name -> finance.PageRequest
+	224, // 397: finance.ListConsolidationsRequest.period:type_name -> finance.ReportPeriod
+	231, // 398: finance.ListConsolidationsResponse.consolidations:type_name -> finance.Consolidation
+	19,  // 399: finance.ListConsolidationsResponse.page:type_name -> finance.PageResponse
+	224, // 400: finance.ConsolidationRequest.period:type_name -> finance.ReportPeriod
+	231, // 401: finance.ConsolidationResponse.consolidations:type_name -> finance.Consolidation
+	261, // 402: finance.ExchangeRate.as_of:type_name -> google.protobuf.Timestamp
+	17,  // 403: finance.ExchangeRate.audit:type_name -> finance.AuditFields
+	16,  // 404: finance.CreateExchangeRateRequest.meta:type_name -> finance.RequestMetadata
+	239, // 405: finance.CreateExchangeRateRequest.rate:type_name -> finance.ExchangeRate
+	16,  // 406: finance.UpdateExchangeRateRequest.meta:type_name -> finance.RequestMetadata
+	239, // 407: finance.UpdateExchangeRateRequest.rate:type_name -> finance.ExchangeRate
+	263, // 408: finance.UpdateExchangeRateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 409: finance.DeleteExchangeRateRequest.meta:type_name -> finance.RequestMetadata
+	18,  // 410: finance.ListExchangeRatesRequest.page:type_name -> finance.PageRequest
+	239, // 411: finance.ListExchangeRatesResponse.rates:type_name -> finance.ExchangeRate
+	19,  // 412: finance.ListExchangeRatesResponse.page:type_name -> finance.PageResponse
+	262, // 413: finance.ConvertMoneyRequest.amount:type_name -> google.type.Money
+	261, // 414: finance.ConvertMoneyRequest.as_of:type_name -> google.protobuf.Timestamp
+	262, // 415: finance.ConvertMoneyResponse.converted:type_name -> google.type.Money
+	17,  // 416: finance.FxRevaluationSettings.audit:type_name -> finance.AuditFields
+	16,  // 417: finance.SetFxRevaluationSettingsRequest.meta:type_name -> finance.RequestMetadata
+	248, // 418: finance.SetFxRevaluationSettingsRequest.settings:type_name -> finance.FxRevaluationSettings
+	16,  // 419: finance.GetFxRevaluationSettingsRequest.meta:type_name -> finance.RequestMetadata
+	16,  // 420: finance.RevalueForeignCurrencyRequest.meta:type_name -> finance.RequestMetadata
+	261, // 421: finance.RevalueForeignCurrencyRequest.as_of:type_name -> google.protobuf.Timestamp
+	262, // 422: finance.FxRevaluationItem.balance:type_name -> google.type.Money
+	262, // 423: finance.FxRevaluationItem.carrying_amount:type_name -> google.type.Money
+	262, // 424: finance.FxRevaluationItem.revalued_amount:type_name -> google.type.Money
+	262, // 425: finance.FxRevaluationItem.difference:type_name -> google.type.Money
+	261, // 426: finance.RevalueForeignCurrencyResponse.as_of:type_name -> google.protobuf.Timestamp
+	252, // 427: finance.RevalueForeignCurrencyResponse.items:type_name -> finance.FxRevaluationItem
+	127, // 428: finance.RevalueForeignCurrencyResponse.journal:type_name -> finance.JournalEntry
+	224, // 429: finance.CashFlowForecastRequest.period:type_name -> finance.ReportPeriod
+	261, // 430: finance.FinanceInvoiceCreatedEvent.invoice_date:type_name -> google.protobuf.Timestamp
+	262, // 431: finance.FinanceInvoiceCreatedEvent.total:type_name -> google.type.Money
+	262, // 432: finance.FinancePaymentReceivedEvent.amount_paid:type_name -> google.type.Money
+	261, // 433: finance.FinancePaymentReceivedEvent.paid_at:type_name -> google.protobuf.Timestamp
+	262, // 434: finance.InventoryCostPostedEvent.amount:type_name -> google.type.Money
+	262, // 435: finance.PayrollPostedEvent.total_gross:type_name -> google.type.Money
+	262, // 436: finance.PayrollPostedEvent.total_net:type_name -> google.type.Money
+	261, // 437: finance.PayrollPostedEvent.run_date:type_name -> google.protobuf.Timestamp
+	262, // 438: finance.VendorBillApprovedEvent.amount:type_name -> google.type.Money
+	261, // 439: finance.VendorBillApprovedEvent.approved_at:type_name -> google.protobuf.Timestamp
+	28,  // 440: finance.InvoiceService.CreateInvoice:input_type -> finance.CreateInvoiceRequest
+	29,  // 441: finance.InvoiceService.GetInvoice:input_type -> finance.GetInvoiceRequest
+	32,  // 442: finance.InvoiceService.ListInvoices:input_type -> finance.ListInvoicesRequest
+	34,  // 443: finance.InvoiceService.SearchInvoices:input_type -> finance.SearchInvoicesRequest
+	30,  // 444: finance.InvoiceService.UpdateInvoice:input_type -> finance.UpdateInvoiceRequest
+	31,  // 445: finance.InvoiceService.DeleteInvoice:input_type -> finance.DeleteInvoiceRequest
+	35,  // 446: finance.InvoiceService.IssueInvoice:input_type -> finance.IssueInvoiceRequest
+	36,  // 447: finance.InvoiceService.VoidInvoice:input_type -> finance.VoidInvoiceRequest
+	37,  // 448: finance.InvoiceService.MarkOverdue:input_type -> finance.MarkOverdueRequest
+	39,  // 449: finance.InvoiceService.SetInvoicePostingRule:input_type -> finance.SetInvoicePostingRuleRequest
+	40,  // 450: finance.InvoiceService.GetInvoicePostingRule:input_type -> finance.GetInvoicePostingRuleRequest
+	49,  // 451: finance.InvoiceService.SetInvoiceNumberSeries:input_type -> finance.SetInvoiceNumberSeriesRequest
+	50,  // 452: finance.InvoiceService.GetInvoiceNumberSeries:input_type -> finance.GetInvoiceNumberSeriesRequest
+	45,  // 453: finance.InvoiceService.RenderInvoice:input_type -> finance.RenderInvoiceRequest
+	43,  // 454: finance.InvoiceService.SetInvoiceTemplate:input_type -> finance.SetInvoiceTemplateRequest
+	44,  // 455: finance.InvoiceService.GetInvoiceTemplate:input_type -> finance.GetInvoiceTemplateRequest
+	47,  // 456: finance.InvoiceService.ExportInvoice:input_type -> finance.ExportInvoiceRequest
+	48,  // 457: finance.InvoiceService.ImportInvoice:input_type -> finance.ImportInvoiceRequest
+	56,  // 458: finance.PartyService.CreateParty:input_type -> finance.CreatePartyRequest
+	57,  // 459: finance.PartyService.GetParty:input_type -> finance.GetPartyRequest
+	58,  // 460: finance.PartyService.UpdateParty:input_type -> finance.UpdatePartyRequest
+	59,  // 461: finance.PartyService.DeleteParty:input_type -> finance.DeletePartyRequest
+	60,  // 462: finance.PartyService.ListParties:input_type -> finance.ListPartiesRequest
+	64,  // 463: finance.CreditControlService.GetCreditPolicy:input_type -> finance.GetCreditPolicyRequest
+	65,  // 464: finance.CreditControlService.SetCreditPolicy:input_type -> finance.SetCreditPolicyRequest
+	66,  // 465: finance.CreditControlService.GetCreditExposure:input_type -> finance.GetCreditExposureRequest
+	67,  // 466: finance.CreditControlService.ApproveInvoiceCredit:input_type -> finance.ApproveInvoiceCreditRequest
+	72,  // 467: finance.DunningService.SetDunningSchedule:input_type -> finance.SetDunningScheduleRequest
+	73,  // 468: finance.DunningService.GetDunningSchedule:input_type -> finance.GetDunningScheduleRequest
+	74,  // 469: finance.DunningService.PlaceDunningHold:input_type -> finance.PlaceDunningHoldRequest
+	75,  // 470: finance.DunningService.ReleaseDunningHold:input_type -> finance.ReleaseDunningHoldRequest
+	76,  // 471: finance.DunningService.ListDunningHolds:input_type -> finance.ListDunningHoldsRequest
+	78,  // 472: finance.DunningService.RunDunning:input_type -> finance.RunDunningRequest
+	81,  // 473: finance.RecurringInvoiceService.CreateRecurringInvoice:input_type -> finance.CreateRecurringInvoiceRequest
+	82,  // 474: finance.RecurringInvoiceService.GetRecurringInvoice:input_type -> finance.GetRecurringInvoiceRequest
+	83,  // 475: finance.RecurringInvoiceService.UpdateRecurringInvoice:input_type -> finance.UpdateRecurringInvoiceRequest
+	84,  // 476: finance.RecurringInvoiceService.DeleteRecurringInvoice:input_type -> finance.DeleteRecurringInvoiceRequest
+	85,  // 477: finance.RecurringInvoiceService.ListRecurringInvoices:input_type -> finance.ListRecurringInvoicesRequest
+	87,  // 478: finance.RecurringInvoiceService.GenerateRecurringInvoices:input_type -> finance.GenerateRecurringInvoicesRequest
+	91,  // 479: finance.CreditDebitNoteService.CreateCreditDebitNote:input_type -> finance.CreateCreditDebitNoteRequest
+	92,  // 480: finance.CreditDebitNoteService.GetCreditDebitNote:input_type -> finance.GetCreditDebitNoteRequest
+	95,  // 481: finance.CreditDebitNoteService.ListCreditDebitNotes:input_type -> finance.ListCreditDebitNotesRequest
+	93,  // 482: finance.CreditDebitNoteService.UpdateCreditDebitNote:input_type -> finance.UpdateCreditDebitNoteRequest
+	94,  // 483: finance.CreditDebitNoteService.DeleteCreditDebitNote:input_type -> finance.DeleteCreditDebitNoteRequest
+	98,  // 484: finance.PaymentService.CreatePaymentDue:input_type -> finance.CreatePaymentDueRequest
+	99,  // 485: finance.PaymentService.GetPaymentDue:input_type -> finance.GetPaymentDueRequest
+	100, // 486: finance.PaymentService.UpdatePaymentDue:input_type -> finance.UpdatePaymentDueRequest
+	101, // 487: finance.PaymentService.DeletePaymentDue:input_type -> finance.DeletePaymentDueRequest
+	102, // 488: finance.PaymentService.MarkPaymentAsPaid:input_type -> finance.MarkPaymentAsPaidRequest
+	103, // 489: finance.PaymentService.ListPaymentDues:input_type -> finance.ListPaymentDuesRequest
+	106, // 490: finance.PaymentService.CreateBankAccount:input_type -> finance.CreateBankAccountRequest
+	107, // 491: finance.PaymentService.GetBankAccount:input_type -> finance.GetBankAccountRequest
+	108, // 492: finance.PaymentService.UpdateBankAccount:input_type -> finance.UpdateBankAccountRequest
+	109, // 493: finance.PaymentService.DeleteBankAccount:input_type -> finance.DeleteBankAccountRequest
+	110, // 494: finance.PaymentService.ListBankAccounts:input_type -> finance.ListBankAccountsRequest
+	113, // 495: finance.PaymentService.ImportBankTransactions:input_type -> finance.ImportBankTransactionsRequest
+	115, // 496: finance.PaymentService.ListBankTransactions:input_type -> finance.ListBankTransactionsRequest
+	117, // 497: finance.BankReconciliationService.ReconcileTransaction:input_type -> finance.ReconcileTransactionRequest
+	120, // 498: finance.LedgerService.CreateAccount:input_type -> finance.CreateAccountRequest
+	121, // 499: finance.LedgerService.GetAccount:input_type -> finance.GetAccountRequest
+	122, // 500: finance.LedgerService.UpdateAccount:input_type -> finance.UpdateAccountRequest
+	123, // 501: finance.LedgerService.DeleteAccount:input_type -> finance.DeleteAccountRequest
+	124, // 502: finance.LedgerService.ListAccounts:input_type -> finance.ListAccountsRequest
+	128, // 503: finance.LedgerService.CreateJournalEntry:input_type -> finance.CreateJournalEntryRequest
+	129, // 504: finance.LedgerService.GetJournalEntry:input_type -> finance.GetJournalEntryRequest
+	130, // 505: finance.LedgerService.UpdateJournalEntry:input_type -> finance.UpdateJournalEntryRequest
+	131, // 506: finance.LedgerService.DeleteJournalEntry:input_type -> finance.DeleteJournalEntryRequest
+	133, // 507: finance.LedgerService.ListJournalEntries:input_type -> finance.ListJournalEntriesRequest
+	132, // 508: finance.LedgerService.ReverseJournalEntry:input_type -> finance.ReverseJournalEntryRequest
+	136, // 509: finance.LedgerService.ListLedgerEntries:input_type -> finance.ListLedgerEntriesRequest
+	140, // 510: finance.LedgerService.GetAccountBalance:input_type -> finance.GetAccountBalanceRequest
+	141, // 511: finance.LedgerService.ListAccountBalances:input_type -> finance.ListAccountBalancesRequest
+	144, // 512: finance.LedgerService.GetAccountTree:input_type -> finance.GetAccountTreeRequest
+	147, // 513: finance.LedgerService.CreateJournalTemplate:input_type -> finance.CreateJournalTemplateRequest
+	148, // 514: finance.LedgerService.GetJournalTemplate:input_type -> finance.GetJournalTemplateRequest
+	149, // 515: finance.LedgerService.UpdateJournalTemplate:input_type -> finance.UpdateJournalTemplateRequest
+	150, // 516: finance.LedgerService.DeleteJournalTemplate:input_type -> finance.DeleteJournalTemplateRequest
+	151, // 517: finance.LedgerService.ListJournalTemplates:input_type -> finance.ListJournalTemplatesRequest
+	153, // 518: finance.LedgerService.GenerateRecurringJournals:input_type -> finance.GenerateRecurringJournalsRequest
+	155, // 519: finance.LedgerService.ImportJournalEntries:input_type -> finance.ImportJournalEntriesRequest
+	160, // 520: finance.FiscalPeriodService.SetFiscalCalendar:input_type -> finance.SetFiscalCalendarRequest
+	161, // 521: finance.FiscalPeriodService.CreateFiscalYear:input_type -> finance.CreateFiscalYearRequest
+	162, // 522: finance.FiscalPeriodService.ListFiscalPeriods:input_type -> finance.ListFiscalPeriodsRequest
+	164, // 523: finance.FiscalPeriodService.OpenPeriod:input_type -> finance.OpenPeriodRequest
+	165, // 524: finance.FiscalPeriodService.ClosePeriod:input_type -> finance.ClosePeriodRequest
+	166, // 525: finance.FiscalPeriodService.CloseFiscalYear:input_type -> finance.CloseFiscalYearRequest
+	169, // 526: finance.BudgetService.CreateBudget:input_type -> finance.CreateBudgetRequest
+	170, // 527: finance.BudgetService.GetBudget:input_type -> finance.GetBudgetRequest
+	171, // 528: finance.BudgetService.UpdateBudget:input_type -> finance.UpdateBudgetRequest
+	172, // 529: finance.BudgetService.DeleteBudget:input_type -> finance.DeleteBudgetRequest
+	173, // 530: finance.BudgetService.ListBudgets:input_type -> finance.ListBudgetsRequest
+	176, // 531: finance.BudgetAllocationService.AllocateBudget:input_type -> finance.AllocateBudgetRequest
+	177, // 532: finance.BudgetAllocationService.GetBudgetAllocation:input_type -> finance.GetBudgetAllocationRequest
+	178, // 533: finance.BudgetAllocationService.UpdateBudgetAllocation:input_type -> finance.UpdateBudgetAllocationRequest
+	179, // 534: finance.BudgetAllocationService.DeleteBudgetAllocation:input_type -> finance.DeleteBudgetAllocationRequest
+	180, // 535: finance.BudgetAllocationService.ListBudgetAllocations:input_type -> finance.ListBudgetAllocationsRequest
+	182, // 536: finance.BudgetComparisonService.GetBudgetComparisonReport:input_type -> finance.BudgetComparisonRequest
+	185, // 537: finance.ExpenseRateService.CreateExpenseRate:input_type -> finance.CreateExpenseRateRequest
+	186, // 538: finance.ExpenseRateService.GetExpenseRate:input_type -> finance.GetExpenseRateRequest
+	187, // 539: finance.ExpenseRateService.UpdateExpenseRate:input_type -> finance.UpdateExpenseRateRequest
+	188, // 540: finance.ExpenseRateService.DeleteExpenseRate:input_type -> finance.DeleteExpenseRateRequest
+	189, // 541: finance.ExpenseRateService.ListExpensesRate:input_type -> finance.ListExpensesRateRequest
+	192, // 542: finance.CostAccountingService.CreateCostCenter:input_type -> finance.CreateCostCenterRequest
+	193, // 543: finance.CostAccountingService.GetCostCenter:input_type -> finance.GetCostCenterRequest
+	194, // 544: finance.CostAccountingService.UpdateCostCenter:input_type -> finance.UpdateCostCenterRequest
+	195, // 545: finance.CostAccountingService.DeleteCostCenter:input_type -> finance.DeleteCostCenterRequest
+	196, // 546: finance.CostAccountingService.ListCostCenters:input_type -> finance.ListCostCentersRequest
+	199, // 547: finance.CostAccountingService.AllocateCost:input_type -> finance.AllocateCostRequest
+	201, // 548: finance.CostAccountingService.ListCostAllocations:input_type -> finance.ListCostAllocationsRequest
+	204, // 549: finance.AuditTrailService.RecordAuditEvent:input_type -> finance.RecordAuditEventRequest
+	205, // 550: finance.AuditTrailService.ListAuditEvents:input_type -> finance.ListAuditEventsRequest
+	207, // 551: finance.AuditTrailService.GetAuditEventById:input_type -> finance.GetAuditEventByIdRequest
+	208, // 552: finance.AuditTrailService.FilterAuditEvents:input_type -> finance.FilterAuditEventsRequest
+	211, // 553: finance.AccrualService.CreateAccrual:input_type -> finance.CreateAccrualRequest
+	212, // 554: finance.AccrualService.GetAccrualById:input_type -> finance.GetAccrualByIdRequest
+	213, // 555: finance.AccrualService.UpdateAccrual:input_type -> finance.UpdateAccrualRequest
+	214, // 556: finance.AccrualService.DeleteAccrual:input_type -> finance.DeleteAccrualRequest
+	215, // 557: finance.AccrualService.ListAccruals:input_type -> finance.ListAccrualsRequest
+	218, // 558: finance.AllocationAutomationService.CreateAllocationRule:input_type -> finance.CreateAllocationRuleRequest
+	219, // 559: finance.AllocationAutomationService.GetAllocationRule:input_type -> finance.GetAllocationRuleRequest
+	220, // 560: finance.AllocationAutomationService.UpdateAllocationRule:input_type -> finance.UpdateAllocationRuleRequest
+	221, // 561: finance.AllocationAutomationService.DeleteAllocationRule:input_type -> finance.DeleteAllocationRuleRequest
+	222, // 562: finance.AllocationAutomationService.ListAllocationRules:input_type -> finance.ListAllocationRulesRequest
+	228, // 563: finance.FinancialReportService.GenerateProfitLossReport:input_type -> finance.ReportRequest
+	228, // 564: finance.FinancialReportService.GenerateBalanceSheetReport:input_type -> finance.ReportRequest
+	228, // 565: finance.FinancialReportService.GenerateTrialBalanceReport:input_type -> finance.ReportRequest
+	229, // 566: finance.FinancialReportService.GenerateComplianceReport:input_type -> finance.ComplianceReportRequest
+	229, // 567: finance.FinancialComplianceService.GenerateComplianceReport:input_type -> finance.ComplianceReportRequest
+	237, // 568: finance.ConsolidationService.ConsolidateEntities:input_type -> finance.ConsolidationRequest
+	232, // 569: finance.ConsolidationService.CreateConsolidation:input_type -> finance.CreateConsolidationRequest
+	233, // 570: finance.ConsolidationService.GetConsolidation:input_type -> finance.GetConsolidationRequest
+	234, // 571: finance.ConsolidationService.ListConsolidations:input_type -> finance.ListConsolidationsRequest
+	236, // 572: finance.ConsolidationService.DeleteConsolidation:input_type -> finance.DeleteConsolidationRequest
+	240, // 573: finance.FxService.CreateExchangeRate:input_type -> finance.CreateExchangeRateRequest
+	241, // 574: finance.FxService.GetExchangeRate:input_type -> finance.GetExchangeRateRequest
+	242, // 575: finance.FxService.UpdateExchangeRate:input_type -> finance.UpdateExchangeRateRequest
+	243, // 576: finance.FxService.DeleteExchangeRate:input_type -> finance.DeleteExchangeRateRequest
+	244, // 577: finance.FxService.ListExchangeRates:input_type -> finance.ListExchangeRatesRequest
+	246, // 578: finance.FxService.ConvertMoney:input_type -> finance.ConvertMoneyRequest
+	249, // 579: finance.FxService.SetFxRevaluationSettings:input_type -> finance.SetFxRevaluationSettingsRequest
+	250, // 580: finance.FxService.GetFxRevaluationSettings:input_type -> finance.GetFxRevaluationSettingsRequest
+	251, // 581: finance.FxService.RevalueForeignCurrency:input_type -> finance.RevalueForeignCurrencyRequest
+	254, // 582: finance.CashFlowService.GenerateForecast:input_type -> finance.CashFlowForecastRequest
+	254, // 583: finance.CashFlowService.GetForecast:input_type -> finance.CashFlowForecastRequest
+	254, // 584: finance.CashFlowService.ListForecasts:input_type -> finance.CashFlowForecastRequest
+	256, // 585: finance.FinanceEventPublisher.PublishInvoiceCreated:input_type -> finance.FinanceInvoiceCreatedEvent
+	257, // 586: finance.FinanceEventPublisher.PublishPaymentReceived:input_type -> finance.FinancePaymentReceivedEvent
+	258, // 587: finance.FinanceEventPublisher.PublishInventoryCostPosted:input_type -> finance.InventoryCostPostedEvent
+	259, // 588: finance.FinanceEventPublisher.PublishPayrollPosted:input_type -> finance.PayrollPostedEvent
+	260, // 589: finance.FinanceEventPublisher.PublishVendorBillApproved:input_type -> finance.VendorBillApprovedEvent
+	27,  // 590: finance.InvoiceService.CreateInvoice:output_type -> finance.Invoice
+	27,  // 591: finance.InvoiceService.GetInvoice:output_type -> finance.Invoice
+	33,  // 592: finance.InvoiceService.ListInvoices:output_type -> finance.ListInvoicesResponse
+	33,  // 593: finance.InvoiceService.SearchInvoices:output_type -> finance.ListInvoicesResponse
+	27,  // 594: finance.InvoiceService.UpdateInvoice:output_type -> finance.Invoice
+	264, // 595: finance.InvoiceService.DeleteInvoice:output_type -> google.protobuf.Empty
+	27,  // 596: finance.InvoiceService.IssueInvoice:output_type -> finance.Invoice
+	27,  // 597: finance.InvoiceService.VoidInvoice:output_type -> finance.Invoice
+	27,  // 598: finance.InvoiceService.MarkOverdue:output_type -> finance.Invoice
+	38,  // 599: finance.InvoiceService.SetInvoicePostingRule:output_type -> finance.InvoicePostingRule
+	38,  // 600: finance.InvoiceService.GetInvoicePostingRule:output_type -> finance.InvoicePostingRule
+	41,  // 601: finance.InvoiceService.SetInvoiceNumberSeries:output_type -> finance.InvoiceNumberSeries
+	41,  // 602: finance.InvoiceService.GetInvoiceNumberSeries:output_type -> finance.InvoiceNumberSeries
+	46,  // 603: finance.InvoiceService.RenderInvoice:output_type -> finance.RenderedInvoice
+	42,  // 604: finance.InvoiceService.SetInvoiceTemplate:output_type -> finance.InvoiceTemplate
+	42,  // 605: finance.InvoiceService.GetInvoiceTemplate:output_type -> finance.InvoiceTemplate
+	46,  // 606: finance.InvoiceService.ExportInvoice:output_type -> finance.RenderedInvoice
+	27,  // 607: finance.InvoiceService.ImportInvoice:output_type -> finance.Invoice
+	55,  // 608: finance.PartyService.CreateParty:output_type -> finance.Party
+	55,  // 609: finance.PartyService.GetParty:output_type -> finance.Party
+	55,  // 610: finance.PartyService.UpdateParty:output_type -> finance.Party
+	264, // 611: finance.PartyService.DeleteParty:output_type -> google.protobuf.Empty
+	61,  // 612: finance.PartyService.ListParties:output_type -> finance.ListPartiesResponse
+	62,  // 613: finance.CreditControlService.GetCreditPolicy:output_type -> finance.CreditPolicy
+	62,  // 614: finance.CreditControlService.SetCreditPolicy:output_type -> finance.CreditPolicy
+	63,  // 615: finance.CreditControlService.GetCreditExposure:output_type -> finance.CreditExposure
+	264, // 616: finance.CreditControlService.ApproveInvoiceCredit:output_type -> google.protobuf.Empty
+	69,  // 617: finance.DunningService.SetDunningSchedule:output_type -> finance.DunningSchedule
+	69,  // 618: finance.DunningService.GetDunningSchedule:output_type -> finance.DunningSchedule
+	70,  // 619: finance.DunningService.PlaceDunningHold:output_type -> finance.DunningHold
+	264, // 620: finance.DunningService.ReleaseDunningHold:output_type -> google.protobuf.Empty
+	77,  // 621: finance.DunningService.ListDunningHolds:output_type -> finance.ListDunningHoldsResponse
+	79,  // 622: finance.DunningService.RunDunning:output_type -> finance.RunDunningResponse
+	80,  // 623: finance.RecurringInvoiceService.CreateRecurringInvoice:output_type -> finance.RecurringInvoice
+	80,  // 624: finance.RecurringInvoiceService.GetRecurringInvoice:output_type -> finance.RecurringInvoice
+	80,  // 625: finance.RecurringInvoiceService.UpdateRecurringInvoice:output_type -> finance.RecurringInvoice
+	264, // 626: finance.RecurringInvoiceService.DeleteRecurringInvoice:output_type -> google.protobuf.Empty
+	86,  // 627: finance.RecurringInvoiceService.ListRecurringInvoices:output_type -> finance.ListRecurringInvoicesResponse
+	89,  // 628: finance.RecurringInvoiceService.GenerateRecurringInvoices:output_type -> finance.GenerateRecurringInvoicesResponse
+	90,  // 629: finance.CreditDebitNoteService.CreateCreditDebitNote:output_type -> finance.CreditDebitNote
+	90,  // 630: finance.CreditDebitNoteService.GetCreditDebitNote:output_type -> finance.CreditDebitNote
+	96,  // 631: finance.CreditDebitNoteService.ListCreditDebitNotes:output_type -> finance.ListCreditDebitNotesResponse
+	90,  // 632: finance.CreditDebitNoteService.UpdateCreditDebitNote:output_type -> finance.CreditDebitNote
+	264, // 633: finance.CreditDebitNoteService.DeleteCreditDebitNote:output_type -> google.protobuf.Empty
+	97,  // 634: finance.PaymentService.CreatePaymentDue:output_type -> finance.PaymentDue
+	97,  // 635: finance.PaymentService.GetPaymentDue:output_type -> finance.PaymentDue
+	97,  // 636: finance.PaymentService.UpdatePaymentDue:output_type -> finance.PaymentDue
+	264, // 637: finance.PaymentService.DeletePaymentDue:output_type -> google.protobuf.Empty
+	97,  // 638: finance.PaymentService.MarkPaymentAsPaid:output_type -> finance.PaymentDue
+	104, // 639: finance.PaymentService.ListPaymentDues:output_type -> finance.ListPaymentDuesResponse
+	105, // 640: finance.PaymentService.CreateBankAccount:output_type -> finance.BankAccount
+	105, // 641: finance.PaymentService.GetBankAccount:output_type -> finance.BankAccount
+	105, // 642: finance.PaymentService.UpdateBankAccount:output_type -> finance.BankAccount
+	264, // 643: finance.PaymentService.DeleteBankAccount:output_type -> google.protobuf.Empty
+	111, // 644: finance.PaymentService.ListBankAccounts:output_type -> finance.ListBankAccountsResponse
+	114, // 645: finance.PaymentService.ImportBankTransactions:output_type -> finance.ImportBankTransactionsResponse
+	116, // 646: finance.PaymentService.ListBankTransactions:output_type -> finance.ListBankTransactionsResponse
+	118, // 647: finance.BankReconciliationService.ReconcileTransaction:output_type -> finance.Reconciliation
+	119, // 648: finance.LedgerService.CreateAccount:output_type -> finance.Account
+	119, // 649: finance.LedgerService.GetAccount:output_type -> finance.Account
+	119, // 650: finance.LedgerService.UpdateAccount:output_type -> finance.Account
+	264, // 651: finance.LedgerService.DeleteAccount:output_type -> google.protobuf.Empty
+	125, // 652: finance.LedgerService.ListAccounts:output_type -> finance.ListAccountsResponse
+	127, // 653: finance.LedgerService.CreateJournalEntry:output_type -> finance.JournalEntry
+	127, // 654: finance.LedgerService.GetJournalEntry:output_type -> finance.JournalEntry
+	127, // 655: finance.LedgerService.UpdateJournalEntry:output_type -> finance.JournalEntry
+	264, // 656: finance.LedgerService.DeleteJournalEntry:output_type -> google.protobuf.Empty
+	134, // 657: finance.LedgerService.ListJournalEntries:output_type -> finance.ListJournalEntriesResponse
+	127, // 658: finance.LedgerService.ReverseJournalEntry:output_type -> finance.JournalEntry
+	137, // 659: finance.LedgerService.ListLedgerEntries:output_type -> finance.ListLedgerEntriesResponse
+	138, // 660: finance.LedgerService.GetAccountBalance:output_type -> finance.AccountBalance
+	142, // 661: finance.LedgerService.ListAccountBalances:output_type -> finance.ListAccountBalancesResponse
+	145, // 662: finance.LedgerService.GetAccountTree:output_type -> finance.GetAccountTreeResponse
+	146, // 663: finance.LedgerService.CreateJournalTemplate:output_type -> finance.JournalTemplate
+	146, // 664: finance.LedgerService.GetJournalTemplate:output_type -> finance.JournalTemplate
+	146, // 665: finance.LedgerService.UpdateJournalTemplate:output_type -> finance.JournalTemplate
+	264, // 666: finance.LedgerService.DeleteJournalTemplate:output_type -> google.protobuf.Empty
+	152, // 667: finance.LedgerService.ListJournalTemplates:output_type -> finance.ListJournalTemplatesResponse
+	154, // 668: finance.LedgerService.GenerateRecurringJournals:output_type -> finance.GenerateRecurringJournalsResponse
+	157, // 669: finance.LedgerService.ImportJournalEntries:output_type -> finance.ImportJournalEntriesResponse
+	158, // 670: finance.FiscalPeriodService.SetFiscalCalendar:output_type -> finance.FiscalCalendar
+	163, // 671: finance.FiscalPeriodService.CreateFiscalYear:output_type -> finance.ListFiscalPeriodsResponse
+	163, // 672: finance.FiscalPeriodService.ListFiscalPeriods:output_type -> finance.ListFiscalPeriodsResponse
+	159, // 673: finance.FiscalPeriodService.OpenPeriod:output_type -> finance.FiscalPeriod
+	159, // 674: finance.FiscalPeriodService.ClosePeriod:output_type -> finance.FiscalPeriod
+	167, // 675: finance.FiscalPeriodService.CloseFiscalYear:output_type -> finance.CloseFiscalYearResponse
+	168, // 676: finance.BudgetService.CreateBudget:output_type -> finance.Budget
+	168, // 677: finance.BudgetService.GetBudget:output_type -> finance.Budget
+	168, // 678: finance.BudgetService.UpdateBudget:output_type -> finance.Budget
+	264, // 679: finance.BudgetService.DeleteBudget:output_type -> google.protobuf.Empty
+	174, // 680: finance.BudgetService.ListBudgets:output_type -> finance.ListBudgetsResponse
+	175, // 681: finance.BudgetAllocationService.AllocateBudget:output_type -> finance.BudgetAllocation
+	175, // 682: finance.BudgetAllocationService.GetBudgetAllocation:output_type -> finance.BudgetAllocation
+	175, // 683: finance.BudgetAllocationService.UpdateBudgetAllocation:output_type -> finance.BudgetAllocation
+	264, // 684: finance.BudgetAllocationService.DeleteBudgetAllocation:output_type -> google.protobuf.Empty
+	181, // 685: finance.BudgetAllocationService.ListBudgetAllocations:output_type -> finance.ListBudgetAllocationsResponse
+	183, // 686: finance.BudgetComparisonService.GetBudgetComparisonReport:output_type -> finance.BudgetComparisonResponse
+	184, // 687: finance.ExpenseRateService.CreateExpenseRate:output_type -> finance.ExpenseRate
+	184, // 688: finance.ExpenseRateService.GetExpenseRate:output_type -> finance.ExpenseRate
+	184, // 689: finance.ExpenseRateService.UpdateExpenseRate:output_type -> finance.ExpenseRate
+	264, // 690: finance.ExpenseRateService.DeleteExpenseRate:output_type -> google.protobuf.Empty
+	190, // 691: finance.ExpenseRateService.ListExpensesRate:output_type -> finance.ListExpensesRateResponse
+	191, // 692: finance.CostAccountingService.CreateCostCenter:output_type -> finance.CostCenter
+	191, // 693: finance.CostAccountingService.GetCostCenter:output_type -> finance.CostCenter
+	191, // 694: finance.CostAccountingService.UpdateCostCenter:output_type -> finance.CostCenter
+	264, // 695: finance.CostAccountingService.DeleteCostCenter:output_type -> google.protobuf.Empty
+	197, // 696: finance.CostAccountingService.ListCostCenters:output_type -> finance.ListCostCentersResponse
+	200, // 697: finance.CostAccountingService.AllocateCost:output_type -> finance.AllocateCostResponse
+	202, // 698: finance.CostAccountingService.ListCostAllocations:output_type -> finance.ListCostAllocationsResponse
+	203, // 699: finance.AuditTrailService.RecordAuditEvent:output_type -> finance.AuditEvent
+	206, // 700: finance.AuditTrailService.ListAuditEvents:output_type -> finance.ListAuditEventsResponse
+	203, // 701: finance.AuditTrailService.GetAuditEventById:output_type -> finance.AuditEvent
+	209, // 702: finance.AuditTrailService.FilterAuditEvents:output_type -> finance.FilterAuditEventsResponse
+	210, // 703: finance.AccrualService.CreateAccrual:output_type -> finance.Accrual
+	210, // 704: finance.AccrualService.GetAccrualById:output_type -> finance.Accrual
+	210, // 705: finance.AccrualService.UpdateAccrual:output_type -> finance.Accrual
+	264, // 706: finance.AccrualService.DeleteAccrual:output_type -> google.protobuf.Empty
+	216, // 707: finance.AccrualService.ListAccruals:output_type -> finance.ListAccrualsResponse
+	217, // 708: finance.AllocationAutomationService.CreateAllocationRule:output_type -> finance.AllocationRule
+	217, // 709: finance.AllocationAutomationService.GetAllocationRule:output_type -> finance.AllocationRule
+	217, // 710: finance.AllocationAutomationService.UpdateAllocationRule:output_type -> finance.AllocationRule
+	264, // 711: finance.AllocationAutomationService.DeleteAllocationRule:output_type -> google.protobuf.Empty
+	223, // 712: finance.AllocationAutomationService.ListAllocationRules:output_type -> finance.ListAllocationRulesResponse
+	225, // 713: finance.FinancialReportService.GenerateProfitLossReport:output_type -> finance.ProfitLossReport
+	226, // 714: finance.FinancialReportService.GenerateBalanceSheetReport:output_type -> finance.BalanceSheetReport
+	227, // 715: finance.FinancialReportService.GenerateTrialBalanceReport:output_type -> finance.TrialBalanceReport
+	230, // 716: finance.FinancialReportService.GenerateComplianceReport:output_type -> finance.ComplianceReport
+	230, // 717: finance.FinancialComplianceService.GenerateComplianceReport:output_type -> finance.ComplianceReport
+	238, // 718: finance.ConsolidationService.ConsolidateEntities:output_type -> finance.ConsolidationResponse
+	231, // 719: finance.ConsolidationService.CreateConsolidation:output_type -> finance.Consolidation
+	231, // 720: finance.ConsolidationService.GetConsolidation:output_type -> finance.Consolidation
+	235, // 721: finance.ConsolidationService.ListConsolidations:output_type -> finance.ListConsolidationsResponse
+	264, // 722: finance.ConsolidationService.DeleteConsolidation:output_type -> google.protobuf.Empty
+	239, // 723: finance.FxService.CreateExchangeRate:output_type -> finance.ExchangeRate
+	239, // 724: finance.FxService.GetExchangeRate:output_type -> finance.ExchangeRate
+	239, // 725: finance.FxService.UpdateExchangeRate:output_type -> finance.ExchangeRate
+	264, // 726: finance.FxService.DeleteExchangeRate:output_type -> google.protobuf.Empty
+	245, // 727: finance.FxService.ListExchangeRates:output_type -> finance.ListExchangeRatesResponse
+	247, // 728: finance.FxService.ConvertMoney:output_type -> finance.ConvertMoneyResponse
+	248, // 729: finance.FxService.SetFxRevaluationSettings:output_type -> finance.FxRevaluationSettings
+	248, // 730: finance.FxService.GetFxRevaluationSettings:output_type -> finance.FxRevaluationSettings
+	253, // 731: finance.FxService.RevalueForeignCurrency:output_type -> finance.RevalueForeignCurrencyResponse
+	255, // 732: finance.CashFlowService.GenerateForecast:output_type -> finance.CashFlowForecastResponse
+	255, // 733: finance.CashFlowService.GetForecast:output_type -> finance.CashFlowForecastResponse
+	255, // 734: finance.CashFlowService.ListForecasts:output_type -> finance.CashFlowForecastResponse
+	264, // 735: finance.FinanceEventPublisher.PublishInvoiceCreated:output_type -> google.protobuf.Empty
+	264, // 736: finance.FinanceEventPublisher.PublishPaymentReceived:output_type -> google.protobuf.Empty
+	264, // 737: finance.FinanceEventPublisher.PublishInventoryCostPosted:output_type -> google.protobuf.Empty
+	264, // 738: finance.FinanceEventPublisher.PublishPayrollPosted:output_type -> google.protobuf.Empty
+	264, // 739: finance.FinanceEventPublisher.PublishVendorBillApproved:output_type -> google.protobuf.Empty
+	590, // [590:740] is the sub-list for method output_type
+	440, // [440:590] is the sub-list for method input_type
+	440, // [440:440] is the sub-list for extension type_name
+	440, // [440:440] is the sub-list for extension extendee
+	0,   // [0:440] is the sub-list for field type_name
 }
 
 func init() { file_finance_proto_init() }
@@ -11289,10 +18830,10 @@ func file_finance_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_finance_proto_rawDesc), len(file_finance_proto_rawDesc)),
-			NumEnums:      10,
-			NumMessages:   153,
+			NumEnums:      16,
+			NumMessages:   245,
 			NumExtensions: 0,
-			NumServices:   19,
+			NumServices:   24,
 		},
 		GoTypes:           file_finance_proto_goTypes,
 		DependencyIndexes: file_finance_proto_depIdxs,
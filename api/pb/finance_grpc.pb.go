@@ -20,12 +20,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InvoiceService_CreateInvoice_FullMethodName  = "/finance.InvoiceService/CreateInvoice"
-	InvoiceService_GetInvoice_FullMethodName     = "/finance.InvoiceService/GetInvoice"
-	InvoiceService_ListInvoices_FullMethodName   = "/finance.InvoiceService/ListInvoices"
-	InvoiceService_SearchInvoices_FullMethodName = "/finance.InvoiceService/SearchInvoices"
-	InvoiceService_UpdateInvoice_FullMethodName  = "/finance.InvoiceService/UpdateInvoice"
-	InvoiceService_DeleteInvoice_FullMethodName  = "/finance.InvoiceService/DeleteInvoice"
+	InvoiceService_CreateInvoice_FullMethodName          = "/finance.InvoiceService/CreateInvoice"
+	InvoiceService_GetInvoice_FullMethodName             = "/finance.InvoiceService/GetInvoice"
+	InvoiceService_ListInvoices_FullMethodName           = "/finance.InvoiceService/ListInvoices"
+	InvoiceService_SearchInvoices_FullMethodName         = "/finance.InvoiceService/SearchInvoices"
+	InvoiceService_UpdateInvoice_FullMethodName          = "/finance.InvoiceService/UpdateInvoice"
+	InvoiceService_DeleteInvoice_FullMethodName          = "/finance.InvoiceService/DeleteInvoice"
+	InvoiceService_IssueInvoice_FullMethodName           = "/finance.InvoiceService/IssueInvoice"
+	InvoiceService_VoidInvoice_FullMethodName            = "/finance.InvoiceService/VoidInvoice"
+	InvoiceService_MarkOverdue_FullMethodName            = "/finance.InvoiceService/MarkOverdue"
+	InvoiceService_SetInvoicePostingRule_FullMethodName  = "/finance.InvoiceService/SetInvoicePostingRule"
+	InvoiceService_GetInvoicePostingRule_FullMethodName  = "/finance.InvoiceService/GetInvoicePostingRule"
+	InvoiceService_SetInvoiceNumberSeries_FullMethodName = "/finance.InvoiceService/SetInvoiceNumberSeries"
+	InvoiceService_GetInvoiceNumberSeries_FullMethodName = "/finance.InvoiceService/GetInvoiceNumberSeries"
+	InvoiceService_RenderInvoice_FullMethodName          = "/finance.InvoiceService/RenderInvoice"
+	InvoiceService_SetInvoiceTemplate_FullMethodName     = "/finance.InvoiceService/SetInvoiceTemplate"
+	InvoiceService_GetInvoiceTemplate_FullMethodName     = "/finance.InvoiceService/GetInvoiceTemplate"
+	InvoiceService_ExportInvoice_FullMethodName          = "/finance.InvoiceService/ExportInvoice"
+	InvoiceService_ImportInvoice_FullMethodName          = "/finance.InvoiceService/ImportInvoice"
 )
 
 // InvoiceServiceClient is the client API for InvoiceService service.
@@ -38,6 +50,30 @@ type InvoiceServiceClient interface {
 	SearchInvoices(ctx context.Context, in *SearchInvoicesRequest, opts ...grpc.CallOption) (*ListInvoicesResponse, error)
 	UpdateInvoice(ctx context.Context, in *UpdateInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error)
 	DeleteInvoice(ctx context.Context, in *DeleteInvoiceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Status transitions. Only DRAFT invoices can be changed; an ISSUED
+	// invoice is corrected through credit/debit notes.
+	IssueInvoice(ctx context.Context, in *IssueInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error)
+	VoidInvoice(ctx context.Context, in *VoidInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error)
+	MarkOverdue(ctx context.Context, in *MarkOverdueRequest, opts ...grpc.CallOption) (*Invoice, error)
+	// Issuing a SALES or PURCHASE invoice posts its journal with these
+	// accounts; voiding it reverses the journal.
+	SetInvoicePostingRule(ctx context.Context, in *SetInvoicePostingRuleRequest, opts ...grpc.CallOption) (*InvoicePostingRule, error)
+	GetInvoicePostingRule(ctx context.Context, in *GetInvoicePostingRuleRequest, opts ...grpc.CallOption) (*InvoicePostingRule, error)
+	// Invoices of a type with a number series are created without a number
+	// and take the next one of the series when issued.
+	SetInvoiceNumberSeries(ctx context.Context, in *SetInvoiceNumberSeriesRequest, opts ...grpc.CallOption) (*InvoiceNumberSeries, error)
+	GetInvoiceNumberSeries(ctx context.Context, in *GetInvoiceNumberSeriesRequest, opts ...grpc.CallOption) (*InvoiceNumberSeries, error)
+	// Customer-facing PDF of an invoice, laid out with the organization's
+	// template.
+	RenderInvoice(ctx context.Context, in *RenderInvoiceRequest, opts ...grpc.CallOption) (*RenderedInvoice, error)
+	SetInvoiceTemplate(ctx context.Context, in *SetInvoiceTemplateRequest, opts ...grpc.CallOption) (*InvoiceTemplate, error)
+	GetInvoiceTemplate(ctx context.Context, in *GetInvoiceTemplateRequest, opts ...grpc.CallOption) (*InvoiceTemplate, error)
+	// Structured e-invoices in UBL 2.1, PEPPOL BIS Billing 3.0. Documents are
+	// validated against the UBL 2.1 XSD and the PEPPOL BIS Schematron the
+	// server has installed. Documents that break a rule are rejected with a
+	// violation per field, under the rule's ID or "UBL" for the schema.
+	ExportInvoice(ctx context.Context, in *ExportInvoiceRequest, opts ...grpc.CallOption) (*RenderedInvoice, error)
+	ImportInvoice(ctx context.Context, in *ImportInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error)
 }
 
 type invoiceServiceClient struct {
@@ -108,6 +144,126 @@ func (c *invoiceServiceClient) DeleteInvoice(ctx context.Context, in *DeleteInvo
 	return out, nil
 }
 
+func (c *invoiceServiceClient) IssueInvoice(ctx context.Context, in *IssueInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invoice)
+	err := c.cc.Invoke(ctx, InvoiceService_IssueInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) VoidInvoice(ctx context.Context, in *VoidInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invoice)
+	err := c.cc.Invoke(ctx, InvoiceService_VoidInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) MarkOverdue(ctx context.Context, in *MarkOverdueRequest, opts ...grpc.CallOption) (*Invoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invoice)
+	err := c.cc.Invoke(ctx, InvoiceService_MarkOverdue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) SetInvoicePostingRule(ctx context.Context, in *SetInvoicePostingRuleRequest, opts ...grpc.CallOption) (*InvoicePostingRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoicePostingRule)
+	err := c.cc.Invoke(ctx, InvoiceService_SetInvoicePostingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetInvoicePostingRule(ctx context.Context, in *GetInvoicePostingRuleRequest, opts ...grpc.CallOption) (*InvoicePostingRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoicePostingRule)
+	err := c.cc.Invoke(ctx, InvoiceService_GetInvoicePostingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) SetInvoiceNumberSeries(ctx context.Context, in *SetInvoiceNumberSeriesRequest, opts ...grpc.CallOption) (*InvoiceNumberSeries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceNumberSeries)
+	err := c.cc.Invoke(ctx, InvoiceService_SetInvoiceNumberSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetInvoiceNumberSeries(ctx context.Context, in *GetInvoiceNumberSeriesRequest, opts ...grpc.CallOption) (*InvoiceNumberSeries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceNumberSeries)
+	err := c.cc.Invoke(ctx, InvoiceService_GetInvoiceNumberSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) RenderInvoice(ctx context.Context, in *RenderInvoiceRequest, opts ...grpc.CallOption) (*RenderedInvoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderedInvoice)
+	err := c.cc.Invoke(ctx, InvoiceService_RenderInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) SetInvoiceTemplate(ctx context.Context, in *SetInvoiceTemplateRequest, opts ...grpc.CallOption) (*InvoiceTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceTemplate)
+	err := c.cc.Invoke(ctx, InvoiceService_SetInvoiceTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetInvoiceTemplate(ctx context.Context, in *GetInvoiceTemplateRequest, opts ...grpc.CallOption) (*InvoiceTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceTemplate)
+	err := c.cc.Invoke(ctx, InvoiceService_GetInvoiceTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ExportInvoice(ctx context.Context, in *ExportInvoiceRequest, opts ...grpc.CallOption) (*RenderedInvoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderedInvoice)
+	err := c.cc.Invoke(ctx, InvoiceService_ExportInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ImportInvoice(ctx context.Context, in *ImportInvoiceRequest, opts ...grpc.CallOption) (*Invoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invoice)
+	err := c.cc.Invoke(ctx, InvoiceService_ImportInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InvoiceServiceServer is the server API for InvoiceService service.
 // All implementations must embed UnimplementedInvoiceServiceServer
 // for forward compatibility.
@@ -118,6 +274,30 @@ type InvoiceServiceServer interface {
 	SearchInvoices(context.Context, *SearchInvoicesRequest) (*ListInvoicesResponse, error)
 	UpdateInvoice(context.Context, *UpdateInvoiceRequest) (*Invoice, error)
 	DeleteInvoice(context.Context, *DeleteInvoiceRequest) (*emptypb.Empty, error)
+	// Status transitions. Only DRAFT invoices can be changed; an ISSUED
+	// invoice is corrected through credit/debit notes.
+	IssueInvoice(context.Context, *IssueInvoiceRequest) (*Invoice, error)
+	VoidInvoice(context.Context, *VoidInvoiceRequest) (*Invoice, error)
+	MarkOverdue(context.Context, *MarkOverdueRequest) (*Invoice, error)
+	// Issuing a SALES or PURCHASE invoice posts its journal with these
+	// accounts; voiding it reverses the journal.
+	SetInvoicePostingRule(context.Context, *SetInvoicePostingRuleRequest) (*InvoicePostingRule, error)
+	GetInvoicePostingRule(context.Context, *GetInvoicePostingRuleRequest) (*InvoicePostingRule, error)
+	// Invoices of a type with a number series are created without a number
+	// and take the next one of the series when issued.
+	SetInvoiceNumberSeries(context.Context, *SetInvoiceNumberSeriesRequest) (*InvoiceNumberSeries, error)
+	GetInvoiceNumberSeries(context.Context, *GetInvoiceNumberSeriesRequest) (*InvoiceNumberSeries, error)
+	// Customer-facing PDF of an invoice, laid out with the organization's
+	// template.
+	RenderInvoice(context.Context, *RenderInvoiceRequest) (*RenderedInvoice, error)
+	SetInvoiceTemplate(context.Context, *SetInvoiceTemplateRequest) (*InvoiceTemplate, error)
+	GetInvoiceTemplate(context.Context, *GetInvoiceTemplateRequest) (*InvoiceTemplate, error)
+	// Structured e-invoices in UBL 2.1, PEPPOL BIS Billing 3.0. Documents are
+	// validated against the UBL 2.1 XSD and the PEPPOL BIS Schematron the
+	// server has installed. Documents that break a rule are rejected with a
+	// violation per field, under the rule's ID or "UBL" for the schema.
+	ExportInvoice(context.Context, *ExportInvoiceRequest) (*RenderedInvoice, error)
+	ImportInvoice(context.Context, *ImportInvoiceRequest) (*Invoice, error)
 	mustEmbedUnimplementedInvoiceServiceServer()
 }
 
@@ -146,6 +326,42 @@ func (UnimplementedInvoiceServiceServer) UpdateInvoice(context.Context, *UpdateI
 func (UnimplementedInvoiceServiceServer) DeleteInvoice(context.Context, *DeleteInvoiceRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInvoice not implemented")
 }
+func (UnimplementedInvoiceServiceServer) IssueInvoice(context.Context, *IssueInvoiceRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) VoidInvoice(context.Context, *VoidInvoiceRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) MarkOverdue(context.Context, *MarkOverdueRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkOverdue not implemented")
+}
+func (UnimplementedInvoiceServiceServer) SetInvoicePostingRule(context.Context, *SetInvoicePostingRuleRequest) (*InvoicePostingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInvoicePostingRule not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetInvoicePostingRule(context.Context, *GetInvoicePostingRuleRequest) (*InvoicePostingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoicePostingRule not implemented")
+}
+func (UnimplementedInvoiceServiceServer) SetInvoiceNumberSeries(context.Context, *SetInvoiceNumberSeriesRequest) (*InvoiceNumberSeries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInvoiceNumberSeries not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetInvoiceNumberSeries(context.Context, *GetInvoiceNumberSeriesRequest) (*InvoiceNumberSeries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoiceNumberSeries not implemented")
+}
+func (UnimplementedInvoiceServiceServer) RenderInvoice(context.Context, *RenderInvoiceRequest) (*RenderedInvoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) SetInvoiceTemplate(context.Context, *SetInvoiceTemplateRequest) (*InvoiceTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInvoiceTemplate not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetInvoiceTemplate(context.Context, *GetInvoiceTemplateRequest) (*InvoiceTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoiceTemplate not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ExportInvoice(context.Context, *ExportInvoiceRequest) (*RenderedInvoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ImportInvoice(context.Context, *ImportInvoiceRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportInvoice not implemented")
+}
 func (UnimplementedInvoiceServiceServer) mustEmbedUnimplementedInvoiceServiceServer() {}
 func (UnimplementedInvoiceServiceServer) testEmbeddedByValue()                        {}
 
@@ -275,6 +491,222 @@ func _InvoiceService_DeleteInvoice_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InvoiceService_IssueInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).IssueInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_IssueInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).IssueInvoice(ctx, req.(*IssueInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_VoidInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).VoidInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_VoidInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).VoidInvoice(ctx, req.(*VoidInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_MarkOverdue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkOverdueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).MarkOverdue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_MarkOverdue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).MarkOverdue(ctx, req.(*MarkOverdueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_SetInvoicePostingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInvoicePostingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).SetInvoicePostingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_SetInvoicePostingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).SetInvoicePostingRule(ctx, req.(*SetInvoicePostingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetInvoicePostingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoicePostingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetInvoicePostingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetInvoicePostingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetInvoicePostingRule(ctx, req.(*GetInvoicePostingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_SetInvoiceNumberSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInvoiceNumberSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).SetInvoiceNumberSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_SetInvoiceNumberSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).SetInvoiceNumberSeries(ctx, req.(*SetInvoiceNumberSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetInvoiceNumberSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceNumberSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetInvoiceNumberSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetInvoiceNumberSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetInvoiceNumberSeries(ctx, req.(*GetInvoiceNumberSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_RenderInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).RenderInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_RenderInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).RenderInvoice(ctx, req.(*RenderInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_SetInvoiceTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInvoiceTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).SetInvoiceTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_SetInvoiceTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).SetInvoiceTemplate(ctx, req.(*SetInvoiceTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetInvoiceTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetInvoiceTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetInvoiceTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetInvoiceTemplate(ctx, req.(*GetInvoiceTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ExportInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ExportInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ExportInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ExportInvoice(ctx, req.(*ExportInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ImportInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ImportInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ImportInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ImportInvoice(ctx, req.(*ImportInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InvoiceService_ServiceDesc is the grpc.ServiceDesc for InvoiceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -306,260 +738,311 @@ var InvoiceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteInvoice",
 			Handler:    _InvoiceService_DeleteInvoice_Handler,
 		},
+		{
+			MethodName: "IssueInvoice",
+			Handler:    _InvoiceService_IssueInvoice_Handler,
+		},
+		{
+			MethodName: "VoidInvoice",
+			Handler:    _InvoiceService_VoidInvoice_Handler,
+		},
+		{
+			MethodName: "MarkOverdue",
+			Handler:    _InvoiceService_MarkOverdue_Handler,
+		},
+		{
+			MethodName: "SetInvoicePostingRule",
+			Handler:    _InvoiceService_SetInvoicePostingRule_Handler,
+		},
+		{
+			MethodName: "GetInvoicePostingRule",
+			Handler:    _InvoiceService_GetInvoicePostingRule_Handler,
+		},
+		{
+			MethodName: "SetInvoiceNumberSeries",
+			Handler:    _InvoiceService_SetInvoiceNumberSeries_Handler,
+		},
+		{
+			MethodName: "GetInvoiceNumberSeries",
+			Handler:    _InvoiceService_GetInvoiceNumberSeries_Handler,
+		},
+		{
+			MethodName: "RenderInvoice",
+			Handler:    _InvoiceService_RenderInvoice_Handler,
+		},
+		{
+			MethodName: "SetInvoiceTemplate",
+			Handler:    _InvoiceService_SetInvoiceTemplate_Handler,
+		},
+		{
+			MethodName: "GetInvoiceTemplate",
+			Handler:    _InvoiceService_GetInvoiceTemplate_Handler,
+		},
+		{
+			MethodName: "ExportInvoice",
+			Handler:    _InvoiceService_ExportInvoice_Handler,
+		},
+		{
+			MethodName: "ImportInvoice",
+			Handler:    _InvoiceService_ImportInvoice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finance.proto",
 }
 
 const (
-	CreditDebitNoteService_CreateCreditDebitNote_FullMethodName = "/finance.CreditDebitNoteService/CreateCreditDebitNote"
-	CreditDebitNoteService_GetCreditDebitNote_FullMethodName    = "/finance.CreditDebitNoteService/GetCreditDebitNote"
-	CreditDebitNoteService_ListCreditDebitNotes_FullMethodName  = "/finance.CreditDebitNoteService/ListCreditDebitNotes"
-	CreditDebitNoteService_UpdateCreditDebitNote_FullMethodName = "/finance.CreditDebitNoteService/UpdateCreditDebitNote"
-	CreditDebitNoteService_DeleteCreditDebitNote_FullMethodName = "/finance.CreditDebitNoteService/DeleteCreditDebitNote"
+	PartyService_CreateParty_FullMethodName = "/finance.PartyService/CreateParty"
+	PartyService_GetParty_FullMethodName    = "/finance.PartyService/GetParty"
+	PartyService_UpdateParty_FullMethodName = "/finance.PartyService/UpdateParty"
+	PartyService_DeleteParty_FullMethodName = "/finance.PartyService/DeleteParty"
+	PartyService_ListParties_FullMethodName = "/finance.PartyService/ListParties"
 )
 
-// CreditDebitNoteServiceClient is the client API for CreditDebitNoteService service.
+// PartyServiceClient is the client API for PartyService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type CreditDebitNoteServiceClient interface {
-	CreateCreditDebitNote(ctx context.Context, in *CreateCreditDebitNoteRequest, opts ...grpc.CallOption) (*CreditDebitNote, error)
-	GetCreditDebitNote(ctx context.Context, in *GetCreditDebitNoteRequest, opts ...grpc.CallOption) (*CreditDebitNote, error)
-	ListCreditDebitNotes(ctx context.Context, in *ListCreditDebitNotesRequest, opts ...grpc.CallOption) (*ListCreditDebitNotesResponse, error)
-	UpdateCreditDebitNote(ctx context.Context, in *UpdateCreditDebitNoteRequest, opts ...grpc.CallOption) (*CreditDebitNote, error)
-	DeleteCreditDebitNote(ctx context.Context, in *DeleteCreditDebitNoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+type PartyServiceClient interface {
+	CreateParty(ctx context.Context, in *CreatePartyRequest, opts ...grpc.CallOption) (*Party, error)
+	GetParty(ctx context.Context, in *GetPartyRequest, opts ...grpc.CallOption) (*Party, error)
+	UpdateParty(ctx context.Context, in *UpdatePartyRequest, opts ...grpc.CallOption) (*Party, error)
+	// A party that invoices or vendor bills refer to cannot be deleted;
+	// deactivate it instead.
+	DeleteParty(ctx context.Context, in *DeletePartyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListParties(ctx context.Context, in *ListPartiesRequest, opts ...grpc.CallOption) (*ListPartiesResponse, error)
 }
 
-type creditDebitNoteServiceClient struct {
+type partyServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewCreditDebitNoteServiceClient(cc grpc.ClientConnInterface) CreditDebitNoteServiceClient {
-	return &creditDebitNoteServiceClient{cc}
+func NewPartyServiceClient(cc grpc.ClientConnInterface) PartyServiceClient {
+	return &partyServiceClient{cc}
 }
 
-func (c *creditDebitNoteServiceClient) CreateCreditDebitNote(ctx context.Context, in *CreateCreditDebitNoteRequest, opts ...grpc.CallOption) (*CreditDebitNote, error) {
+func (c *partyServiceClient) CreateParty(ctx context.Context, in *CreatePartyRequest, opts ...grpc.CallOption) (*Party, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreditDebitNote)
-	err := c.cc.Invoke(ctx, CreditDebitNoteService_CreateCreditDebitNote_FullMethodName, in, out, cOpts...)
+	out := new(Party)
+	err := c.cc.Invoke(ctx, PartyService_CreateParty_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *creditDebitNoteServiceClient) GetCreditDebitNote(ctx context.Context, in *GetCreditDebitNoteRequest, opts ...grpc.CallOption) (*CreditDebitNote, error) {
+func (c *partyServiceClient) GetParty(ctx context.Context, in *GetPartyRequest, opts ...grpc.CallOption) (*Party, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreditDebitNote)
-	err := c.cc.Invoke(ctx, CreditDebitNoteService_GetCreditDebitNote_FullMethodName, in, out, cOpts...)
+	out := new(Party)
+	err := c.cc.Invoke(ctx, PartyService_GetParty_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *creditDebitNoteServiceClient) ListCreditDebitNotes(ctx context.Context, in *ListCreditDebitNotesRequest, opts ...grpc.CallOption) (*ListCreditDebitNotesResponse, error) {
+func (c *partyServiceClient) UpdateParty(ctx context.Context, in *UpdatePartyRequest, opts ...grpc.CallOption) (*Party, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListCreditDebitNotesResponse)
-	err := c.cc.Invoke(ctx, CreditDebitNoteService_ListCreditDebitNotes_FullMethodName, in, out, cOpts...)
+	out := new(Party)
+	err := c.cc.Invoke(ctx, PartyService_UpdateParty_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *creditDebitNoteServiceClient) UpdateCreditDebitNote(ctx context.Context, in *UpdateCreditDebitNoteRequest, opts ...grpc.CallOption) (*CreditDebitNote, error) {
+func (c *partyServiceClient) DeleteParty(ctx context.Context, in *DeletePartyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreditDebitNote)
-	err := c.cc.Invoke(ctx, CreditDebitNoteService_UpdateCreditDebitNote_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PartyService_DeleteParty_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *creditDebitNoteServiceClient) DeleteCreditDebitNote(ctx context.Context, in *DeleteCreditDebitNoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *partyServiceClient) ListParties(ctx context.Context, in *ListPartiesRequest, opts ...grpc.CallOption) (*ListPartiesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, CreditDebitNoteService_DeleteCreditDebitNote_FullMethodName, in, out, cOpts...)
+	out := new(ListPartiesResponse)
+	err := c.cc.Invoke(ctx, PartyService_ListParties_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// CreditDebitNoteServiceServer is the server API for CreditDebitNoteService service.
-// All implementations must embed UnimplementedCreditDebitNoteServiceServer
+// PartyServiceServer is the server API for PartyService service.
+// All implementations must embed UnimplementedPartyServiceServer
 // for forward compatibility.
-type CreditDebitNoteServiceServer interface {
-	CreateCreditDebitNote(context.Context, *CreateCreditDebitNoteRequest) (*CreditDebitNote, error)
-	GetCreditDebitNote(context.Context, *GetCreditDebitNoteRequest) (*CreditDebitNote, error)
-	ListCreditDebitNotes(context.Context, *ListCreditDebitNotesRequest) (*ListCreditDebitNotesResponse, error)
-	UpdateCreditDebitNote(context.Context, *UpdateCreditDebitNoteRequest) (*CreditDebitNote, error)
-	DeleteCreditDebitNote(context.Context, *DeleteCreditDebitNoteRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedCreditDebitNoteServiceServer()
-}
-
-// UnimplementedCreditDebitNoteServiceServer must be embedded to have
+type PartyServiceServer interface {
+	CreateParty(context.Context, *CreatePartyRequest) (*Party, error)
+	GetParty(context.Context, *GetPartyRequest) (*Party, error)
+	UpdateParty(context.Context, *UpdatePartyRequest) (*Party, error)
+	// A party that invoices or vendor bills refer to cannot be deleted;
+	// deactivate it instead.
+	DeleteParty(context.Context, *DeletePartyRequest) (*emptypb.Empty, error)
+	ListParties(context.Context, *ListPartiesRequest) (*ListPartiesResponse, error)
+	mustEmbedUnimplementedPartyServiceServer()
+}
+
+// UnimplementedPartyServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedCreditDebitNoteServiceServer struct{}
+type UnimplementedPartyServiceServer struct{}
 
-func (UnimplementedCreditDebitNoteServiceServer) CreateCreditDebitNote(context.Context, *CreateCreditDebitNoteRequest) (*CreditDebitNote, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCreditDebitNote not implemented")
-}
-func (UnimplementedCreditDebitNoteServiceServer) GetCreditDebitNote(context.Context, *GetCreditDebitNoteRequest) (*CreditDebitNote, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCreditDebitNote not implemented")
+func (UnimplementedPartyServiceServer) CreateParty(context.Context, *CreatePartyRequest) (*Party, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateParty not implemented")
 }
-func (UnimplementedCreditDebitNoteServiceServer) ListCreditDebitNotes(context.Context, *ListCreditDebitNotesRequest) (*ListCreditDebitNotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCreditDebitNotes not implemented")
+func (UnimplementedPartyServiceServer) GetParty(context.Context, *GetPartyRequest) (*Party, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParty not implemented")
 }
-func (UnimplementedCreditDebitNoteServiceServer) UpdateCreditDebitNote(context.Context, *UpdateCreditDebitNoteRequest) (*CreditDebitNote, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCreditDebitNote not implemented")
+func (UnimplementedPartyServiceServer) UpdateParty(context.Context, *UpdatePartyRequest) (*Party, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParty not implemented")
 }
-func (UnimplementedCreditDebitNoteServiceServer) DeleteCreditDebitNote(context.Context, *DeleteCreditDebitNoteRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCreditDebitNote not implemented")
+func (UnimplementedPartyServiceServer) DeleteParty(context.Context, *DeletePartyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteParty not implemented")
 }
-func (UnimplementedCreditDebitNoteServiceServer) mustEmbedUnimplementedCreditDebitNoteServiceServer() {
+func (UnimplementedPartyServiceServer) ListParties(context.Context, *ListPartiesRequest) (*ListPartiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListParties not implemented")
 }
-func (UnimplementedCreditDebitNoteServiceServer) testEmbeddedByValue() {}
+func (UnimplementedPartyServiceServer) mustEmbedUnimplementedPartyServiceServer() {}
+func (UnimplementedPartyServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeCreditDebitNoteServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CreditDebitNoteServiceServer will
+// UnsafePartyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PartyServiceServer will
 // result in compilation errors.
-type UnsafeCreditDebitNoteServiceServer interface {
-	mustEmbedUnimplementedCreditDebitNoteServiceServer()
+type UnsafePartyServiceServer interface {
+	mustEmbedUnimplementedPartyServiceServer()
 }
 
-func RegisterCreditDebitNoteServiceServer(s grpc.ServiceRegistrar, srv CreditDebitNoteServiceServer) {
-	// If the following call pancis, it indicates UnimplementedCreditDebitNoteServiceServer was
+func RegisterPartyServiceServer(s grpc.ServiceRegistrar, srv PartyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPartyServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&CreditDebitNoteService_ServiceDesc, srv)
+	s.RegisterService(&PartyService_ServiceDesc, srv)
 }
 
-func _CreditDebitNoteService_CreateCreditDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCreditDebitNoteRequest)
+func _PartyService_CreateParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CreditDebitNoteServiceServer).CreateCreditDebitNote(ctx, in)
+		return srv.(PartyServiceServer).CreateParty(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CreditDebitNoteService_CreateCreditDebitNote_FullMethodName,
+		FullMethod: PartyService_CreateParty_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CreditDebitNoteServiceServer).CreateCreditDebitNote(ctx, req.(*CreateCreditDebitNoteRequest))
+		return srv.(PartyServiceServer).CreateParty(ctx, req.(*CreatePartyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CreditDebitNoteService_GetCreditDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCreditDebitNoteRequest)
+func _PartyService_GetParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CreditDebitNoteServiceServer).GetCreditDebitNote(ctx, in)
+		return srv.(PartyServiceServer).GetParty(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CreditDebitNoteService_GetCreditDebitNote_FullMethodName,
+		FullMethod: PartyService_GetParty_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CreditDebitNoteServiceServer).GetCreditDebitNote(ctx, req.(*GetCreditDebitNoteRequest))
+		return srv.(PartyServiceServer).GetParty(ctx, req.(*GetPartyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CreditDebitNoteService_ListCreditDebitNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCreditDebitNotesRequest)
+func _PartyService_UpdateParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePartyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CreditDebitNoteServiceServer).ListCreditDebitNotes(ctx, in)
+		return srv.(PartyServiceServer).UpdateParty(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CreditDebitNoteService_ListCreditDebitNotes_FullMethodName,
+		FullMethod: PartyService_UpdateParty_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CreditDebitNoteServiceServer).ListCreditDebitNotes(ctx, req.(*ListCreditDebitNotesRequest))
+		return srv.(PartyServiceServer).UpdateParty(ctx, req.(*UpdatePartyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CreditDebitNoteService_UpdateCreditDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCreditDebitNoteRequest)
+func _PartyService_DeleteParty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePartyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CreditDebitNoteServiceServer).UpdateCreditDebitNote(ctx, in)
+		return srv.(PartyServiceServer).DeleteParty(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CreditDebitNoteService_UpdateCreditDebitNote_FullMethodName,
+		FullMethod: PartyService_DeleteParty_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CreditDebitNoteServiceServer).UpdateCreditDebitNote(ctx, req.(*UpdateCreditDebitNoteRequest))
+		return srv.(PartyServiceServer).DeleteParty(ctx, req.(*DeletePartyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CreditDebitNoteService_DeleteCreditDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCreditDebitNoteRequest)
+func _PartyService_ListParties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPartiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CreditDebitNoteServiceServer).DeleteCreditDebitNote(ctx, in)
+		return srv.(PartyServiceServer).ListParties(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CreditDebitNoteService_DeleteCreditDebitNote_FullMethodName,
+		FullMethod: PartyService_ListParties_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CreditDebitNoteServiceServer).DeleteCreditDebitNote(ctx, req.(*DeleteCreditDebitNoteRequest))
+		return srv.(PartyServiceServer).ListParties(ctx, req.(*ListPartiesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// CreditDebitNoteService_ServiceDesc is the grpc.ServiceDesc for CreditDebitNoteService service.
+// PartyService_ServiceDesc is the grpc.ServiceDesc for PartyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var CreditDebitNoteService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "finance.CreditDebitNoteService",
-	HandlerType: (*CreditDebitNoteServiceServer)(nil),
+var PartyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.PartyService",
+	HandlerType: (*PartyServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateCreditDebitNote",
-			Handler:    _CreditDebitNoteService_CreateCreditDebitNote_Handler,
+			MethodName: "CreateParty",
+			Handler:    _PartyService_CreateParty_Handler,
 		},
 		{
-			MethodName: "GetCreditDebitNote",
-			Handler:    _CreditDebitNoteService_GetCreditDebitNote_Handler,
+			MethodName: "GetParty",
+			Handler:    _PartyService_GetParty_Handler,
 		},
 		{
-			MethodName: "ListCreditDebitNotes",
-			Handler:    _CreditDebitNoteService_ListCreditDebitNotes_Handler,
+			MethodName: "UpdateParty",
+			Handler:    _PartyService_UpdateParty_Handler,
 		},
 		{
-			MethodName: "UpdateCreditDebitNote",
-			Handler:    _CreditDebitNoteService_UpdateCreditDebitNote_Handler,
+			MethodName: "DeleteParty",
+			Handler:    _PartyService_DeleteParty_Handler,
 		},
 		{
-			MethodName: "DeleteCreditDebitNote",
-			Handler:    _CreditDebitNoteService_DeleteCreditDebitNote_Handler,
+			MethodName: "ListParties",
+			Handler:    _PartyService_ListParties_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -567,559 +1050,816 @@ var CreditDebitNoteService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	PaymentService_CreatePaymentDue_FullMethodName       = "/finance.PaymentService/CreatePaymentDue"
-	PaymentService_GetPaymentDue_FullMethodName          = "/finance.PaymentService/GetPaymentDue"
-	PaymentService_UpdatePaymentDue_FullMethodName       = "/finance.PaymentService/UpdatePaymentDue"
-	PaymentService_DeletePaymentDue_FullMethodName       = "/finance.PaymentService/DeletePaymentDue"
-	PaymentService_MarkPaymentAsPaid_FullMethodName      = "/finance.PaymentService/MarkPaymentAsPaid"
-	PaymentService_ListPaymentDues_FullMethodName        = "/finance.PaymentService/ListPaymentDues"
-	PaymentService_CreateBankAccount_FullMethodName      = "/finance.PaymentService/CreateBankAccount"
-	PaymentService_GetBankAccount_FullMethodName         = "/finance.PaymentService/GetBankAccount"
-	PaymentService_UpdateBankAccount_FullMethodName      = "/finance.PaymentService/UpdateBankAccount"
-	PaymentService_DeleteBankAccount_FullMethodName      = "/finance.PaymentService/DeleteBankAccount"
-	PaymentService_ListBankAccounts_FullMethodName       = "/finance.PaymentService/ListBankAccounts"
-	PaymentService_ImportBankTransactions_FullMethodName = "/finance.PaymentService/ImportBankTransactions"
-	PaymentService_ListBankTransactions_FullMethodName   = "/finance.PaymentService/ListBankTransactions"
+	CreditControlService_GetCreditPolicy_FullMethodName      = "/finance.CreditControlService/GetCreditPolicy"
+	CreditControlService_SetCreditPolicy_FullMethodName      = "/finance.CreditControlService/SetCreditPolicy"
+	CreditControlService_GetCreditExposure_FullMethodName    = "/finance.CreditControlService/GetCreditExposure"
+	CreditControlService_ApproveInvoiceCredit_FullMethodName = "/finance.CreditControlService/ApproveInvoiceCredit"
 )
 
-// PaymentServiceClient is the client API for PaymentService service.
+// CreditControlServiceClient is the client API for CreditControlService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type PaymentServiceClient interface {
-	CreatePaymentDue(ctx context.Context, in *CreatePaymentDueRequest, opts ...grpc.CallOption) (*PaymentDue, error)
-	GetPaymentDue(ctx context.Context, in *GetPaymentDueRequest, opts ...grpc.CallOption) (*PaymentDue, error)
-	UpdatePaymentDue(ctx context.Context, in *UpdatePaymentDueRequest, opts ...grpc.CallOption) (*PaymentDue, error)
-	DeletePaymentDue(ctx context.Context, in *DeletePaymentDueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	MarkPaymentAsPaid(ctx context.Context, in *MarkPaymentAsPaidRequest, opts ...grpc.CallOption) (*PaymentDue, error)
-	ListPaymentDues(ctx context.Context, in *ListPaymentDuesRequest, opts ...grpc.CallOption) (*ListPaymentDuesResponse, error)
-	// Bank accounts & statements
-	CreateBankAccount(ctx context.Context, in *CreateBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error)
-	GetBankAccount(ctx context.Context, in *GetBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error)
-	UpdateBankAccount(ctx context.Context, in *UpdateBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error)
-	DeleteBankAccount(ctx context.Context, in *DeleteBankAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	ListBankAccounts(ctx context.Context, in *ListBankAccountsRequest, opts ...grpc.CallOption) (*ListBankAccountsResponse, error)
-	ImportBankTransactions(ctx context.Context, in *ImportBankTransactionsRequest, opts ...grpc.CallOption) (*ImportBankTransactionsResponse, error)
-	ListBankTransactions(ctx context.Context, in *ListBankTransactionsRequest, opts ...grpc.CallOption) (*ListBankTransactionsResponse, error)
+type CreditControlServiceClient interface {
+	GetCreditPolicy(ctx context.Context, in *GetCreditPolicyRequest, opts ...grpc.CallOption) (*CreditPolicy, error)
+	SetCreditPolicy(ctx context.Context, in *SetCreditPolicyRequest, opts ...grpc.CallOption) (*CreditPolicy, error)
+	GetCreditExposure(ctx context.Context, in *GetCreditExposureRequest, opts ...grpc.CallOption) (*CreditExposure, error)
+	// Lets a DRAFT invoice be issued past its customer's threshold under an
+	// APPROVAL policy.
+	ApproveInvoiceCredit(ctx context.Context, in *ApproveInvoiceCreditRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
-type paymentServiceClient struct {
+type creditControlServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewPaymentServiceClient(cc grpc.ClientConnInterface) PaymentServiceClient {
-	return &paymentServiceClient{cc}
+func NewCreditControlServiceClient(cc grpc.ClientConnInterface) CreditControlServiceClient {
+	return &creditControlServiceClient{cc}
 }
 
-func (c *paymentServiceClient) CreatePaymentDue(ctx context.Context, in *CreatePaymentDueRequest, opts ...grpc.CallOption) (*PaymentDue, error) {
+func (c *creditControlServiceClient) GetCreditPolicy(ctx context.Context, in *GetCreditPolicyRequest, opts ...grpc.CallOption) (*CreditPolicy, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(PaymentDue)
-	err := c.cc.Invoke(ctx, PaymentService_CreatePaymentDue_FullMethodName, in, out, cOpts...)
+	out := new(CreditPolicy)
+	err := c.cc.Invoke(ctx, CreditControlService_GetCreditPolicy_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *paymentServiceClient) GetPaymentDue(ctx context.Context, in *GetPaymentDueRequest, opts ...grpc.CallOption) (*PaymentDue, error) {
+func (c *creditControlServiceClient) SetCreditPolicy(ctx context.Context, in *SetCreditPolicyRequest, opts ...grpc.CallOption) (*CreditPolicy, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(PaymentDue)
-	err := c.cc.Invoke(ctx, PaymentService_GetPaymentDue_FullMethodName, in, out, cOpts...)
+	out := new(CreditPolicy)
+	err := c.cc.Invoke(ctx, CreditControlService_SetCreditPolicy_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *paymentServiceClient) UpdatePaymentDue(ctx context.Context, in *UpdatePaymentDueRequest, opts ...grpc.CallOption) (*PaymentDue, error) {
+func (c *creditControlServiceClient) GetCreditExposure(ctx context.Context, in *GetCreditExposureRequest, opts ...grpc.CallOption) (*CreditExposure, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(PaymentDue)
-	err := c.cc.Invoke(ctx, PaymentService_UpdatePaymentDue_FullMethodName, in, out, cOpts...)
+	out := new(CreditExposure)
+	err := c.cc.Invoke(ctx, CreditControlService_GetCreditExposure_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *paymentServiceClient) DeletePaymentDue(ctx context.Context, in *DeletePaymentDueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *creditControlServiceClient) ApproveInvoiceCredit(ctx context.Context, in *ApproveInvoiceCreditRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, PaymentService_DeletePaymentDue_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, CreditControlService_ApproveInvoiceCredit_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *paymentServiceClient) MarkPaymentAsPaid(ctx context.Context, in *MarkPaymentAsPaidRequest, opts ...grpc.CallOption) (*PaymentDue, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(PaymentDue)
-	err := c.cc.Invoke(ctx, PaymentService_MarkPaymentAsPaid_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// CreditControlServiceServer is the server API for CreditControlService service.
+// All implementations must embed UnimplementedCreditControlServiceServer
+// for forward compatibility.
+type CreditControlServiceServer interface {
+	GetCreditPolicy(context.Context, *GetCreditPolicyRequest) (*CreditPolicy, error)
+	SetCreditPolicy(context.Context, *SetCreditPolicyRequest) (*CreditPolicy, error)
+	GetCreditExposure(context.Context, *GetCreditExposureRequest) (*CreditExposure, error)
+	// Lets a DRAFT invoice be issued past its customer's threshold under an
+	// APPROVAL policy.
+	ApproveInvoiceCredit(context.Context, *ApproveInvoiceCreditRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCreditControlServiceServer()
 }
 
-func (c *paymentServiceClient) ListPaymentDues(ctx context.Context, in *ListPaymentDuesRequest, opts ...grpc.CallOption) (*ListPaymentDuesResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListPaymentDuesResponse)
-	err := c.cc.Invoke(ctx, PaymentService_ListPaymentDues_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// UnimplementedCreditControlServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCreditControlServiceServer struct{}
+
+func (UnimplementedCreditControlServiceServer) GetCreditPolicy(context.Context, *GetCreditPolicyRequest) (*CreditPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditPolicy not implemented")
 }
+func (UnimplementedCreditControlServiceServer) SetCreditPolicy(context.Context, *SetCreditPolicyRequest) (*CreditPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCreditPolicy not implemented")
+}
+func (UnimplementedCreditControlServiceServer) GetCreditExposure(context.Context, *GetCreditExposureRequest) (*CreditExposure, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditExposure not implemented")
+}
+func (UnimplementedCreditControlServiceServer) ApproveInvoiceCredit(context.Context, *ApproveInvoiceCreditRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveInvoiceCredit not implemented")
+}
+func (UnimplementedCreditControlServiceServer) mustEmbedUnimplementedCreditControlServiceServer() {}
+func (UnimplementedCreditControlServiceServer) testEmbeddedByValue()                              {}
 
-func (c *paymentServiceClient) CreateBankAccount(ctx context.Context, in *CreateBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BankAccount)
-	err := c.cc.Invoke(ctx, PaymentService_CreateBankAccount_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// UnsafeCreditControlServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CreditControlServiceServer will
+// result in compilation errors.
+type UnsafeCreditControlServiceServer interface {
+	mustEmbedUnimplementedCreditControlServiceServer()
 }
 
-func (c *paymentServiceClient) GetBankAccount(ctx context.Context, in *GetBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BankAccount)
-	err := c.cc.Invoke(ctx, PaymentService_GetBankAccount_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
+func RegisterCreditControlServiceServer(s grpc.ServiceRegistrar, srv CreditControlServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCreditControlServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
 	}
-	return out, nil
+	s.RegisterService(&CreditControlService_ServiceDesc, srv)
 }
 
-func (c *paymentServiceClient) UpdateBankAccount(ctx context.Context, in *UpdateBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BankAccount)
-	err := c.cc.Invoke(ctx, PaymentService_UpdateBankAccount_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CreditControlService_GetCreditPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditPolicyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CreditControlServiceServer).GetCreditPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditControlService_GetCreditPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditControlServiceServer).GetCreditPolicy(ctx, req.(*GetCreditPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *paymentServiceClient) DeleteBankAccount(ctx context.Context, in *DeleteBankAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, PaymentService_DeleteBankAccount_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CreditControlService_SetCreditPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCreditPolicyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CreditControlServiceServer).SetCreditPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditControlService_SetCreditPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditControlServiceServer).SetCreditPolicy(ctx, req.(*SetCreditPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *paymentServiceClient) ListBankAccounts(ctx context.Context, in *ListBankAccountsRequest, opts ...grpc.CallOption) (*ListBankAccountsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListBankAccountsResponse)
-	err := c.cc.Invoke(ctx, PaymentService_ListBankAccounts_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CreditControlService_GetCreditExposure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditExposureRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *paymentServiceClient) ImportBankTransactions(ctx context.Context, in *ImportBankTransactionsRequest, opts ...grpc.CallOption) (*ImportBankTransactionsResponse, error) {
+	if interceptor == nil {
+		return srv.(CreditControlServiceServer).GetCreditExposure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditControlService_GetCreditExposure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditControlServiceServer).GetCreditExposure(ctx, req.(*GetCreditExposureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditControlService_ApproveInvoiceCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveInvoiceCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditControlServiceServer).ApproveInvoiceCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditControlService_ApproveInvoiceCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditControlServiceServer).ApproveInvoiceCredit(ctx, req.(*ApproveInvoiceCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CreditControlService_ServiceDesc is the grpc.ServiceDesc for CreditControlService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CreditControlService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.CreditControlService",
+	HandlerType: (*CreditControlServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCreditPolicy",
+			Handler:    _CreditControlService_GetCreditPolicy_Handler,
+		},
+		{
+			MethodName: "SetCreditPolicy",
+			Handler:    _CreditControlService_SetCreditPolicy_Handler,
+		},
+		{
+			MethodName: "GetCreditExposure",
+			Handler:    _CreditControlService_GetCreditExposure_Handler,
+		},
+		{
+			MethodName: "ApproveInvoiceCredit",
+			Handler:    _CreditControlService_ApproveInvoiceCredit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	DunningService_SetDunningSchedule_FullMethodName = "/finance.DunningService/SetDunningSchedule"
+	DunningService_GetDunningSchedule_FullMethodName = "/finance.DunningService/GetDunningSchedule"
+	DunningService_PlaceDunningHold_FullMethodName   = "/finance.DunningService/PlaceDunningHold"
+	DunningService_ReleaseDunningHold_FullMethodName = "/finance.DunningService/ReleaseDunningHold"
+	DunningService_ListDunningHolds_FullMethodName   = "/finance.DunningService/ListDunningHolds"
+	DunningService_RunDunning_FullMethodName         = "/finance.DunningService/RunDunning"
+)
+
+// DunningServiceClient is the client API for DunningService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DunningServiceClient interface {
+	// Each organization's reminder schedule. An empty schedule sends none.
+	SetDunningSchedule(ctx context.Context, in *SetDunningScheduleRequest, opts ...grpc.CallOption) (*DunningSchedule, error)
+	GetDunningSchedule(ctx context.Context, in *GetDunningScheduleRequest, opts ...grpc.CallOption) (*DunningSchedule, error)
+	PlaceDunningHold(ctx context.Context, in *PlaceDunningHoldRequest, opts ...grpc.CallOption) (*DunningHold, error)
+	ReleaseDunningHold(ctx context.Context, in *ReleaseDunningHoldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDunningHolds(ctx context.Context, in *ListDunningHoldsRequest, opts ...grpc.CallOption) (*ListDunningHoldsResponse, error)
+	// Runs the hourly dunning job now, for every organization: payment dues
+	// and invoices past due are marked OVERDUE, and each open invoice gets the
+	// latest reminder stage it has reached.
+	RunDunning(ctx context.Context, in *RunDunningRequest, opts ...grpc.CallOption) (*RunDunningResponse, error)
+}
+
+type dunningServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDunningServiceClient(cc grpc.ClientConnInterface) DunningServiceClient {
+	return &dunningServiceClient{cc}
+}
+
+func (c *dunningServiceClient) SetDunningSchedule(ctx context.Context, in *SetDunningScheduleRequest, opts ...grpc.CallOption) (*DunningSchedule, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ImportBankTransactionsResponse)
-	err := c.cc.Invoke(ctx, PaymentService_ImportBankTransactions_FullMethodName, in, out, cOpts...)
+	out := new(DunningSchedule)
+	err := c.cc.Invoke(ctx, DunningService_SetDunningSchedule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *paymentServiceClient) ListBankTransactions(ctx context.Context, in *ListBankTransactionsRequest, opts ...grpc.CallOption) (*ListBankTransactionsResponse, error) {
+func (c *dunningServiceClient) GetDunningSchedule(ctx context.Context, in *GetDunningScheduleRequest, opts ...grpc.CallOption) (*DunningSchedule, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListBankTransactionsResponse)
-	err := c.cc.Invoke(ctx, PaymentService_ListBankTransactions_FullMethodName, in, out, cOpts...)
+	out := new(DunningSchedule)
+	err := c.cc.Invoke(ctx, DunningService_GetDunningSchedule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// PaymentServiceServer is the server API for PaymentService service.
-// All implementations must embed UnimplementedPaymentServiceServer
-// for forward compatibility.
-type PaymentServiceServer interface {
-	CreatePaymentDue(context.Context, *CreatePaymentDueRequest) (*PaymentDue, error)
-	GetPaymentDue(context.Context, *GetPaymentDueRequest) (*PaymentDue, error)
-	UpdatePaymentDue(context.Context, *UpdatePaymentDueRequest) (*PaymentDue, error)
-	DeletePaymentDue(context.Context, *DeletePaymentDueRequest) (*emptypb.Empty, error)
-	MarkPaymentAsPaid(context.Context, *MarkPaymentAsPaidRequest) (*PaymentDue, error)
-	ListPaymentDues(context.Context, *ListPaymentDuesRequest) (*ListPaymentDuesResponse, error)
-	// Bank accounts & statements
-	CreateBankAccount(context.Context, *CreateBankAccountRequest) (*BankAccount, error)
-	GetBankAccount(context.Context, *GetBankAccountRequest) (*BankAccount, error)
-	UpdateBankAccount(context.Context, *UpdateBankAccountRequest) (*BankAccount, error)
-	DeleteBankAccount(context.Context, *DeleteBankAccountRequest) (*emptypb.Empty, error)
-	ListBankAccounts(context.Context, *ListBankAccountsRequest) (*ListBankAccountsResponse, error)
-	ImportBankTransactions(context.Context, *ImportBankTransactionsRequest) (*ImportBankTransactionsResponse, error)
-	ListBankTransactions(context.Context, *ListBankTransactionsRequest) (*ListBankTransactionsResponse, error)
-	mustEmbedUnimplementedPaymentServiceServer()
+func (c *dunningServiceClient) PlaceDunningHold(ctx context.Context, in *PlaceDunningHoldRequest, opts ...grpc.CallOption) (*DunningHold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DunningHold)
+	err := c.cc.Invoke(ctx, DunningService_PlaceDunningHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedPaymentServiceServer must be embedded to have
+func (c *dunningServiceClient) ReleaseDunningHold(ctx context.Context, in *ReleaseDunningHoldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DunningService_ReleaseDunningHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dunningServiceClient) ListDunningHolds(ctx context.Context, in *ListDunningHoldsRequest, opts ...grpc.CallOption) (*ListDunningHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDunningHoldsResponse)
+	err := c.cc.Invoke(ctx, DunningService_ListDunningHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dunningServiceClient) RunDunning(ctx context.Context, in *RunDunningRequest, opts ...grpc.CallOption) (*RunDunningResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunDunningResponse)
+	err := c.cc.Invoke(ctx, DunningService_RunDunning_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DunningServiceServer is the server API for DunningService service.
+// All implementations must embed UnimplementedDunningServiceServer
+// for forward compatibility.
+type DunningServiceServer interface {
+	// Each organization's reminder schedule. An empty schedule sends none.
+	SetDunningSchedule(context.Context, *SetDunningScheduleRequest) (*DunningSchedule, error)
+	GetDunningSchedule(context.Context, *GetDunningScheduleRequest) (*DunningSchedule, error)
+	PlaceDunningHold(context.Context, *PlaceDunningHoldRequest) (*DunningHold, error)
+	ReleaseDunningHold(context.Context, *ReleaseDunningHoldRequest) (*emptypb.Empty, error)
+	ListDunningHolds(context.Context, *ListDunningHoldsRequest) (*ListDunningHoldsResponse, error)
+	// Runs the hourly dunning job now, for every organization: payment dues
+	// and invoices past due are marked OVERDUE, and each open invoice gets the
+	// latest reminder stage it has reached.
+	RunDunning(context.Context, *RunDunningRequest) (*RunDunningResponse, error)
+	mustEmbedUnimplementedDunningServiceServer()
+}
+
+// UnimplementedDunningServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedPaymentServiceServer struct{}
+type UnimplementedDunningServiceServer struct{}
 
-func (UnimplementedPaymentServiceServer) CreatePaymentDue(context.Context, *CreatePaymentDueRequest) (*PaymentDue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentDue not implemented")
-}
-func (UnimplementedPaymentServiceServer) GetPaymentDue(context.Context, *GetPaymentDueRequest) (*PaymentDue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentDue not implemented")
-}
-func (UnimplementedPaymentServiceServer) UpdatePaymentDue(context.Context, *UpdatePaymentDueRequest) (*PaymentDue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePaymentDue not implemented")
-}
-func (UnimplementedPaymentServiceServer) DeletePaymentDue(context.Context, *DeletePaymentDueRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePaymentDue not implemented")
-}
-func (UnimplementedPaymentServiceServer) MarkPaymentAsPaid(context.Context, *MarkPaymentAsPaidRequest) (*PaymentDue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarkPaymentAsPaid not implemented")
-}
-func (UnimplementedPaymentServiceServer) ListPaymentDues(context.Context, *ListPaymentDuesRequest) (*ListPaymentDuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPaymentDues not implemented")
+func (UnimplementedDunningServiceServer) SetDunningSchedule(context.Context, *SetDunningScheduleRequest) (*DunningSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDunningSchedule not implemented")
 }
-func (UnimplementedPaymentServiceServer) CreateBankAccount(context.Context, *CreateBankAccountRequest) (*BankAccount, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBankAccount not implemented")
-}
-func (UnimplementedPaymentServiceServer) GetBankAccount(context.Context, *GetBankAccountRequest) (*BankAccount, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBankAccount not implemented")
-}
-func (UnimplementedPaymentServiceServer) UpdateBankAccount(context.Context, *UpdateBankAccountRequest) (*BankAccount, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBankAccount not implemented")
+func (UnimplementedDunningServiceServer) GetDunningSchedule(context.Context, *GetDunningScheduleRequest) (*DunningSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDunningSchedule not implemented")
 }
-func (UnimplementedPaymentServiceServer) DeleteBankAccount(context.Context, *DeleteBankAccountRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBankAccount not implemented")
+func (UnimplementedDunningServiceServer) PlaceDunningHold(context.Context, *PlaceDunningHoldRequest) (*DunningHold, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceDunningHold not implemented")
 }
-func (UnimplementedPaymentServiceServer) ListBankAccounts(context.Context, *ListBankAccountsRequest) (*ListBankAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBankAccounts not implemented")
+func (UnimplementedDunningServiceServer) ReleaseDunningHold(context.Context, *ReleaseDunningHoldRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDunningHold not implemented")
 }
-func (UnimplementedPaymentServiceServer) ImportBankTransactions(context.Context, *ImportBankTransactionsRequest) (*ImportBankTransactionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportBankTransactions not implemented")
+func (UnimplementedDunningServiceServer) ListDunningHolds(context.Context, *ListDunningHoldsRequest) (*ListDunningHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDunningHolds not implemented")
 }
-func (UnimplementedPaymentServiceServer) ListBankTransactions(context.Context, *ListBankTransactionsRequest) (*ListBankTransactionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBankTransactions not implemented")
+func (UnimplementedDunningServiceServer) RunDunning(context.Context, *RunDunningRequest) (*RunDunningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunDunning not implemented")
 }
-func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
-func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
+func (UnimplementedDunningServiceServer) mustEmbedUnimplementedDunningServiceServer() {}
+func (UnimplementedDunningServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PaymentServiceServer will
+// UnsafeDunningServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DunningServiceServer will
 // result in compilation errors.
-type UnsafePaymentServiceServer interface {
-	mustEmbedUnimplementedPaymentServiceServer()
+type UnsafeDunningServiceServer interface {
+	mustEmbedUnimplementedDunningServiceServer()
 }
 
-func RegisterPaymentServiceServer(s grpc.ServiceRegistrar, srv PaymentServiceServer) {
-	// If the following call pancis, it indicates UnimplementedPaymentServiceServer was
+func RegisterDunningServiceServer(s grpc.ServiceRegistrar, srv DunningServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDunningServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&PaymentService_ServiceDesc, srv)
+	s.RegisterService(&DunningService_ServiceDesc, srv)
 }
 
-func _PaymentService_CreatePaymentDue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePaymentDueRequest)
+func _DunningService_SetDunningSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDunningScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).CreatePaymentDue(ctx, in)
+		return srv.(DunningServiceServer).SetDunningSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_CreatePaymentDue_FullMethodName,
+		FullMethod: DunningService_SetDunningSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).CreatePaymentDue(ctx, req.(*CreatePaymentDueRequest))
+		return srv.(DunningServiceServer).SetDunningSchedule(ctx, req.(*SetDunningScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_GetPaymentDue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPaymentDueRequest)
+func _DunningService_GetDunningSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDunningScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).GetPaymentDue(ctx, in)
+		return srv.(DunningServiceServer).GetDunningSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_GetPaymentDue_FullMethodName,
+		FullMethod: DunningService_GetDunningSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).GetPaymentDue(ctx, req.(*GetPaymentDueRequest))
+		return srv.(DunningServiceServer).GetDunningSchedule(ctx, req.(*GetDunningScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_UpdatePaymentDue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePaymentDueRequest)
+func _DunningService_PlaceDunningHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceDunningHoldRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).UpdatePaymentDue(ctx, in)
+		return srv.(DunningServiceServer).PlaceDunningHold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_UpdatePaymentDue_FullMethodName,
+		FullMethod: DunningService_PlaceDunningHold_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).UpdatePaymentDue(ctx, req.(*UpdatePaymentDueRequest))
+		return srv.(DunningServiceServer).PlaceDunningHold(ctx, req.(*PlaceDunningHoldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_DeletePaymentDue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePaymentDueRequest)
+func _DunningService_ReleaseDunningHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseDunningHoldRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).DeletePaymentDue(ctx, in)
+		return srv.(DunningServiceServer).ReleaseDunningHold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_DeletePaymentDue_FullMethodName,
+		FullMethod: DunningService_ReleaseDunningHold_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).DeletePaymentDue(ctx, req.(*DeletePaymentDueRequest))
+		return srv.(DunningServiceServer).ReleaseDunningHold(ctx, req.(*ReleaseDunningHoldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_MarkPaymentAsPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarkPaymentAsPaidRequest)
+func _DunningService_ListDunningHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDunningHoldsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).MarkPaymentAsPaid(ctx, in)
+		return srv.(DunningServiceServer).ListDunningHolds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_MarkPaymentAsPaid_FullMethodName,
+		FullMethod: DunningService_ListDunningHolds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).MarkPaymentAsPaid(ctx, req.(*MarkPaymentAsPaidRequest))
+		return srv.(DunningServiceServer).ListDunningHolds(ctx, req.(*ListDunningHoldsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_ListPaymentDues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPaymentDuesRequest)
+func _DunningService_RunDunning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunDunningRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).ListPaymentDues(ctx, in)
+		return srv.(DunningServiceServer).RunDunning(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_ListPaymentDues_FullMethodName,
+		FullMethod: DunningService_RunDunning_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).ListPaymentDues(ctx, req.(*ListPaymentDuesRequest))
+		return srv.(DunningServiceServer).RunDunning(ctx, req.(*RunDunningRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_CreateBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBankAccountRequest)
-	if err := dec(in); err != nil {
+// DunningService_ServiceDesc is the grpc.ServiceDesc for DunningService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DunningService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.DunningService",
+	HandlerType: (*DunningServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetDunningSchedule",
+			Handler:    _DunningService_SetDunningSchedule_Handler,
+		},
+		{
+			MethodName: "GetDunningSchedule",
+			Handler:    _DunningService_GetDunningSchedule_Handler,
+		},
+		{
+			MethodName: "PlaceDunningHold",
+			Handler:    _DunningService_PlaceDunningHold_Handler,
+		},
+		{
+			MethodName: "ReleaseDunningHold",
+			Handler:    _DunningService_ReleaseDunningHold_Handler,
+		},
+		{
+			MethodName: "ListDunningHolds",
+			Handler:    _DunningService_ListDunningHolds_Handler,
+		},
+		{
+			MethodName: "RunDunning",
+			Handler:    _DunningService_RunDunning_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	RecurringInvoiceService_CreateRecurringInvoice_FullMethodName    = "/finance.RecurringInvoiceService/CreateRecurringInvoice"
+	RecurringInvoiceService_GetRecurringInvoice_FullMethodName       = "/finance.RecurringInvoiceService/GetRecurringInvoice"
+	RecurringInvoiceService_UpdateRecurringInvoice_FullMethodName    = "/finance.RecurringInvoiceService/UpdateRecurringInvoice"
+	RecurringInvoiceService_DeleteRecurringInvoice_FullMethodName    = "/finance.RecurringInvoiceService/DeleteRecurringInvoice"
+	RecurringInvoiceService_ListRecurringInvoices_FullMethodName     = "/finance.RecurringInvoiceService/ListRecurringInvoices"
+	RecurringInvoiceService_GenerateRecurringInvoices_FullMethodName = "/finance.RecurringInvoiceService/GenerateRecurringInvoices"
+)
+
+// RecurringInvoiceServiceClient is the client API for RecurringInvoiceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RecurringInvoiceServiceClient interface {
+	CreateRecurringInvoice(ctx context.Context, in *CreateRecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoice, error)
+	GetRecurringInvoice(ctx context.Context, in *GetRecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoice, error)
+	UpdateRecurringInvoice(ctx context.Context, in *UpdateRecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoice, error)
+	DeleteRecurringInvoice(ctx context.Context, in *DeleteRecurringInvoiceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRecurringInvoices(ctx context.Context, in *ListRecurringInvoicesRequest, opts ...grpc.CallOption) (*ListRecurringInvoicesResponse, error)
+	// Runs the hourly generator now: every period started by as_of that was
+	// not billed yet is billed once, for every recurring invoice.
+	GenerateRecurringInvoices(ctx context.Context, in *GenerateRecurringInvoicesRequest, opts ...grpc.CallOption) (*GenerateRecurringInvoicesResponse, error)
+}
+
+type recurringInvoiceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecurringInvoiceServiceClient(cc grpc.ClientConnInterface) RecurringInvoiceServiceClient {
+	return &recurringInvoiceServiceClient{cc}
+}
+
+func (c *recurringInvoiceServiceClient) CreateRecurringInvoice(ctx context.Context, in *CreateRecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringInvoice)
+	err := c.cc.Invoke(ctx, RecurringInvoiceService_CreateRecurringInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PaymentServiceServer).CreateBankAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: PaymentService_CreateBankAccount_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).CreateBankAccount(ctx, req.(*CreateBankAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PaymentService_GetBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBankAccountRequest)
-	if err := dec(in); err != nil {
+func (c *recurringInvoiceServiceClient) GetRecurringInvoice(ctx context.Context, in *GetRecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringInvoice)
+	err := c.cc.Invoke(ctx, RecurringInvoiceService_GetRecurringInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PaymentServiceServer).GetBankAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: PaymentService_GetBankAccount_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).GetBankAccount(ctx, req.(*GetBankAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PaymentService_UpdateBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBankAccountRequest)
-	if err := dec(in); err != nil {
+func (c *recurringInvoiceServiceClient) UpdateRecurringInvoice(ctx context.Context, in *UpdateRecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoice, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringInvoice)
+	err := c.cc.Invoke(ctx, RecurringInvoiceService_UpdateRecurringInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PaymentServiceServer).UpdateBankAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: PaymentService_UpdateBankAccount_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).UpdateBankAccount(ctx, req.(*UpdateBankAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PaymentService_DeleteBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBankAccountRequest)
+func (c *recurringInvoiceServiceClient) DeleteRecurringInvoice(ctx context.Context, in *DeleteRecurringInvoiceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecurringInvoiceService_DeleteRecurringInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringInvoiceServiceClient) ListRecurringInvoices(ctx context.Context, in *ListRecurringInvoicesRequest, opts ...grpc.CallOption) (*ListRecurringInvoicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecurringInvoicesResponse)
+	err := c.cc.Invoke(ctx, RecurringInvoiceService_ListRecurringInvoices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringInvoiceServiceClient) GenerateRecurringInvoices(ctx context.Context, in *GenerateRecurringInvoicesRequest, opts ...grpc.CallOption) (*GenerateRecurringInvoicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateRecurringInvoicesResponse)
+	err := c.cc.Invoke(ctx, RecurringInvoiceService_GenerateRecurringInvoices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecurringInvoiceServiceServer is the server API for RecurringInvoiceService service.
+// All implementations must embed UnimplementedRecurringInvoiceServiceServer
+// for forward compatibility.
+type RecurringInvoiceServiceServer interface {
+	CreateRecurringInvoice(context.Context, *CreateRecurringInvoiceRequest) (*RecurringInvoice, error)
+	GetRecurringInvoice(context.Context, *GetRecurringInvoiceRequest) (*RecurringInvoice, error)
+	UpdateRecurringInvoice(context.Context, *UpdateRecurringInvoiceRequest) (*RecurringInvoice, error)
+	DeleteRecurringInvoice(context.Context, *DeleteRecurringInvoiceRequest) (*emptypb.Empty, error)
+	ListRecurringInvoices(context.Context, *ListRecurringInvoicesRequest) (*ListRecurringInvoicesResponse, error)
+	// Runs the hourly generator now: every period started by as_of that was
+	// not billed yet is billed once, for every recurring invoice.
+	GenerateRecurringInvoices(context.Context, *GenerateRecurringInvoicesRequest) (*GenerateRecurringInvoicesResponse, error)
+	mustEmbedUnimplementedRecurringInvoiceServiceServer()
+}
+
+// UnimplementedRecurringInvoiceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecurringInvoiceServiceServer struct{}
+
+func (UnimplementedRecurringInvoiceServiceServer) CreateRecurringInvoice(context.Context, *CreateRecurringInvoiceRequest) (*RecurringInvoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringInvoice not implemented")
+}
+func (UnimplementedRecurringInvoiceServiceServer) GetRecurringInvoice(context.Context, *GetRecurringInvoiceRequest) (*RecurringInvoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringInvoice not implemented")
+}
+func (UnimplementedRecurringInvoiceServiceServer) UpdateRecurringInvoice(context.Context, *UpdateRecurringInvoiceRequest) (*RecurringInvoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecurringInvoice not implemented")
+}
+func (UnimplementedRecurringInvoiceServiceServer) DeleteRecurringInvoice(context.Context, *DeleteRecurringInvoiceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringInvoice not implemented")
+}
+func (UnimplementedRecurringInvoiceServiceServer) ListRecurringInvoices(context.Context, *ListRecurringInvoicesRequest) (*ListRecurringInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecurringInvoices not implemented")
+}
+func (UnimplementedRecurringInvoiceServiceServer) GenerateRecurringInvoices(context.Context, *GenerateRecurringInvoicesRequest) (*GenerateRecurringInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRecurringInvoices not implemented")
+}
+func (UnimplementedRecurringInvoiceServiceServer) mustEmbedUnimplementedRecurringInvoiceServiceServer() {
+}
+func (UnimplementedRecurringInvoiceServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRecurringInvoiceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecurringInvoiceServiceServer will
+// result in compilation errors.
+type UnsafeRecurringInvoiceServiceServer interface {
+	mustEmbedUnimplementedRecurringInvoiceServiceServer()
+}
+
+func RegisterRecurringInvoiceServiceServer(s grpc.ServiceRegistrar, srv RecurringInvoiceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecurringInvoiceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecurringInvoiceService_ServiceDesc, srv)
+}
+
+func _RecurringInvoiceService_CreateRecurringInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringInvoiceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).DeleteBankAccount(ctx, in)
+		return srv.(RecurringInvoiceServiceServer).CreateRecurringInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_DeleteBankAccount_FullMethodName,
+		FullMethod: RecurringInvoiceService_CreateRecurringInvoice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).DeleteBankAccount(ctx, req.(*DeleteBankAccountRequest))
+		return srv.(RecurringInvoiceServiceServer).CreateRecurringInvoice(ctx, req.(*CreateRecurringInvoiceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_ListBankAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBankAccountsRequest)
+func _RecurringInvoiceService_GetRecurringInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecurringInvoiceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).ListBankAccounts(ctx, in)
+		return srv.(RecurringInvoiceServiceServer).GetRecurringInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_ListBankAccounts_FullMethodName,
+		FullMethod: RecurringInvoiceService_GetRecurringInvoice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).ListBankAccounts(ctx, req.(*ListBankAccountsRequest))
+		return srv.(RecurringInvoiceServiceServer).GetRecurringInvoice(ctx, req.(*GetRecurringInvoiceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_ImportBankTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportBankTransactionsRequest)
+func _RecurringInvoiceService_UpdateRecurringInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecurringInvoiceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).ImportBankTransactions(ctx, in)
+		return srv.(RecurringInvoiceServiceServer).UpdateRecurringInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_ImportBankTransactions_FullMethodName,
+		FullMethod: RecurringInvoiceService_UpdateRecurringInvoice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).ImportBankTransactions(ctx, req.(*ImportBankTransactionsRequest))
+		return srv.(RecurringInvoiceServiceServer).UpdateRecurringInvoice(ctx, req.(*UpdateRecurringInvoiceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_ListBankTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBankTransactionsRequest)
+func _RecurringInvoiceService_DeleteRecurringInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringInvoiceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).ListBankTransactions(ctx, in)
+		return srv.(RecurringInvoiceServiceServer).DeleteRecurringInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_ListBankTransactions_FullMethodName,
+		FullMethod: RecurringInvoiceService_DeleteRecurringInvoice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).ListBankTransactions(ctx, req.(*ListBankTransactionsRequest))
+		return srv.(RecurringInvoiceServiceServer).DeleteRecurringInvoice(ctx, req.(*DeleteRecurringInvoiceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
+func _RecurringInvoiceService_ListRecurringInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurringInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringInvoiceServiceServer).ListRecurringInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringInvoiceService_ListRecurringInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringInvoiceServiceServer).ListRecurringInvoices(ctx, req.(*ListRecurringInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringInvoiceService_GenerateRecurringInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRecurringInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringInvoiceServiceServer).GenerateRecurringInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringInvoiceService_GenerateRecurringInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringInvoiceServiceServer).GenerateRecurringInvoices(ctx, req.(*GenerateRecurringInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecurringInvoiceService_ServiceDesc is the grpc.ServiceDesc for RecurringInvoiceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var PaymentService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "finance.PaymentService",
-	HandlerType: (*PaymentServiceServer)(nil),
+var RecurringInvoiceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.RecurringInvoiceService",
+	HandlerType: (*RecurringInvoiceServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreatePaymentDue",
-			Handler:    _PaymentService_CreatePaymentDue_Handler,
-		},
-		{
-			MethodName: "GetPaymentDue",
-			Handler:    _PaymentService_GetPaymentDue_Handler,
-		},
-		{
-			MethodName: "UpdatePaymentDue",
-			Handler:    _PaymentService_UpdatePaymentDue_Handler,
-		},
-		{
-			MethodName: "DeletePaymentDue",
-			Handler:    _PaymentService_DeletePaymentDue_Handler,
-		},
-		{
-			MethodName: "MarkPaymentAsPaid",
-			Handler:    _PaymentService_MarkPaymentAsPaid_Handler,
-		},
-		{
-			MethodName: "ListPaymentDues",
-			Handler:    _PaymentService_ListPaymentDues_Handler,
-		},
-		{
-			MethodName: "CreateBankAccount",
-			Handler:    _PaymentService_CreateBankAccount_Handler,
-		},
-		{
-			MethodName: "GetBankAccount",
-			Handler:    _PaymentService_GetBankAccount_Handler,
+			MethodName: "CreateRecurringInvoice",
+			Handler:    _RecurringInvoiceService_CreateRecurringInvoice_Handler,
 		},
 		{
-			MethodName: "UpdateBankAccount",
-			Handler:    _PaymentService_UpdateBankAccount_Handler,
+			MethodName: "GetRecurringInvoice",
+			Handler:    _RecurringInvoiceService_GetRecurringInvoice_Handler,
 		},
 		{
-			MethodName: "DeleteBankAccount",
-			Handler:    _PaymentService_DeleteBankAccount_Handler,
+			MethodName: "UpdateRecurringInvoice",
+			Handler:    _RecurringInvoiceService_UpdateRecurringInvoice_Handler,
 		},
 		{
-			MethodName: "ListBankAccounts",
-			Handler:    _PaymentService_ListBankAccounts_Handler,
+			MethodName: "DeleteRecurringInvoice",
+			Handler:    _RecurringInvoiceService_DeleteRecurringInvoice_Handler,
 		},
 		{
-			MethodName: "ImportBankTransactions",
-			Handler:    _PaymentService_ImportBankTransactions_Handler,
+			MethodName: "ListRecurringInvoices",
+			Handler:    _RecurringInvoiceService_ListRecurringInvoices_Handler,
 		},
 		{
-			MethodName: "ListBankTransactions",
-			Handler:    _PaymentService_ListBankTransactions_Handler,
+			MethodName: "GenerateRecurringInvoices",
+			Handler:    _RecurringInvoiceService_GenerateRecurringInvoices_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1127,102 +1867,254 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	BankReconciliationService_ReconcileTransaction_FullMethodName = "/finance.BankReconciliationService/ReconcileTransaction"
+	CreditDebitNoteService_CreateCreditDebitNote_FullMethodName = "/finance.CreditDebitNoteService/CreateCreditDebitNote"
+	CreditDebitNoteService_GetCreditDebitNote_FullMethodName    = "/finance.CreditDebitNoteService/GetCreditDebitNote"
+	CreditDebitNoteService_ListCreditDebitNotes_FullMethodName  = "/finance.CreditDebitNoteService/ListCreditDebitNotes"
+	CreditDebitNoteService_UpdateCreditDebitNote_FullMethodName = "/finance.CreditDebitNoteService/UpdateCreditDebitNote"
+	CreditDebitNoteService_DeleteCreditDebitNote_FullMethodName = "/finance.CreditDebitNoteService/DeleteCreditDebitNote"
 )
 
-// BankReconciliationServiceClient is the client API for BankReconciliationService service.
+// CreditDebitNoteServiceClient is the client API for CreditDebitNoteService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type BankReconciliationServiceClient interface {
-	ReconcileTransaction(ctx context.Context, in *ReconcileTransactionRequest, opts ...grpc.CallOption) (*Reconciliation, error)
+type CreditDebitNoteServiceClient interface {
+	CreateCreditDebitNote(ctx context.Context, in *CreateCreditDebitNoteRequest, opts ...grpc.CallOption) (*CreditDebitNote, error)
+	GetCreditDebitNote(ctx context.Context, in *GetCreditDebitNoteRequest, opts ...grpc.CallOption) (*CreditDebitNote, error)
+	ListCreditDebitNotes(ctx context.Context, in *ListCreditDebitNotesRequest, opts ...grpc.CallOption) (*ListCreditDebitNotesResponse, error)
+	UpdateCreditDebitNote(ctx context.Context, in *UpdateCreditDebitNoteRequest, opts ...grpc.CallOption) (*CreditDebitNote, error)
+	DeleteCreditDebitNote(ctx context.Context, in *DeleteCreditDebitNoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
-type bankReconciliationServiceClient struct {
+type creditDebitNoteServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewBankReconciliationServiceClient(cc grpc.ClientConnInterface) BankReconciliationServiceClient {
-	return &bankReconciliationServiceClient{cc}
+func NewCreditDebitNoteServiceClient(cc grpc.ClientConnInterface) CreditDebitNoteServiceClient {
+	return &creditDebitNoteServiceClient{cc}
 }
 
-func (c *bankReconciliationServiceClient) ReconcileTransaction(ctx context.Context, in *ReconcileTransactionRequest, opts ...grpc.CallOption) (*Reconciliation, error) {
+func (c *creditDebitNoteServiceClient) CreateCreditDebitNote(ctx context.Context, in *CreateCreditDebitNoteRequest, opts ...grpc.CallOption) (*CreditDebitNote, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Reconciliation)
-	err := c.cc.Invoke(ctx, BankReconciliationService_ReconcileTransaction_FullMethodName, in, out, cOpts...)
+	out := new(CreditDebitNote)
+	err := c.cc.Invoke(ctx, CreditDebitNoteService_CreateCreditDebitNote_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// BankReconciliationServiceServer is the server API for BankReconciliationService service.
-// All implementations must embed UnimplementedBankReconciliationServiceServer
+func (c *creditDebitNoteServiceClient) GetCreditDebitNote(ctx context.Context, in *GetCreditDebitNoteRequest, opts ...grpc.CallOption) (*CreditDebitNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditDebitNote)
+	err := c.cc.Invoke(ctx, CreditDebitNoteService_GetCreditDebitNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditDebitNoteServiceClient) ListCreditDebitNotes(ctx context.Context, in *ListCreditDebitNotesRequest, opts ...grpc.CallOption) (*ListCreditDebitNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCreditDebitNotesResponse)
+	err := c.cc.Invoke(ctx, CreditDebitNoteService_ListCreditDebitNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditDebitNoteServiceClient) UpdateCreditDebitNote(ctx context.Context, in *UpdateCreditDebitNoteRequest, opts ...grpc.CallOption) (*CreditDebitNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditDebitNote)
+	err := c.cc.Invoke(ctx, CreditDebitNoteService_UpdateCreditDebitNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *creditDebitNoteServiceClient) DeleteCreditDebitNote(ctx context.Context, in *DeleteCreditDebitNoteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CreditDebitNoteService_DeleteCreditDebitNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreditDebitNoteServiceServer is the server API for CreditDebitNoteService service.
+// All implementations must embed UnimplementedCreditDebitNoteServiceServer
 // for forward compatibility.
-type BankReconciliationServiceServer interface {
-	ReconcileTransaction(context.Context, *ReconcileTransactionRequest) (*Reconciliation, error)
-	mustEmbedUnimplementedBankReconciliationServiceServer()
+type CreditDebitNoteServiceServer interface {
+	CreateCreditDebitNote(context.Context, *CreateCreditDebitNoteRequest) (*CreditDebitNote, error)
+	GetCreditDebitNote(context.Context, *GetCreditDebitNoteRequest) (*CreditDebitNote, error)
+	ListCreditDebitNotes(context.Context, *ListCreditDebitNotesRequest) (*ListCreditDebitNotesResponse, error)
+	UpdateCreditDebitNote(context.Context, *UpdateCreditDebitNoteRequest) (*CreditDebitNote, error)
+	DeleteCreditDebitNote(context.Context, *DeleteCreditDebitNoteRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCreditDebitNoteServiceServer()
 }
 
-// UnimplementedBankReconciliationServiceServer must be embedded to have
+// UnimplementedCreditDebitNoteServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedBankReconciliationServiceServer struct{}
+type UnimplementedCreditDebitNoteServiceServer struct{}
 
-func (UnimplementedBankReconciliationServiceServer) ReconcileTransaction(context.Context, *ReconcileTransactionRequest) (*Reconciliation, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReconcileTransaction not implemented")
+func (UnimplementedCreditDebitNoteServiceServer) CreateCreditDebitNote(context.Context, *CreateCreditDebitNoteRequest) (*CreditDebitNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCreditDebitNote not implemented")
 }
-func (UnimplementedBankReconciliationServiceServer) mustEmbedUnimplementedBankReconciliationServiceServer() {
+func (UnimplementedCreditDebitNoteServiceServer) GetCreditDebitNote(context.Context, *GetCreditDebitNoteRequest) (*CreditDebitNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditDebitNote not implemented")
 }
-func (UnimplementedBankReconciliationServiceServer) testEmbeddedByValue() {}
+func (UnimplementedCreditDebitNoteServiceServer) ListCreditDebitNotes(context.Context, *ListCreditDebitNotesRequest) (*ListCreditDebitNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCreditDebitNotes not implemented")
+}
+func (UnimplementedCreditDebitNoteServiceServer) UpdateCreditDebitNote(context.Context, *UpdateCreditDebitNoteRequest) (*CreditDebitNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCreditDebitNote not implemented")
+}
+func (UnimplementedCreditDebitNoteServiceServer) DeleteCreditDebitNote(context.Context, *DeleteCreditDebitNoteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCreditDebitNote not implemented")
+}
+func (UnimplementedCreditDebitNoteServiceServer) mustEmbedUnimplementedCreditDebitNoteServiceServer() {
+}
+func (UnimplementedCreditDebitNoteServiceServer) testEmbeddedByValue() {}
 
-// UnsafeBankReconciliationServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BankReconciliationServiceServer will
+// UnsafeCreditDebitNoteServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CreditDebitNoteServiceServer will
 // result in compilation errors.
-type UnsafeBankReconciliationServiceServer interface {
-	mustEmbedUnimplementedBankReconciliationServiceServer()
+type UnsafeCreditDebitNoteServiceServer interface {
+	mustEmbedUnimplementedCreditDebitNoteServiceServer()
 }
 
-func RegisterBankReconciliationServiceServer(s grpc.ServiceRegistrar, srv BankReconciliationServiceServer) {
-	// If the following call pancis, it indicates UnimplementedBankReconciliationServiceServer was
+func RegisterCreditDebitNoteServiceServer(s grpc.ServiceRegistrar, srv CreditDebitNoteServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCreditDebitNoteServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&BankReconciliationService_ServiceDesc, srv)
+	s.RegisterService(&CreditDebitNoteService_ServiceDesc, srv)
 }
 
-func _BankReconciliationService_ReconcileTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReconcileTransactionRequest)
+func _CreditDebitNoteService_CreateCreditDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCreditDebitNoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BankReconciliationServiceServer).ReconcileTransaction(ctx, in)
+		return srv.(CreditDebitNoteServiceServer).CreateCreditDebitNote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BankReconciliationService_ReconcileTransaction_FullMethodName,
+		FullMethod: CreditDebitNoteService_CreateCreditDebitNote_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BankReconciliationServiceServer).ReconcileTransaction(ctx, req.(*ReconcileTransactionRequest))
+		return srv.(CreditDebitNoteServiceServer).CreateCreditDebitNote(ctx, req.(*CreateCreditDebitNoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// BankReconciliationService_ServiceDesc is the grpc.ServiceDesc for BankReconciliationService service.
+func _CreditDebitNoteService_GetCreditDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditDebitNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditDebitNoteServiceServer).GetCreditDebitNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditDebitNoteService_GetCreditDebitNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditDebitNoteServiceServer).GetCreditDebitNote(ctx, req.(*GetCreditDebitNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditDebitNoteService_ListCreditDebitNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCreditDebitNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditDebitNoteServiceServer).ListCreditDebitNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditDebitNoteService_ListCreditDebitNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditDebitNoteServiceServer).ListCreditDebitNotes(ctx, req.(*ListCreditDebitNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditDebitNoteService_UpdateCreditDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCreditDebitNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditDebitNoteServiceServer).UpdateCreditDebitNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditDebitNoteService_UpdateCreditDebitNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditDebitNoteServiceServer).UpdateCreditDebitNote(ctx, req.(*UpdateCreditDebitNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CreditDebitNoteService_DeleteCreditDebitNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCreditDebitNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CreditDebitNoteServiceServer).DeleteCreditDebitNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CreditDebitNoteService_DeleteCreditDebitNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CreditDebitNoteServiceServer).DeleteCreditDebitNote(ctx, req.(*DeleteCreditDebitNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CreditDebitNoteService_ServiceDesc is the grpc.ServiceDesc for CreditDebitNoteService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var BankReconciliationService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "finance.BankReconciliationService",
-	HandlerType: (*BankReconciliationServiceServer)(nil),
+var CreditDebitNoteService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.CreditDebitNoteService",
+	HandlerType: (*CreditDebitNoteServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "ReconcileTransaction",
-			Handler:    _BankReconciliationService_ReconcileTransaction_Handler,
+			MethodName: "CreateCreditDebitNote",
+			Handler:    _CreditDebitNoteService_CreateCreditDebitNote_Handler,
+		},
+		{
+			MethodName: "GetCreditDebitNote",
+			Handler:    _CreditDebitNoteService_GetCreditDebitNote_Handler,
+		},
+		{
+			MethodName: "ListCreditDebitNotes",
+			Handler:    _CreditDebitNoteService_ListCreditDebitNotes_Handler,
+		},
+		{
+			MethodName: "UpdateCreditDebitNote",
+			Handler:    _CreditDebitNoteService_UpdateCreditDebitNote_Handler,
+		},
+		{
+			MethodName: "DeleteCreditDebitNote",
+			Handler:    _CreditDebitNoteService_DeleteCreditDebitNote_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1230,487 +2122,1884 @@ var BankReconciliationService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	LedgerService_CreateAccount_FullMethodName      = "/finance.LedgerService/CreateAccount"
-	LedgerService_GetAccount_FullMethodName         = "/finance.LedgerService/GetAccount"
-	LedgerService_UpdateAccount_FullMethodName      = "/finance.LedgerService/UpdateAccount"
-	LedgerService_DeleteAccount_FullMethodName      = "/finance.LedgerService/DeleteAccount"
-	LedgerService_ListAccounts_FullMethodName       = "/finance.LedgerService/ListAccounts"
-	LedgerService_CreateJournalEntry_FullMethodName = "/finance.LedgerService/CreateJournalEntry"
-	LedgerService_GetJournalEntry_FullMethodName    = "/finance.LedgerService/GetJournalEntry"
-	LedgerService_UpdateJournalEntry_FullMethodName = "/finance.LedgerService/UpdateJournalEntry"
-	LedgerService_DeleteJournalEntry_FullMethodName = "/finance.LedgerService/DeleteJournalEntry"
-	LedgerService_ListJournalEntries_FullMethodName = "/finance.LedgerService/ListJournalEntries"
-	LedgerService_ListLedgerEntries_FullMethodName  = "/finance.LedgerService/ListLedgerEntries"
+	PaymentService_CreatePaymentDue_FullMethodName       = "/finance.PaymentService/CreatePaymentDue"
+	PaymentService_GetPaymentDue_FullMethodName          = "/finance.PaymentService/GetPaymentDue"
+	PaymentService_UpdatePaymentDue_FullMethodName       = "/finance.PaymentService/UpdatePaymentDue"
+	PaymentService_DeletePaymentDue_FullMethodName       = "/finance.PaymentService/DeletePaymentDue"
+	PaymentService_MarkPaymentAsPaid_FullMethodName      = "/finance.PaymentService/MarkPaymentAsPaid"
+	PaymentService_ListPaymentDues_FullMethodName        = "/finance.PaymentService/ListPaymentDues"
+	PaymentService_CreateBankAccount_FullMethodName      = "/finance.PaymentService/CreateBankAccount"
+	PaymentService_GetBankAccount_FullMethodName         = "/finance.PaymentService/GetBankAccount"
+	PaymentService_UpdateBankAccount_FullMethodName      = "/finance.PaymentService/UpdateBankAccount"
+	PaymentService_DeleteBankAccount_FullMethodName      = "/finance.PaymentService/DeleteBankAccount"
+	PaymentService_ListBankAccounts_FullMethodName       = "/finance.PaymentService/ListBankAccounts"
+	PaymentService_ImportBankTransactions_FullMethodName = "/finance.PaymentService/ImportBankTransactions"
+	PaymentService_ListBankTransactions_FullMethodName   = "/finance.PaymentService/ListBankTransactions"
 )
 
-// LedgerServiceClient is the client API for LedgerService service.
+// PaymentServiceClient is the client API for PaymentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type LedgerServiceClient interface {
-	// COA
-	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error)
-	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error)
-	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*Account, error)
-	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
-	// Journal (double-entry)
-	CreateJournalEntry(ctx context.Context, in *CreateJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error)
-	GetJournalEntry(ctx context.Context, in *GetJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error)
-	UpdateJournalEntry(ctx context.Context, in *UpdateJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error)
-	DeleteJournalEntry(ctx context.Context, in *DeleteJournalEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error)
-	// Ledger (read-only)
-	ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error)
+type PaymentServiceClient interface {
+	CreatePaymentDue(ctx context.Context, in *CreatePaymentDueRequest, opts ...grpc.CallOption) (*PaymentDue, error)
+	GetPaymentDue(ctx context.Context, in *GetPaymentDueRequest, opts ...grpc.CallOption) (*PaymentDue, error)
+	UpdatePaymentDue(ctx context.Context, in *UpdatePaymentDueRequest, opts ...grpc.CallOption) (*PaymentDue, error)
+	DeletePaymentDue(ctx context.Context, in *DeletePaymentDueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MarkPaymentAsPaid(ctx context.Context, in *MarkPaymentAsPaidRequest, opts ...grpc.CallOption) (*PaymentDue, error)
+	ListPaymentDues(ctx context.Context, in *ListPaymentDuesRequest, opts ...grpc.CallOption) (*ListPaymentDuesResponse, error)
+	// Bank accounts & statements
+	CreateBankAccount(ctx context.Context, in *CreateBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error)
+	GetBankAccount(ctx context.Context, in *GetBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error)
+	UpdateBankAccount(ctx context.Context, in *UpdateBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error)
+	DeleteBankAccount(ctx context.Context, in *DeleteBankAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListBankAccounts(ctx context.Context, in *ListBankAccountsRequest, opts ...grpc.CallOption) (*ListBankAccountsResponse, error)
+	ImportBankTransactions(ctx context.Context, in *ImportBankTransactionsRequest, opts ...grpc.CallOption) (*ImportBankTransactionsResponse, error)
+	ListBankTransactions(ctx context.Context, in *ListBankTransactionsRequest, opts ...grpc.CallOption) (*ListBankTransactionsResponse, error)
 }
 
-type ledgerServiceClient struct {
+type paymentServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewLedgerServiceClient(cc grpc.ClientConnInterface) LedgerServiceClient {
-	return &ledgerServiceClient{cc}
+func NewPaymentServiceClient(cc grpc.ClientConnInterface) PaymentServiceClient {
+	return &paymentServiceClient{cc}
 }
 
-func (c *ledgerServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+func (c *paymentServiceClient) CreatePaymentDue(ctx context.Context, in *CreatePaymentDueRequest, opts ...grpc.CallOption) (*PaymentDue, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Account)
-	err := c.cc.Invoke(ctx, LedgerService_CreateAccount_FullMethodName, in, out, cOpts...)
+	out := new(PaymentDue)
+	err := c.cc.Invoke(ctx, PaymentService_CreatePaymentDue_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ledgerServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+func (c *paymentServiceClient) GetPaymentDue(ctx context.Context, in *GetPaymentDueRequest, opts ...grpc.CallOption) (*PaymentDue, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Account)
-	err := c.cc.Invoke(ctx, LedgerService_GetAccount_FullMethodName, in, out, cOpts...)
+	out := new(PaymentDue)
+	err := c.cc.Invoke(ctx, PaymentService_GetPaymentDue_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ledgerServiceClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+func (c *paymentServiceClient) UpdatePaymentDue(ctx context.Context, in *UpdatePaymentDueRequest, opts ...grpc.CallOption) (*PaymentDue, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Account)
-	err := c.cc.Invoke(ctx, LedgerService_UpdateAccount_FullMethodName, in, out, cOpts...)
+	out := new(PaymentDue)
+	err := c.cc.Invoke(ctx, PaymentService_UpdatePaymentDue_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ledgerServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *paymentServiceClient) DeletePaymentDue(ctx context.Context, in *DeletePaymentDueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, LedgerService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, PaymentService_DeletePaymentDue_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ledgerServiceClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+func (c *paymentServiceClient) MarkPaymentAsPaid(ctx context.Context, in *MarkPaymentAsPaidRequest, opts ...grpc.CallOption) (*PaymentDue, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListAccountsResponse)
-	err := c.cc.Invoke(ctx, LedgerService_ListAccounts_FullMethodName, in, out, cOpts...)
+	out := new(PaymentDue)
+	err := c.cc.Invoke(ctx, PaymentService_MarkPaymentAsPaid_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ledgerServiceClient) CreateJournalEntry(ctx context.Context, in *CreateJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error) {
+func (c *paymentServiceClient) ListPaymentDues(ctx context.Context, in *ListPaymentDuesRequest, opts ...grpc.CallOption) (*ListPaymentDuesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(JournalEntry)
-	err := c.cc.Invoke(ctx, LedgerService_CreateJournalEntry_FullMethodName, in, out, cOpts...)
+	out := new(ListPaymentDuesResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListPaymentDues_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ledgerServiceClient) GetJournalEntry(ctx context.Context, in *GetJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error) {
+func (c *paymentServiceClient) CreateBankAccount(ctx context.Context, in *CreateBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(JournalEntry)
-	err := c.cc.Invoke(ctx, LedgerService_GetJournalEntry_FullMethodName, in, out, cOpts...)
+	out := new(BankAccount)
+	err := c.cc.Invoke(ctx, PaymentService_CreateBankAccount_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ledgerServiceClient) UpdateJournalEntry(ctx context.Context, in *UpdateJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error) {
+func (c *paymentServiceClient) GetBankAccount(ctx context.Context, in *GetBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(JournalEntry)
-	err := c.cc.Invoke(ctx, LedgerService_UpdateJournalEntry_FullMethodName, in, out, cOpts...)
+	out := new(BankAccount)
+	err := c.cc.Invoke(ctx, PaymentService_GetBankAccount_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ledgerServiceClient) DeleteJournalEntry(ctx context.Context, in *DeleteJournalEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *paymentServiceClient) UpdateBankAccount(ctx context.Context, in *UpdateBankAccountRequest, opts ...grpc.CallOption) (*BankAccount, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, LedgerService_DeleteJournalEntry_FullMethodName, in, out, cOpts...)
+	out := new(BankAccount)
+	err := c.cc.Invoke(ctx, PaymentService_UpdateBankAccount_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ledgerServiceClient) ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error) {
+func (c *paymentServiceClient) DeleteBankAccount(ctx context.Context, in *DeleteBankAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListJournalEntriesResponse)
-	err := c.cc.Invoke(ctx, LedgerService_ListJournalEntries_FullMethodName, in, out, cOpts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PaymentService_DeleteBankAccount_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ledgerServiceClient) ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error) {
+func (c *paymentServiceClient) ListBankAccounts(ctx context.Context, in *ListBankAccountsRequest, opts ...grpc.CallOption) (*ListBankAccountsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListLedgerEntriesResponse)
-	err := c.cc.Invoke(ctx, LedgerService_ListLedgerEntries_FullMethodName, in, out, cOpts...)
+	out := new(ListBankAccountsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListBankAccounts_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// LedgerServiceServer is the server API for LedgerService service.
-// All implementations must embed UnimplementedLedgerServiceServer
-// for forward compatibility.
-type LedgerServiceServer interface {
-	// COA
-	CreateAccount(context.Context, *CreateAccountRequest) (*Account, error)
-	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
-	UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error)
-	DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error)
-	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
-	// Journal (double-entry)
-	CreateJournalEntry(context.Context, *CreateJournalEntryRequest) (*JournalEntry, error)
-	GetJournalEntry(context.Context, *GetJournalEntryRequest) (*JournalEntry, error)
-	UpdateJournalEntry(context.Context, *UpdateJournalEntryRequest) (*JournalEntry, error)
-	DeleteJournalEntry(context.Context, *DeleteJournalEntryRequest) (*emptypb.Empty, error)
-	ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error)
-	// Ledger (read-only)
-	ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error)
-	mustEmbedUnimplementedLedgerServiceServer()
+func (c *paymentServiceClient) ImportBankTransactions(ctx context.Context, in *ImportBankTransactionsRequest, opts ...grpc.CallOption) (*ImportBankTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportBankTransactionsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ImportBankTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedLedgerServiceServer must be embedded to have
-// forward compatible implementations.
-//
+func (c *paymentServiceClient) ListBankTransactions(ctx context.Context, in *ListBankTransactionsRequest, opts ...grpc.CallOption) (*ListBankTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBankTransactionsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListBankTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PaymentServiceServer is the server API for PaymentService service.
+// All implementations must embed UnimplementedPaymentServiceServer
+// for forward compatibility.
+type PaymentServiceServer interface {
+	CreatePaymentDue(context.Context, *CreatePaymentDueRequest) (*PaymentDue, error)
+	GetPaymentDue(context.Context, *GetPaymentDueRequest) (*PaymentDue, error)
+	UpdatePaymentDue(context.Context, *UpdatePaymentDueRequest) (*PaymentDue, error)
+	DeletePaymentDue(context.Context, *DeletePaymentDueRequest) (*emptypb.Empty, error)
+	MarkPaymentAsPaid(context.Context, *MarkPaymentAsPaidRequest) (*PaymentDue, error)
+	ListPaymentDues(context.Context, *ListPaymentDuesRequest) (*ListPaymentDuesResponse, error)
+	// Bank accounts & statements
+	CreateBankAccount(context.Context, *CreateBankAccountRequest) (*BankAccount, error)
+	GetBankAccount(context.Context, *GetBankAccountRequest) (*BankAccount, error)
+	UpdateBankAccount(context.Context, *UpdateBankAccountRequest) (*BankAccount, error)
+	DeleteBankAccount(context.Context, *DeleteBankAccountRequest) (*emptypb.Empty, error)
+	ListBankAccounts(context.Context, *ListBankAccountsRequest) (*ListBankAccountsResponse, error)
+	ImportBankTransactions(context.Context, *ImportBankTransactionsRequest) (*ImportBankTransactionsResponse, error)
+	ListBankTransactions(context.Context, *ListBankTransactionsRequest) (*ListBankTransactionsResponse, error)
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+// UnimplementedPaymentServiceServer must be embedded to have
+// forward compatible implementations.
+//
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedLedgerServiceServer struct{}
+type UnimplementedPaymentServiceServer struct{}
 
-func (UnimplementedLedgerServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*Account, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+func (UnimplementedPaymentServiceServer) CreatePaymentDue(context.Context, *CreatePaymentDueRequest) (*PaymentDue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentDue not implemented")
 }
-func (UnimplementedLedgerServiceServer) GetAccount(context.Context, *GetAccountRequest) (*Account, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+func (UnimplementedPaymentServiceServer) GetPaymentDue(context.Context, *GetPaymentDueRequest) (*PaymentDue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentDue not implemented")
 }
-func (UnimplementedLedgerServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+func (UnimplementedPaymentServiceServer) UpdatePaymentDue(context.Context, *UpdatePaymentDueRequest) (*PaymentDue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePaymentDue not implemented")
 }
-func (UnimplementedLedgerServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+func (UnimplementedPaymentServiceServer) DeletePaymentDue(context.Context, *DeletePaymentDueRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePaymentDue not implemented")
 }
-func (UnimplementedLedgerServiceServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+func (UnimplementedPaymentServiceServer) MarkPaymentAsPaid(context.Context, *MarkPaymentAsPaidRequest) (*PaymentDue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkPaymentAsPaid not implemented")
 }
-func (UnimplementedLedgerServiceServer) CreateJournalEntry(context.Context, *CreateJournalEntryRequest) (*JournalEntry, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateJournalEntry not implemented")
+func (UnimplementedPaymentServiceServer) ListPaymentDues(context.Context, *ListPaymentDuesRequest) (*ListPaymentDuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPaymentDues not implemented")
 }
-func (UnimplementedLedgerServiceServer) GetJournalEntry(context.Context, *GetJournalEntryRequest) (*JournalEntry, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJournalEntry not implemented")
+func (UnimplementedPaymentServiceServer) CreateBankAccount(context.Context, *CreateBankAccountRequest) (*BankAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBankAccount not implemented")
 }
-func (UnimplementedLedgerServiceServer) UpdateJournalEntry(context.Context, *UpdateJournalEntryRequest) (*JournalEntry, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateJournalEntry not implemented")
+func (UnimplementedPaymentServiceServer) GetBankAccount(context.Context, *GetBankAccountRequest) (*BankAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBankAccount not implemented")
 }
-func (UnimplementedLedgerServiceServer) DeleteJournalEntry(context.Context, *DeleteJournalEntryRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteJournalEntry not implemented")
+func (UnimplementedPaymentServiceServer) UpdateBankAccount(context.Context, *UpdateBankAccountRequest) (*BankAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBankAccount not implemented")
 }
-func (UnimplementedLedgerServiceServer) ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListJournalEntries not implemented")
+func (UnimplementedPaymentServiceServer) DeleteBankAccount(context.Context, *DeleteBankAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBankAccount not implemented")
 }
-func (UnimplementedLedgerServiceServer) ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListLedgerEntries not implemented")
+func (UnimplementedPaymentServiceServer) ListBankAccounts(context.Context, *ListBankAccountsRequest) (*ListBankAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBankAccounts not implemented")
 }
-func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
-func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
+func (UnimplementedPaymentServiceServer) ImportBankTransactions(context.Context, *ImportBankTransactionsRequest) (*ImportBankTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportBankTransactions not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListBankTransactions(context.Context, *ListBankTransactionsRequest) (*ListBankTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBankTransactions not implemented")
+}
+func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
+func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeLedgerServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to LedgerServiceServer will
+// UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentServiceServer will
 // result in compilation errors.
-type UnsafeLedgerServiceServer interface {
-	mustEmbedUnimplementedLedgerServiceServer()
+type UnsafePaymentServiceServer interface {
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+func RegisterPaymentServiceServer(s grpc.ServiceRegistrar, srv PaymentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPaymentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PaymentService_ServiceDesc, srv)
+}
+
+func _PaymentService_CreatePaymentDue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentDueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreatePaymentDue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreatePaymentDue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreatePaymentDue(ctx, req.(*CreatePaymentDueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetPaymentDue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentDueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetPaymentDue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetPaymentDue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetPaymentDue(ctx, req.(*GetPaymentDueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_UpdatePaymentDue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePaymentDueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).UpdatePaymentDue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_UpdatePaymentDue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).UpdatePaymentDue(ctx, req.(*UpdatePaymentDueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_DeletePaymentDue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePaymentDueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).DeletePaymentDue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_DeletePaymentDue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).DeletePaymentDue(ctx, req.(*DeletePaymentDueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_MarkPaymentAsPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkPaymentAsPaidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).MarkPaymentAsPaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_MarkPaymentAsPaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).MarkPaymentAsPaid(ctx, req.(*MarkPaymentAsPaidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListPaymentDues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentDuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListPaymentDues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListPaymentDues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListPaymentDues(ctx, req.(*ListPaymentDuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CreateBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBankAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateBankAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreateBankAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateBankAccount(ctx, req.(*CreateBankAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBankAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetBankAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetBankAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetBankAccount(ctx, req.(*GetBankAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_UpdateBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBankAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).UpdateBankAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_UpdateBankAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).UpdateBankAccount(ctx, req.(*UpdateBankAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_DeleteBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBankAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).DeleteBankAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_DeleteBankAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).DeleteBankAccount(ctx, req.(*DeleteBankAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListBankAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBankAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListBankAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListBankAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListBankAccounts(ctx, req.(*ListBankAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ImportBankTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBankTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ImportBankTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ImportBankTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ImportBankTransactions(ctx, req.(*ImportBankTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListBankTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBankTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListBankTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListBankTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListBankTransactions(ctx, req.(*ListBankTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PaymentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.PaymentService",
+	HandlerType: (*PaymentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePaymentDue",
+			Handler:    _PaymentService_CreatePaymentDue_Handler,
+		},
+		{
+			MethodName: "GetPaymentDue",
+			Handler:    _PaymentService_GetPaymentDue_Handler,
+		},
+		{
+			MethodName: "UpdatePaymentDue",
+			Handler:    _PaymentService_UpdatePaymentDue_Handler,
+		},
+		{
+			MethodName: "DeletePaymentDue",
+			Handler:    _PaymentService_DeletePaymentDue_Handler,
+		},
+		{
+			MethodName: "MarkPaymentAsPaid",
+			Handler:    _PaymentService_MarkPaymentAsPaid_Handler,
+		},
+		{
+			MethodName: "ListPaymentDues",
+			Handler:    _PaymentService_ListPaymentDues_Handler,
+		},
+		{
+			MethodName: "CreateBankAccount",
+			Handler:    _PaymentService_CreateBankAccount_Handler,
+		},
+		{
+			MethodName: "GetBankAccount",
+			Handler:    _PaymentService_GetBankAccount_Handler,
+		},
+		{
+			MethodName: "UpdateBankAccount",
+			Handler:    _PaymentService_UpdateBankAccount_Handler,
+		},
+		{
+			MethodName: "DeleteBankAccount",
+			Handler:    _PaymentService_DeleteBankAccount_Handler,
+		},
+		{
+			MethodName: "ListBankAccounts",
+			Handler:    _PaymentService_ListBankAccounts_Handler,
+		},
+		{
+			MethodName: "ImportBankTransactions",
+			Handler:    _PaymentService_ImportBankTransactions_Handler,
+		},
+		{
+			MethodName: "ListBankTransactions",
+			Handler:    _PaymentService_ListBankTransactions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	BankReconciliationService_ReconcileTransaction_FullMethodName = "/finance.BankReconciliationService/ReconcileTransaction"
+)
+
+// BankReconciliationServiceClient is the client API for BankReconciliationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BankReconciliationServiceClient interface {
+	ReconcileTransaction(ctx context.Context, in *ReconcileTransactionRequest, opts ...grpc.CallOption) (*Reconciliation, error)
+}
+
+type bankReconciliationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBankReconciliationServiceClient(cc grpc.ClientConnInterface) BankReconciliationServiceClient {
+	return &bankReconciliationServiceClient{cc}
+}
+
+func (c *bankReconciliationServiceClient) ReconcileTransaction(ctx context.Context, in *ReconcileTransactionRequest, opts ...grpc.CallOption) (*Reconciliation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reconciliation)
+	err := c.cc.Invoke(ctx, BankReconciliationService_ReconcileTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BankReconciliationServiceServer is the server API for BankReconciliationService service.
+// All implementations must embed UnimplementedBankReconciliationServiceServer
+// for forward compatibility.
+type BankReconciliationServiceServer interface {
+	ReconcileTransaction(context.Context, *ReconcileTransactionRequest) (*Reconciliation, error)
+	mustEmbedUnimplementedBankReconciliationServiceServer()
+}
+
+// UnimplementedBankReconciliationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBankReconciliationServiceServer struct{}
+
+func (UnimplementedBankReconciliationServiceServer) ReconcileTransaction(context.Context, *ReconcileTransactionRequest) (*Reconciliation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileTransaction not implemented")
+}
+func (UnimplementedBankReconciliationServiceServer) mustEmbedUnimplementedBankReconciliationServiceServer() {
+}
+func (UnimplementedBankReconciliationServiceServer) testEmbeddedByValue() {}
+
+// UnsafeBankReconciliationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BankReconciliationServiceServer will
+// result in compilation errors.
+type UnsafeBankReconciliationServiceServer interface {
+	mustEmbedUnimplementedBankReconciliationServiceServer()
+}
+
+func RegisterBankReconciliationServiceServer(s grpc.ServiceRegistrar, srv BankReconciliationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBankReconciliationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BankReconciliationService_ServiceDesc, srv)
+}
+
+func _BankReconciliationService_ReconcileTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankReconciliationServiceServer).ReconcileTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankReconciliationService_ReconcileTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankReconciliationServiceServer).ReconcileTransaction(ctx, req.(*ReconcileTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BankReconciliationService_ServiceDesc is the grpc.ServiceDesc for BankReconciliationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BankReconciliationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.BankReconciliationService",
+	HandlerType: (*BankReconciliationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReconcileTransaction",
+			Handler:    _BankReconciliationService_ReconcileTransaction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	LedgerService_CreateAccount_FullMethodName             = "/finance.LedgerService/CreateAccount"
+	LedgerService_GetAccount_FullMethodName                = "/finance.LedgerService/GetAccount"
+	LedgerService_UpdateAccount_FullMethodName             = "/finance.LedgerService/UpdateAccount"
+	LedgerService_DeleteAccount_FullMethodName             = "/finance.LedgerService/DeleteAccount"
+	LedgerService_ListAccounts_FullMethodName              = "/finance.LedgerService/ListAccounts"
+	LedgerService_CreateJournalEntry_FullMethodName        = "/finance.LedgerService/CreateJournalEntry"
+	LedgerService_GetJournalEntry_FullMethodName           = "/finance.LedgerService/GetJournalEntry"
+	LedgerService_UpdateJournalEntry_FullMethodName        = "/finance.LedgerService/UpdateJournalEntry"
+	LedgerService_DeleteJournalEntry_FullMethodName        = "/finance.LedgerService/DeleteJournalEntry"
+	LedgerService_ListJournalEntries_FullMethodName        = "/finance.LedgerService/ListJournalEntries"
+	LedgerService_ReverseJournalEntry_FullMethodName       = "/finance.LedgerService/ReverseJournalEntry"
+	LedgerService_ListLedgerEntries_FullMethodName         = "/finance.LedgerService/ListLedgerEntries"
+	LedgerService_GetAccountBalance_FullMethodName         = "/finance.LedgerService/GetAccountBalance"
+	LedgerService_ListAccountBalances_FullMethodName       = "/finance.LedgerService/ListAccountBalances"
+	LedgerService_GetAccountTree_FullMethodName            = "/finance.LedgerService/GetAccountTree"
+	LedgerService_CreateJournalTemplate_FullMethodName     = "/finance.LedgerService/CreateJournalTemplate"
+	LedgerService_GetJournalTemplate_FullMethodName        = "/finance.LedgerService/GetJournalTemplate"
+	LedgerService_UpdateJournalTemplate_FullMethodName     = "/finance.LedgerService/UpdateJournalTemplate"
+	LedgerService_DeleteJournalTemplate_FullMethodName     = "/finance.LedgerService/DeleteJournalTemplate"
+	LedgerService_ListJournalTemplates_FullMethodName      = "/finance.LedgerService/ListJournalTemplates"
+	LedgerService_GenerateRecurringJournals_FullMethodName = "/finance.LedgerService/GenerateRecurringJournals"
+	LedgerService_ImportJournalEntries_FullMethodName      = "/finance.LedgerService/ImportJournalEntries"
+)
+
+// LedgerServiceClient is the client API for LedgerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LedgerServiceClient interface {
+	// COA
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	// Journal (double-entry)
+	CreateJournalEntry(ctx context.Context, in *CreateJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error)
+	GetJournalEntry(ctx context.Context, in *GetJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error)
+	UpdateJournalEntry(ctx context.Context, in *UpdateJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error)
+	DeleteJournalEntry(ctx context.Context, in *DeleteJournalEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error)
+	// Posts a mirror entry on reversal_date and marks the original REVERSED.
+	ReverseJournalEntry(ctx context.Context, in *ReverseJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error)
+	// Ledger (read-only)
+	ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error)
+	GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*AccountBalance, error)
+	ListAccountBalances(ctx context.Context, in *ListAccountBalancesRequest, opts ...grpc.CallOption) (*ListAccountBalancesResponse, error)
+	GetAccountTree(ctx context.Context, in *GetAccountTreeRequest, opts ...grpc.CallOption) (*GetAccountTreeResponse, error)
+	// Recurring journal templates
+	CreateJournalTemplate(ctx context.Context, in *CreateJournalTemplateRequest, opts ...grpc.CallOption) (*JournalTemplate, error)
+	GetJournalTemplate(ctx context.Context, in *GetJournalTemplateRequest, opts ...grpc.CallOption) (*JournalTemplate, error)
+	UpdateJournalTemplate(ctx context.Context, in *UpdateJournalTemplateRequest, opts ...grpc.CallOption) (*JournalTemplate, error)
+	DeleteJournalTemplate(ctx context.Context, in *DeleteJournalTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListJournalTemplates(ctx context.Context, in *ListJournalTemplatesRequest, opts ...grpc.CallOption) (*ListJournalTemplatesResponse, error)
+	// Creates every occurrence due on or before as_of that has not been
+	// generated yet. Safe to call repeatedly.
+	GenerateRecurringJournals(ctx context.Context, in *GenerateRecurringJournalsRequest, opts ...grpc.CallOption) (*GenerateRecurringJournalsResponse, error)
+	// Imports journals from a CSV or XLSX file. Rows are grouped into entries
+	// by their reference column, account codes are resolved to accounts and
+	// every entry is validated. The file is imported all or nothing.
+	ImportJournalEntries(ctx context.Context, in *ImportJournalEntriesRequest, opts ...grpc.CallOption) (*ImportJournalEntriesResponse, error)
+}
+
+type ledgerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLedgerServiceClient(cc grpc.ClientConnInterface) LedgerServiceClient {
+	return &ledgerServiceClient{cc}
+}
+
+func (c *ledgerServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, LedgerService_CreateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, LedgerService_GetAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateJournalEntry(ctx context.Context, in *CreateJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JournalEntry)
+	err := c.cc.Invoke(ctx, LedgerService_CreateJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetJournalEntry(ctx context.Context, in *GetJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JournalEntry)
+	err := c.cc.Invoke(ctx, LedgerService_GetJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateJournalEntry(ctx context.Context, in *UpdateJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JournalEntry)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteJournalEntry(ctx context.Context, in *DeleteJournalEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJournalEntriesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListJournalEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ReverseJournalEntry(ctx context.Context, in *ReverseJournalEntryRequest, opts ...grpc.CallOption) (*JournalEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JournalEntry)
+	err := c.cc.Invoke(ctx, LedgerService_ReverseJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLedgerEntriesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListLedgerEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*AccountBalance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountBalance)
+	err := c.cc.Invoke(ctx, LedgerService_GetAccountBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListAccountBalances(ctx context.Context, in *ListAccountBalancesRequest, opts ...grpc.CallOption) (*ListAccountBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountBalancesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListAccountBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetAccountTree(ctx context.Context, in *GetAccountTreeRequest, opts ...grpc.CallOption) (*GetAccountTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountTreeResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetAccountTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateJournalTemplate(ctx context.Context, in *CreateJournalTemplateRequest, opts ...grpc.CallOption) (*JournalTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JournalTemplate)
+	err := c.cc.Invoke(ctx, LedgerService_CreateJournalTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetJournalTemplate(ctx context.Context, in *GetJournalTemplateRequest, opts ...grpc.CallOption) (*JournalTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JournalTemplate)
+	err := c.cc.Invoke(ctx, LedgerService_GetJournalTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateJournalTemplate(ctx context.Context, in *UpdateJournalTemplateRequest, opts ...grpc.CallOption) (*JournalTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JournalTemplate)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateJournalTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteJournalTemplate(ctx context.Context, in *DeleteJournalTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteJournalTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListJournalTemplates(ctx context.Context, in *ListJournalTemplatesRequest, opts ...grpc.CallOption) (*ListJournalTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJournalTemplatesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListJournalTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GenerateRecurringJournals(ctx context.Context, in *GenerateRecurringJournalsRequest, opts ...grpc.CallOption) (*GenerateRecurringJournalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateRecurringJournalsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GenerateRecurringJournals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ImportJournalEntries(ctx context.Context, in *ImportJournalEntriesRequest, opts ...grpc.CallOption) (*ImportJournalEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJournalEntriesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ImportJournalEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LedgerServiceServer is the server API for LedgerService service.
+// All implementations must embed UnimplementedLedgerServiceServer
+// for forward compatibility.
+type LedgerServiceServer interface {
+	// COA
+	CreateAccount(context.Context, *CreateAccountRequest) (*Account, error)
+	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	// Journal (double-entry)
+	CreateJournalEntry(context.Context, *CreateJournalEntryRequest) (*JournalEntry, error)
+	GetJournalEntry(context.Context, *GetJournalEntryRequest) (*JournalEntry, error)
+	UpdateJournalEntry(context.Context, *UpdateJournalEntryRequest) (*JournalEntry, error)
+	DeleteJournalEntry(context.Context, *DeleteJournalEntryRequest) (*emptypb.Empty, error)
+	ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error)
+	// Posts a mirror entry on reversal_date and marks the original REVERSED.
+	ReverseJournalEntry(context.Context, *ReverseJournalEntryRequest) (*JournalEntry, error)
+	// Ledger (read-only)
+	ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error)
+	GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*AccountBalance, error)
+	ListAccountBalances(context.Context, *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error)
+	GetAccountTree(context.Context, *GetAccountTreeRequest) (*GetAccountTreeResponse, error)
+	// Recurring journal templates
+	CreateJournalTemplate(context.Context, *CreateJournalTemplateRequest) (*JournalTemplate, error)
+	GetJournalTemplate(context.Context, *GetJournalTemplateRequest) (*JournalTemplate, error)
+	UpdateJournalTemplate(context.Context, *UpdateJournalTemplateRequest) (*JournalTemplate, error)
+	DeleteJournalTemplate(context.Context, *DeleteJournalTemplateRequest) (*emptypb.Empty, error)
+	ListJournalTemplates(context.Context, *ListJournalTemplatesRequest) (*ListJournalTemplatesResponse, error)
+	// Creates every occurrence due on or before as_of that has not been
+	// generated yet. Safe to call repeatedly.
+	GenerateRecurringJournals(context.Context, *GenerateRecurringJournalsRequest) (*GenerateRecurringJournalsResponse, error)
+	// Imports journals from a CSV or XLSX file. Rows are grouped into entries
+	// by their reference column, account codes are resolved to accounts and
+	// every entry is validated. The file is imported all or nothing.
+	ImportJournalEntries(context.Context, *ImportJournalEntriesRequest) (*ImportJournalEntriesResponse, error)
+	mustEmbedUnimplementedLedgerServiceServer()
+}
+
+// UnimplementedLedgerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLedgerServiceServer struct{}
+
+func (UnimplementedLedgerServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetAccount(context.Context, *GetAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateJournalEntry(context.Context, *CreateJournalEntryRequest) (*JournalEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJournalEntry not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetJournalEntry(context.Context, *GetJournalEntryRequest) (*JournalEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJournalEntry not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateJournalEntry(context.Context, *UpdateJournalEntryRequest) (*JournalEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJournalEntry not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteJournalEntry(context.Context, *DeleteJournalEntryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJournalEntry not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJournalEntries not implemented")
+}
+func (UnimplementedLedgerServiceServer) ReverseJournalEntry(context.Context, *ReverseJournalEntryRequest) (*JournalEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseJournalEntry not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLedgerEntries not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*AccountBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountBalance not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListAccountBalances(context.Context, *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountBalances not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetAccountTree(context.Context, *GetAccountTreeRequest) (*GetAccountTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTree not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateJournalTemplate(context.Context, *CreateJournalTemplateRequest) (*JournalTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJournalTemplate not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetJournalTemplate(context.Context, *GetJournalTemplateRequest) (*JournalTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJournalTemplate not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateJournalTemplate(context.Context, *UpdateJournalTemplateRequest) (*JournalTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateJournalTemplate not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteJournalTemplate(context.Context, *DeleteJournalTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJournalTemplate not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListJournalTemplates(context.Context, *ListJournalTemplatesRequest) (*ListJournalTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJournalTemplates not implemented")
+}
+func (UnimplementedLedgerServiceServer) GenerateRecurringJournals(context.Context, *GenerateRecurringJournalsRequest) (*GenerateRecurringJournalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRecurringJournals not implemented")
+}
+func (UnimplementedLedgerServiceServer) ImportJournalEntries(context.Context, *ImportJournalEntriesRequest) (*ImportJournalEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportJournalEntries not implemented")
+}
+func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
+func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeLedgerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LedgerServiceServer will
+// result in compilation errors.
+type UnsafeLedgerServiceServer interface {
+	mustEmbedUnimplementedLedgerServiceServer()
+}
+
+func RegisterLedgerServiceServer(s grpc.ServiceRegistrar, srv LedgerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLedgerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LedgerService_ServiceDesc, srv)
+}
+
+func _LedgerService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateJournalEntry(ctx, req.(*CreateJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetJournalEntry(ctx, req.(*GetJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UpdateJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateJournalEntry(ctx, req.(*UpdateJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteJournalEntry(ctx, req.(*DeleteJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJournalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListJournalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListJournalEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListJournalEntries(ctx, req.(*ListJournalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ReverseJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ReverseJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ReverseJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ReverseJournalEntry(ctx, req.(*ReverseJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListLedgerEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLedgerEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListLedgerEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListLedgerEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListLedgerEntries(ctx, req.(*ListLedgerEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetAccountBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetAccountBalance(ctx, req.(*GetAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListAccountBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListAccountBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListAccountBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListAccountBalances(ctx, req.(*ListAccountBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetAccountTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetAccountTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetAccountTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetAccountTree(ctx, req.(*GetAccountTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateJournalTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJournalTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateJournalTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateJournalTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateJournalTemplate(ctx, req.(*CreateJournalTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetJournalTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJournalTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetJournalTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetJournalTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetJournalTemplate(ctx, req.(*GetJournalTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UpdateJournalTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateJournalTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateJournalTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateJournalTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateJournalTemplate(ctx, req.(*UpdateJournalTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteJournalTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJournalTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteJournalTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteJournalTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteJournalTemplate(ctx, req.(*DeleteJournalTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListJournalTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJournalTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListJournalTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListJournalTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListJournalTemplates(ctx, req.(*ListJournalTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GenerateRecurringJournals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRecurringJournalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GenerateRecurringJournals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GenerateRecurringJournals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GenerateRecurringJournals(ctx, req.(*GenerateRecurringJournalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ImportJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportJournalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ImportJournalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ImportJournalEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ImportJournalEntries(ctx, req.(*ImportJournalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LedgerService_ServiceDesc is the grpc.ServiceDesc for LedgerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LedgerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.LedgerService",
+	HandlerType: (*LedgerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAccount",
+			Handler:    _LedgerService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _LedgerService_GetAccount_Handler,
+		},
+		{
+			MethodName: "UpdateAccount",
+			Handler:    _LedgerService_UpdateAccount_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _LedgerService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _LedgerService_ListAccounts_Handler,
+		},
+		{
+			MethodName: "CreateJournalEntry",
+			Handler:    _LedgerService_CreateJournalEntry_Handler,
+		},
+		{
+			MethodName: "GetJournalEntry",
+			Handler:    _LedgerService_GetJournalEntry_Handler,
+		},
+		{
+			MethodName: "UpdateJournalEntry",
+			Handler:    _LedgerService_UpdateJournalEntry_Handler,
+		},
+		{
+			MethodName: "DeleteJournalEntry",
+			Handler:    _LedgerService_DeleteJournalEntry_Handler,
+		},
+		{
+			MethodName: "ListJournalEntries",
+			Handler:    _LedgerService_ListJournalEntries_Handler,
+		},
+		{
+			MethodName: "ReverseJournalEntry",
+			Handler:    _LedgerService_ReverseJournalEntry_Handler,
+		},
+		{
+			MethodName: "ListLedgerEntries",
+			Handler:    _LedgerService_ListLedgerEntries_Handler,
+		},
+		{
+			MethodName: "GetAccountBalance",
+			Handler:    _LedgerService_GetAccountBalance_Handler,
+		},
+		{
+			MethodName: "ListAccountBalances",
+			Handler:    _LedgerService_ListAccountBalances_Handler,
+		},
+		{
+			MethodName: "GetAccountTree",
+			Handler:    _LedgerService_GetAccountTree_Handler,
+		},
+		{
+			MethodName: "CreateJournalTemplate",
+			Handler:    _LedgerService_CreateJournalTemplate_Handler,
+		},
+		{
+			MethodName: "GetJournalTemplate",
+			Handler:    _LedgerService_GetJournalTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateJournalTemplate",
+			Handler:    _LedgerService_UpdateJournalTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteJournalTemplate",
+			Handler:    _LedgerService_DeleteJournalTemplate_Handler,
+		},
+		{
+			MethodName: "ListJournalTemplates",
+			Handler:    _LedgerService_ListJournalTemplates_Handler,
+		},
+		{
+			MethodName: "GenerateRecurringJournals",
+			Handler:    _LedgerService_GenerateRecurringJournals_Handler,
+		},
+		{
+			MethodName: "ImportJournalEntries",
+			Handler:    _LedgerService_ImportJournalEntries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	FiscalPeriodService_SetFiscalCalendar_FullMethodName = "/finance.FiscalPeriodService/SetFiscalCalendar"
+	FiscalPeriodService_CreateFiscalYear_FullMethodName  = "/finance.FiscalPeriodService/CreateFiscalYear"
+	FiscalPeriodService_ListFiscalPeriods_FullMethodName = "/finance.FiscalPeriodService/ListFiscalPeriods"
+	FiscalPeriodService_OpenPeriod_FullMethodName        = "/finance.FiscalPeriodService/OpenPeriod"
+	FiscalPeriodService_ClosePeriod_FullMethodName       = "/finance.FiscalPeriodService/ClosePeriod"
+	FiscalPeriodService_CloseFiscalYear_FullMethodName   = "/finance.FiscalPeriodService/CloseFiscalYear"
+)
+
+// FiscalPeriodServiceClient is the client API for FiscalPeriodService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FiscalPeriodServiceClient interface {
+	SetFiscalCalendar(ctx context.Context, in *SetFiscalCalendarRequest, opts ...grpc.CallOption) (*FiscalCalendar, error)
+	// Generates the twelve monthly periods; idempotent.
+	CreateFiscalYear(ctx context.Context, in *CreateFiscalYearRequest, opts ...grpc.CallOption) (*ListFiscalPeriodsResponse, error)
+	ListFiscalPeriods(ctx context.Context, in *ListFiscalPeriodsRequest, opts ...grpc.CallOption) (*ListFiscalPeriodsResponse, error)
+	// Periods of a closed fiscal year cannot be reopened or soft-closed
+	// (FAILED_PRECONDITION).
+	OpenPeriod(ctx context.Context, in *OpenPeriodRequest, opts ...grpc.CallOption) (*FiscalPeriod, error)
+	ClosePeriod(ctx context.Context, in *ClosePeriodRequest, opts ...grpc.CallOption) (*FiscalPeriod, error)
+	// Posts the closing entry into retained earnings and closes every period,
+	// atomically: a failed close leaves the year as it was.
+	CloseFiscalYear(ctx context.Context, in *CloseFiscalYearRequest, opts ...grpc.CallOption) (*CloseFiscalYearResponse, error)
+}
+
+type fiscalPeriodServiceClient struct {
+	cc grpc.ClientConnInterface
 }
 
-func RegisterLedgerServiceServer(s grpc.ServiceRegistrar, srv LedgerServiceServer) {
-	// If the following call pancis, it indicates UnimplementedLedgerServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&LedgerService_ServiceDesc, srv)
+func NewFiscalPeriodServiceClient(cc grpc.ClientConnInterface) FiscalPeriodServiceClient {
+	return &fiscalPeriodServiceClient{cc}
 }
 
-func _LedgerService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
-	if err := dec(in); err != nil {
+func (c *fiscalPeriodServiceClient) SetFiscalCalendar(ctx context.Context, in *SetFiscalCalendarRequest, opts ...grpc.CallOption) (*FiscalCalendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FiscalCalendar)
+	err := c.cc.Invoke(ctx, FiscalPeriodService_SetFiscalCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LedgerServiceServer).CreateAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: LedgerService_CreateAccount_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _LedgerService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountRequest)
-	if err := dec(in); err != nil {
+func (c *fiscalPeriodServiceClient) CreateFiscalYear(ctx context.Context, in *CreateFiscalYearRequest, opts ...grpc.CallOption) (*ListFiscalPeriodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFiscalPeriodsResponse)
+	err := c.cc.Invoke(ctx, FiscalPeriodService_CreateFiscalYear_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LedgerServiceServer).GetAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: LedgerService_GetAccount_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _LedgerService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAccountRequest)
-	if err := dec(in); err != nil {
+func (c *fiscalPeriodServiceClient) ListFiscalPeriods(ctx context.Context, in *ListFiscalPeriodsRequest, opts ...grpc.CallOption) (*ListFiscalPeriodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFiscalPeriodsResponse)
+	err := c.cc.Invoke(ctx, FiscalPeriodService_ListFiscalPeriods_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LedgerServiceServer).UpdateAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: LedgerService_UpdateAccount_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _LedgerService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAccountRequest)
-	if err := dec(in); err != nil {
+func (c *fiscalPeriodServiceClient) OpenPeriod(ctx context.Context, in *OpenPeriodRequest, opts ...grpc.CallOption) (*FiscalPeriod, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FiscalPeriod)
+	err := c.cc.Invoke(ctx, FiscalPeriodService_OpenPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LedgerServiceServer).DeleteAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: LedgerService_DeleteAccount_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _LedgerService_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAccountsRequest)
-	if err := dec(in); err != nil {
+func (c *fiscalPeriodServiceClient) ClosePeriod(ctx context.Context, in *ClosePeriodRequest, opts ...grpc.CallOption) (*FiscalPeriod, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FiscalPeriod)
+	err := c.cc.Invoke(ctx, FiscalPeriodService_ClosePeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(LedgerServiceServer).ListAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: LedgerService_ListAccounts_FullMethodName,
+	return out, nil
+}
+
+func (c *fiscalPeriodServiceClient) CloseFiscalYear(ctx context.Context, in *CloseFiscalYearRequest, opts ...grpc.CallOption) (*CloseFiscalYearResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseFiscalYearResponse)
+	err := c.cc.Invoke(ctx, FiscalPeriodService_CloseFiscalYear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	return out, nil
+}
+
+// FiscalPeriodServiceServer is the server API for FiscalPeriodService service.
+// All implementations must embed UnimplementedFiscalPeriodServiceServer
+// for forward compatibility.
+type FiscalPeriodServiceServer interface {
+	SetFiscalCalendar(context.Context, *SetFiscalCalendarRequest) (*FiscalCalendar, error)
+	// Generates the twelve monthly periods; idempotent.
+	CreateFiscalYear(context.Context, *CreateFiscalYearRequest) (*ListFiscalPeriodsResponse, error)
+	ListFiscalPeriods(context.Context, *ListFiscalPeriodsRequest) (*ListFiscalPeriodsResponse, error)
+	// Periods of a closed fiscal year cannot be reopened or soft-closed
+	// (FAILED_PRECONDITION).
+	OpenPeriod(context.Context, *OpenPeriodRequest) (*FiscalPeriod, error)
+	ClosePeriod(context.Context, *ClosePeriodRequest) (*FiscalPeriod, error)
+	// Posts the closing entry into retained earnings and closes every period,
+	// atomically: a failed close leaves the year as it was.
+	CloseFiscalYear(context.Context, *CloseFiscalYearRequest) (*CloseFiscalYearResponse, error)
+	mustEmbedUnimplementedFiscalPeriodServiceServer()
+}
+
+// UnimplementedFiscalPeriodServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFiscalPeriodServiceServer struct{}
+
+func (UnimplementedFiscalPeriodServiceServer) SetFiscalCalendar(context.Context, *SetFiscalCalendarRequest) (*FiscalCalendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFiscalCalendar not implemented")
+}
+func (UnimplementedFiscalPeriodServiceServer) CreateFiscalYear(context.Context, *CreateFiscalYearRequest) (*ListFiscalPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFiscalYear not implemented")
+}
+func (UnimplementedFiscalPeriodServiceServer) ListFiscalPeriods(context.Context, *ListFiscalPeriodsRequest) (*ListFiscalPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFiscalPeriods not implemented")
+}
+func (UnimplementedFiscalPeriodServiceServer) OpenPeriod(context.Context, *OpenPeriodRequest) (*FiscalPeriod, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenPeriod not implemented")
+}
+func (UnimplementedFiscalPeriodServiceServer) ClosePeriod(context.Context, *ClosePeriodRequest) (*FiscalPeriod, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePeriod not implemented")
+}
+func (UnimplementedFiscalPeriodServiceServer) CloseFiscalYear(context.Context, *CloseFiscalYearRequest) (*CloseFiscalYearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseFiscalYear not implemented")
+}
+func (UnimplementedFiscalPeriodServiceServer) mustEmbedUnimplementedFiscalPeriodServiceServer() {}
+func (UnimplementedFiscalPeriodServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeFiscalPeriodServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FiscalPeriodServiceServer will
+// result in compilation errors.
+type UnsafeFiscalPeriodServiceServer interface {
+	mustEmbedUnimplementedFiscalPeriodServiceServer()
+}
+
+func RegisterFiscalPeriodServiceServer(s grpc.ServiceRegistrar, srv FiscalPeriodServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFiscalPeriodServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
 	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&FiscalPeriodService_ServiceDesc, srv)
 }
 
-func _LedgerService_CreateJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateJournalEntryRequest)
+func _FiscalPeriodService_SetFiscalCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFiscalCalendarRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).CreateJournalEntry(ctx, in)
+		return srv.(FiscalPeriodServiceServer).SetFiscalCalendar(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_CreateJournalEntry_FullMethodName,
+		FullMethod: FiscalPeriodService_SetFiscalCalendar_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).CreateJournalEntry(ctx, req.(*CreateJournalEntryRequest))
+		return srv.(FiscalPeriodServiceServer).SetFiscalCalendar(ctx, req.(*SetFiscalCalendarRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_GetJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetJournalEntryRequest)
+func _FiscalPeriodService_CreateFiscalYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFiscalYearRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).GetJournalEntry(ctx, in)
+		return srv.(FiscalPeriodServiceServer).CreateFiscalYear(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_GetJournalEntry_FullMethodName,
+		FullMethod: FiscalPeriodService_CreateFiscalYear_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).GetJournalEntry(ctx, req.(*GetJournalEntryRequest))
+		return srv.(FiscalPeriodServiceServer).CreateFiscalYear(ctx, req.(*CreateFiscalYearRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_UpdateJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateJournalEntryRequest)
+func _FiscalPeriodService_ListFiscalPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFiscalPeriodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).UpdateJournalEntry(ctx, in)
+		return srv.(FiscalPeriodServiceServer).ListFiscalPeriods(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_UpdateJournalEntry_FullMethodName,
+		FullMethod: FiscalPeriodService_ListFiscalPeriods_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).UpdateJournalEntry(ctx, req.(*UpdateJournalEntryRequest))
+		return srv.(FiscalPeriodServiceServer).ListFiscalPeriods(ctx, req.(*ListFiscalPeriodsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_DeleteJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteJournalEntryRequest)
+func _FiscalPeriodService_OpenPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenPeriodRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).DeleteJournalEntry(ctx, in)
+		return srv.(FiscalPeriodServiceServer).OpenPeriod(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_DeleteJournalEntry_FullMethodName,
+		FullMethod: FiscalPeriodService_OpenPeriod_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).DeleteJournalEntry(ctx, req.(*DeleteJournalEntryRequest))
+		return srv.(FiscalPeriodServiceServer).OpenPeriod(ctx, req.(*OpenPeriodRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_ListJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListJournalEntriesRequest)
+func _FiscalPeriodService_ClosePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePeriodRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).ListJournalEntries(ctx, in)
+		return srv.(FiscalPeriodServiceServer).ClosePeriod(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_ListJournalEntries_FullMethodName,
+		FullMethod: FiscalPeriodService_ClosePeriod_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).ListJournalEntries(ctx, req.(*ListJournalEntriesRequest))
+		return srv.(FiscalPeriodServiceServer).ClosePeriod(ctx, req.(*ClosePeriodRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_ListLedgerEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLedgerEntriesRequest)
+func _FiscalPeriodService_CloseFiscalYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseFiscalYearRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).ListLedgerEntries(ctx, in)
+		return srv.(FiscalPeriodServiceServer).CloseFiscalYear(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_ListLedgerEntries_FullMethodName,
+		FullMethod: FiscalPeriodService_CloseFiscalYear_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).ListLedgerEntries(ctx, req.(*ListLedgerEntriesRequest))
+		return srv.(FiscalPeriodServiceServer).CloseFiscalYear(ctx, req.(*CloseFiscalYearRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// LedgerService_ServiceDesc is the grpc.ServiceDesc for LedgerService service.
+// FiscalPeriodService_ServiceDesc is the grpc.ServiceDesc for FiscalPeriodService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var LedgerService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "finance.LedgerService",
-	HandlerType: (*LedgerServiceServer)(nil),
+var FiscalPeriodService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.FiscalPeriodService",
+	HandlerType: (*FiscalPeriodServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateAccount",
-			Handler:    _LedgerService_CreateAccount_Handler,
-		},
-		{
-			MethodName: "GetAccount",
-			Handler:    _LedgerService_GetAccount_Handler,
-		},
-		{
-			MethodName: "UpdateAccount",
-			Handler:    _LedgerService_UpdateAccount_Handler,
-		},
-		{
-			MethodName: "DeleteAccount",
-			Handler:    _LedgerService_DeleteAccount_Handler,
-		},
-		{
-			MethodName: "ListAccounts",
-			Handler:    _LedgerService_ListAccounts_Handler,
-		},
-		{
-			MethodName: "CreateJournalEntry",
-			Handler:    _LedgerService_CreateJournalEntry_Handler,
+			MethodName: "SetFiscalCalendar",
+			Handler:    _FiscalPeriodService_SetFiscalCalendar_Handler,
 		},
 		{
-			MethodName: "GetJournalEntry",
-			Handler:    _LedgerService_GetJournalEntry_Handler,
+			MethodName: "CreateFiscalYear",
+			Handler:    _FiscalPeriodService_CreateFiscalYear_Handler,
 		},
 		{
-			MethodName: "UpdateJournalEntry",
-			Handler:    _LedgerService_UpdateJournalEntry_Handler,
+			MethodName: "ListFiscalPeriods",
+			Handler:    _FiscalPeriodService_ListFiscalPeriods_Handler,
 		},
 		{
-			MethodName: "DeleteJournalEntry",
-			Handler:    _LedgerService_DeleteJournalEntry_Handler,
+			MethodName: "OpenPeriod",
+			Handler:    _FiscalPeriodService_OpenPeriod_Handler,
 		},
 		{
-			MethodName: "ListJournalEntries",
-			Handler:    _LedgerService_ListJournalEntries_Handler,
+			MethodName: "ClosePeriod",
+			Handler:    _FiscalPeriodService_ClosePeriod_Handler,
 		},
 		{
-			MethodName: "ListLedgerEntries",
-			Handler:    _LedgerService_ListLedgerEntries_Handler,
+			MethodName: "CloseFiscalYear",
+			Handler:    _FiscalPeriodService_CloseFiscalYear_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -4213,12 +6502,15 @@ var ConsolidationService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	FxService_CreateExchangeRate_FullMethodName = "/finance.FxService/CreateExchangeRate"
-	FxService_GetExchangeRate_FullMethodName    = "/finance.FxService/GetExchangeRate"
-	FxService_UpdateExchangeRate_FullMethodName = "/finance.FxService/UpdateExchangeRate"
-	FxService_DeleteExchangeRate_FullMethodName = "/finance.FxService/DeleteExchangeRate"
-	FxService_ListExchangeRates_FullMethodName  = "/finance.FxService/ListExchangeRates"
-	FxService_ConvertMoney_FullMethodName       = "/finance.FxService/ConvertMoney"
+	FxService_CreateExchangeRate_FullMethodName       = "/finance.FxService/CreateExchangeRate"
+	FxService_GetExchangeRate_FullMethodName          = "/finance.FxService/GetExchangeRate"
+	FxService_UpdateExchangeRate_FullMethodName       = "/finance.FxService/UpdateExchangeRate"
+	FxService_DeleteExchangeRate_FullMethodName       = "/finance.FxService/DeleteExchangeRate"
+	FxService_ListExchangeRates_FullMethodName        = "/finance.FxService/ListExchangeRates"
+	FxService_ConvertMoney_FullMethodName             = "/finance.FxService/ConvertMoney"
+	FxService_SetFxRevaluationSettings_FullMethodName = "/finance.FxService/SetFxRevaluationSettings"
+	FxService_GetFxRevaluationSettings_FullMethodName = "/finance.FxService/GetFxRevaluationSettings"
+	FxService_RevalueForeignCurrency_FullMethodName   = "/finance.FxService/RevalueForeignCurrency"
 )
 
 // FxServiceClient is the client API for FxService service.
@@ -4231,6 +6523,10 @@ type FxServiceClient interface {
 	DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error)
 	ConvertMoney(ctx context.Context, in *ConvertMoneyRequest, opts ...grpc.CallOption) (*ConvertMoneyResponse, error)
+	SetFxRevaluationSettings(ctx context.Context, in *SetFxRevaluationSettingsRequest, opts ...grpc.CallOption) (*FxRevaluationSettings, error)
+	GetFxRevaluationSettings(ctx context.Context, in *GetFxRevaluationSettingsRequest, opts ...grpc.CallOption) (*FxRevaluationSettings, error)
+	// Drafts the unrealized gain/loss journal for open foreign-currency items.
+	RevalueForeignCurrency(ctx context.Context, in *RevalueForeignCurrencyRequest, opts ...grpc.CallOption) (*RevalueForeignCurrencyResponse, error)
 }
 
 type fxServiceClient struct {
@@ -4301,6 +6597,36 @@ func (c *fxServiceClient) ConvertMoney(ctx context.Context, in *ConvertMoneyRequ
 	return out, nil
 }
 
+func (c *fxServiceClient) SetFxRevaluationSettings(ctx context.Context, in *SetFxRevaluationSettingsRequest, opts ...grpc.CallOption) (*FxRevaluationSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FxRevaluationSettings)
+	err := c.cc.Invoke(ctx, FxService_SetFxRevaluationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fxServiceClient) GetFxRevaluationSettings(ctx context.Context, in *GetFxRevaluationSettingsRequest, opts ...grpc.CallOption) (*FxRevaluationSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FxRevaluationSettings)
+	err := c.cc.Invoke(ctx, FxService_GetFxRevaluationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fxServiceClient) RevalueForeignCurrency(ctx context.Context, in *RevalueForeignCurrencyRequest, opts ...grpc.CallOption) (*RevalueForeignCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevalueForeignCurrencyResponse)
+	err := c.cc.Invoke(ctx, FxService_RevalueForeignCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FxServiceServer is the server API for FxService service.
 // All implementations must embed UnimplementedFxServiceServer
 // for forward compatibility.
@@ -4311,6 +6637,10 @@ type FxServiceServer interface {
 	DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*emptypb.Empty, error)
 	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
 	ConvertMoney(context.Context, *ConvertMoneyRequest) (*ConvertMoneyResponse, error)
+	SetFxRevaluationSettings(context.Context, *SetFxRevaluationSettingsRequest) (*FxRevaluationSettings, error)
+	GetFxRevaluationSettings(context.Context, *GetFxRevaluationSettingsRequest) (*FxRevaluationSettings, error)
+	// Drafts the unrealized gain/loss journal for open foreign-currency items.
+	RevalueForeignCurrency(context.Context, *RevalueForeignCurrencyRequest) (*RevalueForeignCurrencyResponse, error)
 	mustEmbedUnimplementedFxServiceServer()
 }
 
@@ -4339,6 +6669,15 @@ func (UnimplementedFxServiceServer) ListExchangeRates(context.Context, *ListExch
 func (UnimplementedFxServiceServer) ConvertMoney(context.Context, *ConvertMoneyRequest) (*ConvertMoneyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertMoney not implemented")
 }
+func (UnimplementedFxServiceServer) SetFxRevaluationSettings(context.Context, *SetFxRevaluationSettingsRequest) (*FxRevaluationSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFxRevaluationSettings not implemented")
+}
+func (UnimplementedFxServiceServer) GetFxRevaluationSettings(context.Context, *GetFxRevaluationSettingsRequest) (*FxRevaluationSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFxRevaluationSettings not implemented")
+}
+func (UnimplementedFxServiceServer) RevalueForeignCurrency(context.Context, *RevalueForeignCurrencyRequest) (*RevalueForeignCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevalueForeignCurrency not implemented")
+}
 func (UnimplementedFxServiceServer) mustEmbedUnimplementedFxServiceServer() {}
 func (UnimplementedFxServiceServer) testEmbeddedByValue()                   {}
 
@@ -4468,6 +6807,60 @@ func _FxService_ConvertMoney_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FxService_SetFxRevaluationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFxRevaluationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FxServiceServer).SetFxRevaluationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FxService_SetFxRevaluationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FxServiceServer).SetFxRevaluationSettings(ctx, req.(*SetFxRevaluationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FxService_GetFxRevaluationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFxRevaluationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FxServiceServer).GetFxRevaluationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FxService_GetFxRevaluationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FxServiceServer).GetFxRevaluationSettings(ctx, req.(*GetFxRevaluationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FxService_RevalueForeignCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevalueForeignCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FxServiceServer).RevalueForeignCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FxService_RevalueForeignCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FxServiceServer).RevalueForeignCurrency(ctx, req.(*RevalueForeignCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FxService_ServiceDesc is the grpc.ServiceDesc for FxService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -4499,6 +6892,18 @@ var FxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertMoney",
 			Handler:    _FxService_ConvertMoney_Handler,
 		},
+		{
+			MethodName: "SetFxRevaluationSettings",
+			Handler:    _FxService_SetFxRevaluationSettings_Handler,
+		},
+		{
+			MethodName: "GetFxRevaluationSettings",
+			Handler:    _FxService_GetFxRevaluationSettings_Handler,
+		},
+		{
+			MethodName: "RevalueForeignCurrency",
+			Handler:    _FxService_RevalueForeignCurrency_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finance.proto",
@@ -0,0 +1,83 @@
+// Command importjournals sends a CSV or XLSX journal file to the
+// ImportJournalEntries RPC and prints the validation report.
+//
+//	importjournals -file opening.xlsx -org acme -dry-run
+//
+// It exits non-zero when the file has errors or was not imported.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "gRPC server address")
+	file := flag.String("file", "", "CSV or XLSX file to import")
+	org := flag.String("org", "", "organization id")
+	user := flag.String("user", "", "user recorded as the creator of the entries")
+	dryRun := flag.Bool("dry-run", false, "validate and report without importing")
+	post := flag.Bool("post", false, "create the entries POSTED instead of DRAFT")
+	timeout := flag.Duration("timeout", 5*time.Minute, "request timeout")
+	flag.Parse()
+
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	content, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatalf("read %s: %v", *file, err)
+	}
+
+	conn, err := grpc.NewClient(*addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(len(content)+1<<20)),
+	)
+	if err != nil {
+		log.Fatalf("connect to %s: %v", *addr, err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	if *user != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "user-id", *user)
+	}
+
+	resp, err := pb.NewLedgerServiceClient(conn).ImportJournalEntries(ctx, &pb.ImportJournalEntriesRequest{
+		Meta:     &pb.RequestMetadata{OrganizationId: *org},
+		Filename: filepath.Base(*file),
+		Content:  content,
+		DryRun:   *dryRun,
+		Post:     *post,
+	})
+	if err != nil {
+		log.Fatalf("import: %v", err)
+	}
+
+	for _, e := range resp.Errors {
+		fmt.Printf("row %d\t%s\t%s\t%s\n", e.Row, e.Reference, e.Field, e.Message)
+	}
+	fmt.Printf("%d entries, %d lines, %d errors", resp.Entries, resp.Lines, len(resp.Errors))
+	switch {
+	case resp.Committed:
+		fmt.Println(", imported")
+	case *dryRun && len(resp.Errors) == 0:
+		fmt.Println(", dry run ok")
+	default:
+		fmt.Println(", nothing imported")
+		os.Exit(1)
+	}
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"net"
+	"time"
 
 	_ "github.com/lib/pq"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/kafka"
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/repository"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/ubl"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/ports/grpc_server"
 
@@ -41,35 +44,77 @@ func main() {
 	budgetRepo := repository.NewBudgetRepository(conn)
 	CashFlowRepo := repository.NewCashFlowForecastRepo(conn)
 	ConsolidationRepo :=repository.NewConsolidationRepo(queries)
-	CreditDebitNoteRepo := repository.NewCreditDebitNoteRepo(queries)
+	CreditDebitNoteRepo := repository.NewCreditDebitNoteRepo(conn, queries)
 	ExchangeRateRepo := repository.NewExchangeRateRepo(queries)
+	fiscalRepo := repository.NewFiscalPeriodRepository(conn, queries)
+	fxRevalRepo := repository.NewFXRevaluationRepo(queries)
+	templateRepo := repository.NewJournalTemplateRepository(conn, queries)
+	financeEventRepo := repository.NewFinanceEventRepo(queries)
+	invoiceRepo := repository.NewInvoiceRepo(conn, queries)
+	dunningRepo := repository.NewDunningRepository(conn, queries)
+	recurringInvoiceRepo := repository.NewRecurringInvoiceRepository(conn, queries)
+	partyRepo := repository.NewPartyRepository(conn, queries)
+	creditRepo := repository.NewCreditRepository(queries)
 
 	// ---------------- Services ----------------
+	periodGuard := services.NewPeriodGuard(fiscalRepo)
+	ExchangeRateSvc := services.NewExchangeRateService(ExchangeRateRepo, kpub)
+	// Organizations without a functional currency on their fiscal calendar keep their books in INR.
+	fxConverter := services.NewFXConverter(fiscalRepo, ExchangeRateSvc, "INR")
 	accSvc := services.NewAccountService(accRepo, kpub)
-	journalSvc := services.NewJournalService(jrRepo, kpub)
-	ledgerSvc := services.NewLedgerService(ldRepo)
-	accrualSvc := services.NewAccrualService(accrualRepo, kpub, "accruals")
+	journalSvc := services.NewJournalService(jrRepo, accRepo, periodGuard, fxConverter, kpub)
+	ledgerSvc := services.NewLedgerService(ldRepo, accRepo, fxConverter)
+	accrualSvc := services.NewAccrualService(accrualRepo, periodGuard, kpub, "accruals")
 	allocationSvc := services.NewAllocationService(allocationRepo, kpub, "allocation")
 	auditSvc := services.NewAuditService(auditRepo, kpub)
 	budgetSvc := services.NewBudgetService(budgetRepo, kpub)
 	CashFlowSvc := services.NewCashFlowService(CashFlowRepo, kpub)
 	ConsolidationSvc := services.NewConsolidationService(ConsolidationRepo, kpub)
-	CreditDebitNoteSvc := services.NewCreditDebitNoteService(CreditDebitNoteRepo, kpub)
-	ExchangeRateSvc := services.NewExchangeRateService(ExchangeRateRepo, kpub)
+	fiscalSvc := services.NewFiscalPeriodService(fiscalRepo, journalSvc)
+	templateSvc := services.NewJournalTemplateService(templateRepo, journalSvc)
+	importSvc := services.NewJournalImportService(journalSvc)
+	fxRevalSvc := services.NewFXRevaluationService(fxRevalRepo, fiscalRepo, fxConverter, journalSvc)
+	financeEventSvc := services.NewFinanceEventService(financeEventRepo, kpub)
+	// Invoices without a currency are in INR, rounded half up to paise.
+	creditSvc := services.NewCreditService(creditRepo, partyRepo, kpub)
+	invoiceSvc := services.NewInvoiceService(invoiceRepo, financeEventSvc, periodGuard, fiscalRepo, services.NewInvoiceCalculator("INR", nil), journalSvc, kpub, creditSvc, partyRepo)
+	CreditDebitNoteSvc := services.NewCreditDebitNoteService(CreditDebitNoteRepo, invoiceSvc, kpub)
+	dunningSvc := services.NewDunningService(dunningRepo, invoiceSvc, auditSvc, kpub)
+	recurringInvoiceSvc := services.NewRecurringInvoiceService(recurringInvoiceRepo, invoiceSvc)
+	partySvc := services.NewPartyService(partyRepo)
+	einvoiceSvc := services.NewEInvoiceService(invoiceSvc, partySvc)
+
+	// ---------------- E-invoice Schemas ----------------
+	// E-invoices are validated against the UBL 2.1 XSD and the PEPPOL BIS
+	// Schematron installed under schemas/ (see schemas/README.md). Without
+	// them the server still runs, but every e-invoice export and import fails.
+	einvoiceSchemas, err := ubl.LoadSchemas("schemas", "java -jar /opt/saxon/saxon-he.jar -xsl:{xsl} -s:{}")
+	if err != nil {
+		log.Printf("e-invoicing unavailable: %v", err)
+		einvoiceSchemas = []ubl.Checker{ubl.Unavailable(err)}
+	}
 
 	// ---------------- gRPC Handlers ----------------
-	ledgerHandler := grpc_server.NewLedgerHandler(accSvc, journalSvc, ledgerSvc)
+	ledgerHandler := grpc_server.NewLedgerHandler(accSvc, journalSvc, ledgerSvc, templateSvc, importSvc)
 	accrualHandler := grpc_server.NewAccrualHandler(accrualSvc)
 	allocationHandler := grpc_server.NewAllocationHandler(allocationSvc, kpub)
 	auditHandler := grpc_server.NewAuditHandler(auditSvc, kpub)
 	budgetHandler := grpc_server.NewBudgetHandler(budgetSvc)
+	budgetAllocationHandler := grpc_server.NewBudgetAllocationHandler(budgetSvc)
 	CashFlowHandler := grpc_server.NewCashFlowGRPCServer(CashFlowSvc)
 	ConsolidationHandler := grpc_server.NewConsolidationHandler(ConsolidationSvc)
 	CreditDebitNoteHandler := grpc_server.NewGRPCServer(CreditDebitNoteSvc)
-	ExchangeRateHandler := grpc_server.NewExchangeRateHandler(ExchangeRateSvc)
+	ExchangeRateHandler := grpc_server.NewExchangeRateHandler(ExchangeRateSvc, fxRevalSvc)
+	fiscalHandler := grpc_server.NewFiscalPeriodHandler(fiscalSvc)
+	invoiceHandler := grpc_server.NewInvoiceGRPCHandler(invoiceSvc, einvoiceSvc, einvoiceSchemas...)
+	dunningHandler := grpc_server.NewDunningHandler(dunningSvc)
+	recurringInvoiceHandler := grpc_server.NewRecurringInvoiceHandler(recurringInvoiceSvc)
+	partyHandler := grpc_server.NewPartyHandler(partySvc)
+	creditHandler := grpc_server.NewCreditHandler(creditSvc)
 
 	// ---------------- gRPC Server ----------------
-	grpcServer := grpc.NewServer()
+	// Journal imports upload whole spreadsheets, well past the 4 MB default.
+	grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(32 << 20))
 
 	// Register services
 	pb.RegisterLedgerServiceServer(grpcServer, ledgerHandler)
@@ -77,10 +122,24 @@ func main() {
 	pb.RegisterAllocationAutomationServiceServer(grpcServer, allocationHandler)
 	pb.RegisterAuditTrailServiceServer(grpcServer, auditHandler)
 	pb.RegisterBudgetServiceServer(grpcServer, budgetHandler)
+	pb.RegisterBudgetAllocationServiceServer(grpcServer, budgetAllocationHandler)
 	pb.RegisterCashFlowServiceServer(grpcServer, CashFlowHandler)
 	pb.RegisterConsolidationServiceServer(grpcServer, ConsolidationHandler)
 	pb.RegisterCreditDebitNoteServiceServer(grpcServer, CreditDebitNoteHandler)
 	pb.RegisterFxServiceServer(grpcServer, ExchangeRateHandler)
+	pb.RegisterFiscalPeriodServiceServer(grpcServer, fiscalHandler)
+	pb.RegisterInvoiceServiceServer(grpcServer, invoiceHandler)
+	pb.RegisterDunningServiceServer(grpcServer, dunningHandler)
+	pb.RegisterRecurringInvoiceServiceServer(grpcServer, recurringInvoiceHandler)
+	pb.RegisterPartyServiceServer(grpcServer, partyHandler)
+	pb.RegisterCreditControlServiceServer(grpcServer, creditHandler)
+
+	// ---------------- Background Jobs ----------------
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go templateSvc.Run(ctx, time.Hour)
+	go dunningSvc.Run(ctx, time.Hour)
+	go recurringInvoiceSvc.Run(ctx, time.Hour)
 
 	// Listen on port 50051
 	lis, err := net.Listen("tcp", ":50051")
@@ -11,8 +11,155 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+const accumulateAccountPeriodBalances = `-- name: AccumulateAccountPeriodBalances :exec
+INSERT INTO account_period_balances (organization_id, account_id, period_start, debit_total, credit_total)
+SELECT COALESCE(je.organization_id, ''),
+       jl.account_id,
+       date_trunc('month', je.journal_date)::date,
+       SUM(CASE WHEN jl.side = 'DEBIT' THEN jl.amount ELSE 0 END),
+       SUM(CASE WHEN jl.side = 'CREDIT' THEN jl.amount ELSE 0 END)
+FROM journal_lines jl
+JOIN journal_entries je ON je.id = jl.journal_id
+WHERE je.id = $1
+GROUP BY COALESCE(je.organization_id, ''), jl.account_id, date_trunc('month', je.journal_date)::date
+ON CONFLICT (organization_id, account_id, period_start) DO UPDATE
+SET debit_total = account_period_balances.debit_total + EXCLUDED.debit_total,
+    credit_total = account_period_balances.credit_total + EXCLUDED.credit_total,
+    updated_at = now()
+`
+
+// Adds a posted journal's lines to the monthly balance of each account in
+// the journal's organization.
+func (q *Queries) AccumulateAccountPeriodBalances(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, accumulateAccountPeriodBalances, id)
+	return err
+}
+
+const accumulateAccountPeriodBalancesForJournals = `-- name: AccumulateAccountPeriodBalancesForJournals :exec
+INSERT INTO account_period_balances (organization_id, account_id, period_start, debit_total, credit_total)
+SELECT COALESCE(je.organization_id, ''),
+       jl.account_id,
+       date_trunc('month', je.journal_date)::date,
+       SUM(CASE WHEN jl.side = 'DEBIT' THEN jl.amount ELSE 0 END),
+       SUM(CASE WHEN jl.side = 'CREDIT' THEN jl.amount ELSE 0 END)
+FROM journal_lines jl
+JOIN journal_entries je ON je.id = jl.journal_id
+WHERE je.id = ANY($1::uuid[])
+GROUP BY COALESCE(je.organization_id, ''), jl.account_id, date_trunc('month', je.journal_date)::date
+ON CONFLICT (organization_id, account_id, period_start) DO UPDATE
+SET debit_total = account_period_balances.debit_total + EXCLUDED.debit_total,
+    credit_total = account_period_balances.credit_total + EXCLUDED.credit_total,
+    updated_at = now()
+`
+
+// Bulk form of AccumulateAccountPeriodBalances for many journals at once.
+func (q *Queries) AccumulateAccountPeriodBalancesForJournals(ctx context.Context, journalIds []uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, accumulateAccountPeriodBalancesForJournals, pq.Array(journalIds))
+	return err
+}
+
+const bulkInsertJournalEntries = `-- name: BulkInsertJournalEntries :exec
+INSERT INTO journal_entries (
+    id, journal_date, reference, memo, source_type, created_by, updated_by,
+    status, posted_at, posted_by, organization_id
+)
+SELECT u.id, u.journal_date, NULLIF(u.reference, ''), NULLIF(u.memo, ''), NULLIF(u.source_type, ''),
+       NULLIF(u.created_by, ''), NULLIF(u.created_by, ''), u.status,
+       CASE WHEN u.status = 'POSTED' THEN now() END,
+       CASE WHEN u.status = 'POSTED' THEN NULLIF(u.created_by, '') END,
+       NULLIF(u.organization_id, '')
+FROM (
+    SELECT unnest($1::uuid[]) AS id,
+           unnest($2::timestamp[]) AS journal_date,
+           unnest($3::text[]) AS reference,
+           unnest($4::text[]) AS memo,
+           unnest($5::text[]) AS source_type,
+           unnest($6::text[]) AS status,
+           unnest($7::text[]) AS created_by,
+           unnest($8::text[]) AS organization_id
+) u
+`
+
+type BulkInsertJournalEntriesParams struct {
+	Ids             []uuid.UUID
+	JournalDates    []time.Time
+	Refs            []string
+	Memos           []string
+	SourceTypes     []string
+	Statuses        []string
+	CreatedBys      []string
+	OrganizationIds []string
+}
+
+// Inserts many entries in one statement. IDs are assigned by the caller so
+// the lines can reference them; empty strings are stored as NULL.
+func (q *Queries) BulkInsertJournalEntries(ctx context.Context, arg BulkInsertJournalEntriesParams) error {
+	_, err := q.db.ExecContext(ctx, bulkInsertJournalEntries,
+		pq.Array(arg.Ids),
+		pq.Array(arg.JournalDates),
+		pq.Array(arg.Refs),
+		pq.Array(arg.Memos),
+		pq.Array(arg.SourceTypes),
+		pq.Array(arg.Statuses),
+		pq.Array(arg.CreatedBys),
+		pq.Array(arg.OrganizationIds),
+	)
+	return err
+}
+
+const bulkInsertJournalLines = `-- name: BulkInsertJournalLines :exec
+INSERT INTO journal_lines (
+    journal_id, account_id, side, amount, cost_center_id, description,
+    currency_code, transaction_amount, exchange_rate, functional_currency
+)
+SELECT u.journal_id, u.account_id, u.side, u.amount, NULLIF(u.cost_center_id, '')::uuid, NULLIF(u.description, ''),
+       u.currency_code, u.transaction_amount, u.exchange_rate, u.functional_currency
+FROM (
+    SELECT unnest($1::uuid[]) AS journal_id,
+           unnest($2::uuid[]) AS account_id,
+           unnest($3::text[]) AS side,
+           unnest($4::numeric[]) AS amount,
+           unnest($5::text[]) AS cost_center_id,
+           unnest($6::text[]) AS description,
+           unnest($7::text[]) AS currency_code,
+           unnest($8::numeric[]) AS transaction_amount,
+           unnest($9::numeric[]) AS exchange_rate,
+           unnest($10::text[]) AS functional_currency
+) u
+`
+
+type BulkInsertJournalLinesParams struct {
+	JournalIds           []uuid.UUID
+	AccountIds           []uuid.UUID
+	Sides                []string
+	Amounts              []string
+	CostCenterIds        []string
+	Descriptions         []string
+	CurrencyCodes        []string
+	TransactionAmounts   []string
+	ExchangeRates        []string
+	FunctionalCurrencies []string
+}
+
+func (q *Queries) BulkInsertJournalLines(ctx context.Context, arg BulkInsertJournalLinesParams) error {
+	_, err := q.db.ExecContext(ctx, bulkInsertJournalLines,
+		pq.Array(arg.JournalIds),
+		pq.Array(arg.AccountIds),
+		pq.Array(arg.Sides),
+		pq.Array(arg.Amounts),
+		pq.Array(arg.CostCenterIds),
+		pq.Array(arg.Descriptions),
+		pq.Array(arg.CurrencyCodes),
+		pq.Array(arg.TransactionAmounts),
+		pq.Array(arg.ExchangeRates),
+		pq.Array(arg.FunctionalCurrencies),
+	)
+	return err
+}
+
 const createAccount = `-- name: CreateAccount :one
 
 INSERT INTO accounts (code, name, type, parent_id, status, allow_manual_journal, created_by, updated_by)
@@ -65,19 +212,27 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 
 const createJournalEntry = `-- name: CreateJournalEntry :one
 
-INSERT INTO journal_entries (journal_date, reference, memo, source_type, source_id, created_by, updated_by)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, journal_date, reference, memo, source_type, source_id, created_at, created_by, updated_at, updated_by, revision
+INSERT INTO journal_entries (
+    journal_date, reference, memo, source_type, source_id, created_by, updated_by,
+    status, posted_at, posted_by, reversal_of, organization_id, auto_reverse_date
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING id, journal_date, reference, memo, source_type, source_id, created_at, created_by, updated_at, updated_by, revision, status, posted_at, posted_by, reversal_of, reversed_by, organization_id, auto_reverse_date
 `
 
 type CreateJournalEntryParams struct {
-	JournalDate time.Time
-	Reference   sql.NullString
-	Memo        sql.NullString
-	SourceType  sql.NullString
-	SourceID    sql.NullString
-	CreatedBy   sql.NullString
-	UpdatedBy   sql.NullString
+	JournalDate     time.Time
+	Reference       sql.NullString
+	Memo            sql.NullString
+	SourceType      sql.NullString
+	SourceID        sql.NullString
+	CreatedBy       sql.NullString
+	UpdatedBy       sql.NullString
+	Status          string
+	PostedAt        sql.NullTime
+	PostedBy        sql.NullString
+	ReversalOf      uuid.NullUUID
+	OrganizationID  sql.NullString
+	AutoReverseDate sql.NullTime
 }
 
 // =====================================================
@@ -92,6 +247,12 @@ func (q *Queries) CreateJournalEntry(ctx context.Context, arg CreateJournalEntry
 		arg.SourceID,
 		arg.CreatedBy,
 		arg.UpdatedBy,
+		arg.Status,
+		arg.PostedAt,
+		arg.PostedBy,
+		arg.ReversalOf,
+		arg.OrganizationID,
+		arg.AutoReverseDate,
 	)
 	var i JournalEntry
 	err := row.Scan(
@@ -106,24 +267,36 @@ func (q *Queries) CreateJournalEntry(ctx context.Context, arg CreateJournalEntry
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.Status,
+		&i.PostedAt,
+		&i.PostedBy,
+		&i.ReversalOf,
+		&i.ReversedBy,
+		&i.OrganizationID,
+		&i.AutoReverseDate,
 	)
 	return i, err
 }
 
 const createJournalLine = `-- name: CreateJournalLine :one
 INSERT INTO journal_lines (
-    journal_id, account_id, side, amount, cost_center_id, description
-) VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, journal_id, account_id, side, amount, cost_center_id, description, created_at
+    journal_id, account_id, side, amount, cost_center_id, description,
+    currency_code, transaction_amount, exchange_rate, functional_currency
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, journal_id, account_id, side, amount, cost_center_id, description, created_at, currency_code, transaction_amount, exchange_rate, functional_currency
 `
 
 type CreateJournalLineParams struct {
-	JournalID    uuid.UUID
-	AccountID    uuid.UUID
-	Side         string
-	Amount       string
-	CostCenterID uuid.NullUUID
-	Description  sql.NullString
+	JournalID          uuid.UUID
+	AccountID          uuid.UUID
+	Side               string
+	Amount             string
+	CostCenterID       uuid.NullUUID
+	Description        sql.NullString
+	CurrencyCode       string
+	TransactionAmount  string
+	ExchangeRate       string
+	FunctionalCurrency string
 }
 
 func (q *Queries) CreateJournalLine(ctx context.Context, arg CreateJournalLineParams) (JournalLine, error) {
@@ -134,6 +307,10 @@ func (q *Queries) CreateJournalLine(ctx context.Context, arg CreateJournalLinePa
 		arg.Amount,
 		arg.CostCenterID,
 		arg.Description,
+		arg.CurrencyCode,
+		arg.TransactionAmount,
+		arg.ExchangeRate,
+		arg.FunctionalCurrency,
 	)
 	var i JournalLine
 	err := row.Scan(
@@ -145,6 +322,10 @@ func (q *Queries) CreateJournalLine(ctx context.Context, arg CreateJournalLinePa
 		&i.CostCenterID,
 		&i.Description,
 		&i.CreatedAt,
+		&i.CurrencyCode,
+		&i.TransactionAmount,
+		&i.ExchangeRate,
+		&i.FunctionalCurrency,
 	)
 	return i, err
 }
@@ -158,13 +339,16 @@ func (q *Queries) DeleteAccount(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
-const deleteJournalEntry = `-- name: DeleteJournalEntry :exec
-DELETE FROM journal_entries WHERE id = $1
+const deleteJournalEntry = `-- name: DeleteJournalEntry :execrows
+DELETE FROM journal_entries WHERE id = $1 AND status = 'DRAFT'
 `
 
-func (q *Queries) DeleteJournalEntry(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteJournalEntry, id)
-	return err
+func (q *Queries) DeleteJournalEntry(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteJournalEntry, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const deleteJournalLinesByEntry = `-- name: DeleteJournalLinesByEntry :exec
@@ -200,8 +384,26 @@ func (q *Queries) GetAccount(ctx context.Context, id uuid.UUID) (Account, error)
 	return i, err
 }
 
+const getAccountUsage = `-- name: GetAccountUsage :one
+SELECT (SELECT COUNT(*) FROM accounts c WHERE c.parent_id = $1::uuid) AS child_count,
+       EXISTS (SELECT 1 FROM ledger_entries le WHERE le.account_id = $1::uuid) AS has_ledger_entries
+`
+
+type GetAccountUsageRow struct {
+	ChildCount       int64
+	HasLedgerEntries bool
+}
+
+// What still depends on an account: child accounts and posted ledger lines.
+func (q *Queries) GetAccountUsage(ctx context.Context, id uuid.UUID) (GetAccountUsageRow, error) {
+	row := q.db.QueryRowContext(ctx, getAccountUsage, id)
+	var i GetAccountUsageRow
+	err := row.Scan(&i.ChildCount, &i.HasLedgerEntries)
+	return i, err
+}
+
 const getJournalEntry = `-- name: GetJournalEntry :one
-SELECT id, journal_date, reference, memo, source_type, source_id, created_at, created_by, updated_at, updated_by, revision FROM journal_entries WHERE id = $1
+SELECT id, journal_date, reference, memo, source_type, source_id, created_at, created_by, updated_at, updated_by, revision, status, posted_at, posted_by, reversal_of, reversed_by, organization_id, auto_reverse_date FROM journal_entries WHERE id = $1
 `
 
 func (q *Queries) GetJournalEntry(ctx context.Context, id uuid.UUID) (JournalEntry, error) {
@@ -219,10 +421,192 @@ func (q *Queries) GetJournalEntry(ctx context.Context, id uuid.UUID) (JournalEnt
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.Status,
+		&i.PostedAt,
+		&i.PostedBy,
+		&i.ReversalOf,
+		&i.ReversedBy,
+		&i.OrganizationID,
+		&i.AutoReverseDate,
 	)
 	return i, err
 }
 
+const listAccountBalances = `-- name: ListAccountBalances :many
+WITH bounds AS (
+    SELECT date_trunc('month', $2::date)::date AS first_month,
+           (date_trunc('month', $3::date) + interval '1 month')::date AS after_last_month
+),
+months AS (
+    SELECT pb.account_id,
+           SUM(CASE WHEN pb.period_start < bounds.first_month THEN pb.debit_total ELSE 0 END) AS before_debit,
+           SUM(CASE WHEN pb.period_start < bounds.first_month THEN pb.credit_total ELSE 0 END) AS before_credit,
+           SUM(CASE WHEN pb.period_start >= bounds.first_month THEN pb.debit_total ELSE 0 END) AS within_debit,
+           SUM(CASE WHEN pb.period_start >= bounds.first_month THEN pb.credit_total ELSE 0 END) AS within_credit
+    FROM account_period_balances pb, bounds
+    WHERE pb.organization_id = $4::text
+      AND pb.period_start < bounds.after_last_month
+    GROUP BY pb.account_id
+),
+edges AS (
+    SELECT le.account_id,
+           SUM(CASE WHEN le.transaction_date < $2::date AND le.side = 'DEBIT' THEN le.amount ELSE 0 END) AS head_debit,
+           SUM(CASE WHEN le.transaction_date < $2::date AND le.side = 'CREDIT' THEN le.amount ELSE 0 END) AS head_credit,
+           SUM(CASE WHEN le.transaction_date >= $3::date + 1 AND le.side = 'DEBIT' THEN le.amount ELSE 0 END) AS tail_debit,
+           SUM(CASE WHEN le.transaction_date >= $3::date + 1 AND le.side = 'CREDIT' THEN le.amount ELSE 0 END) AS tail_credit
+    FROM ledger_entries le
+    LEFT JOIN journal_entries je ON je.id = le.journal_id
+    CROSS JOIN bounds
+    WHERE COALESCE(je.organization_id, '') = $4::text
+      AND le.transaction_date >= bounds.first_month
+      AND le.transaction_date < bounds.after_last_month
+      AND (le.transaction_date < $2::date OR le.transaction_date >= $3::date + 1)
+    GROUP BY le.account_id
+)
+SELECT a.id AS account_id, a.code, a.name, a.type,
+       (COALESCE(m.before_debit, 0) + COALESCE(e.head_debit, 0))::NUMERIC(18,2) AS opening_debit,
+       (COALESCE(m.before_credit, 0) + COALESCE(e.head_credit, 0))::NUMERIC(18,2) AS opening_credit,
+       (COALESCE(m.within_debit, 0) - COALESCE(e.head_debit, 0) - COALESCE(e.tail_debit, 0))::NUMERIC(18,2) AS period_debit,
+       (COALESCE(m.within_credit, 0) - COALESCE(e.head_credit, 0) - COALESCE(e.tail_credit, 0))::NUMERIC(18,2) AS period_credit
+FROM accounts a
+LEFT JOIN months m ON m.account_id = a.id
+LEFT JOIN edges e ON e.account_id = a.id
+WHERE cardinality($1::uuid[]) = 0 OR a.id = ANY($1::uuid[])
+ORDER BY a.code
+`
+
+type ListAccountBalancesParams struct {
+	AccountIds     []uuid.UUID
+	FromDate       time.Time
+	ToDate         time.Time
+	OrganizationID string
+}
+
+type ListAccountBalancesRow struct {
+	AccountID     uuid.UUID
+	Code          string
+	Name          string
+	Type          string
+	OpeningDebit  string
+	OpeningCredit string
+	PeriodDebit   string
+	PeriodCredit  string
+}
+
+// Opening totals before from_date and movements over [from_date, to_date]
+// of one organization's journals (” for journals without one) for the given
+// accounts, or every account when account_ids is empty. Whole
+// months come from account_period_balances; ledger_entries is only read for
+// the days of the first and last month that fall outside the range.
+func (q *Queries) ListAccountBalances(ctx context.Context, arg ListAccountBalancesParams) ([]ListAccountBalancesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountBalances,
+		pq.Array(arg.AccountIds),
+		arg.FromDate,
+		arg.ToDate,
+		arg.OrganizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountBalancesRow
+	for rows.Next() {
+		var i ListAccountBalancesRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.Code,
+			&i.Name,
+			&i.Type,
+			&i.OpeningDebit,
+			&i.OpeningCredit,
+			&i.PeriodDebit,
+			&i.PeriodCredit,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountCurrencyBalances = `-- name: ListAccountCurrencyBalances :many
+SELECT le.account_id, le.currency_code,
+       SUM(CASE WHEN le.transaction_date < $1::date AND le.side = 'DEBIT' THEN le.transaction_amount ELSE 0 END)::NUMERIC(18,2) AS opening_debit,
+       SUM(CASE WHEN le.transaction_date < $1::date AND le.side = 'CREDIT' THEN le.transaction_amount ELSE 0 END)::NUMERIC(18,2) AS opening_credit,
+       SUM(CASE WHEN le.transaction_date >= $1::date AND le.side = 'DEBIT' THEN le.transaction_amount ELSE 0 END)::NUMERIC(18,2) AS period_debit,
+       SUM(CASE WHEN le.transaction_date >= $1::date AND le.side = 'CREDIT' THEN le.transaction_amount ELSE 0 END)::NUMERIC(18,2) AS period_credit
+FROM ledger_entries le
+LEFT JOIN journal_entries je ON je.id = le.journal_id
+WHERE COALESCE(je.organization_id, '') = $2::text
+  AND le.transaction_date < $3::date + 1
+  AND le.currency_code <> ''
+  AND (cardinality($4::uuid[]) = 0 OR le.account_id = ANY($4::uuid[]))
+GROUP BY le.account_id, le.currency_code
+ORDER BY le.account_id, le.currency_code
+`
+
+type ListAccountCurrencyBalancesParams struct {
+	FromDate       time.Time
+	OrganizationID string
+	ToDate         time.Time
+	AccountIds     []uuid.UUID
+}
+
+type ListAccountCurrencyBalancesRow struct {
+	AccountID     uuid.UUID
+	CurrencyCode  string
+	OpeningDebit  string
+	OpeningCredit string
+	PeriodDebit   string
+	PeriodCredit  string
+}
+
+// Transaction-currency totals of one organization's journals per account and
+// currency: opening before from_date and movements over [from_date, to_date].
+// Read from
+// ledger_entries, since account_period_balances is kept in functional
+// currency only.
+func (q *Queries) ListAccountCurrencyBalances(ctx context.Context, arg ListAccountCurrencyBalancesParams) ([]ListAccountCurrencyBalancesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountCurrencyBalances,
+		arg.FromDate,
+		arg.OrganizationID,
+		arg.ToDate,
+		pq.Array(arg.AccountIds),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountCurrencyBalancesRow
+	for rows.Next() {
+		var i ListAccountCurrencyBalancesRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.CurrencyCode,
+			&i.OpeningDebit,
+			&i.OpeningCredit,
+			&i.PeriodDebit,
+			&i.PeriodCredit,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listAccounts = `-- name: ListAccounts :many
 SELECT id, code, name, type, parent_id, status, allow_manual_journal, created_at, created_by, updated_at, updated_by, revision FROM accounts ORDER BY code LIMIT $1 OFFSET $2
 `
@@ -268,17 +652,106 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 	return items, nil
 }
 
+const listAllAccounts = `-- name: ListAllAccounts :many
+SELECT id, code, name, type, parent_id, status, allow_manual_journal, created_at, created_by, updated_at, updated_by, revision FROM accounts ORDER BY code
+`
+
+func (q *Queries) ListAllAccounts(ctx context.Context) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAllAccounts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Name,
+			&i.Type,
+			&i.ParentID,
+			&i.Status,
+			&i.AllowManualJournal,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.UpdatedAt,
+			&i.UpdatedBy,
+			&i.Revision,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listJournalEntries = `-- name: ListJournalEntries :many
-SELECT id, journal_date, reference, memo, source_type, source_id, created_at, created_by, updated_at, updated_by, revision FROM journal_entries ORDER BY journal_date DESC LIMIT $1 OFFSET $2
+SELECT je.id, je.journal_date, je.reference, je.memo, je.source_type, je.source_id, je.created_at, je.created_by, je.updated_at, je.updated_by, je.revision, je.status, je.posted_at, je.posted_by, je.reversal_of, je.reversed_by, je.organization_id, je.auto_reverse_date FROM journal_entries je
+WHERE ($1::timestamp IS NULL OR je.journal_date >= $1)
+  AND ($2::timestamp IS NULL OR je.journal_date <= $2)
+  AND ($3::text IS NULL OR je.source_type = $3)
+  AND ($4::text IS NULL OR COALESCE(je.source_type, 'JOURNAL') = $4)
+  AND ($5::text IS NULL OR COALESCE(je.source_id, je.id::text) = $5)
+  AND ($6::uuid IS NULL AND $7::text IS NULL AND $8::uuid IS NULL
+       OR EXISTS (
+           SELECT 1 FROM journal_lines jl
+           WHERE jl.journal_id = je.id
+             AND ($6::uuid IS NULL OR jl.account_id = $6)
+             AND ($7::text IS NULL OR jl.side = $7)
+             AND ($8::uuid IS NULL OR jl.cost_center_id = $8)))
+  AND ($9::text IS NULL OR je.organization_id = $9)
+ORDER BY
+  CASE WHEN $10::text = 'amount' AND NOT $11::bool
+       THEN (SELECT SUM(amount) FROM journal_lines WHERE journal_id = je.id AND side = 'DEBIT') END ASC,
+  CASE WHEN $10::text = 'amount' AND $11::bool
+       THEN (SELECT SUM(amount) FROM journal_lines WHERE journal_id = je.id AND side = 'DEBIT') END DESC,
+  CASE WHEN $10::text = 'date' AND NOT $11::bool THEN je.journal_date END ASC,
+  je.journal_date DESC, je.id
+LIMIT $13 OFFSET $12
 `
 
 type ListJournalEntriesParams struct {
-	Limit  int32
-	Offset int32
+	FromDate       sql.NullTime
+	ToDate         sql.NullTime
+	SourceType     sql.NullString
+	ReferenceType  sql.NullString
+	ReferenceID    sql.NullString
+	AccountID      uuid.NullUUID
+	Side           sql.NullString
+	CostCenterID   uuid.NullUUID
+	OrganizationID sql.NullString
+	OrderBy        string
+	Descending     bool
+	PageOffset     int32
+	PageLimit      int32
 }
 
+// Line-level filters (account, side, cost center) match journals with at
+// least one line satisfying all of them. Amount ordering uses the journal's
+// debit total.
 func (q *Queries) ListJournalEntries(ctx context.Context, arg ListJournalEntriesParams) ([]JournalEntry, error) {
-	rows, err := q.db.QueryContext(ctx, listJournalEntries, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listJournalEntries,
+		arg.FromDate,
+		arg.ToDate,
+		arg.SourceType,
+		arg.ReferenceType,
+		arg.ReferenceID,
+		arg.AccountID,
+		arg.Side,
+		arg.CostCenterID,
+		arg.OrganizationID,
+		arg.OrderBy,
+		arg.Descending,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -298,6 +771,13 @@ func (q *Queries) ListJournalEntries(ctx context.Context, arg ListJournalEntries
 			&i.UpdatedAt,
 			&i.UpdatedBy,
 			&i.Revision,
+			&i.Status,
+			&i.PostedAt,
+			&i.PostedBy,
+			&i.ReversalOf,
+			&i.ReversedBy,
+			&i.OrganizationID,
+			&i.AutoReverseDate,
 		); err != nil {
 			return nil, err
 		}
@@ -313,7 +793,7 @@ func (q *Queries) ListJournalEntries(ctx context.Context, arg ListJournalEntries
 }
 
 const listJournalLinesByEntry = `-- name: ListJournalLinesByEntry :many
-SELECT id, journal_id, account_id, side, amount, cost_center_id, description, created_at FROM journal_lines WHERE journal_id = $1
+SELECT id, journal_id, account_id, side, amount, cost_center_id, description, created_at, currency_code, transaction_amount, exchange_rate, functional_currency FROM journal_lines WHERE journal_id = $1
 `
 
 func (q *Queries) ListJournalLinesByEntry(ctx context.Context, journalID uuid.UUID) ([]JournalLine, error) {
@@ -334,6 +814,10 @@ func (q *Queries) ListJournalLinesByEntry(ctx context.Context, journalID uuid.UU
 			&i.CostCenterID,
 			&i.Description,
 			&i.CreatedAt,
+			&i.CurrencyCode,
+			&i.TransactionAmount,
+			&i.ExchangeRate,
+			&i.FunctionalCurrency,
 		); err != nil {
 			return nil, err
 		}
@@ -350,19 +834,63 @@ func (q *Queries) ListJournalLinesByEntry(ctx context.Context, journalID uuid.UU
 
 const listLedgerEntries = `-- name: ListLedgerEntries :many
 
-SELECT entry_id, account_id, side, amount, transaction_date FROM ledger_entries ORDER BY transaction_date DESC LIMIT $1 OFFSET $2
+SELECT le.entry_id, le.account_id, le.side, le.amount, le.transaction_date, le.journal_id, le.cost_center_id, le.description, le.reference_type, le.reference_id, le.created_at, le.currency_code, le.transaction_amount, le.exchange_rate, le.functional_currency FROM ledger_entries le
+WHERE ($1::uuid IS NULL OR le.account_id = $1)
+  AND ($2::timestamp IS NULL OR le.transaction_date >= $2)
+  AND ($3::timestamp IS NULL OR le.transaction_date <= $3)
+  AND ($4::text IS NULL OR le.side = $4)
+  AND ($5::uuid IS NULL OR le.cost_center_id = $5)
+  AND ($6::text IS NULL OR le.reference_type = $6)
+  AND ($7::text IS NULL OR le.reference_id = $7)
+  AND ($8::text IS NULL OR EXISTS (
+           SELECT 1 FROM journal_entries je
+           WHERE je.id = le.journal_id AND je.source_type = $8))
+  AND ($9::text IS NULL OR EXISTS (
+           SELECT 1 FROM journal_entries je
+           WHERE je.id = le.journal_id AND je.organization_id = $9))
+ORDER BY
+  CASE WHEN $10::text = 'amount' AND NOT $11::bool THEN le.amount END ASC,
+  CASE WHEN $10::text = 'amount' AND $11::bool THEN le.amount END DESC,
+  CASE WHEN $10::text = 'date' AND NOT $11::bool THEN le.transaction_date END ASC,
+  le.transaction_date DESC, le.entry_id
+LIMIT $13 OFFSET $12
 `
 
 type ListLedgerEntriesParams struct {
-	Limit  int32
-	Offset int32
+	AccountID      uuid.NullUUID
+	FromDate       sql.NullTime
+	ToDate         sql.NullTime
+	Side           sql.NullString
+	CostCenterID   uuid.NullUUID
+	ReferenceType  sql.NullString
+	ReferenceID    sql.NullString
+	SourceType     sql.NullString
+	OrganizationID sql.NullString
+	OrderBy        string
+	Descending     bool
+	PageOffset     int32
+	PageLimit      int32
 }
 
 // =====================================================
 // Ledger Entries
 // =====================================================
 func (q *Queries) ListLedgerEntries(ctx context.Context, arg ListLedgerEntriesParams) ([]LedgerEntry, error) {
-	rows, err := q.db.QueryContext(ctx, listLedgerEntries, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listLedgerEntries,
+		arg.AccountID,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Side,
+		arg.CostCenterID,
+		arg.ReferenceType,
+		arg.ReferenceID,
+		arg.SourceType,
+		arg.OrganizationID,
+		arg.OrderBy,
+		arg.Descending,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -376,6 +904,16 @@ func (q *Queries) ListLedgerEntries(ctx context.Context, arg ListLedgerEntriesPa
 			&i.Side,
 			&i.Amount,
 			&i.TransactionDate,
+			&i.JournalID,
+			&i.CostCenterID,
+			&i.Description,
+			&i.ReferenceType,
+			&i.ReferenceID,
+			&i.CreatedAt,
+			&i.CurrencyCode,
+			&i.TransactionAmount,
+			&i.ExchangeRate,
+			&i.FunctionalCurrency,
 		); err != nil {
 			return nil, err
 		}
@@ -390,6 +928,128 @@ func (q *Queries) ListLedgerEntries(ctx context.Context, arg ListLedgerEntriesPa
 	return items, nil
 }
 
+const markJournalReversed = `-- name: MarkJournalReversed :one
+UPDATE journal_entries
+SET status = 'REVERSED', reversed_by = $2,
+    updated_by = $3, updated_at = now(), revision = revision + 1
+WHERE id = $1 AND status = 'POSTED'
+RETURNING id, journal_date, reference, memo, source_type, source_id, created_at, created_by, updated_at, updated_by, revision, status, posted_at, posted_by, reversal_of, reversed_by, organization_id, auto_reverse_date
+`
+
+type MarkJournalReversedParams struct {
+	ID         uuid.UUID
+	ReversedBy uuid.NullUUID
+	UpdatedBy  sql.NullString
+}
+
+func (q *Queries) MarkJournalReversed(ctx context.Context, arg MarkJournalReversedParams) (JournalEntry, error) {
+	row := q.db.QueryRowContext(ctx, markJournalReversed, arg.ID, arg.ReversedBy, arg.UpdatedBy)
+	var i JournalEntry
+	err := row.Scan(
+		&i.ID,
+		&i.JournalDate,
+		&i.Reference,
+		&i.Memo,
+		&i.SourceType,
+		&i.SourceID,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.Revision,
+		&i.Status,
+		&i.PostedAt,
+		&i.PostedBy,
+		&i.ReversalOf,
+		&i.ReversedBy,
+		&i.OrganizationID,
+		&i.AutoReverseDate,
+	)
+	return i, err
+}
+
+const postJournalEntry = `-- name: PostJournalEntry :one
+UPDATE journal_entries
+SET status = 'POSTED', posted_at = now(), posted_by = $2,
+    updated_by = $2, updated_at = now(), revision = revision + 1
+WHERE id = $1 AND status = 'DRAFT'
+RETURNING id, journal_date, reference, memo, source_type, source_id, created_at, created_by, updated_at, updated_by, revision, status, posted_at, posted_by, reversal_of, reversed_by, organization_id, auto_reverse_date
+`
+
+type PostJournalEntryParams struct {
+	ID       uuid.UUID
+	PostedBy sql.NullString
+}
+
+func (q *Queries) PostJournalEntry(ctx context.Context, arg PostJournalEntryParams) (JournalEntry, error) {
+	row := q.db.QueryRowContext(ctx, postJournalEntry, arg.ID, arg.PostedBy)
+	var i JournalEntry
+	err := row.Scan(
+		&i.ID,
+		&i.JournalDate,
+		&i.Reference,
+		&i.Memo,
+		&i.SourceType,
+		&i.SourceID,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.Revision,
+		&i.Status,
+		&i.PostedAt,
+		&i.PostedBy,
+		&i.ReversalOf,
+		&i.ReversedBy,
+		&i.OrganizationID,
+		&i.AutoReverseDate,
+	)
+	return i, err
+}
+
+const postJournalToLedger = `-- name: PostJournalToLedger :exec
+INSERT INTO ledger_entries (
+    account_id, side, amount, transaction_date, journal_id,
+    cost_center_id, description, reference_type, reference_id,
+    currency_code, transaction_amount, exchange_rate, functional_currency
+)
+SELECT jl.account_id, jl.side, jl.amount, je.journal_date, je.id,
+       jl.cost_center_id, jl.description,
+       COALESCE(je.source_type, 'JOURNAL'), COALESCE(je.source_id, je.id::text),
+       jl.currency_code, jl.transaction_amount, jl.exchange_rate, jl.functional_currency
+FROM journal_lines jl
+JOIN journal_entries je ON je.id = jl.journal_id
+WHERE je.id = $1
+`
+
+// Projects every line of a journal into ledger_entries. The reference is the
+// journal's source document, or the journal itself for manual entries.
+func (q *Queries) PostJournalToLedger(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, postJournalToLedger, id)
+	return err
+}
+
+const postJournalsToLedger = `-- name: PostJournalsToLedger :exec
+INSERT INTO ledger_entries (
+    account_id, side, amount, transaction_date, journal_id,
+    cost_center_id, description, reference_type, reference_id,
+    currency_code, transaction_amount, exchange_rate, functional_currency
+)
+SELECT jl.account_id, jl.side, jl.amount, je.journal_date, je.id,
+       jl.cost_center_id, jl.description,
+       COALESCE(je.source_type, 'JOURNAL'), COALESCE(je.source_id, je.id::text),
+       jl.currency_code, jl.transaction_amount, jl.exchange_rate, jl.functional_currency
+FROM journal_lines jl
+JOIN journal_entries je ON je.id = jl.journal_id
+WHERE je.id = ANY($1::uuid[])
+`
+
+// Bulk form of PostJournalToLedger for many journals at once.
+func (q *Queries) PostJournalsToLedger(ctx context.Context, journalIds []uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, postJournalsToLedger, pq.Array(journalIds))
+	return err
+}
+
 const updateAccount = `-- name: UpdateAccount :one
 UPDATE accounts
 SET code = $2, name = $3, type = $4, parent_id = $5,
@@ -443,19 +1103,21 @@ const updateJournalEntry = `-- name: UpdateJournalEntry :one
 UPDATE journal_entries
 SET journal_date = $2, reference = $3, memo = $4,
     source_type = $5, source_id = $6,
-    updated_by = $7, updated_at = now(), revision = revision + 1
-WHERE id = $1
-RETURNING id, journal_date, reference, memo, source_type, source_id, created_at, created_by, updated_at, updated_by, revision
+    updated_by = $7, updated_at = now(), revision = revision + 1,
+    auto_reverse_date = $8
+WHERE id = $1 AND status = 'DRAFT'
+RETURNING id, journal_date, reference, memo, source_type, source_id, created_at, created_by, updated_at, updated_by, revision, status, posted_at, posted_by, reversal_of, reversed_by, organization_id, auto_reverse_date
 `
 
 type UpdateJournalEntryParams struct {
-	ID          uuid.UUID
-	JournalDate time.Time
-	Reference   sql.NullString
-	Memo        sql.NullString
-	SourceType  sql.NullString
-	SourceID    sql.NullString
-	UpdatedBy   sql.NullString
+	ID              uuid.UUID
+	JournalDate     time.Time
+	Reference       sql.NullString
+	Memo            sql.NullString
+	SourceType      sql.NullString
+	SourceID        sql.NullString
+	UpdatedBy       sql.NullString
+	AutoReverseDate sql.NullTime
 }
 
 func (q *Queries) UpdateJournalEntry(ctx context.Context, arg UpdateJournalEntryParams) (JournalEntry, error) {
@@ -467,6 +1129,7 @@ func (q *Queries) UpdateJournalEntry(ctx context.Context, arg UpdateJournalEntry
 		arg.SourceType,
 		arg.SourceID,
 		arg.UpdatedBy,
+		arg.AutoReverseDate,
 	)
 	var i JournalEntry
 	err := row.Scan(
@@ -481,6 +1144,13 @@ func (q *Queries) UpdateJournalEntry(ctx context.Context, arg UpdateJournalEntry
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.Status,
+		&i.PostedAt,
+		&i.PostedBy,
+		&i.ReversalOf,
+		&i.ReversedBy,
+		&i.OrganizationID,
+		&i.AutoReverseDate,
 	)
 	return i, err
 }
@@ -18,19 +18,20 @@ const createAccrual = `-- name: CreateAccrual :one
 
 INSERT INTO accruals (
     description, amount, accrual_date, account_id,
-    created_by, updated_by
+    created_by, updated_by, organization_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
-) RETURNING id, description, amount, accrual_date, account_id, created_at, created_by, updated_at, updated_by, revision
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, description, amount, accrual_date, account_id, created_at, created_by, updated_at, updated_by, revision, organization_id
 `
 
 type CreateAccrualParams struct {
-	Description sql.NullString
-	Amount      string
-	AccrualDate time.Time
-	AccountID   string
-	CreatedBy   sql.NullString
-	UpdatedBy   sql.NullString
+	Description    sql.NullString
+	Amount         string
+	AccrualDate    time.Time
+	AccountID      string
+	CreatedBy      sql.NullString
+	UpdatedBy      sql.NullString
+	OrganizationID sql.NullString
 }
 
 // =====================================================
@@ -44,6 +45,7 @@ func (q *Queries) CreateAccrual(ctx context.Context, arg CreateAccrualParams) (A
 		arg.AccountID,
 		arg.CreatedBy,
 		arg.UpdatedBy,
+		arg.OrganizationID,
 	)
 	var i Accrual
 	err := row.Scan(
@@ -57,6 +59,7 @@ func (q *Queries) CreateAccrual(ctx context.Context, arg CreateAccrualParams) (A
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -130,7 +133,7 @@ func (q *Queries) DeleteAllocationRule(ctx context.Context, id uuid.UUID) error
 }
 
 const getAccrualById = `-- name: GetAccrualById :one
-SELECT id, description, amount, accrual_date, account_id, created_at, created_by, updated_at, updated_by, revision FROM accruals WHERE id = $1
+SELECT id, description, amount, accrual_date, account_id, created_at, created_by, updated_at, updated_by, revision, organization_id FROM accruals WHERE id = $1
 `
 
 func (q *Queries) GetAccrualById(ctx context.Context, id uuid.UUID) (Accrual, error) {
@@ -147,6 +150,7 @@ func (q *Queries) GetAccrualById(ctx context.Context, id uuid.UUID) (Accrual, er
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -175,7 +179,7 @@ func (q *Queries) GetAllocationRule(ctx context.Context, id uuid.UUID) (Allocati
 }
 
 const listAccruals = `-- name: ListAccruals :many
-SELECT id, description, amount, accrual_date, account_id, created_at, created_by, updated_at, updated_by, revision FROM accruals
+SELECT id, description, amount, accrual_date, account_id, created_at, created_by, updated_at, updated_by, revision, organization_id FROM accruals
 ORDER BY accrual_date DESC
 LIMIT $1 OFFSET $2
 `
@@ -205,6 +209,7 @@ func (q *Queries) ListAccruals(ctx context.Context, arg ListAccrualsParams) ([]A
 			&i.UpdatedAt,
 			&i.UpdatedBy,
 			&i.Revision,
+			&i.OrganizationID,
 		); err != nil {
 			return nil, err
 		}
@@ -275,7 +280,7 @@ SET description = $2,
     revision = revision + 1,
     updated_at = now()
 WHERE id = $1
-RETURNING id, description, amount, accrual_date, account_id, created_at, created_by, updated_at, updated_by, revision
+RETURNING id, description, amount, accrual_date, account_id, created_at, created_by, updated_at, updated_by, revision, organization_id
 `
 
 type UpdateAccrualParams struct {
@@ -308,6 +313,7 @@ func (q *Queries) UpdateAccrual(ctx context.Context, arg UpdateAccrualParams) (A
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.OrganizationID,
 	)
 	return i, err
 }
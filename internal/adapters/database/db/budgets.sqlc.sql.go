@@ -59,17 +59,18 @@ func (q *Queries) AllocateBudget(ctx context.Context, arg AllocateBudgetParams)
 
 const createBudget = `-- name: CreateBudget :one
 
-INSERT INTO budgets (name, total_amount, status, created_by, updated_by)
-VALUES ($1, $2, COALESCE($3, 'DRAFT'), $4, $5)
-RETURNING id, name, total_amount, status, created_at, created_by, updated_at, updated_by, revision
+INSERT INTO budgets (name, total_amount, status, created_by, updated_by, currency_code)
+VALUES ($1, $2, COALESCE($3, 'DRAFT'), $4, $5, $6)
+RETURNING id, name, total_amount, status, created_at, created_by, updated_at, updated_by, revision, currency_code
 `
 
 type CreateBudgetParams struct {
-	Name        string
-	TotalAmount string
-	Column3     interface{}
-	CreatedBy   sql.NullString
-	UpdatedBy   sql.NullString
+	Name         string
+	TotalAmount  string
+	Column3      interface{}
+	CreatedBy    sql.NullString
+	UpdatedBy    sql.NullString
+	CurrencyCode string
 }
 
 // =====================================================
@@ -82,6 +83,7 @@ func (q *Queries) CreateBudget(ctx context.Context, arg CreateBudgetParams) (Bud
 		arg.Column3,
 		arg.CreatedBy,
 		arg.UpdatedBy,
+		arg.CurrencyCode,
 	)
 	var i Budget
 	err := row.Scan(
@@ -94,6 +96,7 @@ func (q *Queries) CreateBudget(ctx context.Context, arg CreateBudgetParams) (Bud
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
 	)
 	return i, err
 }
@@ -117,7 +120,7 @@ func (q *Queries) DeleteBudgetAllocation(ctx context.Context, id uuid.UUID) erro
 }
 
 const getBudget = `-- name: GetBudget :one
-SELECT id, name, total_amount, status, created_at, created_by, updated_at, updated_by, revision FROM budgets WHERE id = $1
+SELECT id, name, total_amount, status, created_at, created_by, updated_at, updated_by, revision, currency_code FROM budgets WHERE id = $1
 `
 
 func (q *Queries) GetBudget(ctx context.Context, id uuid.UUID) (Budget, error) {
@@ -133,6 +136,7 @@ func (q *Queries) GetBudget(ctx context.Context, id uuid.UUID) (Budget, error) {
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
 	)
 	return i, err
 }
@@ -247,7 +251,7 @@ func (q *Queries) ListBudgetAllocations(ctx context.Context, arg ListBudgetAlloc
 }
 
 const listBudgets = `-- name: ListBudgets :many
-SELECT id, name, total_amount, status, created_at, created_by, updated_at, updated_by, revision FROM budgets
+SELECT id, name, total_amount, status, created_at, created_by, updated_at, updated_by, revision, currency_code FROM budgets
 ORDER BY created_at DESC
 LIMIT $1 OFFSET $2
 `
@@ -276,6 +280,7 @@ func (q *Queries) ListBudgets(ctx context.Context, arg ListBudgetsParams) ([]Bud
 			&i.UpdatedAt,
 			&i.UpdatedBy,
 			&i.Revision,
+			&i.CurrencyCode,
 		); err != nil {
 			return nil, err
 		}
@@ -297,18 +302,20 @@ SET
     total_amount = $3,
     status = $4,
     updated_by = $5,
+    currency_code = $6,
     updated_at = now(),
     revision = revision + 1
 WHERE id = $1
-RETURNING id, name, total_amount, status, created_at, created_by, updated_at, updated_by, revision
+RETURNING id, name, total_amount, status, created_at, created_by, updated_at, updated_by, revision, currency_code
 `
 
 type UpdateBudgetParams struct {
-	ID          uuid.UUID
-	Name        string
-	TotalAmount string
-	Status      string
-	UpdatedBy   sql.NullString
+	ID           uuid.UUID
+	Name         string
+	TotalAmount  string
+	Status       string
+	UpdatedBy    sql.NullString
+	CurrencyCode string
 }
 
 func (q *Queries) UpdateBudget(ctx context.Context, arg UpdateBudgetParams) (Budget, error) {
@@ -318,6 +325,7 @@ func (q *Queries) UpdateBudget(ctx context.Context, arg UpdateBudgetParams) (Bud
 		arg.TotalAmount,
 		arg.Status,
 		arg.UpdatedBy,
+		arg.CurrencyCode,
 	)
 	var i Budget
 	err := row.Scan(
@@ -330,6 +338,7 @@ func (q *Queries) UpdateBudget(ctx context.Context, arg UpdateBudgetParams) (Bud
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
 	)
 	return i, err
 }
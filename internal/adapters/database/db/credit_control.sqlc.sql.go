@@ -0,0 +1,118 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: credit_control.sqlc.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const approveInvoiceCredit = `-- name: ApproveInvoiceCredit :execrows
+UPDATE invoices SET credit_approved_by = $2, credit_approved_at = now()
+WHERE id = $1 AND status = 'DRAFT'
+`
+
+type ApproveInvoiceCreditParams struct {
+	ID               uuid.UUID
+	CreditApprovedBy sql.NullString
+}
+
+func (q *Queries) ApproveInvoiceCredit(ctx context.Context, arg ApproveInvoiceCreditParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, approveInvoiceCredit, arg.ID, arg.CreditApprovedBy)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getCreditPolicy = `-- name: GetCreditPolicy :one
+
+SELECT organization_id, threshold_percent, action, updated_at, updated_by FROM credit_policies WHERE organization_id = $1
+`
+
+// =====================================================
+// Credit control
+// =====================================================
+func (q *Queries) GetCreditPolicy(ctx context.Context, organizationID string) (CreditPolicy, error) {
+	row := q.db.QueryRowContext(ctx, getCreditPolicy, organizationID)
+	var i CreditPolicy
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.ThresholdPercent,
+		&i.Action,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const getPartyCreditExposure = `-- name: GetPartyCreditExposure :one
+SELECT
+    (SELECT COALESCE(SUM(pd.amount_due), 0)
+       FROM payment_dues pd JOIN invoices i ON i.id = pd.invoice_id
+      WHERE i.party_id = $1 AND i.type = 'SALES'
+        AND pd.status NOT IN ('PAID', 'WRITEOFF'))::text AS open_dues,
+    (SELECT COALESCE(SUM(i.grand_total), 0)
+       FROM invoices i
+      WHERE i.party_id = $1 AND i.type = 'SALES'
+        AND i.status = 'DRAFT')::text AS draft_invoices,
+    (SELECT COALESCE(SUM(n.amount), 0)
+       FROM credit_debit_notes n JOIN invoices i ON i.id = n.invoice_id
+      WHERE i.party_id = $1 AND i.type = 'SALES'
+        AND n.type = 'CREDIT' AND n.applied_at IS NULL)::text AS unapplied_credits
+`
+
+type GetPartyCreditExposureRow struct {
+	OpenDues         string
+	DraftInvoices    string
+	UnappliedCredits string
+}
+
+// The sums making up a customer's exposure, over its sales invoices only.
+// Applied credit notes have already reduced the open dues; only those
+// created before notes were applied are still to be taken off.
+func (q *Queries) GetPartyCreditExposure(ctx context.Context, partyID uuid.NullUUID) (GetPartyCreditExposureRow, error) {
+	row := q.db.QueryRowContext(ctx, getPartyCreditExposure, partyID)
+	var i GetPartyCreditExposureRow
+	err := row.Scan(&i.OpenDues, &i.DraftInvoices, &i.UnappliedCredits)
+	return i, err
+}
+
+const upsertCreditPolicy = `-- name: UpsertCreditPolicy :one
+INSERT INTO credit_policies (organization_id, threshold_percent, action, updated_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (organization_id)
+DO UPDATE SET threshold_percent = EXCLUDED.threshold_percent, action = EXCLUDED.action,
+              updated_at = now(), updated_by = EXCLUDED.updated_by
+RETURNING organization_id, threshold_percent, action, updated_at, updated_by
+`
+
+type UpsertCreditPolicyParams struct {
+	OrganizationID   string
+	ThresholdPercent string
+	Action           string
+	UpdatedBy        sql.NullString
+}
+
+func (q *Queries) UpsertCreditPolicy(ctx context.Context, arg UpsertCreditPolicyParams) (CreditPolicy, error) {
+	row := q.db.QueryRowContext(ctx, upsertCreditPolicy,
+		arg.OrganizationID,
+		arg.ThresholdPercent,
+		arg.Action,
+		arg.UpdatedBy,
+	)
+	var i CreditPolicy
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.ThresholdPercent,
+		&i.Action,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
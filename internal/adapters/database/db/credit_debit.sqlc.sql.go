@@ -12,12 +12,92 @@ import (
 	"github.com/google/uuid"
 )
 
+const applyNoteToInvoice = `-- name: ApplyNoteToInvoice :one
+UPDATE invoices
+SET credited_total = credited_total + $1::numeric,
+    debited_total = debited_total + $2::numeric,
+    status = $3, updated_by = $4,
+    updated_at = now(), revision = revision + 1
+WHERE id = $5
+RETURNING id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision, currency_code, discount_total, tax_total, round_off, issued_at, issued_by, voided_at, voided_by, void_reason, overdue_at, overdue_by, journal_id, party_id, credit_approved_by, credit_approved_at, credited_total, debited_total
+`
+
+type ApplyNoteToInvoiceParams struct {
+	Credited  string
+	Debited   string
+	Status    string
+	UpdatedBy sql.NullString
+	ID        uuid.UUID
+}
+
+func (q *Queries) ApplyNoteToInvoice(ctx context.Context, arg ApplyNoteToInvoiceParams) (Invoice, error) {
+	row := q.db.QueryRowContext(ctx, applyNoteToInvoice,
+		arg.Credited,
+		arg.Debited,
+		arg.Status,
+		arg.UpdatedBy,
+		arg.ID,
+	)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceNumber,
+		&i.Type,
+		&i.InvoiceDate,
+		&i.DueDate,
+		&i.DeliveryDate,
+		&i.OrganizationID,
+		&i.PoNumber,
+		&i.EwayNumberLegacy,
+		&i.StatusNote,
+		&i.Status,
+		&i.PaymentReference,
+		&i.ChallanNumber,
+		&i.ChallanDate,
+		&i.LrNumber,
+		&i.TransporterName,
+		&i.TransporterID,
+		&i.VehicleNumber,
+		&i.AgainstInvoiceNumber,
+		&i.AgainstInvoiceDate,
+		&i.Subtotal,
+		&i.GrandTotal,
+		&i.GstRate,
+		&i.GstCgst,
+		&i.GstSgst,
+		&i.GstIgst,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.Revision,
+		&i.CurrencyCode,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.RoundOff,
+		&i.IssuedAt,
+		&i.IssuedBy,
+		&i.VoidedAt,
+		&i.VoidedBy,
+		&i.VoidReason,
+		&i.OverdueAt,
+		&i.OverdueBy,
+		&i.JournalID,
+		&i.PartyID,
+		&i.CreditApprovedBy,
+		&i.CreditApprovedAt,
+		&i.CreditedTotal,
+		&i.DebitedTotal,
+	)
+	return i, err
+}
+
 const createCreditDebitNote = `-- name: CreateCreditDebitNote :one
 INSERT INTO credit_debit_notes (
     invoice_id, type, amount, reason, created_by, updated_by, revision
 ) VALUES (
     $1, $2, $3, $4, $5, $6, 1
-) RETURNING id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision
+) RETURNING id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision, journal_id, payment_due_id, applied_at
 `
 
 type CreateCreditDebitNoteParams struct {
@@ -50,10 +130,37 @@ func (q *Queries) CreateCreditDebitNote(ctx context.Context, arg CreateCreditDeb
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.JournalID,
+		&i.PaymentDueID,
+		&i.AppliedAt,
 	)
 	return i, err
 }
 
+const creditPaymentDue = `-- name: CreditPaymentDue :exec
+UPDATE payment_dues
+SET amount_due = $2, status = $3, updated_by = $4,
+    updated_at = now(), revision = revision + 1
+WHERE id = $1
+`
+
+type CreditPaymentDueParams struct {
+	ID        uuid.UUID
+	AmountDue string
+	Status    string
+	UpdatedBy sql.NullString
+}
+
+func (q *Queries) CreditPaymentDue(ctx context.Context, arg CreditPaymentDueParams) error {
+	_, err := q.db.ExecContext(ctx, creditPaymentDue,
+		arg.ID,
+		arg.AmountDue,
+		arg.Status,
+		arg.UpdatedBy,
+	)
+	return err
+}
+
 const deleteCreditDebitNote = `-- name: DeleteCreditDebitNote :exec
 DELETE FROM credit_debit_notes WHERE id = $1
 `
@@ -64,7 +171,7 @@ func (q *Queries) DeleteCreditDebitNote(ctx context.Context, id uuid.UUID) error
 }
 
 const getCreditDebitNote = `-- name: GetCreditDebitNote :one
-SELECT id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision FROM credit_debit_notes WHERE id = $1
+SELECT id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision, journal_id, payment_due_id, applied_at FROM credit_debit_notes WHERE id = $1
 `
 
 func (q *Queries) GetCreditDebitNote(ctx context.Context, id uuid.UUID) (CreditDebitNote, error) {
@@ -81,12 +188,15 @@ func (q *Queries) GetCreditDebitNote(ctx context.Context, id uuid.UUID) (CreditD
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.JournalID,
+		&i.PaymentDueID,
+		&i.AppliedAt,
 	)
 	return i, err
 }
 
 const listCreditDebitNotes = `-- name: ListCreditDebitNotes :many
-SELECT id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision 
+SELECT id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision, journal_id, payment_due_id, applied_at 
 FROM credit_debit_notes
 ORDER BY created_at DESC
 LIMIT $1 OFFSET $2
@@ -117,6 +227,53 @@ func (q *Queries) ListCreditDebitNotes(ctx context.Context, arg ListCreditDebitN
 			&i.UpdatedAt,
 			&i.UpdatedBy,
 			&i.Revision,
+			&i.JournalID,
+			&i.PaymentDueID,
+			&i.AppliedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInvoiceDues = `-- name: ListInvoiceDues :many
+SELECT id, invoice_id, amount_due, due_date, status, created_at, created_by, updated_at, updated_by, revision, currency_code, exchange_rate, account_id FROM payment_dues
+WHERE invoice_id = $1
+ORDER BY due_date, created_at
+FOR UPDATE
+`
+
+func (q *Queries) ListInvoiceDues(ctx context.Context, invoiceID uuid.UUID) ([]PaymentDue, error) {
+	rows, err := q.db.QueryContext(ctx, listInvoiceDues, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PaymentDue
+	for rows.Next() {
+		var i PaymentDue
+		if err := rows.Scan(
+			&i.ID,
+			&i.InvoiceID,
+			&i.AmountDue,
+			&i.DueDate,
+			&i.Status,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.UpdatedAt,
+			&i.UpdatedBy,
+			&i.Revision,
+			&i.CurrencyCode,
+			&i.ExchangeRate,
+			&i.AccountID,
 		); err != nil {
 			return nil, err
 		}
@@ -131,6 +288,105 @@ func (q *Queries) ListCreditDebitNotes(ctx context.Context, arg ListCreditDebitN
 	return items, nil
 }
 
+const lockNoteInvoice = `-- name: LockNoteInvoice :one
+
+SELECT id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision, currency_code, discount_total, tax_total, round_off, issued_at, issued_by, voided_at, voided_by, void_reason, overdue_at, overdue_by, journal_id, party_id, credit_approved_by, credit_approved_at, credited_total, debited_total FROM invoices WHERE id = $1 FOR UPDATE
+`
+
+// =====================================================
+// Applying notes
+// =====================================================
+// The invoice a note is applied to, locked until the note is.
+func (q *Queries) LockNoteInvoice(ctx context.Context, id uuid.UUID) (Invoice, error) {
+	row := q.db.QueryRowContext(ctx, lockNoteInvoice, id)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceNumber,
+		&i.Type,
+		&i.InvoiceDate,
+		&i.DueDate,
+		&i.DeliveryDate,
+		&i.OrganizationID,
+		&i.PoNumber,
+		&i.EwayNumberLegacy,
+		&i.StatusNote,
+		&i.Status,
+		&i.PaymentReference,
+		&i.ChallanNumber,
+		&i.ChallanDate,
+		&i.LrNumber,
+		&i.TransporterName,
+		&i.TransporterID,
+		&i.VehicleNumber,
+		&i.AgainstInvoiceNumber,
+		&i.AgainstInvoiceDate,
+		&i.Subtotal,
+		&i.GrandTotal,
+		&i.GstRate,
+		&i.GstCgst,
+		&i.GstSgst,
+		&i.GstIgst,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.Revision,
+		&i.CurrencyCode,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.RoundOff,
+		&i.IssuedAt,
+		&i.IssuedBy,
+		&i.VoidedAt,
+		&i.VoidedBy,
+		&i.VoidReason,
+		&i.OverdueAt,
+		&i.OverdueBy,
+		&i.JournalID,
+		&i.PartyID,
+		&i.CreditApprovedBy,
+		&i.CreditApprovedAt,
+		&i.CreditedTotal,
+		&i.DebitedTotal,
+	)
+	return i, err
+}
+
+const recordNoteApplication = `-- name: RecordNoteApplication :one
+UPDATE credit_debit_notes
+SET journal_id = $2, payment_due_id = $3, applied_at = now()
+WHERE id = $1
+RETURNING id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision, journal_id, payment_due_id, applied_at
+`
+
+type RecordNoteApplicationParams struct {
+	ID           uuid.UUID
+	JournalID    uuid.NullUUID
+	PaymentDueID uuid.NullUUID
+}
+
+func (q *Queries) RecordNoteApplication(ctx context.Context, arg RecordNoteApplicationParams) (CreditDebitNote, error) {
+	row := q.db.QueryRowContext(ctx, recordNoteApplication, arg.ID, arg.JournalID, arg.PaymentDueID)
+	var i CreditDebitNote
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceID,
+		&i.Type,
+		&i.Amount,
+		&i.Reason,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.Revision,
+		&i.JournalID,
+		&i.PaymentDueID,
+		&i.AppliedAt,
+	)
+	return i, err
+}
+
 const updateCreditDebitNote = `-- name: UpdateCreditDebitNote :one
 UPDATE credit_debit_notes
 SET 
@@ -142,7 +398,7 @@ SET
     revision = revision + 1,
     updated_at = now()
 WHERE id = $1
-RETURNING id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision
+RETURNING id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision, journal_id, payment_due_id, applied_at
 `
 
 type UpdateCreditDebitNoteParams struct {
@@ -175,6 +431,9 @@ func (q *Queries) UpdateCreditDebitNote(ctx context.Context, arg UpdateCreditDeb
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.JournalID,
+		&i.PaymentDueID,
+		&i.AppliedAt,
 	)
 	return i, err
 }
@@ -16,11 +16,11 @@ import (
 const createExchangeRate = `-- name: CreateExchangeRate :one
 INSERT INTO exchange_rates (
     base_currency, quote_currency, rate, as_of,
-    created_by, updated_by, revision
+    created_by, updated_by, revision, rate_type
 ) VALUES (
     $1, $2, $3, $4,
-    $5, $6, $7
-) RETURNING id, base_currency, quote_currency, rate, as_of, created_at, created_by, updated_at, updated_by, revision
+    $5, $6, $7, $8
+) RETURNING id, base_currency, quote_currency, rate, as_of, created_at, created_by, updated_at, updated_by, revision, rate_type
 `
 
 type CreateExchangeRateParams struct {
@@ -31,6 +31,7 @@ type CreateExchangeRateParams struct {
 	CreatedBy     sql.NullString
 	UpdatedBy     sql.NullString
 	Revision      sql.NullString
+	RateType      string
 }
 
 // Create a new exchange rate
@@ -43,6 +44,7 @@ func (q *Queries) CreateExchangeRate(ctx context.Context, arg CreateExchangeRate
 		arg.CreatedBy,
 		arg.UpdatedBy,
 		arg.Revision,
+		arg.RateType,
 	)
 	var i ExchangeRate
 	err := row.Scan(
@@ -56,6 +58,7 @@ func (q *Queries) CreateExchangeRate(ctx context.Context, arg CreateExchangeRate
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.RateType,
 	)
 	return i, err
 }
@@ -71,7 +74,7 @@ func (q *Queries) DeleteExchangeRate(ctx context.Context, id uuid.UUID) error {
 }
 
 const getExchangeRate = `-- name: GetExchangeRate :one
-SELECT id, base_currency, quote_currency, rate, as_of, created_at, created_by, updated_at, updated_by, revision FROM exchange_rates WHERE id = $1
+SELECT id, base_currency, quote_currency, rate, as_of, created_at, created_by, updated_at, updated_by, revision, rate_type FROM exchange_rates WHERE id = $1
 `
 
 // Fetch single exchange rate by ID
@@ -89,16 +92,18 @@ func (q *Queries) GetExchangeRate(ctx context.Context, id uuid.UUID) (ExchangeRa
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.RateType,
 	)
 	return i, err
 }
 
 const getLatestRate = `-- name: GetLatestRate :one
-SELECT id, base_currency, quote_currency, rate, as_of, created_at, created_by, updated_at, updated_by, revision
+SELECT id, base_currency, quote_currency, rate, as_of, created_at, created_by, updated_at, updated_by, revision, rate_type
 FROM exchange_rates
 WHERE base_currency = $1
   AND quote_currency = $2
   AND as_of <= $3
+  AND rate_type = 'SPOT'
 ORDER BY as_of DESC
 LIMIT 1
 `
@@ -109,7 +114,7 @@ type GetLatestRateParams struct {
 	AsOf          time.Time
 }
 
-// Fetch the latest rate for a currency pair as of timestamp
+// Fetch the latest spot rate for a currency pair as of timestamp
 func (q *Queries) GetLatestRate(ctx context.Context, arg GetLatestRateParams) (ExchangeRate, error) {
 	row := q.db.QueryRowContext(ctx, getLatestRate, arg.BaseCurrency, arg.QuoteCurrency, arg.AsOf)
 	var i ExchangeRate
@@ -124,12 +129,56 @@ func (q *Queries) GetLatestRate(ctx context.Context, arg GetLatestRateParams) (E
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.RateType,
+	)
+	return i, err
+}
+
+const getLatestRateOfType = `-- name: GetLatestRateOfType :one
+SELECT id, base_currency, quote_currency, rate, as_of, created_at, created_by, updated_at, updated_by, revision, rate_type
+FROM exchange_rates
+WHERE base_currency = $1
+  AND quote_currency = $2
+  AND as_of <= $3
+  AND rate_type = $4
+ORDER BY as_of DESC
+LIMIT 1
+`
+
+type GetLatestRateOfTypeParams struct {
+	BaseCurrency  string
+	QuoteCurrency string
+	AsOf          time.Time
+	RateType      string
+}
+
+// Fetch the latest rate of a given type for a currency pair as of timestamp
+func (q *Queries) GetLatestRateOfType(ctx context.Context, arg GetLatestRateOfTypeParams) (ExchangeRate, error) {
+	row := q.db.QueryRowContext(ctx, getLatestRateOfType,
+		arg.BaseCurrency,
+		arg.QuoteCurrency,
+		arg.AsOf,
+		arg.RateType,
+	)
+	var i ExchangeRate
+	err := row.Scan(
+		&i.ID,
+		&i.BaseCurrency,
+		&i.QuoteCurrency,
+		&i.Rate,
+		&i.AsOf,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.Revision,
+		&i.RateType,
 	)
 	return i, err
 }
 
 const listExchangeRates = `-- name: ListExchangeRates :many
-SELECT id, base_currency, quote_currency, rate, as_of, created_at, created_by, updated_at, updated_by, revision
+SELECT id, base_currency, quote_currency, rate, as_of, created_at, created_by, updated_at, updated_by, revision, rate_type
 FROM exchange_rates
 WHERE
     ($1::TEXT IS NULL OR base_currency = $1)
@@ -171,6 +220,7 @@ func (q *Queries) ListExchangeRates(ctx context.Context, arg ListExchangeRatesPa
 			&i.UpdatedAt,
 			&i.UpdatedBy,
 			&i.Revision,
+			&i.RateType,
 		); err != nil {
 			return nil, err
 		}
@@ -194,9 +244,10 @@ SET
     as_of = $5,
     updated_by = $6,
     revision = $7,
+    rate_type = $8,
     updated_at = now()
 WHERE id = $1
-RETURNING id, base_currency, quote_currency, rate, as_of, created_at, created_by, updated_at, updated_by, revision
+RETURNING id, base_currency, quote_currency, rate, as_of, created_at, created_by, updated_at, updated_by, revision, rate_type
 `
 
 type UpdateExchangeRateParams struct {
@@ -207,6 +258,7 @@ type UpdateExchangeRateParams struct {
 	AsOf          time.Time
 	UpdatedBy     sql.NullString
 	Revision      sql.NullString
+	RateType      string
 }
 
 // Update exchange rate
@@ -219,6 +271,7 @@ func (q *Queries) UpdateExchangeRate(ctx context.Context, arg UpdateExchangeRate
 		arg.AsOf,
 		arg.UpdatedBy,
 		arg.Revision,
+		arg.RateType,
 	)
 	var i ExchangeRate
 	err := row.Scan(
@@ -232,6 +285,7 @@ func (q *Queries) UpdateExchangeRate(ctx context.Context, arg UpdateExchangeRate
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.RateType,
 	)
 	return i, err
 }
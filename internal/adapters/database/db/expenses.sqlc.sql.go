@@ -97,18 +97,19 @@ func (q *Queries) CreateCostCenter(ctx context.Context, arg CreateCostCenterPara
 
 const createExpense = `-- name: CreateExpense :one
 
-INSERT INTO expenses (category, amount, expense_date, cost_center_id, created_by, updated_by)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, category, amount, expense_date, cost_center_id, created_at, created_by, updated_at, updated_by, revision
+INSERT INTO expenses (category, amount, expense_date, cost_center_id, created_by, updated_by, organization_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, category, amount, expense_date, cost_center_id, created_at, created_by, updated_at, updated_by, revision, organization_id
 `
 
 type CreateExpenseParams struct {
-	Category     string
-	Amount       string
-	ExpenseDate  time.Time
-	CostCenterID uuid.NullUUID
-	CreatedBy    sql.NullString
-	UpdatedBy    sql.NullString
+	Category       string
+	Amount         string
+	ExpenseDate    time.Time
+	CostCenterID   uuid.NullUUID
+	CreatedBy      sql.NullString
+	UpdatedBy      sql.NullString
+	OrganizationID sql.NullString
 }
 
 // =====================================================
@@ -122,6 +123,7 @@ func (q *Queries) CreateExpense(ctx context.Context, arg CreateExpenseParams) (E
 		arg.CostCenterID,
 		arg.CreatedBy,
 		arg.UpdatedBy,
+		arg.OrganizationID,
 	)
 	var i Expense
 	err := row.Scan(
@@ -135,6 +137,7 @@ func (q *Queries) CreateExpense(ctx context.Context, arg CreateExpenseParams) (E
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -178,7 +181,7 @@ func (q *Queries) GetCostCenter(ctx context.Context, id uuid.UUID) (CostCenter,
 }
 
 const getExpense = `-- name: GetExpense :one
-SELECT id, category, amount, expense_date, cost_center_id, created_at, created_by, updated_at, updated_by, revision FROM expenses WHERE id = $1
+SELECT id, category, amount, expense_date, cost_center_id, created_at, created_by, updated_at, updated_by, revision, organization_id FROM expenses WHERE id = $1
 `
 
 func (q *Queries) GetExpense(ctx context.Context, id uuid.UUID) (Expense, error) {
@@ -195,6 +198,7 @@ func (q *Queries) GetExpense(ctx context.Context, id uuid.UUID) (Expense, error)
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -288,7 +292,7 @@ func (q *Queries) ListCostCenters(ctx context.Context, arg ListCostCentersParams
 }
 
 const listExpenses = `-- name: ListExpenses :many
-SELECT id, category, amount, expense_date, cost_center_id, created_at, created_by, updated_at, updated_by, revision FROM expenses
+SELECT id, category, amount, expense_date, cost_center_id, created_at, created_by, updated_at, updated_by, revision, organization_id FROM expenses
 ORDER BY expense_date DESC
 LIMIT $1 OFFSET $2
 `
@@ -318,6 +322,7 @@ func (q *Queries) ListExpenses(ctx context.Context, arg ListExpensesParams) ([]E
 			&i.UpdatedAt,
 			&i.UpdatedBy,
 			&i.Revision,
+			&i.OrganizationID,
 		); err != nil {
 			return nil, err
 		}
@@ -381,7 +386,7 @@ SET category = $2,
     revision = revision + 1,
     updated_at = now()
 WHERE id = $1
-RETURNING id, category, amount, expense_date, cost_center_id, created_at, created_by, updated_at, updated_by, revision
+RETURNING id, category, amount, expense_date, cost_center_id, created_at, created_by, updated_at, updated_by, revision, organization_id
 `
 
 type UpdateExpenseParams struct {
@@ -414,6 +419,7 @@ func (q *Queries) UpdateExpense(ctx context.Context, arg UpdateExpenseParams) (E
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.OrganizationID,
 	)
 	return i, err
 }
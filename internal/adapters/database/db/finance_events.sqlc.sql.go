@@ -174,9 +174,9 @@ func (q *Queries) InsertPayrollPostedEvent(ctx context.Context, arg InsertPayrol
 
 const insertVendorBillApprovedEvent = `-- name: InsertVendorBillApprovedEvent :one
 INSERT INTO vendor_bill_approved_events (
-    vendor_bill_id, amount, approved_at, organization_id
-) VALUES ($1, $2, $3, $4)
-RETURNING id, vendor_bill_id, amount, approved_at, organization_id, created_at
+    vendor_bill_id, amount, approved_at, organization_id, party_id
+) VALUES ($1, $2, $3, $4, $5)
+RETURNING id, vendor_bill_id, amount, approved_at, organization_id, created_at, party_id
 `
 
 type InsertVendorBillApprovedEventParams struct {
@@ -184,6 +184,7 @@ type InsertVendorBillApprovedEventParams struct {
 	Amount         string
 	ApprovedAt     time.Time
 	OrganizationID string
+	PartyID        uuid.NullUUID
 }
 
 // =====================================================
@@ -195,6 +196,7 @@ func (q *Queries) InsertVendorBillApprovedEvent(ctx context.Context, arg InsertV
 		arg.Amount,
 		arg.ApprovedAt,
 		arg.OrganizationID,
+		arg.PartyID,
 	)
 	var i VendorBillApprovedEvent
 	err := row.Scan(
@@ -204,6 +206,7 @@ func (q *Queries) InsertVendorBillApprovedEvent(ctx context.Context, arg InsertV
 		&i.ApprovedAt,
 		&i.OrganizationID,
 		&i.CreatedAt,
+		&i.PartyID,
 	)
 	return i, err
 }
@@ -390,7 +393,7 @@ func (q *Queries) ListPayrollPostedEvents(ctx context.Context, arg ListPayrollPo
 }
 
 const listVendorBillApprovedEvents = `-- name: ListVendorBillApprovedEvents :many
-SELECT id, vendor_bill_id, amount, approved_at, organization_id, created_at
+SELECT id, vendor_bill_id, amount, approved_at, organization_id, created_at, party_id
 FROM vendor_bill_approved_events
 WHERE organization_id = $1
 ORDER BY approved_at DESC
@@ -419,6 +422,7 @@ func (q *Queries) ListVendorBillApprovedEvents(ctx context.Context, arg ListVend
 			&i.ApprovedAt,
 			&i.OrganizationID,
 			&i.CreatedAt,
+			&i.PartyID,
 		); err != nil {
 			return nil, err
 		}
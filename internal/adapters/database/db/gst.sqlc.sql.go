@@ -55,10 +55,11 @@ func (q *Queries) AddGstBreakup(ctx context.Context, arg AddGstBreakupParams) (G
 const addGstDocStatus = `-- name: AddGstDocStatus :one
 INSERT INTO gst_doc_statuses (
     invoice_id, einvoice_status, irn, ack_no, ack_date,
-    eway_status, eway_bill_no, eway_valid_upto, last_error, last_synced_at
+    eway_status, eway_bill_no, eway_valid_upto, last_error, last_synced_at,
+    signed_qr_code
 )
-VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
-RETURNING id, invoice_id, einvoice_status, irn, ack_no, ack_date, eway_status, eway_bill_no, eway_valid_upto, last_error, last_synced_at, created_at, created_by, revision
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)
+RETURNING id, invoice_id, einvoice_status, irn, ack_no, ack_date, eway_status, eway_bill_no, eway_valid_upto, last_error, last_synced_at, created_at, created_by, revision, signed_qr_code
 `
 
 type AddGstDocStatusParams struct {
@@ -72,6 +73,7 @@ type AddGstDocStatusParams struct {
 	EwayValidUpto  sql.NullTime
 	LastError      sql.NullString
 	LastSyncedAt   sql.NullTime
+	SignedQrCode   sql.NullString
 }
 
 func (q *Queries) AddGstDocStatus(ctx context.Context, arg AddGstDocStatusParams) (GstDocStatus, error) {
@@ -86,6 +88,7 @@ func (q *Queries) AddGstDocStatus(ctx context.Context, arg AddGstDocStatusParams
 		arg.EwayValidUpto,
 		arg.LastError,
 		arg.LastSyncedAt,
+		arg.SignedQrCode,
 	)
 	var i GstDocStatus
 	err := row.Scan(
@@ -103,6 +106,7 @@ func (q *Queries) AddGstDocStatus(ctx context.Context, arg AddGstDocStatusParams
 		&i.CreatedAt,
 		&i.CreatedBy,
 		&i.Revision,
+		&i.SignedQrCode,
 	)
 	return i, err
 }
@@ -141,6 +145,24 @@ func (q *Queries) AddGstRegime(ctx context.Context, arg AddGstRegimeParams) (Gst
 	return i, err
 }
 
+const deleteGstBreakup = `-- name: DeleteGstBreakup :exec
+DELETE FROM gst_breakups WHERE invoice_id = $1
+`
+
+func (q *Queries) DeleteGstBreakup(ctx context.Context, invoiceID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteGstBreakup, invoiceID)
+	return err
+}
+
+const deleteGstRegime = `-- name: DeleteGstRegime :exec
+DELETE FROM gst_regimes WHERE invoice_id = $1
+`
+
+func (q *Queries) DeleteGstRegime(ctx context.Context, invoiceID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteGstRegime, invoiceID)
+	return err
+}
+
 const getGstBreakup = `-- name: GetGstBreakup :one
 SELECT id, invoice_id, taxable_amount, cgst, sgst, igst, total_gst, created_at, created_by, revision FROM gst_breakups WHERE invoice_id = $1
 `
@@ -164,7 +186,8 @@ func (q *Queries) GetGstBreakup(ctx context.Context, invoiceID uuid.UUID) (GstBr
 }
 
 const getGstDocStatus = `-- name: GetGstDocStatus :one
-SELECT id, invoice_id, einvoice_status, irn, ack_no, ack_date, eway_status, eway_bill_no, eway_valid_upto, last_error, last_synced_at, created_at, created_by, revision FROM gst_doc_statuses WHERE invoice_id = $1
+SELECT id, invoice_id, einvoice_status, irn, ack_no, ack_date, eway_status, eway_bill_no, eway_valid_upto, last_error, last_synced_at, created_at, created_by, revision, signed_qr_code FROM gst_doc_statuses WHERE invoice_id = $1
+ORDER BY created_at DESC LIMIT 1
 `
 
 func (q *Queries) GetGstDocStatus(ctx context.Context, invoiceID uuid.UUID) (GstDocStatus, error) {
@@ -185,6 +208,7 @@ func (q *Queries) GetGstDocStatus(ctx context.Context, invoiceID uuid.UUID) (Gst
 		&i.CreatedAt,
 		&i.CreatedBy,
 		&i.Revision,
+		&i.SignedQrCode,
 	)
 	return i, err
 }
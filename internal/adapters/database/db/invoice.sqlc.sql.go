@@ -14,18 +14,24 @@ import (
 )
 
 const addInvoiceDiscount = `-- name: AddInvoiceDiscount :one
-INSERT INTO invoice_discounts (invoice_id, description, amount)
-VALUES ($1, $2, $3) RETURNING id, invoice_id, description, amount, created_at, created_by, revision
+INSERT INTO invoice_discounts (invoice_id, description, amount, line_no)
+VALUES ($1, $2, $3, $4) RETURNING id, invoice_id, description, amount, created_at, created_by, revision, line_no
 `
 
 type AddInvoiceDiscountParams struct {
 	InvoiceID   uuid.UUID
 	Description sql.NullString
 	Amount      string
+	LineNo      int32
 }
 
 func (q *Queries) AddInvoiceDiscount(ctx context.Context, arg AddInvoiceDiscountParams) (InvoiceDiscount, error) {
-	row := q.db.QueryRowContext(ctx, addInvoiceDiscount, arg.InvoiceID, arg.Description, arg.Amount)
+	row := q.db.QueryRowContext(ctx, addInvoiceDiscount,
+		arg.InvoiceID,
+		arg.Description,
+		arg.Amount,
+		arg.LineNo,
+	)
 	var i InvoiceDiscount
 	err := row.Scan(
 		&i.ID,
@@ -35,13 +41,87 @@ func (q *Queries) AddInvoiceDiscount(ctx context.Context, arg AddInvoiceDiscount
 		&i.CreatedAt,
 		&i.CreatedBy,
 		&i.Revision,
+		&i.LineNo,
+	)
+	return i, err
+}
+
+const addInvoiceItemDiscount = `-- name: AddInvoiceItemDiscount :one
+INSERT INTO invoice_item_discounts (item_id, description, amount, created_by, line_no)
+VALUES ($1, $2, $3, $4, $5) RETURNING id, item_id, description, amount, created_at, created_by, revision, line_no
+`
+
+type AddInvoiceItemDiscountParams struct {
+	ItemID      uuid.UUID
+	Description sql.NullString
+	Amount      string
+	CreatedBy   sql.NullString
+	LineNo      int32
+}
+
+func (q *Queries) AddInvoiceItemDiscount(ctx context.Context, arg AddInvoiceItemDiscountParams) (InvoiceItemDiscount, error) {
+	row := q.db.QueryRowContext(ctx, addInvoiceItemDiscount,
+		arg.ItemID,
+		arg.Description,
+		arg.Amount,
+		arg.CreatedBy,
+		arg.LineNo,
+	)
+	var i InvoiceItemDiscount
+	err := row.Scan(
+		&i.ID,
+		&i.ItemID,
+		&i.Description,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.Revision,
+		&i.LineNo,
+	)
+	return i, err
+}
+
+const addInvoiceItemTax = `-- name: AddInvoiceItemTax :one
+INSERT INTO invoice_item_taxes (item_id, name, rate, amount, created_by, line_no)
+VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, item_id, name, rate, amount, created_at, created_by, revision, line_no
+`
+
+type AddInvoiceItemTaxParams struct {
+	ItemID    uuid.UUID
+	Name      string
+	Rate      string
+	Amount    string
+	CreatedBy sql.NullString
+	LineNo    int32
+}
+
+func (q *Queries) AddInvoiceItemTax(ctx context.Context, arg AddInvoiceItemTaxParams) (InvoiceItemTax, error) {
+	row := q.db.QueryRowContext(ctx, addInvoiceItemTax,
+		arg.ItemID,
+		arg.Name,
+		arg.Rate,
+		arg.Amount,
+		arg.CreatedBy,
+		arg.LineNo,
+	)
+	var i InvoiceItemTax
+	err := row.Scan(
+		&i.ID,
+		&i.ItemID,
+		&i.Name,
+		&i.Rate,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.Revision,
+		&i.LineNo,
 	)
 	return i, err
 }
 
 const addInvoiceTax = `-- name: AddInvoiceTax :one
-INSERT INTO invoice_taxes (invoice_id, name, rate, amount)
-VALUES ($1, $2, $3, $4) RETURNING id, invoice_id, name, rate, amount, created_at, created_by, revision
+INSERT INTO invoice_taxes (invoice_id, name, rate, amount, line_no)
+VALUES ($1, $2, $3, $4, $5) RETURNING id, invoice_id, name, rate, amount, created_at, created_by, revision, line_no
 `
 
 type AddInvoiceTaxParams struct {
@@ -49,6 +129,7 @@ type AddInvoiceTaxParams struct {
 	Name      string
 	Rate      string
 	Amount    string
+	LineNo    int32
 }
 
 func (q *Queries) AddInvoiceTax(ctx context.Context, arg AddInvoiceTaxParams) (InvoiceTax, error) {
@@ -57,6 +138,7 @@ func (q *Queries) AddInvoiceTax(ctx context.Context, arg AddInvoiceTaxParams) (I
 		arg.Name,
 		arg.Rate,
 		arg.Amount,
+		arg.LineNo,
 	)
 	var i InvoiceTax
 	err := row.Scan(
@@ -68,6 +150,7 @@ func (q *Queries) AddInvoiceTax(ctx context.Context, arg AddInvoiceTaxParams) (I
 		&i.CreatedAt,
 		&i.CreatedBy,
 		&i.Revision,
+		&i.LineNo,
 	)
 	return i, err
 }
@@ -76,7 +159,7 @@ const createInvoice = `-- name: CreateInvoice :one
 INSERT INTO invoices (
     invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date,
     lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, gst_cgst, gst_sgst, gst_igst, gst_rate, grand_total,
-    created_by, updated_by, revision
+    created_by, updated_by, revision, currency_code, discount_total, tax_total, round_off, party_id
 ) VALUES (
     $1, $2, $3, $4, $5,
     $6, $7, $8, $9,
@@ -84,7 +167,7 @@ INSERT INTO invoices (
     $15, $16, $17, $18,
     $19, $20,
     $21, $22, $23, $24, $25, $26,
-    $27, $28) RETURNING id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision
+    $27, $28, $29, $30, $31, $32, $33) RETURNING id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision, currency_code, discount_total, tax_total, round_off, issued_at, issued_by, voided_at, voided_by, void_reason, overdue_at, overdue_by, journal_id, party_id, credit_approved_by, credit_approved_at, credited_total, debited_total
 `
 
 type CreateInvoiceParams struct {
@@ -116,6 +199,11 @@ type CreateInvoiceParams struct {
 	CreatedBy            sql.NullString
 	UpdatedBy            sql.NullString
 	Revision             sql.NullInt32
+	CurrencyCode         string
+	DiscountTotal        string
+	TaxTotal             string
+	RoundOff             string
+	PartyID              uuid.NullUUID
 }
 
 func (q *Queries) CreateInvoice(ctx context.Context, arg CreateInvoiceParams) (Invoice, error) {
@@ -148,6 +236,11 @@ func (q *Queries) CreateInvoice(ctx context.Context, arg CreateInvoiceParams) (I
 		arg.CreatedBy,
 		arg.UpdatedBy,
 		arg.Revision,
+		arg.CurrencyCode,
+		arg.DiscountTotal,
+		arg.TaxTotal,
+		arg.RoundOff,
+		arg.PartyID,
 	)
 	var i Invoice
 	err := row.Scan(
@@ -182,16 +275,33 @@ func (q *Queries) CreateInvoice(ctx context.Context, arg CreateInvoiceParams) (I
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.RoundOff,
+		&i.IssuedAt,
+		&i.IssuedBy,
+		&i.VoidedAt,
+		&i.VoidedBy,
+		&i.VoidReason,
+		&i.OverdueAt,
+		&i.OverdueBy,
+		&i.JournalID,
+		&i.PartyID,
+		&i.CreditApprovedBy,
+		&i.CreditApprovedAt,
+		&i.CreditedTotal,
+		&i.DebitedTotal,
 	)
 	return i, err
 }
 
 const createInvoiceItem = `-- name: CreateInvoiceItem :one
 INSERT INTO invoice_items (
-    invoice_id, name, description, hsn, quantity, unit_price, line_subtotal, line_total, cost_center_id
+    invoice_id, name, description, hsn, quantity, unit_price, line_subtotal, line_total, cost_center_id, line_no, account_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
-) RETURNING id, invoice_id, name, description, hsn, quantity, unit_price, line_subtotal, line_total, cost_center_id, created_at, created_by, updated_at, updated_by, revision
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) RETURNING id, invoice_id, name, description, hsn, quantity, unit_price, line_subtotal, line_total, cost_center_id, created_at, created_by, updated_at, updated_by, revision, line_no, account_id
 `
 
 type CreateInvoiceItemParams struct {
@@ -204,6 +314,8 @@ type CreateInvoiceItemParams struct {
 	LineSubtotal string
 	LineTotal    string
 	CostCenterID sql.NullString
+	LineNo       int32
+	AccountID    uuid.NullUUID
 }
 
 func (q *Queries) CreateInvoiceItem(ctx context.Context, arg CreateInvoiceItemParams) (InvoiceItem, error) {
@@ -217,6 +329,8 @@ func (q *Queries) CreateInvoiceItem(ctx context.Context, arg CreateInvoiceItemPa
 		arg.LineSubtotal,
 		arg.LineTotal,
 		arg.CostCenterID,
+		arg.LineNo,
+		arg.AccountID,
 	)
 	var i InvoiceItem
 	err := row.Scan(
@@ -235,21 +349,54 @@ func (q *Queries) CreateInvoiceItem(ctx context.Context, arg CreateInvoiceItemPa
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.LineNo,
+		&i.AccountID,
 	)
 	return i, err
 }
 
-const deleteInvoice = `-- name: DeleteInvoice :exec
-DELETE FROM invoices WHERE id = $1
+const deleteInvoice = `-- name: DeleteInvoice :execrows
+DELETE FROM invoices WHERE id = $1 AND status = 'DRAFT'
+`
+
+func (q *Queries) DeleteInvoice(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteInvoice, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteInvoiceDiscounts = `-- name: DeleteInvoiceDiscounts :exec
+DELETE FROM invoice_discounts WHERE invoice_id = $1
+`
+
+func (q *Queries) DeleteInvoiceDiscounts(ctx context.Context, invoiceID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteInvoiceDiscounts, invoiceID)
+	return err
+}
+
+const deleteInvoiceItems = `-- name: DeleteInvoiceItems :exec
+DELETE FROM invoice_items WHERE invoice_id = $1
+`
+
+// Item discounts and taxes go with their items.
+func (q *Queries) DeleteInvoiceItems(ctx context.Context, invoiceID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteInvoiceItems, invoiceID)
+	return err
+}
+
+const deleteInvoiceTaxes = `-- name: DeleteInvoiceTaxes :exec
+DELETE FROM invoice_taxes WHERE invoice_id = $1
 `
 
-func (q *Queries) DeleteInvoice(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteInvoice, id)
+func (q *Queries) DeleteInvoiceTaxes(ctx context.Context, invoiceID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteInvoiceTaxes, invoiceID)
 	return err
 }
 
 const getInvoice = `-- name: GetInvoice :one
-SELECT id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision FROM invoices WHERE id = $1
+SELECT id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision, currency_code, discount_total, tax_total, round_off, issued_at, issued_by, voided_at, voided_by, void_reason, overdue_at, overdue_by, journal_id, party_id, credit_approved_by, credit_approved_at, credited_total, debited_total FROM invoices WHERE id = $1
 `
 
 func (q *Queries) GetInvoice(ctx context.Context, id uuid.UUID) (Invoice, error) {
@@ -287,39 +434,199 @@ func (q *Queries) GetInvoice(ctx context.Context, id uuid.UUID) (Invoice, error)
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.RoundOff,
+		&i.IssuedAt,
+		&i.IssuedBy,
+		&i.VoidedAt,
+		&i.VoidedBy,
+		&i.VoidReason,
+		&i.OverdueAt,
+		&i.OverdueBy,
+		&i.JournalID,
+		&i.PartyID,
+		&i.CreditApprovedBy,
+		&i.CreditApprovedAt,
+		&i.CreditedTotal,
+		&i.DebitedTotal,
 	)
 	return i, err
 }
 
-const listInvoiceItems = `-- name: ListInvoiceItems :many
-SELECT id, invoice_id, name, description, hsn, quantity, unit_price, line_subtotal, line_total, cost_center_id, created_at, created_by, updated_at, updated_by, revision FROM invoice_items WHERE invoice_id = $1
+const getInvoiceNumberSeries = `-- name: GetInvoiceNumberSeries :one
+SELECT organization_id, invoice_type, pattern, created_at, created_by, updated_at, updated_by FROM invoice_number_series WHERE organization_id = $1 AND invoice_type = $2
 `
 
-func (q *Queries) ListInvoiceItems(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceItem, error) {
-	rows, err := q.db.QueryContext(ctx, listInvoiceItems, invoiceID)
+type GetInvoiceNumberSeriesParams struct {
+	OrganizationID string
+	InvoiceType    string
+}
+
+func (q *Queries) GetInvoiceNumberSeries(ctx context.Context, arg GetInvoiceNumberSeriesParams) (InvoiceNumberSeries, error) {
+	row := q.db.QueryRowContext(ctx, getInvoiceNumberSeries, arg.OrganizationID, arg.InvoiceType)
+	var i InvoiceNumberSeries
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.InvoiceType,
+		&i.Pattern,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const getInvoicePostingRule = `-- name: GetInvoicePostingRule :one
+SELECT organization_id, invoice_type, control_account_id, line_account_id, cgst_account_id, sgst_account_id, igst_account_id, other_tax_account_id, discount_account_id, round_off_account_id, created_at, created_by, updated_at, updated_by FROM invoice_posting_rules WHERE organization_id = $1 AND invoice_type = $2
+`
+
+type GetInvoicePostingRuleParams struct {
+	OrganizationID string
+	InvoiceType    string
+}
+
+func (q *Queries) GetInvoicePostingRule(ctx context.Context, arg GetInvoicePostingRuleParams) (InvoicePostingRule, error) {
+	row := q.db.QueryRowContext(ctx, getInvoicePostingRule, arg.OrganizationID, arg.InvoiceType)
+	var i InvoicePostingRule
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.InvoiceType,
+		&i.ControlAccountID,
+		&i.LineAccountID,
+		&i.CgstAccountID,
+		&i.SgstAccountID,
+		&i.IgstAccountID,
+		&i.OtherTaxAccountID,
+		&i.DiscountAccountID,
+		&i.RoundOffAccountID,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const getInvoiceTemplate = `-- name: GetInvoiceTemplate :one
+SELECT organization_id, company_name, address, logo, bank_details, terms, footer, created_at, created_by, updated_at, updated_by, country_code, peppol_endpoint FROM invoice_templates WHERE organization_id = $1
+`
+
+func (q *Queries) GetInvoiceTemplate(ctx context.Context, organizationID string) (InvoiceTemplate, error) {
+	row := q.db.QueryRowContext(ctx, getInvoiceTemplate, organizationID)
+	var i InvoiceTemplate
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.CompanyName,
+		&i.Address,
+		&i.Logo,
+		&i.BankDetails,
+		&i.Terms,
+		&i.Footer,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.CountryCode,
+		&i.PeppolEndpoint,
+	)
+	return i, err
+}
+
+const issueInvoice = `-- name: IssueInvoice :one
+UPDATE invoices
+SET status = 'ISSUED', issued_at = now(), issued_by = $2,
+    updated_by = $2, updated_at = now(), revision = revision + 1
+WHERE id = $1 AND status = 'DRAFT' AND revision = $3
+RETURNING id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision, currency_code, discount_total, tax_total, round_off, issued_at, issued_by, voided_at, voided_by, void_reason, overdue_at, overdue_by, journal_id, party_id, credit_approved_by, credit_approved_at, credited_total, debited_total
+`
+
+type IssueInvoiceParams struct {
+	ID       uuid.UUID
+	IssuedBy sql.NullString
+	Revision sql.NullInt32
+}
+
+func (q *Queries) IssueInvoice(ctx context.Context, arg IssueInvoiceParams) (Invoice, error) {
+	row := q.db.QueryRowContext(ctx, issueInvoice, arg.ID, arg.IssuedBy, arg.Revision)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceNumber,
+		&i.Type,
+		&i.InvoiceDate,
+		&i.DueDate,
+		&i.DeliveryDate,
+		&i.OrganizationID,
+		&i.PoNumber,
+		&i.EwayNumberLegacy,
+		&i.StatusNote,
+		&i.Status,
+		&i.PaymentReference,
+		&i.ChallanNumber,
+		&i.ChallanDate,
+		&i.LrNumber,
+		&i.TransporterName,
+		&i.TransporterID,
+		&i.VehicleNumber,
+		&i.AgainstInvoiceNumber,
+		&i.AgainstInvoiceDate,
+		&i.Subtotal,
+		&i.GrandTotal,
+		&i.GstRate,
+		&i.GstCgst,
+		&i.GstSgst,
+		&i.GstIgst,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.Revision,
+		&i.CurrencyCode,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.RoundOff,
+		&i.IssuedAt,
+		&i.IssuedBy,
+		&i.VoidedAt,
+		&i.VoidedBy,
+		&i.VoidReason,
+		&i.OverdueAt,
+		&i.OverdueBy,
+		&i.JournalID,
+		&i.PartyID,
+		&i.CreditApprovedBy,
+		&i.CreditApprovedAt,
+		&i.CreditedTotal,
+		&i.DebitedTotal,
+	)
+	return i, err
+}
+
+const listInvoiceDiscounts = `-- name: ListInvoiceDiscounts :many
+SELECT id, invoice_id, description, amount, created_at, created_by, revision, line_no FROM invoice_discounts WHERE invoice_id = $1 ORDER BY line_no, created_at, id
+`
+
+func (q *Queries) ListInvoiceDiscounts(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceDiscount, error) {
+	rows, err := q.db.QueryContext(ctx, listInvoiceDiscounts, invoiceID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []InvoiceItem
+	var items []InvoiceDiscount
 	for rows.Next() {
-		var i InvoiceItem
+		var i InvoiceDiscount
 		if err := rows.Scan(
 			&i.ID,
 			&i.InvoiceID,
-			&i.Name,
 			&i.Description,
-			&i.Hsn,
-			&i.Quantity,
-			&i.UnitPrice,
-			&i.LineSubtotal,
-			&i.LineTotal,
-			&i.CostCenterID,
+			&i.Amount,
 			&i.CreatedAt,
 			&i.CreatedBy,
-			&i.UpdatedAt,
-			&i.UpdatedBy,
 			&i.Revision,
+			&i.LineNo,
 		); err != nil {
 			return nil, err
 		}
@@ -334,56 +641,31 @@ func (q *Queries) ListInvoiceItems(ctx context.Context, invoiceID uuid.UUID) ([]
 	return items, nil
 }
 
-const listInvoices = `-- name: ListInvoices :many
-SELECT id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision FROM invoices ORDER BY invoice_date DESC LIMIT $1 OFFSET $2
+const listInvoiceItemDiscounts = `-- name: ListInvoiceItemDiscounts :many
+SELECT d.id, d.item_id, d.description, d.amount, d.created_at, d.created_by, d.revision, d.line_no FROM invoice_item_discounts d
+JOIN invoice_items i ON i.id = d.item_id
+WHERE i.invoice_id = $1
+ORDER BY d.line_no, d.created_at, d.id
 `
 
-type ListInvoicesParams struct {
-	Limit  int32
-	Offset int32
-}
-
-func (q *Queries) ListInvoices(ctx context.Context, arg ListInvoicesParams) ([]Invoice, error) {
-	rows, err := q.db.QueryContext(ctx, listInvoices, arg.Limit, arg.Offset)
+func (q *Queries) ListInvoiceItemDiscounts(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceItemDiscount, error) {
+	rows, err := q.db.QueryContext(ctx, listInvoiceItemDiscounts, invoiceID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Invoice
+	var items []InvoiceItemDiscount
 	for rows.Next() {
-		var i Invoice
+		var i InvoiceItemDiscount
 		if err := rows.Scan(
 			&i.ID,
-			&i.InvoiceNumber,
-			&i.Type,
-			&i.InvoiceDate,
-			&i.DueDate,
-			&i.DeliveryDate,
-			&i.OrganizationID,
-			&i.PoNumber,
-			&i.EwayNumberLegacy,
-			&i.StatusNote,
-			&i.Status,
-			&i.PaymentReference,
-			&i.ChallanNumber,
-			&i.ChallanDate,
-			&i.LrNumber,
-			&i.TransporterName,
-			&i.TransporterID,
-			&i.VehicleNumber,
-			&i.AgainstInvoiceNumber,
-			&i.AgainstInvoiceDate,
-			&i.Subtotal,
-			&i.GrandTotal,
-			&i.GstRate,
-			&i.GstCgst,
-			&i.GstSgst,
-			&i.GstIgst,
+			&i.ItemID,
+			&i.Description,
+			&i.Amount,
 			&i.CreatedAt,
 			&i.CreatedBy,
-			&i.UpdatedAt,
-			&i.UpdatedBy,
 			&i.Revision,
+			&i.LineNo,
 		); err != nil {
 			return nil, err
 		}
@@ -398,68 +680,32 @@ func (q *Queries) ListInvoices(ctx context.Context, arg ListInvoicesParams) ([]I
 	return items, nil
 }
 
-const searchInvoices = `-- name: SearchInvoices :many
-SELECT id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision
-FROM invoices
-WHERE 
-    (invoice_number ILIKE '%' || $1 || '%'
-     OR po_number ILIKE '%' || $1 || '%'
-     OR status_note ILIKE '%' || $1 || '%'
-     OR payment_reference ILIKE '%' || $1 || '%'
-     OR transporter_name ILIKE '%' || $1 || '%'
-     OR transporter_id ILIKE '%' || $1 || '%'
-     OR vehicle_number ILIKE '%' || $1 || '%')
-ORDER BY invoice_date DESC
-LIMIT $2 OFFSET $3
+const listInvoiceItemTaxes = `-- name: ListInvoiceItemTaxes :many
+SELECT t.id, t.item_id, t.name, t.rate, t.amount, t.created_at, t.created_by, t.revision, t.line_no FROM invoice_item_taxes t
+JOIN invoice_items i ON i.id = t.item_id
+WHERE i.invoice_id = $1
+ORDER BY t.line_no, t.created_at, t.id
 `
 
-type SearchInvoicesParams struct {
-	Column1 sql.NullString
-	Limit   int32
-	Offset  int32
-}
-
-func (q *Queries) SearchInvoices(ctx context.Context, arg SearchInvoicesParams) ([]Invoice, error) {
-	rows, err := q.db.QueryContext(ctx, searchInvoices, arg.Column1, arg.Limit, arg.Offset)
+func (q *Queries) ListInvoiceItemTaxes(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceItemTax, error) {
+	rows, err := q.db.QueryContext(ctx, listInvoiceItemTaxes, invoiceID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Invoice
+	var items []InvoiceItemTax
 	for rows.Next() {
-		var i Invoice
+		var i InvoiceItemTax
 		if err := rows.Scan(
 			&i.ID,
-			&i.InvoiceNumber,
-			&i.Type,
-			&i.InvoiceDate,
-			&i.DueDate,
-			&i.DeliveryDate,
-			&i.OrganizationID,
-			&i.PoNumber,
-			&i.EwayNumberLegacy,
-			&i.StatusNote,
-			&i.Status,
-			&i.PaymentReference,
-			&i.ChallanNumber,
-			&i.ChallanDate,
-			&i.LrNumber,
-			&i.TransporterName,
-			&i.TransporterID,
-			&i.VehicleNumber,
-			&i.AgainstInvoiceNumber,
-			&i.AgainstInvoiceDate,
-			&i.Subtotal,
-			&i.GrandTotal,
-			&i.GstRate,
-			&i.GstCgst,
-			&i.GstSgst,
-			&i.GstIgst,
+			&i.ItemID,
+			&i.Name,
+			&i.Rate,
+			&i.Amount,
 			&i.CreatedAt,
 			&i.CreatedBy,
-			&i.UpdatedAt,
-			&i.UpdatedBy,
 			&i.Revision,
+			&i.LineNo,
 		); err != nil {
 			return nil, err
 		}
@@ -474,28 +720,482 @@ func (q *Queries) SearchInvoices(ctx context.Context, arg SearchInvoicesParams)
 	return items, nil
 }
 
-const updateInvoice = `-- name: UpdateInvoice :one
-UPDATE invoices
-SET 
-    invoice_number       = $2,
-    type                 = $3,
-    invoice_date         = $4,
-    due_date             = $5,
-    delivery_date        = $6,
-    organization_id      = $7,
-    po_number            = $8,
-    eway_number_legacy   = $9,
-    status_note          = $10,
-    status               = $11,
-    payment_reference    = $12,
-    challan_number       = $13,
-    challan_date         = $14,
-    lr_number            = $15,
-    transporter_name     = $16,
-    transporter_id       = $17,
-    vehicle_number       = $18,
-    against_invoice_number = $19,
-    against_invoice_date = $20,
+const listInvoiceItems = `-- name: ListInvoiceItems :many
+SELECT id, invoice_id, name, description, hsn, quantity, unit_price, line_subtotal, line_total, cost_center_id, created_at, created_by, updated_at, updated_by, revision, line_no, account_id FROM invoice_items WHERE invoice_id = $1 ORDER BY line_no, created_at, id
+`
+
+func (q *Queries) ListInvoiceItems(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceItem, error) {
+	rows, err := q.db.QueryContext(ctx, listInvoiceItems, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InvoiceItem
+	for rows.Next() {
+		var i InvoiceItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.InvoiceID,
+			&i.Name,
+			&i.Description,
+			&i.Hsn,
+			&i.Quantity,
+			&i.UnitPrice,
+			&i.LineSubtotal,
+			&i.LineTotal,
+			&i.CostCenterID,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.UpdatedAt,
+			&i.UpdatedBy,
+			&i.Revision,
+			&i.LineNo,
+			&i.AccountID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInvoiceTaxes = `-- name: ListInvoiceTaxes :many
+SELECT id, invoice_id, name, rate, amount, created_at, created_by, revision, line_no FROM invoice_taxes WHERE invoice_id = $1 ORDER BY line_no, created_at, id
+`
+
+func (q *Queries) ListInvoiceTaxes(ctx context.Context, invoiceID uuid.UUID) ([]InvoiceTax, error) {
+	rows, err := q.db.QueryContext(ctx, listInvoiceTaxes, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InvoiceTax
+	for rows.Next() {
+		var i InvoiceTax
+		if err := rows.Scan(
+			&i.ID,
+			&i.InvoiceID,
+			&i.Name,
+			&i.Rate,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.Revision,
+			&i.LineNo,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInvoices = `-- name: ListInvoices :many
+SELECT id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision, currency_code, discount_total, tax_total, round_off, issued_at, issued_by, voided_at, voided_by, void_reason, overdue_at, overdue_by, journal_id, party_id, credit_approved_by, credit_approved_at, credited_total, debited_total FROM invoices ORDER BY invoice_date DESC LIMIT $1 OFFSET $2
+`
+
+type ListInvoicesParams struct {
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) ListInvoices(ctx context.Context, arg ListInvoicesParams) ([]Invoice, error) {
+	rows, err := q.db.QueryContext(ctx, listInvoices, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Invoice
+	for rows.Next() {
+		var i Invoice
+		if err := rows.Scan(
+			&i.ID,
+			&i.InvoiceNumber,
+			&i.Type,
+			&i.InvoiceDate,
+			&i.DueDate,
+			&i.DeliveryDate,
+			&i.OrganizationID,
+			&i.PoNumber,
+			&i.EwayNumberLegacy,
+			&i.StatusNote,
+			&i.Status,
+			&i.PaymentReference,
+			&i.ChallanNumber,
+			&i.ChallanDate,
+			&i.LrNumber,
+			&i.TransporterName,
+			&i.TransporterID,
+			&i.VehicleNumber,
+			&i.AgainstInvoiceNumber,
+			&i.AgainstInvoiceDate,
+			&i.Subtotal,
+			&i.GrandTotal,
+			&i.GstRate,
+			&i.GstCgst,
+			&i.GstSgst,
+			&i.GstIgst,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.UpdatedAt,
+			&i.UpdatedBy,
+			&i.Revision,
+			&i.CurrencyCode,
+			&i.DiscountTotal,
+			&i.TaxTotal,
+			&i.RoundOff,
+			&i.IssuedAt,
+			&i.IssuedBy,
+			&i.VoidedAt,
+			&i.VoidedBy,
+			&i.VoidReason,
+			&i.OverdueAt,
+			&i.OverdueBy,
+			&i.JournalID,
+			&i.PartyID,
+			&i.CreditApprovedBy,
+			&i.CreditApprovedAt,
+			&i.CreditedTotal,
+			&i.DebitedTotal,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markInvoiceOverdue = `-- name: MarkInvoiceOverdue :one
+UPDATE invoices
+SET status = 'OVERDUE', overdue_at = now(), overdue_by = $2,
+    updated_by = $2, updated_at = now(), revision = revision + 1
+WHERE id = $1 AND status IN ('ISSUED', 'PARTIALLY_PAID')
+RETURNING id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision, currency_code, discount_total, tax_total, round_off, issued_at, issued_by, voided_at, voided_by, void_reason, overdue_at, overdue_by, journal_id, party_id, credit_approved_by, credit_approved_at, credited_total, debited_total
+`
+
+type MarkInvoiceOverdueParams struct {
+	ID        uuid.UUID
+	OverdueBy sql.NullString
+}
+
+func (q *Queries) MarkInvoiceOverdue(ctx context.Context, arg MarkInvoiceOverdueParams) (Invoice, error) {
+	row := q.db.QueryRowContext(ctx, markInvoiceOverdue, arg.ID, arg.OverdueBy)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceNumber,
+		&i.Type,
+		&i.InvoiceDate,
+		&i.DueDate,
+		&i.DeliveryDate,
+		&i.OrganizationID,
+		&i.PoNumber,
+		&i.EwayNumberLegacy,
+		&i.StatusNote,
+		&i.Status,
+		&i.PaymentReference,
+		&i.ChallanNumber,
+		&i.ChallanDate,
+		&i.LrNumber,
+		&i.TransporterName,
+		&i.TransporterID,
+		&i.VehicleNumber,
+		&i.AgainstInvoiceNumber,
+		&i.AgainstInvoiceDate,
+		&i.Subtotal,
+		&i.GrandTotal,
+		&i.GstRate,
+		&i.GstCgst,
+		&i.GstSgst,
+		&i.GstIgst,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.Revision,
+		&i.CurrencyCode,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.RoundOff,
+		&i.IssuedAt,
+		&i.IssuedBy,
+		&i.VoidedAt,
+		&i.VoidedBy,
+		&i.VoidReason,
+		&i.OverdueAt,
+		&i.OverdueBy,
+		&i.JournalID,
+		&i.PartyID,
+		&i.CreditApprovedBy,
+		&i.CreditApprovedAt,
+		&i.CreditedTotal,
+		&i.DebitedTotal,
+	)
+	return i, err
+}
+
+const nextInvoiceNumber = `-- name: NextInvoiceNumber :one
+INSERT INTO invoice_number_counters (organization_id, invoice_type, fiscal_year, last_number)
+VALUES ($1, $2, $3, 1)
+ON CONFLICT (organization_id, invoice_type, fiscal_year) DO UPDATE
+SET last_number = invoice_number_counters.last_number + 1
+RETURNING last_number
+`
+
+type NextInvoiceNumberParams struct {
+	OrganizationID string
+	InvoiceType    string
+	FiscalYear     int32
+}
+
+func (q *Queries) NextInvoiceNumber(ctx context.Context, arg NextInvoiceNumberParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, nextInvoiceNumber, arg.OrganizationID, arg.InvoiceType, arg.FiscalYear)
+	var last_number int64
+	err := row.Scan(&last_number)
+	return last_number, err
+}
+
+const recordInvoiceIssue = `-- name: RecordInvoiceIssue :one
+UPDATE invoices SET invoice_number = $2, journal_id = $3
+WHERE id = $1
+RETURNING id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision, currency_code, discount_total, tax_total, round_off, issued_at, issued_by, voided_at, voided_by, void_reason, overdue_at, overdue_by, journal_id, party_id, credit_approved_by, credit_approved_at, credited_total, debited_total
+`
+
+type RecordInvoiceIssueParams struct {
+	ID            uuid.UUID
+	InvoiceNumber string
+	JournalID     uuid.NullUUID
+}
+
+func (q *Queries) RecordInvoiceIssue(ctx context.Context, arg RecordInvoiceIssueParams) (Invoice, error) {
+	row := q.db.QueryRowContext(ctx, recordInvoiceIssue, arg.ID, arg.InvoiceNumber, arg.JournalID)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceNumber,
+		&i.Type,
+		&i.InvoiceDate,
+		&i.DueDate,
+		&i.DeliveryDate,
+		&i.OrganizationID,
+		&i.PoNumber,
+		&i.EwayNumberLegacy,
+		&i.StatusNote,
+		&i.Status,
+		&i.PaymentReference,
+		&i.ChallanNumber,
+		&i.ChallanDate,
+		&i.LrNumber,
+		&i.TransporterName,
+		&i.TransporterID,
+		&i.VehicleNumber,
+		&i.AgainstInvoiceNumber,
+		&i.AgainstInvoiceDate,
+		&i.Subtotal,
+		&i.GrandTotal,
+		&i.GstRate,
+		&i.GstCgst,
+		&i.GstSgst,
+		&i.GstIgst,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.Revision,
+		&i.CurrencyCode,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.RoundOff,
+		&i.IssuedAt,
+		&i.IssuedBy,
+		&i.VoidedAt,
+		&i.VoidedBy,
+		&i.VoidReason,
+		&i.OverdueAt,
+		&i.OverdueBy,
+		&i.JournalID,
+		&i.PartyID,
+		&i.CreditApprovedBy,
+		&i.CreditApprovedAt,
+		&i.CreditedTotal,
+		&i.DebitedTotal,
+	)
+	return i, err
+}
+
+const searchInvoices = `-- name: SearchInvoices :many
+SELECT i.id, i.invoice_number, i.type, i.invoice_date, i.due_date, i.delivery_date, i.organization_id, i.po_number, i.eway_number_legacy, i.status_note, i.status, i.payment_reference, i.challan_number, i.challan_date, i.lr_number, i.transporter_name, i.transporter_id, i.vehicle_number, i.against_invoice_number, i.against_invoice_date, i.subtotal, i.grand_total, i.gst_rate, i.gst_cgst, i.gst_sgst, i.gst_igst, i.created_at, i.created_by, i.updated_at, i.updated_by, i.revision, i.currency_code, i.discount_total, i.tax_total, i.round_off, i.issued_at, i.issued_by, i.voided_at, i.voided_by, i.void_reason, i.overdue_at, i.overdue_by, i.journal_id, i.party_id, i.credit_approved_by, i.credit_approved_at, i.credited_total, i.debited_total
+FROM invoices i
+LEFT JOIN invoice_search s ON s.invoice_id = i.id
+WHERE i.organization_id = $1
+  AND ($2::text = ''
+       OR s.document @@ websearch_to_tsquery('simple', $2::text)
+       OR i.party_id IN (SELECT r.party_id FROM party_tax_registrations r WHERE r.number = upper($2::text)))
+  AND ($3::text IS NULL OR i.status = $3)
+  AND ($4::text IS NULL OR i.type = $4)
+  AND ($5::timestamptz IS NULL OR i.invoice_date >= $5)
+  AND ($6::timestamptz IS NULL OR i.invoice_date <= $6)
+  AND ($7::timestamptz IS NULL OR i.due_date >= $7)
+  AND ($8::timestamptz IS NULL OR i.due_date <= $8)
+  AND ($9::numeric IS NULL OR i.grand_total >= $9)
+  AND ($10::numeric IS NULL OR i.grand_total <= $10)
+ORDER BY
+  CASE WHEN $11::text = 'relevance'
+       THEN COALESCE(ts_rank(s.document, websearch_to_tsquery('simple', $2::text)), 0) END DESC,
+  CASE WHEN $11::text = 'amount' AND NOT $12::bool THEN i.grand_total END ASC,
+  CASE WHEN $11::text = 'amount' AND $12::bool THEN i.grand_total END DESC,
+  CASE WHEN $11::text = 'date' AND NOT $12::bool THEN i.invoice_date END ASC,
+  i.invoice_date DESC, i.id
+LIMIT $14 OFFSET $13
+`
+
+type SearchInvoicesParams struct {
+	OrganizationID string
+	Query          string
+	Status         sql.NullString
+	Type           sql.NullString
+	FromDate       sql.NullTime
+	ToDate         sql.NullTime
+	DueFrom        sql.NullTime
+	DueTo          sql.NullTime
+	MinTotal       sql.NullString
+	MaxTotal       sql.NullString
+	OrderBy        string
+	Descending     bool
+	PageOffset     int32
+	PageLimit      int32
+}
+
+// The query is parsed as web search syntax and matched against the invoice
+// search documents; a GSTIN also matches its party's invoices exactly.
+// Relevance ordering ranks number hits above party and item hits.
+func (q *Queries) SearchInvoices(ctx context.Context, arg SearchInvoicesParams) ([]Invoice, error) {
+	rows, err := q.db.QueryContext(ctx, searchInvoices,
+		arg.OrganizationID,
+		arg.Query,
+		arg.Status,
+		arg.Type,
+		arg.FromDate,
+		arg.ToDate,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.MinTotal,
+		arg.MaxTotal,
+		arg.OrderBy,
+		arg.Descending,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Invoice
+	for rows.Next() {
+		var i Invoice
+		if err := rows.Scan(
+			&i.ID,
+			&i.InvoiceNumber,
+			&i.Type,
+			&i.InvoiceDate,
+			&i.DueDate,
+			&i.DeliveryDate,
+			&i.OrganizationID,
+			&i.PoNumber,
+			&i.EwayNumberLegacy,
+			&i.StatusNote,
+			&i.Status,
+			&i.PaymentReference,
+			&i.ChallanNumber,
+			&i.ChallanDate,
+			&i.LrNumber,
+			&i.TransporterName,
+			&i.TransporterID,
+			&i.VehicleNumber,
+			&i.AgainstInvoiceNumber,
+			&i.AgainstInvoiceDate,
+			&i.Subtotal,
+			&i.GrandTotal,
+			&i.GstRate,
+			&i.GstCgst,
+			&i.GstSgst,
+			&i.GstIgst,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.UpdatedAt,
+			&i.UpdatedBy,
+			&i.Revision,
+			&i.CurrencyCode,
+			&i.DiscountTotal,
+			&i.TaxTotal,
+			&i.RoundOff,
+			&i.IssuedAt,
+			&i.IssuedBy,
+			&i.VoidedAt,
+			&i.VoidedBy,
+			&i.VoidReason,
+			&i.OverdueAt,
+			&i.OverdueBy,
+			&i.JournalID,
+			&i.PartyID,
+			&i.CreditApprovedBy,
+			&i.CreditApprovedAt,
+			&i.CreditedTotal,
+			&i.DebitedTotal,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateInvoice = `-- name: UpdateInvoice :one
+UPDATE invoices
+SET 
+    invoice_number       = $2,
+    type                 = $3,
+    invoice_date         = $4,
+    due_date             = $5,
+    delivery_date        = $6,
+    organization_id      = $7,
+    po_number            = $8,
+    eway_number_legacy   = $9,
+    status_note          = $10,
+    status               = $11,
+    payment_reference    = $12,
+    challan_number       = $13,
+    challan_date         = $14,
+    lr_number            = $15,
+    transporter_name     = $16,
+    transporter_id       = $17,
+    vehicle_number       = $18,
+    against_invoice_number = $19,
+    against_invoice_date = $20,
     subtotal             = $21,
     grand_total          = $22,
     gst_rate             = $23,
@@ -503,10 +1203,17 @@ SET
     gst_sgst             = $25,
     gst_igst             = $26,
     updated_by           = $27,
-    revision             = $28,
+    revision             = revision + 1,
+    currency_code        = $28,
+    discount_total       = $29,
+    tax_total            = $30,
+    round_off            = $31,
+    party_id             = $32,
+    credit_approved_by   = NULL,
+    credit_approved_at   = NULL,
     updated_at           = now()
-WHERE id = $1
-RETURNING id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision
+WHERE id = $1 AND status = 'DRAFT'
+RETURNING id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision, currency_code, discount_total, tax_total, round_off, issued_at, issued_by, voided_at, voided_by, void_reason, overdue_at, overdue_by, journal_id, party_id, credit_approved_by, credit_approved_at, credited_total, debited_total
 `
 
 type UpdateInvoiceParams struct {
@@ -537,7 +1244,11 @@ type UpdateInvoiceParams struct {
 	GstSgst              sql.NullString
 	GstIgst              sql.NullString
 	UpdatedBy            sql.NullString
-	Revision             sql.NullInt32
+	CurrencyCode         string
+	DiscountTotal        string
+	TaxTotal             string
+	RoundOff             string
+	PartyID              uuid.NullUUID
 }
 
 func (q *Queries) UpdateInvoice(ctx context.Context, arg UpdateInvoiceParams) (Invoice, error) {
@@ -569,8 +1280,382 @@ func (q *Queries) UpdateInvoice(ctx context.Context, arg UpdateInvoiceParams) (I
 		arg.GstSgst,
 		arg.GstIgst,
 		arg.UpdatedBy,
-		arg.Revision,
+		arg.CurrencyCode,
+		arg.DiscountTotal,
+		arg.TaxTotal,
+		arg.RoundOff,
+		arg.PartyID,
+	)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceNumber,
+		&i.Type,
+		&i.InvoiceDate,
+		&i.DueDate,
+		&i.DeliveryDate,
+		&i.OrganizationID,
+		&i.PoNumber,
+		&i.EwayNumberLegacy,
+		&i.StatusNote,
+		&i.Status,
+		&i.PaymentReference,
+		&i.ChallanNumber,
+		&i.ChallanDate,
+		&i.LrNumber,
+		&i.TransporterName,
+		&i.TransporterID,
+		&i.VehicleNumber,
+		&i.AgainstInvoiceNumber,
+		&i.AgainstInvoiceDate,
+		&i.Subtotal,
+		&i.GrandTotal,
+		&i.GstRate,
+		&i.GstCgst,
+		&i.GstSgst,
+		&i.GstIgst,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.Revision,
+		&i.CurrencyCode,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.RoundOff,
+		&i.IssuedAt,
+		&i.IssuedBy,
+		&i.VoidedAt,
+		&i.VoidedBy,
+		&i.VoidReason,
+		&i.OverdueAt,
+		&i.OverdueBy,
+		&i.JournalID,
+		&i.PartyID,
+		&i.CreditApprovedBy,
+		&i.CreditApprovedAt,
+		&i.CreditedTotal,
+		&i.DebitedTotal,
+	)
+	return i, err
+}
+
+const updateInvoiceTaxAmount = `-- name: UpdateInvoiceTaxAmount :one
+UPDATE invoice_taxes SET amount = $2, revision = revision + 1
+WHERE id = $1 RETURNING id, invoice_id, name, rate, amount, created_at, created_by, revision, line_no
+`
+
+type UpdateInvoiceTaxAmountParams struct {
+	ID     uuid.UUID
+	Amount string
+}
+
+// Rewrites an invoice-level tax after the taxable value changed.
+func (q *Queries) UpdateInvoiceTaxAmount(ctx context.Context, arg UpdateInvoiceTaxAmountParams) (InvoiceTax, error) {
+	row := q.db.QueryRowContext(ctx, updateInvoiceTaxAmount, arg.ID, arg.Amount)
+	var i InvoiceTax
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceID,
+		&i.Name,
+		&i.Rate,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.Revision,
+		&i.LineNo,
+	)
+	return i, err
+}
+
+const updateInvoiceTotals = `-- name: UpdateInvoiceTotals :one
+UPDATE invoices
+SET subtotal           = $2,
+    discount_total     = $3,
+    tax_total          = $4,
+    gst_rate           = $5,
+    gst_cgst           = $6,
+    gst_sgst           = $7,
+    gst_igst           = $8,
+    round_off          = $9,
+    grand_total        = $10,
+    credit_approved_by = NULL,
+    credit_approved_at = NULL,
+    updated_at         = now(),
+    revision           = revision + 1
+WHERE id = $1 AND status = 'DRAFT'
+RETURNING id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision, currency_code, discount_total, tax_total, round_off, issued_at, issued_by, voided_at, voided_by, void_reason, overdue_at, overdue_by, journal_id, party_id, credit_approved_by, credit_approved_at, credited_total, debited_total
+`
+
+type UpdateInvoiceTotalsParams struct {
+	ID            uuid.UUID
+	Subtotal      string
+	DiscountTotal string
+	TaxTotal      string
+	GstRate       sql.NullString
+	GstCgst       sql.NullString
+	GstSgst       sql.NullString
+	GstIgst       sql.NullString
+	RoundOff      string
+	GrandTotal    string
+}
+
+// Stores figures computed from the invoice's lines. Appended lines change
+// the draft, so its revision moves on and a credit approval given for it is
+// withdrawn, like any other update.
+func (q *Queries) UpdateInvoiceTotals(ctx context.Context, arg UpdateInvoiceTotalsParams) (Invoice, error) {
+	row := q.db.QueryRowContext(ctx, updateInvoiceTotals,
+		arg.ID,
+		arg.Subtotal,
+		arg.DiscountTotal,
+		arg.TaxTotal,
+		arg.GstRate,
+		arg.GstCgst,
+		arg.GstSgst,
+		arg.GstIgst,
+		arg.RoundOff,
+		arg.GrandTotal,
+	)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.InvoiceNumber,
+		&i.Type,
+		&i.InvoiceDate,
+		&i.DueDate,
+		&i.DeliveryDate,
+		&i.OrganizationID,
+		&i.PoNumber,
+		&i.EwayNumberLegacy,
+		&i.StatusNote,
+		&i.Status,
+		&i.PaymentReference,
+		&i.ChallanNumber,
+		&i.ChallanDate,
+		&i.LrNumber,
+		&i.TransporterName,
+		&i.TransporterID,
+		&i.VehicleNumber,
+		&i.AgainstInvoiceNumber,
+		&i.AgainstInvoiceDate,
+		&i.Subtotal,
+		&i.GrandTotal,
+		&i.GstRate,
+		&i.GstCgst,
+		&i.GstSgst,
+		&i.GstIgst,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.Revision,
+		&i.CurrencyCode,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.RoundOff,
+		&i.IssuedAt,
+		&i.IssuedBy,
+		&i.VoidedAt,
+		&i.VoidedBy,
+		&i.VoidReason,
+		&i.OverdueAt,
+		&i.OverdueBy,
+		&i.JournalID,
+		&i.PartyID,
+		&i.CreditApprovedBy,
+		&i.CreditApprovedAt,
+		&i.CreditedTotal,
+		&i.DebitedTotal,
 	)
+	return i, err
+}
+
+const upsertInvoiceNumberSeries = `-- name: UpsertInvoiceNumberSeries :one
+INSERT INTO invoice_number_series (organization_id, invoice_type, pattern, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $4)
+ON CONFLICT (organization_id, invoice_type) DO UPDATE
+SET pattern = EXCLUDED.pattern,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = now()
+RETURNING organization_id, invoice_type, pattern, created_at, created_by, updated_at, updated_by
+`
+
+type UpsertInvoiceNumberSeriesParams struct {
+	OrganizationID string
+	InvoiceType    string
+	Pattern        string
+	CreatedBy      sql.NullString
+}
+
+func (q *Queries) UpsertInvoiceNumberSeries(ctx context.Context, arg UpsertInvoiceNumberSeriesParams) (InvoiceNumberSeries, error) {
+	row := q.db.QueryRowContext(ctx, upsertInvoiceNumberSeries,
+		arg.OrganizationID,
+		arg.InvoiceType,
+		arg.Pattern,
+		arg.CreatedBy,
+	)
+	var i InvoiceNumberSeries
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.InvoiceType,
+		&i.Pattern,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const upsertInvoicePostingRule = `-- name: UpsertInvoicePostingRule :one
+INSERT INTO invoice_posting_rules (
+    organization_id, invoice_type, control_account_id, line_account_id,
+    cgst_account_id, sgst_account_id, igst_account_id, other_tax_account_id,
+    discount_account_id, round_off_account_id, created_by, updated_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $11)
+ON CONFLICT (organization_id, invoice_type) DO UPDATE
+SET control_account_id = EXCLUDED.control_account_id,
+    line_account_id = EXCLUDED.line_account_id,
+    cgst_account_id = EXCLUDED.cgst_account_id,
+    sgst_account_id = EXCLUDED.sgst_account_id,
+    igst_account_id = EXCLUDED.igst_account_id,
+    other_tax_account_id = EXCLUDED.other_tax_account_id,
+    discount_account_id = EXCLUDED.discount_account_id,
+    round_off_account_id = EXCLUDED.round_off_account_id,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = now()
+RETURNING organization_id, invoice_type, control_account_id, line_account_id, cgst_account_id, sgst_account_id, igst_account_id, other_tax_account_id, discount_account_id, round_off_account_id, created_at, created_by, updated_at, updated_by
+`
+
+type UpsertInvoicePostingRuleParams struct {
+	OrganizationID    string
+	InvoiceType       string
+	ControlAccountID  uuid.UUID
+	LineAccountID     uuid.UUID
+	CgstAccountID     uuid.NullUUID
+	SgstAccountID     uuid.NullUUID
+	IgstAccountID     uuid.NullUUID
+	OtherTaxAccountID uuid.NullUUID
+	DiscountAccountID uuid.NullUUID
+	RoundOffAccountID uuid.NullUUID
+	CreatedBy         sql.NullString
+}
+
+func (q *Queries) UpsertInvoicePostingRule(ctx context.Context, arg UpsertInvoicePostingRuleParams) (InvoicePostingRule, error) {
+	row := q.db.QueryRowContext(ctx, upsertInvoicePostingRule,
+		arg.OrganizationID,
+		arg.InvoiceType,
+		arg.ControlAccountID,
+		arg.LineAccountID,
+		arg.CgstAccountID,
+		arg.SgstAccountID,
+		arg.IgstAccountID,
+		arg.OtherTaxAccountID,
+		arg.DiscountAccountID,
+		arg.RoundOffAccountID,
+		arg.CreatedBy,
+	)
+	var i InvoicePostingRule
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.InvoiceType,
+		&i.ControlAccountID,
+		&i.LineAccountID,
+		&i.CgstAccountID,
+		&i.SgstAccountID,
+		&i.IgstAccountID,
+		&i.OtherTaxAccountID,
+		&i.DiscountAccountID,
+		&i.RoundOffAccountID,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+	)
+	return i, err
+}
+
+const upsertInvoiceTemplate = `-- name: UpsertInvoiceTemplate :one
+INSERT INTO invoice_templates (
+    organization_id, company_name, address, logo, bank_details, terms, footer,
+    created_by, updated_by, country_code, peppol_endpoint
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8, $9, $10)
+ON CONFLICT (organization_id) DO UPDATE
+SET company_name = EXCLUDED.company_name,
+    address = EXCLUDED.address,
+    logo = EXCLUDED.logo,
+    bank_details = EXCLUDED.bank_details,
+    terms = EXCLUDED.terms,
+    footer = EXCLUDED.footer,
+    country_code = EXCLUDED.country_code,
+    peppol_endpoint = EXCLUDED.peppol_endpoint,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = now()
+RETURNING organization_id, company_name, address, logo, bank_details, terms, footer, created_at, created_by, updated_at, updated_by, country_code, peppol_endpoint
+`
+
+type UpsertInvoiceTemplateParams struct {
+	OrganizationID string
+	CompanyName    string
+	Address        sql.NullString
+	Logo           []byte
+	BankDetails    sql.NullString
+	Terms          sql.NullString
+	Footer         sql.NullString
+	CreatedBy      sql.NullString
+	CountryCode    string
+	PeppolEndpoint sql.NullString
+}
+
+func (q *Queries) UpsertInvoiceTemplate(ctx context.Context, arg UpsertInvoiceTemplateParams) (InvoiceTemplate, error) {
+	row := q.db.QueryRowContext(ctx, upsertInvoiceTemplate,
+		arg.OrganizationID,
+		arg.CompanyName,
+		arg.Address,
+		arg.Logo,
+		arg.BankDetails,
+		arg.Terms,
+		arg.Footer,
+		arg.CreatedBy,
+		arg.CountryCode,
+		arg.PeppolEndpoint,
+	)
+	var i InvoiceTemplate
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.CompanyName,
+		&i.Address,
+		&i.Logo,
+		&i.BankDetails,
+		&i.Terms,
+		&i.Footer,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.CountryCode,
+		&i.PeppolEndpoint,
+	)
+	return i, err
+}
+
+const voidInvoice = `-- name: VoidInvoice :one
+UPDATE invoices
+SET status = 'VOID', voided_at = now(), voided_by = $2, void_reason = $3,
+    updated_by = $2, updated_at = now(), revision = revision + 1
+WHERE id = $1 AND status IN ('ISSUED', 'OVERDUE')
+  AND credited_total = 0 AND debited_total = 0
+RETURNING id, invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date, lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, grand_total, gst_rate, gst_cgst, gst_sgst, gst_igst, created_at, created_by, updated_at, updated_by, revision, currency_code, discount_total, tax_total, round_off, issued_at, issued_by, voided_at, voided_by, void_reason, overdue_at, overdue_by, journal_id, party_id, credit_approved_by, credit_approved_at, credited_total, debited_total
+`
+
+type VoidInvoiceParams struct {
+	ID         uuid.UUID
+	VoidedBy   sql.NullString
+	VoidReason sql.NullString
+}
+
+func (q *Queries) VoidInvoice(ctx context.Context, arg VoidInvoiceParams) (Invoice, error) {
+	row := q.db.QueryRowContext(ctx, voidInvoice, arg.ID, arg.VoidedBy, arg.VoidReason)
 	var i Invoice
 	err := row.Scan(
 		&i.ID,
@@ -604,6 +1689,23 @@ func (q *Queries) UpdateInvoice(ctx context.Context, arg UpdateInvoiceParams) (I
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
+		&i.DiscountTotal,
+		&i.TaxTotal,
+		&i.RoundOff,
+		&i.IssuedAt,
+		&i.IssuedBy,
+		&i.VoidedAt,
+		&i.VoidedBy,
+		&i.VoidReason,
+		&i.OverdueAt,
+		&i.OverdueBy,
+		&i.JournalID,
+		&i.PartyID,
+		&i.CreditApprovedBy,
+		&i.CreditApprovedAt,
+		&i.CreditedTotal,
+		&i.DebitedTotal,
 	)
 	return i, err
 }
@@ -26,17 +26,27 @@ type Account struct {
 	Revision           sql.NullInt32
 }
 
+type AccountPeriodBalance struct {
+	AccountID      uuid.UUID
+	PeriodStart    time.Time
+	DebitTotal     string
+	CreditTotal    string
+	UpdatedAt      time.Time
+	OrganizationID string
+}
+
 type Accrual struct {
-	ID          uuid.UUID
-	Description sql.NullString
-	Amount      string
-	AccrualDate time.Time
-	AccountID   string
-	CreatedAt   sql.NullTime
-	CreatedBy   sql.NullString
-	UpdatedAt   sql.NullTime
-	UpdatedBy   sql.NullString
-	Revision    sql.NullInt32
+	ID             uuid.UUID
+	Description    sql.NullString
+	Amount         string
+	AccrualDate    time.Time
+	AccountID      string
+	CreatedAt      sql.NullTime
+	CreatedBy      sql.NullString
+	UpdatedAt      sql.NullTime
+	UpdatedBy      sql.NullString
+	Revision       sql.NullInt32
+	OrganizationID sql.NullString
 }
 
 type AllocationRule struct {
@@ -99,6 +109,8 @@ type BankAccount struct {
 	UpdatedAt       sql.NullTime
 	UpdatedBy       sql.NullString
 	Revision        sql.NullInt32
+	CurrencyCode    string
+	OrganizationID  sql.NullString
 }
 
 type BankTransaction struct {
@@ -119,15 +131,16 @@ type BankTransaction struct {
 }
 
 type Budget struct {
-	ID          uuid.UUID
-	Name        string
-	TotalAmount string
-	Status      string
-	CreatedAt   sql.NullTime
-	CreatedBy   sql.NullString
-	UpdatedAt   sql.NullTime
-	UpdatedBy   sql.NullString
-	Revision    sql.NullInt32
+	ID           uuid.UUID
+	Name         string
+	TotalAmount  string
+	Status       string
+	CreatedAt    sql.NullTime
+	CreatedBy    sql.NullString
+	UpdatedAt    sql.NullTime
+	UpdatedBy    sql.NullString
+	Revision     sql.NullInt32
+	CurrencyCode string
 }
 
 type BudgetAllocation struct {
@@ -209,16 +222,54 @@ type CostCenter struct {
 }
 
 type CreditDebitNote struct {
-	ID        uuid.UUID
-	InvoiceID uuid.UUID
-	Type      string
-	Amount    string
-	Reason    sql.NullString
-	CreatedAt sql.NullTime
-	CreatedBy sql.NullString
-	UpdatedAt sql.NullTime
-	UpdatedBy sql.NullString
-	Revision  sql.NullInt32
+	ID           uuid.UUID
+	InvoiceID    uuid.UUID
+	Type         string
+	Amount       string
+	Reason       sql.NullString
+	CreatedAt    sql.NullTime
+	CreatedBy    sql.NullString
+	UpdatedAt    sql.NullTime
+	UpdatedBy    sql.NullString
+	Revision     sql.NullInt32
+	JournalID    uuid.NullUUID
+	PaymentDueID uuid.NullUUID
+	AppliedAt    sql.NullTime
+}
+
+type CreditPolicy struct {
+	OrganizationID   string
+	ThresholdPercent string
+	Action           string
+	UpdatedAt        time.Time
+	UpdatedBy        sql.NullString
+}
+
+type DunningHold struct {
+	ID             uuid.UUID
+	OrganizationID string
+	InvoiceID      uuid.NullUUID
+	Reason         string
+	CreatedAt      time.Time
+	CreatedBy      sql.NullString
+	PartyID        uuid.NullUUID
+}
+
+type DunningReminder struct {
+	ID              uuid.UUID
+	InvoiceID       uuid.UUID
+	DaysFromDue     int32
+	EscalationLevel int32
+	SentAt          time.Time
+}
+
+type DunningStage struct {
+	OrganizationID  string
+	DaysFromDue     int32
+	EscalationLevel int32
+	Name            string
+	CreatedAt       time.Time
+	CreatedBy       sql.NullString
 }
 
 type ExchangeRate struct {
@@ -232,19 +283,21 @@ type ExchangeRate struct {
 	UpdatedAt     sql.NullTime
 	UpdatedBy     sql.NullString
 	Revision      sql.NullString
+	RateType      string
 }
 
 type Expense struct {
-	ID           uuid.UUID
-	Category     string
-	Amount       string
-	ExpenseDate  time.Time
-	CostCenterID uuid.NullUUID
-	CreatedAt    sql.NullTime
-	CreatedBy    sql.NullString
-	UpdatedAt    sql.NullTime
-	UpdatedBy    sql.NullString
-	Revision     sql.NullInt32
+	ID             uuid.UUID
+	Category       string
+	Amount         string
+	ExpenseDate    time.Time
+	CostCenterID   uuid.NullUUID
+	CreatedAt      sql.NullTime
+	CreatedBy      sql.NullString
+	UpdatedAt      sql.NullTime
+	UpdatedBy      sql.NullString
+	Revision       sql.NullInt32
+	OrganizationID sql.NullString
 }
 
 type FinanceInvoiceCreatedEvent struct {
@@ -282,6 +335,42 @@ type FinancePaymentReceivedEvent struct {
 	CreatedAt      sql.NullTime
 }
 
+type FiscalCalendar struct {
+	OrganizationID            string
+	YearStartMonth            int16
+	RetainedEarningsAccountID uuid.NullUUID
+	CreatedAt                 time.Time
+	CreatedBy                 sql.NullString
+	UpdatedAt                 time.Time
+	UpdatedBy                 sql.NullString
+	FunctionalCurrency        sql.NullString
+}
+
+type FiscalPeriod struct {
+	ID             uuid.UUID
+	OrganizationID string
+	FiscalYear     int32
+	PeriodNumber   int16
+	StartDate      time.Time
+	EndDate        time.Time
+	Status         string
+	ClosedAt       sql.NullTime
+	ClosedBy       sql.NullString
+	UpdatedAt      time.Time
+	YearClosedAt   sql.NullTime
+}
+
+type FxRevaluationSetting struct {
+	OrganizationID          string
+	UnrealizedGainAccountID uuid.UUID
+	UnrealizedLossAccountID uuid.UUID
+	AccountIds              []uuid.UUID
+	CreatedAt               time.Time
+	CreatedBy               sql.NullString
+	UpdatedAt               time.Time
+	UpdatedBy               sql.NullString
+}
+
 type GstBreakup struct {
 	ID            uuid.UUID
 	InvoiceID     uuid.UUID
@@ -310,6 +399,7 @@ type GstDocStatus struct {
 	CreatedAt      sql.NullTime
 	CreatedBy      sql.NullString
 	Revision       sql.NullInt32
+	SignedQrCode   sql.NullString
 }
 
 type GstRegime struct {
@@ -365,6 +455,30 @@ type Invoice struct {
 	UpdatedAt            sql.NullTime
 	UpdatedBy            sql.NullString
 	Revision             sql.NullInt32
+	CurrencyCode         string
+	DiscountTotal        string
+	TaxTotal             string
+	RoundOff             string
+	IssuedAt             sql.NullTime
+	IssuedBy             sql.NullString
+	VoidedAt             sql.NullTime
+	VoidedBy             sql.NullString
+	VoidReason           sql.NullString
+	OverdueAt            sql.NullTime
+	OverdueBy            sql.NullString
+	JournalID            uuid.NullUUID
+	PartyID              uuid.NullUUID
+	CreditApprovedBy     sql.NullString
+	CreditApprovedAt     sql.NullTime
+	CreditedTotal        string
+	DebitedTotal         string
+	// Lines and GST details, loaded and written with the invoice.
+	Items      []InvoiceItem
+	Discounts  []InvoiceDiscount
+	Taxes      []InvoiceTax
+	GstBreakup *GstBreakup
+	Gst        *GstRegime
+	GstDocs    *GstDocStatus
 }
 
 type InvoiceDiscount struct {
@@ -375,6 +489,7 @@ type InvoiceDiscount struct {
 	CreatedAt   sql.NullTime
 	CreatedBy   sql.NullString
 	Revision    sql.NullInt32
+	LineNo      int32
 }
 
 type InvoiceItem struct {
@@ -393,6 +508,10 @@ type InvoiceItem struct {
 	UpdatedAt    sql.NullTime
 	UpdatedBy    sql.NullString
 	Revision     sql.NullInt32
+	LineNo       int32
+	AccountID    uuid.NullUUID
+	Discounts []InvoiceItemDiscount
+	Taxes     []InvoiceItemTax
 }
 
 type InvoiceItemDiscount struct {
@@ -403,6 +522,7 @@ type InvoiceItemDiscount struct {
 	CreatedAt   sql.NullTime
 	CreatedBy   sql.NullString
 	Revision    sql.NullInt32
+	LineNo      int32
 }
 
 type InvoiceItemTax struct {
@@ -414,6 +534,46 @@ type InvoiceItemTax struct {
 	CreatedAt sql.NullTime
 	CreatedBy sql.NullString
 	Revision  sql.NullInt32
+	LineNo    int32
+}
+
+type InvoiceNumberCounter struct {
+	OrganizationID string
+	InvoiceType    string
+	FiscalYear     int32
+	LastNumber     int64
+}
+
+type InvoiceNumberSeries struct {
+	OrganizationID string
+	InvoiceType    string
+	Pattern        string
+	CreatedAt      time.Time
+	CreatedBy      sql.NullString
+	UpdatedAt      time.Time
+	UpdatedBy      sql.NullString
+}
+
+type InvoicePostingRule struct {
+	OrganizationID    string
+	InvoiceType       string
+	ControlAccountID  uuid.UUID
+	LineAccountID     uuid.UUID
+	CgstAccountID     uuid.NullUUID
+	SgstAccountID     uuid.NullUUID
+	IgstAccountID     uuid.NullUUID
+	OtherTaxAccountID uuid.NullUUID
+	DiscountAccountID uuid.NullUUID
+	RoundOffAccountID uuid.NullUUID
+	CreatedAt         time.Time
+	CreatedBy         sql.NullString
+	UpdatedAt         time.Time
+	UpdatedBy         sql.NullString
+}
+
+type InvoiceSearch struct {
+	InvoiceID uuid.UUID
+	Document  interface{}
 }
 
 type InvoiceTax struct {
@@ -425,53 +585,199 @@ type InvoiceTax struct {
 	CreatedAt sql.NullTime
 	CreatedBy sql.NullString
 	Revision  sql.NullInt32
+	LineNo    int32
+}
+
+type InvoiceTemplate struct {
+	OrganizationID string
+	CompanyName    string
+	Address        sql.NullString
+	Logo           []byte
+	BankDetails    sql.NullString
+	Terms          sql.NullString
+	Footer         sql.NullString
+	CreatedAt      time.Time
+	CreatedBy      sql.NullString
+	UpdatedAt      time.Time
+	UpdatedBy      sql.NullString
+	CountryCode    string
+	PeppolEndpoint sql.NullString
 }
 
 type JournalEntry struct {
-	ID          uuid.UUID
-	JournalDate time.Time
-	Reference   sql.NullString
-	Memo        sql.NullString
-	SourceType  sql.NullString
-	SourceID    sql.NullString
-	CreatedAt   time.Time
-	CreatedBy   sql.NullString
-	UpdatedAt   time.Time
-	UpdatedBy   sql.NullString
-	Revision    sql.NullInt32
+	ID              uuid.UUID
+	JournalDate     time.Time
+	Reference       sql.NullString
+	Memo            sql.NullString
+	SourceType      sql.NullString
+	SourceID        sql.NullString
+	CreatedAt       time.Time
+	CreatedBy       sql.NullString
+	UpdatedAt       time.Time
+	UpdatedBy       sql.NullString
+	Revision        sql.NullInt32
+	Status          string
+	PostedAt        sql.NullTime
+	PostedBy        sql.NullString
+	ReversalOf      uuid.NullUUID
+	ReversedBy      uuid.NullUUID
+	OrganizationID  sql.NullString
+	AutoReverseDate sql.NullTime
 	Lines       []JournalLine
 }
 
 type JournalLine struct {
+	ID                 uuid.UUID
+	JournalID          uuid.UUID
+	AccountID          uuid.UUID
+	Side               string
+	Amount             string
+	CostCenterID       uuid.NullUUID
+	Description        sql.NullString
+	CreatedAt          time.Time
+	CurrencyCode       string
+	TransactionAmount  string
+	ExchangeRate       string
+	FunctionalCurrency string
+}
+
+type JournalTemplate struct {
+	ID                uuid.UUID
+	OrganizationID    sql.NullString
+	Name              string
+	Reference         sql.NullString
+	Memo              sql.NullString
+	Frequency         string
+	StartDate         time.Time
+	EndDate           sql.NullTime
+	DayOfMonth        int16
+	AutoPost          bool
+	AdjustmentPercent string
+	Active            bool
+	LastOccurrence    sql.NullTime
+	CreatedAt         time.Time
+	CreatedBy         sql.NullString
+	UpdatedAt         time.Time
+	UpdatedBy         sql.NullString
+}
+
+type JournalTemplateLine struct {
 	ID           uuid.UUID
-	JournalID    uuid.UUID
+	TemplateID   uuid.UUID
+	LineNo       int16
 	AccountID    uuid.UUID
 	Side         string
 	Amount       string
 	CostCenterID uuid.NullUUID
 	Description  sql.NullString
-	CreatedAt    time.Time
+}
+
+type JournalTemplateRun struct {
+	TemplateID     uuid.UUID
+	OccurrenceDate time.Time
+	JournalID      uuid.NullUUID
+	CreatedAt      time.Time
 }
 
 type LedgerEntry struct {
-	EntryID         uuid.UUID
-	AccountID       uuid.UUID
-	Side            string
-	Amount          string
-	TransactionDate time.Time
+	EntryID            uuid.UUID
+	AccountID          uuid.UUID
+	Side               string
+	Amount             string
+	TransactionDate    time.Time
+	JournalID          uuid.NullUUID
+	CostCenterID       uuid.NullUUID
+	Description        sql.NullString
+	ReferenceType      sql.NullString
+	ReferenceID        sql.NullString
+	CreatedAt          time.Time
+	CurrencyCode       string
+	TransactionAmount  string
+	ExchangeRate       string
+	FunctionalCurrency string
 }
 
-type PaymentDue struct {
+type Party struct {
+	ID               uuid.UUID
+	OrganizationID   string
+	Kind             string
+	Name             string
+	LegalName        sql.NullString
+	Pan              sql.NullString
+	DefaultCurrency  sql.NullString
+	PaymentTermsDays sql.NullInt32
+	Active           bool
+	CreatedAt        time.Time
+	CreatedBy        sql.NullString
+	UpdatedAt        time.Time
+	UpdatedBy        sql.NullString
+	CreditLimit      sql.NullString
+	CreditHold       bool
+	CreditHoldReason sql.NullString
+	PeppolEndpoint   sql.NullString
+}
+
+type PartyAddress struct {
+	ID          uuid.UUID
+	PartyID     uuid.UUID
+	LineNo      int16
+	Kind        string
+	Line1       string
+	Line2       sql.NullString
+	City        sql.NullString
+	State       sql.NullString
+	StateCode   sql.NullString
+	PostalCode  sql.NullString
+	CountryCode string
+	IsDefault   bool
+}
+
+type PartyBankAccount struct {
+	ID            uuid.UUID
+	PartyID       uuid.UUID
+	LineNo        int16
+	AccountName   string
+	AccountNumber string
+	Ifsc          sql.NullString
+	BankName      sql.NullString
+	Branch        sql.NullString
+	IsPrimary     bool
+}
+
+type PartyContact struct {
+	ID          uuid.UUID
+	PartyID     uuid.UUID
+	LineNo      int16
+	Name        string
+	Email       sql.NullString
+	Phone       sql.NullString
+	Designation sql.NullString
+	IsPrimary   bool
+}
+
+type PartyTaxRegistration struct {
 	ID        uuid.UUID
-	InvoiceID uuid.UUID
-	AmountDue string
-	DueDate   time.Time
-	Status    string
-	CreatedAt sql.NullTime
-	CreatedBy sql.NullString
-	UpdatedAt sql.NullTime
-	UpdatedBy sql.NullString
-	Revision  sql.NullInt32
+	PartyID   uuid.UUID
+	LineNo    int16
+	TaxType   string
+	Number    string
+	StateCode sql.NullString
+}
+
+type PaymentDue struct {
+	ID           uuid.UUID
+	InvoiceID    uuid.UUID
+	AmountDue    string
+	DueDate      time.Time
+	Status       string
+	CreatedAt    sql.NullTime
+	CreatedBy    sql.NullString
+	UpdatedAt    sql.NullTime
+	UpdatedBy    sql.NullString
+	Revision     sql.NullInt32
+	CurrencyCode string
+	ExchangeRate string
+	AccountID    uuid.NullUUID
 }
 
 type PayrollPostedEvent struct {
@@ -499,6 +805,33 @@ type ProfitLossReport struct {
 	Revision       sql.NullInt32
 }
 
+type RecurringInvoice struct {
+	ID                uuid.UUID
+	OrganizationID    string
+	Name              string
+	TemplateInvoiceID uuid.UUID
+	Frequency         string
+	StartDate         time.Time
+	EndDate           sql.NullTime
+	BillingDay        int16
+	Prorate           bool
+	AutoIssue         bool
+	Active            bool
+	BilledThrough     sql.NullTime
+	CreatedAt         time.Time
+	CreatedBy         sql.NullString
+	UpdatedAt         time.Time
+	UpdatedBy         sql.NullString
+}
+
+type RecurringInvoiceRun struct {
+	RecurringInvoiceID uuid.UUID
+	PeriodStart        time.Time
+	PeriodEnd          time.Time
+	InvoiceID          uuid.NullUUID
+	CreatedAt          time.Time
+}
+
 type RequestMetadatum struct {
 	ID             uuid.UUID
 	RequestID      string
@@ -539,4 +872,5 @@ type VendorBillApprovedEvent struct {
 	ApprovedAt     time.Time
 	OrganizationID string
 	CreatedAt      sql.NullTime
+	PartyID        uuid.NullUUID
 }
@@ -16,10 +16,11 @@ import (
 const createBankAccount = `-- name: CreateBankAccount :one
 
 INSERT INTO bank_accounts (
-    name, account_number, ifsc_or_swift, ledger_account_id, created_by, updated_by
+    name, account_number, ifsc_or_swift, ledger_account_id, created_by, updated_by, currency_code,
+    organization_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
-) RETURNING id, name, account_number, ifsc_or_swift, ledger_account_id, created_at, created_by, updated_at, updated_by, revision
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id, name, account_number, ifsc_or_swift, ledger_account_id, created_at, created_by, updated_at, updated_by, revision, currency_code, organization_id
 `
 
 type CreateBankAccountParams struct {
@@ -29,6 +30,8 @@ type CreateBankAccountParams struct {
 	LedgerAccountID sql.NullString
 	CreatedBy       sql.NullString
 	UpdatedBy       sql.NullString
+	CurrencyCode    string
+	OrganizationID  sql.NullString
 }
 
 // =====================================================
@@ -42,6 +45,8 @@ func (q *Queries) CreateBankAccount(ctx context.Context, arg CreateBankAccountPa
 		arg.LedgerAccountID,
 		arg.CreatedBy,
 		arg.UpdatedBy,
+		arg.CurrencyCode,
+		arg.OrganizationID,
 	)
 	var i BankAccount
 	err := row.Scan(
@@ -55,6 +60,8 @@ func (q *Queries) CreateBankAccount(ctx context.Context, arg CreateBankAccountPa
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -62,19 +69,23 @@ func (q *Queries) CreateBankAccount(ctx context.Context, arg CreateBankAccountPa
 const createPaymentDue = `-- name: CreatePaymentDue :one
 
 INSERT INTO payment_dues (
-    invoice_id, amount_due, due_date, status, created_by, updated_by
+    invoice_id, amount_due, due_date, status, created_by, updated_by,
+    currency_code, exchange_rate, account_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
-) RETURNING id, invoice_id, amount_due, due_date, status, created_at, created_by, updated_at, updated_by, revision
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING id, invoice_id, amount_due, due_date, status, created_at, created_by, updated_at, updated_by, revision, currency_code, exchange_rate, account_id
 `
 
 type CreatePaymentDueParams struct {
-	InvoiceID uuid.UUID
-	AmountDue string
-	DueDate   time.Time
-	Status    string
-	CreatedBy sql.NullString
-	UpdatedBy sql.NullString
+	InvoiceID    uuid.UUID
+	AmountDue    string
+	DueDate      time.Time
+	Status       string
+	CreatedBy    sql.NullString
+	UpdatedBy    sql.NullString
+	CurrencyCode string
+	ExchangeRate string
+	AccountID    uuid.NullUUID
 }
 
 // =====================================================
@@ -88,6 +99,9 @@ func (q *Queries) CreatePaymentDue(ctx context.Context, arg CreatePaymentDuePara
 		arg.Status,
 		arg.CreatedBy,
 		arg.UpdatedBy,
+		arg.CurrencyCode,
+		arg.ExchangeRate,
+		arg.AccountID,
 	)
 	var i PaymentDue
 	err := row.Scan(
@@ -101,6 +115,9 @@ func (q *Queries) CreatePaymentDue(ctx context.Context, arg CreatePaymentDuePara
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
+		&i.ExchangeRate,
+		&i.AccountID,
 	)
 	return i, err
 }
@@ -124,7 +141,7 @@ func (q *Queries) DeletePaymentDue(ctx context.Context, id uuid.UUID) error {
 }
 
 const getBankAccount = `-- name: GetBankAccount :one
-SELECT id, name, account_number, ifsc_or_swift, ledger_account_id, created_at, created_by, updated_at, updated_by, revision FROM bank_accounts WHERE id = $1
+SELECT id, name, account_number, ifsc_or_swift, ledger_account_id, created_at, created_by, updated_at, updated_by, revision, currency_code, organization_id FROM bank_accounts WHERE id = $1
 `
 
 func (q *Queries) GetBankAccount(ctx context.Context, id uuid.UUID) (BankAccount, error) {
@@ -141,12 +158,14 @@ func (q *Queries) GetBankAccount(ctx context.Context, id uuid.UUID) (BankAccount
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
+		&i.OrganizationID,
 	)
 	return i, err
 }
 
 const getPaymentDue = `-- name: GetPaymentDue :one
-SELECT id, invoice_id, amount_due, due_date, status, created_at, created_by, updated_at, updated_by, revision FROM payment_dues WHERE id = $1
+SELECT id, invoice_id, amount_due, due_date, status, created_at, created_by, updated_at, updated_by, revision, currency_code, exchange_rate, account_id FROM payment_dues WHERE id = $1
 `
 
 func (q *Queries) GetPaymentDue(ctx context.Context, id uuid.UUID) (PaymentDue, error) {
@@ -163,6 +182,9 @@ func (q *Queries) GetPaymentDue(ctx context.Context, id uuid.UUID) (PaymentDue,
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
+		&i.ExchangeRate,
+		&i.AccountID,
 	)
 	return i, err
 }
@@ -230,7 +252,7 @@ func (q *Queries) ImportBankTransaction(ctx context.Context, arg ImportBankTrans
 }
 
 const listBankAccounts = `-- name: ListBankAccounts :many
-SELECT id, name, account_number, ifsc_or_swift, ledger_account_id, created_at, created_by, updated_at, updated_by, revision FROM bank_accounts
+SELECT id, name, account_number, ifsc_or_swift, ledger_account_id, created_at, created_by, updated_at, updated_by, revision, currency_code, organization_id FROM bank_accounts
 ORDER BY name ASC
 LIMIT $1 OFFSET $2
 `
@@ -260,6 +282,8 @@ func (q *Queries) ListBankAccounts(ctx context.Context, arg ListBankAccountsPara
 			&i.UpdatedAt,
 			&i.UpdatedBy,
 			&i.Revision,
+			&i.CurrencyCode,
+			&i.OrganizationID,
 		); err != nil {
 			return nil, err
 		}
@@ -326,7 +350,7 @@ func (q *Queries) ListBankTransactions(ctx context.Context, arg ListBankTransact
 }
 
 const listPaymentDues = `-- name: ListPaymentDues :many
-SELECT id, invoice_id, amount_due, due_date, status, created_at, created_by, updated_at, updated_by, revision FROM payment_dues
+SELECT id, invoice_id, amount_due, due_date, status, created_at, created_by, updated_at, updated_by, revision, currency_code, exchange_rate, account_id FROM payment_dues
 ORDER BY due_date ASC
 LIMIT $1 OFFSET $2
 `
@@ -356,6 +380,9 @@ func (q *Queries) ListPaymentDues(ctx context.Context, arg ListPaymentDuesParams
 			&i.UpdatedAt,
 			&i.UpdatedBy,
 			&i.Revision,
+			&i.CurrencyCode,
+			&i.ExchangeRate,
+			&i.AccountID,
 		); err != nil {
 			return nil, err
 		}
@@ -377,7 +404,7 @@ SET status = 'PAID',
     updated_by = $2,
     revision = revision + 1
 WHERE id = $1
-RETURNING id, invoice_id, amount_due, due_date, status, created_at, created_by, updated_at, updated_by, revision
+RETURNING id, invoice_id, amount_due, due_date, status, created_at, created_by, updated_at, updated_by, revision, currency_code, exchange_rate, account_id
 `
 
 type MarkPaymentAsPaidParams struct {
@@ -399,6 +426,9 @@ func (q *Queries) MarkPaymentAsPaid(ctx context.Context, arg MarkPaymentAsPaidPa
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
+		&i.ExchangeRate,
+		&i.AccountID,
 	)
 	return i, err
 }
@@ -456,10 +486,11 @@ SET name = $2,
     ifsc_or_swift = $4,
     ledger_account_id = $5,
     updated_by = $6,
+    currency_code = $7,
     updated_at = now(),
     revision = revision + 1
 WHERE id = $1
-RETURNING id, name, account_number, ifsc_or_swift, ledger_account_id, created_at, created_by, updated_at, updated_by, revision
+RETURNING id, name, account_number, ifsc_or_swift, ledger_account_id, created_at, created_by, updated_at, updated_by, revision, currency_code, organization_id
 `
 
 type UpdateBankAccountParams struct {
@@ -469,6 +500,7 @@ type UpdateBankAccountParams struct {
 	IfscOrSwift     string
 	LedgerAccountID sql.NullString
 	UpdatedBy       sql.NullString
+	CurrencyCode    string
 }
 
 func (q *Queries) UpdateBankAccount(ctx context.Context, arg UpdateBankAccountParams) (BankAccount, error) {
@@ -479,6 +511,7 @@ func (q *Queries) UpdateBankAccount(ctx context.Context, arg UpdateBankAccountPa
 		arg.IfscOrSwift,
 		arg.LedgerAccountID,
 		arg.UpdatedBy,
+		arg.CurrencyCode,
 	)
 	var i BankAccount
 	err := row.Scan(
@@ -492,6 +525,8 @@ func (q *Queries) UpdateBankAccount(ctx context.Context, arg UpdateBankAccountPa
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
+		&i.OrganizationID,
 	)
 	return i, err
 }
@@ -503,19 +538,25 @@ SET invoice_id = $2,
     due_date = $4,
     status = $5,
     updated_by = $6,
+    currency_code = $7,
+    exchange_rate = $8,
+    account_id = $9,
     updated_at = now(),
     revision = revision + 1
 WHERE id = $1
-RETURNING id, invoice_id, amount_due, due_date, status, created_at, created_by, updated_at, updated_by, revision
+RETURNING id, invoice_id, amount_due, due_date, status, created_at, created_by, updated_at, updated_by, revision, currency_code, exchange_rate, account_id
 `
 
 type UpdatePaymentDueParams struct {
-	ID        uuid.UUID
-	InvoiceID uuid.UUID
-	AmountDue string
-	DueDate   time.Time
-	Status    string
-	UpdatedBy sql.NullString
+	ID           uuid.UUID
+	InvoiceID    uuid.UUID
+	AmountDue    string
+	DueDate      time.Time
+	Status       string
+	UpdatedBy    sql.NullString
+	CurrencyCode string
+	ExchangeRate string
+	AccountID    uuid.NullUUID
 }
 
 func (q *Queries) UpdatePaymentDue(ctx context.Context, arg UpdatePaymentDueParams) (PaymentDue, error) {
@@ -526,6 +567,9 @@ func (q *Queries) UpdatePaymentDue(ctx context.Context, arg UpdatePaymentDuePara
 		arg.DueDate,
 		arg.Status,
 		arg.UpdatedBy,
+		arg.CurrencyCode,
+		arg.ExchangeRate,
+		arg.AccountID,
 	)
 	var i PaymentDue
 	err := row.Scan(
@@ -539,6 +583,9 @@ func (q *Queries) UpdatePaymentDue(ctx context.Context, arg UpdatePaymentDuePara
 		&i.UpdatedAt,
 		&i.UpdatedBy,
 		&i.Revision,
+		&i.CurrencyCode,
+		&i.ExchangeRate,
+		&i.AccountID,
 	)
 	return i, err
 }
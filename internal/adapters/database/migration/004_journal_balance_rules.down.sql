@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS trg_journal_lines_balanced ON journal_lines;
+DROP FUNCTION IF EXISTS check_journal_balanced();
+
+ALTER TABLE journal_lines
+    DROP CONSTRAINT IF EXISTS chk_journal_lines_amount_positive,
+    DROP CONSTRAINT IF EXISTS chk_journal_lines_side;
@@ -0,0 +1,45 @@
+-- =====================================================
+-- Journal line rules
+-- =====================================================
+-- Backstop for JournalService validation: every line must carry a positive
+-- amount on a valid side, and each journal must balance when the
+-- transaction commits.
+ALTER TABLE journal_lines
+    ADD CONSTRAINT chk_journal_lines_side CHECK (side IN ('DEBIT', 'CREDIT')),
+    ADD CONSTRAINT chk_journal_lines_amount_positive CHECK (amount > 0);
+
+CREATE OR REPLACE FUNCTION check_journal_balanced() RETURNS trigger AS $$
+DECLARE
+    jid UUID;
+    total_dr NUMERIC(18,2);
+    total_cr NUMERIC(18,2);
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        jid := OLD.journal_id;
+    ELSE
+        jid := NEW.journal_id;
+    END IF;
+
+    -- The whole journal was deleted; nothing left to balance.
+    IF NOT EXISTS (SELECT 1 FROM journal_entries WHERE id = jid) THEN
+        RETURN NULL;
+    END IF;
+
+    SELECT COALESCE(SUM(amount) FILTER (WHERE side = 'DEBIT'), 0),
+           COALESCE(SUM(amount) FILTER (WHERE side = 'CREDIT'), 0)
+      INTO total_dr, total_cr
+      FROM journal_lines
+     WHERE journal_id = jid;
+
+    IF total_dr <> total_cr THEN
+        RAISE EXCEPTION 'journal % does not balance: debits % <> credits %', jid, total_dr, total_cr
+            USING ERRCODE = 'check_violation';
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER trg_journal_lines_balanced
+    AFTER INSERT OR UPDATE OR DELETE ON journal_lines
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION check_journal_balanced();
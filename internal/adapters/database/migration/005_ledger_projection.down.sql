@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_ledger_entries_reference;
+DROP INDEX IF EXISTS idx_ledger_entries_journal;
+
+ALTER TABLE ledger_entries
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS reference_id,
+    DROP COLUMN IF EXISTS reference_type,
+    DROP COLUMN IF EXISTS description,
+    DROP COLUMN IF EXISTS cost_center_id,
+    DROP COLUMN IF EXISTS journal_id;
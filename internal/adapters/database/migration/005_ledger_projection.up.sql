@@ -0,0 +1,16 @@
+-- =====================================================
+-- Ledger projection
+-- =====================================================
+-- ledger_entries is append-only: rows are written by JournalSQLCRepository in
+-- the same transaction as the journal, and later edits are recorded as
+-- compensating rows on the opposite side rather than updates.
+ALTER TABLE ledger_entries
+    ADD COLUMN journal_id UUID,
+    ADD COLUMN cost_center_id UUID,
+    ADD COLUMN description TEXT,
+    ADD COLUMN reference_type VARCHAR(50),
+    ADD COLUMN reference_id VARCHAR(50),
+    ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT now();
+
+CREATE INDEX idx_ledger_entries_journal ON ledger_entries(journal_id);
+CREATE INDEX idx_ledger_entries_reference ON ledger_entries(reference_type, reference_id);
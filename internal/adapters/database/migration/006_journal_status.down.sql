@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS uq_journal_entries_reversal_of;
+
+ALTER TABLE journal_entries
+    DROP COLUMN IF EXISTS reversed_by,
+    DROP COLUMN IF EXISTS reversal_of,
+    DROP COLUMN IF EXISTS posted_by,
+    DROP COLUMN IF EXISTS posted_at,
+    DROP COLUMN IF EXISTS status;
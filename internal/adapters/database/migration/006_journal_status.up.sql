@@ -0,0 +1,31 @@
+-- =====================================================
+-- Journal lifecycle: DRAFT -> POSTED -> REVERSED
+-- =====================================================
+-- Journals written before this migration start out POSTED; new journals
+-- default to DRAFT. Journals written before the ledger projection (005) have
+-- no ledger entries, so they are projected here as posting them would have.
+-- account_period_balances (008) is seeded from the ledger, so their balances
+-- follow.
+ALTER TABLE journal_entries
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'POSTED'
+        CHECK (status IN ('DRAFT', 'POSTED', 'REVERSED')),
+    ADD COLUMN posted_at TIMESTAMP,
+    ADD COLUMN posted_by VARCHAR(50),
+    ADD COLUMN reversal_of UUID REFERENCES journal_entries(id),
+    ADD COLUMN reversed_by UUID REFERENCES journal_entries(id);
+
+ALTER TABLE journal_entries ALTER COLUMN status SET DEFAULT 'DRAFT';
+
+CREATE UNIQUE INDEX uq_journal_entries_reversal_of ON journal_entries(reversal_of)
+    WHERE reversal_of IS NOT NULL;
+
+INSERT INTO ledger_entries (
+    account_id, side, amount, transaction_date, journal_id,
+    cost_center_id, description, reference_type, reference_id
+)
+SELECT jl.account_id, jl.side, jl.amount, je.journal_date, je.id,
+       jl.cost_center_id, jl.description,
+       COALESCE(je.source_type, 'JOURNAL'), COALESCE(je.source_id, je.id::text)
+FROM journal_lines jl
+JOIN journal_entries je ON je.id = jl.journal_id
+WHERE NOT EXISTS (SELECT 1 FROM ledger_entries le WHERE le.journal_id = je.id);
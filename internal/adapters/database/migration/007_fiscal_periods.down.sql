@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_journal_entries_org_date;
+ALTER TABLE expenses DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE accruals DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE journal_entries DROP COLUMN IF EXISTS organization_id;
+
+DROP TABLE IF EXISTS fiscal_periods;
+DROP TABLE IF EXISTS fiscal_calendars;
@@ -0,0 +1,42 @@
+-- =====================================================
+-- Fiscal calendar and accounting periods
+-- =====================================================
+-- One calendar per organization. year_start_month is 1-12 (4 = April for an
+-- April-March year). A fiscal year is labelled by the calendar year it starts
+-- in, so FY 2025 for an April start runs 2025-04-01 .. 2026-03-31.
+CREATE TABLE fiscal_calendars (
+    organization_id TEXT PRIMARY KEY,
+    year_start_month SMALLINT NOT NULL CHECK (year_start_month BETWEEN 1 AND 12),
+    retained_earnings_account_id UUID REFERENCES accounts(id),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_by TEXT
+);
+
+-- OPEN: all postings allowed. SOFT_CLOSED: only adjusting and closing
+-- journals. CLOSED: nothing may be dated into the period.
+CREATE TABLE fiscal_periods (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id TEXT NOT NULL REFERENCES fiscal_calendars(organization_id) ON DELETE CASCADE,
+    fiscal_year INT NOT NULL,
+    period_number SMALLINT NOT NULL CHECK (period_number BETWEEN 1 AND 12),
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'OPEN'
+        CHECK (status IN ('OPEN', 'SOFT_CLOSED', 'CLOSED')),
+    closed_at TIMESTAMPTZ,
+    closed_by TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (organization_id, fiscal_year, period_number),
+    CHECK (start_date <= end_date)
+);
+CREATE INDEX idx_fiscal_periods_org_dates ON fiscal_periods(organization_id, start_date, end_date);
+
+-- Period locks are evaluated per organization, so the documents they guard
+-- need to know which organization they belong to.
+ALTER TABLE journal_entries ADD COLUMN organization_id TEXT;
+ALTER TABLE accruals ADD COLUMN organization_id TEXT;
+ALTER TABLE expenses ADD COLUMN organization_id TEXT;
+CREATE INDEX idx_journal_entries_org_date ON journal_entries(organization_id, journal_date);
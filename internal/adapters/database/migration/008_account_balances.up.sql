@@ -0,0 +1,24 @@
+-- =====================================================
+-- Account period balances
+-- =====================================================
+-- Debit and credit totals per account per calendar month, maintained by
+-- JournalSQLCRepository in the same transaction that writes ledger_entries.
+-- Balance queries read whole months from here and only scan ledger_entries
+-- for the partial months at either end of the requested range.
+CREATE TABLE account_period_balances (
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    period_start DATE NOT NULL, -- first day of the month
+    debit_total NUMERIC(18,2) NOT NULL DEFAULT 0,
+    credit_total NUMERIC(18,2) NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (account_id, period_start)
+);
+
+-- Seed from the ledger as it stands.
+INSERT INTO account_period_balances (account_id, period_start, debit_total, credit_total)
+SELECT account_id,
+       date_trunc('month', transaction_date)::date,
+       SUM(CASE WHEN side = 'DEBIT' THEN amount ELSE 0 END),
+       SUM(CASE WHEN side = 'CREDIT' THEN amount ELSE 0 END)
+FROM ledger_entries
+GROUP BY account_id, date_trunc('month', transaction_date)::date;
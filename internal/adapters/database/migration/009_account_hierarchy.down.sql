@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_accounts_parent;
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS chk_accounts_not_own_parent;
@@ -0,0 +1,8 @@
+-- =====================================================
+-- Chart of accounts hierarchy
+-- =====================================================
+-- Longer cycles are rejected by AccountService before the write; the
+-- constraint only catches the trivial case.
+ALTER TABLE accounts ADD CONSTRAINT chk_accounts_not_own_parent CHECK (parent_id <> id);
+
+CREATE INDEX idx_accounts_parent ON accounts(parent_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS journal_template_runs;
+DROP TABLE IF EXISTS journal_template_lines;
+DROP TABLE IF EXISTS journal_templates;
@@ -0,0 +1,51 @@
+-- =====================================================
+-- Recurring journal templates
+-- =====================================================
+-- An occurrence falls on day_of_month (clamped to the month's last day) every
+-- 1, 3 or 12 months from start_date. adjustment_percent compounds once per
+-- completed year since start_date, e.g. a 5% annual rent escalation.
+CREATE TABLE journal_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id TEXT,
+    name VARCHAR(100) NOT NULL,
+    reference VARCHAR(100),
+    memo TEXT,
+    frequency VARCHAR(20) NOT NULL CHECK (frequency IN ('MONTHLY', 'QUARTERLY', 'YEARLY')),
+    start_date DATE NOT NULL,
+    end_date DATE,
+    day_of_month SMALLINT NOT NULL CHECK (day_of_month BETWEEN 1 AND 31),
+    auto_post BOOLEAN NOT NULL DEFAULT false,
+    adjustment_percent NUMERIC(9,4) NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT true,
+    last_occurrence DATE,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_by TEXT,
+    CHECK (end_date IS NULL OR end_date >= start_date)
+);
+
+CREATE TABLE journal_template_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    template_id UUID NOT NULL REFERENCES journal_templates(id) ON DELETE CASCADE,
+    line_no SMALLINT NOT NULL,
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    side VARCHAR(10) NOT NULL CHECK (side IN ('DEBIT', 'CREDIT')),
+    amount NUMERIC(18,2) NOT NULL CHECK (amount > 0),
+    cost_center_id UUID,
+    description TEXT,
+    UNIQUE (template_id, line_no)
+);
+
+-- One row per generated occurrence. The primary key is what makes generation
+-- idempotent: the row is claimed in the same transaction that writes the
+-- journal, so a rerun or a concurrent generator skips it. journal_id is
+-- cleared if a generated draft is later deleted, which does not re-arm it.
+CREATE TABLE journal_template_runs (
+    template_id UUID NOT NULL REFERENCES journal_templates(id) ON DELETE CASCADE,
+    occurrence_date DATE NOT NULL,
+    journal_id UUID REFERENCES journal_entries(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (template_id, occurrence_date)
+);
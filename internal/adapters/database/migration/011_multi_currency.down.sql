@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS idx_ledger_entries_account_currency;
+
+ALTER TABLE ledger_entries
+    DROP COLUMN IF EXISTS functional_currency,
+    DROP COLUMN IF EXISTS exchange_rate,
+    DROP COLUMN IF EXISTS transaction_amount,
+    DROP COLUMN IF EXISTS currency_code;
+
+ALTER TABLE journal_lines
+    DROP CONSTRAINT IF EXISTS chk_journal_lines_exchange_rate_positive,
+    DROP COLUMN IF EXISTS functional_currency,
+    DROP COLUMN IF EXISTS exchange_rate,
+    DROP COLUMN IF EXISTS transaction_amount,
+    DROP COLUMN IF EXISTS currency_code;
+
+ALTER TABLE fiscal_calendars
+    DROP COLUMN IF EXISTS functional_currency;
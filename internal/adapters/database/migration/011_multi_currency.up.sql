@@ -0,0 +1,35 @@
+-- =====================================================
+-- Multi-currency journal lines
+-- =====================================================
+-- amount stays the functional-currency amount, so balance checks, the ledger
+-- projection and account_period_balances keep working in one currency.
+-- transaction_amount is what was actually transacted, in currency_code, and
+-- amount = ROUND(transaction_amount * exchange_rate, 2).
+
+-- An organization's functional currency; NULL falls back to the
+-- application's default.
+ALTER TABLE fiscal_calendars
+    ADD COLUMN functional_currency VARCHAR(3);
+
+ALTER TABLE journal_lines
+    ADD COLUMN currency_code VARCHAR(3) NOT NULL DEFAULT '',
+    ADD COLUMN transaction_amount NUMERIC(18,2),
+    ADD COLUMN exchange_rate NUMERIC(18,6) NOT NULL DEFAULT 1,
+    ADD COLUMN functional_currency VARCHAR(3) NOT NULL DEFAULT '';
+
+UPDATE journal_lines SET transaction_amount = amount;
+ALTER TABLE journal_lines
+    ALTER COLUMN transaction_amount SET NOT NULL,
+    ADD CONSTRAINT chk_journal_lines_exchange_rate_positive CHECK (exchange_rate > 0);
+
+ALTER TABLE ledger_entries
+    ADD COLUMN currency_code VARCHAR(3) NOT NULL DEFAULT '',
+    ADD COLUMN transaction_amount NUMERIC(18,2),
+    ADD COLUMN exchange_rate NUMERIC(18,6) NOT NULL DEFAULT 1,
+    ADD COLUMN functional_currency VARCHAR(3) NOT NULL DEFAULT '';
+
+UPDATE ledger_entries SET transaction_amount = amount;
+ALTER TABLE ledger_entries
+    ALTER COLUMN transaction_amount SET NOT NULL;
+
+CREATE INDEX idx_ledger_entries_account_currency ON ledger_entries(account_id, currency_code, transaction_date);
@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS fx_revaluation_settings;
+
+ALTER TABLE journal_entries
+    DROP COLUMN IF EXISTS auto_reverse_date;
+
+ALTER TABLE bank_accounts
+    DROP COLUMN IF EXISTS currency_code;
+
+ALTER TABLE payment_dues
+    DROP COLUMN IF EXISTS account_id,
+    DROP COLUMN IF EXISTS exchange_rate,
+    DROP COLUMN IF EXISTS currency_code;
+
+ALTER TABLE exchange_rates
+    DROP CONSTRAINT IF EXISTS uq_exchange_rates_pair_type_as_of,
+    ADD CONSTRAINT exchange_rates_base_currency_quote_currency_as_of_key UNIQUE (base_currency, quote_currency, as_of);
+ALTER TABLE exchange_rates
+    DROP COLUMN IF EXISTS rate_type;
@@ -0,0 +1,42 @@
+-- =====================================================
+-- Period-end FX revaluation
+-- =====================================================
+-- Rates are quoted by type: SPOT rates translate transactions as they are
+-- booked, CLOSING rates revalue open balances at period end and AVERAGE
+-- rates translate results. Existing rates are spot rates.
+ALTER TABLE exchange_rates
+    ADD COLUMN rate_type VARCHAR(20) NOT NULL DEFAULT 'SPOT'
+        CHECK (rate_type IN ('SPOT', 'CLOSING', 'AVERAGE'));
+ALTER TABLE exchange_rates
+    DROP CONSTRAINT IF EXISTS exchange_rates_base_currency_quote_currency_as_of_key,
+    ADD CONSTRAINT uq_exchange_rates_pair_type_as_of UNIQUE (base_currency, quote_currency, rate_type, as_of);
+
+-- Open items in a foreign currency. amount_due is in currency_code and was
+-- booked at exchange_rate; account_id is the receivable or payable control
+-- account it sits in. An empty currency_code is the functional currency.
+ALTER TABLE payment_dues
+    ADD COLUMN currency_code VARCHAR(3) NOT NULL DEFAULT '',
+    ADD COLUMN exchange_rate NUMERIC(18,6) NOT NULL DEFAULT 1 CHECK (exchange_rate > 0),
+    ADD COLUMN account_id UUID REFERENCES accounts(id);
+
+ALTER TABLE bank_accounts
+    ADD COLUMN currency_code VARCHAR(3) NOT NULL DEFAULT '';
+
+-- A journal posted with auto_reverse_date set is reversed on that date as
+-- soon as it is posted.
+ALTER TABLE journal_entries
+    ADD COLUMN auto_reverse_date DATE;
+
+-- Where unrealized gains and losses are posted, and which ledger accounts
+-- besides foreign bank accounts hold monetary foreign-currency balances.
+CREATE TABLE fx_revaluation_settings (
+    organization_id TEXT PRIMARY KEY,
+    unrealized_gain_account_id UUID NOT NULL REFERENCES accounts(id),
+    unrealized_loss_account_id UUID NOT NULL REFERENCES accounts(id),
+    account_ids UUID[] NOT NULL DEFAULT '{}',
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_by TEXT
+);
@@ -0,0 +1,5 @@
+ALTER TABLE invoices
+    DROP COLUMN IF EXISTS round_off,
+    DROP COLUMN IF EXISTS tax_total,
+    DROP COLUMN IF EXISTS discount_total,
+    DROP COLUMN IF EXISTS currency_code;
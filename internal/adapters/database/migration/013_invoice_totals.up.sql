@@ -0,0 +1,11 @@
+-- =====================================================
+-- Server-computed invoice totals
+-- =====================================================
+-- Totals are derived from the invoice's items, discounts and taxes and
+-- rounded by currency_code's rules; an empty currency_code is the default
+-- currency. round_off is the cash rounding applied to grand_total.
+ALTER TABLE invoices
+    ADD COLUMN currency_code VARCHAR(3) NOT NULL DEFAULT '',
+    ADD COLUMN discount_total NUMERIC(18,2) NOT NULL DEFAULT 0,
+    ADD COLUMN tax_total NUMERIC(18,2) NOT NULL DEFAULT 0,
+    ADD COLUMN round_off NUMERIC(18,2) NOT NULL DEFAULT 0;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS uq_gst_regimes_invoice;
+DROP INDEX IF EXISTS uq_gst_breakups_invoice;
+
+ALTER TABLE invoice_taxes DROP COLUMN IF EXISTS line_no;
+ALTER TABLE invoice_discounts DROP COLUMN IF EXISTS line_no;
+ALTER TABLE invoice_item_taxes DROP COLUMN IF EXISTS line_no;
+ALTER TABLE invoice_item_discounts DROP COLUMN IF EXISTS line_no;
+ALTER TABLE invoice_items DROP COLUMN IF EXISTS line_no;
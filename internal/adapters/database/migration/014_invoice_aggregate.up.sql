@@ -0,0 +1,14 @@
+-- =====================================================
+-- Invoice lines written as one aggregate
+-- =====================================================
+-- Lines inserted in one transaction share created_at, so each keeps its
+-- position within the invoice (or item) explicitly.
+ALTER TABLE invoice_items ADD COLUMN line_no INT NOT NULL DEFAULT 0;
+ALTER TABLE invoice_item_discounts ADD COLUMN line_no INT NOT NULL DEFAULT 0;
+ALTER TABLE invoice_item_taxes ADD COLUMN line_no INT NOT NULL DEFAULT 0;
+ALTER TABLE invoice_discounts ADD COLUMN line_no INT NOT NULL DEFAULT 0;
+ALTER TABLE invoice_taxes ADD COLUMN line_no INT NOT NULL DEFAULT 0;
+
+-- One GST breakup and regime per invoice; they are replaced with it.
+CREATE UNIQUE INDEX IF NOT EXISTS uq_gst_breakups_invoice ON gst_breakups(invoice_id);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_gst_regimes_invoice ON gst_regimes(invoice_id);
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_invoices_status;
+
+ALTER TABLE invoices
+    DROP COLUMN IF EXISTS overdue_by,
+    DROP COLUMN IF EXISTS overdue_at,
+    DROP COLUMN IF EXISTS void_reason,
+    DROP COLUMN IF EXISTS voided_by,
+    DROP COLUMN IF EXISTS voided_at,
+    DROP COLUMN IF EXISTS issued_by,
+    DROP COLUMN IF EXISTS issued_at,
+    DROP CONSTRAINT IF EXISTS chk_invoices_status,
+    ALTER COLUMN status DROP DEFAULT;
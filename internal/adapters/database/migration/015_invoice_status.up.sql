@@ -0,0 +1,24 @@
+-- =====================================================
+-- Invoice lifecycle: DRAFT -> ISSUED -> PARTIALLY_PAID -> PAID,
+-- plus VOID and OVERDUE
+-- =====================================================
+-- Statuses were free text; bring them to the enum's short upper-case names
+-- (INVOICE_STATUS_ISSUED and issued both become ISSUED) before the check.
+UPDATE invoices
+SET status = upper(regexp_replace(btrim(status), '^invoice_status_', '', 'i'));
+UPDATE invoices SET status = 'DRAFT'
+WHERE status NOT IN ('ISSUED', 'PARTIALLY_PAID', 'PAID', 'VOID', 'OVERDUE');
+
+ALTER TABLE invoices
+    ALTER COLUMN status SET DEFAULT 'DRAFT',
+    ADD CONSTRAINT chk_invoices_status
+        CHECK (status IN ('DRAFT', 'ISSUED', 'PARTIALLY_PAID', 'PAID', 'VOID', 'OVERDUE')),
+    ADD COLUMN issued_at TIMESTAMPTZ,
+    ADD COLUMN issued_by TEXT,
+    ADD COLUMN voided_at TIMESTAMPTZ,
+    ADD COLUMN voided_by TEXT,
+    ADD COLUMN void_reason TEXT,
+    ADD COLUMN overdue_at TIMESTAMPTZ,
+    ADD COLUMN overdue_by TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_invoices_status ON invoices(status);
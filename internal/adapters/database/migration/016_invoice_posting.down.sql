@@ -0,0 +1,7 @@
+ALTER TABLE invoices
+    DROP COLUMN IF EXISTS journal_id;
+
+ALTER TABLE invoice_items
+    DROP COLUMN IF EXISTS account_id;
+
+DROP TABLE IF EXISTS invoice_posting_rules;
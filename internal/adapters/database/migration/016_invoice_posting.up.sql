@@ -0,0 +1,34 @@
+-- =====================================================
+-- Invoice posting to the general ledger
+-- =====================================================
+-- Accounts an issued invoice posts to, per organization and invoice type.
+-- For SALES invoices the control account is the receivable and the line
+-- account the revenue account; for PURCHASE invoices they are the payable
+-- and the expense account, and every side is mirrored. Discounts and
+-- round-off go to the line account when no account of their own is set.
+CREATE TABLE invoice_posting_rules (
+    organization_id TEXT NOT NULL,
+    invoice_type VARCHAR(20) NOT NULL CHECK (invoice_type IN ('SALES', 'PURCHASE')),
+    control_account_id UUID NOT NULL REFERENCES accounts(id),
+    line_account_id UUID NOT NULL REFERENCES accounts(id),
+    cgst_account_id UUID REFERENCES accounts(id),
+    sgst_account_id UUID REFERENCES accounts(id),
+    igst_account_id UUID REFERENCES accounts(id),
+    other_tax_account_id UUID REFERENCES accounts(id),
+    discount_account_id UUID REFERENCES accounts(id),
+    round_off_account_id UUID REFERENCES accounts(id),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_by TEXT,
+    PRIMARY KEY (organization_id, invoice_type)
+);
+
+-- An item's own revenue or expense account, overriding the rule's.
+ALTER TABLE invoice_items
+    ADD COLUMN account_id UUID REFERENCES accounts(id);
+
+-- The journal posted when the invoice was issued.
+ALTER TABLE invoices
+    ADD COLUMN journal_id UUID REFERENCES journal_entries(id);
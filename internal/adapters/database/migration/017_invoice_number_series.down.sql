@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS uq_invoices_org_number;
+ALTER TABLE invoices
+    ALTER COLUMN invoice_number DROP DEFAULT;
+ALTER TABLE invoices
+    ADD CONSTRAINT invoices_invoice_number_key UNIQUE (invoice_number);
+
+DROP TABLE IF EXISTS invoice_number_counters;
+DROP TABLE IF EXISTS invoice_number_series;
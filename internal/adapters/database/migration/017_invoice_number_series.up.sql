@@ -0,0 +1,40 @@
+-- =====================================================
+-- Invoice number series
+-- =====================================================
+-- How an organization numbers its invoices of one type. The pattern may
+-- carry {FY} (the fiscal year, e.g. 2025-26), {FY:2} (e.g. 25-26) and must
+-- carry exactly one {SEQ} or {SEQ:n}, the running number zero-padded to n
+-- digits, e.g. INV/{FY}/{SEQ:5}.
+CREATE TABLE invoice_number_series (
+    organization_id TEXT NOT NULL,
+    invoice_type VARCHAR(20) NOT NULL CHECK (invoice_type IN ('SALES', 'PROFORMA', 'CHALLAN', 'PURCHASE')),
+    pattern TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_by TEXT,
+    PRIMARY KEY (organization_id, invoice_type)
+);
+
+-- The last number handed out in each fiscal year of a series. The row is
+-- bumped in the transaction that issues the invoice, so concurrent issues
+-- queue on it and a failed issue gives its number back.
+CREATE TABLE invoice_number_counters (
+    organization_id TEXT NOT NULL,
+    invoice_type VARCHAR(20) NOT NULL,
+    fiscal_year INT NOT NULL,
+    last_number BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (organization_id, invoice_type, fiscal_year),
+    FOREIGN KEY (organization_id, invoice_type) REFERENCES invoice_number_series(organization_id, invoice_type)
+);
+
+-- Drafts of a numbered series have no number until they are issued, and
+-- numbers need only be unique within an organization.
+ALTER TABLE invoices
+    ALTER COLUMN invoice_number SET DEFAULT '';
+ALTER TABLE invoices
+    DROP CONSTRAINT IF EXISTS invoices_invoice_number_key;
+CREATE UNIQUE INDEX uq_invoices_org_number
+    ON invoices (organization_id, invoice_number)
+    WHERE invoice_number <> '';
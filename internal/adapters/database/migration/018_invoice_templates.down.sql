@@ -0,0 +1,4 @@
+ALTER TABLE gst_doc_statuses
+    DROP COLUMN IF EXISTS signed_qr_code;
+
+DROP TABLE IF EXISTS invoice_templates;
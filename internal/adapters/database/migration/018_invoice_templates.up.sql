@@ -0,0 +1,23 @@
+-- =====================================================
+-- Invoice document templates
+-- =====================================================
+-- What an organization prints on its invoices around the invoice itself.
+-- Address, bank details and terms are free text printed line by line.
+CREATE TABLE invoice_templates (
+    organization_id TEXT PRIMARY KEY,
+    company_name TEXT NOT NULL,
+    address TEXT,
+    logo BYTEA,                               -- PNG or JPEG
+    bank_details TEXT,
+    terms TEXT,
+    footer TEXT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_by TEXT
+);
+
+-- The signed QR code the IRP returns with the IRN, printed on e-invoices.
+ALTER TABLE gst_doc_statuses
+    ADD COLUMN signed_qr_code TEXT;
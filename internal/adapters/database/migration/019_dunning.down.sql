@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS dunning_holds;
+DROP TABLE IF EXISTS dunning_reminders;
+DROP TABLE IF EXISTS dunning_stages;
+
+DROP INDEX IF EXISTS idx_invoices_open_due;
+DROP INDEX IF EXISTS idx_invoices_customer;
+ALTER TABLE invoices
+    DROP COLUMN IF EXISTS customer_id;
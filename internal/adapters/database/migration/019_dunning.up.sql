@@ -0,0 +1,52 @@
+-- =====================================================
+-- Dunning
+-- =====================================================
+-- The customer an invoice is billed to, by its id in the ERP's customer
+-- records, so that reminders can be held per customer.
+ALTER TABLE invoices
+    ADD COLUMN customer_id TEXT;
+CREATE INDEX idx_invoices_customer ON invoices(organization_id, customer_id);
+
+-- Open invoices past their due date, and the payment dues of any invoice,
+-- are marked OVERDUE by the dunning job.
+CREATE INDEX idx_invoices_open_due ON invoices(due_date)
+    WHERE status IN ('ISSUED', 'PARTIALLY_PAID', 'OVERDUE');
+
+-- The organization's reminder schedule: a reminder goes out days_from_due
+-- days after an open invoice's due date, or before it when negative.
+CREATE TABLE dunning_stages (
+    organization_id TEXT NOT NULL,
+    days_from_due INT NOT NULL,
+    escalation_level INT NOT NULL DEFAULT 0 CHECK (escalation_level >= 0),
+    name TEXT NOT NULL DEFAULT '',
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by TEXT,
+    PRIMARY KEY (organization_id, days_from_due)
+);
+
+-- Reminders sent, at most one per invoice and stage.
+CREATE TABLE dunning_reminders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    days_from_due INT NOT NULL,
+    escalation_level INT NOT NULL,
+    sent_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (invoice_id, days_from_due)
+);
+
+-- No reminders go out for a held invoice, or for any invoice of a held
+-- customer. Holds do not stop invoices being marked overdue.
+CREATE TABLE dunning_holds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id TEXT NOT NULL,
+    invoice_id UUID REFERENCES invoices(id) ON DELETE CASCADE,
+    customer_id TEXT,
+    reason TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by TEXT,
+    CHECK ((invoice_id IS NULL) <> (customer_id IS NULL))
+);
+CREATE UNIQUE INDEX uq_dunning_holds_invoice ON dunning_holds(invoice_id) WHERE invoice_id IS NOT NULL;
+CREATE UNIQUE INDEX uq_dunning_holds_customer ON dunning_holds(organization_id, customer_id) WHERE customer_id IS NOT NULL;
@@ -0,0 +1,41 @@
+-- =====================================================
+-- Recurring invoices
+-- =====================================================
+-- A recurring invoice bills a copy of its template invoice (items, taxes,
+-- discounts and customer) once per billing period, in advance. Periods run
+-- every 1, 3 or 12 months from billing_day; the first starts on the latest
+-- billing_day on or before start_date. With prorate, a first or last period
+-- cut short by start_date or end_date bills for the days it covers.
+CREATE TABLE recurring_invoices (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id TEXT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    template_invoice_id UUID NOT NULL REFERENCES invoices(id),
+    frequency VARCHAR(20) NOT NULL CHECK (frequency IN ('MONTHLY', 'QUARTERLY', 'YEARLY')),
+    start_date DATE NOT NULL,
+    end_date DATE,
+    billing_day SMALLINT NOT NULL CHECK (billing_day BETWEEN 1 AND 28),
+    prorate BOOLEAN NOT NULL DEFAULT false,
+    auto_issue BOOLEAN NOT NULL DEFAULT false,
+    active BOOLEAN NOT NULL DEFAULT true,
+    billed_through DATE,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_by TEXT,
+    CHECK (end_date IS NULL OR end_date >= start_date)
+);
+CREATE INDEX idx_recurring_invoices_template ON recurring_invoices(template_invoice_id);
+
+-- One row per billed period, claimed in the same transaction that writes the
+-- invoice so that a rerun or a concurrent generator skips it. invoice_id is
+-- cleared if a generated draft is later deleted, which does not re-arm it.
+CREATE TABLE recurring_invoice_runs (
+    recurring_invoice_id UUID NOT NULL REFERENCES recurring_invoices(id) ON DELETE CASCADE,
+    period_start DATE NOT NULL,
+    period_end DATE NOT NULL,
+    invoice_id UUID REFERENCES invoices(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (recurring_invoice_id, period_start)
+);
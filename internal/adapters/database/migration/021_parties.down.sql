@@ -0,0 +1,8 @@
+ALTER TABLE vendor_bill_approved_events DROP COLUMN IF EXISTS party_id;
+DROP INDEX IF EXISTS idx_invoices_party;
+ALTER TABLE invoices DROP COLUMN IF EXISTS party_id;
+DROP TABLE IF EXISTS party_bank_accounts;
+DROP TABLE IF EXISTS party_tax_registrations;
+DROP TABLE IF EXISTS party_addresses;
+DROP TABLE IF EXISTS party_contacts;
+DROP TABLE IF EXISTS parties;
@@ -0,0 +1,86 @@
+-- =====================================================
+-- Parties (customers and vendors)
+-- =====================================================
+-- A party is a customer, a vendor or both. Its contacts, addresses, tax
+-- registrations and bank accounts are written with the party and replaced
+-- as a whole on update. Invoices and vendor bills refer to the party they
+-- are raised for or received from.
+CREATE TABLE parties (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id TEXT NOT NULL,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('CUSTOMER', 'VENDOR', 'BOTH')),
+    name VARCHAR(200) NOT NULL,
+    legal_name VARCHAR(200),
+    pan VARCHAR(10),
+    default_currency VARCHAR(3),
+    payment_terms_days INT CHECK (payment_terms_days >= 0),
+    active BOOLEAN NOT NULL DEFAULT true,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    created_by TEXT,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_by TEXT
+);
+CREATE INDEX idx_parties_org_name ON parties(organization_id, lower(name));
+
+CREATE TABLE party_contacts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    party_id UUID NOT NULL REFERENCES parties(id) ON DELETE CASCADE,
+    line_no SMALLINT NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    email VARCHAR(200),
+    phone VARCHAR(30),
+    designation VARCHAR(100),
+    is_primary BOOLEAN NOT NULL DEFAULT false,
+    UNIQUE (party_id, line_no)
+);
+
+CREATE TABLE party_addresses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    party_id UUID NOT NULL REFERENCES parties(id) ON DELETE CASCADE,
+    line_no SMALLINT NOT NULL,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('BILLING', 'SHIPPING')),
+    line1 VARCHAR(200) NOT NULL,
+    line2 VARCHAR(200),
+    city VARCHAR(100),
+    state VARCHAR(100),
+    state_code VARCHAR(2),
+    postal_code VARCHAR(20),
+    country_code VARCHAR(2) NOT NULL DEFAULT 'IN',
+    is_default BOOLEAN NOT NULL DEFAULT false,
+    UNIQUE (party_id, line_no)
+);
+
+-- A GSTIN is registered per state, so a party trading in several states has
+-- one registration for each.
+CREATE TABLE party_tax_registrations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    party_id UUID NOT NULL REFERENCES parties(id) ON DELETE CASCADE,
+    line_no SMALLINT NOT NULL,
+    tax_type VARCHAR(20) NOT NULL,
+    number VARCHAR(30) NOT NULL,
+    state_code VARCHAR(2),
+    UNIQUE (party_id, line_no),
+    UNIQUE (party_id, tax_type, number)
+);
+CREATE INDEX idx_party_tax_registrations_number ON party_tax_registrations(number);
+
+CREATE TABLE party_bank_accounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    party_id UUID NOT NULL REFERENCES parties(id) ON DELETE CASCADE,
+    line_no SMALLINT NOT NULL,
+    account_name VARCHAR(200) NOT NULL,
+    account_number VARCHAR(40) NOT NULL,
+    ifsc VARCHAR(11),
+    bank_name VARCHAR(200),
+    branch VARCHAR(200),
+    is_primary BOOLEAN NOT NULL DEFAULT false,
+    UNIQUE (party_id, line_no)
+);
+
+ALTER TABLE invoices
+    ADD COLUMN party_id UUID REFERENCES parties(id);
+CREATE INDEX idx_invoices_party ON invoices(party_id);
+
+ALTER TABLE vendor_bill_approved_events
+    ADD COLUMN party_id UUID REFERENCES parties(id);
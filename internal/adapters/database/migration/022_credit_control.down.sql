@@ -0,0 +1,8 @@
+ALTER TABLE invoices
+    DROP COLUMN IF EXISTS credit_approved_at,
+    DROP COLUMN IF EXISTS credit_approved_by;
+DROP TABLE IF EXISTS credit_policies;
+ALTER TABLE parties
+    DROP COLUMN IF EXISTS credit_hold_reason,
+    DROP COLUMN IF EXISTS credit_hold,
+    DROP COLUMN IF EXISTS credit_limit;
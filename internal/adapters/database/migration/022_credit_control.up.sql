@@ -0,0 +1,25 @@
+-- =====================================================
+-- Customer credit control
+-- =====================================================
+-- A customer's exposure is its open payment dues plus its draft sales
+-- invoices, less its credit notes not yet applied. Issuing a sales invoice
+-- whose customer's exposure is past threshold_percent of its credit limit
+-- is blocked, or held until someone approves the invoice on credit, as the
+-- organization's policy says. A customer on credit hold cannot be invoiced.
+ALTER TABLE parties
+    ADD COLUMN credit_limit NUMERIC(18,2) CHECK (credit_limit >= 0),
+    ADD COLUMN credit_hold BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN credit_hold_reason TEXT;
+
+CREATE TABLE credit_policies (
+    organization_id TEXT PRIMARY KEY,
+    threshold_percent NUMERIC(6,2) NOT NULL DEFAULT 100 CHECK (threshold_percent > 0),
+    action VARCHAR(10) NOT NULL DEFAULT 'BLOCK' CHECK (action IN ('BLOCK', 'APPROVAL')),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_by TEXT
+);
+
+-- Set on a draft by a credit approval and cleared when the draft changes.
+ALTER TABLE invoices
+    ADD COLUMN credit_approved_by TEXT,
+    ADD COLUMN credit_approved_at TIMESTAMPTZ;
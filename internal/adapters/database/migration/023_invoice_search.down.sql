@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS trg_invoice_search_party ON parties;
+DROP TRIGGER IF EXISTS trg_invoice_search_item ON invoice_items;
+DROP TRIGGER IF EXISTS trg_invoice_search_invoice ON invoices;
+DROP FUNCTION IF EXISTS invoice_search_on_party();
+DROP FUNCTION IF EXISTS invoice_search_on_item();
+DROP FUNCTION IF EXISTS invoice_search_on_invoice();
+DROP FUNCTION IF EXISTS refresh_invoice_search(UUID);
+DROP FUNCTION IF EXISTS invoice_search_document(UUID);
+DROP INDEX IF EXISTS idx_invoices_org_date;
+DROP TABLE IF EXISTS invoice_search;
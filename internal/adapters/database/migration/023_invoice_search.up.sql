@@ -0,0 +1,76 @@
+-- =====================================================
+-- Invoice search
+-- =====================================================
+-- One search document per invoice, weighted so that a hit on the invoice
+-- or PO number ranks above one on the party's name, which ranks above one
+-- on an item name or HSN code. Triggers keep the documents current as
+-- invoices, their items and their parties change. The 'simple' text search
+-- configuration is used because numbers, codes and names must not be
+-- stemmed.
+CREATE TABLE invoice_search (
+    invoice_id UUID PRIMARY KEY REFERENCES invoices(id) ON DELETE CASCADE,
+    document TSVECTOR NOT NULL
+);
+
+CREATE INDEX idx_invoice_search_document ON invoice_search USING GIN (document);
+CREATE INDEX idx_invoices_org_date ON invoices(organization_id, invoice_date DESC);
+
+CREATE OR REPLACE FUNCTION invoice_search_document(inv UUID) RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector('simple', i.invoice_number || ' ' || COALESCE(i.po_number, '')), 'A')
+        || setweight(to_tsvector('simple', COALESCE(p.name, '') || ' ' || COALESCE(p.legal_name, '')), 'B')
+        || setweight(to_tsvector('simple', COALESCE(
+               (SELECT string_agg(it.name || ' ' || COALESCE(it.hsn, ''), ' ')
+                  FROM invoice_items it WHERE it.invoice_id = i.id), '')), 'C')
+      FROM invoices i
+      LEFT JOIN parties p ON p.id = i.party_id
+     WHERE i.id = inv;
+$$ LANGUAGE sql STABLE;
+
+-- refresh_invoice_search rebuilds an invoice's document; it does nothing
+-- once the invoice is gone.
+CREATE OR REPLACE FUNCTION refresh_invoice_search(inv UUID) RETURNS void AS $$
+    INSERT INTO invoice_search (invoice_id, document)
+    SELECT i.id, invoice_search_document(i.id) FROM invoices i WHERE i.id = inv
+    ON CONFLICT (invoice_id) DO UPDATE SET document = EXCLUDED.document;
+$$ LANGUAGE sql;
+
+CREATE OR REPLACE FUNCTION invoice_search_on_invoice() RETURNS trigger AS $$
+BEGIN
+    PERFORM refresh_invoice_search(NEW.id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION invoice_search_on_item() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM refresh_invoice_search(OLD.invoice_id);
+    END IF;
+    IF TG_OP <> 'DELETE' AND (TG_OP = 'INSERT' OR NEW.invoice_id <> OLD.invoice_id) THEN
+        PERFORM refresh_invoice_search(NEW.invoice_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION invoice_search_on_party() RETURNS trigger AS $$
+BEGIN
+    PERFORM refresh_invoice_search(i.id) FROM invoices i WHERE i.party_id = NEW.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_invoice_search_invoice
+    AFTER INSERT OR UPDATE OF invoice_number, po_number, party_id ON invoices
+    FOR EACH ROW EXECUTE FUNCTION invoice_search_on_invoice();
+
+CREATE TRIGGER trg_invoice_search_item
+    AFTER INSERT OR UPDATE OF invoice_id, name, hsn OR DELETE ON invoice_items
+    FOR EACH ROW EXECUTE FUNCTION invoice_search_on_item();
+
+CREATE TRIGGER trg_invoice_search_party
+    AFTER UPDATE OF name, legal_name ON parties
+    FOR EACH ROW EXECUTE FUNCTION invoice_search_on_party();
+
+INSERT INTO invoice_search (invoice_id, document)
+SELECT id, invoice_search_document(id) FROM invoices;
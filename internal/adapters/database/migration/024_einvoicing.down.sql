@@ -0,0 +1,5 @@
+ALTER TABLE parties
+    DROP COLUMN IF EXISTS peppol_endpoint;
+ALTER TABLE invoice_templates
+    DROP COLUMN IF EXISTS peppol_endpoint,
+    DROP COLUMN IF EXISTS country_code;
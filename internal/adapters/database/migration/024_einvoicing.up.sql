@@ -0,0 +1,14 @@
+-- =====================================================
+-- Structured e-invoicing (UBL 2.1 / PEPPOL BIS Billing 3.0)
+-- =====================================================
+-- PEPPOL addresses seller and buyer by an electronic endpoint, kept as
+-- "<scheme>:<identifier>" with the ISO 6523 scheme code, e.g.
+-- "0088:5790000435975". The seller is the organization as its invoice
+-- template describes it; its country defaults to India, where it is
+-- registered for GST.
+ALTER TABLE invoice_templates
+    ADD COLUMN country_code CHAR(2) NOT NULL DEFAULT 'IN',
+    ADD COLUMN peppol_endpoint TEXT;
+
+ALTER TABLE parties
+    ADD COLUMN peppol_endpoint TEXT;
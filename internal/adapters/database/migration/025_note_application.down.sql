@@ -0,0 +1,7 @@
+ALTER TABLE invoices
+    DROP COLUMN IF EXISTS debited_total,
+    DROP COLUMN IF EXISTS credited_total;
+ALTER TABLE credit_debit_notes
+    DROP COLUMN IF EXISTS applied_at,
+    DROP COLUMN IF EXISTS payment_due_id,
+    DROP COLUMN IF EXISTS journal_id;
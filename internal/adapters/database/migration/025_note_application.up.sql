@@ -0,0 +1,22 @@
+-- =====================================================
+-- Applying credit/debit notes to invoice balances
+-- =====================================================
+-- A credit note reduces its invoice's open payment dues, earliest due
+-- first, and cannot take more than they leave open; a debit note raises a
+-- new due. Either posts a journal reversing, or adding to, the invoice's
+-- revenue and tax in proportion, and is kept with the note along with the
+-- due it raised. Notes created before this were never applied and keep a
+-- null applied_at.
+UPDATE credit_debit_notes
+SET type = upper(regexp_replace(btrim(type), '^note_type_', '', 'i'));
+
+ALTER TABLE credit_debit_notes
+    ADD COLUMN journal_id UUID REFERENCES journal_entries(id),
+    ADD COLUMN payment_due_id UUID REFERENCES payment_dues(id) ON DELETE SET NULL,
+    ADD COLUMN applied_at TIMESTAMPTZ;
+
+-- What the invoice's notes credited and debited; the net billed amount is
+-- grand_total less credited_total plus debited_total.
+ALTER TABLE invoices
+    ADD COLUMN credited_total NUMERIC(18,2) NOT NULL DEFAULT 0,
+    ADD COLUMN debited_total NUMERIC(18,2) NOT NULL DEFAULT 0;
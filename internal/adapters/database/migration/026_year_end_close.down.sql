@@ -0,0 +1 @@
+ALTER TABLE fiscal_periods DROP COLUMN IF EXISTS year_closed_at;
@@ -0,0 +1,8 @@
+-- =====================================================
+-- Year-end close marker
+-- =====================================================
+-- Set on every period of a fiscal year when the year-end close posts its
+-- closing entry and hard-closes the periods, in one transaction. Periods of a
+-- closed year cannot be reopened: postings made then would never be closed
+-- to retained earnings.
+ALTER TABLE fiscal_periods ADD COLUMN year_closed_at TIMESTAMPTZ;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_bank_accounts_org;
+ALTER TABLE bank_accounts DROP COLUMN IF EXISTS organization_id;
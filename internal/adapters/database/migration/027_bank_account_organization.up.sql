@@ -0,0 +1,8 @@
+-- =====================================================
+-- Bank account organization
+-- =====================================================
+-- Bank accounts belong to one organization, so period-end FX revaluation
+-- only revalues that organization's accounts. Accounts created before this
+-- have no organization and are not revalued until one is set.
+ALTER TABLE bank_accounts ADD COLUMN organization_id TEXT;
+CREATE INDEX idx_bank_accounts_org ON bank_accounts(organization_id);
@@ -0,0 +1,12 @@
+ALTER TABLE invoices ADD COLUMN customer_id TEXT;
+UPDATE invoices SET customer_id = party_id::text WHERE party_id IS NOT NULL;
+CREATE INDEX idx_invoices_customer ON invoices(organization_id, customer_id);
+
+ALTER TABLE dunning_holds ADD COLUMN customer_id TEXT;
+UPDATE dunning_holds SET customer_id = party_id::text WHERE party_id IS NOT NULL;
+DROP INDEX IF EXISTS uq_dunning_holds_party;
+ALTER TABLE dunning_holds DROP CONSTRAINT IF EXISTS dunning_holds_target;
+ALTER TABLE dunning_holds DROP COLUMN party_id;
+ALTER TABLE dunning_holds
+    ADD CONSTRAINT dunning_holds_check CHECK ((invoice_id IS NULL) <> (customer_id IS NULL));
+CREATE UNIQUE INDEX uq_dunning_holds_customer ON dunning_holds(organization_id, customer_id) WHERE customer_id IS NOT NULL;
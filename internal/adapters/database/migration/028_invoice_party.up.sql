@@ -0,0 +1,48 @@
+-- =====================================================
+-- Invoice party
+-- =====================================================
+-- Invoices and dunning holds name their customer by party_id alone. The
+-- customer_id they carried before the party master is folded into it: an id
+-- that is one of the organization's parties is that party, and any other id
+-- becomes a CUSTOMER party named after it, to be completed in the party
+-- master.
+INSERT INTO parties (organization_id, kind, name, created_by)
+SELECT DISTINCT c.organization_id, 'CUSTOMER', left(c.customer_id, 200), 'migration 028'
+FROM (
+    SELECT organization_id, customer_id FROM invoices
+    WHERE customer_id IS NOT NULL AND party_id IS NULL
+    UNION
+    SELECT organization_id, customer_id FROM dunning_holds
+    WHERE customer_id IS NOT NULL
+) c
+WHERE NOT EXISTS (
+    SELECT 1 FROM parties p
+    WHERE p.organization_id = c.organization_id AND p.id::text = c.customer_id
+);
+
+UPDATE invoices i
+SET party_id = p.id
+FROM parties p
+WHERE i.party_id IS NULL AND i.customer_id IS NOT NULL
+  AND p.organization_id = i.organization_id
+  AND (p.id::text = i.customer_id
+       OR (p.created_by = 'migration 028' AND p.name = left(i.customer_id, 200)));
+
+ALTER TABLE dunning_holds
+    ADD COLUMN party_id UUID REFERENCES parties(id) ON DELETE CASCADE;
+UPDATE dunning_holds h
+SET party_id = p.id
+FROM parties p
+WHERE h.customer_id IS NOT NULL
+  AND p.organization_id = h.organization_id
+  AND (p.id::text = h.customer_id
+       OR (p.created_by = 'migration 028' AND p.name = left(h.customer_id, 200)));
+
+-- Dropping customer_id takes its check and unique index with it.
+ALTER TABLE dunning_holds DROP COLUMN customer_id;
+ALTER TABLE dunning_holds
+    ADD CONSTRAINT dunning_holds_target CHECK ((invoice_id IS NULL) <> (party_id IS NULL));
+CREATE UNIQUE INDEX uq_dunning_holds_party ON dunning_holds(organization_id, party_id) WHERE party_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_invoices_customer;
+ALTER TABLE invoices DROP COLUMN customer_id;
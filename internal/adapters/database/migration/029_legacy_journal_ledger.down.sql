@@ -0,0 +1,2 @@
+-- The projected entries cannot be told from ones written by posting, so
+-- they are kept.
@@ -0,0 +1,35 @@
+-- =====================================================
+-- Legacy journal ledger entries
+-- =====================================================
+-- Databases that ran 006 before it projected the journals written ahead of
+-- the ledger projection have POSTED journals with no ledger entries and no
+-- share of account_period_balances. They are projected here, and exactly the
+-- rows written are added to the monthly balances. Posting always projects a
+-- journal, so elsewhere this finds nothing.
+WITH projected AS (
+    INSERT INTO ledger_entries (
+        account_id, side, amount, transaction_date, journal_id,
+        cost_center_id, description, reference_type, reference_id,
+        currency_code, transaction_amount, exchange_rate, functional_currency
+    )
+    SELECT jl.account_id, jl.side, jl.amount, je.journal_date, je.id,
+           jl.cost_center_id, jl.description,
+           COALESCE(je.source_type, 'JOURNAL'), COALESCE(je.source_id, je.id::text),
+           jl.currency_code, jl.transaction_amount, jl.exchange_rate, jl.functional_currency
+    FROM journal_lines jl
+    JOIN journal_entries je ON je.id = jl.journal_id
+    WHERE je.status IN ('POSTED', 'REVERSED')
+      AND NOT EXISTS (SELECT 1 FROM ledger_entries le WHERE le.journal_id = je.id)
+    RETURNING account_id, side, amount, transaction_date
+)
+INSERT INTO account_period_balances (account_id, period_start, debit_total, credit_total)
+SELECT account_id,
+       date_trunc('month', transaction_date)::date,
+       SUM(CASE WHEN side = 'DEBIT' THEN amount ELSE 0 END),
+       SUM(CASE WHEN side = 'CREDIT' THEN amount ELSE 0 END)
+FROM projected
+GROUP BY account_id, date_trunc('month', transaction_date)::date
+ON CONFLICT (account_id, period_start) DO UPDATE
+SET debit_total = account_period_balances.debit_total + EXCLUDED.debit_total,
+    credit_total = account_period_balances.credit_total + EXCLUDED.credit_total,
+    updated_at = now();
@@ -0,0 +1,14 @@
+ALTER TABLE account_period_balances DROP CONSTRAINT account_period_balances_pkey;
+DELETE FROM account_period_balances;
+
+INSERT INTO account_period_balances (organization_id, account_id, period_start, debit_total, credit_total)
+SELECT '',
+       account_id,
+       date_trunc('month', transaction_date)::date,
+       SUM(CASE WHEN side = 'DEBIT' THEN amount ELSE 0 END),
+       SUM(CASE WHEN side = 'CREDIT' THEN amount ELSE 0 END)
+FROM ledger_entries
+GROUP BY account_id, date_trunc('month', transaction_date)::date;
+
+ALTER TABLE account_period_balances DROP COLUMN organization_id;
+ALTER TABLE account_period_balances ADD PRIMARY KEY (account_id, period_start);
@@ -0,0 +1,24 @@
+-- =====================================================
+-- Account period balances per organization
+-- =====================================================
+-- The monthly totals were kept per account only, so every organization
+-- posting to an account shared one balance. They are now keyed by the
+-- posting journal's organization as well; journals without one fall under
+-- ''. The table is rebuilt from the ledger, since existing rows cannot be
+-- split after the fact.
+ALTER TABLE account_period_balances ADD COLUMN organization_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE account_period_balances DROP CONSTRAINT account_period_balances_pkey;
+DELETE FROM account_period_balances;
+
+INSERT INTO account_period_balances (organization_id, account_id, period_start, debit_total, credit_total)
+SELECT COALESCE(je.organization_id, ''),
+       le.account_id,
+       date_trunc('month', le.transaction_date)::date,
+       SUM(CASE WHEN le.side = 'DEBIT' THEN le.amount ELSE 0 END),
+       SUM(CASE WHEN le.side = 'CREDIT' THEN le.amount ELSE 0 END)
+FROM ledger_entries le
+LEFT JOIN journal_entries je ON je.id = le.journal_id
+GROUP BY COALESCE(je.organization_id, ''), le.account_id, date_trunc('month', le.transaction_date)::date;
+
+ALTER TABLE account_period_balances ALTER COLUMN organization_id DROP DEFAULT;
+ALTER TABLE account_period_balances ADD PRIMARY KEY (organization_id, account_id, period_start);
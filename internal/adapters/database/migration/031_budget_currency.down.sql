@@ -0,0 +1,2 @@
+ALTER TABLE budgets
+    DROP COLUMN IF EXISTS currency_code;
@@ -0,0 +1,7 @@
+-- =====================================================
+-- Budget currency
+-- =====================================================
+-- The currency total_amount is kept in; an empty currency_code is the
+-- default currency.
+ALTER TABLE budgets
+    ADD COLUMN currency_code VARCHAR(3) NOT NULL DEFAULT '';
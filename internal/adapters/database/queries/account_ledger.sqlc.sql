@@ -13,6 +13,9 @@ SELECT * FROM accounts WHERE id = $1;
 -- name: ListAccounts :many
 SELECT * FROM accounts ORDER BY code LIMIT $1 OFFSET $2;
 
+-- name: ListAllAccounts :many
+SELECT * FROM accounts ORDER BY code;
+
 -- name: UpdateAccount :one
 UPDATE accounts
 SET code = $2, name = $3, type = $4, parent_id = $5,
@@ -24,36 +27,126 @@ RETURNING *;
 -- name: DeleteAccount :exec
 DELETE FROM accounts WHERE id = $1;
 
+-- name: GetAccountUsage :one
+-- What still depends on an account: child accounts and posted ledger lines.
+SELECT (SELECT COUNT(*) FROM accounts c WHERE c.parent_id = sqlc.arg(id)::uuid) AS child_count,
+       EXISTS (SELECT 1 FROM ledger_entries le WHERE le.account_id = sqlc.arg(id)::uuid) AS has_ledger_entries;
+
 -- =====================================================
 -- Journal Entries
 -- =====================================================
 
 -- name: CreateJournalEntry :one
-INSERT INTO journal_entries (journal_date, reference, memo, source_type, source_id, created_by, updated_by)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO journal_entries (
+    journal_date, reference, memo, source_type, source_id, created_by, updated_by,
+    status, posted_at, posted_by, reversal_of, organization_id, auto_reverse_date
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 RETURNING *;
 
+-- name: BulkInsertJournalEntries :exec
+-- Inserts many entries in one statement. IDs are assigned by the caller so
+-- the lines can reference them; empty strings are stored as NULL.
+INSERT INTO journal_entries (
+    id, journal_date, reference, memo, source_type, created_by, updated_by,
+    status, posted_at, posted_by, organization_id
+)
+SELECT u.id, u.journal_date, NULLIF(u.reference, ''), NULLIF(u.memo, ''), NULLIF(u.source_type, ''),
+       NULLIF(u.created_by, ''), NULLIF(u.created_by, ''), u.status,
+       CASE WHEN u.status = 'POSTED' THEN now() END,
+       CASE WHEN u.status = 'POSTED' THEN NULLIF(u.created_by, '') END,
+       NULLIF(u.organization_id, '')
+FROM (
+    SELECT unnest(sqlc.arg(ids)::uuid[]) AS id,
+           unnest(sqlc.arg(journal_dates)::timestamp[]) AS journal_date,
+           unnest(sqlc.arg(refs)::text[]) AS reference,
+           unnest(sqlc.arg(memos)::text[]) AS memo,
+           unnest(sqlc.arg(source_types)::text[]) AS source_type,
+           unnest(sqlc.arg(statuses)::text[]) AS status,
+           unnest(sqlc.arg(created_bys)::text[]) AS created_by,
+           unnest(sqlc.arg(organization_ids)::text[]) AS organization_id
+) u;
+
+-- name: BulkInsertJournalLines :exec
+INSERT INTO journal_lines (
+    journal_id, account_id, side, amount, cost_center_id, description,
+    currency_code, transaction_amount, exchange_rate, functional_currency
+)
+SELECT u.journal_id, u.account_id, u.side, u.amount, NULLIF(u.cost_center_id, '')::uuid, NULLIF(u.description, ''),
+       u.currency_code, u.transaction_amount, u.exchange_rate, u.functional_currency
+FROM (
+    SELECT unnest(sqlc.arg(journal_ids)::uuid[]) AS journal_id,
+           unnest(sqlc.arg(account_ids)::uuid[]) AS account_id,
+           unnest(sqlc.arg(sides)::text[]) AS side,
+           unnest(sqlc.arg(amounts)::numeric[]) AS amount,
+           unnest(sqlc.arg(cost_center_ids)::text[]) AS cost_center_id,
+           unnest(sqlc.arg(descriptions)::text[]) AS description,
+           unnest(sqlc.arg(currency_codes)::text[]) AS currency_code,
+           unnest(sqlc.arg(transaction_amounts)::numeric[]) AS transaction_amount,
+           unnest(sqlc.arg(exchange_rates)::numeric[]) AS exchange_rate,
+           unnest(sqlc.arg(functional_currencies)::text[]) AS functional_currency
+) u;
+
 -- name: GetJournalEntry :one
 SELECT * FROM journal_entries WHERE id = $1;
 
 -- name: ListJournalEntries :many
-SELECT * FROM journal_entries ORDER BY journal_date DESC LIMIT $1 OFFSET $2;
+-- Line-level filters (account, side, cost center) match journals with at
+-- least one line satisfying all of them. Amount ordering uses the journal's
+-- debit total.
+SELECT je.* FROM journal_entries je
+WHERE (sqlc.narg(from_date)::timestamp IS NULL OR je.journal_date >= sqlc.narg(from_date))
+  AND (sqlc.narg(to_date)::timestamp IS NULL OR je.journal_date <= sqlc.narg(to_date))
+  AND (sqlc.narg(source_type)::text IS NULL OR je.source_type = sqlc.narg(source_type))
+  AND (sqlc.narg(reference_type)::text IS NULL OR COALESCE(je.source_type, 'JOURNAL') = sqlc.narg(reference_type))
+  AND (sqlc.narg(reference_id)::text IS NULL OR COALESCE(je.source_id, je.id::text) = sqlc.narg(reference_id))
+  AND (sqlc.narg(account_id)::uuid IS NULL AND sqlc.narg(side)::text IS NULL AND sqlc.narg(cost_center_id)::uuid IS NULL
+       OR EXISTS (
+           SELECT 1 FROM journal_lines jl
+           WHERE jl.journal_id = je.id
+             AND (sqlc.narg(account_id)::uuid IS NULL OR jl.account_id = sqlc.narg(account_id))
+             AND (sqlc.narg(side)::text IS NULL OR jl.side = sqlc.narg(side))
+             AND (sqlc.narg(cost_center_id)::uuid IS NULL OR jl.cost_center_id = sqlc.narg(cost_center_id))))
+  AND (sqlc.narg(organization_id)::text IS NULL OR je.organization_id = sqlc.narg(organization_id))
+ORDER BY
+  CASE WHEN sqlc.arg(order_by)::text = 'amount' AND NOT sqlc.arg(descending)::bool
+       THEN (SELECT SUM(amount) FROM journal_lines WHERE journal_id = je.id AND side = 'DEBIT') END ASC,
+  CASE WHEN sqlc.arg(order_by)::text = 'amount' AND sqlc.arg(descending)::bool
+       THEN (SELECT SUM(amount) FROM journal_lines WHERE journal_id = je.id AND side = 'DEBIT') END DESC,
+  CASE WHEN sqlc.arg(order_by)::text = 'date' AND NOT sqlc.arg(descending)::bool THEN je.journal_date END ASC,
+  je.journal_date DESC, je.id
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
 
 -- name: UpdateJournalEntry :one
 UPDATE journal_entries
 SET journal_date = $2, reference = $3, memo = $4,
     source_type = $5, source_id = $6,
-    updated_by = $7, updated_at = now(), revision = revision + 1
-WHERE id = $1
+    updated_by = $7, updated_at = now(), revision = revision + 1,
+    auto_reverse_date = $8
+WHERE id = $1 AND status = 'DRAFT'
 RETURNING *;
 
--- name: DeleteJournalEntry :exec
-DELETE FROM journal_entries WHERE id = $1;
+-- name: PostJournalEntry :one
+UPDATE journal_entries
+SET status = 'POSTED', posted_at = now(), posted_by = $2,
+    updated_by = $2, updated_at = now(), revision = revision + 1
+WHERE id = $1 AND status = 'DRAFT'
+RETURNING *;
+
+-- name: MarkJournalReversed :one
+UPDATE journal_entries
+SET status = 'REVERSED', reversed_by = $2,
+    updated_by = $3, updated_at = now(), revision = revision + 1
+WHERE id = $1 AND status = 'POSTED'
+RETURNING *;
+
+-- name: DeleteJournalEntry :execrows
+DELETE FROM journal_entries WHERE id = $1 AND status = 'DRAFT';
 
 -- name: CreateJournalLine :one
 INSERT INTO journal_lines (
-    journal_id, account_id, side, amount, cost_center_id, description
-) VALUES ($1, $2, $3, $4, $5, $6)
+    journal_id, account_id, side, amount, cost_center_id, description,
+    currency_code, transaction_amount, exchange_rate, functional_currency
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 RETURNING *;
 
 -- name: ListJournalLinesByEntry :many
@@ -67,4 +160,157 @@ DELETE FROM journal_lines WHERE journal_id = $1;
 -- =====================================================
 
 -- name: ListLedgerEntries :many
-SELECT * FROM ledger_entries ORDER BY transaction_date DESC LIMIT $1 OFFSET $2;
\ No newline at end of file
+SELECT le.* FROM ledger_entries le
+WHERE (sqlc.narg(account_id)::uuid IS NULL OR le.account_id = sqlc.narg(account_id))
+  AND (sqlc.narg(from_date)::timestamp IS NULL OR le.transaction_date >= sqlc.narg(from_date))
+  AND (sqlc.narg(to_date)::timestamp IS NULL OR le.transaction_date <= sqlc.narg(to_date))
+  AND (sqlc.narg(side)::text IS NULL OR le.side = sqlc.narg(side))
+  AND (sqlc.narg(cost_center_id)::uuid IS NULL OR le.cost_center_id = sqlc.narg(cost_center_id))
+  AND (sqlc.narg(reference_type)::text IS NULL OR le.reference_type = sqlc.narg(reference_type))
+  AND (sqlc.narg(reference_id)::text IS NULL OR le.reference_id = sqlc.narg(reference_id))
+  AND (sqlc.narg(source_type)::text IS NULL OR EXISTS (
+           SELECT 1 FROM journal_entries je
+           WHERE je.id = le.journal_id AND je.source_type = sqlc.narg(source_type)))
+  AND (sqlc.narg(organization_id)::text IS NULL OR EXISTS (
+           SELECT 1 FROM journal_entries je
+           WHERE je.id = le.journal_id AND je.organization_id = sqlc.narg(organization_id)))
+ORDER BY
+  CASE WHEN sqlc.arg(order_by)::text = 'amount' AND NOT sqlc.arg(descending)::bool THEN le.amount END ASC,
+  CASE WHEN sqlc.arg(order_by)::text = 'amount' AND sqlc.arg(descending)::bool THEN le.amount END DESC,
+  CASE WHEN sqlc.arg(order_by)::text = 'date' AND NOT sqlc.arg(descending)::bool THEN le.transaction_date END ASC,
+  le.transaction_date DESC, le.entry_id
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
+
+-- name: PostJournalToLedger :exec
+-- Projects every line of a journal into ledger_entries. The reference is the
+-- journal's source document, or the journal itself for manual entries.
+INSERT INTO ledger_entries (
+    account_id, side, amount, transaction_date, journal_id,
+    cost_center_id, description, reference_type, reference_id,
+    currency_code, transaction_amount, exchange_rate, functional_currency
+)
+SELECT jl.account_id, jl.side, jl.amount, je.journal_date, je.id,
+       jl.cost_center_id, jl.description,
+       COALESCE(je.source_type, 'JOURNAL'), COALESCE(je.source_id, je.id::text),
+       jl.currency_code, jl.transaction_amount, jl.exchange_rate, jl.functional_currency
+FROM journal_lines jl
+JOIN journal_entries je ON je.id = jl.journal_id
+WHERE je.id = $1;
+
+-- name: PostJournalsToLedger :exec
+-- Bulk form of PostJournalToLedger for many journals at once.
+INSERT INTO ledger_entries (
+    account_id, side, amount, transaction_date, journal_id,
+    cost_center_id, description, reference_type, reference_id,
+    currency_code, transaction_amount, exchange_rate, functional_currency
+)
+SELECT jl.account_id, jl.side, jl.amount, je.journal_date, je.id,
+       jl.cost_center_id, jl.description,
+       COALESCE(je.source_type, 'JOURNAL'), COALESCE(je.source_id, je.id::text),
+       jl.currency_code, jl.transaction_amount, jl.exchange_rate, jl.functional_currency
+FROM journal_lines jl
+JOIN journal_entries je ON je.id = jl.journal_id
+WHERE je.id = ANY(sqlc.arg(journal_ids)::uuid[]);
+
+-- name: AccumulateAccountPeriodBalances :exec
+-- Adds a posted journal's lines to the monthly balance of each account in
+-- the journal's organization.
+INSERT INTO account_period_balances (organization_id, account_id, period_start, debit_total, credit_total)
+SELECT COALESCE(je.organization_id, ''),
+       jl.account_id,
+       date_trunc('month', je.journal_date)::date,
+       SUM(CASE WHEN jl.side = 'DEBIT' THEN jl.amount ELSE 0 END),
+       SUM(CASE WHEN jl.side = 'CREDIT' THEN jl.amount ELSE 0 END)
+FROM journal_lines jl
+JOIN journal_entries je ON je.id = jl.journal_id
+WHERE je.id = $1
+GROUP BY COALESCE(je.organization_id, ''), jl.account_id, date_trunc('month', je.journal_date)::date
+ON CONFLICT (organization_id, account_id, period_start) DO UPDATE
+SET debit_total = account_period_balances.debit_total + EXCLUDED.debit_total,
+    credit_total = account_period_balances.credit_total + EXCLUDED.credit_total,
+    updated_at = now();
+
+-- name: AccumulateAccountPeriodBalancesForJournals :exec
+-- Bulk form of AccumulateAccountPeriodBalances for many journals at once.
+INSERT INTO account_period_balances (organization_id, account_id, period_start, debit_total, credit_total)
+SELECT COALESCE(je.organization_id, ''),
+       jl.account_id,
+       date_trunc('month', je.journal_date)::date,
+       SUM(CASE WHEN jl.side = 'DEBIT' THEN jl.amount ELSE 0 END),
+       SUM(CASE WHEN jl.side = 'CREDIT' THEN jl.amount ELSE 0 END)
+FROM journal_lines jl
+JOIN journal_entries je ON je.id = jl.journal_id
+WHERE je.id = ANY(sqlc.arg(journal_ids)::uuid[])
+GROUP BY COALESCE(je.organization_id, ''), jl.account_id, date_trunc('month', je.journal_date)::date
+ON CONFLICT (organization_id, account_id, period_start) DO UPDATE
+SET debit_total = account_period_balances.debit_total + EXCLUDED.debit_total,
+    credit_total = account_period_balances.credit_total + EXCLUDED.credit_total,
+    updated_at = now();
+
+-- name: ListAccountBalances :many
+-- Opening totals before from_date and movements over [from_date, to_date]
+-- of one organization's journals ('' for journals without one) for the given
+-- accounts, or every account when account_ids is empty. Whole
+-- months come from account_period_balances; ledger_entries is only read for
+-- the days of the first and last month that fall outside the range.
+WITH bounds AS (
+    SELECT date_trunc('month', sqlc.arg(from_date)::date)::date AS first_month,
+           (date_trunc('month', sqlc.arg(to_date)::date) + interval '1 month')::date AS after_last_month
+),
+months AS (
+    SELECT pb.account_id,
+           SUM(CASE WHEN pb.period_start < bounds.first_month THEN pb.debit_total ELSE 0 END) AS before_debit,
+           SUM(CASE WHEN pb.period_start < bounds.first_month THEN pb.credit_total ELSE 0 END) AS before_credit,
+           SUM(CASE WHEN pb.period_start >= bounds.first_month THEN pb.debit_total ELSE 0 END) AS within_debit,
+           SUM(CASE WHEN pb.period_start >= bounds.first_month THEN pb.credit_total ELSE 0 END) AS within_credit
+    FROM account_period_balances pb, bounds
+    WHERE pb.organization_id = sqlc.arg(organization_id)::text
+      AND pb.period_start < bounds.after_last_month
+    GROUP BY pb.account_id
+),
+edges AS (
+    SELECT le.account_id,
+           SUM(CASE WHEN le.transaction_date < sqlc.arg(from_date)::date AND le.side = 'DEBIT' THEN le.amount ELSE 0 END) AS head_debit,
+           SUM(CASE WHEN le.transaction_date < sqlc.arg(from_date)::date AND le.side = 'CREDIT' THEN le.amount ELSE 0 END) AS head_credit,
+           SUM(CASE WHEN le.transaction_date >= sqlc.arg(to_date)::date + 1 AND le.side = 'DEBIT' THEN le.amount ELSE 0 END) AS tail_debit,
+           SUM(CASE WHEN le.transaction_date >= sqlc.arg(to_date)::date + 1 AND le.side = 'CREDIT' THEN le.amount ELSE 0 END) AS tail_credit
+    FROM ledger_entries le
+    LEFT JOIN journal_entries je ON je.id = le.journal_id
+    CROSS JOIN bounds
+    WHERE COALESCE(je.organization_id, '') = sqlc.arg(organization_id)::text
+      AND le.transaction_date >= bounds.first_month
+      AND le.transaction_date < bounds.after_last_month
+      AND (le.transaction_date < sqlc.arg(from_date)::date OR le.transaction_date >= sqlc.arg(to_date)::date + 1)
+    GROUP BY le.account_id
+)
+SELECT a.id AS account_id, a.code, a.name, a.type,
+       (COALESCE(m.before_debit, 0) + COALESCE(e.head_debit, 0))::NUMERIC(18,2) AS opening_debit,
+       (COALESCE(m.before_credit, 0) + COALESCE(e.head_credit, 0))::NUMERIC(18,2) AS opening_credit,
+       (COALESCE(m.within_debit, 0) - COALESCE(e.head_debit, 0) - COALESCE(e.tail_debit, 0))::NUMERIC(18,2) AS period_debit,
+       (COALESCE(m.within_credit, 0) - COALESCE(e.head_credit, 0) - COALESCE(e.tail_credit, 0))::NUMERIC(18,2) AS period_credit
+FROM accounts a
+LEFT JOIN months m ON m.account_id = a.id
+LEFT JOIN edges e ON e.account_id = a.id
+WHERE cardinality(sqlc.arg(account_ids)::uuid[]) = 0 OR a.id = ANY(sqlc.arg(account_ids)::uuid[])
+ORDER BY a.code;
+
+
+-- name: ListAccountCurrencyBalances :many
+-- Transaction-currency totals of one organization's journals per account and
+-- currency: opening before from_date and movements over [from_date, to_date].
+-- Read from
+-- ledger_entries, since account_period_balances is kept in functional
+-- currency only.
+SELECT le.account_id, le.currency_code,
+       SUM(CASE WHEN le.transaction_date < sqlc.arg(from_date)::date AND le.side = 'DEBIT' THEN le.transaction_amount ELSE 0 END)::NUMERIC(18,2) AS opening_debit,
+       SUM(CASE WHEN le.transaction_date < sqlc.arg(from_date)::date AND le.side = 'CREDIT' THEN le.transaction_amount ELSE 0 END)::NUMERIC(18,2) AS opening_credit,
+       SUM(CASE WHEN le.transaction_date >= sqlc.arg(from_date)::date AND le.side = 'DEBIT' THEN le.transaction_amount ELSE 0 END)::NUMERIC(18,2) AS period_debit,
+       SUM(CASE WHEN le.transaction_date >= sqlc.arg(from_date)::date AND le.side = 'CREDIT' THEN le.transaction_amount ELSE 0 END)::NUMERIC(18,2) AS period_credit
+FROM ledger_entries le
+LEFT JOIN journal_entries je ON je.id = le.journal_id
+WHERE COALESCE(je.organization_id, '') = sqlc.arg(organization_id)::text
+  AND le.transaction_date < sqlc.arg(to_date)::date + 1
+  AND le.currency_code <> ''
+  AND (cardinality(sqlc.arg(account_ids)::uuid[]) = 0 OR le.account_id = ANY(sqlc.arg(account_ids)::uuid[]))
+GROUP BY le.account_id, le.currency_code
+ORDER BY le.account_id, le.currency_code;
\ No newline at end of file
@@ -5,9 +5,9 @@
 -- name: CreateAccrual :one
 INSERT INTO accruals (
     description, amount, accrual_date, account_id,
-    created_by, updated_by
+    created_by, updated_by, organization_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7
 ) RETURNING *;
 
 -- name: GetAccrualById :one
@@ -3,8 +3,8 @@
 -- =====================================================
 
 -- name: CreateBudget :one
-INSERT INTO budgets (name, total_amount, status, created_by, updated_by)
-VALUES ($1, $2, COALESCE($3, 'DRAFT'), $4, $5)
+INSERT INTO budgets (name, total_amount, status, created_by, updated_by, currency_code)
+VALUES ($1, $2, COALESCE($3, 'DRAFT'), $4, $5, $6)
 RETURNING *;
 
 -- name: GetBudget :one
@@ -22,6 +22,7 @@ SET
     total_amount = $3,
     status = $4,
     updated_by = $5,
+    currency_code = $6,
     updated_at = now(),
     revision = revision + 1
 WHERE id = $1
@@ -0,0 +1,36 @@
+-- =====================================================
+-- Credit control
+-- =====================================================
+
+-- name: GetCreditPolicy :one
+SELECT * FROM credit_policies WHERE organization_id = $1;
+
+-- name: UpsertCreditPolicy :one
+INSERT INTO credit_policies (organization_id, threshold_percent, action, updated_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (organization_id)
+DO UPDATE SET threshold_percent = EXCLUDED.threshold_percent, action = EXCLUDED.action,
+              updated_at = now(), updated_by = EXCLUDED.updated_by
+RETURNING *;
+
+-- name: GetPartyCreditExposure :one
+-- The sums making up a customer's exposure, over its sales invoices only.
+-- Applied credit notes have already reduced the open dues; only those
+-- created before notes were applied are still to be taken off.
+SELECT
+    (SELECT COALESCE(SUM(pd.amount_due), 0)
+       FROM payment_dues pd JOIN invoices i ON i.id = pd.invoice_id
+      WHERE i.party_id = sqlc.arg(party_id) AND i.type = 'SALES'
+        AND pd.status NOT IN ('PAID', 'WRITEOFF'))::text AS open_dues,
+    (SELECT COALESCE(SUM(i.grand_total), 0)
+       FROM invoices i
+      WHERE i.party_id = sqlc.arg(party_id) AND i.type = 'SALES'
+        AND i.status = 'DRAFT')::text AS draft_invoices,
+    (SELECT COALESCE(SUM(n.amount), 0)
+       FROM credit_debit_notes n JOIN invoices i ON i.id = n.invoice_id
+      WHERE i.party_id = sqlc.arg(party_id) AND i.type = 'SALES'
+        AND n.type = 'CREDIT' AND n.applied_at IS NULL)::text AS unapplied_credits;
+
+-- name: ApproveInvoiceCredit :execrows
+UPDATE invoices SET credit_approved_by = $2, credit_approved_at = now()
+WHERE id = $1 AND status = 'DRAFT';
@@ -28,4 +28,39 @@ WHERE id = $1
 RETURNING *;
 
 -- name: DeleteCreditDebitNote :exec
-DELETE FROM credit_debit_notes WHERE id = $1;
\ No newline at end of file
+DELETE FROM credit_debit_notes WHERE id = $1;
+
+-- =====================================================
+-- Applying notes
+-- =====================================================
+
+-- name: LockNoteInvoice :one
+-- The invoice a note is applied to, locked until the note is.
+SELECT * FROM invoices WHERE id = $1 FOR UPDATE;
+
+-- name: ListInvoiceDues :many
+SELECT * FROM payment_dues
+WHERE invoice_id = $1
+ORDER BY due_date, created_at
+FOR UPDATE;
+
+-- name: CreditPaymentDue :exec
+UPDATE payment_dues
+SET amount_due = $2, status = $3, updated_by = $4,
+    updated_at = now(), revision = revision + 1
+WHERE id = $1;
+
+-- name: ApplyNoteToInvoice :one
+UPDATE invoices
+SET credited_total = credited_total + sqlc.arg(credited)::numeric,
+    debited_total = debited_total + sqlc.arg(debited)::numeric,
+    status = sqlc.arg(status), updated_by = sqlc.arg(updated_by),
+    updated_at = now(), revision = revision + 1
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: RecordNoteApplication :one
+UPDATE credit_debit_notes
+SET journal_id = $2, payment_due_id = $3, applied_at = now()
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,87 @@
+-- =====================================================
+-- Dunning
+-- =====================================================
+
+-- name: DeleteDunningStages :exec
+DELETE FROM dunning_stages WHERE organization_id = $1;
+
+-- name: InsertDunningStage :one
+INSERT INTO dunning_stages (organization_id, days_from_due, escalation_level, name, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListDunningStages :many
+SELECT * FROM dunning_stages WHERE organization_id = $1 ORDER BY days_from_due;
+
+-- name: PlaceInvoiceDunningHold :one
+INSERT INTO dunning_holds (organization_id, invoice_id, reason, created_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (invoice_id) WHERE invoice_id IS NOT NULL
+DO UPDATE SET reason = EXCLUDED.reason, created_at = now(), created_by = EXCLUDED.created_by
+RETURNING *;
+
+-- name: PlacePartyDunningHold :one
+INSERT INTO dunning_holds (organization_id, party_id, reason, created_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (organization_id, party_id) WHERE party_id IS NOT NULL
+DO UPDATE SET reason = EXCLUDED.reason, created_at = now(), created_by = EXCLUDED.created_by
+RETURNING *;
+
+-- name: ReleaseInvoiceDunningHold :execrows
+DELETE FROM dunning_holds WHERE invoice_id = $1;
+
+-- name: ReleasePartyDunningHold :execrows
+DELETE FROM dunning_holds WHERE organization_id = $1 AND party_id = $2;
+
+-- name: ListDunningHolds :many
+SELECT * FROM dunning_holds WHERE organization_id = $1 ORDER BY created_at, id;
+
+-- name: ListPastDueInvoices :many
+-- Open invoices the dunning job marks OVERDUE.
+SELECT id FROM invoices
+WHERE status IN ('ISSUED', 'PARTIALLY_PAID') AND due_date < sqlc.arg(as_of)::timestamptz
+ORDER BY due_date, id;
+
+-- name: MarkPaymentDuesOverdue :many
+UPDATE payment_dues
+SET status = 'OVERDUE',
+    updated_at = now(),
+    updated_by = sqlc.arg(updated_by),
+    revision = revision + 1
+WHERE status IN ('DUE', 'PARTIALLY_PAID') AND due_date < sqlc.arg(as_of)::timestamptz
+RETURNING *;
+
+-- name: ListDunningReminderCandidates :many
+-- The latest stage each open, unheld invoice has reached by as_of, unless a
+-- reminder for that stage or a later one was already sent. Invoices that
+-- reached several stages since the last run get only the latest.
+WITH reached AS (
+    SELECT DISTINCT ON (i.id)
+        i.id AS invoice_id, i.organization_id, i.invoice_number, i.party_id,
+        i.due_date, i.grand_total, i.currency_code, i.status,
+        s.days_from_due, s.escalation_level, s.name AS stage_name
+    FROM invoices i
+    JOIN dunning_stages s ON s.organization_id = i.organization_id
+    WHERE i.status IN ('ISSUED', 'PARTIALLY_PAID', 'OVERDUE')
+      AND i.due_date IS NOT NULL
+      AND i.due_date + s.days_from_due * INTERVAL '1 day' <= sqlc.arg(as_of)::timestamptz
+      AND NOT EXISTS (
+          SELECT 1 FROM dunning_holds h
+          WHERE h.invoice_id = i.id
+             OR (h.organization_id = i.organization_id AND h.party_id = i.party_id)
+      )
+    ORDER BY i.id, s.days_from_due DESC
+)
+SELECT * FROM reached r
+WHERE NOT EXISTS (
+    SELECT 1 FROM dunning_reminders d
+    WHERE d.invoice_id = r.invoice_id AND d.days_from_due >= r.days_from_due
+)
+ORDER BY r.due_date, r.invoice_id;
+
+-- name: RecordDunningReminder :one
+-- Returns no row when the reminder was already sent.
+INSERT INTO dunning_reminders (invoice_id, days_from_due, escalation_level)
+VALUES ($1, $2, $3)
+ON CONFLICT (invoice_id, days_from_due) DO NOTHING
+RETURNING *;
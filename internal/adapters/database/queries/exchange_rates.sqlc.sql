@@ -2,10 +2,10 @@
 -- name: CreateExchangeRate :one
 INSERT INTO exchange_rates (
     base_currency, quote_currency, rate, as_of,
-    created_by, updated_by, revision
+    created_by, updated_by, revision, rate_type
 ) VALUES (
     $1, $2, $3, $4,
-    $5, $6, $7
+    $5, $6, $7, $8
 ) RETURNING *;
 
 -- Fetch single exchange rate by ID
@@ -22,6 +22,7 @@ SET
     as_of = $5,
     updated_by = $6,
     revision = $7,
+    rate_type = $8,
     updated_at = now()
 WHERE id = $1
 RETURNING *;
@@ -40,12 +41,24 @@ WHERE
 ORDER BY as_of DESC
 LIMIT $3 OFFSET $4;
 
--- Fetch the latest rate for a currency pair as of timestamp
+-- Fetch the latest spot rate for a currency pair as of timestamp
 -- name: GetLatestRate :one
 SELECT *
 FROM exchange_rates
 WHERE base_currency = $1
   AND quote_currency = $2
   AND as_of <= $3
+  AND rate_type = 'SPOT'
+ORDER BY as_of DESC
+LIMIT 1;
+
+-- Fetch the latest rate of a given type for a currency pair as of timestamp
+-- name: GetLatestRateOfType :one
+SELECT *
+FROM exchange_rates
+WHERE base_currency = $1
+  AND quote_currency = $2
+  AND as_of <= $3
+  AND rate_type = $4
 ORDER BY as_of DESC
 LIMIT 1;
\ No newline at end of file
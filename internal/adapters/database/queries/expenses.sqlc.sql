@@ -3,8 +3,8 @@
 -- =====================================================
 
 -- name: CreateExpense :one 
-INSERT INTO expenses (category, amount, expense_date, cost_center_id, created_by, updated_by)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO expenses (category, amount, expense_date, cost_center_id, created_by, updated_by, organization_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *;
 
 -- name: GetExpense :one 
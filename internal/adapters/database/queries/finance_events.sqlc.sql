@@ -67,8 +67,8 @@ LIMIT $2 OFFSET $3;
 -- =====================================================
 -- name: InsertVendorBillApprovedEvent :one
 INSERT INTO vendor_bill_approved_events (
-    vendor_bill_id, amount, approved_at, organization_id
-) VALUES ($1, $2, $3, $4)
+    vendor_bill_id, amount, approved_at, organization_id, party_id
+) VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
 -- name: ListVendorBillApprovedEvents :many
@@ -0,0 +1,96 @@
+-- =====================================================
+-- Fiscal Calendars
+-- =====================================================
+
+-- name: UpsertFiscalCalendar :one
+INSERT INTO fiscal_calendars (
+    organization_id, year_start_month, retained_earnings_account_id, created_by, updated_by,
+    functional_currency
+) VALUES ($1, $2, $3, $4, $4, $5)
+ON CONFLICT (organization_id) DO UPDATE
+SET year_start_month = EXCLUDED.year_start_month,
+    retained_earnings_account_id = EXCLUDED.retained_earnings_account_id,
+    functional_currency = EXCLUDED.functional_currency,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = now()
+RETURNING *;
+
+-- name: GetFiscalCalendar :one
+SELECT * FROM fiscal_calendars WHERE organization_id = $1;
+
+-- =====================================================
+-- Fiscal Periods
+-- =====================================================
+
+-- name: CreateFiscalPeriod :one
+INSERT INTO fiscal_periods (organization_id, fiscal_year, period_number, start_date, end_date)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (organization_id, fiscal_year, period_number) DO UPDATE
+SET updated_at = fiscal_periods.updated_at
+RETURNING *;
+
+-- name: GetFiscalPeriod :one
+SELECT * FROM fiscal_periods WHERE id = $1;
+
+-- name: GetFiscalPeriodForDate :one
+SELECT * FROM fiscal_periods
+WHERE organization_id = $1 AND start_date <= $2 AND end_date >= $2;
+
+-- name: ListFiscalPeriods :many
+SELECT * FROM fiscal_periods
+WHERE organization_id = $1 AND fiscal_year = $2
+ORDER BY period_number;
+
+-- name: LockFiscalYearPeriods :many
+-- Locks a year's periods for the year-end close, so concurrent closes and
+-- status changes wait for it.
+SELECT * FROM fiscal_periods
+WHERE organization_id = $1 AND fiscal_year = $2
+ORDER BY period_number
+FOR UPDATE;
+
+-- name: LockJournalPeriods :many
+-- Share-locks the periods journals are being posted into, so a posting waits
+-- for a year-end close holding them and then reads them as the close left
+-- them. Journals outside any generated period return no row.
+SELECT je.id AS journal_id, je.source_type,
+       fp.fiscal_year, fp.period_number, fp.status, fp.year_closed_at
+FROM journal_entries je
+JOIN fiscal_periods fp
+  ON fp.organization_id = je.organization_id
+ AND fp.start_date <= je.journal_date AND fp.end_date >= je.journal_date
+WHERE je.id = ANY(sqlc.arg(journal_ids)::uuid[])
+FOR SHARE OF fp;
+
+-- name: CloseFiscalYearPeriods :many
+UPDATE fiscal_periods
+SET status = 'CLOSED',
+    closed_at = now(),
+    closed_by = $3,
+    year_closed_at = now(),
+    updated_at = now()
+WHERE organization_id = $1 AND fiscal_year = $2
+RETURNING *;
+
+-- name: UpdateFiscalPeriodStatus :one
+UPDATE fiscal_periods
+SET status = $2,
+    closed_at = CASE WHEN $2 = 'OPEN' THEN NULL ELSE now() END,
+    closed_by = $3,
+    updated_at = now()
+WHERE id = $1 AND year_closed_at IS NULL
+RETURNING *;
+
+-- name: SumIncomeStatementBalances :many
+-- Net (debit - credit) ledger movement per revenue/expense account for one
+-- organization over [$2, $3). Seed data uses INCOME for revenue accounts.
+SELECT le.account_id, a.type,
+       SUM(CASE WHEN le.side = 'DEBIT' THEN le.amount ELSE -le.amount END)::NUMERIC(18,2) AS net_amount
+FROM ledger_entries le
+JOIN accounts a ON a.id = le.account_id
+JOIN journal_entries je ON je.id = le.journal_id
+WHERE je.organization_id = $1
+  AND le.transaction_date >= $2 AND le.transaction_date < $3
+  AND a.type IN ('REVENUE', 'INCOME', 'EXPENSE')
+GROUP BY le.account_id, a.type
+ORDER BY le.account_id;
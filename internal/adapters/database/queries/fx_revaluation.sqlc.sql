@@ -0,0 +1,54 @@
+-- name: UpsertFxRevaluationSettings :one
+INSERT INTO fx_revaluation_settings (
+    organization_id, unrealized_gain_account_id, unrealized_loss_account_id, account_ids,
+    created_by, updated_by
+) VALUES ($1, $2, $3, $4, $5, $5)
+ON CONFLICT (organization_id) DO UPDATE
+SET unrealized_gain_account_id = EXCLUDED.unrealized_gain_account_id,
+    unrealized_loss_account_id = EXCLUDED.unrealized_loss_account_id,
+    account_ids = EXCLUDED.account_ids,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = now()
+RETURNING *;
+
+-- name: GetFxRevaluationSettings :one
+SELECT * FROM fx_revaluation_settings WHERE organization_id = $1;
+
+-- name: ListForeignBankAccounts :many
+-- An organization's bank accounts held in a currency other than
+-- functional_currency and linked to a ledger account.
+SELECT * FROM bank_accounts
+WHERE organization_id = sqlc.arg(organization_id)::text
+  AND currency_code NOT IN ('', sqlc.arg(functional_currency)::text)
+  AND COALESCE(ledger_account_id, '') <> ''
+ORDER BY name, id;
+
+-- name: ListForeignCurrencyPositions :many
+-- Debit-positive balance of each account in each foreign currency as at
+-- as_of from one organization's journals, in that currency and at the
+-- functional amounts it was booked at.
+SELECT le.account_id, le.currency_code,
+       SUM(CASE WHEN le.side = 'DEBIT' THEN le.transaction_amount ELSE -le.transaction_amount END)::NUMERIC(18,2) AS balance,
+       SUM(CASE WHEN le.side = 'DEBIT' THEN le.amount ELSE -le.amount END)::NUMERIC(18,2) AS carrying_amount
+FROM ledger_entries le
+JOIN journal_entries je ON je.id = le.journal_id
+WHERE je.organization_id = sqlc.arg(organization_id)::text
+  AND le.account_id = ANY(sqlc.arg(account_ids)::uuid[])
+  AND le.currency_code NOT IN ('', sqlc.arg(functional_currency)::text)
+  AND le.transaction_date < sqlc.arg(as_of)::date + 1
+GROUP BY le.account_id, le.currency_code
+ORDER BY le.account_id, le.currency_code;
+
+-- name: ListOpenForeignPaymentDues :many
+-- Unsettled dues of an organization's invoices dated on or before as_of,
+-- in a currency other than functional_currency.
+SELECT pd.id, pd.invoice_id, i.invoice_number, i.type AS invoice_type,
+       pd.currency_code, pd.amount_due, pd.exchange_rate, pd.account_id
+FROM payment_dues pd
+JOIN invoices i ON i.id = pd.invoice_id
+WHERE i.organization_id = sqlc.arg(organization_id)
+  AND i.invoice_date < sqlc.arg(as_of)::date + 1
+  AND pd.status NOT IN ('PAID', 'WRITEOFF')
+  AND pd.currency_code NOT IN ('', sqlc.arg(functional_currency)::text)
+  AND pd.account_id IS NOT NULL
+ORDER BY pd.due_date, pd.id;
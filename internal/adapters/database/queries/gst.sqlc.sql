@@ -6,6 +6,9 @@ RETURNING *;
 -- name: GetGstBreakup :one
 SELECT * FROM gst_breakups WHERE invoice_id = $1;
 
+-- name: DeleteGstBreakup :exec
+DELETE FROM gst_breakups WHERE invoice_id = $1;
+
 -- name: AddGstRegime :one
 INSERT INTO gst_regimes (invoice_id, gstin, place_of_supply, reverse_charge)
 VALUES ($1, $2, $3, $4)
@@ -14,13 +17,18 @@ RETURNING *;
 -- name: GetGstRegime :one
 SELECT * FROM gst_regimes WHERE invoice_id = $1;
 
+-- name: DeleteGstRegime :exec
+DELETE FROM gst_regimes WHERE invoice_id = $1;
+
 -- name: AddGstDocStatus :one
 INSERT INTO gst_doc_statuses (
     invoice_id, einvoice_status, irn, ack_no, ack_date,
-    eway_status, eway_bill_no, eway_valid_upto, last_error, last_synced_at
+    eway_status, eway_bill_no, eway_valid_upto, last_error, last_synced_at,
+    signed_qr_code
 )
-VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)
 RETURNING *;
 
 -- name: GetGstDocStatus :one
-SELECT * FROM gst_doc_statuses WHERE invoice_id = $1;
\ No newline at end of file
+SELECT * FROM gst_doc_statuses WHERE invoice_id = $1
+ORDER BY created_at DESC LIMIT 1;
\ No newline at end of file
@@ -2,7 +2,7 @@
 INSERT INTO invoices (
     invoice_number, type, invoice_date, due_date, delivery_date, organization_id, po_number, eway_number_legacy, status_note, status, payment_reference, challan_number, challan_date,
     lr_number, transporter_name, transporter_id, vehicle_number, against_invoice_number, against_invoice_date, subtotal, gst_cgst, gst_sgst, gst_igst, gst_rate, grand_total,
-    created_by, updated_by, revision
+    created_by, updated_by, revision, currency_code, discount_total, tax_total, round_off, party_id
 ) VALUES (
     $1, $2, $3, $4, $5,
     $6, $7, $8, $9,
@@ -10,7 +10,7 @@ INSERT INTO invoices (
     $15, $16, $17, $18,
     $19, $20,
     $21, $22, $23, $24, $25, $26,
-    $27, $28) RETURNING *;
+    $27, $28, $29, $30, $31, $32, $33) RETURNING *;
 
 -- name: GetInvoice :one
 SELECT * FROM invoices WHERE id = $1;
@@ -18,42 +18,117 @@ SELECT * FROM invoices WHERE id = $1;
 -- name: ListInvoices :many
 SELECT * FROM invoices ORDER BY invoice_date DESC LIMIT $1 OFFSET $2;
 
--- name: DeleteInvoice :exec
-DELETE FROM invoices WHERE id = $1;
+-- name: DeleteInvoice :execrows
+DELETE FROM invoices WHERE id = $1 AND status = 'DRAFT';
 
 -- name: CreateInvoiceItem :one
 INSERT INTO invoice_items (
-    invoice_id, name, description, hsn, quantity, unit_price, line_subtotal, line_total, cost_center_id
+    invoice_id, name, description, hsn, quantity, unit_price, line_subtotal, line_total, cost_center_id, line_no, account_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
 ) RETURNING *;
 
 -- name: ListInvoiceItems :many
-SELECT * FROM invoice_items WHERE invoice_id = $1;
+SELECT * FROM invoice_items WHERE invoice_id = $1 ORDER BY line_no, created_at, id;
+
+-- name: DeleteInvoiceItems :exec
+-- Item discounts and taxes go with their items.
+DELETE FROM invoice_items WHERE invoice_id = $1;
+
+-- name: AddInvoiceItemDiscount :one
+INSERT INTO invoice_item_discounts (item_id, description, amount, created_by, line_no)
+VALUES ($1, $2, $3, $4, $5) RETURNING *;
+
+-- name: AddInvoiceItemTax :one
+INSERT INTO invoice_item_taxes (item_id, name, rate, amount, created_by, line_no)
+VALUES ($1, $2, $3, $4, $5, $6) RETURNING *;
+
+-- name: ListInvoiceItemDiscounts :many
+SELECT d.* FROM invoice_item_discounts d
+JOIN invoice_items i ON i.id = d.item_id
+WHERE i.invoice_id = $1
+ORDER BY d.line_no, d.created_at, d.id;
+
+-- name: ListInvoiceItemTaxes :many
+SELECT t.* FROM invoice_item_taxes t
+JOIN invoice_items i ON i.id = t.item_id
+WHERE i.invoice_id = $1
+ORDER BY t.line_no, t.created_at, t.id;
+
+-- name: ListInvoiceTaxes :many
+SELECT * FROM invoice_taxes WHERE invoice_id = $1 ORDER BY line_no, created_at, id;
+
+-- name: ListInvoiceDiscounts :many
+SELECT * FROM invoice_discounts WHERE invoice_id = $1 ORDER BY line_no, created_at, id;
+
+-- name: UpdateInvoiceTotals :one
+-- Stores figures computed from the invoice's lines. Appended lines change
+-- the draft, so its revision moves on and a credit approval given for it is
+-- withdrawn, like any other update.
+UPDATE invoices
+SET subtotal           = $2,
+    discount_total     = $3,
+    tax_total          = $4,
+    gst_rate           = $5,
+    gst_cgst           = $6,
+    gst_sgst           = $7,
+    gst_igst           = $8,
+    round_off          = $9,
+    grand_total        = $10,
+    credit_approved_by = NULL,
+    credit_approved_at = NULL,
+    updated_at         = now(),
+    revision           = revision + 1
+WHERE id = $1 AND status = 'DRAFT'
+RETURNING *;
 
 -- name: AddInvoiceTax :one
-INSERT INTO invoice_taxes (invoice_id, name, rate, amount)
-VALUES ($1, $2, $3, $4) RETURNING *;
+INSERT INTO invoice_taxes (invoice_id, name, rate, amount, line_no)
+VALUES ($1, $2, $3, $4, $5) RETURNING *;
+
+-- name: DeleteInvoiceTaxes :exec
+DELETE FROM invoice_taxes WHERE invoice_id = $1;
+
+-- name: UpdateInvoiceTaxAmount :one
+-- Rewrites an invoice-level tax after the taxable value changed.
+UPDATE invoice_taxes SET amount = $2, revision = revision + 1
+WHERE id = $1 RETURNING *;
 
 -- name: AddInvoiceDiscount :one
-INSERT INTO invoice_discounts (invoice_id, description, amount)
-VALUES ($1, $2, $3) RETURNING *;
+INSERT INTO invoice_discounts (invoice_id, description, amount, line_no)
+VALUES ($1, $2, $3, $4) RETURNING *;
+
+-- name: DeleteInvoiceDiscounts :exec
+DELETE FROM invoice_discounts WHERE invoice_id = $1;
 
 -- name: SearchInvoices :many
-SELECT *
-FROM invoices
-WHERE 
-    (invoice_number ILIKE '%' || $1 || '%'
-     OR po_number ILIKE '%' || $1 || '%'
-     OR status_note ILIKE '%' || $1 || '%'
-     OR payment_reference ILIKE '%' || $1 || '%'
-     OR transporter_name ILIKE '%' || $1 || '%'
-     OR transporter_id ILIKE '%' || $1 || '%'
-     OR vehicle_number ILIKE '%' || $1 || '%')
-ORDER BY invoice_date DESC
-LIMIT $2 OFFSET $3;
+-- The query is parsed as web search syntax and matched against the invoice
+-- search documents; a GSTIN also matches its party's invoices exactly.
+-- Relevance ordering ranks number hits above party and item hits.
+SELECT i.*
+FROM invoices i
+LEFT JOIN invoice_search s ON s.invoice_id = i.id
+WHERE i.organization_id = sqlc.arg(organization_id)
+  AND (sqlc.arg(query)::text = ''
+       OR s.document @@ websearch_to_tsquery('simple', sqlc.arg(query)::text)
+       OR i.party_id IN (SELECT r.party_id FROM party_tax_registrations r WHERE r.number = upper(sqlc.arg(query)::text)))
+  AND (sqlc.narg(status)::text IS NULL OR i.status = sqlc.narg(status))
+  AND (sqlc.narg(type)::text IS NULL OR i.type = sqlc.narg(type))
+  AND (sqlc.narg(from_date)::timestamptz IS NULL OR i.invoice_date >= sqlc.narg(from_date))
+  AND (sqlc.narg(to_date)::timestamptz IS NULL OR i.invoice_date <= sqlc.narg(to_date))
+  AND (sqlc.narg(due_from)::timestamptz IS NULL OR i.due_date >= sqlc.narg(due_from))
+  AND (sqlc.narg(due_to)::timestamptz IS NULL OR i.due_date <= sqlc.narg(due_to))
+  AND (sqlc.narg(min_total)::numeric IS NULL OR i.grand_total >= sqlc.narg(min_total))
+  AND (sqlc.narg(max_total)::numeric IS NULL OR i.grand_total <= sqlc.narg(max_total))
+ORDER BY
+  CASE WHEN sqlc.arg(order_by)::text = 'relevance'
+       THEN COALESCE(ts_rank(s.document, websearch_to_tsquery('simple', sqlc.arg(query)::text)), 0) END DESC,
+  CASE WHEN sqlc.arg(order_by)::text = 'amount' AND NOT sqlc.arg(descending)::bool THEN i.grand_total END ASC,
+  CASE WHEN sqlc.arg(order_by)::text = 'amount' AND sqlc.arg(descending)::bool THEN i.grand_total END DESC,
+  CASE WHEN sqlc.arg(order_by)::text = 'date' AND NOT sqlc.arg(descending)::bool THEN i.invoice_date END ASC,
+  i.invoice_date DESC, i.id
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
 
--- name: UpdateInvoice :one
 -- name: UpdateInvoice :one
 UPDATE invoices
 SET 
@@ -83,7 +158,103 @@ SET
     gst_sgst             = $25,
     gst_igst             = $26,
     updated_by           = $27,
-    revision             = $28,
+    revision             = revision + 1,
+    currency_code        = $28,
+    discount_total       = $29,
+    tax_total            = $30,
+    round_off            = $31,
+    party_id             = $32,
+    credit_approved_by   = NULL,
+    credit_approved_at   = NULL,
     updated_at           = now()
+WHERE id = $1 AND status = 'DRAFT'
+RETURNING *;
+
+-- name: IssueInvoice :one
+UPDATE invoices
+SET status = 'ISSUED', issued_at = now(), issued_by = $2,
+    updated_by = $2, updated_at = now(), revision = revision + 1
+WHERE id = $1 AND status = 'DRAFT' AND revision = $3
+RETURNING *;
+
+-- name: RecordInvoiceIssue :one
+UPDATE invoices SET invoice_number = $2, journal_id = $3
 WHERE id = $1
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: VoidInvoice :one
+UPDATE invoices
+SET status = 'VOID', voided_at = now(), voided_by = $2, void_reason = $3,
+    updated_by = $2, updated_at = now(), revision = revision + 1
+WHERE id = $1 AND status IN ('ISSUED', 'OVERDUE')
+  AND credited_total = 0 AND debited_total = 0
+RETURNING *;
+
+-- name: MarkInvoiceOverdue :one
+UPDATE invoices
+SET status = 'OVERDUE', overdue_at = now(), overdue_by = $2,
+    updated_by = $2, updated_at = now(), revision = revision + 1
+WHERE id = $1 AND status IN ('ISSUED', 'PARTIALLY_PAID')
+RETURNING *;
+
+-- name: UpsertInvoicePostingRule :one
+INSERT INTO invoice_posting_rules (
+    organization_id, invoice_type, control_account_id, line_account_id,
+    cgst_account_id, sgst_account_id, igst_account_id, other_tax_account_id,
+    discount_account_id, round_off_account_id, created_by, updated_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $11)
+ON CONFLICT (organization_id, invoice_type) DO UPDATE
+SET control_account_id = EXCLUDED.control_account_id,
+    line_account_id = EXCLUDED.line_account_id,
+    cgst_account_id = EXCLUDED.cgst_account_id,
+    sgst_account_id = EXCLUDED.sgst_account_id,
+    igst_account_id = EXCLUDED.igst_account_id,
+    other_tax_account_id = EXCLUDED.other_tax_account_id,
+    discount_account_id = EXCLUDED.discount_account_id,
+    round_off_account_id = EXCLUDED.round_off_account_id,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = now()
+RETURNING *;
+
+-- name: GetInvoicePostingRule :one
+SELECT * FROM invoice_posting_rules WHERE organization_id = $1 AND invoice_type = $2;
+
+-- name: UpsertInvoiceNumberSeries :one
+INSERT INTO invoice_number_series (organization_id, invoice_type, pattern, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $4)
+ON CONFLICT (organization_id, invoice_type) DO UPDATE
+SET pattern = EXCLUDED.pattern,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = now()
+RETURNING *;
+
+-- name: GetInvoiceNumberSeries :one
+SELECT * FROM invoice_number_series WHERE organization_id = $1 AND invoice_type = $2;
+
+-- name: NextInvoiceNumber :one
+INSERT INTO invoice_number_counters (organization_id, invoice_type, fiscal_year, last_number)
+VALUES ($1, $2, $3, 1)
+ON CONFLICT (organization_id, invoice_type, fiscal_year) DO UPDATE
+SET last_number = invoice_number_counters.last_number + 1
+RETURNING last_number;
+
+-- name: UpsertInvoiceTemplate :one
+INSERT INTO invoice_templates (
+    organization_id, company_name, address, logo, bank_details, terms, footer,
+    created_by, updated_by, country_code, peppol_endpoint
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8, $9, $10)
+ON CONFLICT (organization_id) DO UPDATE
+SET company_name = EXCLUDED.company_name,
+    address = EXCLUDED.address,
+    logo = EXCLUDED.logo,
+    bank_details = EXCLUDED.bank_details,
+    terms = EXCLUDED.terms,
+    footer = EXCLUDED.footer,
+    country_code = EXCLUDED.country_code,
+    peppol_endpoint = EXCLUDED.peppol_endpoint,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = now()
+RETURNING *;
+
+-- name: GetInvoiceTemplate :one
+SELECT * FROM invoice_templates WHERE organization_id = $1;
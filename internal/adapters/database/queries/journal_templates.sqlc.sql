@@ -0,0 +1,62 @@
+-- =====================================================
+-- Journal Templates
+-- =====================================================
+
+-- name: CreateJournalTemplate :one
+INSERT INTO journal_templates (
+    organization_id, name, reference, memo, frequency, start_date, end_date,
+    day_of_month, auto_post, adjustment_percent, active, created_by, updated_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $12)
+RETURNING *;
+
+-- name: GetJournalTemplate :one
+SELECT * FROM journal_templates WHERE id = $1;
+
+-- name: UpdateJournalTemplate :one
+UPDATE journal_templates
+SET name = $2, reference = $3, memo = $4, frequency = $5, start_date = $6,
+    end_date = $7, day_of_month = $8, auto_post = $9, adjustment_percent = $10,
+    active = $11, updated_by = $12, updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteJournalTemplate :exec
+DELETE FROM journal_templates WHERE id = $1;
+
+-- name: ListJournalTemplates :many
+SELECT * FROM journal_templates ORDER BY name, id LIMIT $1 OFFSET $2;
+
+-- name: ListDueJournalTemplates :many
+-- Active templates whose schedule has started by as_of and not yet run to
+-- its end date.
+SELECT * FROM journal_templates
+WHERE active
+  AND start_date <= sqlc.arg(as_of)::date
+  AND (end_date IS NULL OR last_occurrence IS NULL OR last_occurrence < end_date)
+ORDER BY start_date, id;
+
+-- name: CreateJournalTemplateLine :one
+INSERT INTO journal_template_lines (
+    template_id, line_no, account_id, side, amount, cost_center_id, description
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: ListJournalTemplateLines :many
+SELECT * FROM journal_template_lines WHERE template_id = $1 ORDER BY line_no;
+
+-- name: DeleteJournalTemplateLines :exec
+DELETE FROM journal_template_lines WHERE template_id = $1;
+
+-- name: ClaimJournalTemplateRun :execrows
+INSERT INTO journal_template_runs (template_id, occurrence_date)
+VALUES ($1, $2)
+ON CONFLICT (template_id, occurrence_date) DO NOTHING;
+
+-- name: SetJournalTemplateRunJournal :exec
+UPDATE journal_template_runs SET journal_id = $3
+WHERE template_id = $1 AND occurrence_date = $2;
+
+-- name: AdvanceJournalTemplate :exec
+UPDATE journal_templates
+SET last_occurrence = GREATEST(COALESCE(last_occurrence, sqlc.arg(occurrence_date)::date), sqlc.arg(occurrence_date)::date)
+WHERE id = sqlc.arg(id);
@@ -0,0 +1,92 @@
+-- =====================================================
+-- Parties
+-- =====================================================
+
+-- name: CreateParty :one
+INSERT INTO parties (
+    organization_id, name, kind, legal_name, pan, default_currency,
+    payment_terms_days, active, created_by, updated_by,
+    credit_limit, credit_hold, credit_hold_reason, peppol_endpoint
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9, $10, $11, $12, $13)
+RETURNING *;
+
+-- name: GetParty :one
+SELECT * FROM parties WHERE id = $1;
+
+-- name: UpdateParty :one
+UPDATE parties
+SET name = $2, kind = $3, legal_name = $4, pan = $5, default_currency = $6,
+    payment_terms_days = $7, active = $8, updated_by = $9,
+    credit_limit = $10, credit_hold = $11, credit_hold_reason = $12, peppol_endpoint = $13,
+    updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteParty :exec
+DELETE FROM parties WHERE id = $1;
+
+-- name: ListParties :many
+-- A kind of CUSTOMER or VENDOR also matches parties that are both. query
+-- matches the name, the legal name or any tax registration number.
+SELECT * FROM parties
+WHERE organization_id = sqlc.arg(organization_id)
+  AND (sqlc.arg(kind)::text = '' OR kind = sqlc.arg(kind)::text OR kind = 'BOTH')
+  AND (sqlc.arg(query)::text = ''
+       OR name ILIKE '%' || sqlc.arg(query)::text || '%'
+       OR legal_name ILIKE '%' || sqlc.arg(query)::text || '%'
+       OR id IN (SELECT party_id FROM party_tax_registrations
+                 WHERE number ILIKE '%' || sqlc.arg(query)::text || '%'))
+ORDER BY lower(name), id
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
+
+-- name: CreatePartyContact :one
+INSERT INTO party_contacts (party_id, line_no, name, email, phone, designation, is_primary)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: ListPartyContacts :many
+SELECT * FROM party_contacts WHERE party_id = $1 ORDER BY line_no;
+
+-- name: DeletePartyContacts :exec
+DELETE FROM party_contacts WHERE party_id = $1;
+
+-- name: CreatePartyAddress :one
+INSERT INTO party_addresses (
+    party_id, line_no, kind, line1, line2, city, state, state_code,
+    postal_code, country_code, is_default
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+
+-- name: ListPartyAddresses :many
+SELECT * FROM party_addresses WHERE party_id = $1 ORDER BY line_no;
+
+-- name: DeletePartyAddresses :exec
+DELETE FROM party_addresses WHERE party_id = $1;
+
+-- name: CreatePartyTaxRegistration :one
+INSERT INTO party_tax_registrations (party_id, line_no, tax_type, number, state_code)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListPartyTaxRegistrations :many
+SELECT * FROM party_tax_registrations WHERE party_id = $1 ORDER BY line_no;
+
+-- name: DeletePartyTaxRegistrations :exec
+DELETE FROM party_tax_registrations WHERE party_id = $1;
+
+-- name: CreatePartyBankAccount :one
+INSERT INTO party_bank_accounts (
+    party_id, line_no, account_name, account_number, ifsc, bank_name, branch, is_primary
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: ListPartyBankAccounts :many
+SELECT * FROM party_bank_accounts WHERE party_id = $1 ORDER BY line_no;
+
+-- name: DeletePartyBankAccounts :exec
+DELETE FROM party_bank_accounts WHERE party_id = $1;
+
+-- name: CountPartyDocuments :one
+-- Invoices and vendor bills that refer to the party.
+SELECT ((SELECT count(*) FROM invoices i WHERE i.party_id = $1)
+     + (SELECT count(*) FROM vendor_bill_approved_events v WHERE v.party_id = $1))::int AS documents;
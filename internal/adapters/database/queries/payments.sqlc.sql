@@ -4,9 +4,10 @@
 
 -- name: CreatePaymentDue :one
 INSERT INTO payment_dues (
-    invoice_id, amount_due, due_date, status, created_by, updated_by
+    invoice_id, amount_due, due_date, status, created_by, updated_by,
+    currency_code, exchange_rate, account_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING *;
 
 -- name: GetPaymentDue :one
@@ -19,6 +20,9 @@ SET invoice_id = $2,
     due_date = $4,
     status = $5,
     updated_by = $6,
+    currency_code = $7,
+    exchange_rate = $8,
+    account_id = $9,
     updated_at = now(),
     revision = revision + 1
 WHERE id = $1
@@ -47,9 +51,10 @@ RETURNING *;
 
 -- name: CreateBankAccount :one
 INSERT INTO bank_accounts (
-    name, account_number, ifsc_or_swift, ledger_account_id, created_by, updated_by
+    name, account_number, ifsc_or_swift, ledger_account_id, created_by, updated_by, currency_code,
+    organization_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7, $8
 ) RETURNING *;
 
 -- name: GetBankAccount :one
@@ -62,6 +67,7 @@ SET name = $2,
     ifsc_or_swift = $4,
     ledger_account_id = $5,
     updated_by = $6,
+    currency_code = $7,
     updated_at = now(),
     revision = revision + 1
 WHERE id = $1
@@ -0,0 +1,51 @@
+-- =====================================================
+-- Recurring Invoices
+-- =====================================================
+
+-- name: CreateRecurringInvoice :one
+INSERT INTO recurring_invoices (
+    organization_id, name, template_invoice_id, frequency, start_date, end_date,
+    billing_day, prorate, auto_issue, active, created_by, updated_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $11)
+RETURNING *;
+
+-- name: GetRecurringInvoice :one
+SELECT * FROM recurring_invoices WHERE id = $1;
+
+-- name: UpdateRecurringInvoice :one
+UPDATE recurring_invoices
+SET name = $2, template_invoice_id = $3, frequency = $4, start_date = $5,
+    end_date = $6, billing_day = $7, prorate = $8, auto_issue = $9,
+    active = $10, updated_by = $11, updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteRecurringInvoice :exec
+DELETE FROM recurring_invoices WHERE id = $1;
+
+-- name: ListRecurringInvoices :many
+SELECT * FROM recurring_invoices ORDER BY name, id LIMIT $1 OFFSET $2;
+
+-- name: ListDueRecurringInvoices :many
+-- Active recurring invoices whose next period has started by as_of and that
+-- have not yet billed through their end date.
+SELECT * FROM recurring_invoices
+WHERE active
+  AND start_date <= sqlc.arg(as_of)::date
+  AND (billed_through IS NULL
+       OR (billed_through < sqlc.arg(as_of)::date AND (end_date IS NULL OR billed_through < end_date)))
+ORDER BY start_date, id;
+
+-- name: ClaimRecurringInvoiceRun :execrows
+INSERT INTO recurring_invoice_runs (recurring_invoice_id, period_start, period_end)
+VALUES ($1, $2, $3)
+ON CONFLICT (recurring_invoice_id, period_start) DO NOTHING;
+
+-- name: SetRecurringInvoiceRunInvoice :exec
+UPDATE recurring_invoice_runs SET invoice_id = $3
+WHERE recurring_invoice_id = $1 AND period_start = $2;
+
+-- name: AdvanceRecurringInvoice :exec
+UPDATE recurring_invoices
+SET billed_through = GREATEST(COALESCE(billed_through, sqlc.arg(period_end)::date), sqlc.arg(period_end)::date)
+WHERE id = sqlc.arg(id);
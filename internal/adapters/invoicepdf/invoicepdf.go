@@ -0,0 +1,606 @@
+// Package invoicepdf renders the customer-facing PDF of an invoice: the
+// organization's letterhead, the invoice and transport details, items with
+// HSN codes, the tax breakup, totals with the amount in words, the e-invoice
+// IRN and QR code, and the bank details and terms.
+//
+// Rendering is deterministic: the document dates are the invoice's last
+// update, so the same revision of an invoice renders to the same bytes.
+package invoicepdf
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/shopspring/decimal"
+	"github.com/skip2/go-qrcode"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+// ContentType is the media type of rendered invoices.
+const ContentType = "application/pdf"
+
+// Page layout, in millimetres on A4 portrait.
+const (
+	margin     = 12.0
+	pageWidth  = 210.0
+	bodyWidth  = pageWidth - 2*margin
+	lineHeight = 4.5
+	logoWidth  = 35.0
+	logoHeight = 20.0
+	qrSize     = 32.0
+)
+
+// titles are the document titles of the invoice types.
+var titles = map[string]string{
+	"SALES":    "TAX INVOICE",
+	"PROFORMA": "PROFORMA INVOICE",
+	"CHALLAN":  "DELIVERY CHALLAN",
+	"PURCHASE": "PURCHASE INVOICE",
+}
+
+// itemColumns are the items table: heading, width and alignment.
+var itemColumns = []struct {
+	title string
+	width float64
+	align string
+}{
+	{"#", 8, "C"},
+	{"Item", 54, "L"},
+	{"HSN/SAC", 16, "C"},
+	{"Qty", 12, "R"},
+	{"Rate", 20, "R"},
+	{"Discount", 18, "R"},
+	{"Taxable", 20, "R"},
+	{"Tax", 18, "R"},
+	{"Amount", 20, "R"},
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// FileName is the name a rendered invoice is offered under.
+func FileName(inv db.Invoice) string {
+	name := strings.Trim(unsafeFileChars.ReplaceAllString(inv.InvoiceNumber, "-"), "-")
+	if name == "" {
+		name = "invoice-" + inv.ID.String()
+	}
+	return name + ".pdf"
+}
+
+// Render lays out doc as a PDF.
+func Render(doc ports.InvoiceDocument) ([]byte, error) {
+	inv, tmpl := doc.Invoice, doc.Template
+	r := &renderer{pdf: fpdf.New("P", "mm", "A4", ""), currency: inv.CurrencyCode}
+	r.tr = r.pdf.UnicodeTranslatorFromDescriptor("")
+
+	stamp := documentTime(inv)
+	r.pdf.SetCreationDate(stamp)
+	r.pdf.SetModificationDate(stamp)
+	r.pdf.SetCatalogSort(true)
+	r.pdf.SetTitle(r.tr(strings.TrimSpace(titleOf(inv)+" "+inv.InvoiceNumber)), false)
+	if tmpl.CompanyName != "" {
+		r.pdf.SetAuthor(r.tr(tmpl.CompanyName), false)
+	}
+	r.pdf.SetMargins(margin, margin, margin)
+	r.pdf.SetAutoPageBreak(true, 18)
+	r.pdf.AliasNbPages("")
+	r.pdf.SetFooterFunc(func() { r.footer(tmpl) })
+	r.pdf.AddPage()
+
+	r.letterhead(inv, tmpl)
+	r.details(inv)
+	if err := r.einvoice(inv); err != nil {
+		return nil, err
+	}
+	r.transport(inv)
+	r.items(inv)
+	r.taxBreakup(inv)
+	r.totals(inv, doc.AmountInWords)
+	r.closing(tmpl)
+
+	var out bytes.Buffer
+	if err := r.pdf.Output(&out); err != nil {
+		return nil, fmt.Errorf("render invoice %s: %w", inv.ID, err)
+	}
+	return out.Bytes(), nil
+}
+
+type renderer struct {
+	pdf      *fpdf.Fpdf
+	tr       func(string) string
+	currency string
+}
+
+func (r *renderer) font(style string, size float64) {
+	r.pdf.SetFont("Helvetica", style, size)
+}
+
+// text writes a cell of the given width, translating s to the PDF encoding.
+func (r *renderer) text(w float64, s, align string, ln int) {
+	r.pdf.CellFormat(w, lineHeight, r.tr(s), "", ln, align, false, 0, "")
+}
+
+// wrap breaks s into translated lines no wider than w, at spaces where it
+// can. fpdf's SplitText is not used as it only measures single-byte runes.
+func (r *renderer) wrap(s string, w float64) []string {
+	var out []string
+	for _, para := range strings.Split(r.tr(s), "\n") {
+		line := ""
+		for _, word := range strings.Fields(para) {
+			switch {
+			case line == "":
+				line = word
+			case r.pdf.GetStringWidth(line+" "+word) <= w:
+				line += " " + word
+				continue
+			default:
+				out = append(out, line)
+				line = word
+			}
+			for len(line) > 1 && r.pdf.GetStringWidth(line) > w {
+				cut := len(line) - 1
+				for cut > 1 && r.pdf.GetStringWidth(line[:cut]) > w {
+					cut--
+				}
+				out = append(out, line[:cut])
+				line = line[cut:]
+			}
+		}
+		out = append(out, line)
+	}
+	return out
+}
+
+// heading starts a section with a shaded title bar.
+func (r *renderer) heading(title string) {
+	r.pdf.Ln(2)
+	r.font("B", 9)
+	r.pdf.SetFillColor(235, 235, 235)
+	r.pdf.CellFormat(bodyWidth, 6, r.tr(title), "", 1, "L", true, 0, "")
+	r.font("", 8)
+}
+
+// fields writes label/value pairs in two columns, skipping empty values.
+func (r *renderer) fields(pairs [][2]string) {
+	var shown [][2]string
+	for _, p := range pairs {
+		if strings.TrimSpace(p[1]) != "" {
+			shown = append(shown, p)
+		}
+	}
+	half := bodyWidth / 2
+	for i, p := range shown {
+		r.font("B", 8)
+		r.text(30, p[0], "L", 0)
+		r.font("", 8)
+		if i%2 == 0 && i < len(shown)-1 {
+			r.text(half-30, p[1], "L", 0)
+		} else {
+			r.text(half-30, p[1], "L", 1)
+		}
+	}
+}
+
+func (r *renderer) letterhead(inv db.Invoice, tmpl db.InvoiceTemplate) {
+	top := r.pdf.GetY()
+	textX := margin
+	if len(tmpl.Logo) > 0 {
+		imageType := "PNG"
+		if http.DetectContentType(tmpl.Logo) == "image/jpeg" {
+			imageType = "JPG"
+		}
+		opts := fpdf.ImageOptions{ImageType: imageType}
+		if info := r.pdf.RegisterImageOptionsReader("logo", opts, bytes.NewReader(tmpl.Logo)); info != nil {
+			w, h := fitBox(info.Width(), info.Height(), logoWidth, logoHeight)
+			r.pdf.ImageOptions("logo", margin, top, w, h, false, opts, 0, "")
+			textX = margin + w + 4
+		}
+	}
+
+	r.pdf.SetXY(textX, top)
+	r.font("B", 14)
+	r.pdf.CellFormat(110, 7, r.tr(tmpl.CompanyName), "", 2, "L", false, 0, "")
+	r.font("", 8)
+	for _, l := range lines(tmpl.Address.String) {
+		r.pdf.CellFormat(110, 4, r.tr(l), "", 2, "L", false, 0, "")
+	}
+	if inv.Gst != nil && inv.Gst.Gstin != "" {
+		r.pdf.CellFormat(110, 4, "GSTIN: "+r.tr(inv.Gst.Gstin), "", 2, "L", false, 0, "")
+	}
+	bottom := max(r.pdf.GetY(), top+logoHeight)
+
+	r.pdf.SetXY(pageWidth-margin-70, top)
+	r.font("B", 14)
+	r.pdf.CellFormat(70, 7, r.tr(titleOf(inv)), "", 2, "R", false, 0, "")
+	if mark := statusMark(inv.Status); mark != "" {
+		r.pdf.SetTextColor(200, 0, 0)
+		r.font("B", 11)
+		r.pdf.CellFormat(70, 6, mark, "", 2, "R", false, 0, "")
+		r.pdf.SetTextColor(0, 0, 0)
+	}
+
+	r.pdf.SetXY(margin, bottom+2)
+	r.pdf.Line(margin, r.pdf.GetY(), pageWidth-margin, r.pdf.GetY())
+	r.pdf.Ln(2)
+}
+
+func (r *renderer) details(inv db.Invoice) {
+	pairs := [][2]string{
+		{"Invoice No.", inv.InvoiceNumber},
+		{"Invoice Date", formatDate(inv.InvoiceDate)},
+		{"Due Date", formatNullDate(inv.DueDate)},
+		{"PO Number", inv.PoNumber.String},
+		{"Payment Ref.", inv.PaymentReference.String},
+		{"Currency", inv.CurrencyCode},
+	}
+	if inv.Gst != nil {
+		reverse := "No"
+		if inv.Gst.ReverseCharge.Bool {
+			reverse = "Yes"
+		}
+		pairs = append(pairs, [2]string{"Place of Supply", inv.Gst.PlaceOfSupply}, [2]string{"Reverse Charge", reverse})
+	}
+	if inv.AgainstInvoiceNumber.Valid {
+		against := inv.AgainstInvoiceNumber.String
+		if inv.AgainstInvoiceDate.Valid {
+			against += " dated " + formatDate(inv.AgainstInvoiceDate.Time)
+		}
+		pairs = append(pairs, [2]string{"Against Invoice", against})
+	}
+	r.fields(pairs)
+}
+
+// einvoice prints the IRN and acknowledgement, with the signed QR code
+// beside them, when the invoice has been registered.
+func (r *renderer) einvoice(inv db.Invoice) error {
+	d := inv.GstDocs
+	if d == nil || (!d.Irn.Valid && !d.SignedQrCode.Valid) {
+		return nil
+	}
+	r.heading("E-Invoice")
+	top := r.pdf.GetY()
+	textWidth := bodyWidth
+	if d.SignedQrCode.String != "" {
+		png, err := qrcode.Encode(d.SignedQrCode.String, qrcode.Medium, 256)
+		if err != nil {
+			return fmt.Errorf("render invoice %s: e-invoice QR code: %w", inv.ID, err)
+		}
+		opts := fpdf.ImageOptions{ImageType: "PNG"}
+		r.pdf.RegisterImageOptionsReader("einvoice-qr", opts, bytes.NewReader(png))
+		r.pdf.ImageOptions("einvoice-qr", pageWidth-margin-qrSize, top, qrSize, qrSize, false, opts, 0, "")
+		textWidth -= qrSize + 4
+	}
+	for _, p := range [][2]string{
+		{"IRN", d.Irn.String},
+		{"Ack No.", d.AckNo.String},
+		{"Ack Date", formatNullDate(d.AckDate)},
+	} {
+		if p[1] == "" {
+			continue
+		}
+		r.font("B", 8)
+		r.text(30, p[0], "L", 0)
+		r.font("", 8)
+		r.pdf.MultiCell(textWidth-30, lineHeight, r.tr(p[1]), "", "L", false)
+	}
+	if d.SignedQrCode.String != "" {
+		r.pdf.SetY(max(r.pdf.GetY(), top+qrSize+1))
+	}
+	return nil
+}
+
+func (r *renderer) transport(inv db.Invoice) {
+	eway := inv.EwayNumberLegacy.String
+	if inv.GstDocs != nil && inv.GstDocs.EwayBillNo.Valid {
+		eway = inv.GstDocs.EwayBillNo.String
+	}
+	pairs := [][2]string{
+		{"Challan No.", inv.ChallanNumber.String},
+		{"Challan Date", formatNullDate(inv.ChallanDate)},
+		{"Delivery Date", formatNullDate(inv.DeliveryDate)},
+		{"E-Way Bill No.", eway},
+		{"LR No.", inv.LrNumber.String},
+		{"Vehicle No.", inv.VehicleNumber.String},
+		{"Transporter", inv.TransporterName.String},
+		{"Transporter ID", inv.TransporterID.String},
+	}
+	for _, p := range pairs {
+		if p[1] != "" {
+			r.heading("Dispatch")
+			r.fields(pairs)
+			return
+		}
+	}
+}
+
+func (r *renderer) itemHeader() {
+	r.font("B", 8)
+	r.pdf.SetFillColor(235, 235, 235)
+	for _, c := range itemColumns {
+		r.pdf.CellFormat(c.width, 6, c.title, "1", 0, "C", true, 0, "")
+	}
+	r.pdf.Ln(-1)
+	r.font("", 8)
+}
+
+func (r *renderer) items(inv db.Invoice) {
+	r.pdf.Ln(3)
+	r.itemHeader()
+	_, pageHeight := r.pdf.GetPageSize()
+	_, _, _, bottom := r.pdf.GetMargins()
+	for i, item := range inv.Items {
+		text := item.Name
+		if item.Description.String != "" {
+			text += "\n" + item.Description.String
+		}
+		wrapped := r.wrap(text, itemColumns[1].width-2)
+		h := float64(max(len(wrapped), 1)) * lineHeight
+		if r.pdf.GetY()+h > pageHeight-bottom {
+			r.pdf.AddPage()
+			r.itemHeader()
+		}
+
+		discount, tax := decimal.Zero, decimal.Zero
+		for _, d := range item.Discounts {
+			discount = discount.Add(amount(d.Amount))
+		}
+		for _, t := range item.Taxes {
+			tax = tax.Add(amount(t.Amount))
+		}
+		taxable := amount(item.LineSubtotal).Sub(discount)
+		cells := []string{
+			fmt.Sprint(i + 1),
+			"",
+			item.Hsn.String,
+			fmt.Sprint(item.Quantity),
+			r.money(amount(item.UnitPrice)),
+			r.money(discount),
+			r.money(taxable),
+			r.money(tax),
+			r.money(amount(item.LineTotal)),
+		}
+		x, y := r.pdf.GetX(), r.pdf.GetY()
+		for j, c := range itemColumns {
+			if j == 1 {
+				for k, l := range wrapped {
+					r.pdf.SetXY(x+1, y+float64(k)*lineHeight)
+					r.pdf.CellFormat(c.width-2, lineHeight, l, "", 0, "L", false, 0, "")
+				}
+				r.pdf.Rect(x, y, c.width, h, "D")
+			} else {
+				r.pdf.SetXY(x, y)
+				r.pdf.CellFormat(c.width, h, r.tr(cells[j]), "1", 0, c.align, false, 0, "")
+			}
+			x += c.width
+		}
+		r.pdf.SetXY(margin, y+h)
+	}
+}
+
+// taxBreakup totals the item taxes by tax and rate, with the taxable value
+// they were charged on, followed by taxes charged on the whole invoice.
+func (r *renderer) taxBreakup(inv db.Invoice) {
+	type key struct{ name, rate string }
+	type sum struct{ taxable, tax decimal.Decimal }
+	sums := map[key]*sum{}
+	for _, item := range inv.Items {
+		taxable := amount(item.LineSubtotal)
+		for _, d := range item.Discounts {
+			taxable = taxable.Sub(amount(d.Amount))
+		}
+		for _, t := range item.Taxes {
+			k := key{strings.ToUpper(t.Name), amount(t.Rate).String()}
+			if sums[k] == nil {
+				sums[k] = &sum{}
+			}
+			sums[k].taxable = sums[k].taxable.Add(taxable)
+			sums[k].tax = sums[k].tax.Add(amount(t.Amount))
+		}
+	}
+	if len(sums) == 0 && len(inv.Taxes) == 0 {
+		return
+	}
+	keys := make([]key, 0, len(sums))
+	for k := range sums {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		return amount(keys[i].rate).LessThan(amount(keys[j].rate))
+	})
+
+	r.heading("Tax Breakup")
+	widths := []float64{50, 30, 50, 56}
+	r.font("B", 8)
+	for i, t := range []string{"Tax", "Rate", "Taxable Value", "Tax Amount"} {
+		r.pdf.CellFormat(widths[i], 5, t, "B", 0, "L", false, 0, "")
+	}
+	r.pdf.Ln(-1)
+	r.font("", 8)
+	row := func(name, rate, taxable string, tax decimal.Decimal) {
+		r.text(widths[0], name, "L", 0)
+		r.text(widths[1], rate, "L", 0)
+		r.text(widths[2], taxable, "L", 0)
+		r.text(widths[3], r.money(tax), "L", 1)
+	}
+	for _, k := range keys {
+		row(k.name, k.rate+"%", r.money(sums[k].taxable), sums[k].tax)
+	}
+	for _, t := range inv.Taxes {
+		rate := ""
+		if t.Rate != "" {
+			rate = amount(t.Rate).String() + "%"
+		}
+		row(strings.ToUpper(t.Name), rate, "On invoice", amount(t.Amount))
+	}
+}
+
+func (r *renderer) totals(inv db.Invoice, words string) {
+	r.pdf.Ln(3)
+	labelX := pageWidth - margin - 80
+	for _, t := range []struct {
+		label string
+		value string
+		skip  bool
+	}{
+		{"Subtotal", inv.Subtotal, false},
+		{"Discount", inv.DiscountTotal, amount(inv.DiscountTotal).IsZero()},
+		{"Tax", inv.TaxTotal, false},
+		{"Round-off", inv.RoundOff, amount(inv.RoundOff).IsZero()},
+	} {
+		if t.skip {
+			continue
+		}
+		r.pdf.SetX(labelX)
+		r.text(45, t.label, "L", 0)
+		r.text(35, r.money(amount(t.value)), "R", 1)
+	}
+	r.pdf.SetX(labelX)
+	r.font("B", 10)
+	r.pdf.CellFormat(45, 7, "Grand Total ("+r.tr(inv.CurrencyCode)+")", "T", 0, "L", false, 0, "")
+	r.pdf.CellFormat(35, 7, r.money(amount(inv.GrandTotal)), "T", 1, "R", false, 0, "")
+
+	if words != "" {
+		r.pdf.Ln(2)
+		r.font("B", 8)
+		r.text(30, "Amount in words", "L", 0)
+		r.font("I", 8)
+		r.pdf.MultiCell(bodyWidth-30, lineHeight, r.tr(words), "", "L", false)
+	}
+}
+
+// closing prints the bank details and terms, and the signature block.
+func (r *renderer) closing(tmpl db.InvoiceTemplate) {
+	for _, s := range []struct{ title, body string }{
+		{"Bank Details", tmpl.BankDetails.String},
+		{"Terms & Conditions", tmpl.Terms.String},
+	} {
+		if strings.TrimSpace(s.body) == "" {
+			continue
+		}
+		r.heading(s.title)
+		for _, l := range lines(s.body) {
+			r.pdf.MultiCell(bodyWidth, lineHeight, r.tr(l), "", "L", false)
+		}
+	}
+	if tmpl.CompanyName != "" {
+		r.pdf.Ln(8)
+		r.font("B", 8)
+		r.text(bodyWidth, "For "+tmpl.CompanyName, "R", 1)
+		r.pdf.Ln(10)
+		r.font("", 8)
+		r.text(bodyWidth, "Authorised Signatory", "R", 1)
+	}
+}
+
+func (r *renderer) footer(tmpl db.InvoiceTemplate) {
+	r.pdf.SetY(-15)
+	r.font("", 7)
+	r.pdf.SetTextColor(100, 100, 100)
+	if tmpl.Footer.String != "" {
+		r.text(bodyWidth, strings.ReplaceAll(tmpl.Footer.String, "\n", " "), "C", 1)
+	}
+	r.text(bodyWidth, fmt.Sprintf("Page %d of {nb}", r.pdf.PageNo()), "C", 1)
+	r.pdf.SetTextColor(0, 0, 0)
+}
+
+// money formats d at two decimals, grouping INR amounts the Indian way
+// (1,18,000.00) and others in thousands (118,000.00).
+func (r *renderer) money(d decimal.Decimal) string {
+	s := d.Abs().StringFixed(2)
+	whole, frac, _ := strings.Cut(s, ".")
+	var groups []string
+	if len(whole) > 3 {
+		groups = append(groups, whole[len(whole)-3:])
+		whole = whole[:len(whole)-3]
+		size := 3
+		if strings.EqualFold(r.currency, "INR") {
+			size = 2
+		}
+		for len(whole) > size {
+			groups = append([]string{whole[len(whole)-size:]}, groups...)
+			whole = whole[:len(whole)-size]
+		}
+	}
+	groups = append([]string{whole}, groups...)
+	out := strings.Join(groups, ",") + "." + frac
+	if d.IsNegative() {
+		out = "-" + out
+	}
+	return out
+}
+
+func titleOf(inv db.Invoice) string {
+	if t, ok := titles[strings.ToUpper(inv.Type)]; ok {
+		return t
+	}
+	return "INVOICE"
+}
+
+// statusMark flags copies that are not a live invoice.
+func statusMark(status string) string {
+	switch status {
+	case "DRAFT", "VOID":
+		return status
+	}
+	return ""
+}
+
+// documentTime dates the PDF by the invoice's last change, so re-rendering
+// a revision gives the same bytes.
+func documentTime(inv db.Invoice) time.Time {
+	for _, t := range []sql.NullTime{inv.UpdatedAt, inv.CreatedAt} {
+		if t.Valid {
+			return t.Time.UTC()
+		}
+	}
+	return inv.InvoiceDate.UTC()
+}
+
+func amount(s string) decimal.Decimal {
+	d, err := decimal.NewFromString(strings.TrimSpace(s))
+	if err != nil {
+		return decimal.Zero
+	}
+	return d
+}
+
+func formatDate(t time.Time) string {
+	return t.Format("02-Jan-2006")
+}
+
+func formatNullDate(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return formatDate(t.Time)
+}
+
+func lines(s string) []string {
+	var out []string
+	for _, l := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		if l = strings.TrimSpace(l); l != "" {
+			out = append(out, l)
+		}
+	}
+	return out
+}
+
+// fitBox scales w×h to fit within maxW×maxH, keeping the aspect ratio.
+func fitBox(w, h, maxW, maxH float64) (float64, float64) {
+	if w <= 0 || h <= 0 {
+		return maxW, maxH
+	}
+	scale := min(maxW/w, maxH/h)
+	return w * scale, h * scale
+}
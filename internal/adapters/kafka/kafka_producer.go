@@ -84,7 +84,13 @@ const (
 	EventInvoiceItemCreated    = "invoice.item.created"
 	EventInvoiceTaxAdded       = "invoice.tax.added"
 	EventInvoiceDiscountAdded  = "invoice.discount.added"
+	EventInvoiceIssued         = "invoice.issued"
+	EventInvoiceVoided         = "invoice.voided"
+	EventInvoiceOverdue        = "invoice.overdue"
+	EventInvoiceStatusChanged  = "invoice.status.changed"
 	EventFinanceInvoiceCreated = "finance.invoice.created"
+	EventDunningReminder       = "dunning.reminder"
+	EventCreditLimitBreached   = "credit.limit.breached"
 
 	// Expenses
 	EventExpenseCreated = "expense.created"
@@ -534,6 +540,28 @@ func (p *KafkaPublisher) PublishInvoiceDiscountAdded(ctx context.Context, disc *
 	return p.publish(ctx, TopicInvoices, EventInvoiceDiscountAdded, disc)
 }
 
+// PublishInvoiceStatusChanged names the event after the status reached.
+func (p *KafkaPublisher) PublishInvoiceStatusChanged(ctx context.Context, ev *ports.InvoiceStatusChangedEvent) error {
+	event := EventInvoiceStatusChanged
+	switch ev.To {
+	case "ISSUED":
+		event = EventInvoiceIssued
+	case "VOID":
+		event = EventInvoiceVoided
+	case "OVERDUE":
+		event = EventInvoiceOverdue
+	}
+	return p.publish(ctx, TopicInvoices, event, ev)
+}
+
+func (p *KafkaPublisher) PublishDunningReminder(ctx context.Context, ev *ports.DunningReminderEvent) error {
+	return p.publish(ctx, TopicInvoices, EventDunningReminder, ev)
+}
+
+func (p *KafkaPublisher) PublishCreditLimitBreached(ctx context.Context, ev *ports.CreditLimitBreachedEvent) error {
+	return p.publish(ctx, TopicInvoices, EventCreditLimitBreached, ev)
+}
+
 func (p *KafkaPublisher) PublishBankTransactionImported(ctx context.Context, tx *db.BankAccount) error {
 	return p.publish(ctx, TopicBankTransactions, EventBankTransactionImported, tx)
 }
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
@@ -29,8 +31,11 @@ func (r *AccountSQLCRepository) Create(ctx context.Context, a *db.Account) (*db.
 		Code:               a.Code,
 		Name:               a.Name,
 		Type:               a.Type,
+		ParentID:           a.ParentID,
 		Status:             a.Status,
 		AllowManualJournal: a.AllowManualJournal,
+		CreatedBy:          a.CreatedBy,
+		UpdatedBy:          a.CreatedBy,
 	}
 	acc, err := r.queries.CreateAccount(ctx, params)
 	if err != nil {
@@ -41,6 +46,7 @@ func (r *AccountSQLCRepository) Create(ctx context.Context, a *db.Account) (*db.
 		Code:               acc.Code,
 		Name:               acc.Name,
 		Type:               acc.Type,
+		ParentID:           acc.ParentID,
 		Status:             acc.Status,
 		AllowManualJournal: acc.AllowManualJournal,
 		CreatedAt:          acc.CreatedAt,
@@ -59,6 +65,7 @@ func (r *AccountSQLCRepository) Get(ctx context.Context, id uuid.UUID) (*db.Acco
 		Code:               acc.Code,
 		Name:               acc.Name,
 		Type:               acc.Type,
+		ParentID:           acc.ParentID,
 		Status:             acc.Status,
 		AllowManualJournal: acc.AllowManualJournal,
 		CreatedAt:          acc.CreatedAt,
@@ -72,8 +79,10 @@ func (r *AccountSQLCRepository) Update(ctx context.Context, a *db.Account) (*db.
 		Code:               a.Code,
 		Name:               a.Name,
 		Type:               a.Type,
+		ParentID:           a.ParentID,
 		Status:             a.Status,
 		AllowManualJournal: a.AllowManualJournal,
+		UpdatedBy:          a.UpdatedBy,
 	}
 	dbAcc, err := r.queries.UpdateAccount(ctx, arg)
 	if err != nil {
@@ -84,6 +93,7 @@ func (r *AccountSQLCRepository) Update(ctx context.Context, a *db.Account) (*db.
 		Code:               dbAcc.Code,
 		Name:               dbAcc.Name,
 		Type:               dbAcc.Type,
+		ParentID:           dbAcc.ParentID,
 		Status:             dbAcc.Status,
 		AllowManualJournal: dbAcc.AllowManualJournal,
 		CreatedAt:          dbAcc.CreatedAt,
@@ -107,6 +117,22 @@ func (r *AccountSQLCRepository) List(ctx context.Context, limit, offset int32) (
 	return accounts, nil
 }
 
+func (r *AccountSQLCRepository) ListAll(ctx context.Context) ([]*db.Account, error) {
+	dbAccs, err := r.queries.ListAllAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*db.Account, len(dbAccs))
+	for i := range dbAccs {
+		accounts[i] = &dbAccs[i]
+	}
+	return accounts, nil
+}
+
+func (r *AccountSQLCRepository) Usage(ctx context.Context, id uuid.UUID) (db.GetAccountUsageRow, error) {
+	return r.queries.GetAccountUsage(ctx, id)
+}
+
 // ---------------- Journal Repository ----------------
 
 type JournalSQLCRepository struct {
@@ -133,16 +159,50 @@ func (r *JournalSQLCRepository) Create(ctx context.Context, journal *db.JournalE
 	defer tx.Rollback()
 
 	qtx := r.queries.WithTx(tx)
+	created, err := insertJournal(ctx, qtx, journal)
+	if err != nil {
+		return nil, err
+	}
+	if created, err = autoReverseJournal(ctx, qtx, created, journal.CreatedBy); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// insertJournal writes the entry and its lines, and projects them to the
+// ledger when the entry is created already POSTED.
+func insertJournal(ctx context.Context, qtx *db.Queries, journal *db.JournalEntry) (*db.JournalEntry, error) {
+	status := journal.Status
+	if status == "" {
+		status = "DRAFT"
+	}
+	var postedAt sql.NullTime
+	var postedBy sql.NullString
+	if status == "POSTED" {
+		postedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		postedBy = journal.CreatedBy
+	}
 
 	// Insert entry
 	created, err := qtx.CreateJournalEntry(ctx, db.CreateJournalEntryParams{
-		JournalDate: journal.JournalDate,
-		Reference:   journal.Reference,
-		Memo:        journal.Memo,
-		SourceType:  journal.SourceType,
-		SourceID:    journal.SourceID,
-		CreatedBy:   journal.CreatedBy,
-		UpdatedBy:   journal.UpdatedBy,
+		JournalDate:     journal.JournalDate,
+		Reference:       journal.Reference,
+		Memo:            journal.Memo,
+		SourceType:      journal.SourceType,
+		SourceID:        journal.SourceID,
+		CreatedBy:       journal.CreatedBy,
+		UpdatedBy:       journal.UpdatedBy,
+		Status:          status,
+		PostedAt:        postedAt,
+		PostedBy:        postedBy,
+		ReversalOf:      journal.ReversalOf,
+		OrganizationID:  journal.OrganizationID,
+		AutoReverseDate: journal.AutoReverseDate,
 	})
 	if err != nil {
 		return nil, err
@@ -153,24 +213,83 @@ func (r *JournalSQLCRepository) Create(ctx context.Context, journal *db.JournalE
 	}
 
 	// Insert lines
-	for _, l := range journal.Lines {
-		_, err := qtx.CreateJournalLine(ctx, db.CreateJournalLineParams{
-			JournalID:   created.ID,
-			AccountID:   l.AccountID,
-			Side:        l.Side,
-			Amount:      l.Amount,
-			Description: l.Description,
+	if created.Lines, err = insertJournalLines(ctx, qtx, created.ID, journal.Lines); err != nil {
+		return nil, err
+	}
+
+	if created.Status == "POSTED" {
+		if err := postToLedger(ctx, qtx, created.ID); err != nil {
+			return nil, err
+		}
+	}
+	return &created, nil
+}
+
+// postToLedger projects a posted journal into ledger_entries and rolls its
+// lines into the per-period account balances.
+func postToLedger(ctx context.Context, qtx *db.Queries, journalID uuid.UUID) error {
+	if err := lockPostingPeriods(ctx, qtx, []uuid.UUID{journalID}); err != nil {
+		return err
+	}
+	if err := qtx.PostJournalToLedger(ctx, journalID); err != nil {
+		return err
+	}
+	return qtx.AccumulateAccountPeriodBalances(ctx, journalID)
+}
+
+// lockPostingPeriods share-locks the fiscal periods the journals are posted
+// into until the transaction ends, and rejects a posting into a period a
+// year-end close hard-closed while it waited. Only the close's own entry is
+// posted into the CLOSED periods of a year it has not yet closed.
+func lockPostingPeriods(ctx context.Context, qtx *db.Queries, journalIDs []uuid.UUID) error {
+	periods, err := qtx.LockJournalPeriods(ctx, journalIDs)
+	if err != nil {
+		return err
+	}
+	for _, p := range periods {
+		if p.Status != "CLOSED" || (p.SourceType.String == "YEAR_END_CLOSE" && !p.YearClosedAt.Valid) {
+			continue
+		}
+		return fmt.Errorf("%w: FY%d period %d is closed", ports.ErrPeriodClosed, p.FiscalYear, p.PeriodNumber)
+	}
+	return nil
+}
+
+func insertJournalLines(ctx context.Context, qtx *db.Queries, journalID uuid.UUID, lines []db.JournalLine) ([]db.JournalLine, error) {
+	inserted := make([]db.JournalLine, 0, len(lines))
+	for _, l := range lines {
+		transactionAmount, rate := lineTransaction(l)
+		line, err := qtx.CreateJournalLine(ctx, db.CreateJournalLineParams{
+			JournalID:          journalID,
+			AccountID:          l.AccountID,
+			Side:               l.Side,
+			Amount:             l.Amount,
+			CostCenterID:       l.CostCenterID,
+			Description:        l.Description,
+			CurrencyCode:       l.CurrencyCode,
+			TransactionAmount:  transactionAmount,
+			ExchangeRate:       rate,
+			FunctionalCurrency: l.FunctionalCurrency,
 		})
 		if err != nil {
 			return nil, err
 		}
+		inserted = append(inserted, line)
 	}
+	return inserted, nil
+}
 
-	if err := tx.Commit(); err != nil {
-		return nil, err
+// lineTransaction returns the line's transaction amount and rate, treating a
+// line without them as transacted in the functional currency.
+func lineTransaction(l db.JournalLine) (amount, rate string) {
+	amount, rate = l.TransactionAmount, l.ExchangeRate
+	if amount == "" {
+		amount = l.Amount
 	}
-
-	return &created, nil
+	if rate == "" {
+		rate = "1"
+	}
+	return amount, rate
 }
 
 func (r *JournalSQLCRepository) Get(ctx context.Context, id uuid.UUID) (*db.JournalEntry, error) {
@@ -186,6 +305,9 @@ func (r *JournalSQLCRepository) Get(ctx context.Context, id uuid.UUID) (*db.Jour
 	return &entry, nil
 }
 
+// Update rewrites a DRAFT entry and its lines. Setting Status to POSTED posts
+// the entry, projects it to the ledger and posts its auto-reversal in the same
+// transaction.
 func (r *JournalSQLCRepository) Update(ctx context.Context, journal *db.JournalEntry) (*db.JournalEntry, error) {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -195,51 +317,236 @@ func (r *JournalSQLCRepository) Update(ctx context.Context, journal *db.JournalE
 
 	qtx := r.queries.WithTx(tx)
 
-	// Update journal entry
+	// Update journal entry; only drafts match
 	updated, err := qtx.UpdateJournalEntry(ctx, db.UpdateJournalEntryParams{
-		ID:          journal.ID, 
-		JournalDate: journal.JournalDate,
-		Reference:   journal.Reference,
-		Memo:        journal.Memo,
-		SourceType:  journal.SourceType,
-		SourceID:    journal.SourceID,
-		UpdatedBy:   journal.UpdatedBy,// ✅ important!
+		ID:              journal.ID,
+		JournalDate:     journal.JournalDate,
+		Reference:       journal.Reference,
+		Memo:            journal.Memo,
+		SourceType:      journal.SourceType,
+		SourceID:        journal.SourceID,
+		UpdatedBy:       journal.UpdatedBy,
+		AutoReverseDate: journal.AutoReverseDate,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	// ✅ Correct journal.ID passed here
 	if err := qtx.DeleteJournalLinesByEntry(ctx, journal.ID); err != nil {
 		return nil, err
 	}
 
 	// Re-insert lines
-	for _, l := range journal.Lines {
-		_, err := qtx.CreateJournalLine(ctx, db.CreateJournalLineParams{
-			JournalID:   journal.ID,
-			AccountID:   l.AccountID,
-			Side:        l.Side,
-			Amount:      l.Amount,
-			Description: l.Description,
-		})
-		if err != nil {
+	lines, err := insertJournalLines(ctx, qtx, journal.ID, journal.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	if journal.Status == "POSTED" {
+		if updated, err = qtx.PostJournalEntry(ctx, db.PostJournalEntryParams{
+			ID:       journal.ID,
+			PostedBy: journal.UpdatedBy,
+		}); err != nil {
+			return nil, err
+		}
+		if err := postToLedger(ctx, qtx, journal.ID); err != nil {
 			return nil, err
 		}
 	}
+	updated.Lines = lines
+	reversed, err := autoReverseJournal(ctx, qtx, &updated, journal.UpdatedBy)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
-	return &updated, nil
+	return reversed, nil
 }
 
+// Delete removes a DRAFT entry. It returns sql.ErrNoRows when no draft with
+// that id exists.
 func (r *JournalSQLCRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.queries.DeleteJournalEntry(ctx, id)
+	n, err := r.queries.DeleteJournalEntry(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// Reverse inserts the POSTED reversal entry, projects it to the ledger and
+// marks the original REVERSED, all in one transaction.
+func (r *JournalSQLCRepository) Reverse(ctx context.Context, originalID uuid.UUID, reversal *db.JournalEntry) (*db.JournalEntry, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	created, err := reverseJournal(ctx, r.queries.WithTx(tx), originalID, reversal)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// reverseJournal inserts the POSTED reversal entry, projects it to the ledger
+// and marks the original REVERSED. It returns sql.ErrNoRows when the original
+// is no longer POSTED.
+func reverseJournal(ctx context.Context, qtx *db.Queries, originalID uuid.UUID, reversal *db.JournalEntry) (*db.JournalEntry, error) {
+	reversal.Status = "POSTED"
+	reversal.ReversalOf = uuid.NullUUID{UUID: originalID, Valid: true}
+	created, err := insertJournal(ctx, qtx, reversal)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := qtx.MarkJournalReversed(ctx, db.MarkJournalReversedParams{
+		ID:         originalID,
+		ReversedBy: uuid.NullUUID{UUID: created.ID, Valid: true},
+		UpdatedBy:  reversal.CreatedBy,
+	}); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// autoReverseJournal posts the mirror image of a POSTED entry with an
+// auto-reverse date on that date and returns the entry as REVERSED. Other
+// entries are returned as they are.
+func autoReverseJournal(ctx context.Context, qtx *db.Queries, j *db.JournalEntry, by sql.NullString) (*db.JournalEntry, error) {
+	if j.Status != "POSTED" || !j.AutoReverseDate.Valid {
+		return j, nil
+	}
+	reversal := &db.JournalEntry{
+		JournalDate:    j.AutoReverseDate.Time,
+		Reference:      j.Reference,
+		Memo:           sql.NullString{String: "Auto-reversal of journal " + j.ID.String(), Valid: true},
+		SourceType:     j.SourceType,
+		SourceID:       j.SourceID,
+		OrganizationID: j.OrganizationID,
+		CreatedBy:      by,
+		UpdatedBy:      by,
+		Lines:          make([]db.JournalLine, len(j.Lines)),
+	}
+	for i, l := range j.Lines {
+		if l.Side == "DEBIT" {
+			l.Side = "CREDIT"
+		} else {
+			l.Side = "DEBIT"
+		}
+		reversal.Lines[i] = l
+	}
+	created, err := reverseJournal(ctx, qtx, j.ID, reversal)
+	if err != nil {
+		return nil, err
+	}
+	reversed := *j
+	reversed.Status = "REVERSED"
+	reversed.ReversedBy = uuid.NullUUID{UUID: created.ID, Valid: true}
+	return &reversed, nil
+}
+
+// BulkCreate inserts all entries with one statement and all lines with
+// another, then projects the POSTED ones to the ledger, in one transaction.
+func (r *JournalSQLCRepository) BulkCreate(ctx context.Context, journals []*db.JournalEntry) error {
+	if len(journals) == 0 {
+		return nil
+	}
+	var entries db.BulkInsertJournalEntriesParams
+	var lines db.BulkInsertJournalLinesParams
+	var posted []uuid.UUID
+	for _, j := range journals {
+		if j.ID == uuid.Nil {
+			j.ID = uuid.New()
+		}
+		if j.Status == "" {
+			j.Status = "DRAFT"
+		}
+		if j.Status == "POSTED" {
+			posted = append(posted, j.ID)
+		}
+		entries.Ids = append(entries.Ids, j.ID)
+		entries.JournalDates = append(entries.JournalDates, j.JournalDate)
+		entries.Refs = append(entries.Refs, j.Reference.String)
+		entries.Memos = append(entries.Memos, j.Memo.String)
+		entries.SourceTypes = append(entries.SourceTypes, j.SourceType.String)
+		entries.Statuses = append(entries.Statuses, j.Status)
+		entries.CreatedBys = append(entries.CreatedBys, j.CreatedBy.String)
+		entries.OrganizationIds = append(entries.OrganizationIds, j.OrganizationID.String)
+
+		for i := range j.Lines {
+			l := &j.Lines[i]
+			l.JournalID = j.ID
+			costCenter := ""
+			if l.CostCenterID.Valid {
+				costCenter = l.CostCenterID.UUID.String()
+			}
+			lines.JournalIds = append(lines.JournalIds, j.ID)
+			lines.AccountIds = append(lines.AccountIds, l.AccountID)
+			lines.Sides = append(lines.Sides, l.Side)
+			lines.Amounts = append(lines.Amounts, l.Amount)
+			lines.CostCenterIds = append(lines.CostCenterIds, costCenter)
+			lines.Descriptions = append(lines.Descriptions, l.Description.String)
+			transactionAmount, rate := lineTransaction(*l)
+			lines.CurrencyCodes = append(lines.CurrencyCodes, l.CurrencyCode)
+			lines.TransactionAmounts = append(lines.TransactionAmounts, transactionAmount)
+			lines.ExchangeRates = append(lines.ExchangeRates, rate)
+			lines.FunctionalCurrencies = append(lines.FunctionalCurrencies, l.FunctionalCurrency)
+		}
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := r.queries.WithTx(tx)
+
+	if err := qtx.BulkInsertJournalEntries(ctx, entries); err != nil {
+		return err
+	}
+	if err := qtx.BulkInsertJournalLines(ctx, lines); err != nil {
+		return err
+	}
+	if len(posted) > 0 {
+		if err := lockPostingPeriods(ctx, qtx, posted); err != nil {
+			return err
+		}
+		if err := qtx.PostJournalsToLedger(ctx, posted); err != nil {
+			return err
+		}
+		if err := qtx.AccumulateAccountPeriodBalancesForJournals(ctx, posted); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
 }
 
-func (r *JournalSQLCRepository) List(ctx context.Context, limit, offset int32) ([]*db.JournalEntry, error) {
-	dbJEs, err := r.queries.ListJournalEntries(ctx, db.ListJournalEntriesParams{Limit: limit, Offset: offset})
+func (r *JournalSQLCRepository) List(ctx context.Context, f ports.EntryFilter, limit, offset int32) ([]*db.JournalEntry, error) {
+	dbJEs, err := r.queries.ListJournalEntries(ctx, db.ListJournalEntriesParams{
+		FromDate:      nullTime(f.FromDate),
+		ToDate:        nullTime(f.ToDate),
+		SourceType:    nullString(f.SourceType),
+		ReferenceType: nullString(f.ReferenceType),
+		ReferenceID:   nullString(f.ReferenceID),
+		AccountID:     f.AccountID,
+		Side:          nullString(f.Side),
+		CostCenterID:   f.CostCenterID,
+		OrganizationID: nullString(f.OrganizationID),
+		OrderBy:        f.OrderBy,
+		Descending:    f.Descending,
+		PageLimit:     limit,
+		PageOffset:    offset,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -262,8 +569,22 @@ func NewLedgerRepository(q *db.Queries) ports.LedgerRepository {
 	return &LedgerSQLCRepository{queries: q}
 }
 
-func (r *LedgerSQLCRepository) List(ctx context.Context, limit, offset int32) ([]*db.LedgerEntry, error) {
-	rows, err := r.queries.ListLedgerEntries(ctx, db.ListLedgerEntriesParams{Limit: limit, Offset: offset})
+func (r *LedgerSQLCRepository) List(ctx context.Context, f ports.EntryFilter, limit, offset int32) ([]*db.LedgerEntry, error) {
+	rows, err := r.queries.ListLedgerEntries(ctx, db.ListLedgerEntriesParams{
+		AccountID:     f.AccountID,
+		FromDate:      nullTime(f.FromDate),
+		ToDate:        nullTime(f.ToDate),
+		Side:          nullString(f.Side),
+		CostCenterID:  f.CostCenterID,
+		ReferenceType: nullString(f.ReferenceType),
+		ReferenceID:   nullString(f.ReferenceID),
+		SourceType:     nullString(f.SourceType),
+		OrganizationID: nullString(f.OrganizationID),
+		OrderBy:        f.OrderBy,
+		Descending:    f.Descending,
+		PageLimit:     limit,
+		PageOffset:    offset,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -273,3 +594,36 @@ func (r *LedgerSQLCRepository) List(ctx context.Context, limit, offset int32) ([
 	}
 	return entries, nil
 }
+
+func (r *LedgerSQLCRepository) Balances(ctx context.Context, orgID string, accountIDs []uuid.UUID, from, to time.Time) ([]db.ListAccountBalancesRow, error) {
+	if accountIDs == nil {
+		accountIDs = []uuid.UUID{}
+	}
+	return r.queries.ListAccountBalances(ctx, db.ListAccountBalancesParams{
+		OrganizationID: orgID,
+		AccountIds:     accountIDs,
+		FromDate:       from,
+		ToDate:         to,
+	})
+}
+
+func (r *LedgerSQLCRepository) CurrencyBalances(ctx context.Context, orgID string, accountIDs []uuid.UUID, from, to time.Time) ([]db.ListAccountCurrencyBalancesRow, error) {
+	if accountIDs == nil {
+		accountIDs = []uuid.UUID{}
+	}
+	return r.queries.ListAccountCurrencyBalances(ctx, db.ListAccountCurrencyBalancesParams{
+		OrganizationID: orgID,
+		AccountIds:     accountIDs,
+		FromDate:       from,
+		ToDate:         to,
+	})
+}
+
+// nullString and nullTime map an unset filter field to SQL NULL.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
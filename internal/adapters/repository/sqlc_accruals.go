@@ -22,12 +22,13 @@ func NewAccrualRepository(q *db.Queries, pub ports.EventPublisher) ports.Accrual
 
 func (r *accrualRepository) Create(ctx context.Context, a db.Accrual) (db.Accrual, error) {
 	arg := db.CreateAccrualParams{
-		Description: a.Description,
-		Amount:      a.Amount,
-		AccrualDate: a.AccrualDate,
-		AccountID:   a.AccountID,
-		CreatedBy:   a.CreatedBy,
-		UpdatedBy:   a.UpdatedBy,
+		Description:    a.Description,
+		Amount:         a.Amount,
+		AccrualDate:    a.AccrualDate,
+		AccountID:      a.AccountID,
+		CreatedBy:      a.CreatedBy,
+		UpdatedBy:      a.UpdatedBy,
+		OrganizationID: a.OrganizationID,
 	}
 	res, err := r.q.CreateAccrual(ctx, arg)
 	if err != nil {
@@ -86,16 +87,17 @@ func (r *accrualRepository) List(ctx context.Context, limit, offset int32) ([]db
 // --- mapping helpers ---
 func toDomainAccrual(a db.Accrual) db.Accrual {
 	return db.Accrual{
-		ID:          a.ID,
-		Description: a.Description,
-		Amount:      a.Amount,
-		AccrualDate: a.AccrualDate,
-		AccountID:   a.AccountID,
-		CreatedAt:   a.CreatedAt,
-		CreatedBy:   a.CreatedBy,
-		UpdatedAt:   a.UpdatedAt,
-		UpdatedBy:   a.UpdatedBy,
-		Revision:    a.Revision,
+		ID:             a.ID,
+		Description:    a.Description,
+		Amount:         a.Amount,
+		AccrualDate:    a.AccrualDate,
+		AccountID:      a.AccountID,
+		CreatedAt:      a.CreatedAt,
+		CreatedBy:      a.CreatedBy,
+		UpdatedAt:      a.UpdatedAt,
+		UpdatedBy:      a.UpdatedBy,
+		Revision:       a.Revision,
+		OrganizationID: a.OrganizationID,
 	}
 }
 
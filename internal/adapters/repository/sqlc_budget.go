@@ -23,11 +23,12 @@ func NewBudgetRepository(queries *sql.DB) *BudgetRepository {
 
 func (r *BudgetRepository) Create(ctx context.Context, b *db.Budget) (*db.Budget, error) {
 	row, err := r.queries.CreateBudget(ctx, db.CreateBudgetParams{
-		Name:        b.Name,
-		TotalAmount: b.TotalAmount,
-		Column3:     nil,
-		CreatedBy:   b.CreatedBy,
-		UpdatedBy:   b.UpdatedBy,
+		Name:         b.Name,
+		TotalAmount:  b.TotalAmount,
+		Column3:      nil,
+		CreatedBy:    b.CreatedBy,
+		UpdatedBy:    b.UpdatedBy,
+		CurrencyCode: b.CurrencyCode,
 	})
 	if err != nil {
 		return nil, err
@@ -62,11 +63,12 @@ func (r *BudgetRepository) List(ctx context.Context, limit, offset int32) ([]db.
 
 func (r *BudgetRepository) Update(ctx context.Context, b *db.Budget) (*db.Budget, error) {
 	row, err := r.queries.UpdateBudget(ctx, db.UpdateBudgetParams{
-		ID:          b.ID,
-		Name:        b.Name,
-		TotalAmount: b.TotalAmount,
-		Status:      b.Status,
-		UpdatedBy:   b.UpdatedBy,
+		ID:           b.ID,
+		Name:         b.Name,
+		TotalAmount:  b.TotalAmount,
+		Status:       b.Status,
+		UpdatedBy:    b.UpdatedBy,
+		CurrencyCode: b.CurrencyCode,
 	})
 	if err != nil {
 		return nil, err
@@ -172,15 +174,16 @@ func (r *BudgetRepository) GetBudgetComparisonReport(
 func mapBudget(row db.Budget) *db.Budget {
 
 	return &db.Budget{
-		ID:          row.ID,
-		Name:        row.Name,
-		TotalAmount: row.TotalAmount,
-		Status:      row.Status,
-		CreatedAt:   row.CreatedAt,
-		CreatedBy:   row.CreatedBy,
-		UpdatedAt:   row.UpdatedAt,
-		UpdatedBy:   row.UpdatedBy,
-		Revision:    row.Revision,
+		ID:           row.ID,
+		Name:         row.Name,
+		TotalAmount:  row.TotalAmount,
+		Status:       row.Status,
+		CreatedAt:    row.CreatedAt,
+		CreatedBy:    row.CreatedBy,
+		UpdatedAt:    row.UpdatedAt,
+		UpdatedBy:    row.UpdatedBy,
+		Revision:     row.Revision,
+		CurrencyCode: row.CurrencyCode,
 	}
 }
 
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+type CreditRepo struct {
+	q *db.Queries
+}
+
+var _ ports.CreditRepository = (*CreditRepo)(nil)
+
+func NewCreditRepository(q *db.Queries) ports.CreditRepository {
+	return &CreditRepo{q: q}
+}
+
+func (r *CreditRepo) GetPolicy(ctx context.Context, orgID string) (db.CreditPolicy, error) {
+	return r.q.GetCreditPolicy(ctx, orgID)
+}
+
+func (r *CreditRepo) SetPolicy(ctx context.Context, p db.CreditPolicy) (db.CreditPolicy, error) {
+	return r.q.UpsertCreditPolicy(ctx, db.UpsertCreditPolicyParams{
+		OrganizationID:   p.OrganizationID,
+		ThresholdPercent: p.ThresholdPercent,
+		Action:           p.Action,
+		UpdatedBy:        p.UpdatedBy,
+	})
+}
+
+func (r *CreditRepo) PartyExposure(ctx context.Context, partyID uuid.UUID) (db.GetPartyCreditExposureRow, error) {
+	return r.q.GetPartyCreditExposure(ctx, uuid.NullUUID{UUID: partyID, Valid: true})
+}
+
+func (r *CreditRepo) ApproveInvoice(ctx context.Context, invoiceID uuid.UUID, by string) (bool, error) {
+	n, err := r.q.ApproveInvoiceCredit(ctx, db.ApproveInvoiceCreditParams{
+		ID:               invoiceID,
+		CreditApprovedBy: nullString(by),
+	})
+	return n > 0, err
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/google/uuid"
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
@@ -9,11 +10,12 @@ import (
 )
 
 type CreditDebitNoteRepo struct {
+	db      *sql.DB
 	queries *db.Queries
 }
 
-func NewCreditDebitNoteRepo(q *db.Queries) ports.CreditDebitNoteRepository {
-	return &CreditDebitNoteRepo{queries: q}
+func NewCreditDebitNoteRepo(conn *sql.DB, q *db.Queries) ports.CreditDebitNoteRepository {
+	return &CreditDebitNoteRepo{db: conn, queries: q}
 }
 
 func (r *CreditDebitNoteRepo) Create(ctx context.Context, note db.CreditDebitNote) (db.CreditDebitNote, error) {
@@ -77,6 +79,102 @@ func (r *CreditDebitNoteRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.queries.DeleteCreditDebitNote(ctx, id)
 }
 
+// Apply locks the note's invoice and its payment dues, stores the note and
+// writes what plan returns: the reduced dues, the raised due, the journal,
+// the invoice's note totals and status, and the note's application.
+func (r *CreditDebitNoteRepo) Apply(ctx context.Context, note db.CreditDebitNote, by string, plan ports.NotePlan) (db.CreditDebitNote, db.Invoice, *db.JournalEntry, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return db.CreditDebitNote{}, db.Invoice{}, nil, err
+	}
+	defer tx.Rollback()
+	qtx := r.queries.WithTx(tx)
+
+	inv, err := qtx.LockNoteInvoice(ctx, note.InvoiceID)
+	if err != nil {
+		return db.CreditDebitNote{}, db.Invoice{}, nil, err
+	}
+	dues, err := qtx.ListInvoiceDues(ctx, note.InvoiceID)
+	if err != nil {
+		return db.CreditDebitNote{}, db.Invoice{}, nil, err
+	}
+	created, err := qtx.CreateCreditDebitNote(ctx, db.CreateCreditDebitNoteParams{
+		InvoiceID: note.InvoiceID,
+		Type:      note.Type,
+		Amount:    note.Amount,
+		Reason:    note.Reason,
+		CreatedBy: note.CreatedBy,
+		UpdatedBy: note.UpdatedBy,
+	})
+	if err != nil {
+		return db.CreditDebitNote{}, db.Invoice{}, nil, err
+	}
+	app, err := plan(mapSQLCToDomains(created), inv, dues)
+	if err != nil {
+		return db.CreditDebitNote{}, db.Invoice{}, nil, err
+	}
+
+	for _, d := range app.Dues {
+		if err := qtx.CreditPaymentDue(ctx, db.CreditPaymentDueParams{
+			ID:        d.ID,
+			AmountDue: d.AmountDue,
+			Status:    d.Status,
+			UpdatedBy: nullString(by),
+		}); err != nil {
+			return db.CreditDebitNote{}, db.Invoice{}, nil, err
+		}
+	}
+	var dueID uuid.NullUUID
+	if d := app.NewDue; d != nil {
+		raised, err := qtx.CreatePaymentDue(ctx, db.CreatePaymentDueParams{
+			InvoiceID:    d.InvoiceID,
+			AmountDue:    d.AmountDue,
+			DueDate:      d.DueDate,
+			Status:       d.Status,
+			CreatedBy:    nullString(by),
+			UpdatedBy:    nullString(by),
+			CurrencyCode: d.CurrencyCode,
+			ExchangeRate: d.ExchangeRate,
+			AccountID:    d.AccountID,
+		})
+		if err != nil {
+			return db.CreditDebitNote{}, db.Invoice{}, nil, err
+		}
+		dueID = uuid.NullUUID{UUID: raised.ID, Valid: true}
+	}
+	var posted *db.JournalEntry
+	var journalID uuid.NullUUID
+	if app.Journal != nil {
+		if posted, err = insertJournal(ctx, qtx, app.Journal); err != nil {
+			return db.CreditDebitNote{}, db.Invoice{}, nil, err
+		}
+		journalID = uuid.NullUUID{UUID: posted.ID, Valid: true}
+	}
+
+	updated, err := qtx.ApplyNoteToInvoice(ctx, db.ApplyNoteToInvoiceParams{
+		ID:        inv.ID,
+		Credited:  app.Credited,
+		Debited:   app.Debited,
+		Status:    app.Status,
+		UpdatedBy: nullString(by),
+	})
+	if err != nil {
+		return db.CreditDebitNote{}, db.Invoice{}, nil, err
+	}
+	applied, err := qtx.RecordNoteApplication(ctx, db.RecordNoteApplicationParams{
+		ID:           created.ID,
+		JournalID:    journalID,
+		PaymentDueID: dueID,
+	})
+	if err != nil {
+		return db.CreditDebitNote{}, db.Invoice{}, nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return db.CreditDebitNote{}, db.Invoice{}, nil, err
+	}
+	return mapSQLCToDomains(applied), updated, posted, nil
+}
+
 func mapSQLCToDomains(n db.CreditDebitNote) db.CreditDebitNote {
 	return db.CreditDebitNote{
 		ID:        n.ID,
@@ -88,5 +186,9 @@ func mapSQLCToDomains(n db.CreditDebitNote) db.CreditDebitNote {
 		CreatedBy: n.CreatedBy,
 		UpdatedAt: n.UpdatedAt,
 		UpdatedBy: n.UpdatedBy,
+
+		JournalID:    n.JournalID,
+		PaymentDueID: n.PaymentDueID,
+		AppliedAt:    n.AppliedAt,
 	}
 }
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+type DunningRepository struct {
+	db *sql.DB
+	q  *db.Queries
+}
+
+var _ ports.DunningRepository = (*DunningRepository)(nil)
+
+func NewDunningRepository(conn *sql.DB, q *db.Queries) *DunningRepository {
+	return &DunningRepository{db: conn, q: q}
+}
+
+// ReplaceStages deletes the organization's stages and inserts stages in one
+// transaction.
+func (r *DunningRepository) ReplaceStages(ctx context.Context, orgID string, stages []db.DunningStage, by string) ([]db.DunningStage, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	qtx := r.q.WithTx(tx)
+
+	if err := qtx.DeleteDunningStages(ctx, orgID); err != nil {
+		return nil, err
+	}
+	saved := make([]db.DunningStage, 0, len(stages))
+	for _, s := range stages {
+		row, err := qtx.InsertDunningStage(ctx, db.InsertDunningStageParams{
+			OrganizationID:  orgID,
+			DaysFromDue:     s.DaysFromDue,
+			EscalationLevel: s.EscalationLevel,
+			Name:            s.Name,
+			CreatedBy:       nullString(by),
+		})
+		if err != nil {
+			return nil, err
+		}
+		saved = append(saved, row)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+func (r *DunningRepository) ListStages(ctx context.Context, orgID string) ([]db.DunningStage, error) {
+	return r.q.ListDunningStages(ctx, orgID)
+}
+
+func (r *DunningRepository) PlaceInvoiceHold(ctx context.Context, hold db.DunningHold) (db.DunningHold, error) {
+	return r.q.PlaceInvoiceDunningHold(ctx, db.PlaceInvoiceDunningHoldParams{
+		OrganizationID: hold.OrganizationID,
+		InvoiceID:      hold.InvoiceID,
+		Reason:         hold.Reason,
+		CreatedBy:      hold.CreatedBy,
+	})
+}
+
+func (r *DunningRepository) PlacePartyHold(ctx context.Context, hold db.DunningHold) (db.DunningHold, error) {
+	return r.q.PlacePartyDunningHold(ctx, db.PlacePartyDunningHoldParams{
+		OrganizationID: hold.OrganizationID,
+		PartyID:        hold.PartyID,
+		Reason:         hold.Reason,
+		CreatedBy:      hold.CreatedBy,
+	})
+}
+
+func (r *DunningRepository) ReleaseInvoiceHold(ctx context.Context, invoiceID uuid.UUID) error {
+	return releasedHold(r.q.ReleaseInvoiceDunningHold(ctx, uuid.NullUUID{UUID: invoiceID, Valid: true}))
+}
+
+func (r *DunningRepository) ReleasePartyHold(ctx context.Context, orgID string, partyID uuid.UUID) error {
+	return releasedHold(r.q.ReleasePartyDunningHold(ctx, db.ReleasePartyDunningHoldParams{
+		OrganizationID: orgID,
+		PartyID:        uuid.NullUUID{UUID: partyID, Valid: true},
+	}))
+}
+
+func releasedHold(n int64, err error) error {
+	if err == nil && n == 0 {
+		return sql.ErrNoRows
+	}
+	return err
+}
+
+func (r *DunningRepository) ListHolds(ctx context.Context, orgID string) ([]db.DunningHold, error) {
+	return r.q.ListDunningHolds(ctx, orgID)
+}
+
+func (r *DunningRepository) PastDueInvoices(ctx context.Context, asOf time.Time) ([]uuid.UUID, error) {
+	return r.q.ListPastDueInvoices(ctx, asOf)
+}
+
+func (r *DunningRepository) MarkPaymentDuesOverdue(ctx context.Context, asOf time.Time, by string) ([]db.PaymentDue, error) {
+	return r.q.MarkPaymentDuesOverdue(ctx, db.MarkPaymentDuesOverdueParams{AsOf: asOf, UpdatedBy: nullString(by)})
+}
+
+func (r *DunningRepository) ReminderCandidates(ctx context.Context, asOf time.Time) ([]db.ListDunningReminderCandidatesRow, error) {
+	return r.q.ListDunningReminderCandidates(ctx, asOf)
+}
+
+func (r *DunningRepository) RecordReminder(ctx context.Context, rem db.DunningReminder) (*db.DunningReminder, error) {
+	row, err := r.q.RecordDunningReminder(ctx, db.RecordDunningReminderParams{
+		InvoiceID:       rem.InvoiceID,
+		DaysFromDue:     rem.DaysFromDue,
+		EscalationLevel: rem.EscalationLevel,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
@@ -27,6 +27,7 @@ func (r *ExchangeRateRepo) Create(ctx context.Context, rate db.ExchangeRate) (db
         CreatedBy:     rate.CreatedBy,
         UpdatedBy:     rate.UpdatedBy,
         Revision:      rate.Revision,
+        RateType:      rate.RateType,
     }
     rec, err := r.q.CreateExchangeRate(ctx, arg)
     if err != nil {
@@ -52,6 +53,7 @@ func (r *ExchangeRateRepo) Update(ctx context.Context, rate db.ExchangeRate) (db
         AsOf:          rate.AsOf,
         UpdatedBy:     rate.UpdatedBy,
         Revision:      rate.Revision,
+        RateType:      rate.RateType,
     }
     rec, err := r.q.UpdateExchangeRate(ctx, arg)
     if err != nil {
@@ -94,6 +96,19 @@ func (r *ExchangeRateRepo) GetLatest(ctx context.Context, base, quote string, as
     return mapDBToDomain(rec), nil
 }
 
+func (r *ExchangeRateRepo) GetLatestOfType(ctx context.Context, base, quote, rateType string, asOf time.Time) (db.ExchangeRate, error) {
+    rec, err := r.q.GetLatestRateOfType(ctx, db.GetLatestRateOfTypeParams{
+        BaseCurrency:  base,
+        QuoteCurrency: quote,
+        AsOf:          asOf,
+        RateType:      rateType,
+    })
+    if err != nil {
+        return db.ExchangeRate{}, err
+    }
+    return mapDBToDomain(rec), nil
+}
+
 func safePtr(ptr *string) string {
     if ptr == nil {
         return ""
@@ -113,6 +128,7 @@ func mapDBToDomain(rec db.ExchangeRate) db.ExchangeRate {
         CreatedBy:     rec.CreatedBy,
         UpdatedBy:     rec.UpdatedBy,
         Revision:      rec.Revision,
+        RateType:      rec.RateType,
     }
 }
 
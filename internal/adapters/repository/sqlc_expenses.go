@@ -42,12 +42,13 @@ func NewExpenseRepo(q ExpenseQuerier) ports.ExpenseRepository {
 
 func (r *ExpenseRepo) Create(ctx context.Context, exp db.Expense) (db.Expense, error) {
 	arg := db.CreateExpenseParams{
-		Category:     exp.Category,
-		Amount:       exp.Amount,
-		ExpenseDate:  exp.ExpenseDate,
-		CostCenterID: exp.CostCenterID, // already uuid.NullUUID in db.Expense
-		CreatedBy:    exp.CreatedBy,    // sql.NullString
-		UpdatedBy:    exp.UpdatedBy,    // sql.NullString
+		Category:       exp.Category,
+		Amount:         exp.Amount,
+		ExpenseDate:    exp.ExpenseDate,
+		CostCenterID:   exp.CostCenterID, // already uuid.NullUUID in db.Expense
+		CreatedBy:      exp.CreatedBy,    // sql.NullString
+		UpdatedBy:      exp.UpdatedBy,    // sql.NullString
+		OrganizationID: exp.OrganizationID,
 	}
 	row, err := r.q.CreateExpense(ctx, arg)
 	if err != nil {
@@ -203,16 +204,17 @@ func (r *CostAllocationRepo) List(ctx context.Context, limit, offset int32) ([]d
 // =======================
 func mapDbExpense(row db.Expense) db.Expense {
 	return db.Expense{
-		ID:           row.ID,
-		Category:     row.Category,
-		Amount:       row.Amount,
-		ExpenseDate:  row.ExpenseDate,
-		CostCenterID: row.CostCenterID,
-		CreatedAt:    row.CreatedAt,
-		UpdatedAt:    row.UpdatedAt,
-		CreatedBy:    row.CreatedBy,
-		UpdatedBy:    row.UpdatedBy,
-		Revision:     row.Revision,
+		ID:             row.ID,
+		Category:       row.Category,
+		Amount:         row.Amount,
+		ExpenseDate:    row.ExpenseDate,
+		CostCenterID:   row.CostCenterID,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+		CreatedBy:      row.CreatedBy,
+		UpdatedBy:      row.UpdatedBy,
+		Revision:       row.Revision,
+		OrganizationID: row.OrganizationID,
 	}
 }
 
@@ -156,6 +156,7 @@ func (r *FinanceEventRepo) InsertVendorBillApproved(ctx context.Context, e db.Ve
 		Amount:         e.Amount,
 		ApprovedAt:     e.ApprovedAt,
 		OrganizationID: e.OrganizationID,
+		PartyID:        e.PartyID,
 	}
 	row, err := r.q.InsertVendorBillApprovedEvent(ctx, params)
 	if err != nil {
@@ -239,5 +240,6 @@ func mapVendorBillApprovedRowToDomain(row db.VendorBillApprovedEvent) db.VendorB
 		ApprovedAt:     row.ApprovedAt,
 		OrganizationID: row.OrganizationID,
 		CreatedAt:      row.CreatedAt,
+		PartyID:        row.PartyID,
 	}
 }
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FiscalPeriodRepo struct {
+	db *sql.DB
+	q  *db.Queries
+}
+
+var _ ports.FiscalPeriodRepository = (*FiscalPeriodRepo)(nil)
+
+func NewFiscalPeriodRepository(conn *sql.DB, q *db.Queries) ports.FiscalPeriodRepository {
+	return &FiscalPeriodRepo{db: conn, q: q}
+}
+
+func (r *FiscalPeriodRepo) UpsertCalendar(ctx context.Context, c db.FiscalCalendar) (db.FiscalCalendar, error) {
+	return r.q.UpsertFiscalCalendar(ctx, db.UpsertFiscalCalendarParams{
+		OrganizationID:            c.OrganizationID,
+		YearStartMonth:            c.YearStartMonth,
+		RetainedEarningsAccountID: c.RetainedEarningsAccountID,
+		CreatedBy:                 c.UpdatedBy,
+		FunctionalCurrency:        c.FunctionalCurrency,
+	})
+}
+
+func (r *FiscalPeriodRepo) GetCalendar(ctx context.Context, orgID string) (db.FiscalCalendar, error) {
+	return r.q.GetFiscalCalendar(ctx, orgID)
+}
+
+// CreatePeriods inserts a year's periods in one transaction. Periods that
+// already exist are returned unchanged.
+func (r *FiscalPeriodRepo) CreatePeriods(ctx context.Context, periods []db.FiscalPeriod) ([]db.FiscalPeriod, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+	created := make([]db.FiscalPeriod, 0, len(periods))
+	for _, p := range periods {
+		row, err := qtx.CreateFiscalPeriod(ctx, db.CreateFiscalPeriodParams{
+			OrganizationID: p.OrganizationID,
+			FiscalYear:     p.FiscalYear,
+			PeriodNumber:   p.PeriodNumber,
+			StartDate:      p.StartDate,
+			EndDate:        p.EndDate,
+		})
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, row)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (r *FiscalPeriodRepo) GetPeriod(ctx context.Context, id uuid.UUID) (db.FiscalPeriod, error) {
+	return r.q.GetFiscalPeriod(ctx, id)
+}
+
+func (r *FiscalPeriodRepo) GetPeriodForDate(ctx context.Context, orgID string, date time.Time) (db.FiscalPeriod, error) {
+	return r.q.GetFiscalPeriodForDate(ctx, db.GetFiscalPeriodForDateParams{
+		OrganizationID: orgID,
+		StartDate:      date,
+	})
+}
+
+func (r *FiscalPeriodRepo) ListPeriods(ctx context.Context, orgID string, fiscalYear int32) ([]db.FiscalPeriod, error) {
+	return r.q.ListFiscalPeriods(ctx, db.ListFiscalPeriodsParams{
+		OrganizationID: orgID,
+		FiscalYear:     fiscalYear,
+	})
+}
+
+func (r *FiscalPeriodRepo) UpdatePeriodStatus(ctx context.Context, id uuid.UUID, status, by string) (db.FiscalPeriod, error) {
+	return r.q.UpdateFiscalPeriodStatus(ctx, db.UpdateFiscalPeriodStatusParams{
+		ID:       id,
+		Status:   status,
+		ClosedBy: sql.NullString{String: by, Valid: by != ""},
+	})
+}
+
+// CloseYear holds the year's periods locked until the closing journal is
+// written and the periods are closed, so a failure leaves the year as it was
+// and a concurrent close sees the year closed. Postings into the periods wait
+// for the lock, so the balances read under it are the ones being closed.
+func (r *FiscalPeriodRepo) CloseYear(ctx context.Context, orgID string, fiscalYear int32, by string, closing func(locked []db.FiscalPeriod, balances []db.SumIncomeStatementBalancesRow) (*db.JournalEntry, error)) (*db.JournalEntry, []db.FiscalPeriod, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+	locked, err := qtx.LockFiscalYearPeriods(ctx, db.LockFiscalYearPeriodsParams{
+		OrganizationID: orgID,
+		FiscalYear:     fiscalYear,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var balances []db.SumIncomeStatementBalancesRow
+	if len(locked) > 0 {
+		if balances, err = qtx.SumIncomeStatementBalances(ctx, db.SumIncomeStatementBalancesParams{
+			OrganizationID:    sql.NullString{String: orgID, Valid: true},
+			TransactionDate:   locked[0].StartDate,
+			TransactionDate_2: locked[len(locked)-1].EndDate.AddDate(0, 0, 1),
+		}); err != nil {
+			return nil, nil, err
+		}
+	}
+	journal, err := closing(locked, balances)
+	if err != nil {
+		return nil, nil, err
+	}
+	var posted *db.JournalEntry
+	if journal != nil {
+		if posted, err = insertJournal(ctx, qtx, journal); err != nil {
+			return nil, nil, err
+		}
+	}
+	closed, err := qtx.CloseFiscalYearPeriods(ctx, db.CloseFiscalYearPeriodsParams{
+		OrganizationID: orgID,
+		FiscalYear:     fiscalYear,
+		ClosedBy:       sql.NullString{String: by, Valid: by != ""},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(closed, func(i, j int) bool { return closed[i].PeriodNumber < closed[j].PeriodNumber })
+	return posted, closed, nil
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+type FXRevaluationRepo struct {
+	q *db.Queries
+}
+
+func NewFXRevaluationRepo(q *db.Queries) ports.FXRevaluationRepository {
+	return &FXRevaluationRepo{q: q}
+}
+
+func (r *FXRevaluationRepo) UpsertSettings(ctx context.Context, s db.FxRevaluationSetting) (db.FxRevaluationSetting, error) {
+	return r.q.UpsertFxRevaluationSettings(ctx, db.UpsertFxRevaluationSettingsParams{
+		OrganizationID:          s.OrganizationID,
+		UnrealizedGainAccountID: s.UnrealizedGainAccountID,
+		UnrealizedLossAccountID: s.UnrealizedLossAccountID,
+		AccountIds:              s.AccountIds,
+		CreatedBy:               s.UpdatedBy,
+	})
+}
+
+func (r *FXRevaluationRepo) GetSettings(ctx context.Context, orgID string) (db.FxRevaluationSetting, error) {
+	return r.q.GetFxRevaluationSettings(ctx, orgID)
+}
+
+func (r *FXRevaluationRepo) ForeignBankAccounts(ctx context.Context, orgID, functionalCurrency string) ([]db.BankAccount, error) {
+	return r.q.ListForeignBankAccounts(ctx, db.ListForeignBankAccountsParams{
+		OrganizationID:     orgID,
+		FunctionalCurrency: functionalCurrency,
+	})
+}
+
+func (r *FXRevaluationRepo) ForeignCurrencyPositions(ctx context.Context, orgID string, accountIDs []uuid.UUID, functionalCurrency string, asOf time.Time) ([]db.ListForeignCurrencyPositionsRow, error) {
+	if len(accountIDs) == 0 {
+		return nil, nil
+	}
+	return r.q.ListForeignCurrencyPositions(ctx, db.ListForeignCurrencyPositionsParams{
+		OrganizationID:     orgID,
+		AccountIds:         accountIDs,
+		FunctionalCurrency: functionalCurrency,
+		AsOf:               asOf,
+	})
+}
+
+func (r *FXRevaluationRepo) OpenPaymentDues(ctx context.Context, orgID, functionalCurrency string, asOf time.Time) ([]db.ListOpenForeignPaymentDuesRow, error) {
+	return r.q.ListOpenForeignPaymentDues(ctx, db.ListOpenForeignPaymentDuesParams{
+		OrganizationID:     orgID,
+		AsOf:               asOf,
+		FunctionalCurrency: functionalCurrency,
+	})
+}
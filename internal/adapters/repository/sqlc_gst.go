@@ -104,6 +104,7 @@ func mapGstDocStatus(r db.GstDocStatus) db.GstDocStatus {
 		EwayValidUpto:  r.EwayValidUpto,
 		LastError:      r.LastError,
 		LastSyncedAt:   r.LastSyncedAt,
+		SignedQrCode:   r.SignedQrCode,
 		CreatedAt:      r.CreatedAt,
 		CreatedBy:      r.CreatedBy,
 		Revision:       r.Revision,
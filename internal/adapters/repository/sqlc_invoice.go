@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
@@ -11,59 +12,49 @@ import (
 
 // ---------- InvoiceRepo ----------
 type InvoiceRepo struct {
-	q *db.Queries
+	db *sql.DB
+	q  *db.Queries
 }
 
 // NewInvoiceRepo creates a new InvoiceRepo instance
-func NewInvoiceRepo(q *db.Queries) ports.InvoiceRepository {
-	return &InvoiceRepo{q: q}
+func NewInvoiceRepo(conn *sql.DB, q *db.Queries) ports.InvoiceRepository {
+	return &InvoiceRepo{db: conn, q: q}
 }
 
 // ======================================= Invoice =========================================
 
+// CreateInvoice inserts the invoice with its items, discounts, taxes and GST
+// details in one transaction.
 func (r *InvoiceRepo) CreateInvoice(ctx context.Context, inv db.Invoice) (db.Invoice, error) {
-	dbRow, err := r.q.CreateInvoice(ctx, db.CreateInvoiceParams{
-		InvoiceNumber:        inv.InvoiceNumber,
-		Type:                 inv.Type,
-		InvoiceDate:          inv.InvoiceDate,
-		DueDate:              inv.DueDate,
-		DeliveryDate:         inv.DeliveryDate,
-		OrganizationID:       inv.OrganizationID,
-		PoNumber:             inv.PoNumber,
-		EwayNumberLegacy:     inv.EwayNumberLegacy,
-		StatusNote:           inv.StatusNote,
-		Status:               inv.Status,
-		PaymentReference:     inv.PaymentReference,
-		ChallanNumber:        inv.ChallanNumber,
-		ChallanDate:          inv.ChallanDate,
-		LrNumber:             inv.LrNumber,
-		TransporterName:      inv.TransporterName,
-		TransporterID:        inv.TransporterID,
-		VehicleNumber:        inv.VehicleNumber,
-		AgainstInvoiceNumber: inv.AgainstInvoiceNumber,
-		AgainstInvoiceDate:   inv.AgainstInvoiceDate,
-		Subtotal:             inv.Subtotal,
-		GstCgst:              inv.GstCgst,
-		GstSgst:              inv.GstSgst,
-		GstIgst:              inv.GstIgst,
-		GstRate:              inv.GstRate,
-		GrandTotal:           inv.GrandTotal,
-		CreatedBy:            inv.CreatedBy,
-		UpdatedBy:            inv.UpdatedBy,
-		Revision:             inv.Revision,
-	})
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return db.Invoice{}, err
 	}
-	return mapInvoice(dbRow), nil
+	defer tx.Rollback()
+	qtx := r.q.WithTx(tx)
+
+	created, err := insertInvoice(ctx, qtx, inv)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return db.Invoice{}, err
+	}
+	return created, nil
 }
 
+// GetInvoice returns the invoice with its lines and GST details.
 func (r *InvoiceRepo) GetInvoice(ctx context.Context, id uuid.UUID) (db.Invoice, error) {
 	dbRow, err := r.q.GetInvoice(ctx, id)
 	if err != nil {
 		return db.Invoice{}, err
 	}
-	return mapInvoice(dbRow), nil
+	inv := mapInvoice(dbRow)
+	if err := loadInvoiceDetails(ctx, r.q, &inv); err != nil {
+		return db.Invoice{}, err
+	}
+	return inv, nil
 }
 
 func (r *InvoiceRepo) ListInvoices(ctx context.Context, limit, offset int32) ([]db.Invoice, error) {
@@ -78,8 +69,18 @@ func (r *InvoiceRepo) ListInvoices(ctx context.Context, limit, offset int32) ([]
 	return invoices, nil
 }
 
+// UpdateInvoice rewrites the invoice and replaces its items, discounts, taxes,
+// GST breakup and regime in one transaction. GST document statuses are kept
+// as a history: a non-nil GstDocs is added as the latest.
 func (r *InvoiceRepo) UpdateInvoice(ctx context.Context, inv db.Invoice) (db.Invoice, error) {
-	dbRow, err := r.q.UpdateInvoice(ctx, db.UpdateInvoiceParams{
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	defer tx.Rollback()
+	qtx := r.q.WithTx(tx)
+
+	dbRow, err := qtx.UpdateInvoice(ctx, db.UpdateInvoiceParams{
 		ID:                   inv.ID,
 		InvoiceNumber:        inv.InvoiceNumber,
 		Type:                 inv.Type,
@@ -106,24 +107,308 @@ func (r *InvoiceRepo) UpdateInvoice(ctx context.Context, inv db.Invoice) (db.Inv
 		GstIgst:              inv.GstIgst,
 		GstRate:              inv.GstRate,
 		GrandTotal:           inv.GrandTotal,
+		CurrencyCode:         inv.CurrencyCode,
+		DiscountTotal:        inv.DiscountTotal,
+		TaxTotal:             inv.TaxTotal,
+		RoundOff:             inv.RoundOff,
+		PartyID:              inv.PartyID,
 		UpdatedBy:            inv.UpdatedBy,
-		Revision:             inv.Revision,
 	})
 	if err != nil {
 		return db.Invoice{}, err
 	}
-	return mapInvoice(dbRow), nil
+	if err := deleteInvoiceLines(ctx, qtx, inv.ID); err != nil {
+		return db.Invoice{}, err
+	}
+	updated := mapInvoice(dbRow)
+	if err := insertInvoiceLines(ctx, qtx, &updated, inv); err != nil {
+		return db.Invoice{}, err
+	}
+	if inv.GstDocs != nil {
+		updated.GstDocs, err = insertGstDocStatus(ctx, qtx, inv.ID, *inv.GstDocs)
+	} else {
+		updated.GstDocs, err = optionalRow(qtx.GetGstDocStatus(ctx, inv.ID))
+	}
+	if err != nil {
+		return db.Invoice{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return db.Invoice{}, err
+	}
+	return updated, nil
 }
 
+// AppendInvoiceLines stores a recomputed invoice after lines were added to
+// it: lines without an id are inserted, stored invoice-level taxes get their
+// recomputed amounts and the header totals and GST breakup are rewritten, in
+// one transaction. Stored lines keep their ids.
+func (r *InvoiceRepo) AppendInvoiceLines(ctx context.Context, inv db.Invoice) (db.Invoice, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	defer tx.Rollback()
+	qtx := r.q.WithTx(tx)
+
+	dbRow, err := qtx.UpdateInvoiceTotals(ctx, db.UpdateInvoiceTotalsParams{
+		ID:            inv.ID,
+		Subtotal:      inv.Subtotal,
+		DiscountTotal: inv.DiscountTotal,
+		TaxTotal:      inv.TaxTotal,
+		GstRate:       inv.GstRate,
+		GstCgst:       inv.GstCgst,
+		GstSgst:       inv.GstSgst,
+		GstIgst:       inv.GstIgst,
+		RoundOff:      inv.RoundOff,
+		GrandTotal:    inv.GrandTotal,
+	})
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	saved := mapInvoice(dbRow)
+	saved.Gst, saved.GstDocs = inv.Gst, inv.GstDocs
+
+	for i, item := range inv.Items {
+		if item.ID == uuid.Nil {
+			if item, err = insertInvoiceItem(ctx, qtx, inv.ID, int32(i), item); err != nil {
+				return db.Invoice{}, err
+			}
+		}
+		saved.Items = append(saved.Items, item)
+	}
+	for i, d := range inv.Discounts {
+		if d.ID == uuid.Nil {
+			if d, err = insertInvoiceDiscount(ctx, qtx, inv.ID, int32(i), d); err != nil {
+				return db.Invoice{}, err
+			}
+		}
+		saved.Discounts = append(saved.Discounts, d)
+	}
+	for i, t := range inv.Taxes {
+		if t.ID == uuid.Nil {
+			t, err = insertInvoiceTax(ctx, qtx, inv.ID, int32(i), t)
+		} else {
+			t, err = qtx.UpdateInvoiceTaxAmount(ctx, db.UpdateInvoiceTaxAmountParams{ID: t.ID, Amount: t.Amount})
+		}
+		if err != nil {
+			return db.Invoice{}, err
+		}
+		saved.Taxes = append(saved.Taxes, t)
+	}
+	if err := qtx.DeleteGstBreakup(ctx, inv.ID); err != nil {
+		return db.Invoice{}, err
+	}
+	if inv.GstBreakup != nil {
+		if saved.GstBreakup, err = insertGstBreakup(ctx, qtx, inv.ID, *inv.GstBreakup); err != nil {
+			return db.Invoice{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return db.Invoice{}, err
+	}
+	return saved, nil
+}
+
+// DeleteInvoice removes a DRAFT invoice. It returns sql.ErrNoRows when no
+// draft with that id exists.
 func (r *InvoiceRepo) DeleteInvoice(ctx context.Context, id uuid.UUID) error {
-	return r.q.DeleteInvoice(ctx, id)
+	n, err := r.q.DeleteInvoice(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// IssueInvoice moves a DRAFT invoice to ISSUED, stamping by and the time.
+// In the same transaction it draws the invoice's number from its series,
+// when issue asks for one, and writes the journal issue builds, linking it
+// to the invoice and returning it as stored. The series counter stays locked
+// until the transaction ends, so numbers are handed out in order and one
+// drawn by a failed issue is given back. Like the other transitions it
+// returns sql.ErrNoRows when the invoice is not in a status the transition
+// starts from, and also when it is no longer at issue.Revision.
+func (r *InvoiceRepo) IssueInvoice(ctx context.Context, id uuid.UUID, by string, issue ports.InvoiceIssue) (db.Invoice, *db.JournalEntry, error) {
+	var posted *db.JournalEntry
+	inv, err := r.transition(ctx, func(qtx *db.Queries) (db.Invoice, error) {
+		issued, err := qtx.IssueInvoice(ctx, db.IssueInvoiceParams{
+			ID:       id,
+			IssuedBy: nullString(by),
+			Revision: sql.NullInt32{Int32: issue.Revision, Valid: true},
+		})
+		if err != nil {
+			return db.Invoice{}, err
+		}
+		number := issued.InvoiceNumber
+		if issue.Number != nil {
+			seq, err := qtx.NextInvoiceNumber(ctx, db.NextInvoiceNumberParams{
+				OrganizationID: issued.OrganizationID,
+				InvoiceType:    issued.Type,
+				FiscalYear:     issue.Number.FiscalYear,
+			})
+			if err != nil {
+				return db.Invoice{}, err
+			}
+			number = issue.Number.Format(seq)
+		}
+		var journalID uuid.NullUUID
+		if issue.Journal != nil {
+			journal, err := issue.Journal(number)
+			if err != nil {
+				return db.Invoice{}, err
+			}
+			if journal != nil {
+				if posted, err = insertJournal(ctx, qtx, journal); err != nil {
+					return db.Invoice{}, err
+				}
+				journalID = uuid.NullUUID{UUID: posted.ID, Valid: true}
+			}
+		}
+		return qtx.RecordInvoiceIssue(ctx, db.RecordInvoiceIssueParams{ID: id, InvoiceNumber: number, JournalID: journalID})
+	})
+	if err != nil {
+		return db.Invoice{}, nil, err
+	}
+	return inv, posted, nil
+}
+
+// VoidInvoice moves an ISSUED or OVERDUE invoice to VOID. A non-nil reversal
+// of the invoice's journal is posted in the same transaction, marking the
+// journal REVERSED, and returned as stored.
+func (r *InvoiceRepo) VoidInvoice(ctx context.Context, id uuid.UUID, reason, by string, reversal *db.JournalEntry) (db.Invoice, *db.JournalEntry, error) {
+	var reversed *db.JournalEntry
+	inv, err := r.transition(ctx, func(qtx *db.Queries) (db.Invoice, error) {
+		voided, err := qtx.VoidInvoice(ctx, db.VoidInvoiceParams{
+			ID:         id,
+			VoidedBy:   nullString(by),
+			VoidReason: nullString(reason),
+		})
+		if err != nil || reversal == nil || !voided.JournalID.Valid {
+			return voided, err
+		}
+		reversed, err = reverseJournal(ctx, qtx, voided.JournalID.UUID, reversal)
+		return voided, err
+	})
+	if err != nil {
+		return db.Invoice{}, nil, err
+	}
+	return inv, reversed, nil
+}
+
+// MarkInvoiceOverdue moves an ISSUED or PARTIALLY_PAID invoice to OVERDUE.
+func (r *InvoiceRepo) MarkInvoiceOverdue(ctx context.Context, id uuid.UUID, by string) (db.Invoice, error) {
+	return r.transition(ctx, func(qtx *db.Queries) (db.Invoice, error) {
+		return qtx.MarkInvoiceOverdue(ctx, db.MarkInvoiceOverdueParams{ID: id, OverdueBy: nullString(by)})
+	})
+}
+
+// transition runs a status update, and whatever is written with it, in one
+// transaction and returns the updated invoice with its lines and GST details.
+func (r *InvoiceRepo) transition(ctx context.Context, update func(qtx *db.Queries) (db.Invoice, error)) (db.Invoice, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	defer tx.Rollback()
+	qtx := r.q.WithTx(tx)
+
+	row, err := update(qtx)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	inv := mapInvoice(row)
+	if err := loadInvoiceDetails(ctx, qtx, &inv); err != nil {
+		return db.Invoice{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return db.Invoice{}, err
+	}
+	return inv, nil
+}
+
+// UpsertPostingRule stores the organization's accounts for one invoice type.
+func (r *InvoiceRepo) UpsertPostingRule(ctx context.Context, rule db.InvoicePostingRule) (db.InvoicePostingRule, error) {
+	return r.q.UpsertInvoicePostingRule(ctx, db.UpsertInvoicePostingRuleParams{
+		OrganizationID:    rule.OrganizationID,
+		InvoiceType:       rule.InvoiceType,
+		ControlAccountID:  rule.ControlAccountID,
+		LineAccountID:     rule.LineAccountID,
+		CgstAccountID:     rule.CgstAccountID,
+		SgstAccountID:     rule.SgstAccountID,
+		IgstAccountID:     rule.IgstAccountID,
+		OtherTaxAccountID: rule.OtherTaxAccountID,
+		DiscountAccountID: rule.DiscountAccountID,
+		RoundOffAccountID: rule.RoundOffAccountID,
+		CreatedBy:         rule.UpdatedBy,
+	})
+}
+
+func (r *InvoiceRepo) GetPostingRule(ctx context.Context, orgID, invoiceType string) (db.InvoicePostingRule, error) {
+	return r.q.GetInvoicePostingRule(ctx, db.GetInvoicePostingRuleParams{
+		OrganizationID: orgID,
+		InvoiceType:    invoiceType,
+	})
+}
+
+// UpsertNumberSeries stores the organization's number pattern for one
+// invoice type. Changing the pattern keeps the running numbers.
+func (r *InvoiceRepo) UpsertNumberSeries(ctx context.Context, series db.InvoiceNumberSeries) (db.InvoiceNumberSeries, error) {
+	return r.q.UpsertInvoiceNumberSeries(ctx, db.UpsertInvoiceNumberSeriesParams{
+		OrganizationID: series.OrganizationID,
+		InvoiceType:    series.InvoiceType,
+		Pattern:        series.Pattern,
+		CreatedBy:      series.UpdatedBy,
+	})
+}
+
+func (r *InvoiceRepo) GetNumberSeries(ctx context.Context, orgID, invoiceType string) (db.InvoiceNumberSeries, error) {
+	return r.q.GetInvoiceNumberSeries(ctx, db.GetInvoiceNumberSeriesParams{
+		OrganizationID: orgID,
+		InvoiceType:    invoiceType,
+	})
+}
+
+// UpsertTemplate stores what the organization prints on its invoices.
+func (r *InvoiceRepo) UpsertTemplate(ctx context.Context, t db.InvoiceTemplate) (db.InvoiceTemplate, error) {
+	return r.q.UpsertInvoiceTemplate(ctx, db.UpsertInvoiceTemplateParams{
+		OrganizationID: t.OrganizationID,
+		CompanyName:    t.CompanyName,
+		Address:        t.Address,
+		Logo:           t.Logo,
+		BankDetails:    t.BankDetails,
+		Terms:          t.Terms,
+		Footer:         t.Footer,
+		CreatedBy:      t.UpdatedBy,
+		CountryCode:    t.CountryCode,
+		PeppolEndpoint: t.PeppolEndpoint,
+	})
+}
+
+func (r *InvoiceRepo) GetTemplate(ctx context.Context, orgID string) (db.InvoiceTemplate, error) {
+	return r.q.GetInvoiceTemplate(ctx, orgID)
 }
 
-func (r *InvoiceRepo) SearchInvoices(ctx context.Context, query string, limit, offset int32) ([]db.Invoice, error) {
+func (r *InvoiceRepo) SearchInvoices(ctx context.Context, f ports.InvoiceFilter, limit, offset int32) ([]db.Invoice, error) {
 	dbRows, err := r.q.SearchInvoices(ctx, db.SearchInvoicesParams{
-		Column1: sql.NullString{String: query, Valid: query != ""},
-		Limit:   limit,
-		Offset:  offset,
+		OrganizationID: f.OrganizationID,
+		Query:          f.Query,
+		Status:         sql.NullString{String: f.Status, Valid: f.Status != ""},
+		Type:           sql.NullString{String: f.Type, Valid: f.Type != ""},
+		FromDate:       sql.NullTime{Time: f.FromDate, Valid: !f.FromDate.IsZero()},
+		ToDate:         sql.NullTime{Time: f.ToDate, Valid: !f.ToDate.IsZero()},
+		DueFrom:        sql.NullTime{Time: f.DueFrom, Valid: !f.DueFrom.IsZero()},
+		DueTo:          sql.NullTime{Time: f.DueTo, Valid: !f.DueTo.IsZero()},
+		MinTotal:       sql.NullString{String: f.MinTotal, Valid: f.MinTotal != ""},
+		MaxTotal:       sql.NullString{String: f.MaxTotal, Valid: f.MaxTotal != ""},
+		OrderBy:        f.OrderBy,
+		Descending:     f.Descending,
+		PageLimit:      limit,
+		PageOffset:     offset,
 	})
 	if err != nil {
 		return nil, err
@@ -148,6 +433,8 @@ func (r *InvoiceRepo) CreateInvoiceItem(ctx context.Context, item db.InvoiceItem
 		LineSubtotal: item.LineSubtotal,
 		LineTotal:    item.LineTotal,
 		CostCenterID: item.CostCenterID,
+		LineNo:       item.LineNo,
+		AccountID:    item.AccountID,
 	})
 	if err != nil {
 		return db.InvoiceItem{}, err
@@ -155,16 +442,9 @@ func (r *InvoiceRepo) CreateInvoiceItem(ctx context.Context, item db.InvoiceItem
 	return mapInvoiceItem(dbRow), nil
 }
 
+// ListInvoiceItems returns the items with their discounts and taxes.
 func (r *InvoiceRepo) ListInvoiceItems(ctx context.Context, invoiceID uuid.UUID) ([]db.InvoiceItem, error) {
-	dbRows, err := r.q.ListInvoiceItems(ctx, invoiceID)
-	if err != nil {
-		return nil, err
-	}
-	items := make([]db.InvoiceItem, len(dbRows))
-	for i, row := range dbRows {
-		items[i] = mapInvoiceItem(row)
-	}
-	return items, nil
+	return listInvoiceItems(ctx, r.q, invoiceID)
 }
 
 // ---------- InvoiceTax ----------
@@ -174,6 +454,7 @@ func (r *InvoiceRepo) AddInvoiceTax(ctx context.Context, tax db.InvoiceTax) (db.
 		Name:      tax.Name,
 		Rate:      tax.Rate,
 		Amount:    tax.Amount,
+		LineNo:    tax.LineNo,
 	})
 	if err != nil {
 		return db.InvoiceTax{}, err
@@ -188,6 +469,7 @@ func (r *InvoiceRepo) AddInvoiceDiscount(ctx context.Context, disc db.InvoiceDis
 		InvoiceID:   disc.InvoiceID,
 		Description: disc.Description,
 		Amount:      disc.Amount,
+		LineNo:      disc.LineNo,
 	})
 	if err != nil {
 		return db.InvoiceDiscount{}, err
@@ -195,6 +477,283 @@ func (r *InvoiceRepo) AddInvoiceDiscount(ctx context.Context, disc db.InvoiceDis
 	return mapInvoiceDiscount(dbRow), nil
 }
 
+// =========================================== Aggregate ===========================================
+
+// insertInvoice inserts inv with its lines and GST details through qtx.
+func insertInvoice(ctx context.Context, qtx *db.Queries, inv db.Invoice) (db.Invoice, error) {
+	dbRow, err := qtx.CreateInvoice(ctx, db.CreateInvoiceParams{
+		InvoiceNumber:        inv.InvoiceNumber,
+		Type:                 inv.Type,
+		InvoiceDate:          inv.InvoiceDate,
+		DueDate:              inv.DueDate,
+		DeliveryDate:         inv.DeliveryDate,
+		OrganizationID:       inv.OrganizationID,
+		PoNumber:             inv.PoNumber,
+		EwayNumberLegacy:     inv.EwayNumberLegacy,
+		StatusNote:           inv.StatusNote,
+		Status:               inv.Status,
+		PaymentReference:     inv.PaymentReference,
+		ChallanNumber:        inv.ChallanNumber,
+		ChallanDate:          inv.ChallanDate,
+		LrNumber:             inv.LrNumber,
+		TransporterName:      inv.TransporterName,
+		TransporterID:        inv.TransporterID,
+		VehicleNumber:        inv.VehicleNumber,
+		AgainstInvoiceNumber: inv.AgainstInvoiceNumber,
+		AgainstInvoiceDate:   inv.AgainstInvoiceDate,
+		Subtotal:             inv.Subtotal,
+		GstCgst:              inv.GstCgst,
+		GstSgst:              inv.GstSgst,
+		GstIgst:              inv.GstIgst,
+		GstRate:              inv.GstRate,
+		GrandTotal:           inv.GrandTotal,
+		CurrencyCode:         inv.CurrencyCode,
+		DiscountTotal:        inv.DiscountTotal,
+		TaxTotal:             inv.TaxTotal,
+		RoundOff:             inv.RoundOff,
+		PartyID:              inv.PartyID,
+		CreatedBy:            inv.CreatedBy,
+		UpdatedBy:            inv.UpdatedBy,
+		Revision:             inv.Revision,
+	})
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	created := mapInvoice(dbRow)
+	if err := insertInvoiceLines(ctx, qtx, &created, inv); err != nil {
+		return db.Invoice{}, err
+	}
+	if inv.GstDocs != nil {
+		if created.GstDocs, err = insertGstDocStatus(ctx, qtx, created.ID, *inv.GstDocs); err != nil {
+			return db.Invoice{}, err
+		}
+	}
+	return created, nil
+}
+
+// insertInvoiceLines writes src's lines and GST breakup and regime under
+// inv, numbering the lines in order, and attaches the stored rows to inv.
+func insertInvoiceLines(ctx context.Context, qtx *db.Queries, inv *db.Invoice, src db.Invoice) error {
+	for i, item := range src.Items {
+		saved, err := insertInvoiceItem(ctx, qtx, inv.ID, int32(i), item)
+		if err != nil {
+			return err
+		}
+		inv.Items = append(inv.Items, saved)
+	}
+	for i, d := range src.Discounts {
+		saved, err := insertInvoiceDiscount(ctx, qtx, inv.ID, int32(i), d)
+		if err != nil {
+			return err
+		}
+		inv.Discounts = append(inv.Discounts, saved)
+	}
+	for i, t := range src.Taxes {
+		saved, err := insertInvoiceTax(ctx, qtx, inv.ID, int32(i), t)
+		if err != nil {
+			return err
+		}
+		inv.Taxes = append(inv.Taxes, saved)
+	}
+	var err error
+	if src.GstBreakup != nil {
+		if inv.GstBreakup, err = insertGstBreakup(ctx, qtx, inv.ID, *src.GstBreakup); err != nil {
+			return err
+		}
+	}
+	if src.Gst != nil {
+		regime, err := qtx.AddGstRegime(ctx, db.AddGstRegimeParams{
+			InvoiceID:     inv.ID,
+			Gstin:         src.Gst.Gstin,
+			PlaceOfSupply: src.Gst.PlaceOfSupply,
+			ReverseCharge: src.Gst.ReverseCharge,
+		})
+		if err != nil {
+			return err
+		}
+		inv.Gst = &regime
+	}
+	return nil
+}
+
+// deleteInvoiceLines removes everything insertInvoiceLines writes.
+func deleteInvoiceLines(ctx context.Context, qtx *db.Queries, invoiceID uuid.UUID) error {
+	for _, del := range []func(context.Context, uuid.UUID) error{
+		qtx.DeleteInvoiceItems, qtx.DeleteInvoiceDiscounts, qtx.DeleteInvoiceTaxes,
+		qtx.DeleteGstBreakup, qtx.DeleteGstRegime,
+	} {
+		if err := del(ctx, invoiceID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertInvoiceItem(ctx context.Context, qtx *db.Queries, invoiceID uuid.UUID, lineNo int32, item db.InvoiceItem) (db.InvoiceItem, error) {
+	saved, err := qtx.CreateInvoiceItem(ctx, db.CreateInvoiceItemParams{
+		InvoiceID:    invoiceID,
+		Name:         item.Name,
+		Description:  item.Description,
+		Hsn:          item.Hsn,
+		Quantity:     item.Quantity,
+		UnitPrice:    item.UnitPrice,
+		LineSubtotal: item.LineSubtotal,
+		LineTotal:    item.LineTotal,
+		CostCenterID: item.CostCenterID,
+		LineNo:       lineNo,
+		AccountID:    item.AccountID,
+	})
+	if err != nil {
+		return db.InvoiceItem{}, err
+	}
+	for i, d := range item.Discounts {
+		row, err := qtx.AddInvoiceItemDiscount(ctx, db.AddInvoiceItemDiscountParams{
+			ItemID:      saved.ID,
+			Description: d.Description,
+			Amount:      d.Amount,
+			CreatedBy:   d.CreatedBy,
+			LineNo:      int32(i),
+		})
+		if err != nil {
+			return db.InvoiceItem{}, err
+		}
+		saved.Discounts = append(saved.Discounts, row)
+	}
+	for i, t := range item.Taxes {
+		row, err := qtx.AddInvoiceItemTax(ctx, db.AddInvoiceItemTaxParams{
+			ItemID:    saved.ID,
+			Name:      t.Name,
+			Rate:      t.Rate,
+			Amount:    t.Amount,
+			CreatedBy: t.CreatedBy,
+			LineNo:    int32(i),
+		})
+		if err != nil {
+			return db.InvoiceItem{}, err
+		}
+		saved.Taxes = append(saved.Taxes, row)
+	}
+	return saved, nil
+}
+
+func insertInvoiceDiscount(ctx context.Context, qtx *db.Queries, invoiceID uuid.UUID, lineNo int32, d db.InvoiceDiscount) (db.InvoiceDiscount, error) {
+	return qtx.AddInvoiceDiscount(ctx, db.AddInvoiceDiscountParams{
+		InvoiceID:   invoiceID,
+		Description: d.Description,
+		Amount:      d.Amount,
+		LineNo:      lineNo,
+	})
+}
+
+func insertInvoiceTax(ctx context.Context, qtx *db.Queries, invoiceID uuid.UUID, lineNo int32, t db.InvoiceTax) (db.InvoiceTax, error) {
+	return qtx.AddInvoiceTax(ctx, db.AddInvoiceTaxParams{
+		InvoiceID: invoiceID,
+		Name:      t.Name,
+		Rate:      t.Rate,
+		Amount:    t.Amount,
+		LineNo:    lineNo,
+	})
+}
+
+func insertGstBreakup(ctx context.Context, qtx *db.Queries, invoiceID uuid.UUID, g db.GstBreakup) (*db.GstBreakup, error) {
+	row, err := qtx.AddGstBreakup(ctx, db.AddGstBreakupParams{
+		InvoiceID:     invoiceID,
+		TaxableAmount: g.TaxableAmount,
+		Cgst:          g.Cgst,
+		Sgst:          g.Sgst,
+		Igst:          g.Igst,
+		TotalGst:      g.TotalGst,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func insertGstDocStatus(ctx context.Context, qtx *db.Queries, invoiceID uuid.UUID, d db.GstDocStatus) (*db.GstDocStatus, error) {
+	row, err := qtx.AddGstDocStatus(ctx, db.AddGstDocStatusParams{
+		InvoiceID:      invoiceID,
+		EinvoiceStatus: d.EinvoiceStatus,
+		Irn:            d.Irn,
+		AckNo:          d.AckNo,
+		AckDate:        d.AckDate,
+		EwayStatus:     d.EwayStatus,
+		EwayBillNo:     d.EwayBillNo,
+		EwayValidUpto:  d.EwayValidUpto,
+		LastError:      d.LastError,
+		LastSyncedAt:   d.LastSyncedAt,
+		SignedQrCode:   d.SignedQrCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+// loadInvoiceDetails attaches the invoice's lines and GST details.
+func loadInvoiceDetails(ctx context.Context, q *db.Queries, inv *db.Invoice) (err error) {
+	if inv.Items, err = listInvoiceItems(ctx, q, inv.ID); err != nil {
+		return err
+	}
+	if inv.Discounts, err = q.ListInvoiceDiscounts(ctx, inv.ID); err != nil {
+		return err
+	}
+	if inv.Taxes, err = q.ListInvoiceTaxes(ctx, inv.ID); err != nil {
+		return err
+	}
+	if inv.GstBreakup, err = optionalRow(q.GetGstBreakup(ctx, inv.ID)); err != nil {
+		return err
+	}
+	if inv.Gst, err = optionalRow(q.GetGstRegime(ctx, inv.ID)); err != nil {
+		return err
+	}
+	inv.GstDocs, err = optionalRow(q.GetGstDocStatus(ctx, inv.ID))
+	return err
+}
+
+func listInvoiceItems(ctx context.Context, q *db.Queries, invoiceID uuid.UUID) ([]db.InvoiceItem, error) {
+	dbRows, err := q.ListInvoiceItems(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	discounts, err := q.ListInvoiceItemDiscounts(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	taxes, err := q.ListInvoiceItemTaxes(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]db.InvoiceItem, len(dbRows))
+	byID := make(map[uuid.UUID]*db.InvoiceItem, len(dbRows))
+	for i, row := range dbRows {
+		items[i] = mapInvoiceItem(row)
+		byID[row.ID] = &items[i]
+	}
+	for _, d := range discounts {
+		if it, ok := byID[d.ItemID]; ok {
+			it.Discounts = append(it.Discounts, d)
+		}
+	}
+	for _, t := range taxes {
+		if it, ok := byID[t.ItemID]; ok {
+			it.Taxes = append(it.Taxes, t)
+		}
+	}
+	return items, nil
+}
+
+// optionalRow turns a :one lookup that found nothing into a nil row.
+func optionalRow[T any](row T, err error) (*T, error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
 // ============================================ Mapping functions =======================================
 
 func mapInvoice(i db.Invoice) db.Invoice {
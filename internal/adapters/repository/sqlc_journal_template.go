@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type JournalTemplateRepo struct {
+	db *sql.DB
+	q  *db.Queries
+}
+
+var _ ports.JournalTemplateRepository = (*JournalTemplateRepo)(nil)
+
+func NewJournalTemplateRepository(conn *sql.DB, q *db.Queries) ports.JournalTemplateRepository {
+	return &JournalTemplateRepo{db: conn, q: q}
+}
+
+func (r *JournalTemplateRepo) Create(ctx context.Context, t *ports.JournalTemplate) (*ports.JournalTemplate, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	qtx := r.q.WithTx(tx)
+
+	created, err := qtx.CreateJournalTemplate(ctx, db.CreateJournalTemplateParams{
+		OrganizationID:    t.OrganizationID,
+		Name:              t.Name,
+		Reference:         t.Reference,
+		Memo:              t.Memo,
+		Frequency:         t.Frequency,
+		StartDate:         t.StartDate,
+		EndDate:           t.EndDate,
+		DayOfMonth:        t.DayOfMonth,
+		AutoPost:          t.AutoPost,
+		AdjustmentPercent: t.AdjustmentPercent,
+		Active:            t.Active,
+		CreatedBy:         t.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	lines, err := insertTemplateLines(ctx, qtx, created.ID, t.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &ports.JournalTemplate{JournalTemplate: created, Lines: lines}, nil
+}
+
+func (r *JournalTemplateRepo) Get(ctx context.Context, id uuid.UUID) (*ports.JournalTemplate, error) {
+	t, err := r.q.GetJournalTemplate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.withLines(ctx, t)
+}
+
+// Update rewrites the template and replaces its lines.
+func (r *JournalTemplateRepo) Update(ctx context.Context, t *ports.JournalTemplate) (*ports.JournalTemplate, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	qtx := r.q.WithTx(tx)
+
+	updated, err := qtx.UpdateJournalTemplate(ctx, db.UpdateJournalTemplateParams{
+		ID:                t.ID,
+		Name:              t.Name,
+		Reference:         t.Reference,
+		Memo:              t.Memo,
+		Frequency:         t.Frequency,
+		StartDate:         t.StartDate,
+		EndDate:           t.EndDate,
+		DayOfMonth:        t.DayOfMonth,
+		AutoPost:          t.AutoPost,
+		AdjustmentPercent: t.AdjustmentPercent,
+		Active:            t.Active,
+		UpdatedBy:         t.UpdatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := qtx.DeleteJournalTemplateLines(ctx, t.ID); err != nil {
+		return nil, err
+	}
+	lines, err := insertTemplateLines(ctx, qtx, t.ID, t.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &ports.JournalTemplate{JournalTemplate: updated, Lines: lines}, nil
+}
+
+func (r *JournalTemplateRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.q.DeleteJournalTemplate(ctx, id)
+}
+
+func (r *JournalTemplateRepo) List(ctx context.Context, limit, offset int32) ([]*ports.JournalTemplate, error) {
+	rows, err := r.q.ListJournalTemplates(ctx, db.ListJournalTemplatesParams{Limit: limit, Offset: offset})
+	if err != nil {
+		return nil, err
+	}
+	return r.allWithLines(ctx, rows)
+}
+
+func (r *JournalTemplateRepo) ListDue(ctx context.Context, asOf time.Time) ([]*ports.JournalTemplate, error) {
+	rows, err := r.q.ListDueJournalTemplates(ctx, asOf)
+	if err != nil {
+		return nil, err
+	}
+	return r.allWithLines(ctx, rows)
+}
+
+// CreateOccurrence claims the (template, date) run and writes the journal in
+// one transaction, so each occurrence produces at most one entry no matter
+// how often or how concurrently the generator runs.
+func (r *JournalTemplateRepo) CreateOccurrence(ctx context.Context, templateID uuid.UUID, date time.Time, j *db.JournalEntry) (*db.JournalEntry, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	qtx := r.q.WithTx(tx)
+
+	claimed, err := qtx.ClaimJournalTemplateRun(ctx, db.ClaimJournalTemplateRunParams{
+		TemplateID:     templateID,
+		OccurrenceDate: date,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if claimed == 0 {
+		return nil, nil
+	}
+
+	created, err := insertJournal(ctx, qtx, j)
+	if err != nil {
+		return nil, err
+	}
+	if err := qtx.SetJournalTemplateRunJournal(ctx, db.SetJournalTemplateRunJournalParams{
+		TemplateID:     templateID,
+		OccurrenceDate: date,
+		JournalID:      uuid.NullUUID{UUID: created.ID, Valid: true},
+	}); err != nil {
+		return nil, err
+	}
+	if err := qtx.AdvanceJournalTemplate(ctx, db.AdvanceJournalTemplateParams{
+		ID:             templateID,
+		OccurrenceDate: date,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (r *JournalTemplateRepo) withLines(ctx context.Context, t db.JournalTemplate) (*ports.JournalTemplate, error) {
+	lines, err := r.q.ListJournalTemplateLines(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &ports.JournalTemplate{JournalTemplate: t, Lines: lines}, nil
+}
+
+func (r *JournalTemplateRepo) allWithLines(ctx context.Context, rows []db.JournalTemplate) ([]*ports.JournalTemplate, error) {
+	out := make([]*ports.JournalTemplate, len(rows))
+	for i, t := range rows {
+		tmpl, err := r.withLines(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = tmpl
+	}
+	return out, nil
+}
+
+func insertTemplateLines(ctx context.Context, qtx *db.Queries, templateID uuid.UUID, lines []db.JournalTemplateLine) ([]db.JournalTemplateLine, error) {
+	inserted := make([]db.JournalTemplateLine, 0, len(lines))
+	for i, l := range lines {
+		line, err := qtx.CreateJournalTemplateLine(ctx, db.CreateJournalTemplateLineParams{
+			TemplateID:   templateID,
+			LineNo:       int16(i + 1),
+			AccountID:    l.AccountID,
+			Side:         l.Side,
+			Amount:       l.Amount,
+			CostCenterID: l.CostCenterID,
+			Description:  l.Description,
+		})
+		if err != nil {
+			return nil, err
+		}
+		inserted = append(inserted, line)
+	}
+	return inserted, nil
+}
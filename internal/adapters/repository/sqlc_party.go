@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+type PartyRepo struct {
+	db *sql.DB
+	q  *db.Queries
+}
+
+var _ ports.PartyRepository = (*PartyRepo)(nil)
+
+func NewPartyRepository(conn *sql.DB, q *db.Queries) ports.PartyRepository {
+	return &PartyRepo{db: conn, q: q}
+}
+
+func (r *PartyRepo) Create(ctx context.Context, p *ports.Party) (*ports.Party, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	qtx := r.q.WithTx(tx)
+
+	created, err := qtx.CreateParty(ctx, db.CreatePartyParams{
+		OrganizationID:   p.OrganizationID,
+		Name:             p.Name,
+		Kind:             p.Kind,
+		LegalName:        p.LegalName,
+		Pan:              p.Pan,
+		DefaultCurrency:  p.DefaultCurrency,
+		PaymentTermsDays: p.PaymentTermsDays,
+		Active:           p.Active,
+		CreatedBy:        p.CreatedBy,
+		CreditLimit:      p.CreditLimit,
+		CreditHold:       p.CreditHold,
+		CreditHoldReason: p.CreditHoldReason,
+		PeppolEndpoint:   p.PeppolEndpoint,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out, err := insertPartyDetails(ctx, qtx, created, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PartyRepo) Get(ctx context.Context, id uuid.UUID) (*ports.Party, error) {
+	p, err := r.q.GetParty(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.withDetails(ctx, p)
+}
+
+func (r *PartyRepo) Update(ctx context.Context, p *ports.Party) (*ports.Party, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	qtx := r.q.WithTx(tx)
+
+	updated, err := qtx.UpdateParty(ctx, db.UpdatePartyParams{
+		ID:               p.ID,
+		Name:             p.Name,
+		Kind:             p.Kind,
+		LegalName:        p.LegalName,
+		Pan:              p.Pan,
+		DefaultCurrency:  p.DefaultCurrency,
+		PaymentTermsDays: p.PaymentTermsDays,
+		Active:           p.Active,
+		UpdatedBy:        p.UpdatedBy,
+		CreditLimit:      p.CreditLimit,
+		CreditHold:       p.CreditHold,
+		CreditHoldReason: p.CreditHoldReason,
+		PeppolEndpoint:   p.PeppolEndpoint,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, del := range []func(context.Context, uuid.UUID) error{
+		qtx.DeletePartyContacts,
+		qtx.DeletePartyAddresses,
+		qtx.DeletePartyTaxRegistrations,
+		qtx.DeletePartyBankAccounts,
+	} {
+		if err := del(ctx, p.ID); err != nil {
+			return nil, err
+		}
+	}
+	out, err := insertPartyDetails(ctx, qtx, updated, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PartyRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.q.DeleteParty(ctx, id)
+}
+
+func (r *PartyRepo) List(ctx context.Context, f ports.PartyFilter, limit, offset int32) ([]*ports.Party, error) {
+	rows, err := r.q.ListParties(ctx, db.ListPartiesParams{
+		OrganizationID: f.OrganizationID,
+		Kind:           f.Kind,
+		Query:          f.Query,
+		PageLimit:      limit,
+		PageOffset:     offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*ports.Party, len(rows))
+	for i, p := range rows {
+		if out[i], err = r.withDetails(ctx, p); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (r *PartyRepo) CountDocuments(ctx context.Context, id uuid.UUID) (int, error) {
+	n, err := r.q.CountPartyDocuments(ctx, uuid.NullUUID{UUID: id, Valid: true})
+	return int(n), err
+}
+
+func (r *PartyRepo) withDetails(ctx context.Context, p db.Party) (*ports.Party, error) {
+	out := &ports.Party{Party: p}
+	var err error
+	if out.Contacts, err = r.q.ListPartyContacts(ctx, p.ID); err != nil {
+		return nil, err
+	}
+	if out.Addresses, err = r.q.ListPartyAddresses(ctx, p.ID); err != nil {
+		return nil, err
+	}
+	if out.TaxRegistrations, err = r.q.ListPartyTaxRegistrations(ctx, p.ID); err != nil {
+		return nil, err
+	}
+	if out.BankAccounts, err = r.q.ListPartyBankAccounts(ctx, p.ID); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// insertPartyDetails writes p's contacts, addresses, tax registrations and
+// bank accounts under saved, numbering each kind of line from 1.
+func insertPartyDetails(ctx context.Context, qtx *db.Queries, saved db.Party, p *ports.Party) (*ports.Party, error) {
+	out := &ports.Party{Party: saved}
+	for i, c := range p.Contacts {
+		row, err := qtx.CreatePartyContact(ctx, db.CreatePartyContactParams{
+			PartyID:     saved.ID,
+			LineNo:      int16(i + 1),
+			Name:        c.Name,
+			Email:       c.Email,
+			Phone:       c.Phone,
+			Designation: c.Designation,
+			IsPrimary:   c.IsPrimary,
+		})
+		if err != nil {
+			return nil, err
+		}
+		out.Contacts = append(out.Contacts, row)
+	}
+	for i, a := range p.Addresses {
+		row, err := qtx.CreatePartyAddress(ctx, db.CreatePartyAddressParams{
+			PartyID:     saved.ID,
+			LineNo:      int16(i + 1),
+			Kind:        a.Kind,
+			Line1:       a.Line1,
+			Line2:       a.Line2,
+			City:        a.City,
+			State:       a.State,
+			StateCode:   a.StateCode,
+			PostalCode:  a.PostalCode,
+			CountryCode: a.CountryCode,
+			IsDefault:   a.IsDefault,
+		})
+		if err != nil {
+			return nil, err
+		}
+		out.Addresses = append(out.Addresses, row)
+	}
+	for i, t := range p.TaxRegistrations {
+		row, err := qtx.CreatePartyTaxRegistration(ctx, db.CreatePartyTaxRegistrationParams{
+			PartyID:   saved.ID,
+			LineNo:    int16(i + 1),
+			TaxType:   t.TaxType,
+			Number:    t.Number,
+			StateCode: t.StateCode,
+		})
+		if err != nil {
+			return nil, err
+		}
+		out.TaxRegistrations = append(out.TaxRegistrations, row)
+	}
+	for i, b := range p.BankAccounts {
+		row, err := qtx.CreatePartyBankAccount(ctx, db.CreatePartyBankAccountParams{
+			PartyID:       saved.ID,
+			LineNo:        int16(i + 1),
+			AccountName:   b.AccountName,
+			AccountNumber: b.AccountNumber,
+			Ifsc:          b.Ifsc,
+			BankName:      b.BankName,
+			Branch:        b.Branch,
+			IsPrimary:     b.IsPrimary,
+		})
+		if err != nil {
+			return nil, err
+		}
+		out.BankAccounts = append(out.BankAccounts, row)
+	}
+	return out, nil
+}
@@ -27,6 +27,8 @@ func (r *BankAccountRepository) CreateBankAccount(ctx context.Context, ba db.Ban
 		LedgerAccountID: ba.LedgerAccountID,
 		CreatedBy:       ba.CreatedBy,
 		UpdatedBy:       ba.UpdatedBy,
+		CurrencyCode:    ba.CurrencyCode,
+		OrganizationID:  ba.OrganizationID,
 	}
 	b, err := r.queries.CreateBankAccount(ctx, params)
 	if err != nil {
@@ -51,6 +53,7 @@ func (r *BankAccountRepository) UpdateBankAccount(ctx context.Context, ba db.Ban
 		IfscOrSwift:     ba.IfscOrSwift,
 		LedgerAccountID: ba.LedgerAccountID,
 		UpdatedBy:       ba.UpdatedBy,
+		CurrencyCode:    ba.CurrencyCode,
 	}
 	b, err := r.queries.UpdateBankAccount(ctx, params)
 	if err != nil {
@@ -89,12 +92,15 @@ func NewPaymentDueRepository(q *db.Queries) *PaymentDueRepository {
 
 func (r *PaymentDueRepository) CreatePaymentDue(ctx context.Context, pd db.PaymentDue) (db.PaymentDue, error) {
 	params := db.CreatePaymentDueParams{
-		InvoiceID: pd.InvoiceID,
-		AmountDue: pd.AmountDue,
-		DueDate:   pd.DueDate,
-		Status:    pd.Status,
-		CreatedBy: pd.CreatedBy,
-		UpdatedBy: pd.UpdatedBy,
+		InvoiceID:    pd.InvoiceID,
+		AmountDue:    pd.AmountDue,
+		DueDate:      pd.DueDate,
+		Status:       pd.Status,
+		CreatedBy:    pd.CreatedBy,
+		UpdatedBy:    pd.UpdatedBy,
+		CurrencyCode: pd.CurrencyCode,
+		ExchangeRate: exchangeRateOrOne(pd.ExchangeRate),
+		AccountID:    pd.AccountID,
 	}
 	b, err := r.queries.CreatePaymentDue(ctx, params)
 	if err != nil {
@@ -113,12 +119,15 @@ func (r *PaymentDueRepository) GetPaymentDue(ctx context.Context, id uuid.UUID)
 
 func (r *PaymentDueRepository) UpdatePaymentDue(ctx context.Context, pd db.PaymentDue) (db.PaymentDue, error) {
 	params := db.UpdatePaymentDueParams{
-		ID:        pd.ID,
-		InvoiceID: pd.InvoiceID,
-		AmountDue: pd.AmountDue,
-		DueDate:   pd.DueDate,
-		Status:    pd.Status,
-		UpdatedBy: pd.UpdatedBy,
+		ID:           pd.ID,
+		InvoiceID:    pd.InvoiceID,
+		AmountDue:    pd.AmountDue,
+		DueDate:      pd.DueDate,
+		Status:       pd.Status,
+		UpdatedBy:    pd.UpdatedBy,
+		CurrencyCode: pd.CurrencyCode,
+		ExchangeRate: exchangeRateOrOne(pd.ExchangeRate),
+		AccountID:    pd.AccountID,
 	}
 	b, err := r.queries.UpdatePaymentDue(ctx, params)
 	if err != nil {
@@ -233,6 +242,8 @@ func dbBankAccountToDomain(b db.BankAccount) db.BankAccount {
 		UpdatedAt:       b.UpdatedAt,
 		UpdatedBy:       b.UpdatedBy,
 		Revision:        b.Revision,
+		CurrencyCode:    b.CurrencyCode,
+		OrganizationID:  b.OrganizationID,
 	}
 }
 
@@ -248,9 +259,21 @@ func dbPaymentDueToDomain(b db.PaymentDue) db.PaymentDue {
 		UpdatedAt:       b.UpdatedAt,
 		UpdatedBy:       b.UpdatedBy,
 		Revision:        b.Revision,
+		CurrencyCode:    b.CurrencyCode,
+		ExchangeRate:    b.ExchangeRate,
+		AccountID:       b.AccountID,
 	}
 }
 
+// exchangeRateOrOne defaults a due's booking rate: dues in the functional
+// currency, and those written before rates were recorded, are at par.
+func exchangeRateOrOne(rate string) string {
+	if rate == "" {
+		return "1"
+	}
+	return rate
+}
+
 func dbBankTransactionToDomain(b db.BankTransaction) db.BankTransaction {
 	return db.BankTransaction{
 		ID:                  b.ID,
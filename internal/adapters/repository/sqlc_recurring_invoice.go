@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+type RecurringInvoiceRepo struct {
+	db *sql.DB
+	q  *db.Queries
+}
+
+var _ ports.RecurringInvoiceRepository = (*RecurringInvoiceRepo)(nil)
+
+func NewRecurringInvoiceRepository(conn *sql.DB, q *db.Queries) ports.RecurringInvoiceRepository {
+	return &RecurringInvoiceRepo{db: conn, q: q}
+}
+
+func (r *RecurringInvoiceRepo) Create(ctx context.Context, ri db.RecurringInvoice) (db.RecurringInvoice, error) {
+	return r.q.CreateRecurringInvoice(ctx, db.CreateRecurringInvoiceParams{
+		OrganizationID:    ri.OrganizationID,
+		Name:              ri.Name,
+		TemplateInvoiceID: ri.TemplateInvoiceID,
+		Frequency:         ri.Frequency,
+		StartDate:         ri.StartDate,
+		EndDate:           ri.EndDate,
+		BillingDay:        ri.BillingDay,
+		Prorate:           ri.Prorate,
+		AutoIssue:         ri.AutoIssue,
+		Active:            ri.Active,
+		CreatedBy:         ri.CreatedBy,
+	})
+}
+
+func (r *RecurringInvoiceRepo) Get(ctx context.Context, id uuid.UUID) (db.RecurringInvoice, error) {
+	return r.q.GetRecurringInvoice(ctx, id)
+}
+
+func (r *RecurringInvoiceRepo) Update(ctx context.Context, ri db.RecurringInvoice) (db.RecurringInvoice, error) {
+	return r.q.UpdateRecurringInvoice(ctx, db.UpdateRecurringInvoiceParams{
+		ID:                ri.ID,
+		Name:              ri.Name,
+		TemplateInvoiceID: ri.TemplateInvoiceID,
+		Frequency:         ri.Frequency,
+		StartDate:         ri.StartDate,
+		EndDate:           ri.EndDate,
+		BillingDay:        ri.BillingDay,
+		Prorate:           ri.Prorate,
+		AutoIssue:         ri.AutoIssue,
+		Active:            ri.Active,
+		UpdatedBy:         ri.UpdatedBy,
+	})
+}
+
+func (r *RecurringInvoiceRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.q.DeleteRecurringInvoice(ctx, id)
+}
+
+func (r *RecurringInvoiceRepo) List(ctx context.Context, limit, offset int32) ([]db.RecurringInvoice, error) {
+	return r.q.ListRecurringInvoices(ctx, db.ListRecurringInvoicesParams{Limit: limit, Offset: offset})
+}
+
+func (r *RecurringInvoiceRepo) ListDue(ctx context.Context, asOf time.Time) ([]db.RecurringInvoice, error) {
+	return r.q.ListDueRecurringInvoices(ctx, asOf)
+}
+
+// CreateOccurrence claims the (recurring invoice, period) run and writes the
+// invoice in one transaction, so each period is billed at most once no
+// matter how often or how concurrently the generator runs.
+func (r *RecurringInvoiceRepo) CreateOccurrence(ctx context.Context, recurringID uuid.UUID, period ports.BillingPeriod, inv db.Invoice) (*db.Invoice, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	qtx := r.q.WithTx(tx)
+
+	claimed, err := qtx.ClaimRecurringInvoiceRun(ctx, db.ClaimRecurringInvoiceRunParams{
+		RecurringInvoiceID: recurringID,
+		PeriodStart:        period.Start,
+		PeriodEnd:          period.End,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if claimed == 0 {
+		return nil, nil
+	}
+
+	created, err := insertInvoice(ctx, qtx, inv)
+	if err != nil {
+		return nil, err
+	}
+	if err := qtx.SetRecurringInvoiceRunInvoice(ctx, db.SetRecurringInvoiceRunInvoiceParams{
+		RecurringInvoiceID: recurringID,
+		PeriodStart:        period.Start,
+		InvoiceID:          uuid.NullUUID{UUID: created.ID, Valid: true},
+	}); err != nil {
+		return nil, err
+	}
+	if err := qtx.AdvanceRecurringInvoice(ctx, db.AdvanceRecurringInvoiceParams{
+		ID:        recurringID,
+		PeriodEnd: period.End,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
@@ -0,0 +1,297 @@
+package ubl
+
+import (
+	"database/sql"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+var hundred = decimal.NewFromInt(100)
+
+// Export writes e as a PEPPOL BIS Billing 3.0 invoice. Documents that break
+// a rule Validate or one of checkers checks are not returned; the error is
+// then a *ValidationError listing every violation.
+func Export(e ports.EInvoice, checkers ...Checker) ([]byte, error) {
+	out, err := xml.MarshalIndent(fromInvoice(e), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode UBL invoice: %w", err)
+	}
+	out = append([]byte(xml.Header), out...)
+	violations, err := checkAll(out, checkers, Validate(out))
+	if err != nil {
+		return nil, fmt.Errorf("check UBL invoice: %w", err)
+	}
+	if len(violations) > 0 {
+		return nil, &ValidationError{Violations: violations}
+	}
+	return out, nil
+}
+
+// category is a VAT category with the lines taxed in it.
+type category struct {
+	id      string
+	percent decimal.Decimal
+	taxable decimal.Decimal
+	tax     decimal.Decimal
+}
+
+func (c *category) xml() taxCategory {
+	return taxCategory{ID: c.id, Percent: c.percent.String(), TaxScheme: taxScheme{ID: schemeVAT}}
+}
+
+// fromInvoice maps the invoice aggregate onto a UBL document with the
+// invoice's figures as stored. What UBL cannot express, such as an invoice
+// discount over lines of several VAT rates, is left for Validate to report.
+func fromInvoice(e ports.EInvoice) *invoiceDoc {
+	inv := e.Invoice
+	cur := inv.CurrencyCode
+	amt := func(s string) amount { return amount{CurrencyID: cur, Value: s} }
+	dec := func(s string) decimal.Decimal {
+		d, _ := decimal.NewFromString(strings.TrimSpace(s))
+		return d
+	}
+	doc := &invoiceDoc{
+		Xmlns:                   nsInvoice,
+		XmlnsCAC:                nsCAC,
+		XmlnsCBC:                nsCBC,
+		CustomizationID:         CustomizationID,
+		ProfileID:               ProfileID,
+		ID:                      inv.InvoiceNumber,
+		IssueDate:               inv.InvoiceDate.Format(dateLayout),
+		InvoiceTypeCode:         commercialInvoice,
+		DocumentCurrencyCode:    cur,
+		AccountingSupplierParty: partyRole{Party: toParty(e.Seller, true)},
+		AccountingCustomerParty: partyRole{Party: toParty(e.Buyer, false)},
+	}
+	if inv.DueDate.Valid {
+		doc.DueDate = inv.DueDate.Time.Format(dateLayout)
+	}
+	if inv.PoNumber.Valid && inv.PoNumber.String != "" {
+		doc.BuyerReference = inv.PoNumber.String
+		doc.OrderReference = &orderReference{ID: inv.PoNumber.String}
+	}
+	if inv.DeliveryDate.Valid {
+		doc.Delivery = &delivery{ActualDeliveryDate: inv.DeliveryDate.Time.Format(dateLayout)}
+	}
+	if terms := strings.TrimSpace(e.PaymentTerms); terms != "" {
+		doc.PaymentTerms = &paymentTerms{Note: terms}
+	}
+
+	invoiceRate := decimal.Zero
+	for _, t := range inv.Taxes {
+		invoiceRate = invoiceRate.Add(dec(strings.TrimSuffix(t.Rate, "%")))
+	}
+
+	var cats []*category
+	categoryOf := func(percent decimal.Decimal) *category {
+		for _, c := range cats {
+			if c.percent.Equal(percent) {
+				return c
+			}
+		}
+		c := &category{id: categoryZero, percent: percent}
+		if percent.IsPositive() {
+			c.id = categoryStandard
+		}
+		cats = append(cats, c)
+		return c
+	}
+
+	lineNet := decimal.Zero
+	for i, it := range inv.Items {
+		percent := invoiceRate
+		itemTax := decimal.Zero
+		for _, t := range it.Taxes {
+			percent = percent.Add(dec(strings.TrimSuffix(t.Rate, "%")))
+			itemTax = itemTax.Add(dec(t.Amount))
+		}
+		c := categoryOf(percent)
+
+		line := invoiceLine{
+			ID:               fmt.Sprint(i + 1),
+			InvoicedQuantity: quantity{UnitCode: unitOne, Value: fmt.Sprint(it.Quantity)},
+			Item: item{
+				Description:           it.Description.String,
+				Name:                  it.Name,
+				ClassifiedTaxCategory: c.xml(),
+			},
+			Price: price{PriceAmount: amt(it.UnitPrice)},
+		}
+		if it.Hsn.Valid && it.Hsn.String != "" {
+			line.Item.CommodityClassification = []commodityClassification{{
+				ItemClassificationCode: classificationCode{ListID: listHS, Value: it.Hsn.String},
+			}}
+		}
+		net := dec(it.LineSubtotal)
+		for _, d := range it.Discounts {
+			line.AllowanceCharges = append(line.AllowanceCharges, allowanceCharge{
+				ChargeIndicator:       "false",
+				AllowanceChargeReason: discountReason(d.Description),
+				Amount:                amt(d.Amount),
+			})
+			net = net.Sub(dec(d.Amount))
+		}
+		line.LineExtensionAmount = amt(net.StringFixed(places(it.LineSubtotal)))
+		doc.InvoiceLines = append(doc.InvoiceLines, line)
+
+		c.taxable = c.taxable.Add(net)
+		c.tax = c.tax.Add(itemTax)
+		lineNet = lineNet.Add(net)
+	}
+
+	scale := places(inv.GrandTotal)
+	allowances := decimal.Zero
+	for _, d := range inv.Discounts {
+		ac := allowanceCharge{
+			ChargeIndicator:       "false",
+			AllowanceChargeReason: discountReason(d.Description),
+			Amount:                amt(d.Amount),
+		}
+		if len(cats) == 1 {
+			tc := cats[0].xml()
+			ac.TaxCategory = &tc
+			cats[0].taxable = cats[0].taxable.Sub(dec(d.Amount))
+		}
+		doc.AllowanceCharges = append(doc.AllowanceCharges, ac)
+		allowances = allowances.Add(dec(d.Amount))
+	}
+
+	// Invoice-level taxes are shared out over the categories by taxable
+	// amount, the last taking what rounding leaves.
+	invoiceTax := decimal.Zero
+	for _, t := range inv.Taxes {
+		invoiceTax = invoiceTax.Add(dec(t.Amount))
+	}
+	left := invoiceTax
+	for i, c := range cats {
+		share := left
+		if i < len(cats)-1 {
+			share = c.taxable.Mul(invoiceRate).Div(hundred).Round(scale)
+			left = left.Sub(share)
+		}
+		c.tax = c.tax.Add(share)
+	}
+
+	total := taxTotal{TaxAmount: amt(inv.TaxTotal)}
+	for _, c := range cats {
+		total.TaxSubtotals = append(total.TaxSubtotals, taxSubtotal{
+			TaxableAmount: amt(c.taxable.StringFixed(scale)),
+			TaxAmount:     amt(c.tax.StringFixed(scale)),
+			TaxCategory:   c.xml(),
+		})
+	}
+	doc.TaxTotals = []taxTotal{total}
+
+	exclusive := lineNet.Sub(allowances)
+	doc.LegalMonetaryTotal = monetaryTotal{
+		LineExtensionAmount: amt(lineNet.StringFixed(scale)),
+		TaxExclusiveAmount:  amt(exclusive.StringFixed(scale)),
+		TaxInclusiveAmount:  amt(exclusive.Add(dec(inv.TaxTotal)).StringFixed(scale)),
+		PayableAmount:       amt(inv.GrandTotal),
+	}
+	if len(inv.Discounts) > 0 {
+		a := amt(allowances.StringFixed(scale))
+		doc.LegalMonetaryTotal.AllowanceTotalAmount = &a
+	}
+	if ro := dec(inv.RoundOff); !ro.IsZero() {
+		a := amt(inv.RoundOff)
+		doc.LegalMonetaryTotal.PayableRoundingAmount = &a
+	}
+	return doc
+}
+
+// toParty describes p as a supplier or customer. Its GSTIN is its VAT
+// identifier; a supplier also gives its first other tax registration.
+func toParty(p ports.Party, supplier bool) party {
+	out := party{}
+	if e := p.PeppolEndpoint.String; e != "" {
+		scheme, id, _ := strings.Cut(e, ":")
+		out.EndpointID = &identifier{SchemeID: scheme, Value: id}
+	}
+	if p.Name != "" {
+		out.PartyName = &partyName{Name: p.Name}
+	}
+	if a, ok := billingAddress(p.Addresses); ok {
+		out.PostalAddress = &postalAddress{
+			StreetName:           a.Line1,
+			AdditionalStreetName: a.Line2.String,
+			CityName:             a.City.String,
+			PostalZone:           a.PostalCode.String,
+			CountrySubentity:     a.State.String,
+			Country:              country{IdentificationCode: a.CountryCode},
+		}
+	}
+	var other bool
+	for _, r := range p.TaxRegistrations {
+		switch {
+		case r.TaxType == taxGSTIN:
+			out.PartyTaxSchemes = append(out.PartyTaxSchemes, partyTaxScheme{CompanyID: r.Number, TaxScheme: taxScheme{ID: schemeVAT}})
+		case supplier && !other:
+			out.PartyTaxSchemes = append(out.PartyTaxSchemes, partyTaxScheme{CompanyID: r.Number, TaxScheme: taxScheme{ID: r.TaxType}})
+			other = true
+		}
+	}
+	name := p.Name
+	if p.LegalName.Valid && p.LegalName.String != "" {
+		name = p.LegalName.String
+	}
+	if name != "" {
+		out.PartyLegalEntity = &partyLegalEntity{RegistrationName: name}
+	}
+	if c, ok := primaryContact(p.Contacts); ok {
+		out.Contact = &contact{Name: c.Name, Telephone: c.Phone.String, ElectronicMail: c.Email.String}
+	}
+	return out
+}
+
+func billingAddress(addrs []db.PartyAddress) (db.PartyAddress, bool) {
+	for _, a := range addrs {
+		if a.Kind == addressBilling && a.IsDefault {
+			return a, true
+		}
+	}
+	for _, a := range addrs {
+		if a.Kind == addressBilling {
+			return a, true
+		}
+	}
+	if len(addrs) > 0 {
+		return addrs[0], true
+	}
+	return db.PartyAddress{}, false
+}
+
+func primaryContact(contacts []db.PartyContact) (db.PartyContact, bool) {
+	for _, c := range contacts {
+		if c.IsPrimary {
+			return c, true
+		}
+	}
+	if len(contacts) > 0 {
+		return contacts[0], true
+	}
+	return db.PartyContact{}, false
+}
+
+// discountReason is the reason an allowance is given; BR-33 and BR-42
+// require one.
+func discountReason(desc sql.NullString) string {
+	if s := strings.TrimSpace(desc.String); s != "" {
+		return s
+	}
+	return "Discount"
+}
+
+// places is the number of decimal places s is written with.
+func places(s string) int32 {
+	if _, frac, ok := strings.Cut(strings.TrimSpace(s), "."); ok {
+		return int32(len(frac))
+	}
+	return 0
+}
@@ -0,0 +1,313 @@
+package ubl
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+// Parse reads a UBL 2.1 invoice. A document that is not a UBL invoice, or
+// breaks a rule Validate or one of checkers checks, is reported as a
+// *ValidationError; otherwise the invoice comes back with its lines,
+// discounts and taxes and the figures the sender stated, and with its
+// seller and buyer.
+func Parse(data []byte, checkers ...Checker) (ports.EInvoice, error) {
+	doc, err := decode(data)
+	if err != nil {
+		return ports.EInvoice{}, &ValidationError{Violations: []ports.EInvoiceViolation{{Path: "Invoice", Rule: "UBL", Reason: err.Error()}}}
+	}
+	violations, err := checkAll(data, checkers, check(doc))
+	if err != nil {
+		return ports.EInvoice{}, fmt.Errorf("check UBL invoice: %w", err)
+	}
+	e, more := toInvoice(doc)
+	if violations = append(violations, more...); len(violations) > 0 {
+		return ports.EInvoice{}, &ValidationError{Violations: violations}
+	}
+	return e, nil
+}
+
+// decode unmarshals a UBL invoice whatever namespace prefixes it uses.
+func decode(data []byte) (*invoiceDoc, error) {
+	d := xml.NewTokenDecoder(&prefixer{d: xml.NewDecoder(bytes.NewReader(data))})
+	var doc invoiceDoc
+	if err := d.Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("document is empty")
+		}
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// prefixer names elements of the UBL namespaces as invoiceDoc's tags do,
+// "cbc:ID" for the CommonBasicComponents ID whatever prefix the document
+// binds, and drops the namespace declarations so that the names are kept.
+type prefixer struct {
+	d    *xml.Decoder
+	root bool
+}
+
+func (p *prefixer) Token() (xml.Token, error) {
+	t, err := p.d.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok := t.(type) {
+	case xml.StartElement:
+		if !p.root {
+			p.root = true
+			if tok.Name.Space != nsInvoice || tok.Name.Local != "Invoice" {
+				return nil, fmt.Errorf("root element is {%s}%s, not a UBL 2.1 Invoice", tok.Name.Space, tok.Name.Local)
+			}
+		}
+		tok.Name = p.rename(tok.Name)
+		attrs := tok.Attr[:0:0]
+		for _, a := range tok.Attr {
+			if a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns") {
+				continue
+			}
+			attrs = append(attrs, a)
+		}
+		tok.Attr = attrs
+		return tok, nil
+	case xml.EndElement:
+		tok.Name = p.rename(tok.Name)
+		return tok, nil
+	}
+	return t, nil
+}
+
+func (p *prefixer) rename(n xml.Name) xml.Name {
+	switch n.Space {
+	case nsInvoice:
+		return xml.Name{Local: n.Local}
+	case nsCAC:
+		return xml.Name{Local: "cac:" + n.Local}
+	case nsCBC:
+		return xml.Name{Local: "cbc:" + n.Local}
+	}
+	// Extensions and other vocabularies keep a name no field matches.
+	return xml.Name{Local: "{" + n.Space + "}" + n.Local}
+}
+
+// toInvoice maps a checked document onto the invoice aggregate. Violations
+// are UBL constructs the invoice model has no place for.
+func toInvoice(doc *invoiceDoc) (ports.EInvoice, []ports.EInvoiceViolation) {
+	var violations []ports.EInvoiceViolation
+	unsupported := func(path, reason string) {
+		violations = append(violations, ports.EInvoiceViolation{Path: path, Rule: "UNSUPPORTED", Reason: reason})
+	}
+
+	inv := db.Invoice{
+		InvoiceNumber: strings.TrimSpace(doc.ID),
+		CurrencyCode:  strings.TrimSpace(doc.DocumentCurrencyCode),
+	}
+	inv.InvoiceDate, _ = time.Parse(dateLayout, strings.TrimSpace(doc.IssueDate))
+	if d, err := time.Parse(dateLayout, strings.TrimSpace(doc.DueDate)); err == nil {
+		inv.DueDate = sql.NullTime{Time: d, Valid: true}
+	}
+	if doc.Delivery != nil {
+		if d, err := time.Parse(dateLayout, strings.TrimSpace(doc.Delivery.ActualDeliveryDate)); err == nil {
+			inv.DeliveryDate = sql.NullTime{Time: d, Valid: true}
+		}
+	}
+	if doc.OrderReference != nil && strings.TrimSpace(doc.OrderReference.ID) != "" {
+		inv.PoNumber = nullString(doc.OrderReference.ID)
+	} else {
+		inv.PoNumber = nullString(doc.BuyerReference)
+	}
+
+	// A VAT rate every line shares is an invoice-level tax, so that
+	// document-level allowances reduce it as they do in UBL. Otherwise each
+	// line carries its own rate.
+	common, shared := "", true
+	for i, l := range doc.InvoiceLines {
+		p := percentOf(l.Item.ClassifiedTaxCategory)
+		if i == 0 {
+			common = p
+		} else if !decEqual(p, common) {
+			shared = false
+		}
+	}
+	if !shared && len(doc.AllowanceCharges) > 0 {
+		unsupported("Invoice/cac:AllowanceCharge", "document level allowances are only supported when every line has the same VAT rate")
+	}
+
+	for i, l := range doc.InvoiceLines {
+		path := fmt.Sprintf("Invoice/cac:InvoiceLine[%d]", i+1)
+		qty, err := decimal.NewFromString(strings.TrimSpace(l.InvoicedQuantity.Value))
+		if err == nil && (!qty.IsInteger() || !qty.IsPositive() || qty.GreaterThan(decimal.NewFromInt32(1<<31-1))) {
+			unsupported(path+"/cbc:InvoicedQuantity", "quantities must be positive whole numbers")
+		}
+		if bq := l.Price.BaseQuantity; bq != nil {
+			if b, err := decimal.NewFromString(strings.TrimSpace(bq.Value)); err == nil && !b.Equal(decimal.NewFromInt(1)) {
+				unsupported(path+"/cac:Price/cbc:BaseQuantity", "prices must be per single unit")
+			}
+		}
+
+		it := db.InvoiceItem{
+			Name:        strings.TrimSpace(l.Item.Name),
+			Description: nullString(l.Item.Description),
+			Quantity:    int32(qty.IntPart()),
+			UnitPrice:   strings.TrimSpace(l.Price.PriceAmount.Value),
+			LineNo:      int32(i + 1),
+		}
+		for _, c := range l.Item.CommodityClassification {
+			if c.ItemClassificationCode.ListID == listHS {
+				it.Hsn = nullString(c.ItemClassificationCode.Value)
+				break
+			}
+		}
+		for j, ac := range l.AllowanceCharges {
+			if isCharge(ac) {
+				unsupported(fmt.Sprintf("%s/cac:AllowanceCharge[%d]", path, j+1), "line charges are not supported")
+				continue
+			}
+			it.Discounts = append(it.Discounts, db.InvoiceItemDiscount{
+				Description: nullString(ac.AllowanceChargeReason),
+				Amount:      strings.TrimSpace(ac.Amount.Value),
+				LineNo:      int32(len(it.Discounts) + 1),
+			})
+		}
+		if !shared {
+			it.Taxes = []db.InvoiceItemTax{{Name: schemeVAT, Rate: percentOf(l.Item.ClassifiedTaxCategory), LineNo: 1}}
+		}
+		inv.Items = append(inv.Items, it)
+	}
+	if shared && len(doc.InvoiceLines) > 0 {
+		inv.Taxes = []db.InvoiceTax{{Name: schemeVAT, Rate: common, LineNo: 1}}
+	}
+
+	for i, ac := range doc.AllowanceCharges {
+		if isCharge(ac) {
+			unsupported(fmt.Sprintf("Invoice/cac:AllowanceCharge[%d]", i+1), "document level charges are not supported")
+			continue
+		}
+		inv.Discounts = append(inv.Discounts, db.InvoiceDiscount{
+			Description: nullString(ac.AllowanceChargeReason),
+			Amount:      strings.TrimSpace(ac.Amount.Value),
+			LineNo:      int32(len(inv.Discounts) + 1),
+		})
+	}
+
+	// The stated figures are kept for the invoice service to check against
+	// those it computes.
+	m := doc.LegalMonetaryTotal
+	if m.PrepaidAmount != nil {
+		if p, err := decimal.NewFromString(strings.TrimSpace(m.PrepaidAmount.Value)); err == nil && !p.IsZero() {
+			unsupported("Invoice/cac:LegalMonetaryTotal/cbc:PrepaidAmount", "prepaid amounts are not supported")
+		}
+	}
+	inv.GrandTotal = strings.TrimSpace(m.PayableAmount.Value)
+	if len(doc.TaxTotals) > 0 {
+		inv.TaxTotal = strings.TrimSpace(doc.TaxTotals[0].TaxAmount.Value)
+	}
+
+	return ports.EInvoice{
+		Invoice: inv,
+		Seller:  fromParty(doc.AccountingSupplierParty.Party),
+		Buyer:   fromParty(doc.AccountingCustomerParty.Party),
+	}, violations
+}
+
+// fromParty reads a party back as toParty writes it. Its VAT identifier is
+// registered as a GSTIN when the party is in India and under the
+// identifier's own scheme otherwise.
+func fromParty(p party) ports.Party {
+	out := ports.Party{}
+	if p.PartyName != nil {
+		out.Name = strings.TrimSpace(p.PartyName.Name)
+	}
+	if p.PartyLegalEntity != nil {
+		legal := strings.TrimSpace(p.PartyLegalEntity.RegistrationName)
+		if out.Name == "" {
+			out.Name = legal
+		} else if legal != out.Name {
+			out.LegalName = nullString(legal)
+		}
+	}
+	if p.EndpointID != nil && strings.TrimSpace(p.EndpointID.Value) != "" {
+		out.PeppolEndpoint = nullString(strings.TrimSpace(p.EndpointID.SchemeID) + ":" + strings.TrimSpace(p.EndpointID.Value))
+	}
+	countryCode := ""
+	if a := p.PostalAddress; a != nil {
+		countryCode = strings.ToUpper(strings.TrimSpace(a.Country.IdentificationCode))
+	}
+	// Parties need a first address line; the city stands in for a missing
+	// street.
+	if a := p.PostalAddress; a != nil && strings.TrimSpace(a.StreetName+a.CityName) != "" {
+		line1 := strings.TrimSpace(a.StreetName)
+		if line1 == "" {
+			line1 = strings.TrimSpace(a.CityName)
+		}
+		out.Addresses = []db.PartyAddress{{
+			LineNo:      1,
+			Kind:        addressBilling,
+			Line1:       line1,
+			Line2:       nullString(a.AdditionalStreetName),
+			City:        nullString(a.CityName),
+			State:       nullString(a.CountrySubentity),
+			PostalCode:  nullString(a.PostalZone),
+			CountryCode: countryCode,
+			IsDefault:   true,
+		}}
+	}
+	for _, s := range p.PartyTaxSchemes {
+		number := strings.TrimSpace(s.CompanyID)
+		if number == "" {
+			continue
+		}
+		taxType := strings.ToUpper(strings.TrimSpace(s.TaxScheme.ID))
+		if taxType == schemeVAT && countryCode == "IN" {
+			taxType = taxGSTIN
+		}
+		out.TaxRegistrations = append(out.TaxRegistrations, db.PartyTaxRegistration{
+			LineNo:  int16(len(out.TaxRegistrations) + 1),
+			TaxType: taxType,
+			Number:  number,
+		})
+	}
+	if c := p.Contact; c != nil && strings.TrimSpace(c.Name) != "" {
+		out.Contacts = []db.PartyContact{{
+			LineNo:    1,
+			Name:      strings.TrimSpace(c.Name),
+			Email:     nullString(c.ElectronicMail),
+			Phone:     nullString(c.Telephone),
+			IsPrimary: true,
+		}}
+	}
+	return out
+}
+
+func isCharge(ac allowanceCharge) bool {
+	return strings.TrimSpace(ac.ChargeIndicator) == "true"
+}
+
+func percentOf(c taxCategory) string {
+	if p := strings.TrimSpace(c.Percent); p != "" {
+		return p
+	}
+	return "0"
+}
+
+func decEqual(a, b string) bool {
+	x, errX := decimal.NewFromString(a)
+	y, errY := decimal.NewFromString(b)
+	return errX == nil && errY == nil && x.Equal(y)
+}
+
+func nullString(s string) sql.NullString {
+	s = strings.TrimSpace(s)
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,333 @@
+package ubl
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+// A Checker validates a serialized invoice against rules kept outside this
+// package, such as the UBL 2.1 XSD or the PEPPOL BIS Schematron. An error
+// means the check could not run, not that the document is invalid.
+type Checker interface {
+	Check(data []byte) ([]ports.EInvoiceViolation, error)
+}
+
+// The files LoadSchemas expects under its directory: the xsd tree of the
+// OASIS UBL 2.1 distribution and the PEPPOL BIS Billing 3.0 Schematron
+// compiled to XSLT, as the OpenPEPPOL release ships it.
+const (
+	InvoiceXSD       = "ubl-2.1/xsd/maindoc/UBL-Invoice-2.1.xsd"
+	PeppolSchematron = "peppol-bis-3/PEPPOL-EN16931-UBL.xslt"
+)
+
+// LoadSchemas returns the checkers for the schemas installed under dir. The
+// compiled Schematron is XSLT 2.0, so it runs through xslt, a command line
+// for an XSLT 2.0 processor such as Saxon in which "{xsl}" stands for the
+// stylesheet and "{}" for the document, e.g.
+// "java -jar saxon-he.jar -xsl:{xsl} -s:{}". Missing files are an error.
+func LoadSchemas(dir, xslt string) ([]Checker, error) {
+	xsd := filepath.Join(dir, filepath.FromSlash(InvoiceXSD))
+	sch := filepath.Join(dir, filepath.FromSlash(PeppolSchematron))
+	for _, f := range []string{xsd, sch} {
+		if _, err := os.Stat(f); err != nil {
+			return nil, fmt.Errorf("load e-invoice schemas: %w", err)
+		}
+	}
+	args := strings.Fields(xslt)
+	if len(args) == 0 {
+		return nil, errors.New("load e-invoice schemas: an XSLT 2.0 processor is required for the Schematron")
+	}
+	for i, a := range args {
+		args[i] = strings.ReplaceAll(a, "{xsl}", sch)
+	}
+	return []Checker{NewXSD(xsd), NewSchematron(args...)}, nil
+}
+
+// Unavailable is the checker for schemas that could not be loaded: it
+// fails every check with err, so that no document is exchanged unchecked.
+func Unavailable(err error) Checker {
+	return unavailable{err: err}
+}
+
+type unavailable struct {
+	err error
+}
+
+func (u unavailable) Check([]byte) ([]ports.EInvoiceViolation, error) {
+	return nil, u.err
+}
+
+// XSD validates documents against an XML schema with xmllint.
+type XSD struct {
+	path string
+}
+
+func NewXSD(path string) *XSD {
+	return &XSD{path: path}
+}
+
+// xmllintError is one error xmllint reports for a document read from
+// standard input, e.g. "-:12: element InvoicedQuantity: Schemas validity
+// error : Element '...': ...".
+var xmllintError = regexp.MustCompile(`^-:(\d+): (?:element (\S+): )?(.*?) : (.*)$`)
+
+// Check reports each schema error under the element it is raised on, with
+// rule "UBL".
+func (x *XSD) Check(data []byte) ([]ports.EInvoiceViolation, error) {
+	cmd := exec.Command("xmllint", "--noout", "--nonet", "--schema", x.path, "-")
+	cmd.Stdin = bytes.NewReader(data)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	var exit *exec.ExitError
+	switch {
+	case err == nil:
+		return nil, nil
+	case !errors.As(err, &exit) || (exit.ExitCode() != 1 && exit.ExitCode() != 3):
+		// 1 is a document that is not well formed and 3 one that is not
+		// valid; anything else is xmllint or the schema failing.
+		return nil, fmt.Errorf("xmllint %s: %v: %s", x.path, err, strings.TrimSpace(stderr.String()))
+	}
+	idx := indexDocument(data)
+	var violations []ports.EInvoiceViolation
+	for _, line := range strings.Split(stderr.String(), "\n") {
+		m := xmllintError.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		n, _ := strconv.Atoi(m[1])
+		violations = append(violations, ports.EInvoiceViolation{Path: idx.at(n, m[2]), Rule: "UBL", Reason: m[4]})
+	}
+	if len(violations) == 0 {
+		return nil, fmt.Errorf("xmllint %s: %v: %s", x.path, err, strings.TrimSpace(stderr.String()))
+	}
+	return violations, nil
+}
+
+// Schematron runs a Schematron compiled to XSLT and reports its fatal
+// assertions. Warnings do not make a document invalid and are dropped.
+type Schematron struct {
+	args []string
+}
+
+// NewSchematron takes the command line that applies the compiled
+// Schematron to the document at "{}" and writes SVRL to standard output.
+func NewSchematron(args ...string) *Schematron {
+	return &Schematron{args: args}
+}
+
+// svrl is the part of a Schematron Validation Report the checks read.
+type svrl struct {
+	FailedAsserts []struct {
+		ID       string `xml:"id,attr"`
+		Flag     string `xml:"flag,attr"`
+		Location string `xml:"location,attr"`
+		Text     string `xml:"text"`
+	} `xml:"failed-assert"`
+}
+
+// Check reports each failed assertion under the element it locates, with
+// the assertion's rule ID.
+func (s *Schematron) Check(data []byte) ([]ports.EInvoiceViolation, error) {
+	if len(s.args) == 0 {
+		return nil, errors.New("schematron: no XSLT processor")
+	}
+	f, err := os.CreateTemp("", "einvoice-*.xml")
+	if err != nil {
+		return nil, fmt.Errorf("schematron: %w", err)
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("schematron: %w", err)
+	}
+
+	args := make([]string, len(s.args))
+	for i, a := range s.args {
+		args[i] = strings.ReplaceAll(a, "{}", f.Name())
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("schematron %s: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	var report svrl
+	if err := xml.Unmarshal(stdout.Bytes(), &report); err != nil {
+		return nil, fmt.Errorf("schematron %s: read report: %w", args[0], err)
+	}
+
+	idx := indexDocument(data)
+	var violations []ports.EInvoiceViolation
+	for _, a := range report.FailedAsserts {
+		if a.Flag != "" && a.Flag != "fatal" {
+			continue
+		}
+		violations = append(violations, ports.EInvoiceViolation{
+			Path:   idx.locate(a.Location),
+			Rule:   a.ID,
+			Reason: strings.Join(strings.Fields(a.Text), " "),
+		})
+	}
+	return violations, nil
+}
+
+// docIndex names the elements of a document as Validate does:
+// "Invoice/cac:InvoiceLine[2]/cbc:ID", with a position where an element
+// may repeat or has siblings of the same name.
+type docIndex struct {
+	nodes []*docNode
+	// full maps the path with every position written out, as Schematron
+	// locations give it, to the element.
+	full map[string]*docNode
+}
+
+type docNode struct {
+	name   string
+	pos    int
+	line   int
+	parent *docNode
+	counts map[string]int
+}
+
+// repeated are the elements Validate always gives a position.
+var repeated = map[string]bool{
+	"cac:InvoiceLine": true, "cac:AllowanceCharge": true, "cac:TaxTotal": true,
+	"cac:TaxSubtotal": true, "cac:PartyTaxScheme": true,
+}
+
+func (n *docNode) path() string {
+	if n.parent == nil {
+		return n.name
+	}
+	step := n.name
+	if repeated[n.name] || n.parent.counts[n.name] > 1 {
+		step = fmt.Sprintf("%s[%d]", n.name, n.pos)
+	}
+	return n.parent.path() + "/" + step
+}
+
+// indexDocument records every element of data with the line it starts on.
+// A document that is not well formed is indexed as far as it can be read.
+func indexDocument(data []byte) *docIndex {
+	idx := &docIndex{full: map[string]*docNode{}}
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var (
+		stack []*docNode
+		full  []string
+		p     prefixer
+	)
+	for {
+		line, _ := d.InputPos()
+		t, err := d.Token()
+		if err != nil {
+			break
+		}
+		switch tok := t.(type) {
+		case xml.StartElement:
+			n := &docNode{name: p.rename(tok.Name).Local, pos: 1, line: line, counts: map[string]int{}}
+			key := n.name
+			if len(stack) > 0 {
+				n.parent = stack[len(stack)-1]
+				n.parent.counts[n.name]++
+				n.pos = n.parent.counts[n.name]
+				key = fmt.Sprintf("%s/%s[%d]", full[len(full)-1], n.name, n.pos)
+			}
+			idx.nodes = append(idx.nodes, n)
+			idx.full[key] = n
+			stack, full = append(stack, n), append(full, key)
+		case xml.EndElement:
+			if len(stack) > 0 {
+				stack, full = stack[:len(stack)-1], full[:len(full)-1]
+			}
+		}
+	}
+	return idx
+}
+
+// at names the element local starting on line, or the first element on
+// that line when none matches.
+func (idx *docIndex) at(line int, local string) string {
+	var first *docNode
+	for _, n := range idx.nodes {
+		if n.line != line {
+			continue
+		}
+		if first == nil {
+			first = n
+		}
+		if _, name, _ := strings.Cut(n.name, ":"); name == local || n.name == local {
+			return n.path()
+		}
+	}
+	if first != nil {
+		return first.path()
+	}
+	return fmt.Sprintf("Invoice (line %d)", line)
+}
+
+// locationStep is one step of an SVRL location, e.g.
+// "*:InvoiceLine[namespace-uri()='urn:...'][2]".
+var locationStep = regexp.MustCompile(`\*:([\w.-]+)\[namespace-uri\(\)='([^']*)'\](?:\[(\d+)\])?`)
+
+// locate names the element an SVRL location points at. Locations that do
+// not resolve, such as attributes or the document itself, fall back to the
+// nearest element.
+func (idx *docIndex) locate(location string) string {
+	var p prefixer
+	key, best := "", "Invoice"
+	for i, m := range locationStep.FindAllStringSubmatch(location, -1) {
+		name := p.rename(xml.Name{Space: m[2], Local: m[1]}).Local
+		if i == 0 {
+			key = name
+		} else {
+			pos := m[3]
+			if pos == "" {
+				pos = "1"
+			}
+			key = fmt.Sprintf("%s/%s[%s]", key, name, pos)
+		}
+		n, ok := idx.full[key]
+		if !ok {
+			break
+		}
+		best = n.path()
+	}
+	return best
+}
+
+// checkAll runs every checker over data, dropping what an earlier check
+// already reported under the same element and rule.
+func checkAll(data []byte, checkers []Checker, violations []ports.EInvoiceViolation) ([]ports.EInvoiceViolation, error) {
+	type key struct{ path, rule string }
+	seen := map[key]bool{}
+	for _, v := range violations {
+		seen[key{v.Path, v.Rule}] = true
+	}
+	for _, c := range checkers {
+		more, err := c.Check(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range more {
+			if k := (key{v.Path, v.Rule}); !seen[k] {
+				seen[k] = true
+				violations = append(violations, v)
+			}
+		}
+	}
+	return violations, nil
+}
@@ -0,0 +1,252 @@
+// Package ubl writes and reads invoices as UBL 2.1 XML under the PEPPOL BIS
+// Billing 3.0 profile.
+//
+// UBL carries one VAT category per line, so a line's GST is exported as
+// the sum of its tax rates and the invoice-level tax rates: standard rated
+// ("S") when positive, zero rated ("Z") otherwise. Item discounts are line
+// allowances and invoice discounts document-level allowances. Imports map
+// back the other way, except that a VAT rate common to every line becomes
+// an invoice-level tax, which is how document-level allowances reduce the
+// tax in UBL.
+//
+// Both directions check documents with Validate, which covers the UBL 2.1
+// schema constraints and the EN 16931 and PEPPOL business rules on the
+// elements the mapping uses, and with the Checkers they are given, which run
+// the UBL 2.1 XSD and the PEPPOL BIS Schematron over the whole document.
+// Every broken rule is reported with the element it concerns.
+package ubl
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+// ContentType is the media type of exported e-invoices.
+const ContentType = "application/xml"
+
+// The PEPPOL BIS Billing 3.0 specification and business process.
+const (
+	CustomizationID = "urn:cen.eu:en16931:2017#compliant#urn:fdc:peppol.eu:2017:poacc:billing:3.0"
+	ProfileID       = "urn:fdc:peppol.eu:2017:poacc:billing:01:1.0"
+)
+
+// UBL 2.1 namespaces.
+const (
+	nsInvoice = "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	nsCAC     = "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	nsCBC     = "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+)
+
+const (
+	dateLayout        = "2006-01-02"
+	commercialInvoice = "380" // UNTDID 1001
+	unitOne           = "C62" // UN/ECE Rec 20: one
+	schemeVAT         = "VAT"
+	categoryStandard  = "S" // UNTDID 5305
+	categoryZero      = "Z"
+	listHS            = "HS" // UNTDID 7143: Harmonized System, of which HSN codes are an extension
+)
+
+// Party values shared with the services package.
+const (
+	addressBilling = "BILLING"
+	taxGSTIN       = "GSTIN"
+)
+
+// ValidationError lists every rule a document breaks.
+type ValidationError struct {
+	Violations []ports.EInvoiceViolation
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = fmt.Sprintf("%s [%s]: %s", v.Path, v.Rule, v.Reason)
+	}
+	return "e-invoice is not valid UBL/PEPPOL: " + strings.Join(parts, "; ")
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// FileName is the name an exported invoice is offered under.
+func FileName(inv db.Invoice) string {
+	name := strings.Trim(unsafeFileChars.ReplaceAllString(inv.InvoiceNumber, "-"), "-")
+	if name == "" {
+		name = "invoice-" + inv.ID.String()
+	}
+	return name + ".xml"
+}
+
+// The document types below hold the subset of UBL 2.1 the mapping uses, in
+// schema order. Elements are tagged with the cac and cbc prefixes; Parse
+// renames inbound elements to match whatever prefixes the sender chose.
+
+type invoiceDoc struct {
+	XMLName                 xml.Name          `xml:"Invoice"`
+	Xmlns                   string            `xml:"xmlns,attr,omitempty"`
+	XmlnsCAC                string            `xml:"xmlns:cac,attr,omitempty"`
+	XmlnsCBC                string            `xml:"xmlns:cbc,attr,omitempty"`
+	CustomizationID         string            `xml:"cbc:CustomizationID"`
+	ProfileID               string            `xml:"cbc:ProfileID"`
+	ID                      string            `xml:"cbc:ID"`
+	IssueDate               string            `xml:"cbc:IssueDate"`
+	DueDate                 string            `xml:"cbc:DueDate,omitempty"`
+	InvoiceTypeCode         string            `xml:"cbc:InvoiceTypeCode"`
+	DocumentCurrencyCode    string            `xml:"cbc:DocumentCurrencyCode"`
+	BuyerReference          string            `xml:"cbc:BuyerReference,omitempty"`
+	OrderReference          *orderReference   `xml:"cac:OrderReference"`
+	AccountingSupplierParty partyRole         `xml:"cac:AccountingSupplierParty"`
+	AccountingCustomerParty partyRole         `xml:"cac:AccountingCustomerParty"`
+	Delivery                *delivery         `xml:"cac:Delivery"`
+	PaymentTerms            *paymentTerms     `xml:"cac:PaymentTerms"`
+	AllowanceCharges        []allowanceCharge `xml:"cac:AllowanceCharge"`
+	TaxTotals               []taxTotal        `xml:"cac:TaxTotal"`
+	LegalMonetaryTotal      monetaryTotal     `xml:"cac:LegalMonetaryTotal"`
+	InvoiceLines            []invoiceLine     `xml:"cac:InvoiceLine"`
+}
+
+type amount struct {
+	CurrencyID string `xml:"currencyID,attr"`
+	Value      string `xml:",chardata"`
+}
+
+type quantity struct {
+	UnitCode string `xml:"unitCode,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type identifier struct {
+	SchemeID string `xml:"schemeID,attr,omitempty"`
+	Value    string `xml:",chardata"`
+}
+
+type classificationCode struct {
+	ListID string `xml:"listID,attr,omitempty"`
+	Value  string `xml:",chardata"`
+}
+
+type orderReference struct {
+	ID string `xml:"cbc:ID"`
+}
+
+type partyRole struct {
+	Party party `xml:"cac:Party"`
+}
+
+type party struct {
+	EndpointID       *identifier       `xml:"cbc:EndpointID"`
+	PartyName        *partyName        `xml:"cac:PartyName"`
+	PostalAddress    *postalAddress    `xml:"cac:PostalAddress"`
+	PartyTaxSchemes  []partyTaxScheme  `xml:"cac:PartyTaxScheme"`
+	PartyLegalEntity *partyLegalEntity `xml:"cac:PartyLegalEntity"`
+	Contact          *contact          `xml:"cac:Contact"`
+}
+
+type partyName struct {
+	Name string `xml:"cbc:Name"`
+}
+
+type postalAddress struct {
+	StreetName           string  `xml:"cbc:StreetName,omitempty"`
+	AdditionalStreetName string  `xml:"cbc:AdditionalStreetName,omitempty"`
+	CityName             string  `xml:"cbc:CityName,omitempty"`
+	PostalZone           string  `xml:"cbc:PostalZone,omitempty"`
+	CountrySubentity     string  `xml:"cbc:CountrySubentity,omitempty"`
+	Country              country `xml:"cac:Country"`
+}
+
+type country struct {
+	IdentificationCode string `xml:"cbc:IdentificationCode"`
+}
+
+type partyTaxScheme struct {
+	CompanyID string    `xml:"cbc:CompanyID"`
+	TaxScheme taxScheme `xml:"cac:TaxScheme"`
+}
+
+type taxScheme struct {
+	ID string `xml:"cbc:ID"`
+}
+
+type partyLegalEntity struct {
+	RegistrationName string `xml:"cbc:RegistrationName"`
+}
+
+type contact struct {
+	Name           string `xml:"cbc:Name,omitempty"`
+	Telephone      string `xml:"cbc:Telephone,omitempty"`
+	ElectronicMail string `xml:"cbc:ElectronicMail,omitempty"`
+}
+
+type delivery struct {
+	ActualDeliveryDate string `xml:"cbc:ActualDeliveryDate"`
+}
+
+type paymentTerms struct {
+	Note string `xml:"cbc:Note"`
+}
+
+type allowanceCharge struct {
+	ChargeIndicator       string       `xml:"cbc:ChargeIndicator"`
+	AllowanceChargeReason string       `xml:"cbc:AllowanceChargeReason,omitempty"`
+	Amount                amount       `xml:"cbc:Amount"`
+	TaxCategory           *taxCategory `xml:"cac:TaxCategory"`
+}
+
+type taxCategory struct {
+	ID        string    `xml:"cbc:ID"`
+	Percent   string    `xml:"cbc:Percent,omitempty"`
+	TaxScheme taxScheme `xml:"cac:TaxScheme"`
+}
+
+type taxTotal struct {
+	TaxAmount    amount        `xml:"cbc:TaxAmount"`
+	TaxSubtotals []taxSubtotal `xml:"cac:TaxSubtotal"`
+}
+
+type taxSubtotal struct {
+	TaxableAmount amount      `xml:"cbc:TaxableAmount"`
+	TaxAmount     amount      `xml:"cbc:TaxAmount"`
+	TaxCategory   taxCategory `xml:"cac:TaxCategory"`
+}
+
+type monetaryTotal struct {
+	LineExtensionAmount   amount  `xml:"cbc:LineExtensionAmount"`
+	TaxExclusiveAmount    amount  `xml:"cbc:TaxExclusiveAmount"`
+	TaxInclusiveAmount    amount  `xml:"cbc:TaxInclusiveAmount"`
+	AllowanceTotalAmount  *amount `xml:"cbc:AllowanceTotalAmount"`
+	ChargeTotalAmount     *amount `xml:"cbc:ChargeTotalAmount"`
+	PrepaidAmount         *amount `xml:"cbc:PrepaidAmount"`
+	PayableRoundingAmount *amount `xml:"cbc:PayableRoundingAmount"`
+	PayableAmount         amount  `xml:"cbc:PayableAmount"`
+}
+
+type invoiceLine struct {
+	ID                  string            `xml:"cbc:ID"`
+	InvoicedQuantity    quantity          `xml:"cbc:InvoicedQuantity"`
+	LineExtensionAmount amount            `xml:"cbc:LineExtensionAmount"`
+	AllowanceCharges    []allowanceCharge `xml:"cac:AllowanceCharge"`
+	Item                item              `xml:"cac:Item"`
+	Price               price             `xml:"cac:Price"`
+}
+
+type item struct {
+	Description             string                    `xml:"cbc:Description,omitempty"`
+	Name                    string                    `xml:"cbc:Name"`
+	CommodityClassification []commodityClassification `xml:"cac:CommodityClassification"`
+	ClassifiedTaxCategory   taxCategory               `xml:"cac:ClassifiedTaxCategory"`
+}
+
+type commodityClassification struct {
+	ItemClassificationCode classificationCode `xml:"cbc:ItemClassificationCode"`
+}
+
+type price struct {
+	PriceAmount  amount    `xml:"cbc:PriceAmount"`
+	BaseQuantity *quantity `xml:"cbc:BaseQuantity"`
+}
@@ -0,0 +1,441 @@
+package ubl
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+// Validate checks a UBL invoice with rules written here: the presence and
+// form of the elements the mapping uses, the code lists it needs, and the
+// EN 16931 and PEPPOL rules on those elements. Elements the mapping does
+// not use are left to the XSD and Schematron checkers. Rules are named as
+// in the specifications; "UBL" marks a constraint the UBL schema itself
+// sets.
+func Validate(data []byte) []ports.EInvoiceViolation {
+	doc, err := decode(data)
+	if err != nil {
+		return []ports.EInvoiceViolation{{Path: "Invoice", Rule: "UBL", Reason: err.Error()}}
+	}
+	return check(doc)
+}
+
+var (
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	countryPattern  = regexp.MustCompile(`^[A-Z]{2}$`)
+	schemePattern   = regexp.MustCompile(`^[0-9]{4}$`)
+)
+
+// invoiceTypeCodes are the UNTDID 1001 codes PEPPOL accepts for invoices.
+var invoiceTypeCodes = map[string]bool{
+	"71": true, "80": true, "82": true, "84": true, "102": true, "218": true, "219": true,
+	"331": true, "380": true, "382": true, "383": true, "386": true, "388": true, "393": true,
+	"395": true, "553": true, "575": true, "623": true, "780": true, "817": true, "870": true,
+	"875": true, "876": true, "877": true,
+}
+
+// vatCategories are the UNTDID 5305 codes of EN 16931.
+var vatCategories = map[string]bool{
+	"S": true, "Z": true, "E": true, "AE": true, "K": true, "G": true, "O": true, "L": true, "M": true,
+}
+
+// checker collects the violations of one document.
+type checker struct {
+	currency   string
+	violations []ports.EInvoiceViolation
+}
+
+func (c *checker) fail(path, rule, format string, args ...any) {
+	c.violations = append(c.violations, ports.EInvoiceViolation{Path: path, Rule: rule, Reason: fmt.Sprintf(format, args...)})
+}
+
+func (c *checker) required(path, rule, value string) bool {
+	if strings.TrimSpace(value) == "" {
+		c.fail(path, rule, "is required")
+		return false
+	}
+	return true
+}
+
+func (c *checker) date(path, rule, value string) {
+	if !c.required(path, rule, value) {
+		return
+	}
+	if _, err := time.Parse(dateLayout, strings.TrimSpace(value)); err != nil {
+		c.fail(path, "UBL", "%q is not a date of the form YYYY-MM-DD", value)
+	}
+}
+
+// amount reads a monetary amount: a decimal of at most two places in the
+// document currency.
+func (c *checker) amount(path, rule string, a amount) (decimal.Decimal, bool) {
+	if !c.required(path, rule, a.Value) {
+		return decimal.Zero, false
+	}
+	d, err := decimal.NewFromString(strings.TrimSpace(a.Value))
+	if err != nil {
+		c.fail(path, "UBL", "%q is not a decimal", a.Value)
+		return decimal.Zero, false
+	}
+	if d.Exponent() < -2 {
+		c.fail(path, "BR-DEC-01", "%q has more than two decimal places", a.Value)
+	}
+	switch cur := strings.TrimSpace(a.CurrencyID); {
+	case cur == "":
+		c.fail(path+"/@currencyID", "UBL", "is required")
+	case cur != c.currency:
+		c.fail(path+"/@currencyID", "PEPPOL-EN16931-R051", "%q is not the document currency %q", cur, c.currency)
+	}
+	return d, true
+}
+
+func (c *checker) optionalAmount(path string, a *amount) decimal.Decimal {
+	if a == nil {
+		return decimal.Zero
+	}
+	d, _ := c.amount(path, "UBL", *a)
+	return d
+}
+
+// equal reports whether want and got agree within a unit of the second
+// decimal place, allowing for how either side rounded.
+func equal(want, got decimal.Decimal) bool {
+	return want.Sub(got).Abs().LessThanOrEqual(decimal.New(1, -2))
+}
+
+// catKey identifies a VAT category by code and rate.
+type catKey struct {
+	id      string
+	percent string
+}
+
+func (c *checker) category(path, codeRule string, tc *taxCategory) (catKey, bool) {
+	if tc == nil || strings.TrimSpace(tc.ID) == "" {
+		c.fail(path, codeRule, "a VAT category code is required")
+		return catKey{}, false
+	}
+	id := strings.TrimSpace(tc.ID)
+	if !vatCategories[id] {
+		c.fail(path+"/cbc:ID", "BR-CL-18", "%q is not a VAT category code of UNTDID 5305", id)
+	}
+	if strings.TrimSpace(tc.TaxScheme.ID) != schemeVAT {
+		c.fail(path+"/cac:TaxScheme/cbc:ID", "UBL", "the tax scheme must be %s", schemeVAT)
+	}
+	percent := decimal.Zero
+	if p := strings.TrimSpace(tc.Percent); p != "" {
+		d, err := decimal.NewFromString(p)
+		if err != nil {
+			c.fail(path+"/cbc:Percent", "UBL", "%q is not a decimal", tc.Percent)
+			return catKey{}, false
+		}
+		percent = d
+	} else if id != "O" {
+		c.fail(path+"/cbc:Percent", "BR-48", "a VAT rate is required for category %s", id)
+	}
+	switch {
+	case id == categoryStandard && !percent.IsPositive():
+		c.fail(path+"/cbc:Percent", "BR-S-05", "standard rated supplies need a rate above zero")
+	case id == categoryZero && !percent.IsZero():
+		c.fail(path+"/cbc:Percent", "BR-Z-05", "zero rated supplies have a rate of 0")
+	}
+	return catKey{id: id, percent: percent.String()}, true
+}
+
+// check applies every rule to a decoded document.
+func check(doc *invoiceDoc) []ports.EInvoiceViolation {
+	c := &checker{currency: strings.TrimSpace(doc.DocumentCurrencyCode)}
+	const root = "Invoice"
+
+	if c.required(root+"/cbc:CustomizationID", "BR-01", doc.CustomizationID) && strings.TrimSpace(doc.CustomizationID) != CustomizationID {
+		c.fail(root+"/cbc:CustomizationID", "PEPPOL-EN16931-R004", "must be %s", CustomizationID)
+	}
+	c.required(root+"/cbc:ProfileID", "PEPPOL-EN16931-R001", doc.ProfileID)
+	c.required(root+"/cbc:ID", "BR-02", doc.ID)
+	c.date(root+"/cbc:IssueDate", "BR-03", doc.IssueDate)
+	if strings.TrimSpace(doc.DueDate) != "" {
+		c.date(root+"/cbc:DueDate", "UBL", doc.DueDate)
+	}
+	if c.required(root+"/cbc:InvoiceTypeCode", "BR-04", doc.InvoiceTypeCode) && !invoiceTypeCodes[strings.TrimSpace(doc.InvoiceTypeCode)] {
+		c.fail(root+"/cbc:InvoiceTypeCode", "BR-CL-01", "%q is not an invoice type code of UNTDID 1001", doc.InvoiceTypeCode)
+	}
+	if c.required(root+"/cbc:DocumentCurrencyCode", "BR-05", doc.DocumentCurrencyCode) && !currencyPattern.MatchString(c.currency) {
+		c.fail(root+"/cbc:DocumentCurrencyCode", "BR-CL-04", "%q is not an ISO 4217 currency code", doc.DocumentCurrencyCode)
+	}
+	if strings.TrimSpace(doc.BuyerReference) == "" && (doc.OrderReference == nil || strings.TrimSpace(doc.OrderReference.ID) == "") {
+		c.fail(root+"/cbc:BuyerReference", "PEPPOL-EN16931-R003", "a buyer reference or purchase order reference is required")
+	}
+	if doc.Delivery != nil {
+		c.date(root+"/cac:Delivery/cbc:ActualDeliveryDate", "UBL", doc.Delivery.ActualDeliveryDate)
+	}
+
+	sellerVAT := c.party(root+"/cac:AccountingSupplierParty/cac:Party", doc.AccountingSupplierParty.Party, true)
+	c.party(root+"/cac:AccountingCustomerParty/cac:Party", doc.AccountingCustomerParty.Party, false)
+
+	// Lines, with the net amount of each VAT category.
+	taxable := map[catKey]decimal.Decimal{}
+	var order []catKey
+	addTaxable := func(k catKey, d decimal.Decimal) {
+		if _, ok := taxable[k]; !ok {
+			order = append(order, k)
+		}
+		taxable[k] = taxable[k].Add(d)
+	}
+	if len(doc.InvoiceLines) == 0 {
+		c.fail(root+"/cac:InvoiceLine", "BR-16", "an invoice has at least one line")
+	}
+	lineTotal := decimal.Zero
+	for i, l := range doc.InvoiceLines {
+		path := fmt.Sprintf("%s/cac:InvoiceLine[%d]", root, i+1)
+		c.required(path+"/cbc:ID", "BR-21", l.ID)
+		var qty decimal.Decimal
+		qtyOK := c.required(path+"/cbc:InvoicedQuantity", "BR-22", l.InvoicedQuantity.Value)
+		if qtyOK {
+			var err error
+			if qty, err = decimal.NewFromString(strings.TrimSpace(l.InvoicedQuantity.Value)); err != nil {
+				c.fail(path+"/cbc:InvoicedQuantity", "UBL", "%q is not a decimal", l.InvoicedQuantity.Value)
+				qtyOK = false
+			}
+		}
+		c.required(path+"/cbc:InvoicedQuantity/@unitCode", "BR-23", l.InvoicedQuantity.UnitCode)
+		net, netOK := c.amount(path+"/cbc:LineExtensionAmount", "BR-24", l.LineExtensionAmount)
+		c.required(path+"/cac:Item/cbc:Name", "BR-25", l.Item.Name)
+		unitPrice, priceOK := c.amount(path+"/cac:Price/cbc:PriceAmount", "BR-26", l.Price.PriceAmount)
+		if priceOK && unitPrice.IsNegative() {
+			c.fail(path+"/cac:Price/cbc:PriceAmount", "BR-27", "the item net price must not be negative")
+			priceOK = false
+		}
+		baseQty := decimal.NewFromInt(1)
+		if bq := l.Price.BaseQuantity; bq != nil {
+			if d, err := decimal.NewFromString(strings.TrimSpace(bq.Value)); err != nil || !d.IsPositive() {
+				c.fail(path+"/cac:Price/cbc:BaseQuantity", "UBL", "%q is not a positive quantity", bq.Value)
+				priceOK = false
+			} else {
+				baseQty = d
+			}
+		}
+
+		adjust := decimal.Zero
+		for j, ac := range l.AllowanceCharges {
+			apath := fmt.Sprintf("%s/cac:AllowanceCharge[%d]", path, j+1)
+			amt, ok := c.allowance(apath, ac, "BR-41", "BR-42")
+			if !ok {
+				netOK = false
+				continue
+			}
+			adjust = adjust.Add(amt)
+		}
+		if qtyOK && priceOK && netOK {
+			want := qty.Mul(unitPrice).Div(baseQty).Add(adjust).Round(2)
+			if !equal(want, net) {
+				c.fail(path+"/cbc:LineExtensionAmount", "PEPPOL-EN16931-R120", "%s is not quantity × price ± allowances and charges, %s", net, want.StringFixed(2))
+			}
+		}
+		if k, ok := c.category(path+"/cac:Item/cac:ClassifiedTaxCategory", "BR-CO-04", &l.Item.ClassifiedTaxCategory); ok && netOK {
+			addTaxable(k, net)
+		}
+		lineTotal = lineTotal.Add(net)
+	}
+
+	// Document level allowances and charges.
+	allowances, charges := decimal.Zero, decimal.Zero
+	for i, ac := range doc.AllowanceCharges {
+		path := fmt.Sprintf("%s/cac:AllowanceCharge[%d]", root, i+1)
+		amt, ok := c.allowance(path, ac, "BR-31", "BR-33")
+		if !ok {
+			continue
+		}
+		if amt.IsNegative() {
+			allowances = allowances.Sub(amt)
+		} else {
+			charges = charges.Add(amt)
+		}
+		if k, ok := c.category(path+"/cac:TaxCategory", "BR-32", ac.TaxCategory); ok {
+			addTaxable(k, amt)
+		}
+	}
+
+	// VAT breakdown.
+	taxTotal := decimal.Zero
+	var withSubtotals int
+	for i, t := range doc.TaxTotals {
+		path := fmt.Sprintf("%s/cac:TaxTotal[%d]", root, i+1)
+		if len(t.TaxSubtotals) == 0 {
+			continue
+		}
+		withSubtotals++
+		total, totalOK := c.amount(path+"/cbc:TaxAmount", "BR-CO-14", t.TaxAmount)
+		sum := decimal.Zero
+		stated := map[catKey]bool{}
+		for j, s := range t.TaxSubtotals {
+			spath := fmt.Sprintf("%s/cac:TaxSubtotal[%d]", path, j+1)
+			base, baseOK := c.amount(spath+"/cbc:TaxableAmount", "BR-45", s.TaxableAmount)
+			tax, taxOK := c.amount(spath+"/cbc:TaxAmount", "BR-46", s.TaxAmount)
+			k, catOK := c.category(spath+"/cac:TaxCategory", "BR-47", &s.TaxCategory)
+			if taxOK {
+				sum = sum.Add(tax)
+			}
+			if !catOK {
+				continue
+			}
+			if stated[k] {
+				c.fail(spath+"/cac:TaxCategory", "BR-CO-18", "category %s at %s%% is broken down twice", k.id, k.percent)
+			}
+			stated[k] = true
+			if want, ok := taxable[k]; ok && baseOK && !equal(want, base) {
+				c.fail(spath+"/cbc:TaxableAmount", "BR-"+k.id+"-08", "%s is not the sum of line net amounts less allowances in category %s at %s%%, %s", base, k.id, k.percent, want.StringFixed(2))
+			} else if !ok {
+				c.fail(spath+"/cac:TaxCategory", "BR-CO-18", "no line or allowance is in category %s at %s%%", k.id, k.percent)
+			}
+			if baseOK && taxOK {
+				percent, _ := decimal.NewFromString(k.percent)
+				if want := base.Mul(percent).Div(hundred).Round(2); !equal(want, tax) {
+					c.fail(spath+"/cbc:TaxAmount", "BR-CO-17", "%s is not the taxable amount × %s%%, %s", tax, k.percent, want.StringFixed(2))
+				}
+			}
+		}
+		for _, k := range order {
+			if !stated[k] {
+				c.fail(path, "BR-CO-18", "category %s at %s%% has no VAT breakdown", k.id, k.percent)
+			}
+		}
+		if totalOK {
+			if !sum.Equal(total) {
+				c.fail(path+"/cbc:TaxAmount", "BR-CO-14", "%s is not the sum of the VAT breakdown, %s", total, sum)
+			}
+			taxTotal = total
+		}
+	}
+	switch {
+	case withSubtotals == 0:
+		c.fail(root+"/cac:TaxTotal", "BR-CO-18", "a VAT breakdown is required")
+	case withSubtotals > 1:
+		c.fail(root+"/cac:TaxTotal", "PEPPOL-EN16931-R053", "only one tax total may have a VAT breakdown")
+	}
+	if len(order) > 0 && !sellerVAT {
+		for _, k := range order {
+			if k.id == categoryStandard || k.id == categoryZero {
+				c.fail(root+"/cac:AccountingSupplierParty/cac:Party/cac:PartyTaxScheme", "BR-"+k.id+"-02", "the seller's VAT identifier is required for category %s", k.id)
+				break
+			}
+		}
+	}
+
+	// Document totals.
+	const mt = root + "/cac:LegalMonetaryTotal"
+	m := doc.LegalMonetaryTotal
+	lineExt, lineExtOK := c.amount(mt+"/cbc:LineExtensionAmount", "BR-12", m.LineExtensionAmount)
+	exclusive, exclusiveOK := c.amount(mt+"/cbc:TaxExclusiveAmount", "BR-13", m.TaxExclusiveAmount)
+	inclusive, inclusiveOK := c.amount(mt+"/cbc:TaxInclusiveAmount", "BR-14", m.TaxInclusiveAmount)
+	payable, payableOK := c.amount(mt+"/cbc:PayableAmount", "BR-15", m.PayableAmount)
+	allowanceTotal := c.optionalAmount(mt+"/cbc:AllowanceTotalAmount", m.AllowanceTotalAmount)
+	chargeTotal := c.optionalAmount(mt+"/cbc:ChargeTotalAmount", m.ChargeTotalAmount)
+	prepaid := c.optionalAmount(mt+"/cbc:PrepaidAmount", m.PrepaidAmount)
+	rounding := c.optionalAmount(mt+"/cbc:PayableRoundingAmount", m.PayableRoundingAmount)
+
+	if lineExtOK && !lineExt.Equal(lineTotal) {
+		c.fail(mt+"/cbc:LineExtensionAmount", "BR-CO-10", "%s is not the sum of line net amounts, %s", lineExt, lineTotal)
+	}
+	if !allowanceTotal.Equal(allowances) {
+		c.fail(mt+"/cbc:AllowanceTotalAmount", "BR-CO-11", "%s is not the sum of document level allowances, %s", allowanceTotal, allowances)
+	}
+	if !chargeTotal.Equal(charges) {
+		c.fail(mt+"/cbc:ChargeTotalAmount", "BR-CO-12", "%s is not the sum of document level charges, %s", chargeTotal, charges)
+	}
+	if exclusiveOK && lineExtOK {
+		if want := lineExt.Sub(allowanceTotal).Add(chargeTotal); !exclusive.Equal(want) {
+			c.fail(mt+"/cbc:TaxExclusiveAmount", "BR-CO-13", "%s is not the line total less allowances plus charges, %s", exclusive, want)
+		}
+	}
+	if inclusiveOK && exclusiveOK {
+		if want := exclusive.Add(taxTotal); !inclusive.Equal(want) {
+			c.fail(mt+"/cbc:TaxInclusiveAmount", "BR-CO-15", "%s is not the amount without VAT plus VAT, %s", inclusive, want)
+		}
+	}
+	if payableOK && inclusiveOK {
+		if want := inclusive.Sub(prepaid).Add(rounding); !payable.Equal(want) {
+			c.fail(mt+"/cbc:PayableAmount", "BR-CO-16", "%s is not the amount with VAT less prepaid plus rounding, %s", payable, want)
+		}
+		if payable.IsPositive() && strings.TrimSpace(doc.DueDate) == "" && (doc.PaymentTerms == nil || strings.TrimSpace(doc.PaymentTerms.Note) == "") {
+			c.fail(root+"/cbc:DueDate", "BR-CO-25", "a due date or payment terms are required when an amount is due")
+		}
+	}
+	return c.violations
+}
+
+// party checks a seller or buyer and reports whether it has a VAT
+// identifier.
+func (c *checker) party(path string, p party, seller bool) bool {
+	rule := func(sellerRule, buyerRule string) string {
+		if seller {
+			return sellerRule
+		}
+		return buyerRule
+	}
+	if p.EndpointID == nil || strings.TrimSpace(p.EndpointID.Value) == "" {
+		c.fail(path+"/cbc:EndpointID", rule("PEPPOL-EN16931-R020", "PEPPOL-EN16931-R010"), "an electronic address is required")
+	} else if scheme := strings.TrimSpace(p.EndpointID.SchemeID); scheme == "" {
+		c.fail(path+"/cbc:EndpointID/@schemeID", rule("BR-62", "BR-63"), "the electronic address needs a scheme identifier")
+	} else if !schemePattern.MatchString(scheme) {
+		c.fail(path+"/cbc:EndpointID/@schemeID", "BR-CL-25", "%q is not an electronic address scheme code", scheme)
+	}
+	name := ""
+	if p.PartyLegalEntity != nil {
+		name = p.PartyLegalEntity.RegistrationName
+	}
+	c.required(path+"/cac:PartyLegalEntity/cbc:RegistrationName", rule("BR-06", "BR-07"), name)
+	if p.PostalAddress == nil {
+		c.fail(path+"/cac:PostalAddress", rule("BR-08", "BR-10"), "a postal address is required")
+	} else if code := strings.TrimSpace(p.PostalAddress.Country.IdentificationCode); code == "" {
+		c.fail(path+"/cac:PostalAddress/cac:Country/cbc:IdentificationCode", rule("BR-09", "BR-11"), "is required")
+	} else if !countryPattern.MatchString(code) {
+		c.fail(path+"/cac:PostalAddress/cac:Country/cbc:IdentificationCode", "BR-CL-14", "%q is not an ISO 3166-1 country code", code)
+	}
+	vat := false
+	for i, s := range p.PartyTaxSchemes {
+		spath := fmt.Sprintf("%s/cac:PartyTaxScheme[%d]", path, i+1)
+		c.required(spath+"/cbc:CompanyID", "UBL", s.CompanyID)
+		switch id := strings.TrimSpace(s.TaxScheme.ID); {
+		case id == schemeVAT:
+			vat = true
+		case id == "":
+			c.fail(spath+"/cac:TaxScheme/cbc:ID", "UBL", "is required")
+		case !seller:
+			c.fail(spath+"/cac:TaxScheme/cbc:ID", "UBL", "the buyer's tax registration must be under the %s scheme", schemeVAT)
+		}
+	}
+	return vat
+}
+
+// allowance checks an allowance or charge and returns its amount, negative
+// for an allowance.
+func (c *checker) allowance(path string, ac allowanceCharge, amountRule, reasonRule string) (decimal.Decimal, bool) {
+	var charge bool
+	switch strings.TrimSpace(ac.ChargeIndicator) {
+	case "true":
+		charge = true
+	case "false":
+	default:
+		c.fail(path+"/cbc:ChargeIndicator", "UBL", "%q is not true or false", ac.ChargeIndicator)
+		return decimal.Zero, false
+	}
+	if !charge {
+		c.required(path+"/cbc:AllowanceChargeReason", reasonRule, ac.AllowanceChargeReason)
+	}
+	amt, ok := c.amount(path+"/cbc:Amount", amountRule, ac.Amount)
+	if !ok {
+		return decimal.Zero, false
+	}
+	if amt.IsNegative() {
+		c.fail(path+"/cbc:Amount", "UBL", "must not be negative")
+		return decimal.Zero, false
+	}
+	if !charge {
+		amt = amt.Neg()
+	}
+	return amt, true
+}
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 )
@@ -94,9 +95,61 @@ type EventPublisher interface {
 	PublishInvoiceItemCreated(ctx context.Context, item *db.InvoiceItem) error
 	PublishInvoiceTaxAdded(ctx context.Context, tax *db.InvoiceTax) error
 	PublishInvoiceDiscountAdded(ctx context.Context, disc *db.InvoiceDiscount) error
+	PublishInvoiceStatusChanged(ctx context.Context, ev *InvoiceStatusChangedEvent) error
+	PublishDunningReminder(ctx context.Context, ev *DunningReminderEvent) error
+	PublishCreditLimitBreached(ctx context.Context, ev *CreditLimitBreachedEvent) error
 
 	// ---------------- Bank Transaction events ----------------
 	PublishBankTransactionImported(ctx context.Context, ev *db.BankAccount) error
 	PublishBankTransactionReconciled(ctx context.Context, ev *db.BankAccount) error
 	
 }
+
+// InvoiceStatusChangedEvent records an invoice moving between lifecycle
+// statuses, e.g. DRAFT to ISSUED. Reason is set when an invoice is voided.
+type InvoiceStatusChangedEvent struct {
+	InvoiceID      string
+	InvoiceNumber  string
+	OrganizationID string
+	From           string
+	To             string
+	ChangedBy      string
+	ChangedAt      time.Time
+	Reason         string
+}
+
+// DunningReminderEvent asks for a payment reminder to go out for an open
+// invoice. DaysFromDue is the stage reached, negative before the due date;
+// EscalationLevel is the stage's, for picking the tone and recipients.
+type DunningReminderEvent struct {
+	InvoiceID       string
+	InvoiceNumber   string
+	OrganizationID  string
+	PartyID         string
+	Status          string
+	DueDate         time.Time
+	Amount          string
+	CurrencyCode    string
+	Stage           string
+	DaysFromDue     int32
+	EscalationLevel int32
+	SentAt          time.Time
+}
+
+// CreditLimitBreachedEvent reports an invoice issue that found its customer
+// past the credit threshold. Action is the policy applied, BLOCK or
+// APPROVAL; Approved is set when the invoice went out on a credit approval.
+type CreditLimitBreachedEvent struct {
+	InvoiceID      string
+	OrganizationID string
+	PartyID        string
+	PartyName      string
+	CurrencyCode   string
+	CreditLimit    string
+	Threshold      string
+	Exposure       string
+	Action         string
+	Approved       bool
+	ApprovedBy     string
+	At             time.Time
+}
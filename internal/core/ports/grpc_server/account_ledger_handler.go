@@ -2,13 +2,19 @@ package grpc_server
 
 import (
 	"context"
-	"strconv"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -17,31 +23,40 @@ import (
 
 type LedgerHandler struct {
 	pb.UnimplementedLedgerServiceServer
-	accountSvc ports.AccountService
-	journalSvc ports.JournalService
-	ledgerSvc  ports.LedgerService
+	accountSvc  ports.AccountService
+	journalSvc  ports.JournalService
+	ledgerSvc   ports.LedgerService
+	templateSvc ports.JournalTemplateService
+	importSvc   ports.JournalImportService
 }
 
-func NewLedgerHandler(acc ports.AccountService, j ports.JournalService, l ports.LedgerService) *LedgerHandler {
+func NewLedgerHandler(acc ports.AccountService, j ports.JournalService, l ports.LedgerService, t ports.JournalTemplateService, imp ports.JournalImportService) *LedgerHandler {
 	return &LedgerHandler{
-		accountSvc: acc,
-		journalSvc: j,
-		ledgerSvc:  l,
+		accountSvc:  acc,
+		journalSvc:  j,
+		ledgerSvc:   l,
+		templateSvc: t,
+		importSvc:   imp,
 	}
 }
 
 // ---------------- Accounts ----------------
 func (h *LedgerHandler) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.Account, error) {
+	parentID, err := parseOptionalUUID("parent_id", req.Account.ParentId)
+	if err != nil {
+		return nil, err
+	}
 	acc, err := h.accountSvc.Create(ctx, &db.Account{
 		ID:                 uuid.New(),
 		Code:               req.Account.Code,
 		Name:               req.Account.Name,
 		Type:               fromPbAccountType(req.Account.Type),
+		ParentID:           parentID,
 		Status:             fromPbAccountStatus(req.Account.Status),
 		AllowManualJournal: req.Account.AllowManualJournal,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "create account: %v", err)
+		return nil, ledgerError("create account", err)
 	}
 	return toPbAccount(acc), nil
 }
@@ -59,20 +74,35 @@ func (h *LedgerHandler) GetAccount(ctx context.Context, req *pb.GetAccountReques
 }
 
 func (h *LedgerHandler) UpdateAccount(ctx context.Context, req *pb.UpdateAccountRequest) (*pb.Account, error) {
-	id, err := uuid.Parse(req.Account.Id)
+	id, err := uuid.Parse(req.GetAccount().GetId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
 	}
+	in, err := maskedUpdate(req.UpdateMask, req.Account, func() (*pb.Account, error) {
+		acc, err := h.accountSvc.Get(ctx, id)
+		if err != nil {
+			return nil, ledgerError("update account", err)
+		}
+		return toPbAccount(acc), nil
+	}, "id", "audit")
+	if err != nil {
+		return nil, err
+	}
+	parentID, err := parseOptionalUUID("parent_id", in.ParentId)
+	if err != nil {
+		return nil, err
+	}
 	acc, err := h.accountSvc.Update(ctx, &db.Account{
 		ID:                 id,
-		Code:               req.Account.Code,
-		Name:               req.Account.Name,
-		Type:               fromPbAccountType(req.Account.Type),
-		Status:             fromPbAccountStatus(req.Account.Status),
-		AllowManualJournal: req.Account.AllowManualJournal,
+		Code:               in.Code,
+		Name:               in.Name,
+		Type:               fromPbAccountType(in.Type),
+		ParentID:           parentID,
+		Status:             fromPbAccountStatus(in.Status),
+		AllowManualJournal: in.AllowManualJournal,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "update account: %v", err)
+		return nil, ledgerError("update account", err)
 	}
 	return toPbAccount(acc), nil
 }
@@ -83,7 +113,7 @@ func (h *LedgerHandler) DeleteAccount(ctx context.Context, req *pb.DeleteAccount
 		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
 	}
 	if err := h.accountSvc.Delete(ctx, id); err != nil {
-		return nil, status.Errorf(codes.Internal, "delete account: %v", err)
+		return nil, ledgerError("delete account", err)
 	}
 	return &emptypb.Empty{}, nil
 }
@@ -129,15 +159,24 @@ func (h *LedgerHandler) ListAccounts(ctx context.Context, req *pb.ListAccountsRe
 
 // ---------------- Journals ----------------
 func (h *LedgerHandler) CreateJournalEntry(ctx context.Context, req *pb.CreateJournalEntryRequest) (*pb.JournalEntry, error) {
+	lines, err := fromPbJournalLines(req.Entry.Lines)
+	if err != nil {
+		return nil, err
+	}
 	j, err := h.journalSvc.Create(ctx, &db.JournalEntry{
-		ID:          uuid.New(),
-		JournalDate: req.Entry.JournalDate.AsTime(),
-		Memo:        toNullString(req.Entry.Memo),
-		SourceType:  toNullString(req.Entry.SourceType),
-		SourceID:    toNullString(req.Entry.SourceId),
+		ID:              uuid.New(),
+		JournalDate:     req.Entry.JournalDate.AsTime(),
+		Reference:       toNullString(req.Entry.Reference),
+		Memo:            toNullString(req.Entry.Memo),
+		SourceType:      toNullString(req.Entry.SourceType),
+		SourceID:        toNullString(req.Entry.SourceId),
+		OrganizationID:  toNullString(req.GetMeta().GetOrganizationId()),
+		Status:          fromPbJournalStatus(req.Entry.Status),
+		Lines:           lines,
+		AutoReverseDate: toNullTime(req.Entry.AutoReverseDate),
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "create journal: %v", err)
+		return nil, ledgerError("create journal", err)
 	}
 	return toPbJournalEntry(j), nil
 }
@@ -155,19 +194,37 @@ func (h *LedgerHandler) GetJournalEntry(ctx context.Context, req *pb.GetJournalE
 }
 
 func (h *LedgerHandler) UpdateJournalEntry(ctx context.Context, req *pb.UpdateJournalEntryRequest) (*pb.JournalEntry, error) {
-	id, err := uuid.Parse(req.Entry.Id)
+	id, err := uuid.Parse(req.GetEntry().GetId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
 	}
+	in, err := maskedUpdate(req.UpdateMask, req.Entry, func() (*pb.JournalEntry, error) {
+		j, err := h.journalSvc.Get(ctx, id)
+		if err != nil {
+			return nil, ledgerError("update journal", err)
+		}
+		return toPbJournalEntry(j), nil
+	}, "id", "audit", "reversal_of_id", "reversed_by_id")
+	if err != nil {
+		return nil, err
+	}
+	lines, err := fromPbJournalLines(in.Lines)
+	if err != nil {
+		return nil, err
+	}
 	j, err := h.journalSvc.Update(ctx, &db.JournalEntry{
-		ID:          id,
-		JournalDate: req.Entry.JournalDate.AsTime(),
-		Memo:        toNullString(req.Entry.Memo),
-		SourceType:  toNullString(req.Entry.SourceType),
-		SourceID:    toNullString(req.Entry.SourceId),
+		ID:              id,
+		JournalDate:     in.JournalDate.AsTime(),
+		Reference:       toNullString(in.Reference),
+		Memo:            toNullString(in.Memo),
+		SourceType:      toNullString(in.SourceType),
+		SourceID:        toNullString(in.SourceId),
+		Status:          fromPbJournalStatus(in.Status),
+		Lines:           lines,
+		AutoReverseDate: toNullTime(in.AutoReverseDate),
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "update journal: %v", err)
+		return nil, ledgerError("update journal", err)
 	}
 	return toPbJournalEntry(j), nil
 }
@@ -178,11 +235,26 @@ func (h *LedgerHandler) DeleteJournalEntry(ctx context.Context, req *pb.DeleteJo
 		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
 	}
 	if err := h.journalSvc.Delete(ctx, id); err != nil {
-		return nil, status.Errorf(codes.Internal, "delete journal: %v", err)
+		return nil, ledgerError("delete journal", err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
+func (h *LedgerHandler) ReverseJournalEntry(ctx context.Context, req *pb.ReverseJournalEntryRequest) (*pb.JournalEntry, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	if req.ReversalDate == nil {
+		return nil, status.Error(codes.InvalidArgument, "reversal_date is required")
+	}
+	j, err := h.journalSvc.Reverse(ctx, id, req.ReversalDate.AsTime(), req.Memo)
+	if err != nil {
+		return nil, ledgerError("reverse journal", err)
+	}
+	return toPbJournalEntry(j), nil
+}
+
 func (h *LedgerHandler) ListJournalEntries(ctx context.Context, req *pb.ListJournalEntriesRequest) (*pb.ListJournalEntriesResponse, error) {
 	limit := int32(50)
 	if req.GetPage().GetPageSize() > 0 {
@@ -197,9 +269,14 @@ func (h *LedgerHandler) ListJournalEntries(ctx context.Context, req *pb.ListJour
 		offset = int32(o)
 	}
 
-	entries, err := h.journalSvc.List(ctx, limit, offset)
+	filter, err := parseEntryFilter(req.GetPage(), "journal_date")
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+
+	entries, err := h.journalSvc.List(ctx, filter, limit, offset)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "list journal entries: %v", err)
+		return nil, ledgerError("list journal entries", err)
 	}
 
 	pbEntries := make([]*pb.JournalEntry, len(entries))
@@ -237,9 +314,14 @@ func (h *LedgerHandler) ListLedgerEntries(ctx context.Context, req *pb.ListLedge
 		offset = int32(o)
 	}
 
-	entries, err := h.ledgerSvc.List(ctx, limit, offset)
+	filter, err := parseEntryFilter(req.GetPage(), "transaction_date")
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "list ledger entries: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+
+	entries, err := h.ledgerSvc.List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, ledgerError("list ledger entries", err)
 	}
 
 	pbEntries := make([]*pb.LedgerEntry, len(entries))
@@ -262,26 +344,244 @@ func (h *LedgerHandler) ListLedgerEntries(ctx context.Context, req *pb.ListLedge
 	}, nil
 }
 
+func (h *LedgerHandler) GetAccountBalance(ctx context.Context, req *pb.GetAccountBalanceRequest) (*pb.AccountBalance, error) {
+	id, err := uuid.Parse(req.AccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account_id: %v", err)
+	}
+	if req.ToDate == nil {
+		return nil, status.Error(codes.InvalidArgument, "to_date is required")
+	}
+	balances, err := h.ledgerSvc.Balances(ctx, req.OrganizationId, []uuid.UUID{id}, optionalTime(req.FromDate), req.ToDate.AsTime())
+	if err != nil {
+		return nil, ledgerError("get account balance", err)
+	}
+	if len(balances) == 0 {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", id)
+	}
+	return toPbAccountBalance(balances[0]), nil
+}
+
+func (h *LedgerHandler) ListAccountBalances(ctx context.Context, req *pb.ListAccountBalancesRequest) (*pb.ListAccountBalancesResponse, error) {
+	ids := make([]uuid.UUID, len(req.AccountIds))
+	for i, s := range req.AccountIds {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid account_ids[%d]: %v", i, err)
+		}
+		ids[i] = id
+	}
+	if req.ToDate == nil {
+		return nil, status.Error(codes.InvalidArgument, "to_date is required")
+	}
+	balances, err := h.ledgerSvc.Balances(ctx, req.OrganizationId, ids, optionalTime(req.FromDate), req.ToDate.AsTime())
+	if err != nil {
+		return nil, ledgerError("list account balances", err)
+	}
+	resp := &pb.ListAccountBalancesResponse{Balances: make([]*pb.AccountBalance, len(balances))}
+	for i, b := range balances {
+		resp.Balances[i] = toPbAccountBalance(b)
+	}
+	return resp, nil
+}
+
+func (h *LedgerHandler) GetAccountTree(ctx context.Context, req *pb.GetAccountTreeRequest) (*pb.GetAccountTreeResponse, error) {
+	to := time.Now().UTC()
+	if req.ToDate != nil {
+		to = req.ToDate.AsTime()
+	}
+	roots, err := h.ledgerSvc.AccountTree(ctx, req.OrganizationId, optionalTime(req.FromDate), to)
+	if err != nil {
+		return nil, ledgerError("get account tree", err)
+	}
+	resp := &pb.GetAccountTreeResponse{Roots: make([]*pb.AccountTreeNode, len(roots))}
+	for i, r := range roots {
+		resp.Roots[i] = toPbAccountTreeNode(r)
+	}
+	return resp, nil
+}
+
 // ---------------- Helper Conversions ----------------
+func toPbAccountTreeNode(n *ports.AccountTreeNode) *pb.AccountTreeNode {
+	out := &pb.AccountTreeNode{
+		Account:  toPbAccount(n.Account),
+		Balance:  toPbAccountBalance(n.Balance),
+		Children: make([]*pb.AccountTreeNode, len(n.Children)),
+	}
+	for i, c := range n.Children {
+		out.Children[i] = toPbAccountTreeNode(c)
+	}
+	return out
+}
+
+func toPbAccountBalance(b ports.AccountBalance) *pb.AccountBalance {
+	out := &pb.AccountBalance{
+		AccountId:      b.AccountID.String(),
+		AccountCode:    b.Code,
+		AccountName:    b.Name,
+		AccountType:    toPbAccountType(strings.ToUpper(b.Type)),
+		ToDate:         timestamppb.New(b.To),
+		OpeningBalance: toPbMoney(b.Opening, b.Currency),
+		TotalDebits:    toPbMoney(b.TotalDebits, b.Currency),
+		TotalCredits:   toPbMoney(b.TotalCredits, b.Currency),
+		ClosingBalance: toPbMoney(b.Closing, b.Currency),
+	}
+	if !b.From.IsZero() {
+		out.FromDate = timestamppb.New(b.From)
+	}
+	for _, c := range b.Currencies {
+		out.Currencies = append(out.Currencies, &pb.CurrencyBalance{
+			CurrencyCode:   c.Currency,
+			OpeningBalance: toPbMoney(c.Opening, c.Currency),
+			TotalDebits:    toPbMoney(c.TotalDebits, c.Currency),
+			TotalCredits:   toPbMoney(c.TotalCredits, c.Currency),
+			ClosingBalance: toPbMoney(c.Closing, c.Currency),
+		})
+	}
+	return out
+}
+
+// optionalTime maps an unset timestamp to the zero time.
+func optionalTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
 func toPbAccount(a *db.Account) *pb.Account {
 	return &pb.Account{
 		Id:                 a.ID.String(),
 		Code:               a.Code,
 		Name:               a.Name,
 		Type:               toPbAccountType(a.Type),
+		ParentId:           nullUUIDString(a.ParentID),
 		Status:             toPbAccountStatus(a.Status),
 		AllowManualJournal: a.AllowManualJournal,
 	}
 }
 
+// parseOptionalUUID maps "" to a null UUID and rejects anything else that is
+// not a UUID.
+func parseOptionalUUID(field, s string) (uuid.NullUUID, error) {
+	if s == "" {
+		return uuid.NullUUID{}, nil
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.NullUUID{}, status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
+}
+
 func toPbJournalEntry(j *db.JournalEntry) *pb.JournalEntry {
-	return &pb.JournalEntry{
-		Id:          j.ID.String(),
-		JournalDate: timestamppb.New(j.JournalDate),
-		Memo:        j.Memo.String,
-		SourceType:  j.SourceType.String,
-		SourceId:    j.SourceID.String,
+	lines := make([]*pb.JournalLine, len(j.Lines))
+	for i, l := range j.Lines {
+		lines[i] = toPbJournalLine(l)
+	}
+	pj := &pb.JournalEntry{
+		Id:           j.ID.String(),
+		JournalDate:  timestamppb.New(j.JournalDate),
+		Reference:    j.Reference.String,
+		Memo:         j.Memo.String,
+		Lines:        lines,
+		SourceType:   j.SourceType.String,
+		SourceId:     j.SourceID.String,
+		Status:       toPbJournalStatus(j.Status),
+		ReversalOfId: nullUUIDString(j.ReversalOf),
+		ReversedById: nullUUIDString(j.ReversedBy),
+	}
+	if j.AutoReverseDate.Valid {
+		pj.AutoReverseDate = timestamppb.New(j.AutoReverseDate.Time)
+	}
+	return pj
+}
+
+func nullUUIDString(id uuid.NullUUID) string {
+	if !id.Valid {
+		return ""
 	}
+	return id.UUID.String()
+}
+
+func toPbJournalLine(l db.JournalLine) *pb.JournalLine {
+	costCenter := ""
+	if l.CostCenterID.Valid {
+		costCenter = l.CostCenterID.UUID.String()
+	}
+	return &pb.JournalLine{
+		AccountId:        l.AccountID.String(),
+		Side:             toPbLedgerSide(l.Side),
+		Amount:           toPbMoney(l.TransactionAmount, l.CurrencyCode),
+		CostCenterId:     costCenter,
+		Description:      l.Description.String,
+		ExchangeRate:     l.ExchangeRate,
+		FunctionalAmount: toPbMoney(l.Amount, l.FunctionalCurrency),
+	}
+}
+
+// fromPbJournalLines converts request lines, rejecting malformed ids up front.
+// Business rules (balance, sides, accounts) are left to the JournalService.
+func fromPbJournalLines(in []*pb.JournalLine) ([]db.JournalLine, error) {
+	lines := make([]db.JournalLine, len(in))
+	for i, l := range in {
+		accountID, err := uuid.Parse(l.AccountId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid entry.lines[%d].account_id: %v", i, err)
+		}
+		var costCenter uuid.NullUUID
+		if l.CostCenterId != "" {
+			cc, err := uuid.Parse(l.CostCenterId)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid entry.lines[%d].cost_center_id: %v", i, err)
+			}
+			costCenter = uuid.NullUUID{UUID: cc, Valid: true}
+		}
+		// Amount is in the line's currency until the service converts it.
+		lines[i] = db.JournalLine{
+			AccountID:    accountID,
+			Side:         fromPbLedgerSide(l.Side),
+			Amount:       mapProtoAmountToDomain(l.Amount),
+			CostCenterID: costCenter,
+			Description:  toNullString(l.Description),
+			CurrencyCode: l.GetAmount().GetCurrencyCode(),
+			ExchangeRate: l.ExchangeRate,
+		}
+	}
+	return lines, nil
+}
+
+// ledgerError maps service errors from the ledger, accounts and fiscal
+// periods to gRPC codes. Journal validation failures become InvalidArgument
+// with a BadRequest detail per violated line, lifecycle conflicts, closed
+// periods and missing exchange rates FailedPrecondition, and anything
+// unexpected Internal.
+func ledgerError(op string, err error) error {
+	var verr *services.JournalValidationError
+	if errors.As(err, &verr) {
+		br := &errdetails.BadRequest{}
+		for _, v := range verr.Violations {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "entry." + v.Path(),
+				Description: v.Reason,
+			})
+		}
+		st := status.New(codes.InvalidArgument, fmt.Sprintf("%s: %v", op, err))
+		if detailed, derr := st.WithDetails(br); derr == nil {
+			return detailed.Err()
+		}
+		return st.Err()
+	}
+	switch {
+	case errors.Is(err, services.ErrInvalidInput):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+	case errors.Is(err, services.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", op, err)
+	case errors.Is(err, services.ErrConflict), errors.Is(err, services.ErrPeriodClosed),
+		errors.Is(err, services.ErrNoExchangeRate), errors.Is(err, services.ErrCreditLimit):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", op, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", op, err)
 }
 
 func toNullString(s string) sql.NullString {
@@ -291,6 +591,13 @@ func toNullString(s string) sql.NullString {
 	return sql.NullString{String: s, Valid: true}
 }
 
+func toNullTime(ts *timestamppb.Timestamp) sql.NullTime {
+	if ts == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: ts.AsTime(), Valid: true}
+}
+
 // ---------------- Enum Conversions ----------------
 
 func fromPbAccountType(t pb.AccountType) string {
@@ -340,6 +647,32 @@ func toPbAccountType(s string) pb.AccountType {
 	}
 }
 
+func fromPbJournalStatus(s pb.JournalStatus) string {
+	switch s {
+	case pb.JournalStatus_JOURNAL_DRAFT:
+		return "DRAFT"
+	case pb.JournalStatus_JOURNAL_POSTED:
+		return "POSTED"
+	case pb.JournalStatus_JOURNAL_REVERSED:
+		return "REVERSED"
+	default:
+		return ""
+	}
+}
+
+func toPbJournalStatus(s string) pb.JournalStatus {
+	switch s {
+	case "DRAFT":
+		return pb.JournalStatus_JOURNAL_DRAFT
+	case "POSTED":
+		return pb.JournalStatus_JOURNAL_POSTED
+	case "REVERSED":
+		return pb.JournalStatus_JOURNAL_REVERSED
+	default:
+		return pb.JournalStatus_JOURNAL_STATUS_UNSPECIFIED
+	}
+}
+
 func toPbAccountStatus(s string) pb.AccountStatus {
 	switch s {
 	case "ACTIVE":
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 	"database/sql"
+	"errors"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -13,6 +14,7 @@ import (
 	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
 )
 
 type AccrualHandler struct {
@@ -30,20 +32,30 @@ func (h *AccrualHandler) CreateAccrual(ctx context.Context, req *pb.CreateAccrua
 		return nil, status.Errorf(codes.InvalidArgument, "invalid id")
 	}
 	acc, err := h.svc.Create(ctx, db.Accrual{
-		ID:          id,
-		Description: toNullString(req.Accrual.Description),
-		Amount:      moneyToString(req.Accrual.Amount),
-		AccrualDate: req.Accrual.AccrualDate.AsTime(),
-		AccountID:   req.Accrual.AccountId,
-		UpdatedBy:   toNullString(getUserFromContext(ctx)),
+		ID:             id,
+		Description:    toNullString(req.Accrual.Description),
+		Amount:         moneyToString(req.Accrual.Amount),
+		AccrualDate:    req.Accrual.AccrualDate.AsTime(),
+		AccountID:      req.Accrual.AccountId,
+		UpdatedBy:      toNullString(getUserFromContext(ctx)),
+		OrganizationID: toNullString(req.GetMeta().GetOrganizationId()),
 	})
 	if err != nil {
-		return nil, err
+		return nil, accrualError(err)
 
 	}
 	return toPbAccrual(acc), nil
 }
 
+// accrualError reports writes into a closed accounting period as
+// FailedPrecondition and passes every other error through.
+func accrualError(err error) error {
+	if errors.Is(err, services.ErrPeriodClosed) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
+
 func (h *AccrualHandler) GetAccrualById(ctx context.Context, req *pb.GetAccrualByIdRequest) (*pb.Accrual, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
@@ -61,16 +73,26 @@ func (h *AccrualHandler) UpdateAccrual(ctx context.Context, req *pb.UpdateAccrua
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid id")
 	}
+	in, err := maskedUpdate(req.UpdateMask, req.Accrual, func() (*pb.Accrual, error) {
+		acc, err := h.svc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return toPbAccrual(acc), nil
+	}, "id", "audit")
+	if err != nil {
+		return nil, err
+	}
 	acc, err := h.svc.Update(ctx, db.Accrual{
 		ID:          id,
-		Description: toNullString(req.Accrual.Description),
-		Amount:      moneyToString(req.Accrual.Amount),
-		AccrualDate: req.Accrual.AccrualDate.AsTime(),
-		AccountID:   req.Accrual.AccountId,
+		Description: toNullString(in.Description),
+		Amount:      moneyToString(in.Amount),
+		AccrualDate: in.AccrualDate.AsTime(),
+		AccountID:   in.AccountId,
 		UpdatedBy:   toNullString(getUserFromContext(ctx)),
 	})
 	if err != nil {
-		return nil, err
+		return nil, accrualError(err)
 	}
 
 	return toPbAccrual(acc), nil
@@ -82,7 +104,7 @@ func (h *AccrualHandler) DeleteAccrual(ctx context.Context, req *pb.DeleteAccrua
 		return nil, status.Errorf(codes.InvalidArgument, "invalid id")
 	}
 	if err := h.svc.Delete(ctx, id); err != nil {
-		return nil, err
+		return nil, accrualError(err)
 	}
 	return &emptypb.Empty{}, nil
 }
@@ -164,14 +186,24 @@ func (h *AllocationHandler) UpdateRule(ctx context.Context, req *pb.UpdateAlloca
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid id")
 	}
+	in, err := maskedUpdate(req.UpdateMask, req.Rule, func() (*pb.AllocationRule, error) {
+		rule, err := h.svc.GetRule(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return toPbAllocationRule(rule), nil
+	}, "id", "audit")
+	if err != nil {
+		return nil, err
+	}
 
 	rule, err := h.svc.UpdateRule(ctx, db.AllocationRule{
 		ID:                  id,
-		Name:                req.Rule.Name,
-		Basis:               req.Rule.Basis,
-		SourceAccountID:     req.Rule.SourceAccountId,
-		TargetCostCenterIds: req.Rule.TargetCostCenterIds,
-		Formula:             toNullString(req.Rule.Formula),
+		Name:                in.Name,
+		Basis:               in.Basis,
+		SourceAccountID:     in.SourceAccountId,
+		TargetCostCenterIds: in.TargetCostCenterIds,
+		Formula:             toNullString(in.Formula),
 		UpdatedBy:           toNullString(getUserFromContext(ctx)),
 	})
 	if err != nil {
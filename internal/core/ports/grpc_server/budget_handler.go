@@ -31,7 +31,7 @@ func dbBudgetToPb(b *db.Budget) *financepb.Budget {
     return &financepb.Budget{
         Id:          b.ID.String(),
         Name:        b.Name,
-        TotalAmount: stringToMoney(b.TotalAmount, "USD"),
+        TotalAmount: stringToMoney(b.TotalAmount, b.CurrencyCode),
         Status:      b.Status,
     }
 }
@@ -43,12 +43,13 @@ func pbBudgetToDb(pbB *financepb.Budget) *db.Budget {
     }
     id, _ := uuid.Parse(pbB.GetId())
     return &db.Budget{
-        ID:          id,
-        Name:        pbB.GetName(),
-        TotalAmount: moneyToString(pbB.GetTotalAmount()),
-        Status:      pbB.GetStatus(),
-        CreatedAt:   sql.NullTime{},   // set in service/repo
-        CreatedBy:   sql.NullString{}, // set in service/repo
+        ID:           id,
+        Name:         pbB.GetName(),
+        TotalAmount:  moneyToString(pbB.GetTotalAmount()),
+        Status:       pbB.GetStatus(),
+        CurrencyCode: pbB.GetTotalAmount().GetCurrencyCode(),
+        CreatedAt:    sql.NullTime{},   // set in service/repo
+        CreatedBy:    sql.NullString{}, // set in service/repo
     }
 }
 
@@ -100,7 +101,7 @@ func (h *BudgetHandler) GetBudget(ctx context.Context, req *financepb.GetBudgetR
 	pbBudget := &financepb.Budget{
 		Id:          b.ID.String(),
 		Name:        b.Name,
-		TotalAmount: stringToMoney(b.TotalAmount, "USD"), // adjust if using money proto
+		TotalAmount: stringToMoney(b.TotalAmount, b.CurrencyCode),
 		Status:      b.Status,
 	}
 
@@ -135,7 +136,7 @@ func (h *BudgetHandler) ListBudgets(ctx context.Context, req *financepb.ListBudg
         pbBudgets[i] = &financepb.Budget{
             Id:          b.ID.String(),
             Name:        b.Name,
-            TotalAmount: stringToMoney(b.TotalAmount, "USD"), // adjust for money proto
+            TotalAmount: stringToMoney(b.TotalAmount, b.CurrencyCode),
             Status:      b.Status,
         }
     }
@@ -157,12 +158,33 @@ func (h *BudgetHandler) ListBudgets(ctx context.Context, req *financepb.ListBudg
 
 
 func (h *BudgetHandler) UpdateBudget(ctx context.Context, req *financepb.UpdateBudgetRequest) (*financepb.Budget, error) {
+    id, err := uuid.Parse(req.GetBudget().GetId())
+    if err != nil {
+        return nil, status.Errorf(codes.InvalidArgument, "invalid budget id: %v", err)
+    }
+    in, err := maskedUpdate(req.UpdateMask, req.Budget, func() (*financepb.Budget, error) {
+        b, err := h.service.GetBudget(ctx, id)
+        if err != nil {
+            return nil, status.Errorf(codes.NotFound, "budget not found: %v", err)
+        }
+        return &financepb.Budget{
+            Id:          b.ID.String(),
+            Name:        b.Name,
+            TotalAmount: stringToMoney(b.TotalAmount, b.CurrencyCode),
+            Status:      b.Status,
+        }, nil
+    }, "id", "audit")
+    if err != nil {
+        return nil, err
+    }
+
     // Map protobuf request to internal db.Budget
     b := &db.Budget{
-        ID:          uuid.MustParse(req.GetBudget().GetId()),
-        Name:        req.GetBudget().GetName(),
-        TotalAmount: moneyToString(req.GetBudget().GetTotalAmount()),
-        Status:      req.GetBudget().GetStatus(),
+        ID:           id,
+        Name:         in.GetName(),
+        TotalAmount:  moneyToString(in.GetTotalAmount()),
+        Status:       in.GetStatus(),
+        CurrencyCode: in.GetTotalAmount().GetCurrencyCode(),
     }
 
     updatedBudget, err := h.service.UpdateBudget(ctx, b)
@@ -174,7 +196,7 @@ func (h *BudgetHandler) UpdateBudget(ctx context.Context, req *financepb.UpdateB
     pbBudget := &financepb.Budget{
         Id:          updatedBudget.ID.String(),
         Name:        updatedBudget.Name,
-        TotalAmount: stringToMoney(updatedBudget.TotalAmount, "USD"),
+        TotalAmount: stringToMoney(updatedBudget.TotalAmount, updatedBudget.CurrencyCode),
         Status:      updatedBudget.Status,
     }
 
@@ -213,14 +235,87 @@ func (h *BudgetHandler) ListBudgetAllocations(ctx context.Context, budgetID uuid
 }
 
 
-func (h *BudgetHandler) UpdateBudgetAllocation(ctx context.Context, ba *db.BudgetAllocation) (*db.BudgetAllocation, error) {
-	return h.service.UpdateBudgetAllocation(ctx, ba)
-}
-
 func (h *BudgetHandler) DeleteBudgetAllocation(ctx context.Context, id uuid.UUID) error {
 	return h.service.DeleteBudgetAllocation(ctx, id)
 }
 
+// BudgetAllocationHandler serves BudgetAllocationService. Allocation amounts
+// are in the currency of the budget they belong to.
+type BudgetAllocationHandler struct {
+	financepb.UnimplementedBudgetAllocationServiceServer
+	service ports.BudgetService
+}
+
+func NewBudgetAllocationHandler(service ports.BudgetService) *BudgetAllocationHandler {
+	return &BudgetAllocationHandler{service: service}
+}
+
+func (h *BudgetAllocationHandler) GetBudgetAllocation(ctx context.Context, req *financepb.GetBudgetAllocationRequest) (*financepb.BudgetAllocation, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid allocation id: %v", err)
+	}
+	ba, err := h.service.GetBudgetAllocation(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "budget allocation not found: %v", err)
+	}
+	return h.toPbAllocation(ctx, ba)
+}
+
+func (h *BudgetAllocationHandler) UpdateBudgetAllocation(ctx context.Context, req *financepb.UpdateBudgetAllocationRequest) (*financepb.BudgetAllocation, error) {
+	id, err := uuid.Parse(req.GetAllocation().GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid allocation id: %v", err)
+	}
+	in, err := maskedUpdate(req.UpdateMask, req.Allocation, func() (*financepb.BudgetAllocation, error) {
+		ba, err := h.service.GetBudgetAllocation(ctx, id)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "budget allocation not found: %v", err)
+		}
+		return h.toPbAllocation(ctx, ba)
+	}, "id", "budget_id", "remaining_amount", "audit")
+	if err != nil {
+		return nil, err
+	}
+
+	spent := sql.NullString{}
+	if in.GetSpentAmount() != nil {
+		spent = sql.NullString{String: moneyToString(in.GetSpentAmount()), Valid: true}
+	}
+	updated, err := h.service.UpdateBudgetAllocation(ctx, &db.BudgetAllocation{
+		ID:              id,
+		DepartmentID:    in.GetDepartmentId(),
+		AllocatedAmount: moneyToString(in.GetAllocatedAmount()),
+		SpentAmount:     spent,
+		UpdatedBy:       toNullString(getUserFromContext(ctx)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return h.toPbAllocation(ctx, updated)
+}
+
+// toPbAllocation maps ba, reading the currency from its budget.
+func (h *BudgetAllocationHandler) toPbAllocation(ctx context.Context, ba *db.BudgetAllocation) (*financepb.BudgetAllocation, error) {
+	b, err := h.service.GetBudget(ctx, ba.BudgetID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load budget %s: %v", ba.BudgetID, err)
+	}
+	out := &financepb.BudgetAllocation{
+		Id:              ba.ID.String(),
+		BudgetId:        ba.BudgetID.String(),
+		DepartmentId:    ba.DepartmentID,
+		AllocatedAmount: stringToMoney(ba.AllocatedAmount, b.CurrencyCode),
+	}
+	if ba.SpentAmount.Valid {
+		out.SpentAmount = stringToMoney(ba.SpentAmount.String, b.CurrencyCode)
+	}
+	if ba.RemainingAmount.Valid {
+		out.RemainingAmount = stringToMoney(ba.RemainingAmount.String, b.CurrencyCode)
+	}
+	return out, nil
+}
+
 // ---------------- Budget Comparison ----------------
 
 func (h *BudgetHandler) GetBudgetComparison(ctx context.Context, id uuid.UUID) (*db.GetBudgetComparisonReportRow, error) {
@@ -35,13 +35,15 @@ func (s *CreditDebitNoteHandler) CreateCreditDebitNote(ctx context.Context, req
     note := db.CreditDebitNote{
         InvoiceID: invoiceID,
         Type:      noteReq.Type.String(), 
-        Amount:    noteReq.Amount.String(),               
+        Amount:    mapProtoAmountToDomain(noteReq.Amount),
+        Reason:    toNullString(noteReq.Reason),
         CreatedBy: toNullString(noteReq.Audit.GetCreatedBy()),          
     }
 
+    // The note is applied to its invoice as it is created.
     createdNote, err := s.svc.Create(ctx, note)
     if err != nil {
-        return nil, status.Errorf(codes.Internal, "failed to create note: %v", err)
+        return nil, ledgerError("create note", err)
     }
 
     return mapDomainToProtoCreditDebitNote(createdNote), nil
@@ -103,7 +105,18 @@ func (s *CreditDebitNoteHandler) UpdateCreditDebitNote(ctx context.Context, req
         return nil, status.Errorf(codes.InvalidArgument, "invalid id UUID format: %v", err)
     }
 
-    invoiceID, err := uuid.Parse(noteReq.InvoiceId)
+    in, err := maskedUpdate(req.UpdateMask, noteReq, func() (*pb.CreditDebitNote, error) {
+        note, err := s.svc.Get(ctx, id)
+        if err != nil {
+            return nil, status.Errorf(codes.NotFound, "note not found: %v", err)
+        }
+        return mapDomainToProtoCreditDebitNote(note), nil
+    }, "id", "audit", "journal_id", "payment_due_id", "applied_at")
+    if err != nil {
+        return nil, err
+    }
+
+    invoiceID, err := uuid.Parse(in.InvoiceId)
     if err != nil {
         return nil, status.Errorf(codes.InvalidArgument, "invalid invoice_id UUID format: %v", err)
     }
@@ -111,16 +124,15 @@ func (s *CreditDebitNoteHandler) UpdateCreditDebitNote(ctx context.Context, req
     note := db.CreditDebitNote{
         ID:        id,
         InvoiceID: invoiceID,
-        Type:      noteReq.Type.String(), // map enum → domain
-        Amount:    noteReq.Amount.String(),                // map Money → string, or convert properly
-        Reason:    toNullString(noteReq.Reason),
+        Type:      in.Type.String(), // map enum → domain
+        Amount:    mapProtoAmountToDomain(in.Amount),
+        Reason:    toNullString(in.Reason),
         UpdatedBy: toNullString(noteReq.Audit.GetUpdatedBy()),           // comes from AuditFields inside CreditDebitNote
     }
 
-    // Optionally: use req.UpdateMask to only update specific fields in DB
     updatedNote, err := s.svc.Update(ctx, note)
     if err != nil {
-        return nil, status.Errorf(codes.Internal, "failed to update note: %v", err)
+        return nil, ledgerError("update note", err)
     }
 
     return mapDomainToProtoCreditDebitNote(updatedNote), nil
@@ -132,7 +144,7 @@ func (s *CreditDebitNoteHandler) DeleteCreditDebitNote(ctx context.Context, req
 		return nil, status.Errorf(codes.InvalidArgument, "invalid id UUID format: %v", err)
 	}
 	if err := s.svc.Delete(ctx, id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete note: %v", err)
+		return nil, ledgerError("delete note", err)
 	}
 	return &emptypb.Empty{}, nil
 }
@@ -0,0 +1,120 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+type CreditHandler struct {
+	pb.UnimplementedCreditControlServiceServer
+	svc ports.CreditService
+}
+
+func NewCreditHandler(svc ports.CreditService) *CreditHandler {
+	return &CreditHandler{svc: svc}
+}
+
+func (h *CreditHandler) GetCreditPolicy(ctx context.Context, req *pb.GetCreditPolicyRequest) (*pb.CreditPolicy, error) {
+	p, err := h.svc.GetPolicy(ctx, req.GetMeta().GetOrganizationId())
+	if err != nil {
+		return nil, ledgerError("get credit policy", err)
+	}
+	return toPbCreditPolicy(p), nil
+}
+
+func (h *CreditHandler) SetCreditPolicy(ctx context.Context, req *pb.SetCreditPolicyRequest) (*pb.CreditPolicy, error) {
+	if req.GetPolicy() == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+	p, err := h.svc.SetPolicy(ctx, db.CreditPolicy{
+		OrganizationID:   req.GetMeta().GetOrganizationId(),
+		ThresholdPercent: req.Policy.ThresholdPercent,
+		Action:           fromPbCreditAction(req.Policy.Action),
+		UpdatedBy:        toNullString(getUserFromContext(ctx)),
+	})
+	if err != nil {
+		return nil, ledgerError("set credit policy", err)
+	}
+	return toPbCreditPolicy(p), nil
+}
+
+func (h *CreditHandler) GetCreditExposure(ctx context.Context, req *pb.GetCreditExposureRequest) (*pb.CreditExposure, error) {
+	id, err := uuid.Parse(req.PartyId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid party_id: %v", err)
+	}
+	e, err := h.svc.GetExposure(ctx, id)
+	if err != nil {
+		return nil, ledgerError("get credit exposure", err)
+	}
+	cur := e.CurrencyCode
+	return &pb.CreditExposure{
+		PartyId:          e.PartyID.String(),
+		CreditLimit:      toPbMoney(e.CreditLimit, cur),
+		CreditHold:       e.CreditHold,
+		OpenDues:         toPbMoney(e.OpenDues, cur),
+		DraftInvoices:    toPbMoney(e.DraftInvoices, cur),
+		UnappliedCredits: toPbMoney(e.UnappliedCredits, cur),
+		Exposure:         toPbMoney(e.Exposure, cur),
+		Threshold:        toPbMoney(e.Threshold, cur),
+		Breached:         e.Breached,
+	}, nil
+}
+
+func (h *CreditHandler) ApproveInvoiceCredit(ctx context.Context, req *pb.ApproveInvoiceCreditRequest) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(req.InvoiceId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invoice_id: %v", err)
+	}
+	if err := h.svc.ApproveInvoice(ctx, id, getUserFromContext(ctx)); err != nil {
+		return nil, ledgerError("approve invoice credit", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func toPbCreditPolicy(p db.CreditPolicy) *pb.CreditPolicy {
+	out := &pb.CreditPolicy{
+		OrganizationId:   p.OrganizationID,
+		ThresholdPercent: p.ThresholdPercent,
+		Action:           toPbCreditAction(p.Action),
+	}
+	if !p.UpdatedAt.IsZero() {
+		out.Audit = &pb.AuditFields{
+			UpdatedAt: timestamppb.New(p.UpdatedAt),
+			UpdatedBy: p.UpdatedBy.String,
+		}
+	}
+	return out
+}
+
+func fromPbCreditAction(a pb.CreditAction) string {
+	switch a {
+	case pb.CreditAction_CREDIT_ACTION_BLOCK:
+		return services.CreditActionBlock
+	case pb.CreditAction_CREDIT_ACTION_APPROVAL:
+		return services.CreditActionApproval
+	default:
+		return ""
+	}
+}
+
+func toPbCreditAction(a string) pb.CreditAction {
+	switch a {
+	case services.CreditActionBlock:
+		return pb.CreditAction_CREDIT_ACTION_BLOCK
+	case services.CreditActionApproval:
+		return pb.CreditAction_CREDIT_ACTION_APPROVAL
+	default:
+		return pb.CreditAction_CREDIT_ACTION_UNSPECIFIED
+	}
+}
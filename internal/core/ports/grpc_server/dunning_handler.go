@@ -0,0 +1,191 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+type DunningHandler struct {
+	pb.UnimplementedDunningServiceServer
+	svc ports.DunningService
+}
+
+func NewDunningHandler(svc ports.DunningService) *DunningHandler {
+	return &DunningHandler{svc: svc}
+}
+
+func (h *DunningHandler) SetDunningSchedule(ctx context.Context, req *pb.SetDunningScheduleRequest) (*pb.DunningSchedule, error) {
+	in := req.GetSchedule()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "schedule is required")
+	}
+	orgID := in.OrganizationId
+	if orgID == "" {
+		orgID = req.GetMeta().GetOrganizationId()
+	}
+	stages := make([]db.DunningStage, len(in.Stages))
+	for i, st := range in.Stages {
+		stages[i] = db.DunningStage{DaysFromDue: st.DaysFromDue, EscalationLevel: st.EscalationLevel, Name: st.Name}
+	}
+	saved, err := h.svc.SetSchedule(ctx, orgID, stages, getUserFromContext(ctx))
+	if err != nil {
+		return nil, ledgerError("set dunning schedule", err)
+	}
+	return toPbDunningSchedule(orgID, saved), nil
+}
+
+func (h *DunningHandler) GetDunningSchedule(ctx context.Context, req *pb.GetDunningScheduleRequest) (*pb.DunningSchedule, error) {
+	orgID := req.GetMeta().GetOrganizationId()
+	stages, err := h.svc.GetSchedule(ctx, orgID)
+	if err != nil {
+		return nil, ledgerError("get dunning schedule", err)
+	}
+	return toPbDunningSchedule(orgID, stages), nil
+}
+
+func (h *DunningHandler) PlaceDunningHold(ctx context.Context, req *pb.PlaceDunningHoldRequest) (*pb.DunningHold, error) {
+	in := req.GetHold()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "hold is required")
+	}
+	var (
+		hold db.DunningHold
+		err  error
+	)
+	switch {
+	case in.InvoiceId != "" && in.PartyId != "":
+		return nil, status.Error(codes.InvalidArgument, "hold either an invoice_id or a party_id, not both")
+	case in.InvoiceId != "":
+		id, perr := uuid.Parse(in.InvoiceId)
+		if perr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid invoice_id: %v", perr)
+		}
+		hold, err = h.svc.HoldInvoice(ctx, id, in.Reason, getUserFromContext(ctx))
+	case in.PartyId != "":
+		id, perr := uuid.Parse(in.PartyId)
+		if perr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid party_id: %v", perr)
+		}
+		orgID := in.OrganizationId
+		if orgID == "" {
+			orgID = req.GetMeta().GetOrganizationId()
+		}
+		hold, err = h.svc.HoldParty(ctx, orgID, id, in.Reason, getUserFromContext(ctx))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invoice_id or party_id is required")
+	}
+	if err != nil {
+		return nil, ledgerError("place dunning hold", err)
+	}
+	return toPbDunningHold(hold), nil
+}
+
+func (h *DunningHandler) ReleaseDunningHold(ctx context.Context, req *pb.ReleaseDunningHoldRequest) (*emptypb.Empty, error) {
+	var err error
+	switch {
+	case req.InvoiceId != "" && req.PartyId != "":
+		return nil, status.Error(codes.InvalidArgument, "release either an invoice_id or a party_id, not both")
+	case req.InvoiceId != "":
+		id, perr := uuid.Parse(req.InvoiceId)
+		if perr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid invoice_id: %v", perr)
+		}
+		err = h.svc.ReleaseInvoice(ctx, id, getUserFromContext(ctx))
+	case req.PartyId != "":
+		id, perr := uuid.Parse(req.PartyId)
+		if perr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid party_id: %v", perr)
+		}
+		err = h.svc.ReleaseParty(ctx, req.GetMeta().GetOrganizationId(), id, getUserFromContext(ctx))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invoice_id or party_id is required")
+	}
+	if err != nil {
+		return nil, ledgerError("release dunning hold", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *DunningHandler) ListDunningHolds(ctx context.Context, req *pb.ListDunningHoldsRequest) (*pb.ListDunningHoldsResponse, error) {
+	holds, err := h.svc.ListHolds(ctx, req.GetMeta().GetOrganizationId())
+	if err != nil {
+		return nil, ledgerError("list dunning holds", err)
+	}
+	resp := &pb.ListDunningHoldsResponse{Holds: make([]*pb.DunningHold, len(holds))}
+	for i, hold := range holds {
+		resp.Holds[i] = toPbDunningHold(hold)
+	}
+	return resp, nil
+}
+
+// RunDunning reports failed steps in the response rather than failing the
+// call, since what the run did is already committed.
+func (h *DunningHandler) RunDunning(ctx context.Context, req *pb.RunDunningRequest) (*pb.RunDunningResponse, error) {
+	asOf := time.Now()
+	if req.AsOf != nil {
+		asOf = req.AsOf.AsTime()
+	}
+	run, err := h.svc.Process(ctx, asOf)
+	var runErr *services.DunningRunError
+	switch {
+	case errors.As(err, &runErr):
+	case err != nil:
+		return nil, ledgerError("run dunning", err)
+	}
+
+	resp := &pb.RunDunningResponse{}
+	for _, d := range run.OverdueDues {
+		resp.OverduePaymentDueIds = append(resp.OverduePaymentDueIds, d.ID.String())
+	}
+	for _, inv := range run.OverdueInvoices {
+		resp.OverdueInvoiceIds = append(resp.OverdueInvoiceIds, inv.ID.String())
+	}
+	for _, r := range run.Reminders {
+		resp.Reminders = append(resp.Reminders, &pb.DunningReminder{
+			InvoiceId:       r.InvoiceID,
+			InvoiceNumber:   r.InvoiceNumber,
+			DaysFromDue:     r.DaysFromDue,
+			EscalationLevel: r.EscalationLevel,
+			Stage:           r.Stage,
+			PartyId:         r.PartyID,
+		})
+	}
+	if runErr != nil {
+		for _, f := range runErr.Failures {
+			resp.Failures = append(resp.Failures, f.Error())
+		}
+	}
+	return resp, nil
+}
+
+func toPbDunningSchedule(orgID string, stages []db.DunningStage) *pb.DunningSchedule {
+	out := &pb.DunningSchedule{OrganizationId: orgID, Stages: make([]*pb.DunningStage, len(stages))}
+	for i, st := range stages {
+		out.Stages[i] = &pb.DunningStage{DaysFromDue: st.DaysFromDue, EscalationLevel: st.EscalationLevel, Name: st.Name}
+	}
+	return out
+}
+
+func toPbDunningHold(h db.DunningHold) *pb.DunningHold {
+	return &pb.DunningHold{
+		Id:             h.ID.String(),
+		OrganizationId: h.OrganizationID,
+		InvoiceId:      nullUUIDString(h.InvoiceID),
+		Reason:         h.Reason,
+		CreatedAt:      timestamppb.New(h.CreatedAt),
+		CreatedBy:      h.CreatedBy.String,
+		PartyId:        nullUUIDString(h.PartyID),
+	}
+}
@@ -0,0 +1,127 @@
+package grpc_server
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+// filterTerm matches one `field op value` comparison of a PageRequest filter.
+// Values may be bare or wrapped in single or double quotes.
+var filterTerm = regexp.MustCompile(`^\s*([a-z_]+)\s*(>=|<=|=)\s*(?:'([^']*)'|"([^"]*)"|(\S+))\s*$`)
+
+var filterAnd = regexp.MustCompile(`(?i)\s+AND\s+`)
+
+// parseEntryFilter turns the filter and order_by of a journal or ledger list
+// request into a ports.EntryFilter. Terms are joined with AND, e.g.
+//
+//	account_id = '…' AND date >= '2025-04-01' AND side = 'DEBIT'
+//
+// dateField names the entity's own date column, accepted alongside "date".
+// Unknown fields and operators are rejected rather than ignored.
+func parseEntryFilter(page *pb.PageRequest, dateField string) (ports.EntryFilter, error) {
+	var f ports.EntryFilter
+	if expr := strings.TrimSpace(page.GetFilter()); expr != "" {
+		for _, term := range filterAnd.Split(expr, -1) {
+			m := filterTerm.FindStringSubmatch(term)
+			if m == nil {
+				return f, fmt.Errorf("cannot parse filter term %q", strings.TrimSpace(term))
+			}
+			field, op, value := m[1], m[2], m[3]+m[4]+m[5]
+			if err := applyFilterTerm(&f, field, op, value, dateField); err != nil {
+				return f, err
+			}
+		}
+	}
+
+	if ob := strings.Fields(page.GetOrderBy()); len(ob) > 0 {
+		if len(ob) > 2 {
+			return f, fmt.Errorf("order_by accepts a single field")
+		}
+		switch ob[0] {
+		case "date", dateField:
+			f.OrderBy = services.OrderByDate
+		case "amount":
+			f.OrderBy = services.OrderByAmount
+		default:
+			return f, fmt.Errorf("unsupported order_by field %q", ob[0])
+		}
+		if len(ob) == 2 {
+			switch strings.ToLower(ob[1]) {
+			case "asc":
+			case "desc":
+				f.Descending = true
+			default:
+				return f, fmt.Errorf("unsupported order_by direction %q", ob[1])
+			}
+		}
+	}
+	return f, nil
+}
+
+func applyFilterTerm(f *ports.EntryFilter, field, op, value, dateField string) error {
+	if field == dateField {
+		field = "date"
+	}
+	if field != "date" && op != "=" {
+		return fmt.Errorf("%s only supports =", field)
+	}
+
+	switch field {
+	case "date":
+		day, err := parseFilterDate(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", field, err)
+		}
+		if op == ">=" || op == "=" {
+			f.FromDate = day
+		}
+		if op == "<=" || op == "=" {
+			f.ToDate = endOfDay(day, value)
+		}
+	case "account_id", "cost_center_id":
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", field, err)
+		}
+		if field == "account_id" {
+			f.AccountID = uuid.NullUUID{UUID: id, Valid: true}
+		} else {
+			f.CostCenterID = uuid.NullUUID{UUID: id, Valid: true}
+		}
+	case "side":
+		f.Side = strings.ToUpper(value)
+	case "reference_type":
+		f.ReferenceType = value
+	case "reference_id":
+		f.ReferenceID = value
+	case "source_type":
+		f.SourceType = value
+	default:
+		return fmt.Errorf("unsupported filter field %q", field)
+	}
+	return nil
+}
+
+// parseFilterDate accepts a calendar day or an RFC 3339 timestamp.
+func parseFilterDate(s string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// endOfDay makes an upper bound given as a bare day cover the whole day.
+func endOfDay(t time.Time, raw string) time.Time {
+	if len(raw) == len("2006-01-02") {
+		return t.AddDate(0, 0, 1).Add(-time.Microsecond)
+	}
+	return t
+}
@@ -17,10 +17,11 @@ import (
 type ExchangeRateHandler struct {
 	 pb.UnimplementedFxServiceServer // ← required!
 	svc ports.ExchangeRateService
+	reval ports.FXRevaluationService
 }
 
-func NewExchangeRateHandler(svc ports.ExchangeRateService) *ExchangeRateHandler {
-	return &ExchangeRateHandler{svc: svc}
+func NewExchangeRateHandler(svc ports.ExchangeRateService, reval ports.FXRevaluationService) *ExchangeRateHandler {
+	return &ExchangeRateHandler{svc: svc, reval: reval}
 }
 
 func (h *ExchangeRateHandler) CreateExchangeRate(ctx context.Context, req *pb.CreateExchangeRateRequest) (*pb.ExchangeRate, error) {
@@ -33,6 +34,7 @@ func (h *ExchangeRateHandler) CreateExchangeRate(ctx context.Context, req *pb.Cr
 		QuoteCurrency: req.Rate.QuoteCurrency,
 		Rate:          rateStr, // now string
 		AsOf:          req.Rate.AsOf.AsTime(),
+		RateType:      req.Rate.RateType,
 		CreatedBy:     toNullString(req.Meta.AuthSubject),
 	}
 
@@ -62,15 +64,27 @@ func (h *ExchangeRateHandler) UpdateExchangeRate(ctx context.Context, req *pb.Up
         return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
     }
 
+    in, err := maskedUpdate(req.UpdateMask, req.Rate, func() (*pb.ExchangeRate, error) {
+        rate, err := h.svc.Get(ctx, id)
+        if err != nil {
+            return nil, status.Errorf(codes.NotFound, "not found: %v", err)
+        }
+        return mapDomainToProtoExchangeRate(rate), nil
+    }, "id", "audit")
+    if err != nil {
+        return nil, err
+    }
+
     // Convert float64 → string
-    rateStr := strconv.FormatFloat(req.Rate.Rate, 'f', -1, 64)
+    rateStr := strconv.FormatFloat(in.Rate, 'f', -1, 64)
 
     rate := db.ExchangeRate{
         ID:            id,
-        BaseCurrency:  req.Rate.BaseCurrency,
-        QuoteCurrency: req.Rate.QuoteCurrency,
+        BaseCurrency:  in.BaseCurrency,
+        QuoteCurrency: in.QuoteCurrency,
         Rate:          rateStr,
-        AsOf:          req.Rate.AsOf.AsTime(),
+        AsOf:          in.AsOf.AsTime(),
+        RateType:      in.RateType,
         UpdatedBy:     toNullString(req.Meta.AuthSubject),
     }
 
@@ -25,7 +25,7 @@ func (h *ExpenseHandler) CreateExpense(c *gin.Context) {
 	}
 	exp, err := h.service.CreateExpense(c, req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(httpErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 	c.JSON(http.StatusCreated, exp)
@@ -65,10 +65,19 @@ func (h *ExpenseHandler) UpdateExpense(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	// Only the fields named in update_mask change; without one the body
+	// replaces the stored row.
+	req, code, err := maskedRow(c.Query("update_mask"), req, func() (db.Expense, error) {
+		return h.service.GetExpense(c, id)
+	})
+	if err != nil {
+		c.JSON(code, gin.H{"error": err.Error()})
+		return
+	}
 	req.ID = id
 	updated, err := h.service.UpdateExpense(c, req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(httpErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 	c.JSON(http.StatusOK, updated)
@@ -81,7 +90,7 @@ func (h *ExpenseHandler) DeleteExpense(c *gin.Context) {
 		return
 	}
 	if err := h.service.DeleteExpense(c, id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(httpErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 	c.Status(http.StatusNoContent)
@@ -143,6 +152,15 @@ func (h *CostCenterHandler) UpdateCostCenter(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	// Only the fields named in update_mask change; without one the body
+	// replaces the stored row.
+	req, code, err := maskedRow(c.Query("update_mask"), req, func() (db.CostCenter, error) {
+		return h.service.GetCostCenter(c, id)
+	})
+	if err != nil {
+		c.JSON(code, gin.H{"error": err.Error()})
+		return
+	}
 	req.ID = id
 	updated, err := h.service.UpdateCostCenter(c, req)
 	if err != nil {
@@ -0,0 +1,162 @@
+package grpc_server
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+type FiscalPeriodHandler struct {
+	pb.UnimplementedFiscalPeriodServiceServer
+	svc ports.FiscalPeriodService
+}
+
+func NewFiscalPeriodHandler(svc ports.FiscalPeriodService) *FiscalPeriodHandler {
+	return &FiscalPeriodHandler{svc: svc}
+}
+
+func (h *FiscalPeriodHandler) SetFiscalCalendar(ctx context.Context, req *pb.SetFiscalCalendarRequest) (*pb.FiscalCalendar, error) {
+	c := req.GetCalendar()
+	if c == nil {
+		return nil, status.Error(codes.InvalidArgument, "calendar is required")
+	}
+	orgID := c.OrganizationId
+	if orgID == "" {
+		orgID = req.GetMeta().GetOrganizationId()
+	}
+	var retained uuid.NullUUID
+	if c.RetainedEarningsAccountId != "" {
+		id, err := uuid.Parse(c.RetainedEarningsAccountId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid retained_earnings_account_id: %v", err)
+		}
+		retained = uuid.NullUUID{UUID: id, Valid: true}
+	}
+
+	cal, err := h.svc.SetCalendar(ctx, db.FiscalCalendar{
+		OrganizationID:            orgID,
+		YearStartMonth:            int16(c.YearStartMonth),
+		RetainedEarningsAccountID: retained,
+		FunctionalCurrency:        toNullString(c.FunctionalCurrency),
+		UpdatedBy:                 toNullString(getUserFromContext(ctx)),
+	})
+	if err != nil {
+		return nil, ledgerError("set fiscal calendar", err)
+	}
+	return toPbFiscalCalendar(cal), nil
+}
+
+func (h *FiscalPeriodHandler) CreateFiscalYear(ctx context.Context, req *pb.CreateFiscalYearRequest) (*pb.ListFiscalPeriodsResponse, error) {
+	periods, err := h.svc.CreateFiscalYear(ctx, req.GetMeta().GetOrganizationId(), req.FiscalYear)
+	if err != nil {
+		return nil, ledgerError("create fiscal year", err)
+	}
+	return &pb.ListFiscalPeriodsResponse{Periods: toPbFiscalPeriods(periods)}, nil
+}
+
+func (h *FiscalPeriodHandler) ListFiscalPeriods(ctx context.Context, req *pb.ListFiscalPeriodsRequest) (*pb.ListFiscalPeriodsResponse, error) {
+	periods, err := h.svc.ListPeriods(ctx, req.GetMeta().GetOrganizationId(), req.FiscalYear)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list fiscal periods: %v", err)
+	}
+	return &pb.ListFiscalPeriodsResponse{Periods: toPbFiscalPeriods(periods)}, nil
+}
+
+func (h *FiscalPeriodHandler) OpenPeriod(ctx context.Context, req *pb.OpenPeriodRequest) (*pb.FiscalPeriod, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	p, err := h.svc.OpenPeriod(ctx, id, getUserFromContext(ctx))
+	if err != nil {
+		return nil, ledgerError("open period", err)
+	}
+	return toPbFiscalPeriod(p), nil
+}
+
+func (h *FiscalPeriodHandler) ClosePeriod(ctx context.Context, req *pb.ClosePeriodRequest) (*pb.FiscalPeriod, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	p, err := h.svc.ClosePeriod(ctx, id, req.Soft, getUserFromContext(ctx))
+	if err != nil {
+		return nil, ledgerError("close period", err)
+	}
+	return toPbFiscalPeriod(p), nil
+}
+
+func (h *FiscalPeriodHandler) CloseFiscalYear(ctx context.Context, req *pb.CloseFiscalYearRequest) (*pb.CloseFiscalYearResponse, error) {
+	closing, periods, err := h.svc.CloseFiscalYear(ctx, req.GetMeta().GetOrganizationId(), req.FiscalYear, getUserFromContext(ctx))
+	if err != nil {
+		return nil, ledgerError("close fiscal year", err)
+	}
+	resp := &pb.CloseFiscalYearResponse{Periods: toPbFiscalPeriods(periods)}
+	if closing != nil {
+		resp.ClosingEntry = toPbJournalEntry(closing)
+	}
+	return resp, nil
+}
+
+func toPbFiscalCalendar(c db.FiscalCalendar) *pb.FiscalCalendar {
+	return &pb.FiscalCalendar{
+		OrganizationId:            c.OrganizationID,
+		YearStartMonth:            int32(c.YearStartMonth),
+		RetainedEarningsAccountId: nullUUIDString(c.RetainedEarningsAccountID),
+		FunctionalCurrency:        nullStringValue(c.FunctionalCurrency),
+	}
+}
+
+func toPbFiscalPeriods(in []db.FiscalPeriod) []*pb.FiscalPeriod {
+	out := make([]*pb.FiscalPeriod, len(in))
+	for i, p := range in {
+		out[i] = toPbFiscalPeriod(p)
+	}
+	return out
+}
+
+func toPbFiscalPeriod(p db.FiscalPeriod) *pb.FiscalPeriod {
+	out := &pb.FiscalPeriod{
+		Id:             p.ID.String(),
+		OrganizationId: p.OrganizationID,
+		FiscalYear:     p.FiscalYear,
+		PeriodNumber:   int32(p.PeriodNumber),
+		StartDate:      timestamppb.New(p.StartDate),
+		EndDate:        timestamppb.New(p.EndDate),
+		Status:         toPbPeriodStatus(p.Status),
+		ClosedBy:       nullStringValue(p.ClosedBy),
+	}
+	if p.ClosedAt.Valid {
+		out.ClosedAt = timestamppb.New(p.ClosedAt.Time)
+	}
+	return out
+}
+
+func nullStringValue(s sql.NullString) string {
+	if !s.Valid {
+		return ""
+	}
+	return s.String
+}
+
+func toPbPeriodStatus(s string) pb.PeriodStatus {
+	switch s {
+	case services.PeriodOpen:
+		return pb.PeriodStatus_PERIOD_OPEN
+	case services.PeriodSoftClosed:
+		return pb.PeriodStatus_PERIOD_SOFT_CLOSED
+	case services.PeriodClosed:
+		return pb.PeriodStatus_PERIOD_CLOSED
+	default:
+		return pb.PeriodStatus_PERIOD_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,115 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+func (h *ExchangeRateHandler) SetFxRevaluationSettings(ctx context.Context, req *pb.SetFxRevaluationSettingsRequest) (*pb.FxRevaluationSettings, error) {
+	in := req.GetSettings()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "settings are required")
+	}
+	orgID := in.OrganizationId
+	if orgID == "" {
+		orgID = req.GetMeta().GetOrganizationId()
+	}
+	gain, err := uuid.Parse(in.UnrealizedGainAccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid unrealized_gain_account_id: %v", err)
+	}
+	loss, err := uuid.Parse(in.UnrealizedLossAccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid unrealized_loss_account_id: %v", err)
+	}
+	accounts := make([]uuid.UUID, len(in.AccountIds))
+	for i, s := range in.AccountIds {
+		if accounts[i], err = uuid.Parse(s); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid account_ids[%d]: %v", i, err)
+		}
+	}
+
+	st, err := h.reval.SetSettings(ctx, db.FxRevaluationSetting{
+		OrganizationID:          orgID,
+		UnrealizedGainAccountID: gain,
+		UnrealizedLossAccountID: loss,
+		AccountIds:              accounts,
+		UpdatedBy:               toNullString(getUserFromContext(ctx)),
+	})
+	if err != nil {
+		return nil, ledgerError("set FX revaluation settings", err)
+	}
+	return toPbFxRevaluationSettings(st), nil
+}
+
+func (h *ExchangeRateHandler) GetFxRevaluationSettings(ctx context.Context, req *pb.GetFxRevaluationSettingsRequest) (*pb.FxRevaluationSettings, error) {
+	st, err := h.reval.GetSettings(ctx, req.GetMeta().GetOrganizationId())
+	if err != nil {
+		return nil, ledgerError("get FX revaluation settings", err)
+	}
+	return toPbFxRevaluationSettings(st), nil
+}
+
+func (h *ExchangeRateHandler) RevalueForeignCurrency(ctx context.Context, req *pb.RevalueForeignCurrencyRequest) (*pb.RevalueForeignCurrencyResponse, error) {
+	if req.AsOf == nil {
+		return nil, status.Error(codes.InvalidArgument, "as_of is required")
+	}
+	res, err := h.reval.Revalue(ctx, req.GetMeta().GetOrganizationId(), req.AsOf.AsTime(), req.RateType, getUserFromContext(ctx))
+	if err != nil {
+		return nil, ledgerError("revalue foreign currency", err)
+	}
+	return toPbFxRevaluation(res), nil
+}
+
+func toPbFxRevaluationSettings(st db.FxRevaluationSetting) *pb.FxRevaluationSettings {
+	accounts := make([]string, len(st.AccountIds))
+	for i, id := range st.AccountIds {
+		accounts[i] = id.String()
+	}
+	return &pb.FxRevaluationSettings{
+		OrganizationId:          st.OrganizationID,
+		UnrealizedGainAccountId: st.UnrealizedGainAccountID.String(),
+		UnrealizedLossAccountId: st.UnrealizedLossAccountID.String(),
+		AccountIds:              accounts,
+		Audit: &pb.AuditFields{
+			CreatedAt: timestamppb.New(st.CreatedAt),
+			CreatedBy: st.CreatedBy.String,
+			UpdatedAt: timestamppb.New(st.UpdatedAt),
+			UpdatedBy: st.UpdatedBy.String,
+		},
+	}
+}
+
+func toPbFxRevaluation(r *ports.FXRevaluation) *pb.RevalueForeignCurrencyResponse {
+	resp := &pb.RevalueForeignCurrencyResponse{
+		AsOf:               timestamppb.New(r.AsOf),
+		RateType:           r.RateType,
+		FunctionalCurrency: r.FunctionalCurrency,
+		Items:              make([]*pb.FxRevaluationItem, len(r.Items)),
+	}
+	for i, it := range r.Items {
+		resp.Items[i] = &pb.FxRevaluationItem{
+			Source:         it.Source,
+			SourceId:       it.SourceID,
+			Description:    it.Description,
+			AccountId:      it.AccountID.String(),
+			Balance:        toPbMoney(it.Balance, it.Currency),
+			CarryingAmount: toPbMoney(it.CarryingAmount, r.FunctionalCurrency),
+			Rate:           it.ClosingRate,
+			RevaluedAmount: toPbMoney(it.RevaluedAmount, r.FunctionalCurrency),
+			Difference:     toPbMoney(it.Difference, r.FunctionalCurrency),
+		}
+	}
+	if r.Journal != nil {
+		resp.Journal = toPbJournalEntry(r.Journal)
+	}
+	return resp
+}
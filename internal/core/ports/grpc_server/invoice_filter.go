@@ -0,0 +1,105 @@
+package grpc_server
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+// parseInvoiceFilter turns the filter and order_by of an invoice search into
+// a ports.InvoiceFilter, with the grammar of parseEntryFilter, e.g.
+//
+//	status = 'ISSUED' AND invoice_date >= '2025-04-01' AND grand_total >= 10000
+//
+// Statuses and types may be given bare or as their enum names. Results are
+// ordered by relevance, invoice_date or grand_total.
+func parseInvoiceFilter(page *pb.PageRequest) (ports.InvoiceFilter, error) {
+	var f ports.InvoiceFilter
+	if expr := strings.TrimSpace(page.GetFilter()); expr != "" {
+		for _, term := range filterAnd.Split(expr, -1) {
+			m := filterTerm.FindStringSubmatch(term)
+			if m == nil {
+				return f, fmt.Errorf("cannot parse filter term %q", strings.TrimSpace(term))
+			}
+			field, op, value := m[1], m[2], m[3]+m[4]+m[5]
+			if err := applyInvoiceFilterTerm(&f, field, op, value); err != nil {
+				return f, err
+			}
+		}
+	}
+
+	if ob := strings.Fields(page.GetOrderBy()); len(ob) > 0 {
+		if len(ob) > 2 {
+			return f, fmt.Errorf("order_by accepts a single field")
+		}
+		switch ob[0] {
+		case services.OrderByRelevance:
+			f.OrderBy = services.OrderByRelevance
+		case "date", "invoice_date":
+			f.OrderBy = services.OrderByDate
+		case "amount", "grand_total":
+			f.OrderBy = services.OrderByAmount
+		default:
+			return f, fmt.Errorf("unsupported order_by field %q", ob[0])
+		}
+		if len(ob) == 2 {
+			switch strings.ToLower(ob[1]) {
+			case "asc":
+			case "desc":
+				f.Descending = true
+			default:
+				return f, fmt.Errorf("unsupported order_by direction %q", ob[1])
+			}
+		}
+	}
+	return f, nil
+}
+
+func applyInvoiceFilterTerm(f *ports.InvoiceFilter, field, op, value string) error {
+	switch field {
+	case "date":
+		field = "invoice_date"
+	case "amount":
+		field = "grand_total"
+	}
+
+	switch field {
+	case "status", "type":
+		if op != "=" {
+			return fmt.Errorf("%s only supports =", field)
+		}
+		if field == "status" {
+			f.Status = strings.TrimPrefix(strings.ToUpper(value), "INVOICE_STATUS_")
+		} else {
+			f.Type = strings.TrimPrefix(strings.ToUpper(value), "INVOICE_TYPE_")
+		}
+	case "invoice_date", "due_date":
+		day, err := parseFilterDate(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", field, err)
+		}
+		from, to := &f.FromDate, &f.ToDate
+		if field == "due_date" {
+			from, to = &f.DueFrom, &f.DueTo
+		}
+		if op == ">=" || op == "=" {
+			*from = day
+		}
+		if op == "<=" || op == "=" {
+			*to = endOfDay(day, value)
+		}
+	case "grand_total":
+		if op == ">=" || op == "=" {
+			f.MinTotal = value
+		}
+		if op == "<=" || op == "=" {
+			f.MaxTotal = value
+		}
+	default:
+		return fmt.Errorf("unsupported filter field %q", field)
+	}
+	return nil
+}
@@ -0,0 +1,813 @@
+package grpc_server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/invoicepdf"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/ubl"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+// InvoiceGRPCHandler serves InvoiceService over gRPC. Totals, tax amounts
+// and the GST breakup are computed by the service; figures a client sends
+// are checked against them.
+type InvoiceGRPCHandler struct {
+	pb.UnimplementedInvoiceServiceServer
+	svc       InvoiceServiceInterface
+	einvoices ports.EInvoiceService
+	// schemas are run over every e-invoice exported or imported, in
+	// addition to the ubl package's own rules.
+	schemas []ubl.Checker
+}
+
+func NewInvoiceGRPCHandler(svc InvoiceServiceInterface, einvoices ports.EInvoiceService, schemas ...ubl.Checker) *InvoiceGRPCHandler {
+	return &InvoiceGRPCHandler{svc: svc, einvoices: einvoices, schemas: schemas}
+}
+
+func (h *InvoiceGRPCHandler) CreateInvoice(ctx context.Context, req *pb.CreateInvoiceRequest) (*pb.Invoice, error) {
+	if req.Invoice == nil {
+		return nil, status.Error(codes.InvalidArgument, "invoice is required")
+	}
+	inv, err := fromPbInvoice(req.Invoice)
+	if err != nil {
+		return nil, err
+	}
+	inv.ID = uuid.New()
+	if org := req.GetMeta().GetOrganizationId(); org != "" {
+		inv.OrganizationID = org
+	}
+	inv.CreatedBy = toNullString(getUserFromContext(ctx))
+	inv.UpdatedBy = inv.CreatedBy
+
+	created, err := h.svc.CreateInvoice(ctx, inv)
+	if err != nil {
+		return nil, invoiceError("create invoice", err)
+	}
+	return toPbInvoice(created), nil
+}
+
+func (h *InvoiceGRPCHandler) GetInvoice(ctx context.Context, req *pb.GetInvoiceRequest) (*pb.Invoice, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	inv, err := h.svc.GetInvoice(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "invoice %s not found", id)
+	}
+	if err != nil {
+		return nil, invoiceError("get invoice", err)
+	}
+	return toPbInvoice(inv), nil
+}
+
+func (h *InvoiceGRPCHandler) ListInvoices(ctx context.Context, req *pb.ListInvoicesRequest) (*pb.ListInvoicesResponse, error) {
+	limit, offset, err := invoicePage(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := h.svc.ListInvoices(ctx, limit, offset)
+	if err != nil {
+		return nil, invoiceError("list invoices", err)
+	}
+	return toPbInvoiceList(invoices, limit, offset), nil
+}
+
+func (h *InvoiceGRPCHandler) SearchInvoices(ctx context.Context, req *pb.SearchInvoicesRequest) (*pb.ListInvoicesResponse, error) {
+	limit, offset, err := invoicePage(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+	f, err := parseInvoiceFilter(req.GetPage())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	f.OrganizationID = req.GetMeta().GetOrganizationId()
+	f.Query = req.Query
+	invoices, err := h.svc.SearchInvoices(ctx, f, limit, offset)
+	if err != nil {
+		return nil, invoiceError("search invoices", err)
+	}
+	return toPbInvoiceList(invoices, limit, offset), nil
+}
+
+// invoiceImmutable are the invoice fields an update mask cannot name: they
+// are set by the status RPCs and the server.
+var invoiceImmutable = []string{
+	"id", "organization_id", "status", "audit", "issued_at", "issued_by", "voided_at", "voided_by", "void_reason",
+	"overdue_at", "overdue_by", "journal_id", "credit_approved_by", "credit_approved_at",
+	"credited_total", "debited_total", "net_billed",
+}
+
+// UpdateInvoice replaces a DRAFT invoice, lines included, or with an
+// update_mask only the fields it names. Figures are recomputed either way;
+// those the client sends within the mask are checked.
+func (h *InvoiceGRPCHandler) UpdateInvoice(ctx context.Context, req *pb.UpdateInvoiceRequest) (*pb.Invoice, error) {
+	if req.Invoice == nil {
+		return nil, status.Error(codes.InvalidArgument, "invoice is required")
+	}
+	id, err := uuid.Parse(req.Invoice.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	in, err := maskedUpdate(req.UpdateMask, req.Invoice, func() (*pb.Invoice, error) {
+		current, err := h.svc.GetInvoice(ctx, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "invoice %s not found", id)
+		}
+		if err != nil {
+			return nil, invoiceError("update invoice", err)
+		}
+		return withoutFigures(toPbInvoice(current)), nil
+	}, invoiceImmutable...)
+	if err != nil {
+		return nil, err
+	}
+	inv, err := fromPbInvoice(in)
+	if err != nil {
+		return nil, err
+	}
+	inv.ID = id
+	inv.UpdatedBy = toNullString(getUserFromContext(ctx))
+
+	updated, err := h.svc.UpdateInvoice(ctx, inv)
+	if err != nil {
+		return nil, invoiceError("update invoice", err)
+	}
+	return toPbInvoice(updated), nil
+}
+
+// withoutFigures clears the computed figures of a stored invoice, so that a
+// masked update does not check the new figures against the old.
+func withoutFigures(inv *pb.Invoice) *pb.Invoice {
+	inv.Subtotal, inv.GrandTotal, inv.GstBreakup = nil, nil, nil
+	for _, it := range inv.Items {
+		it.LineSubtotal, it.LineTotal = nil, nil
+		for _, t := range it.Taxes {
+			t.Amount = nil
+		}
+	}
+	for _, t := range inv.Taxes {
+		t.Amount = nil
+	}
+	return inv
+}
+
+func (h *InvoiceGRPCHandler) DeleteInvoice(ctx context.Context, req *pb.DeleteInvoiceRequest) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	if err := h.svc.DeleteInvoice(ctx, id); err != nil {
+		return nil, invoiceError("delete invoice", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ---------------- Status transitions ----------------
+
+func (h *InvoiceGRPCHandler) IssueInvoice(ctx context.Context, req *pb.IssueInvoiceRequest) (*pb.Invoice, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	inv, err := h.svc.IssueInvoice(ctx, id, getUserFromContext(ctx))
+	if err != nil {
+		return nil, invoiceError("issue invoice", err)
+	}
+	return toPbInvoice(inv), nil
+}
+
+func (h *InvoiceGRPCHandler) VoidInvoice(ctx context.Context, req *pb.VoidInvoiceRequest) (*pb.Invoice, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	inv, err := h.svc.VoidInvoice(ctx, id, req.Reason, getUserFromContext(ctx))
+	if err != nil {
+		return nil, invoiceError("void invoice", err)
+	}
+	return toPbInvoice(inv), nil
+}
+
+func (h *InvoiceGRPCHandler) MarkOverdue(ctx context.Context, req *pb.MarkOverdueRequest) (*pb.Invoice, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	asOf := time.Now()
+	if req.AsOf != nil {
+		asOf = req.AsOf.AsTime()
+	}
+	inv, err := h.svc.MarkOverdue(ctx, id, asOf, getUserFromContext(ctx))
+	if err != nil {
+		return nil, invoiceError("mark invoice overdue", err)
+	}
+	return toPbInvoice(inv), nil
+}
+
+// ---------------- Ledger posting ----------------
+
+func (h *InvoiceGRPCHandler) SetInvoicePostingRule(ctx context.Context, req *pb.SetInvoicePostingRuleRequest) (*pb.InvoicePostingRule, error) {
+	in := req.GetRule()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule is required")
+	}
+	orgID := in.OrganizationId
+	if orgID == "" {
+		orgID = req.GetMeta().GetOrganizationId()
+	}
+	control, err := uuid.Parse(in.ControlAccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid control_account_id: %v", err)
+	}
+	line, err := uuid.Parse(in.LineAccountId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid line_account_id: %v", err)
+	}
+	rule := db.InvoicePostingRule{
+		OrganizationID:   orgID,
+		InvoiceType:      fromPbInvoiceType(in.InvoiceType),
+		ControlAccountID: control,
+		LineAccountID:    line,
+		UpdatedBy:        toNullString(getUserFromContext(ctx)),
+	}
+	for _, f := range []struct {
+		name string
+		in   string
+		out  *uuid.NullUUID
+	}{
+		{"cgst_account_id", in.CgstAccountId, &rule.CgstAccountID},
+		{"sgst_account_id", in.SgstAccountId, &rule.SgstAccountID},
+		{"igst_account_id", in.IgstAccountId, &rule.IgstAccountID},
+		{"other_tax_account_id", in.OtherTaxAccountId, &rule.OtherTaxAccountID},
+		{"discount_account_id", in.DiscountAccountId, &rule.DiscountAccountID},
+		{"round_off_account_id", in.RoundOffAccountId, &rule.RoundOffAccountID},
+	} {
+		if *f.out, err = parseOptionalUUID(f.name, f.in); err != nil {
+			return nil, err
+		}
+	}
+
+	saved, err := h.svc.SetPostingRule(ctx, rule)
+	if err != nil {
+		return nil, invoiceError("set invoice posting rule", err)
+	}
+	return toPbInvoicePostingRule(saved), nil
+}
+
+func (h *InvoiceGRPCHandler) GetInvoicePostingRule(ctx context.Context, req *pb.GetInvoicePostingRuleRequest) (*pb.InvoicePostingRule, error) {
+	rule, err := h.svc.GetPostingRule(ctx, req.GetMeta().GetOrganizationId(), fromPbInvoiceType(req.InvoiceType))
+	if err != nil {
+		return nil, invoiceError("get invoice posting rule", err)
+	}
+	return toPbInvoicePostingRule(rule), nil
+}
+
+func toPbInvoicePostingRule(r db.InvoicePostingRule) *pb.InvoicePostingRule {
+	return &pb.InvoicePostingRule{
+		OrganizationId:    r.OrganizationID,
+		InvoiceType:       toPbInvoiceType(r.InvoiceType),
+		ControlAccountId:  r.ControlAccountID.String(),
+		LineAccountId:     r.LineAccountID.String(),
+		CgstAccountId:     nullUUIDString(r.CgstAccountID),
+		SgstAccountId:     nullUUIDString(r.SgstAccountID),
+		IgstAccountId:     nullUUIDString(r.IgstAccountID),
+		OtherTaxAccountId: nullUUIDString(r.OtherTaxAccountID),
+		DiscountAccountId: nullUUIDString(r.DiscountAccountID),
+		RoundOffAccountId: nullUUIDString(r.RoundOffAccountID),
+		Audit: &pb.AuditFields{
+			CreatedAt: timestamppb.New(r.CreatedAt),
+			CreatedBy: r.CreatedBy.String,
+			UpdatedAt: timestamppb.New(r.UpdatedAt),
+			UpdatedBy: r.UpdatedBy.String,
+		},
+	}
+}
+
+func (h *InvoiceGRPCHandler) SetInvoiceNumberSeries(ctx context.Context, req *pb.SetInvoiceNumberSeriesRequest) (*pb.InvoiceNumberSeries, error) {
+	in := req.GetSeries()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "series is required")
+	}
+	orgID := in.OrganizationId
+	if orgID == "" {
+		orgID = req.GetMeta().GetOrganizationId()
+	}
+	saved, err := h.svc.SetNumberSeries(ctx, db.InvoiceNumberSeries{
+		OrganizationID: orgID,
+		InvoiceType:    fromPbInvoiceType(in.InvoiceType),
+		Pattern:        in.Pattern,
+		UpdatedBy:      toNullString(getUserFromContext(ctx)),
+	})
+	if err != nil {
+		return nil, invoiceError("set invoice number series", err)
+	}
+	return toPbInvoiceNumberSeries(saved), nil
+}
+
+func (h *InvoiceGRPCHandler) GetInvoiceNumberSeries(ctx context.Context, req *pb.GetInvoiceNumberSeriesRequest) (*pb.InvoiceNumberSeries, error) {
+	series, err := h.svc.GetNumberSeries(ctx, req.GetMeta().GetOrganizationId(), fromPbInvoiceType(req.InvoiceType))
+	if err != nil {
+		return nil, invoiceError("get invoice number series", err)
+	}
+	return toPbInvoiceNumberSeries(series), nil
+}
+
+// RenderInvoice renders the customer-facing PDF of an invoice with its
+// organization's template.
+func (h *InvoiceGRPCHandler) RenderInvoice(ctx context.Context, req *pb.RenderInvoiceRequest) (*pb.RenderedInvoice, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	doc, err := h.svc.InvoiceDocument(ctx, id)
+	if err != nil {
+		return nil, invoiceError("render invoice", err)
+	}
+	content, err := invoicepdf.Render(doc)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "render invoice: %v", err)
+	}
+	return &pb.RenderedInvoice{
+		FileName:    invoicepdf.FileName(doc.Invoice),
+		ContentType: invoicepdf.ContentType,
+		Content:     content,
+		Revision:    doc.Invoice.Revision.Int32,
+	}, nil
+}
+
+// ExportInvoice writes an issued sales invoice as a PEPPOL BIS Billing 3.0
+// UBL document. An invoice that would not make a valid e-invoice fails with
+// the broken rules as field violations.
+func (h *InvoiceGRPCHandler) ExportInvoice(ctx context.Context, req *pb.ExportInvoiceRequest) (*pb.RenderedInvoice, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	e, err := h.einvoices.ExportDocument(ctx, id)
+	if err != nil {
+		return nil, invoiceError("export invoice", err)
+	}
+	content, err := ubl.Export(e, h.schemas...)
+	if err != nil {
+		return nil, einvoiceError("export invoice", codes.FailedPrecondition, err)
+	}
+	return &pb.RenderedInvoice{
+		FileName:    ubl.FileName(e.Invoice),
+		ContentType: ubl.ContentType,
+		Content:     content,
+		Revision:    e.Invoice.Revision.Int32,
+	}, nil
+}
+
+// ImportInvoice records an inbound UBL invoice as a DRAFT purchase invoice
+// of the organization in meta.
+func (h *InvoiceGRPCHandler) ImportInvoice(ctx context.Context, req *pb.ImportInvoiceRequest) (*pb.Invoice, error) {
+	if len(req.GetContent()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+	e, err := ubl.Parse(req.Content, h.schemas...)
+	if err != nil {
+		return nil, einvoiceError("import invoice", codes.InvalidArgument, err)
+	}
+	inv, err := h.einvoices.Import(ctx, req.GetMeta().GetOrganizationId(), e, getUserFromContext(ctx))
+	if err != nil {
+		return nil, invoiceError("import invoice", err)
+	}
+	return toPbInvoice(inv), nil
+}
+
+func (h *InvoiceGRPCHandler) SetInvoiceTemplate(ctx context.Context, req *pb.SetInvoiceTemplateRequest) (*pb.InvoiceTemplate, error) {
+	in := req.GetTemplate()
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "template is required")
+	}
+	orgID := in.OrganizationId
+	if orgID == "" {
+		orgID = req.GetMeta().GetOrganizationId()
+	}
+	saved, err := h.svc.SetTemplate(ctx, db.InvoiceTemplate{
+		OrganizationID: orgID,
+		CompanyName:    in.CompanyName,
+		Address:        toNullString(in.Address),
+		Logo:           in.Logo,
+		BankDetails:    toNullString(in.BankDetails),
+		Terms:          toNullString(in.Terms),
+		Footer:         toNullString(in.Footer),
+		CountryCode:    in.CountryCode,
+		PeppolEndpoint: toNullString(in.PeppolEndpoint),
+		UpdatedBy:      toNullString(getUserFromContext(ctx)),
+	})
+	if err != nil {
+		return nil, invoiceError("set invoice template", err)
+	}
+	return toPbInvoiceTemplate(saved), nil
+}
+
+func (h *InvoiceGRPCHandler) GetInvoiceTemplate(ctx context.Context, req *pb.GetInvoiceTemplateRequest) (*pb.InvoiceTemplate, error) {
+	t, err := h.svc.GetTemplate(ctx, req.GetMeta().GetOrganizationId())
+	if err != nil {
+		return nil, invoiceError("get invoice template", err)
+	}
+	return toPbInvoiceTemplate(t), nil
+}
+
+func toPbInvoiceTemplate(t db.InvoiceTemplate) *pb.InvoiceTemplate {
+	return &pb.InvoiceTemplate{
+		OrganizationId: t.OrganizationID,
+		CompanyName:    t.CompanyName,
+		Address:        t.Address.String,
+		Logo:           t.Logo,
+		BankDetails:    t.BankDetails.String,
+		Terms:          t.Terms.String,
+		Footer:         t.Footer.String,
+		CountryCode:    t.CountryCode,
+		PeppolEndpoint: t.PeppolEndpoint.String,
+		Audit: &pb.AuditFields{
+			CreatedAt: timestamppb.New(t.CreatedAt),
+			CreatedBy: t.CreatedBy.String,
+			UpdatedAt: timestamppb.New(t.UpdatedAt),
+			UpdatedBy: t.UpdatedBy.String,
+		},
+	}
+}
+
+func toPbInvoiceNumberSeries(s db.InvoiceNumberSeries) *pb.InvoiceNumberSeries {
+	return &pb.InvoiceNumberSeries{
+		OrganizationId: s.OrganizationID,
+		InvoiceType:    toPbInvoiceType(s.InvoiceType),
+		Pattern:        s.Pattern,
+		Audit: &pb.AuditFields{
+			CreatedAt: timestamppb.New(s.CreatedAt),
+			CreatedBy: s.CreatedBy.String,
+			UpdatedAt: timestamppb.New(s.UpdatedAt),
+			UpdatedBy: s.UpdatedBy.String,
+		},
+	}
+}
+
+// invoiceError maps service errors to gRPC codes like ledgerError, giving
+// totals that disagree with the computed ones a BadRequest detail per field.
+func invoiceError(op string, err error) error {
+	var terr *services.InvoiceTotalsError
+	if errors.As(err, &terr) {
+		br := &errdetails.BadRequest{}
+		for _, m := range terr.Mismatches {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       "invoice." + m.Field,
+				Description: fmt.Sprintf("sent %s, computed %s", m.Client, m.Computed),
+			})
+		}
+		st := status.New(codes.InvalidArgument, fmt.Sprintf("%s: %v", op, err))
+		if detailed, derr := st.WithDetails(br); derr == nil {
+			return detailed.Err()
+		}
+		return st.Err()
+	}
+	return ledgerError(op, err)
+}
+
+// einvoiceError reports the rules a UBL document breaks as field
+// violations, the field being the element's path.
+func einvoiceError(op string, code codes.Code, err error) error {
+	var verr *ubl.ValidationError
+	if !errors.As(err, &verr) {
+		return status.Errorf(codes.Internal, "%s: %v", op, err)
+	}
+	br := &errdetails.BadRequest{}
+	for _, v := range verr.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Path,
+			Description: fmt.Sprintf("%s: %s", v.Rule, v.Reason),
+		})
+	}
+	st := status.New(code, fmt.Sprintf("%s: %v", op, err))
+	if detailed, derr := st.WithDetails(br); derr == nil {
+		return detailed.Err()
+	}
+	return st.Err()
+}
+
+func invoicePage(page *pb.PageRequest) (limit, offset int32, err error) {
+	limit = 50
+	if page.GetPageSize() > 0 {
+		limit = page.PageSize
+	}
+	if page.GetPageToken() != "" {
+		o, err := strconv.Atoi(page.PageToken)
+		if err != nil {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "invalid page_token")
+		}
+		offset = int32(o)
+	}
+	return limit, offset, nil
+}
+
+func toPbInvoiceList(invoices []db.Invoice, limit, offset int32) *pb.ListInvoicesResponse {
+	resp := &pb.ListInvoicesResponse{
+		Invoices: make([]*pb.Invoice, len(invoices)),
+		Page:     &pb.PageResponse{TotalSize: int64(len(invoices))}, // TODO: DB count
+	}
+	for i, inv := range invoices {
+		resp.Invoices[i] = toPbInvoice(inv)
+	}
+	if int32(len(invoices)) == limit {
+		resp.Page.NextPageToken = strconv.Itoa(int(offset + limit))
+	}
+	return resp
+}
+
+// ---------------- Mapping ----------------
+
+// fromPbInvoice maps the client's invoice. Money fields become the client
+// figures the service verifies; an unset one is not checked. The currency
+// is taken from the first money field that carries one.
+func fromPbInvoice(p *pb.Invoice) (db.Invoice, error) {
+	if p.InvoiceDate == nil {
+		return db.Invoice{}, status.Error(codes.InvalidArgument, "invoice_date is required")
+	}
+	inv := db.Invoice{
+		InvoiceNumber:        p.InvoiceNumber,
+		Type:                 fromPbInvoiceType(p.Type),
+		InvoiceDate:          p.InvoiceDate.AsTime(),
+		DueDate:              toNullTime(p.DueDate),
+		DeliveryDate:         toNullTime(p.DeliveryDate),
+		OrganizationID:       p.OrganizationId,
+		PoNumber:             toNullString(p.PoNumber),
+		EwayNumberLegacy:     toNullString(p.EwayNumberLegacy),
+		StatusNote:           toNullString(p.StatusNote),
+		Status:               fromPbInvoiceStatus(p.Status),
+		PaymentReference:     toNullString(p.PaymentReference),
+		ChallanNumber:        toNullString(p.ChallanNumber),
+		ChallanDate:          toNullTime(p.ChallanDate),
+		LrNumber:             toNullString(p.LrNumber),
+		TransporterName:      toNullString(p.TransporterName),
+		TransporterID:        toNullString(p.TransporterId),
+		VehicleNumber:        toNullString(p.VehicleNumber),
+		AgainstInvoiceNumber: toNullString(p.AgainstInvoiceNumber),
+		AgainstInvoiceDate:   toNullTime(p.AgainstInvoiceDate),
+		Subtotal:             mapProtoAmountToDomain(p.Subtotal),
+		GrandTotal:           mapProtoAmountToDomain(p.GrandTotal),
+		CurrencyCode:         invoiceCurrency(p),
+	}
+	party, err := parseOptionalUUID("party_id", p.PartyId)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	inv.PartyID = party
+	if b := p.GstBreakup; b != nil {
+		inv.GstCgst = toNullString(mapProtoAmountToDomain(b.Cgst))
+		inv.GstSgst = toNullString(mapProtoAmountToDomain(b.Sgst))
+		inv.GstIgst = toNullString(mapProtoAmountToDomain(b.Igst))
+	}
+	for i, it := range p.Items {
+		account, err := parseOptionalUUID(fmt.Sprintf("items[%d].account_id", i), it.AccountId)
+		if err != nil {
+			return db.Invoice{}, err
+		}
+		item := db.InvoiceItem{
+			Name:         it.Name,
+			Description:  toNullString(it.Description),
+			Hsn:          toNullString(it.Hsn),
+			Quantity:     it.Quantity,
+			UnitPrice:    mapProtoAmountToDomain(it.UnitPrice),
+			LineSubtotal: mapProtoAmountToDomain(it.LineSubtotal),
+			LineTotal:    mapProtoAmountToDomain(it.LineTotal),
+			CostCenterID: toNullString(it.CostCenterId),
+			AccountID:    account,
+		}
+		for _, d := range it.Discounts {
+			item.Discounts = append(item.Discounts, db.InvoiceItemDiscount{
+				Description: toNullString(d.Description),
+				Amount:      mapProtoAmountToDomain(d.Amount),
+			})
+		}
+		for _, t := range it.Taxes {
+			item.Taxes = append(item.Taxes, db.InvoiceItemTax{
+				Name:   fromPbTaxType(t.Type),
+				Rate:   strconv.FormatFloat(t.RatePercent, 'f', -1, 64),
+				Amount: mapProtoAmountToDomain(t.Amount),
+			})
+		}
+		inv.Items = append(inv.Items, item)
+	}
+	for _, d := range p.Discounts {
+		inv.Discounts = append(inv.Discounts, db.InvoiceDiscount{
+			Description: toNullString(d.Description),
+			Amount:      mapProtoAmountToDomain(d.Amount),
+		})
+	}
+	for _, t := range p.Taxes {
+		inv.Taxes = append(inv.Taxes, db.InvoiceTax{
+			Name:   fromPbTaxType(t.Type),
+			Rate:   strconv.FormatFloat(t.RatePercent, 'f', -1, 64),
+			Amount: mapProtoAmountToDomain(t.Amount),
+		})
+	}
+	if g := p.Gst; g != nil {
+		inv.Gst = &db.GstRegime{
+			Gstin:         g.Gstin,
+			PlaceOfSupply: g.PlaceOfSupply,
+			ReverseCharge: sql.NullBool{Bool: g.ReverseCharge, Valid: true},
+		}
+	}
+	if d := p.GstDocs; d != nil {
+		inv.GstDocs = &db.GstDocStatus{
+			EinvoiceStatus: toNullString(d.EinvoiceStatus.String()),
+			Irn:            toNullString(d.Irn),
+			AckNo:          toNullString(d.AckNo),
+			AckDate:        toNullTime(d.AckDate),
+			EwayStatus:     toNullString(d.EwayStatus.String()),
+			EwayBillNo:     toNullString(d.EwayBillNo),
+			EwayValidUpto:  toNullTime(d.EwayValidUpto),
+			LastError:      toNullString(d.LastError),
+			LastSyncedAt:   toNullTime(d.LastSyncedAt),
+			SignedQrCode:   toNullString(d.SignedQrCode),
+		}
+	}
+	return inv, nil
+}
+
+func invoiceCurrency(p *pb.Invoice) string {
+	amounts := []*money.Money{p.GrandTotal, p.Subtotal}
+	for _, it := range p.Items {
+		amounts = append(amounts, it.UnitPrice)
+	}
+	for _, m := range amounts {
+		if m.GetCurrencyCode() != "" {
+			return m.CurrencyCode
+		}
+	}
+	return ""
+}
+
+func toPbInvoice(inv db.Invoice) *pb.Invoice {
+	cur := inv.CurrencyCode
+	p := &pb.Invoice{
+		Id:                   inv.ID.String(),
+		InvoiceNumber:        inv.InvoiceNumber,
+		Type:                 toPbInvoiceType(inv.Type),
+		InvoiceDate:          timestamppb.New(inv.InvoiceDate),
+		DueDate:              toPbTimestamp(inv.DueDate),
+		DeliveryDate:         toPbTimestamp(inv.DeliveryDate),
+		OrganizationId:       inv.OrganizationID,
+		PoNumber:             inv.PoNumber.String,
+		EwayNumberLegacy:     inv.EwayNumberLegacy.String,
+		StatusNote:           inv.StatusNote.String,
+		Status:               toPbInvoiceStatus(inv.Status),
+		PaymentReference:     inv.PaymentReference.String,
+		ChallanNumber:        inv.ChallanNumber.String,
+		ChallanDate:          toPbTimestamp(inv.ChallanDate),
+		LrNumber:             inv.LrNumber.String,
+		TransporterName:      inv.TransporterName.String,
+		TransporterId:        inv.TransporterID.String,
+		VehicleNumber:        inv.VehicleNumber.String,
+		AgainstInvoiceNumber: inv.AgainstInvoiceNumber.String,
+		AgainstInvoiceDate:   toPbTimestamp(inv.AgainstInvoiceDate),
+		Subtotal:             toPbMoney(inv.Subtotal, cur),
+		GrandTotal:           toPbMoney(inv.GrandTotal, cur),
+		PartyId:              nullUUIDString(inv.PartyID),
+		CreditApprovedBy:     inv.CreditApprovedBy.String,
+		CreditApprovedAt:     toPbTimestamp(inv.CreditApprovedAt),
+		CreditedTotal:        toPbMoney(inv.CreditedTotal, cur),
+		DebitedTotal:         toPbMoney(inv.DebitedTotal, cur),
+		NetBilled:            toPbMoney(services.NetBilled(inv), cur),
+		Audit: &pb.AuditFields{
+			CreatedAt: toPbTimestamp(inv.CreatedAt),
+			CreatedBy: inv.CreatedBy.String,
+			UpdatedAt: toPbTimestamp(inv.UpdatedAt),
+			UpdatedBy: inv.UpdatedBy.String,
+			Revision:  strconv.Itoa(int(inv.Revision.Int32)),
+		},
+		IssuedAt:   toPbTimestamp(inv.IssuedAt),
+		IssuedBy:   inv.IssuedBy.String,
+		VoidedAt:   toPbTimestamp(inv.VoidedAt),
+		VoidedBy:   inv.VoidedBy.String,
+		VoidReason: inv.VoidReason.String,
+		OverdueAt:  toPbTimestamp(inv.OverdueAt),
+		OverdueBy:  inv.OverdueBy.String,
+		JournalId:  nullUUIDString(inv.JournalID),
+	}
+	for _, it := range inv.Items {
+		item := &pb.InvoiceItem{
+			Id:           it.ID.String(),
+			Name:         it.Name,
+			Description:  it.Description.String,
+			Hsn:          it.Hsn.String,
+			Quantity:     it.Quantity,
+			UnitPrice:    toPbMoney(it.UnitPrice, cur),
+			LineSubtotal: toPbMoney(it.LineSubtotal, cur),
+			LineTotal:    toPbMoney(it.LineTotal, cur),
+			CostCenterId: it.CostCenterID.String,
+			AccountId:    nullUUIDString(it.AccountID),
+		}
+		for _, d := range it.Discounts {
+			item.Discounts = append(item.Discounts, &pb.Discount{Description: d.Description.String, Amount: toPbMoney(d.Amount, cur)})
+		}
+		for _, t := range it.Taxes {
+			item.Taxes = append(item.Taxes, toPbTaxLine(t.Name, t.Rate, t.Amount, cur))
+		}
+		p.Items = append(p.Items, item)
+	}
+	for _, d := range inv.Discounts {
+		p.Discounts = append(p.Discounts, &pb.Discount{Description: d.Description.String, Amount: toPbMoney(d.Amount, cur)})
+	}
+	for _, t := range inv.Taxes {
+		p.Taxes = append(p.Taxes, toPbTaxLine(t.Name, t.Rate, t.Amount, cur))
+	}
+	if b := inv.GstBreakup; b != nil {
+		p.GstBreakup = &pb.GstBreakup{
+			TaxableAmount: toPbMoney(b.TaxableAmount, cur),
+			Cgst:          toPbMoney(b.Cgst.String, cur),
+			Sgst:          toPbMoney(b.Sgst.String, cur),
+			Igst:          toPbMoney(b.Igst.String, cur),
+			TotalGst:      toPbMoney(b.TotalGst.String, cur),
+		}
+	}
+	if g := inv.Gst; g != nil {
+		p.Gst = &pb.GstTaxRegime{Gstin: g.Gstin, PlaceOfSupply: g.PlaceOfSupply, ReverseCharge: g.ReverseCharge.Bool}
+	}
+	if d := inv.GstDocs; d != nil {
+		p.GstDocs = &pb.GstDocStatus{
+			EinvoiceStatus: pb.GstDocStatus_EInvoiceStatus(pb.GstDocStatus_EInvoiceStatus_value[d.EinvoiceStatus.String]),
+			Irn:            d.Irn.String,
+			AckNo:          d.AckNo.String,
+			AckDate:        toPbTimestamp(d.AckDate),
+			EwayStatus:     pb.GstDocStatus_EWayStatus(pb.GstDocStatus_EWayStatus_value[d.EwayStatus.String]),
+			EwayBillNo:     d.EwayBillNo.String,
+			EwayValidUpto:  toPbTimestamp(d.EwayValidUpto),
+			LastError:      d.LastError.String,
+			LastSyncedAt:   toPbTimestamp(d.LastSyncedAt),
+			SignedQrCode:   d.SignedQrCode.String,
+		}
+	}
+	return p
+}
+
+func toPbTaxLine(name, rate, amount, currency string) *pb.TaxLine {
+	pct, _ := strconv.ParseFloat(rate, 64)
+	return &pb.TaxLine{Type: toPbTaxType(name), RatePercent: pct, Amount: toPbMoney(amount, currency)}
+}
+
+func toPbTimestamp(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+func fromPbInvoiceType(t pb.InvoiceType) string {
+	if t == pb.InvoiceType_INVOICE_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(t.String(), "INVOICE_TYPE_")
+}
+
+func toPbInvoiceType(s string) pb.InvoiceType {
+	return pb.InvoiceType(pb.InvoiceType_value["INVOICE_TYPE_"+s])
+}
+
+func fromPbInvoiceStatus(s pb.InvoiceStatus) string {
+	if s == pb.InvoiceStatus_INVOICE_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(s.String(), "INVOICE_STATUS_")
+}
+
+func toPbInvoiceStatus(s string) pb.InvoiceStatus {
+	return pb.InvoiceStatus(pb.InvoiceStatus_value["INVOICE_STATUS_"+s])
+}
+
+// fromPbTaxType names a tax after its type; CGST, SGST and IGST make up the
+// GST breakup.
+func fromPbTaxType(t pb.TaxType) string {
+	if t == pb.TaxType_TAX_TYPE_UNSPECIFIED {
+		return "OTHER"
+	}
+	return strings.TrimPrefix(t.String(), "TAX_TYPE_")
+}
+
+func toPbTaxType(name string) pb.TaxType {
+	if t, ok := pb.TaxType_value["TAX_TYPE_"+strings.ToUpper(name)]; ok {
+		return pb.TaxType(t)
+	}
+	return pb.TaxType_TAX_TYPE_OTHER
+}
@@ -2,13 +2,18 @@ package grpc_server
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"context"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
 )
 
 type InvoiceServiceInterface interface {
@@ -17,7 +22,25 @@ type InvoiceServiceInterface interface {
     UpdateInvoice(ctx context.Context, inv db.Invoice) (db.Invoice, error)
     DeleteInvoice(ctx context.Context, id uuid.UUID) error
     ListInvoices(ctx context.Context, limit, offset int32) ([]db.Invoice, error)
-    SearchInvoices(ctx context.Context, query string, limit, offset int32) ([]db.Invoice, error)
+    SearchInvoices(ctx context.Context, f ports.InvoiceFilter, limit, offset int32) ([]db.Invoice, error)
+
+    // Status transitions
+    IssueInvoice(ctx context.Context, id uuid.UUID, by string) (db.Invoice, error)
+    VoidInvoice(ctx context.Context, id uuid.UUID, reason, by string) (db.Invoice, error)
+    MarkOverdue(ctx context.Context, id uuid.UUID, asOf time.Time, by string) (db.Invoice, error)
+
+    // Accounts issued invoices post to
+    SetPostingRule(ctx context.Context, rule db.InvoicePostingRule) (db.InvoicePostingRule, error)
+    GetPostingRule(ctx context.Context, orgID, invoiceType string) (db.InvoicePostingRule, error)
+
+    // How invoices are numbered at issue
+    SetNumberSeries(ctx context.Context, series db.InvoiceNumberSeries) (db.InvoiceNumberSeries, error)
+    GetNumberSeries(ctx context.Context, orgID, invoiceType string) (db.InvoiceNumberSeries, error)
+
+    // What invoices are printed with
+    SetTemplate(ctx context.Context, t db.InvoiceTemplate) (db.InvoiceTemplate, error)
+    GetTemplate(ctx context.Context, orgID string) (db.InvoiceTemplate, error)
+    InvoiceDocument(ctx context.Context, id uuid.UUID) (ports.InvoiceDocument, error)
 
 	CreateInvoiceItem(ctx context.Context, item db.InvoiceItem) (db.InvoiceItem, error)
     ListInvoiceItems(ctx context.Context, invoiceID uuid.UUID) ([]db.InvoiceItem, error)
@@ -46,6 +69,11 @@ func NewInvoiceHandler(svc InvoiceServiceInterface) *InvoiceHandler {
 // 	r.Get("/invoices", h.ListInvoices)
 // 	r.Get("/invoices/search", h.SearchInvoices)
 
+// 	// Status transitions
+// 	r.Post("/invoices/{id}/issue", h.IssueInvoice)
+// 	r.Post("/invoices/{id}/void", h.VoidInvoice)
+// 	r.Post("/invoices/{id}/overdue", h.MarkOverdue)
+
 // 	// Invoice items
 // 	r.Post("/invoices/{id}/items", h.CreateInvoiceItem)
 // 	r.Get("/invoices/{id}/items", h.ListInvoiceItems)
@@ -65,7 +93,7 @@ func (h *InvoiceHandler) CreateInvoice(w http.ResponseWriter, r *http.Request) {
 	}
 	created, err := h.svc.CreateInvoice(r.Context(), inv)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), httpErrorStatus(err))
 		return
 	}
 	json.NewEncoder(w).Encode(created)
@@ -97,11 +125,20 @@ func (h *InvoiceHandler) UpdateInvoice(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	// Only the fields named in update_mask change; without one the body
+	// replaces the stored row.
+	inv, code, err := maskedRow(r.URL.Query().Get("update_mask"), inv, func() (db.Invoice, error) {
+		return h.svc.GetInvoice(r.Context(), id)
+	})
+	if err != nil {
+		http.Error(w, err.Error(), code)
+		return
+	}
 	inv.ID = id
 
 	updated, err := h.svc.UpdateInvoice(r.Context(), inv)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), httpErrorStatus(err))
 		return
 	}
 	json.NewEncoder(w).Encode(updated)
@@ -114,7 +151,7 @@ func (h *InvoiceHandler) DeleteInvoice(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if err := h.svc.DeleteInvoice(r.Context(), id); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), httpErrorStatus(err))
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -131,16 +168,80 @@ func (h *InvoiceHandler) ListInvoices(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *InvoiceHandler) SearchInvoices(w http.ResponseWriter, r *http.Request) {
-	query := r.URL.Query().Get("q")
+	params := r.URL.Query()
+	f, err := parseInvoiceFilter(&pb.PageRequest{Filter: params.Get("filter"), OrderBy: params.Get("order_by")})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f.OrganizationID = params.Get("org_id")
+	f.Query = params.Get("q")
 	limit, offset := parsePagingParams(r)
-	invoices, err := h.svc.SearchInvoices(r.Context(), query, limit, offset)
+	invoices, err := h.svc.SearchInvoices(r.Context(), f, limit, offset)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), httpErrorStatus(err))
 		return
 	}
 	json.NewEncoder(w).Encode(invoices)
 }
 
+// ---------- Status Transition Handlers ----------
+
+func (h *InvoiceHandler) IssueInvoice(w http.ResponseWriter, r *http.Request) {
+	id, err := parseUUIDParam(r, "id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	inv, err := h.svc.IssueInvoice(r.Context(), id, r.Header.Get("X-User-ID"))
+	if err != nil {
+		http.Error(w, err.Error(), httpErrorStatus(err))
+		return
+	}
+	json.NewEncoder(w).Encode(inv)
+}
+
+func (h *InvoiceHandler) VoidInvoice(w http.ResponseWriter, r *http.Request) {
+	id, err := parseUUIDParam(r, "id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var body struct{ Reason string }
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	inv, err := h.svc.VoidInvoice(r.Context(), id, body.Reason, r.Header.Get("X-User-ID"))
+	if err != nil {
+		http.Error(w, err.Error(), httpErrorStatus(err))
+		return
+	}
+	json.NewEncoder(w).Encode(inv)
+}
+
+// MarkOverdue takes an optional as_of date (YYYY-MM-DD); it defaults to now.
+func (h *InvoiceHandler) MarkOverdue(w http.ResponseWriter, r *http.Request) {
+	id, err := parseUUIDParam(r, "id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	asOf := time.Now()
+	if v := r.URL.Query().Get("as_of"); v != "" {
+		if asOf, err = time.Parse(time.DateOnly, v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	inv, err := h.svc.MarkOverdue(r.Context(), id, asOf, r.Header.Get("X-User-ID"))
+	if err != nil {
+		http.Error(w, err.Error(), httpErrorStatus(err))
+		return
+	}
+	json.NewEncoder(w).Encode(inv)
+}
+
 // ---------- InvoiceItem Handlers ----------
 
 func (h *InvoiceHandler) CreateInvoiceItem(w http.ResponseWriter, r *http.Request) {
@@ -159,7 +260,7 @@ func (h *InvoiceHandler) CreateInvoiceItem(w http.ResponseWriter, r *http.Reques
 
 	created, err := h.svc.CreateInvoiceItem(r.Context(), item)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), httpErrorStatus(err))
 		return
 	}
 	json.NewEncoder(w).Encode(created)
@@ -198,7 +299,7 @@ func (h *InvoiceHandler) AddInvoiceTax(w http.ResponseWriter, r *http.Request) {
 
 	created, err := h.svc.AddInvoiceTax(r.Context(), tax)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), httpErrorStatus(err))
 		return
 	}
 	json.NewEncoder(w).Encode(created)
@@ -220,7 +321,7 @@ func (h *InvoiceHandler) AddInvoiceDiscount(w http.ResponseWriter, r *http.Reque
 
 	created, err := h.svc.AddInvoiceDiscount(r.Context(), disc)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), httpErrorStatus(err))
 		return
 	}
 	json.NewEncoder(w).Encode(created)
@@ -233,6 +334,20 @@ func parseUUIDParam(r *http.Request, param string) (uuid.UUID, error) {
 	return uuid.Parse(idStr)
 }
 
+// httpErrorStatus maps a service error from a write to an HTTP status code.
+func httpErrorStatus(err error) int {
+	if errors.Is(err, services.ErrPeriodClosed) || errors.Is(err, services.ErrConflict) {
+		return http.StatusConflict
+	}
+	if errors.Is(err, services.ErrInvalidInput) {
+		return http.StatusBadRequest
+	}
+	if errors.Is(err, services.ErrNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
 func parsePagingParams(r *http.Request) (limit, offset int32) {
 	limit = 100
 	offset = 0
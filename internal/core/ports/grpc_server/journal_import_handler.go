@@ -0,0 +1,55 @@
+package grpc_server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/spreadsheet"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+// ---------------- Journal Import ----------------
+
+// ImportJournalEntries answers with the validation report whether or not the
+// file was imported; only a file that cannot be read at all is an error.
+func (h *LedgerHandler) ImportJournalEntries(ctx context.Context, req *pb.ImportJournalEntriesRequest) (*pb.ImportJournalEntriesResponse, error) {
+	if len(req.Content) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+	rows, err := spreadsheet.Read(req.Filename, req.Content)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid file: %v", err)
+	}
+
+	report, err := h.importSvc.Import(ctx, rows, ports.JournalImportOptions{
+		OrganizationID: req.GetMeta().GetOrganizationId(),
+		ImportedBy:     getUserFromContext(ctx),
+		DryRun:         req.DryRun,
+		Post:           req.Post,
+	})
+	if err != nil {
+		return nil, ledgerError("import journal entries", err)
+	}
+	return toPbImportReport(report), nil
+}
+
+func toPbImportReport(r *ports.JournalImportReport) *pb.ImportJournalEntriesResponse {
+	out := &pb.ImportJournalEntriesResponse{
+		Entries:   int32(r.Entries),
+		Lines:     int32(r.Lines),
+		Committed: r.Committed,
+		Errors:    make([]*pb.ImportRowError, len(r.Errors)),
+	}
+	for i, e := range r.Errors {
+		out.Errors[i] = &pb.ImportRowError{
+			Row:       int32(e.Row),
+			Reference: e.Reference,
+			Field:     e.Field,
+			Message:   e.Message,
+		}
+	}
+	return out
+}
@@ -0,0 +1,250 @@
+package grpc_server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+// ---------------- Journal Templates ----------------
+func (h *LedgerHandler) CreateJournalTemplate(ctx context.Context, req *pb.CreateJournalTemplateRequest) (*pb.JournalTemplate, error) {
+	t, err := fromPbJournalTemplate(req.GetTemplate())
+	if err != nil {
+		return nil, err
+	}
+	t.OrganizationID = toNullString(req.GetMeta().GetOrganizationId())
+	t.CreatedBy = toNullString(getUserFromContext(ctx))
+	t.UpdatedBy = t.CreatedBy
+
+	created, err := h.templateSvc.Create(ctx, t)
+	if err != nil {
+		return nil, ledgerError("create journal template", err)
+	}
+	return toPbJournalTemplate(created), nil
+}
+
+func (h *LedgerHandler) GetJournalTemplate(ctx context.Context, req *pb.GetJournalTemplateRequest) (*pb.JournalTemplate, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	t, err := h.templateSvc.Get(ctx, id)
+	if err != nil {
+		return nil, ledgerError("get journal template", err)
+	}
+	return toPbJournalTemplate(t), nil
+}
+
+func (h *LedgerHandler) UpdateJournalTemplate(ctx context.Context, req *pb.UpdateJournalTemplateRequest) (*pb.JournalTemplate, error) {
+	id, err := uuid.Parse(req.GetTemplate().GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	in, err := maskedUpdate(req.UpdateMask, req.Template, func() (*pb.JournalTemplate, error) {
+		t, err := h.templateSvc.Get(ctx, id)
+		if err != nil {
+			return nil, ledgerError("update journal template", err)
+		}
+		return toPbJournalTemplate(t), nil
+	}, "id", "organization_id", "last_occurrence", "audit")
+	if err != nil {
+		return nil, err
+	}
+	t, err := fromPbJournalTemplate(in)
+	if err != nil {
+		return nil, err
+	}
+	t.ID = id
+	t.UpdatedBy = toNullString(getUserFromContext(ctx))
+
+	updated, err := h.templateSvc.Update(ctx, t)
+	if err != nil {
+		return nil, ledgerError("update journal template", err)
+	}
+	return toPbJournalTemplate(updated), nil
+}
+
+func (h *LedgerHandler) DeleteJournalTemplate(ctx context.Context, req *pb.DeleteJournalTemplateRequest) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	if err := h.templateSvc.Delete(ctx, id); err != nil {
+		return nil, ledgerError("delete journal template", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *LedgerHandler) ListJournalTemplates(ctx context.Context, req *pb.ListJournalTemplatesRequest) (*pb.ListJournalTemplatesResponse, error) {
+	limit := int32(50)
+	if req.GetPage().GetPageSize() > 0 {
+		limit = req.Page.PageSize
+	}
+	offset := int32(0)
+	if req.GetPage().GetPageToken() != "" {
+		o, err := strconv.Atoi(req.Page.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
+		}
+		offset = int32(o)
+	}
+
+	templates, err := h.templateSvc.List(ctx, limit, offset)
+	if err != nil {
+		return nil, ledgerError("list journal templates", err)
+	}
+	out := make([]*pb.JournalTemplate, len(templates))
+	for i, t := range templates {
+		out[i] = toPbJournalTemplate(t)
+	}
+
+	nextToken := ""
+	if int32(len(templates)) == limit {
+		nextToken = strconv.Itoa(int(offset + limit))
+	}
+	return &pb.ListJournalTemplatesResponse{
+		Templates: out,
+		Page:      &pb.PageResponse{NextPageToken: nextToken, TotalSize: int64(len(templates))},
+	}, nil
+}
+
+// GenerateRecurringJournals reports per-template failures in the response
+// rather than failing the call, since the occurrences that were generated
+// are already committed.
+func (h *LedgerHandler) GenerateRecurringJournals(ctx context.Context, req *pb.GenerateRecurringJournalsRequest) (*pb.GenerateRecurringJournalsResponse, error) {
+	asOf := time.Now()
+	if req.AsOf != nil {
+		asOf = req.AsOf.AsTime()
+	}
+	generated, err := h.templateSvc.Generate(ctx, asOf)
+	resp := &pb.GenerateRecurringJournalsResponse{Entries: make([]*pb.JournalEntry, len(generated))}
+	for i, j := range generated {
+		resp.Entries[i] = toPbJournalEntry(j)
+	}
+	var runErr *services.RecurringRunError
+	switch {
+	case errors.As(err, &runErr):
+		for _, f := range runErr.Failures {
+			resp.Failures = append(resp.Failures, f.Error())
+		}
+	case err != nil:
+		return nil, ledgerError("generate recurring journals", err)
+	}
+	return resp, nil
+}
+
+func fromPbJournalTemplate(in *pb.JournalTemplate) (*ports.JournalTemplate, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "template is required")
+	}
+	lines, err := fromPbJournalLines(in.Lines)
+	if err != nil {
+		return nil, err
+	}
+	t := &ports.JournalTemplate{
+		JournalTemplate: db.JournalTemplate{
+			Name:              in.Name,
+			Reference:         toNullString(in.Reference),
+			Memo:              toNullString(in.Memo),
+			Frequency:         fromPbTemplateFrequency(in.Frequency),
+			StartDate:         optionalTime(in.StartDate),
+			DayOfMonth:        int16(in.DayOfMonth),
+			AutoPost:          in.AutoPost,
+			AdjustmentPercent: in.AdjustmentPercent,
+			Active:            in.Active,
+		},
+		Lines: make([]db.JournalTemplateLine, len(lines)),
+	}
+	if in.EndDate != nil {
+		t.EndDate = sql.NullTime{Time: in.EndDate.AsTime(), Valid: true}
+	}
+	for i, l := range lines {
+		t.Lines[i] = db.JournalTemplateLine{
+			AccountID:    l.AccountID,
+			Side:         l.Side,
+			Amount:       l.Amount,
+			CostCenterID: l.CostCenterID,
+			Description:  l.Description,
+		}
+	}
+	return t, nil
+}
+
+func toPbJournalTemplate(t *ports.JournalTemplate) *pb.JournalTemplate {
+	lines := make([]*pb.JournalLine, len(t.Lines))
+	for i, l := range t.Lines {
+		lines[i] = toPbJournalLine(db.JournalLine{
+			AccountID:    l.AccountID,
+			Side:         l.Side,
+			Amount:       l.Amount,
+			CostCenterID: l.CostCenterID,
+			Description:  l.Description,
+		})
+	}
+	out := &pb.JournalTemplate{
+		Id:                t.ID.String(),
+		Name:              t.Name,
+		Reference:         t.Reference.String,
+		Memo:              t.Memo.String,
+		Lines:             lines,
+		Frequency:         toPbTemplateFrequency(t.Frequency),
+		StartDate:         timestamppb.New(t.StartDate),
+		DayOfMonth:        int32(t.DayOfMonth),
+		AutoPost:          t.AutoPost,
+		AdjustmentPercent: t.AdjustmentPercent,
+		Active:            t.Active,
+		OrganizationId:    t.OrganizationID.String,
+		Audit: &pb.AuditFields{
+			CreatedAt: timestamppb.New(t.CreatedAt),
+			CreatedBy: t.CreatedBy.String,
+			UpdatedAt: timestamppb.New(t.UpdatedAt),
+			UpdatedBy: t.UpdatedBy.String,
+		},
+	}
+	if t.EndDate.Valid {
+		out.EndDate = timestamppb.New(t.EndDate.Time)
+	}
+	if t.LastOccurrence.Valid {
+		out.LastOccurrence = timestamppb.New(t.LastOccurrence.Time)
+	}
+	return out
+}
+
+func fromPbTemplateFrequency(f pb.TemplateFrequency) string {
+	switch f {
+	case pb.TemplateFrequency_TEMPLATE_MONTHLY:
+		return services.FrequencyMonthly
+	case pb.TemplateFrequency_TEMPLATE_QUARTERLY:
+		return services.FrequencyQuarterly
+	case pb.TemplateFrequency_TEMPLATE_YEARLY:
+		return services.FrequencyYearly
+	default:
+		return ""
+	}
+}
+
+func toPbTemplateFrequency(s string) pb.TemplateFrequency {
+	switch s {
+	case services.FrequencyMonthly:
+		return pb.TemplateFrequency_TEMPLATE_MONTHLY
+	case services.FrequencyQuarterly:
+		return pb.TemplateFrequency_TEMPLATE_QUARTERLY
+	case services.FrequencyYearly:
+		return pb.TemplateFrequency_TEMPLATE_YEARLY
+	default:
+		return pb.TemplateFrequency_TEMPLATE_FREQUENCY_UNSPECIFIED
+	}
+}
@@ -27,20 +27,37 @@ func toPbLedgerSide(s string) pb.LedgerSide {
     }
 }
 
+func fromPbLedgerSide(s pb.LedgerSide) string {
+	switch s {
+	case pb.LedgerSide_LEDGER_SIDE_DEBIT:
+		return "DEBIT"
+	case pb.LedgerSide_LEDGER_SIDE_CREDIT:
+		return "CREDIT"
+	default:
+		return ""
+	}
+}
+
 //
 // ---------- Ledger Entry Mapping ----------
 //
 func toPbLedgerEntry(e *db.LedgerEntry) *pb.LedgerEntry {
+	costCenter := ""
+	if e.CostCenterID.Valid {
+		costCenter = e.CostCenterID.UUID.String()
+	}
 	return &pb.LedgerEntry{
-		Id:        e.EntryID.String(),
-		AccountId: e.AccountID.String(),
-		Side:      toPbLedgerSide(e.Side),
-		Amount: &money.Money{
-			CurrencyCode: "USD",
-			Units:        parseAmount(e.Amount),
-			Nanos:        0,
-		},
-		TransactionDate: timestamppb.New(e.TransactionDate),
+		Id:               e.EntryID.String(),
+		AccountId:        e.AccountID.String(),
+		Description:      e.Description.String,
+		Side:             toPbLedgerSide(e.Side),
+		Amount:           toPbMoney(e.TransactionAmount, e.CurrencyCode),
+		TransactionDate:  timestamppb.New(e.TransactionDate),
+		CostCenterId:     costCenter,
+		ReferenceType:    e.ReferenceType.String,
+		ReferenceId:      e.ReferenceID.String,
+		FunctionalAmount: toPbMoney(e.Amount, e.FunctionalCurrency),
+		ExchangeRate:     e.ExchangeRate,
 	}
 }
 
@@ -83,6 +100,11 @@ func mapDomainNoteTypeToProto(t string) pb.NoteType {
 
 
 func mapDomainAmountToProto(amount string) *money.Money {
+	return toPbMoney(amount, "USD")
+}
+
+// toPbMoney converts a decimal amount in currency to a Money message.
+func toPbMoney(amount, currency string) *money.Money {
 	if amount == "" {
 		return nil
 	}
@@ -94,12 +116,20 @@ func mapDomainAmountToProto(amount string) *money.Money {
 	nanos := (dec.Sub(decimal.NewFromInt(units))).Mul(decimal.New(1_000_000_000, 0)).IntPart()
 
 	return &money.Money{
-		CurrencyCode: "USD", // pick or inject dynamically
+		CurrencyCode: currency,
 		Units:        units,
 		Nanos:        int32(nanos),
 	}
 }
 
+// mapProtoAmountToDomain is the inverse of mapDomainAmountToProto. A nil amount
+// maps to "" so that validation can report it as missing.
+func mapProtoAmountToDomain(m *money.Money) string {
+	if m == nil {
+		return ""
+	}
+	return decimal.New(m.Units, 0).Add(decimal.New(int64(m.Nanos), -9)).String()
+}
 
 // mapDomainToProtoCreditDebitNote converts a domain CreditDebitNote to a protobuf message.
 func mapDomainToProtoCreditDebitNote(note db.CreditDebitNote) *pb.CreditDebitNote {
@@ -109,6 +139,10 @@ func mapDomainToProtoCreditDebitNote(note db.CreditDebitNote) *pb.CreditDebitNot
 		Type:      mapDomainNoteTypeToProto(note.Type),
 		Amount:    mapDomainAmountToProto(note.Amount),
 		Reason:    note.Reason.String,
+
+		JournalId:    nullUUIDString(note.JournalID),
+		PaymentDueId: nullUUIDString(note.PaymentDueID),
+		AppliedAt:    toPbTimestamp(note.AppliedAt),
 	}
 }
 
@@ -126,6 +160,7 @@ func mapDomainToProtoExchangeRate(rate db.ExchangeRate) *pb.ExchangeRate {
         QuoteCurrency: rate.QuoteCurrency,
         Rate:          f64,
         AsOf:          timestamppb.New(rate.AsOf),
+        RateType:      rate.RateType,
     }
 }
 
@@ -0,0 +1,291 @@
+package grpc_server
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+type PartyHandler struct {
+	pb.UnimplementedPartyServiceServer
+	svc ports.PartyService
+}
+
+func NewPartyHandler(svc ports.PartyService) *PartyHandler {
+	return &PartyHandler{svc: svc}
+}
+
+func (h *PartyHandler) CreateParty(ctx context.Context, req *pb.CreatePartyRequest) (*pb.Party, error) {
+	if req.GetParty() == nil {
+		return nil, status.Error(codes.InvalidArgument, "party is required")
+	}
+	p := fromPbParty(req.GetParty())
+	p.OrganizationID = req.GetMeta().GetOrganizationId()
+	p.CreatedBy = toNullString(getUserFromContext(ctx))
+	p.UpdatedBy = p.CreatedBy
+
+	created, err := h.svc.Create(ctx, p)
+	if err != nil {
+		return nil, ledgerError("create party", err)
+	}
+	return toPbParty(created), nil
+}
+
+func (h *PartyHandler) GetParty(ctx context.Context, req *pb.GetPartyRequest) (*pb.Party, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	p, err := h.svc.Get(ctx, id)
+	if err != nil {
+		return nil, ledgerError("get party", err)
+	}
+	return toPbParty(p), nil
+}
+
+func (h *PartyHandler) UpdateParty(ctx context.Context, req *pb.UpdatePartyRequest) (*pb.Party, error) {
+	if req.GetParty() == nil {
+		return nil, status.Error(codes.InvalidArgument, "party is required")
+	}
+	id, err := uuid.Parse(req.GetParty().GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	in, err := maskedUpdate(req.UpdateMask, req.Party, func() (*pb.Party, error) {
+		p, err := h.svc.Get(ctx, id)
+		if err != nil {
+			return nil, ledgerError("update party", err)
+		}
+		return toPbParty(p), nil
+	}, "id", "organization_id", "audit")
+	if err != nil {
+		return nil, err
+	}
+	p := fromPbParty(in)
+	p.ID = id
+	p.UpdatedBy = toNullString(getUserFromContext(ctx))
+
+	updated, err := h.svc.Update(ctx, p)
+	if err != nil {
+		return nil, ledgerError("update party", err)
+	}
+	return toPbParty(updated), nil
+}
+
+func (h *PartyHandler) DeleteParty(ctx context.Context, req *pb.DeletePartyRequest) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	if err := h.svc.Delete(ctx, id); err != nil {
+		return nil, ledgerError("delete party", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *PartyHandler) ListParties(ctx context.Context, req *pb.ListPartiesRequest) (*pb.ListPartiesResponse, error) {
+	limit, offset, err := invoicePage(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+	list, err := h.svc.List(ctx, ports.PartyFilter{
+		OrganizationID: req.GetMeta().GetOrganizationId(),
+		Kind:           fromPbPartyKind(req.Kind),
+		Query:          req.Query,
+	}, limit, offset)
+	if err != nil {
+		return nil, ledgerError("list parties", err)
+	}
+	resp := &pb.ListPartiesResponse{
+		Parties: make([]*pb.Party, len(list)),
+		Page:    &pb.PageResponse{TotalSize: int64(len(list))},
+	}
+	for i, p := range list {
+		resp.Parties[i] = toPbParty(p)
+	}
+	if int32(len(list)) == limit {
+		resp.Page.NextPageToken = strconv.Itoa(int(offset + limit))
+	}
+	return resp, nil
+}
+
+func fromPbParty(in *pb.Party) *ports.Party {
+	p := &ports.Party{Party: db.Party{
+		Kind:             fromPbPartyKind(in.Kind),
+		Name:             in.Name,
+		LegalName:        toNullString(in.LegalName),
+		Pan:              toNullString(in.Pan),
+		DefaultCurrency:  toNullString(in.DefaultCurrency),
+		Active:           in.Active,
+		CreditLimit:      toNullString(mapProtoAmountToDomain(in.CreditLimit)),
+		CreditHold:       in.CreditHold,
+		CreditHoldReason: toNullString(in.CreditHoldReason),
+		PeppolEndpoint:   toNullString(in.PeppolEndpoint),
+	}}
+	if in.PaymentTermsDays != 0 {
+		p.PaymentTermsDays = sql.NullInt32{Int32: in.PaymentTermsDays, Valid: true}
+	}
+	for _, c := range in.Contacts {
+		p.Contacts = append(p.Contacts, db.PartyContact{
+			Name:        c.Name,
+			Email:       toNullString(c.Email),
+			Phone:       toNullString(c.Phone),
+			Designation: toNullString(c.Designation),
+			IsPrimary:   c.IsPrimary,
+		})
+	}
+	for _, a := range in.Addresses {
+		p.Addresses = append(p.Addresses, db.PartyAddress{
+			Kind:        fromPbAddressKind(a.Kind),
+			Line1:       a.Line1,
+			Line2:       toNullString(a.Line2),
+			City:        toNullString(a.City),
+			State:       toNullString(a.State),
+			StateCode:   toNullString(a.StateCode),
+			PostalCode:  toNullString(a.PostalCode),
+			CountryCode: a.CountryCode,
+			IsDefault:   a.IsDefault,
+		})
+	}
+	for _, t := range in.TaxRegistrations {
+		p.TaxRegistrations = append(p.TaxRegistrations, db.PartyTaxRegistration{
+			TaxType:   t.TaxType,
+			Number:    t.Number,
+			StateCode: toNullString(t.StateCode),
+		})
+	}
+	for _, b := range in.BankAccounts {
+		p.BankAccounts = append(p.BankAccounts, db.PartyBankAccount{
+			AccountName:   b.AccountName,
+			AccountNumber: b.AccountNumber,
+			Ifsc:          toNullString(b.Ifsc),
+			BankName:      toNullString(b.BankName),
+			Branch:        toNullString(b.Branch),
+			IsPrimary:     b.IsPrimary,
+		})
+	}
+	return p
+}
+
+func toPbParty(p *ports.Party) *pb.Party {
+	out := &pb.Party{
+		Id:               p.ID.String(),
+		OrganizationId:   p.OrganizationID,
+		Kind:             toPbPartyKind(p.Kind),
+		Name:             p.Name,
+		LegalName:        p.LegalName.String,
+		Pan:              p.Pan.String,
+		DefaultCurrency:  p.DefaultCurrency.String,
+		PaymentTermsDays: p.PaymentTermsDays.Int32,
+		Active:           p.Active,
+		CreditLimit:      toPbMoney(p.CreditLimit.String, p.DefaultCurrency.String),
+		CreditHold:       p.CreditHold,
+		CreditHoldReason: p.CreditHoldReason.String,
+		PeppolEndpoint:   p.PeppolEndpoint.String,
+		Audit: &pb.AuditFields{
+			CreatedAt: timestamppb.New(p.CreatedAt),
+			CreatedBy: p.CreatedBy.String,
+			UpdatedAt: timestamppb.New(p.UpdatedAt),
+			UpdatedBy: p.UpdatedBy.String,
+		},
+	}
+	for _, c := range p.Contacts {
+		out.Contacts = append(out.Contacts, &pb.PartyContact{
+			Name:        c.Name,
+			Email:       c.Email.String,
+			Phone:       c.Phone.String,
+			Designation: c.Designation.String,
+			IsPrimary:   c.IsPrimary,
+		})
+	}
+	for _, a := range p.Addresses {
+		out.Addresses = append(out.Addresses, &pb.PartyAddress{
+			Kind:        toPbAddressKind(a.Kind),
+			Line1:       a.Line1,
+			Line2:       a.Line2.String,
+			City:        a.City.String,
+			State:       a.State.String,
+			StateCode:   a.StateCode.String,
+			PostalCode:  a.PostalCode.String,
+			CountryCode: a.CountryCode,
+			IsDefault:   a.IsDefault,
+		})
+	}
+	for _, t := range p.TaxRegistrations {
+		out.TaxRegistrations = append(out.TaxRegistrations, &pb.PartyTaxRegistration{
+			TaxType:   t.TaxType,
+			Number:    t.Number,
+			StateCode: t.StateCode.String,
+		})
+	}
+	for _, b := range p.BankAccounts {
+		out.BankAccounts = append(out.BankAccounts, &pb.PartyBankAccount{
+			AccountName:   b.AccountName,
+			AccountNumber: b.AccountNumber,
+			Ifsc:          b.Ifsc.String,
+			BankName:      b.BankName.String,
+			Branch:        b.Branch.String,
+			IsPrimary:     b.IsPrimary,
+		})
+	}
+	return out
+}
+
+func fromPbPartyKind(k pb.PartyKind) string {
+	switch k {
+	case pb.PartyKind_PARTY_CUSTOMER:
+		return services.PartyCustomer
+	case pb.PartyKind_PARTY_VENDOR:
+		return services.PartyVendor
+	case pb.PartyKind_PARTY_BOTH:
+		return services.PartyBoth
+	default:
+		return ""
+	}
+}
+
+func toPbPartyKind(k string) pb.PartyKind {
+	switch k {
+	case services.PartyCustomer:
+		return pb.PartyKind_PARTY_CUSTOMER
+	case services.PartyVendor:
+		return pb.PartyKind_PARTY_VENDOR
+	case services.PartyBoth:
+		return pb.PartyKind_PARTY_BOTH
+	default:
+		return pb.PartyKind_PARTY_KIND_UNSPECIFIED
+	}
+}
+
+func fromPbAddressKind(k pb.AddressKind) string {
+	switch k {
+	case pb.AddressKind_ADDRESS_BILLING:
+		return services.AddressBilling
+	case pb.AddressKind_ADDRESS_SHIPPING:
+		return services.AddressShipping
+	default:
+		return ""
+	}
+}
+
+func toPbAddressKind(k string) pb.AddressKind {
+	switch k {
+	case services.AddressBilling:
+		return pb.AddressKind_ADDRESS_BILLING
+	case services.AddressShipping:
+		return pb.AddressKind_ADDRESS_SHIPPING
+	default:
+		return pb.AddressKind_ADDRESS_KIND_UNSPECIFIED
+	}
+}
@@ -59,6 +59,15 @@ func (h *BankAccountHandler) Update(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	// Only the fields named in update_mask change; without one the body
+	// replaces the stored row.
+	ba, code, err := maskedRow(r.URL.Query().Get("update_mask"), ba, func() (db.BankAccount, error) {
+		return h.svc.GetBankAccount(r.Context(), id)
+	})
+	if err != nil {
+		http.Error(w, err.Error(), code)
+		return
+	}
 	ba.ID = id
 	updated, err := h.svc.UpdateBankAccount(r.Context(), ba)
 	if err != nil {
@@ -134,6 +143,15 @@ func (h *PaymentDueHandler) Update(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	// Only the fields named in update_mask change; without one the body
+	// replaces the stored row.
+	pd, code, err := maskedRow(r.URL.Query().Get("update_mask"), pd, func() (db.PaymentDue, error) {
+		return h.svc.GetPaymentDue(r.Context(), id)
+	})
+	if err != nil {
+		http.Error(w, err.Error(), code)
+		return
+	}
 	pd.ID = id
 	updated, err := h.svc.UpdatePaymentDue(r.Context(), pd)
 	if err != nil {
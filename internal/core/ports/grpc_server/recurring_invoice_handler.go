@@ -0,0 +1,196 @@
+package grpc_server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+type RecurringInvoiceHandler struct {
+	pb.UnimplementedRecurringInvoiceServiceServer
+	svc ports.RecurringInvoiceService
+}
+
+func NewRecurringInvoiceHandler(svc ports.RecurringInvoiceService) *RecurringInvoiceHandler {
+	return &RecurringInvoiceHandler{svc: svc}
+}
+
+func (h *RecurringInvoiceHandler) CreateRecurringInvoice(ctx context.Context, req *pb.CreateRecurringInvoiceRequest) (*pb.RecurringInvoice, error) {
+	r, err := fromPbRecurringInvoice(req.GetRecurringInvoice())
+	if err != nil {
+		return nil, err
+	}
+	r.OrganizationID = req.GetMeta().GetOrganizationId()
+	r.CreatedBy = toNullString(getUserFromContext(ctx))
+	r.UpdatedBy = r.CreatedBy
+
+	created, err := h.svc.Create(ctx, r)
+	if err != nil {
+		return nil, ledgerError("create recurring invoice", err)
+	}
+	return toPbRecurringInvoice(created), nil
+}
+
+func (h *RecurringInvoiceHandler) GetRecurringInvoice(ctx context.Context, req *pb.GetRecurringInvoiceRequest) (*pb.RecurringInvoice, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	r, err := h.svc.Get(ctx, id)
+	if err != nil {
+		return nil, ledgerError("get recurring invoice", err)
+	}
+	return toPbRecurringInvoice(r), nil
+}
+
+func (h *RecurringInvoiceHandler) UpdateRecurringInvoice(ctx context.Context, req *pb.UpdateRecurringInvoiceRequest) (*pb.RecurringInvoice, error) {
+	id, err := uuid.Parse(req.GetRecurringInvoice().GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	in, err := maskedUpdate(req.UpdateMask, req.RecurringInvoice, func() (*pb.RecurringInvoice, error) {
+		r, err := h.svc.Get(ctx, id)
+		if err != nil {
+			return nil, ledgerError("update recurring invoice", err)
+		}
+		return toPbRecurringInvoice(r), nil
+	}, "id", "organization_id", "billed_through", "audit")
+	if err != nil {
+		return nil, err
+	}
+	r, err := fromPbRecurringInvoice(in)
+	if err != nil {
+		return nil, err
+	}
+	r.ID = id
+	r.UpdatedBy = toNullString(getUserFromContext(ctx))
+
+	updated, err := h.svc.Update(ctx, r)
+	if err != nil {
+		return nil, ledgerError("update recurring invoice", err)
+	}
+	return toPbRecurringInvoice(updated), nil
+}
+
+func (h *RecurringInvoiceHandler) DeleteRecurringInvoice(ctx context.Context, req *pb.DeleteRecurringInvoiceRequest) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	if err := h.svc.Delete(ctx, id); err != nil {
+		return nil, ledgerError("delete recurring invoice", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *RecurringInvoiceHandler) ListRecurringInvoices(ctx context.Context, req *pb.ListRecurringInvoicesRequest) (*pb.ListRecurringInvoicesResponse, error) {
+	limit, offset, err := invoicePage(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+	list, err := h.svc.List(ctx, limit, offset)
+	if err != nil {
+		return nil, ledgerError("list recurring invoices", err)
+	}
+	resp := &pb.ListRecurringInvoicesResponse{
+		RecurringInvoices: make([]*pb.RecurringInvoice, len(list)),
+		Page:              &pb.PageResponse{TotalSize: int64(len(list))},
+	}
+	for i, r := range list {
+		resp.RecurringInvoices[i] = toPbRecurringInvoice(r)
+	}
+	if int32(len(list)) == limit {
+		resp.Page.NextPageToken = strconv.Itoa(int(offset + limit))
+	}
+	return resp, nil
+}
+
+// GenerateRecurringInvoices reports per-recurring-invoice failures in the
+// response rather than failing the call, since the invoices that were
+// generated are already committed.
+func (h *RecurringInvoiceHandler) GenerateRecurringInvoices(ctx context.Context, req *pb.GenerateRecurringInvoicesRequest) (*pb.GenerateRecurringInvoicesResponse, error) {
+	asOf := time.Now()
+	if req.AsOf != nil {
+		asOf = req.AsOf.AsTime()
+	}
+	generated, err := h.svc.Generate(ctx, asOf)
+	resp := &pb.GenerateRecurringInvoicesResponse{Invoices: make([]*pb.GeneratedInvoice, len(generated))}
+	for i, g := range generated {
+		resp.Invoices[i] = &pb.GeneratedInvoice{
+			RecurringInvoiceId: g.RecurringInvoiceID.String(),
+			PeriodStart:        timestamppb.New(g.Period.Start),
+			PeriodEnd:          timestamppb.New(g.Period.End),
+			Invoice:            toPbInvoice(g.Invoice),
+		}
+	}
+	var runErr *services.RecurringRunError
+	switch {
+	case errors.As(err, &runErr):
+		for _, f := range runErr.Failures {
+			resp.Failures = append(resp.Failures, f.Error())
+		}
+	case err != nil:
+		return nil, ledgerError("generate recurring invoices", err)
+	}
+	return resp, nil
+}
+
+func fromPbRecurringInvoice(in *pb.RecurringInvoice) (db.RecurringInvoice, error) {
+	if in == nil {
+		return db.RecurringInvoice{}, status.Error(codes.InvalidArgument, "recurring_invoice is required")
+	}
+	templateID, err := uuid.Parse(in.TemplateInvoiceId)
+	if err != nil {
+		return db.RecurringInvoice{}, status.Errorf(codes.InvalidArgument, "invalid template_invoice_id: %v", err)
+	}
+	r := db.RecurringInvoice{
+		Name:              in.Name,
+		TemplateInvoiceID: templateID,
+		Frequency:         fromPbTemplateFrequency(in.Frequency),
+		StartDate:         optionalTime(in.StartDate),
+		BillingDay:        int16(in.BillingDay),
+		Prorate:           in.Prorate,
+		AutoIssue:         in.AutoIssue,
+		Active:            in.Active,
+	}
+	if in.EndDate != nil {
+		r.EndDate = sql.NullTime{Time: in.EndDate.AsTime(), Valid: true}
+	}
+	return r, nil
+}
+
+func toPbRecurringInvoice(r db.RecurringInvoice) *pb.RecurringInvoice {
+	return &pb.RecurringInvoice{
+		Id:                r.ID.String(),
+		OrganizationId:    r.OrganizationID,
+		Name:              r.Name,
+		TemplateInvoiceId: r.TemplateInvoiceID.String(),
+		Frequency:         toPbTemplateFrequency(r.Frequency),
+		StartDate:         timestamppb.New(r.StartDate),
+		EndDate:           toPbTimestamp(r.EndDate),
+		BillingDay:        int32(r.BillingDay),
+		Prorate:           r.Prorate,
+		AutoIssue:         r.AutoIssue,
+		Active:            r.Active,
+		BilledThrough:     toPbTimestamp(r.BilledThrough),
+		Audit: &pb.AuditFields{
+			CreatedAt: timestamppb.New(r.CreatedAt),
+			CreatedBy: r.CreatedBy.String,
+			UpdatedAt: timestamppb.New(r.UpdatedAt),
+			UpdatedBy: r.UpdatedBy.String,
+		},
+	}
+}
@@ -0,0 +1,108 @@
+package grpc_server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// maskedUpdate returns the resource an Update RPC stores. An empty mask, or
+// "*", replaces the whole resource with in, as AIP-134 specifies; otherwise
+// the stored resource is loaded with current and only the masked paths are
+// copied over from in, a path set in the mask but not in in clearing the
+// field. Paths that name no field, or that name or lie under one of
+// immutable, are rejected with InvalidArgument before anything is loaded.
+func maskedUpdate[M proto.Message](mask *fieldmaskpb.FieldMask, in M, current func() (M, error), immutable ...string) (M, error) {
+	paths := mask.GetPaths()
+	if len(paths) == 0 || (len(paths) == 1 && paths[0] == "*") {
+		return in, nil
+	}
+	for _, p := range paths {
+		if p == "*" {
+			return in, status.Error(codes.InvalidArgument, `update_mask: "*" cannot be combined with other paths`)
+		}
+		if !(&fieldmaskpb.FieldMask{Paths: []string{p}}).IsValid(in) {
+			return in, status.Errorf(codes.InvalidArgument, "update_mask: unknown field %q", p)
+		}
+		for _, f := range immutable {
+			if p == f || strings.HasPrefix(p, f+".") {
+				return in, status.Errorf(codes.InvalidArgument, "update_mask: %q cannot be updated", p)
+			}
+		}
+	}
+
+	out, err := current()
+	if err != nil {
+		return in, err
+	}
+	for _, p := range paths {
+		copyPath(out.ProtoReflect(), in.ProtoReflect(), strings.Split(p, "."))
+	}
+	return out, nil
+}
+
+// copyPath sets the field at path in dst to its value in src, clearing it
+// when src does not set it.
+func copyPath(dst, src protoreflect.Message, path []string) {
+	fd := dst.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if len(path) > 1 {
+		copyPath(dst.Mutable(fd).Message(), src.Get(fd).Message(), path[1:])
+		return
+	}
+	if src.Has(fd) {
+		dst.Set(fd, src.Get(fd))
+	} else {
+		dst.Clear(fd)
+	}
+}
+
+// rowAuditFields are the columns every row keeps out of a masked HTTP update.
+var rowAuditFields = []string{"ID", "CreatedAt", "CreatedBy", "UpdatedAt", "UpdatedBy", "Revision"}
+
+// maskedRow is maskedUpdate for the HTTP handlers, whose bodies decode into
+// db rows. mask is the update_mask query parameter: comma-separated field
+// names of the row, which are also the body's keys, matched case-insensitively.
+// An empty mask, or "*", stores in as sent; otherwise the stored row is loaded
+// with current and only the masked fields are copied over from in. On error
+// the returned status is 400 for a bad mask and 404 when current fails.
+func maskedRow[T any](mask string, in T, current func() (T, error)) (T, int, error) {
+	if mask == "" || mask == "*" {
+		return in, 0, nil
+	}
+	rt := reflect.TypeOf(in)
+	var fields []int
+	for _, p := range strings.Split(mask, ",") {
+		p = strings.TrimSpace(p)
+		if p == "*" {
+			return in, http.StatusBadRequest, errors.New(`update_mask: "*" cannot be combined with other fields`)
+		}
+		f, ok := rt.FieldByNameFunc(func(name string) bool { return strings.EqualFold(name, p) })
+		if !ok || len(f.Index) != 1 {
+			return in, http.StatusBadRequest, fmt.Errorf("update_mask: unknown field %q", p)
+		}
+		for _, a := range rowAuditFields {
+			if f.Name == a {
+				return in, http.StatusBadRequest, fmt.Errorf("update_mask: %q cannot be updated", p)
+			}
+		}
+		fields = append(fields, f.Index[0])
+	}
+
+	out, err := current()
+	if err != nil {
+		return in, http.StatusNotFound, err
+	}
+	dst, src := reflect.ValueOf(&out).Elem(), reflect.ValueOf(in)
+	for _, i := range fields {
+		dst.Field(i).Set(src.Field(i))
+	}
+	return out, 0, nil
+}
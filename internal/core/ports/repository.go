@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
@@ -16,20 +17,37 @@ type AccountRepository interface {
 	Update(ctx context.Context, a *db.Account) (*db.Account, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, limit, offset int32) ([]*db.Account, error)
+	ListAll(ctx context.Context) ([]*db.Account, error)
+	// Usage reports the child accounts and ledger lines that reference an account.
+	Usage(ctx context.Context, id uuid.UUID) (db.GetAccountUsageRow, error)
 }
 
 // Journals
 type JournalRepository interface {
+	// Create and Update post the reversal of an entry that ends up POSTED
+	// with an auto-reverse date in the same transaction, and return the entry
+	// REVERSED.
 	Create(ctx context.Context, j *db.JournalEntry) (*db.JournalEntry, error)
 	Get(ctx context.Context, id uuid.UUID) (*db.JournalEntry, error)
 	Update(ctx context.Context, j *db.JournalEntry) (*db.JournalEntry, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int32) ([]*db.JournalEntry, error)
+	List(ctx context.Context, f EntryFilter, limit, offset int32) ([]*db.JournalEntry, error)
+	Reverse(ctx context.Context, originalID uuid.UUID, reversal *db.JournalEntry) (*db.JournalEntry, error)
+	// BulkCreate writes every journal and its lines in one transaction,
+	// setting the IDs on the journals passed in.
+	BulkCreate(ctx context.Context, journals []*db.JournalEntry) error
 }
 
 // Ledger (read-only projection)
 type LedgerRepository interface {
-	List(ctx context.Context, limit, offset int32) ([]*db.LedgerEntry, error)
+	List(ctx context.Context, f EntryFilter, limit, offset int32) ([]*db.LedgerEntry, error)
+	// Balances returns opening totals of orgID's journals before from and
+	// movements over [from, to] per account; every account when accountIDs is
+	// empty.
+	Balances(ctx context.Context, orgID string, accountIDs []uuid.UUID, from, to time.Time) ([]db.ListAccountBalancesRow, error)
+	// CurrencyBalances returns the same totals in transaction currency, per
+	// account and currency.
+	CurrencyBalances(ctx context.Context, orgID string, accountIDs []uuid.UUID, from, to time.Time) ([]db.ListAccountCurrencyBalancesRow, error)
 }
 
 // Accounts
@@ -47,12 +65,70 @@ type JournalService interface {
     Get(ctx context.Context, id uuid.UUID) (*db.JournalEntry, error)
     Update(ctx context.Context, j *db.JournalEntry) (*db.JournalEntry, error)
     Delete(ctx context.Context, id uuid.UUID) error
-    List(ctx context.Context, limit, offset int32) ([]*db.JournalEntry, error)
+    List(ctx context.Context, f EntryFilter, limit, offset int32) ([]*db.JournalEntry, error)
+    Reverse(ctx context.Context, id uuid.UUID, reversalDate time.Time, memo string) (*db.JournalEntry, error)
 }
 
 // Ledger (read-only projection)
 type LedgerService interface {
-    List(ctx context.Context, limit, offset int32) ([]*db.LedgerEntry, error)
+    List(ctx context.Context, f EntryFilter, limit, offset int32) ([]*db.LedgerEntry, error)
+    Balances(ctx context.Context, orgID string, accountIDs []uuid.UUID, from, to time.Time) ([]AccountBalance, error)
+    AccountTree(ctx context.Context, orgID string, from, to time.Time) ([]*AccountTreeNode, error)
+}
+
+// EntryFilter narrows journal and ledger listings. Zero-valued fields are not
+// applied; dates are inclusive. For journals the account, side and cost
+// center match any line, and the reference is the journal's source document.
+type EntryFilter struct {
+    AccountID     uuid.NullUUID
+    FromDate      time.Time
+    ToDate        time.Time
+    Side          string
+    CostCenterID  uuid.NullUUID
+    ReferenceType string
+    ReferenceID   string
+    SourceType    string
+    OrganizationID string
+    OrderBy       string // "date" or "amount"; newest first when empty
+    Descending    bool
+}
+
+// AccountBalance is an account's position over a date range. Amounts are
+// decimal strings signed by the account's normal side: positive means a
+// debit balance for ASSET/EXPENSE accounts and a credit balance otherwise.
+// The amounts are in Currency, the functional currency; Currencies breaks
+// them down by transaction currency.
+type AccountBalance struct {
+    AccountID    uuid.UUID
+    Code         string
+    Name         string
+    Type         string
+    From         time.Time
+    To           time.Time
+    Currency     string
+    Opening      string
+    TotalDebits  string
+    TotalCredits string
+    Closing      string
+    Currencies   []CurrencyBalance
+}
+
+// CurrencyBalance is the part of an AccountBalance transacted in Currency,
+// in that currency's amounts and signed the same way.
+type CurrencyBalance struct {
+    Currency     string
+    Opening      string
+    TotalDebits  string
+    TotalCredits string
+    Closing      string
+}
+
+// AccountTreeNode is an account in the chart hierarchy. Balance is rolled up
+// over the account and all of its descendants.
+type AccountTreeNode struct {
+    Account  *db.Account
+    Balance  AccountBalance
+    Children []*AccountTreeNode
 }
 
 // Accruals
@@ -167,6 +243,31 @@ type CreditDebitNoteRepository interface {
 	List(ctx context.Context, limit, offset int32) ([]db.CreditDebitNote, error)
 	Update(ctx context.Context, note db.CreditDebitNote) (db.CreditDebitNote, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	// Apply stores note and, in the same transaction, what plan works out
+	// from the stored note, its invoice and the invoice's payment dues, all
+	// locked until the transaction ends. It returns the applied note,
+	// the updated invoice and the posted journal, if any.
+	Apply(ctx context.Context, note db.CreditDebitNote, by string, plan NotePlan) (db.CreditDebitNote, db.Invoice, *db.JournalEntry, error)
+}
+
+// NotePlan works out how a stored note applies to its invoice, given the
+// invoice's payment dues, paid or not, earliest due first.
+type NotePlan func(note db.CreditDebitNote, inv db.Invoice, dues []db.PaymentDue) (NoteApplication, error)
+
+// NoteApplication is what applying a credit or debit note writes.
+type NoteApplication struct {
+	// Dues are the open dues a credit note reduces, with the amount and
+	// status each is left with.
+	Dues []db.PaymentDue
+	// NewDue, when set, is the due a debit note raises.
+	NewDue *db.PaymentDue
+	// Credited and Debited are added to the invoice's note totals, and
+	// Status is the invoice's status afterwards.
+	Credited string
+	Debited  string
+	Status   string
+	// Journal, when set, is posted for the note.
+	Journal *db.JournalEntry
 }
 
 type CreditDebitNoteService interface {
@@ -184,6 +285,7 @@ type ExchangeRateRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, base, quote *string, limit, offset int32) ([]db.ExchangeRate, error)
 	GetLatest(ctx context.Context, base, quote string, asOf time.Time) (db.ExchangeRate, error)
+	GetLatestOfType(ctx context.Context, base, quote, rateType string, asOf time.Time) (db.ExchangeRate, error)
 }
 
 type ExchangeRateService interface {
@@ -193,6 +295,7 @@ type ExchangeRateService interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, base, quote *string, limit, offset int32) ([]db.ExchangeRate, error)
 	GetLatest(ctx context.Context, base, quote string, asOf time.Time) (db.ExchangeRate, error)
+	GetLatestOfType(ctx context.Context, base, quote, rateType string, asOf time.Time) (db.ExchangeRate, error)
 }
 
 type ExpenseRepository interface {
@@ -306,27 +409,138 @@ type GstServiceInterface interface {
 }
 
 type InvoiceRepository interface {
+    // CreateInvoice and UpdateInvoice write the whole aggregate (items with
+    // their discounts and taxes, invoice discounts and taxes, GST breakup,
+    // regime and document status) in one transaction; UpdateInvoice replaces
+    // the stored lines.
     CreateInvoice(ctx context.Context, inv db.Invoice) (db.Invoice, error)
     UpdateInvoice(ctx context.Context, inv db.Invoice) (db.Invoice, error)
+    // AppendInvoiceLines stores a recomputed invoice, inserting the lines
+    // that have no id yet and keeping the others.
+    AppendInvoiceLines(ctx context.Context, inv db.Invoice) (db.Invoice, error)
+    // DeleteInvoice removes a DRAFT invoice.
     DeleteInvoice(ctx context.Context, id uuid.UUID) error
+    // IssueInvoice, VoidInvoice and MarkInvoiceOverdue move the invoice to
+    // the new status, stamping who did it and when. They return
+    // sql.ErrNoRows when the invoice's status does not allow the move, and
+    // VoidInvoice also when a credit or debit note has been applied.
+    // IssueInvoice numbers the invoice and posts its journal as issue says;
+    // VoidInvoice posts a non-nil reversal of that journal. Either returns
+    // the stored journal, in the same transaction as the move.
+    IssueInvoice(ctx context.Context, id uuid.UUID, by string, issue InvoiceIssue) (db.Invoice, *db.JournalEntry, error)
+    VoidInvoice(ctx context.Context, id uuid.UUID, reason, by string, reversal *db.JournalEntry) (db.Invoice, *db.JournalEntry, error)
+    MarkInvoiceOverdue(ctx context.Context, id uuid.UUID, by string) (db.Invoice, error)
+    // UpsertPostingRule and GetPostingRule store and load the accounts an
+    // organization's invoices of one type post to.
+    UpsertPostingRule(ctx context.Context, rule db.InvoicePostingRule) (db.InvoicePostingRule, error)
+    GetPostingRule(ctx context.Context, orgID, invoiceType string) (db.InvoicePostingRule, error)
+    // UpsertNumberSeries and GetNumberSeries store and load how an
+    // organization numbers its invoices of one type.
+    UpsertNumberSeries(ctx context.Context, series db.InvoiceNumberSeries) (db.InvoiceNumberSeries, error)
+    GetNumberSeries(ctx context.Context, orgID, invoiceType string) (db.InvoiceNumberSeries, error)
+    // UpsertTemplate and GetTemplate store and load what the organization
+    // prints on its invoices.
+    UpsertTemplate(ctx context.Context, t db.InvoiceTemplate) (db.InvoiceTemplate, error)
+    GetTemplate(ctx context.Context, orgID string) (db.InvoiceTemplate, error)
+    // GetInvoice returns the fully hydrated aggregate.
     GetInvoice(ctx context.Context, id uuid.UUID) (db.Invoice, error)
     ListInvoices(ctx context.Context, limit, offset int32) ([]db.Invoice, error)
-    SearchInvoices(ctx context.Context, query string, limit, offset int32) ([]db.Invoice, error)
+    SearchInvoices(ctx context.Context, f InvoiceFilter, limit, offset int32) ([]db.Invoice, error)
 
     CreateInvoiceItem(ctx context.Context, item db.InvoiceItem) (db.InvoiceItem, error)
+    // ListInvoiceItems returns the items with their discounts and taxes.
     ListInvoiceItems(ctx context.Context, invoiceID uuid.UUID) ([]db.InvoiceItem, error)
 
     AddInvoiceTax(ctx context.Context, tax db.InvoiceTax) (db.InvoiceTax, error)
     AddInvoiceDiscount(ctx context.Context, discount db.InvoiceDiscount) (db.InvoiceDiscount, error)
 }
 
+// InvoiceFilter narrows an invoice search to one organization. Query is
+// matched against the invoice and PO numbers, the party's name and GSTIN and
+// the item names and HSN codes. Other zero-valued fields are not applied;
+// ranges are inclusive.
+type InvoiceFilter struct {
+    OrganizationID string
+    Query          string
+    Status         string
+    Type           string
+    FromDate       time.Time // invoice_date
+    ToDate         time.Time
+    DueFrom        time.Time
+    DueTo          time.Time
+    MinTotal       string // grand_total
+    MaxTotal       string
+    OrderBy        string // "relevance", "date" or "amount"; newest first when empty
+    Descending     bool
+}
+
+// InvoiceIssue is what IssueInvoice writes along with the status.
+type InvoiceIssue struct {
+    // Revision is the revision of the draft the number and journal were
+    // worked out from; the issue fails if the invoice has changed since.
+    Revision int32
+    // Number, when set, gives the invoice the next number of its series.
+    Number *InvoiceSeriesNumber
+    // Journal, when set, builds the journal to post once the invoice has
+    // its number. It may return nil to post none.
+    Journal func(invoiceNumber string) (*db.JournalEntry, error)
+}
+
+// InvoiceSeriesNumber draws a number from an invoice number series. The
+// series counts afresh in each fiscal year; Format turns the running number
+// into the invoice number.
+type InvoiceSeriesNumber struct {
+    FiscalYear int32
+    Format     func(seq int64) string
+}
+
+// InvoiceDocument is what a customer-facing invoice is printed from.
+type InvoiceDocument struct {
+    Invoice  db.Invoice
+    Template db.InvoiceTemplate
+    // AmountInWords is the grand total written out.
+    AmountInWords string
+}
+
+// EInvoice is an invoice as a structured e-invoice carries it: the invoice
+// with its lines and the parties it is exchanged between. On export the
+// seller is the organization as its invoice template describes it; on
+// import it is the vendor.
+type EInvoice struct {
+    Invoice      db.Invoice
+    Seller       Party
+    Buyer        Party
+    PaymentTerms string
+}
+
+// EInvoiceViolation is a rule of the UBL schema or of PEPPOL BIS an
+// e-invoice breaks. Path locates the element, e.g.
+// "Invoice/cac:InvoiceLine[2]/cbc:InvoicedQuantity"; Rule names the rule,
+// e.g. "BR-CO-25", or "UBL" for the schema itself.
+type EInvoiceViolation struct {
+    Path   string
+    Rule   string
+    Reason string
+}
+
+// EInvoiceService prepares invoices for structured e-invoicing and records
+// inbound e-invoices.
+type EInvoiceService interface {
+    // ExportDocument gathers an issued sales invoice with its seller and
+    // buyer.
+    ExportDocument(ctx context.Context, id uuid.UUID) (EInvoice, error)
+    // Import records an inbound e-invoice as a DRAFT purchase invoice from
+    // the vendor party its seller matches, creating the party if none does.
+    Import(ctx context.Context, orgID string, e EInvoice, by string) (db.Invoice, error)
+}
+
 type InvoiceServiceInterface interface {
     CreateInvoice(ctx context.Context, inv db.Invoice) (db.Invoice, error)
     GetInvoice(ctx context.Context, id uuid.UUID) (db.Invoice, error)
     UpdateInvoice(ctx context.Context, inv db.Invoice) (db.Invoice, error)
     DeleteInvoice(ctx context.Context, id uuid.UUID) error
     ListInvoices(ctx context.Context, limit, offset int32) ([]db.Invoice, error)
-    SearchInvoices(ctx context.Context, query string, limit, offset int32) ([]db.Invoice, error)
+    SearchInvoices(ctx context.Context, f InvoiceFilter, limit, offset int32) ([]db.Invoice, error)
 }
 
 type BankAccountRepository interface {
@@ -370,3 +584,321 @@ type FinanceEventServiceInterface interface {
     RecordBudgetUpdated(ctx context.Context, b db.Budget) error
     RecordBudgetDeleted(ctx context.Context, id uuid.UUID) error
 }
+
+// Fiscal calendar & accounting periods
+type FiscalPeriodRepository interface {
+	UpsertCalendar(ctx context.Context, c db.FiscalCalendar) (db.FiscalCalendar, error)
+	GetCalendar(ctx context.Context, orgID string) (db.FiscalCalendar, error)
+	CreatePeriods(ctx context.Context, periods []db.FiscalPeriod) ([]db.FiscalPeriod, error)
+	GetPeriod(ctx context.Context, id uuid.UUID) (db.FiscalPeriod, error)
+	GetPeriodForDate(ctx context.Context, orgID string, date time.Time) (db.FiscalPeriod, error)
+	ListPeriods(ctx context.Context, orgID string, fiscalYear int32) ([]db.FiscalPeriod, error)
+	// UpdatePeriodStatus returns sql.ErrNoRows for a period of a year that
+	// has been closed.
+	UpdatePeriodStatus(ctx context.Context, id uuid.UUID, status, by string) (db.FiscalPeriod, error)
+	// CloseYear locks the year's periods and, in one transaction, writes the
+	// journal closing builds from them, when it builds one, and hard-closes
+	// every period, marking the year closed. It returns the journal as stored.
+	// closing is also handed each revenue and expense account's net movement
+	// over the locked periods, read in the same transaction.
+	CloseYear(ctx context.Context, orgID string, fiscalYear int32, by string, closing func(locked []db.FiscalPeriod, balances []db.SumIncomeStatementBalancesRow) (*db.JournalEntry, error)) (*db.JournalEntry, []db.FiscalPeriod, error)
+}
+
+// ErrPeriodClosed is returned when a write is dated into a period that does
+// not accept it. Journal repositories return it for a posting into a period
+// that was hard-closed while the posting waited for it.
+var ErrPeriodClosed = errors.New("accounting period is closed")
+
+// PeriodGuard rejects writes dated into a closed accounting period.
+type PeriodGuard interface {
+	EnsureOpen(ctx context.Context, orgID string, date time.Time, sourceType string) error
+}
+
+// CurrencyConverter supplies an organization's functional currency and the
+// rate that converts an amount in one currency into another.
+type CurrencyConverter interface {
+	FunctionalCurrency(ctx context.Context, orgID string) (string, error)
+	// Rate returns how many units of to one unit of from is worth on asOf.
+	Rate(ctx context.Context, from, to string, asOf time.Time) (string, error)
+	// RateOfType is Rate using rates of the given type (SPOT, CLOSING or
+	// AVERAGE); Rate uses spot rates.
+	RateOfType(ctx context.Context, from, to, rateType string, asOf time.Time) (string, error)
+}
+
+type FiscalPeriodService interface {
+	SetCalendar(ctx context.Context, c db.FiscalCalendar) (db.FiscalCalendar, error)
+	CreateFiscalYear(ctx context.Context, orgID string, fiscalYear int32) ([]db.FiscalPeriod, error)
+	ListPeriods(ctx context.Context, orgID string, fiscalYear int32) ([]db.FiscalPeriod, error)
+	OpenPeriod(ctx context.Context, id uuid.UUID, by string) (db.FiscalPeriod, error)
+	ClosePeriod(ctx context.Context, id uuid.UUID, soft bool, by string) (db.FiscalPeriod, error)
+	CloseFiscalYear(ctx context.Context, orgID string, fiscalYear int32, by string) (*db.JournalEntry, []db.FiscalPeriod, error)
+}
+
+// Recurring journal templates
+type JournalTemplate struct {
+	db.JournalTemplate
+	Lines []db.JournalTemplateLine
+}
+
+type JournalTemplateRepository interface {
+	Create(ctx context.Context, t *JournalTemplate) (*JournalTemplate, error)
+	Get(ctx context.Context, id uuid.UUID) (*JournalTemplate, error)
+	Update(ctx context.Context, t *JournalTemplate) (*JournalTemplate, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, limit, offset int32) ([]*JournalTemplate, error)
+	// ListDue returns active templates that may have occurrences on or before asOf.
+	ListDue(ctx context.Context, asOf time.Time) ([]*JournalTemplate, error)
+	// CreateOccurrence writes j as the templateID occurrence on date. It
+	// returns nil, nil when that occurrence was already generated.
+	CreateOccurrence(ctx context.Context, templateID uuid.UUID, date time.Time, j *db.JournalEntry) (*db.JournalEntry, error)
+}
+
+type JournalTemplateService interface {
+	Create(ctx context.Context, t *JournalTemplate) (*JournalTemplate, error)
+	Get(ctx context.Context, id uuid.UUID) (*JournalTemplate, error)
+	Update(ctx context.Context, t *JournalTemplate) (*JournalTemplate, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, limit, offset int32) ([]*JournalTemplate, error)
+	Generate(ctx context.Context, asOf time.Time) ([]*db.JournalEntry, error)
+}
+
+// Journal import
+type JournalImportOptions struct {
+	OrganizationID string
+	ImportedBy     string
+	DryRun         bool
+	Post           bool // create the entries POSTED rather than DRAFT
+}
+
+// JournalImportError is a problem found in an import file. Row is the
+// 1-based row number in the file, header included.
+type JournalImportError struct {
+	Row       int
+	Reference string
+	Field     string
+	Message   string
+}
+
+type JournalImportReport struct {
+	Entries   int
+	Lines     int
+	Committed bool
+	Errors    []JournalImportError
+}
+
+type JournalImportService interface {
+	// Import validates rows, the first of which is the header, and creates
+	// the journals unless opts.DryRun is set or any row has an error.
+	Import(ctx context.Context, rows [][]string, opts JournalImportOptions) (*JournalImportReport, error)
+}
+
+// FX revaluation
+type FXRevaluationRepository interface {
+	UpsertSettings(ctx context.Context, s db.FxRevaluationSetting) (db.FxRevaluationSetting, error)
+	GetSettings(ctx context.Context, orgID string) (db.FxRevaluationSetting, error)
+	// ForeignBankAccounts and ForeignCurrencyPositions only see the
+	// organization's bank accounts and the postings of its journals.
+	ForeignBankAccounts(ctx context.Context, orgID, functionalCurrency string) ([]db.BankAccount, error)
+	ForeignCurrencyPositions(ctx context.Context, orgID string, accountIDs []uuid.UUID, functionalCurrency string, asOf time.Time) ([]db.ListForeignCurrencyPositionsRow, error)
+	OpenPaymentDues(ctx context.Context, orgID, functionalCurrency string, asOf time.Time) ([]db.ListOpenForeignPaymentDuesRow, error)
+}
+
+// FXRevaluationItem is one open foreign-currency position. Amounts are
+// debit-positive: Balance in Currency, CarryingAmount and RevaluedAmount in
+// the functional currency at the booked and the closing rate.
+type FXRevaluationItem struct {
+	Source         string // ACCOUNT, BANK_ACCOUNT or PAYMENT_DUE
+	SourceID       string
+	Description    string
+	AccountID      uuid.UUID
+	Currency       string
+	Balance        string
+	CarryingAmount string
+	ClosingRate    string
+	RevaluedAmount string
+	Difference     string // RevaluedAmount - CarryingAmount; positive is a debit to AccountID
+}
+
+type FXRevaluation struct {
+	OrganizationID     string
+	AsOf               time.Time
+	RateType           string
+	FunctionalCurrency string
+	Items              []FXRevaluationItem
+	// Journal is the DRAFT adjustment, nil when nothing needs revaluing.
+	Journal *db.JournalEntry
+}
+
+type FXRevaluationService interface {
+	SetSettings(ctx context.Context, s db.FxRevaluationSetting) (db.FxRevaluationSetting, error)
+	GetSettings(ctx context.Context, orgID string) (db.FxRevaluationSetting, error)
+	// Revalue revalues the organization's open foreign-currency items at
+	// rateType rates as of asOf and drafts the adjusting journal.
+	Revalue(ctx context.Context, orgID string, asOf time.Time, rateType, by string) (*FXRevaluation, error)
+}
+
+// Dunning
+type DunningRepository interface {
+	// ReplaceStages swaps the organization's reminder schedule for stages.
+	ReplaceStages(ctx context.Context, orgID string, stages []db.DunningStage, by string) ([]db.DunningStage, error)
+	ListStages(ctx context.Context, orgID string) ([]db.DunningStage, error)
+	PlaceInvoiceHold(ctx context.Context, hold db.DunningHold) (db.DunningHold, error)
+	PlacePartyHold(ctx context.Context, hold db.DunningHold) (db.DunningHold, error)
+	// ReleaseInvoiceHold and ReleasePartyHold return sql.ErrNoRows when
+	// there was no hold.
+	ReleaseInvoiceHold(ctx context.Context, invoiceID uuid.UUID) error
+	ReleasePartyHold(ctx context.Context, orgID string, partyID uuid.UUID) error
+	ListHolds(ctx context.Context, orgID string) ([]db.DunningHold, error)
+
+	// PastDueInvoices lists ISSUED and PARTIALLY_PAID invoices due before asOf.
+	PastDueInvoices(ctx context.Context, asOf time.Time) ([]uuid.UUID, error)
+	// MarkPaymentDuesOverdue flags open payment dues due before asOf as
+	// OVERDUE and returns them.
+	MarkPaymentDuesOverdue(ctx context.Context, asOf time.Time, by string) ([]db.PaymentDue, error)
+	// ReminderCandidates returns the stage each open, unheld invoice has
+	// reached by asOf that no reminder went out for yet.
+	ReminderCandidates(ctx context.Context, asOf time.Time) ([]db.ListDunningReminderCandidatesRow, error)
+	// RecordReminder stores a sent reminder. It returns nil, nil when the
+	// reminder was already sent.
+	RecordReminder(ctx context.Context, r db.DunningReminder) (*db.DunningReminder, error)
+}
+
+// DunningRun is what one run of the dunning job did.
+type DunningRun struct {
+	AsOf            time.Time
+	OverdueDues     []db.PaymentDue
+	OverdueInvoices []db.Invoice
+	Reminders       []DunningReminderEvent
+}
+
+type DunningService interface {
+	SetSchedule(ctx context.Context, orgID string, stages []db.DunningStage, by string) ([]db.DunningStage, error)
+	GetSchedule(ctx context.Context, orgID string) ([]db.DunningStage, error)
+	HoldInvoice(ctx context.Context, invoiceID uuid.UUID, reason, by string) (db.DunningHold, error)
+	HoldParty(ctx context.Context, orgID string, partyID uuid.UUID, reason, by string) (db.DunningHold, error)
+	ReleaseInvoice(ctx context.Context, invoiceID uuid.UUID, by string) error
+	ReleaseParty(ctx context.Context, orgID string, partyID uuid.UUID, by string) error
+	ListHolds(ctx context.Context, orgID string) ([]db.DunningHold, error)
+	// Process marks what fell overdue by asOf and sends the reminders due.
+	Process(ctx context.Context, asOf time.Time) (*DunningRun, error)
+}
+
+// Recurring invoices
+
+// BillingPeriod is the days, both inclusive, one recurring invoice bills.
+type BillingPeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+// GeneratedInvoice is an invoice billed for one period of a recurring invoice.
+type GeneratedInvoice struct {
+	RecurringInvoiceID uuid.UUID
+	Period             BillingPeriod
+	Invoice            db.Invoice
+}
+
+type RecurringInvoiceRepository interface {
+	Create(ctx context.Context, r db.RecurringInvoice) (db.RecurringInvoice, error)
+	Get(ctx context.Context, id uuid.UUID) (db.RecurringInvoice, error)
+	Update(ctx context.Context, r db.RecurringInvoice) (db.RecurringInvoice, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, limit, offset int32) ([]db.RecurringInvoice, error)
+	// ListDue returns active recurring invoices that may have periods
+	// starting on or before asOf.
+	ListDue(ctx context.Context, asOf time.Time) ([]db.RecurringInvoice, error)
+	// CreateOccurrence writes inv as the recurringID invoice for period. It
+	// returns nil, nil when that period was already billed.
+	CreateOccurrence(ctx context.Context, recurringID uuid.UUID, period BillingPeriod, inv db.Invoice) (*db.Invoice, error)
+}
+
+type RecurringInvoiceService interface {
+	Create(ctx context.Context, r db.RecurringInvoice) (db.RecurringInvoice, error)
+	Get(ctx context.Context, id uuid.UUID) (db.RecurringInvoice, error)
+	Update(ctx context.Context, r db.RecurringInvoice) (db.RecurringInvoice, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, limit, offset int32) ([]db.RecurringInvoice, error)
+	Generate(ctx context.Context, asOf time.Time) ([]GeneratedInvoice, error)
+}
+
+// Parties
+
+// Party is a customer or vendor with its contacts, addresses, tax
+// registrations and bank accounts.
+type Party struct {
+	db.Party
+	Contacts         []db.PartyContact
+	Addresses        []db.PartyAddress
+	TaxRegistrations []db.PartyTaxRegistration
+	BankAccounts     []db.PartyBankAccount
+}
+
+// PartyFilter narrows ListParties. Empty fields match every party.
+type PartyFilter struct {
+	OrganizationID string
+	Kind           string
+	Query          string
+}
+
+type PartyRepository interface {
+	Create(ctx context.Context, p *Party) (*Party, error)
+	Get(ctx context.Context, id uuid.UUID) (*Party, error)
+	// Update rewrites the party and replaces its contacts, addresses, tax
+	// registrations and bank accounts.
+	Update(ctx context.Context, p *Party) (*Party, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, f PartyFilter, limit, offset int32) ([]*Party, error)
+	// CountDocuments counts the invoices and vendor bills that refer to id.
+	CountDocuments(ctx context.Context, id uuid.UUID) (int, error)
+}
+
+type PartyService interface {
+	Create(ctx context.Context, p *Party) (*Party, error)
+	Get(ctx context.Context, id uuid.UUID) (*Party, error)
+	Update(ctx context.Context, p *Party) (*Party, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, f PartyFilter, limit, offset int32) ([]*Party, error)
+}
+
+// Credit control
+
+// CreditExposure is what a customer owes and is about to be invoiced, net of
+// its unapplied credit notes, against its credit limit. Amounts are decimal
+// strings; CreditLimit is empty when the customer has no limit.
+type CreditExposure struct {
+	PartyID          uuid.UUID
+	OrganizationID   string
+	CurrencyCode     string
+	CreditLimit      string
+	CreditHold       bool
+	OpenDues         string
+	DraftInvoices    string
+	UnappliedCredits string
+	Exposure         string
+	// Threshold is the exposure past which invoices are blocked or need
+	// approval: the credit limit scaled by the policy's threshold percent.
+	Threshold string
+	Breached  bool
+}
+
+type CreditRepository interface {
+	GetPolicy(ctx context.Context, orgID string) (db.CreditPolicy, error)
+	SetPolicy(ctx context.Context, p db.CreditPolicy) (db.CreditPolicy, error)
+	PartyExposure(ctx context.Context, partyID uuid.UUID) (db.GetPartyCreditExposureRow, error)
+	// ApproveInvoice marks a DRAFT invoice approved on credit. It reports
+	// false when the invoice is not a draft.
+	ApproveInvoice(ctx context.Context, invoiceID uuid.UUID, by string) (bool, error)
+}
+
+// CreditGuard rejects issuing an invoice to a customer past its credit.
+type CreditGuard interface {
+	CheckIssue(ctx context.Context, inv db.Invoice) error
+}
+
+type CreditService interface {
+	CreditGuard
+	GetPolicy(ctx context.Context, orgID string) (db.CreditPolicy, error)
+	SetPolicy(ctx context.Context, p db.CreditPolicy) (db.CreditPolicy, error)
+	GetExposure(ctx context.Context, partyID uuid.UUID) (*CreditExposure, error)
+	ApproveInvoice(ctx context.Context, invoiceID uuid.UUID, by string) error
+}
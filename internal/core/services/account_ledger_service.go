@@ -3,12 +3,19 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"log"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 var (
@@ -27,7 +34,13 @@ func NewAccountService(repo ports.AccountRepository, pub ports.EventPublisher) *
 	return &AccountService{repo: repo, publisher: pub}
 }
 
+// AccountArchived is the status of an account retired from the chart.
+const AccountArchived = "ARCHIVED"
+
 func (s *AccountService) Create(ctx context.Context, a *db.Account) (*db.Account, error) {
+	if err := s.validateParent(ctx, a); err != nil {
+		return nil, err
+	}
 	createdVal, err := s.repo.Create(ctx, a) // repo expects value type
 	if err != nil {
 		return nil, err
@@ -54,6 +67,28 @@ func (s *AccountService) Get(ctx context.Context, id uuid.UUID) (*db.Account, er
 
 // AccountService
 func (s *AccountService) Update(ctx context.Context, a *db.Account) (*db.Account, error) {
+	current, err := s.get(ctx, a.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateParent(ctx, a); err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(current.Type, a.Type) {
+		usage, err := s.repo.Usage(ctx, a.ID)
+		if err != nil {
+			return nil, err
+		}
+		if usage.ChildCount > 0 {
+			return nil, fmt.Errorf("%w: account %s has child accounts, its type cannot change", ErrConflict, current.Code)
+		}
+	}
+	if a.Status == AccountArchived && current.Status != AccountArchived {
+		if err := s.ensureUnused(ctx, current, "archived"); err != nil {
+			return nil, err
+		}
+	}
+
 	updatedVal, err := s.repo.Update(ctx, a)
 	if err != nil {
 		return nil, err
@@ -72,6 +107,13 @@ func (s *AccountService) Update(ctx context.Context, a *db.Account) (*db.Account
 }
 
 func (s *AccountService) Delete(ctx context.Context, id uuid.UUID) error {
+	current, err := s.get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.ensureUnused(ctx, current, "deleted"); err != nil {
+		return err
+	}
 	if err := s.repo.Delete(ctx, id); err != nil {
 		return err
 	}
@@ -102,31 +144,128 @@ func (s *AccountService) List(ctx context.Context, limit, offset int32) ([]*db.A
 	return result, nil
 }
 
+func (s *AccountService) get(ctx context.Context, id uuid.UUID) (*db.Account, error) {
+	acc, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: account %s", ErrNotFound, id)
+	}
+	return acc, err
+}
+
+// validateParent checks that a's parent exists, has the same account type, and
+// is not a itself or one of its descendants.
+func (s *AccountService) validateParent(ctx context.Context, a *db.Account) error {
+	if !a.ParentID.Valid {
+		return nil
+	}
+	parent, err := s.repo.Get(ctx, a.ParentID.UUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: parent account %s does not exist", ErrInvalidInput, a.ParentID.UUID)
+	}
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(parent.Type, a.Type) {
+		return fmt.Errorf("%w: account type %s does not match parent %s (%s)", ErrInvalidInput, a.Type, parent.Code, parent.Type)
+	}
+
+	// Walk up from the parent; reaching a means the new link closes a loop.
+	seen := map[uuid.UUID]bool{}
+	for anc := parent; ; {
+		if anc.ID == a.ID {
+			return fmt.Errorf("%w: parent %s would make account %s its own ancestor", ErrInvalidInput, parent.Code, a.Code)
+		}
+		if !anc.ParentID.Valid || seen[anc.ID] {
+			return nil
+		}
+		seen[anc.ID] = true
+		if anc, err = s.repo.Get(ctx, anc.ParentID.UUID); err != nil {
+			return err
+		}
+	}
+}
+
+// ensureUnused blocks removing an account that still has children or ledger lines.
+func (s *AccountService) ensureUnused(ctx context.Context, a *db.Account, action string) error {
+	usage, err := s.repo.Usage(ctx, a.ID)
+	if err != nil {
+		return err
+	}
+	switch {
+	case usage.ChildCount > 0:
+		return fmt.Errorf("%w: account %s has %d child accounts and cannot be %s", ErrConflict, a.Code, usage.ChildCount, action)
+	case usage.HasLedgerEntries:
+		return fmt.Errorf("%w: account %s has posted ledger lines and cannot be %s", ErrConflict, a.Code, action)
+	}
+	return nil
+}
+
+// Journal statuses. Only DRAFT entries may be edited or deleted; POSTED
+// entries are corrected by reversal.
+const (
+	JournalDraft    = "DRAFT"
+	JournalPosted   = "POSTED"
+	JournalReversed = "REVERSED"
+)
+
 // JournalService
 type JournalService struct {
 	repo ports.JournalRepository
+	accounts ports.AccountRepository
+	periods ports.PeriodGuard
+	fx ports.CurrencyConverter
 	publisher ports.EventPublisher
 }
 
-func NewJournalService(repo ports.JournalRepository, pub ports.EventPublisher) *JournalService {
-	return &JournalService{repo: repo, publisher: pub}
+// NewJournalService builds a JournalService. periods and fx may be nil: dates
+// are then not checked against fiscal periods, and lines must all be in one
+// unnamed currency.
+func NewJournalService(repo ports.JournalRepository, accounts ports.AccountRepository, periods ports.PeriodGuard, fx ports.CurrencyConverter, pub ports.EventPublisher) *JournalService {
+	return &JournalService{repo: repo, accounts: accounts, periods: periods, fx: fx, publisher: pub}
 }
 
 func (s *JournalService) Create(ctx context.Context, j *db.JournalEntry) (*db.JournalEntry, error) {
+	if err := s.prepareCreate(ctx, j); err != nil {
+		return nil, err
+	}
+
 	createdVal, err := s.repo.Create(ctx, j)
 	if err != nil {
 		return nil, err
 	}
+	s.publishCreated(createdVal)
+	s.publishAutoReversal(ctx, createdVal)
 
-	if s.publisher != nil {
-		go func(jrnl *db.JournalEntry) {
-			if err := s.publisher.PublishJournalCreated(context.Background(), jrnl); err != nil {
-				log.Printf("failed to publish journal created: %v", err)
-			}
-		}(createdVal)
+	return createdVal, nil
+}
+
+// prepareCreate applies the checks every new entry must pass, whichever
+// repository ends up writing it.
+func (s *JournalService) prepareCreate(ctx context.Context, j *db.JournalEntry) error {
+	if j.Status == "" {
+		j.Status = JournalDraft
+	}
+	if j.Status != JournalDraft && j.Status != JournalPosted {
+		return fmt.Errorf("%w: journal cannot be created as %s", ErrInvalidInput, j.Status)
+	}
+	if err := s.validateJournal(ctx, j); err != nil {
+		return err
 	}
+	if err := s.ensureOpen(ctx, j); err != nil {
+		return err
+	}
+	return s.checkAutoReverse(ctx, j)
+}
 
-	return createdVal, nil
+func (s *JournalService) publishCreated(j *db.JournalEntry) {
+	if s.publisher == nil {
+		return
+	}
+	go func() {
+		if err := s.publisher.PublishJournalCreated(context.Background(), j); err != nil {
+			log.Printf("failed to publish journal created: %v", err)
+		}
+	}()
 }
 
 func (s *JournalService) Get(ctx context.Context, id uuid.UUID) (*db.JournalEntry, error) {
@@ -134,6 +273,34 @@ func (s *JournalService) Get(ctx context.Context, id uuid.UUID) (*db.JournalEntr
 }
 
 func (s *JournalService) Update(ctx context.Context, j *db.JournalEntry) (*db.JournalEntry, error) {
+    current, err := s.getDraft(ctx, j.ID)
+    if err != nil {
+        return nil, err
+    }
+    if j.Status == "" {
+        j.Status = JournalDraft
+    }
+    if j.Status != JournalDraft && j.Status != JournalPosted {
+        return nil, fmt.Errorf("%w: journal cannot be moved to %s", ErrInvalidInput, j.Status)
+    }
+    // The organization never changes on update, and decides the functional
+    // currency the lines are converted into.
+    j.OrganizationID = current.OrganizationID
+    if err := s.validateJournal(ctx, j); err != nil {
+        return nil, err
+    }
+    // Moving an entry out of a closed period is as much a change to that
+    // period as moving one in.
+    if err := s.ensureOpen(ctx, current); err != nil {
+        return nil, err
+    }
+    if err := s.ensureOpen(ctx, j); err != nil {
+        return nil, err
+    }
+    if err := s.checkAutoReverse(ctx, j); err != nil {
+        return nil, err
+    }
+
     // Convert pointer to value for repo
     updatedVal, err := s.repo.Update(ctx, j)
     if err != nil {
@@ -148,6 +315,7 @@ func (s *JournalService) Update(ctx context.Context, j *db.JournalEntry) (*db.Jo
             }
         }(updatedVal)
     }
+    s.publishAutoReversal(ctx, updatedVal)
 
     return updatedVal, nil
 }
@@ -156,6 +324,13 @@ func (s *JournalService) Update(ctx context.Context, j *db.JournalEntry) (*db.Jo
 
 
 func (s *JournalService) Delete(ctx context.Context, id uuid.UUID) error {
+	current, err := s.getDraft(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.ensureOpen(ctx, current); err != nil {
+		return err
+	}
 	if err := s.repo.Delete(ctx, id); err != nil {
 		return err
 	}
@@ -171,19 +346,405 @@ func (s *JournalService) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (s *JournalService) List(ctx context.Context, limit, offset int32) ([]*db.JournalEntry, error) {
-	return s.repo.List(ctx, limit, offset)
+func (s *JournalService) List(ctx context.Context, f ports.EntryFilter, limit, offset int32) ([]*db.JournalEntry, error) {
+	if err := validateEntryFilter(f); err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, f, limit, offset)
+}
+
+// Reverse posts a mirror image of a POSTED entry on reversalDate and marks the
+// original REVERSED. The reversal is returned.
+func (s *JournalService) Reverse(ctx context.Context, id uuid.UUID, reversalDate time.Time, memo string) (*db.JournalEntry, error) {
+	reversal, err := s.prepareReverse(ctx, id, reversalDate, memo)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Reverse(ctx, id, reversal)
+	if err != nil {
+		return nil, err
+	}
+	s.publishCreated(created)
+
+	return created, nil
+}
+
+// prepareReverse builds the reversal of a POSTED entry and applies the checks
+// it must pass, whichever repository ends up writing it.
+func (s *JournalService) prepareReverse(ctx context.Context, id uuid.UUID, reversalDate time.Time, memo string) (*db.JournalEntry, error) {
+	if reversalDate.IsZero() {
+		return nil, fmt.Errorf("%w: reversal date is required", ErrInvalidInput)
+	}
+	orig, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if orig.Status != JournalPosted {
+		return nil, fmt.Errorf("%w: journal %s is %s, only POSTED entries can be reversed", ErrConflict, id, orig.Status)
+	}
+
+	if memo == "" {
+		memo = "Reversal of journal " + id.String()
+	}
+	reversal := &db.JournalEntry{
+		JournalDate:    reversalDate,
+		Reference:      orig.Reference,
+		Memo:           sql.NullString{String: memo, Valid: true},
+		SourceType:     orig.SourceType,
+		SourceID:       orig.SourceID,
+		OrganizationID: orig.OrganizationID,
+		Status:         JournalPosted,
+		Lines:          make([]db.JournalLine, len(orig.Lines)),
+	}
+	for i, l := range orig.Lines {
+		l.Side = oppositeSide(l.Side)
+		reversal.Lines[i] = l
+	}
+	if err := s.ensureOpen(ctx, reversal); err != nil {
+		return nil, err
+	}
+	return reversal, nil
+}
+
+// checkAutoReverse makes sure an entry's auto-reversal, which the repository
+// posts in the same transaction as the entry, falls in an open period.
+func (s *JournalService) checkAutoReverse(ctx context.Context, j *db.JournalEntry) error {
+	if !j.AutoReverseDate.Valid {
+		return nil
+	}
+	if !j.AutoReverseDate.Time.After(j.JournalDate) {
+		return fmt.Errorf("%w: auto-reverse date %s must be after the journal date %s", ErrInvalidInput,
+			j.AutoReverseDate.Time.Format(time.DateOnly), j.JournalDate.Format(time.DateOnly))
+	}
+	return ensureOpen(ctx, s.periods, j.OrganizationID.String, j.AutoReverseDate.Time, j.SourceType.String)
+}
+
+// publishAutoReversal announces the reversal the repository posted along with
+// j, when j was posted with an auto-reverse date.
+func (s *JournalService) publishAutoReversal(ctx context.Context, j *db.JournalEntry) {
+	if s.publisher == nil || j.Status != JournalReversed || !j.ReversedBy.Valid {
+		return
+	}
+	reversal, err := s.repo.Get(ctx, j.ReversedBy.UUID)
+	if err != nil {
+		log.Printf("failed to load auto-reversal of journal %s: %v", j.ID, err)
+		return
+	}
+	s.publishCreated(reversal)
+}
+
+func (s *JournalService) get(ctx context.Context, id uuid.UUID) (*db.JournalEntry, error) {
+	j, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: journal %s", ErrNotFound, id)
+	}
+	return j, err
+}
+
+func (s *JournalService) getDraft(ctx context.Context, id uuid.UUID) (*db.JournalEntry, error) {
+	j, err := s.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if j.Status != JournalDraft {
+		return nil, fmt.Errorf("%w: journal %s is %s, only DRAFT entries can be changed", ErrConflict, id, j.Status)
+	}
+	return j, nil
+}
+
+// ensureOpen rejects entries dated into a closed accounting period.
+func (s *JournalService) ensureOpen(ctx context.Context, j *db.JournalEntry) error {
+	return ensureOpen(ctx, s.periods, j.OrganizationID.String, j.JournalDate, j.SourceType.String)
+}
+
+func oppositeSide(side string) string {
+	if side == SideDebit {
+		return SideCredit
+	}
+	return SideDebit
 }
 
 // LedgerService
 type LedgerService struct {
-	repo ports.LedgerRepository
+	repo     ports.LedgerRepository
+	accounts ports.AccountRepository
+	fx       ports.CurrencyConverter
+}
+
+// NewLedgerService builds a LedgerService. fx may be nil, in which case
+// balances carry no currency code.
+func NewLedgerService(repo ports.LedgerRepository, accounts ports.AccountRepository, fx ports.CurrencyConverter) *LedgerService {
+	return &LedgerService{repo: repo, accounts: accounts, fx: fx}
 }
 
-func NewLedgerService(repo ports.LedgerRepository) *LedgerService {
-	return &LedgerService{repo: repo}
+func (s *LedgerService) List(ctx context.Context, f ports.EntryFilter, limit, offset int32) ([]*db.LedgerEntry, error) {
+	if err := validateEntryFilter(f); err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, f, limit, offset)
 }
 
-func (s *LedgerService) List(ctx context.Context, limit, offset int32) ([]*db.LedgerEntry, error) {
-	return s.repo.List(ctx, limit, offset)
-}
\ No newline at end of file
+// Sort keys accepted by journal and ledger listings.
+const (
+	OrderByDate   = "date"
+	OrderByAmount = "amount"
+)
+
+func validateEntryFilter(f ports.EntryFilter) error {
+	if f.Side != "" && f.Side != SideDebit && f.Side != SideCredit {
+		return fmt.Errorf("%w: side must be %s or %s", ErrInvalidInput, SideDebit, SideCredit)
+	}
+	if !f.FromDate.IsZero() && !f.ToDate.IsZero() && f.ToDate.Before(f.FromDate) {
+		return fmt.Errorf("%w: to_date is before from_date", ErrInvalidInput)
+	}
+	switch f.OrderBy {
+	case "", OrderByDate, OrderByAmount:
+	default:
+		return fmt.Errorf("%w: cannot order by %q", ErrInvalidInput, f.OrderBy)
+	}
+	return nil
+}
+
+// Balances returns the opening balance, debits, credits and closing balance of
+// each account over [from, to], both dates inclusive, in the functional
+// currency and broken down by transaction currency. Only journals of orgID are
+// counted; "" counts the journals recorded without an organization.
+func (s *LedgerService) Balances(ctx context.Context, orgID string, accountIDs []uuid.UUID, from, to time.Time) ([]ports.AccountBalance, error) {
+	if to.IsZero() {
+		return nil, fmt.Errorf("%w: to date is required", ErrInvalidInput)
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: from date %s is after to date %s", ErrInvalidInput,
+			from.Format(time.DateOnly), to.Format(time.DateOnly))
+	}
+
+	rows, err := s.repo.Balances(ctx, orgID, accountIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	functional, err := s.functionalCurrency(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	balances := make([]ports.AccountBalance, len(rows))
+	byAccount := make(map[uuid.UUID]*ports.AccountBalance, len(rows))
+	for i, r := range rows {
+		b, err := signedBalance(r)
+		if err != nil {
+			return nil, err
+		}
+		b.From, b.To, b.Currency = from, to, functional
+		balances[i] = b
+		byAccount[r.AccountID] = &balances[i]
+	}
+
+	currencyRows, err := s.repo.CurrencyBalances(ctx, orgID, accountIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range currencyRows {
+		b, ok := byAccount[r.AccountID]
+		if !ok {
+			continue
+		}
+		amounts, err := signedAmounts(b.Type, r.OpeningDebit, r.OpeningCredit, r.PeriodDebit, r.PeriodCredit)
+		if err != nil {
+			return nil, fmt.Errorf("%s balance for account %s: %w", r.CurrencyCode, r.AccountID, err)
+		}
+		b.Currencies = append(b.Currencies, ports.CurrencyBalance{
+			Currency:     r.CurrencyCode,
+			Opening:      amounts[0],
+			TotalDebits:  amounts[1],
+			TotalCredits: amounts[2],
+			Closing:      amounts[3],
+		})
+	}
+	return balances, nil
+}
+
+// functionalCurrency is the currency orgID's ledger amounts are kept in.
+func (s *LedgerService) functionalCurrency(ctx context.Context, orgID string) (string, error) {
+	if s.fx == nil {
+		return "", nil
+	}
+	return s.fx.FunctionalCurrency(ctx, orgID)
+}
+
+// IsDebitNormal reports whether an account of the given type carries a debit
+// balance: assets and expenses do, liabilities, equity and revenue do not.
+func IsDebitNormal(accountType string) bool {
+	switch strings.ToUpper(accountType) {
+	case "ASSET", "EXPENSE":
+		return true
+	default:
+		return false
+	}
+}
+
+func signedBalance(r db.ListAccountBalancesRow) (ports.AccountBalance, error) {
+	amounts, err := signedAmounts(r.Type, r.OpeningDebit, r.OpeningCredit, r.PeriodDebit, r.PeriodCredit)
+	if err != nil {
+		return ports.AccountBalance{}, fmt.Errorf("balance for account %s: %w", r.AccountID, err)
+	}
+	return ports.AccountBalance{
+		AccountID:    r.AccountID,
+		Code:         r.Code,
+		Name:         r.Name,
+		Type:         r.Type,
+		Opening:      amounts[0],
+		TotalDebits:  amounts[1],
+		TotalCredits: amounts[2],
+		Closing:      amounts[3],
+	}, nil
+}
+
+// signedAmounts turns raw opening and period debit/credit totals into the
+// opening, debits, credits and closing of an account of type accountType.
+func signedAmounts(accountType, openingDebit, openingCredit, periodDebit, periodCredit string) ([4]string, error) {
+	var amounts [4]decimal.Decimal
+	for i, v := range []string{openingDebit, openingCredit, periodDebit, periodCredit} {
+		d, err := decimal.NewFromString(v)
+		if err != nil {
+			return [4]string{}, err
+		}
+		amounts[i] = d
+	}
+	openDr, openCr, debits, credits := amounts[0], amounts[1], amounts[2], amounts[3]
+
+	opening := openDr.Sub(openCr)
+	movement := debits.Sub(credits)
+	if !IsDebitNormal(accountType) {
+		opening, movement = opening.Neg(), movement.Neg()
+	}
+	return [4]string{
+		opening.StringFixed(amountScale),
+		debits.StringFixed(amountScale),
+		credits.StringFixed(amountScale),
+		opening.Add(movement).StringFixed(amountScale),
+	}, nil
+}
+
+// AccountTree returns the chart of accounts as a forest. Each node's balance
+// covers orgID's postings to the account and all of its descendants over
+// [from, to].
+func (s *LedgerService) AccountTree(ctx context.Context, orgID string, from, to time.Time) ([]*ports.AccountTreeNode, error) {
+	accounts, err := s.accounts.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	balances, err := s.Balances(ctx, orgID, nil, from, to)
+	if err != nil {
+		return nil, err
+	}
+	functional, err := s.functionalCurrency(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	byAccount := make(map[uuid.UUID]ports.AccountBalance, len(balances))
+	for _, b := range balances {
+		byAccount[b.AccountID] = b
+	}
+
+	nodes := make(map[uuid.UUID]*ports.AccountTreeNode, len(accounts))
+	for _, a := range accounts {
+		b, ok := byAccount[a.ID]
+		if !ok {
+			b = ports.AccountBalance{AccountID: a.ID, Code: a.Code, Name: a.Name, Type: a.Type, From: from, To: to,
+				Currency: functional, Opening: "0.00", TotalDebits: "0.00", TotalCredits: "0.00", Closing: "0.00"}
+		}
+		nodes[a.ID] = &ports.AccountTreeNode{Account: a, Balance: b}
+	}
+
+	// accounts is ordered by code, so children stay in code order.
+	var roots []*ports.AccountTreeNode
+	for _, a := range accounts {
+		n := nodes[a.ID]
+		if parent, ok := nodes[a.ParentID.UUID]; a.ParentID.Valid && ok {
+			parent.Children = append(parent.Children, n)
+		} else {
+			roots = append(roots, n)
+		}
+	}
+	for _, r := range roots {
+		if err := rollUp(r); err != nil {
+			return nil, err
+		}
+	}
+	return roots, nil
+}
+
+// rollUp adds every descendant's balance into n. Children share their parent's
+// account type, so the signed amounts can be summed directly.
+func rollUp(n *ports.AccountTreeNode) error {
+	total, err := balanceAmounts(n)
+	if err != nil {
+		return err
+	}
+	for _, c := range n.Children {
+		if err := rollUp(c); err != nil {
+			return err
+		}
+		sub, err := balanceAmounts(c)
+		if err != nil {
+			return err
+		}
+		for i := range total {
+			total[i] = total[i].Add(sub[i])
+		}
+		if n.Balance.Currencies, err = mergeCurrencies(n.Balance.Currencies, c.Balance.Currencies); err != nil {
+			return fmt.Errorf("balance for account %s: %w", n.Account.Code, err)
+		}
+	}
+	n.Balance.Opening = total[0].StringFixed(amountScale)
+	n.Balance.TotalDebits = total[1].StringFixed(amountScale)
+	n.Balance.TotalCredits = total[2].StringFixed(amountScale)
+	n.Balance.Closing = total[3].StringFixed(amountScale)
+	return nil
+}
+
+// mergeCurrencies adds the per-currency amounts of src into dst, keeping dst
+// ordered by currency code. dst is not modified in place.
+func mergeCurrencies(dst, src []ports.CurrencyBalance) ([]ports.CurrencyBalance, error) {
+	if len(src) == 0 {
+		return dst, nil
+	}
+	merged := append([]ports.CurrencyBalance(nil), dst...)
+	for _, c := range src {
+		i := sort.Search(len(merged), func(i int) bool { return merged[i].Currency >= c.Currency })
+		if i == len(merged) || merged[i].Currency != c.Currency {
+			merged = slices.Insert(merged, i, ports.CurrencyBalance{Currency: c.Currency,
+				Opening: "0.00", TotalDebits: "0.00", TotalCredits: "0.00", Closing: "0.00"})
+		}
+		m := &merged[i]
+		fields := [][2]*string{
+			{&m.Opening, &c.Opening}, {&m.TotalDebits, &c.TotalDebits},
+			{&m.TotalCredits, &c.TotalCredits}, {&m.Closing, &c.Closing},
+		}
+		for _, f := range fields {
+			a, err := decimal.NewFromString(*f[0])
+			if err != nil {
+				return nil, err
+			}
+			b, err := decimal.NewFromString(*f[1])
+			if err != nil {
+				return nil, err
+			}
+			*f[0] = a.Add(b).StringFixed(amountScale)
+		}
+	}
+	return merged, nil
+}
+
+func balanceAmounts(n *ports.AccountTreeNode) ([4]decimal.Decimal, error) {
+	var out [4]decimal.Decimal
+	b := n.Balance
+	for i, v := range []string{b.Opening, b.TotalDebits, b.TotalCredits, b.Closing} {
+		d, err := decimal.NewFromString(v)
+		if err != nil {
+			return out, fmt.Errorf("balance for account %s: %w", n.Account.Code, err)
+		}
+		out[i] = d
+	}
+	return out, nil
+}
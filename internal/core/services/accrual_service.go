@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
@@ -16,15 +17,19 @@ var (
 
 type AccrualService struct {
 	repo      ports.AccrualRepository
+	periods   ports.PeriodGuard
 	publisher ports.EventPublisher
 	topic     string
 }
 
-func NewAccrualService(r ports.AccrualRepository, producer ports.EventPublisher, topic string) *AccrualService {
-	return &AccrualService{repo: r, publisher: producer, topic: topic}
+func NewAccrualService(r ports.AccrualRepository, periods ports.PeriodGuard, producer ports.EventPublisher, topic string) *AccrualService {
+	return &AccrualService{repo: r, periods: periods, publisher: producer, topic: topic}
 }
 
 func (s *AccrualService) Create(ctx context.Context, a db.Accrual) (db.Accrual, error) {
+	if err := s.ensureOpen(ctx, a); err != nil {
+		return db.Accrual{}, err
+	}
 	acc, err := s.repo.Create(ctx, a)
 	if err == nil {
 		s.publishEvent(ctx, "AccrualCreated", acc)
@@ -37,6 +42,9 @@ func (s *AccrualService) Get(ctx context.Context, id uuid.UUID) (db.Accrual, err
 }
 
 func (s *AccrualService) Update(ctx context.Context, a db.Accrual) (db.Accrual, error) {
+	if err := s.ensureStoredOpen(ctx, a.ID, a.AccrualDate); err != nil {
+		return db.Accrual{}, err
+	}
 	acc, err := s.repo.Update(ctx, a)
 	if err == nil {
 		s.publishEvent(ctx, "AccrualUpdated", acc)
@@ -45,6 +53,9 @@ func (s *AccrualService) Update(ctx context.Context, a db.Accrual) (db.Accrual,
 }
 
 func (s *AccrualService) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.ensureStoredOpen(ctx, id); err != nil {
+		return err
+	}
 	err := s.repo.Delete(ctx, id)
 	if err == nil {
 		s.publishEvent(ctx, "AccrualDeleted", map[string]any{"id": id})
@@ -56,6 +67,29 @@ func (s *AccrualService) List(ctx context.Context, limit, offset int32) ([]db.Ac
 	return s.repo.List(ctx, limit, offset)
 }
 
+// helper: reject accruals dated into a closed period
+func (s *AccrualService) ensureOpen(ctx context.Context, a db.Accrual) error {
+	return ensureOpen(ctx, s.periods, a.OrganizationID.String, a.AccrualDate, "")
+}
+
+// helper: reject changes to an accrual dated into a closed period, checking
+// both the stored date and any new date it is being moved to
+func (s *AccrualService) ensureStoredOpen(ctx context.Context, id uuid.UUID, moveTo ...time.Time) error {
+	if s.periods == nil {
+		return nil
+	}
+	current, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, d := range append([]time.Time{current.AccrualDate}, moveTo...) {
+		if err := ensureOpen(ctx, s.periods, current.OrganizationID.String, d, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // helper: publish Kafka event
 func (s *AccrualService) publishEvent(ctx context.Context, eventType string, payload interface{}) {
 	data := map[string]interface{}{
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// currencyWords names the major and minor units of the currencies amounts
+// are most often written out in.
+var currencyWords = map[string][2]string{
+	"INR": {"Rupees", "Paise"},
+	"USD": {"US Dollars", "Cents"},
+	"EUR": {"Euros", "Cents"},
+	"GBP": {"Pounds Sterling", "Pence"},
+	"AED": {"UAE Dirhams", "Fils"},
+	"SGD": {"Singapore Dollars", "Cents"},
+}
+
+var (
+	smallNumberWords = []string{
+		"Zero", "One", "Two", "Three", "Four", "Five", "Six", "Seven", "Eight", "Nine", "Ten",
+		"Eleven", "Twelve", "Thirteen", "Fourteen", "Fifteen", "Sixteen", "Seventeen", "Eighteen", "Nineteen",
+	}
+	tensWords = []string{"", "", "Twenty", "Thirty", "Forty", "Fifty", "Sixty", "Seventy", "Eighty", "Ninety"}
+)
+
+// numberScale is one named power of ten, largest first.
+type numberScale struct {
+	value int64
+	name  string
+}
+
+// Indian numbering groups by lakh and crore; crores of crores are written
+// as such, e.g. "One Hundred Crore".
+var indianScales = []numberScale{{10_000_000, "Crore"}, {100_000, "Lakh"}, {1_000, "Thousand"}}
+
+var internationalScales = []numberScale{
+	{1_000_000_000_000, "Trillion"}, {1_000_000_000, "Billion"}, {1_000_000, "Million"}, {1_000, "Thousand"},
+}
+
+// AmountInWords writes amount out as printed on invoices, e.g. "Rupees One
+// Lakh Eighteen Thousand and Fifty Paise Only". INR amounts use Indian
+// numbering (lakh, crore), others international numbering. scale is the
+// number of minor-unit digits the currency has; currencies without known
+// unit names are written with their code.
+func AmountInWords(amount, currency string, scale int32) (string, error) {
+	d, err := decimal.NewFromString(strings.TrimSpace(amount))
+	if err != nil {
+		return "", fmt.Errorf("%w: %q is not an amount", ErrInvalidInput, amount)
+	}
+	currency = strings.ToUpper(currency)
+	scales := internationalScales
+	if currency == "INR" {
+		scales = indianScales
+	}
+	units, ok := currencyWords[currency]
+	if !ok {
+		units = [2]string{currency, ""}
+	}
+
+	d = d.Round(scale)
+	var b strings.Builder
+	if d.IsNegative() {
+		b.WriteString("Minus ")
+		d = d.Abs()
+	}
+	major := d.Truncate(0)
+	if !major.LessThan(decimal.New(1, 18)) {
+		return "", fmt.Errorf("%w: %s is too large to write out", ErrInvalidInput, amount)
+	}
+	b.WriteString(units[0] + " " + numberInWords(major.IntPart(), scales))
+
+	if minor := d.Sub(major).Shift(scale).IntPart(); minor > 0 {
+		b.WriteString(" and " + numberInWords(minor, scales))
+		if units[1] != "" {
+			b.WriteString(" " + units[1])
+		}
+	}
+	b.WriteString(" Only")
+	return b.String(), nil
+}
+
+func numberInWords(n int64, scales []numberScale) string {
+	if n < 1000 {
+		return hundredsInWords(n)
+	}
+	var parts []string
+	for _, s := range scales {
+		if n >= s.value {
+			parts = append(parts, numberInWords(n/s.value, scales)+" "+s.name)
+			n %= s.value
+		}
+	}
+	if n > 0 {
+		parts = append(parts, hundredsInWords(n))
+	}
+	return strings.Join(parts, " ")
+}
+
+func hundredsInWords(n int64) string {
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, smallNumberWords[n/100]+" Hundred")
+		n %= 100
+		if n == 0 {
+			return strings.Join(parts, " ")
+		}
+	}
+	switch {
+	case n < 20:
+		parts = append(parts, smallNumberWords[n])
+	case n%10 == 0:
+		parts = append(parts, tensWords[n/10])
+	default:
+		parts = append(parts, tensWords[n/10]+"-"+smallNumberWords[n%10])
+	}
+	return strings.Join(parts, " ")
+}
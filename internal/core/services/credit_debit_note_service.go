@@ -2,39 +2,323 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
+// Note types. The API's enum names, NOTE_TYPE_CREDIT and NOTE_TYPE_DEBIT,
+// are taken for them too.
+const (
+	NoteCredit = "CREDIT"
+	NoteDebit  = "DEBIT"
+)
+
+// SourceCreditDebitNote is the source type of the journals notes post.
+const SourceCreditDebitNote = "CREDIT_DEBIT_NOTE"
+
+// Payment due statuses notes write: a debit note raises a DUE, and a due a
+// credit note takes to nothing is PAID. PAID and WRITEOFF dues are settled.
+const (
+	PaymentDueOpen     = "DUE"
+	PaymentDuePaid     = "PAID"
+	PaymentDueWriteOff = "WRITEOFF"
+)
+
+// noteStatuses are the invoice statuses notes can be applied in. Drafts are
+// changed instead and VOID invoices are final. A PAID invoice has nothing
+// left to credit, but can still be debited.
+var noteStatuses = []string{InvoiceIssued, InvoicePartiallyPaid, InvoiceOverdue}
+
 type creditDebitNoteService struct {
 	repo      ports.CreditDebitNoteRepository
+	invoices  *InvoiceService
 	publisher ports.EventPublisher
 }
 
-// NewCreditDebitNoteService creates a new service instance with Kafka publisher.
-func NewCreditDebitNoteService(repo ports.CreditDebitNoteRepository, publisher ports.EventPublisher) ports.CreditDebitNoteService {
+// NewCreditDebitNoteService creates a new service instance with Kafka
+// publisher. Notes are applied to invoices as invoices loads them, and
+// post their journals with its journal service.
+func NewCreditDebitNoteService(repo ports.CreditDebitNoteRepository, invoices *InvoiceService, publisher ports.EventPublisher) ports.CreditDebitNoteService {
 	return &creditDebitNoteService{
 		repo:      repo,
+		invoices:  invoices,
 		publisher: publisher,
 	}
 }
 
-// Create a new credit/debit note and publish event
-// Create a new credit/debit note and publish event
+// Create applies a note to its ISSUED, PARTIALLY_PAID or OVERDUE invoice,
+// or a debit note to its PAID invoice, and publishes it. A credit note is refused for more than the invoice
+// leaves open, what it bills net of notes less what its paid and written-off
+// dues settled, whether or not dues were raised for it. It reduces the
+// invoice's open payment dues, earliest due first, and the invoice is PAID
+// once nothing is left open. A debit note raises a new due, payable
+// on the invoice's due date or today when that has passed, and moves a PAID
+// invoice back to PARTIALLY_PAID. When the invoice
+// posted a journal, the note posts the invoice's revenue and tax in
+// proportion to its share of the grand total, reversed for a credit note.
 func (s *creditDebitNoteService) Create(ctx context.Context, note db.CreditDebitNote) (db.CreditDebitNote, error) {
-	created, err := s.repo.Create(ctx, note)
+	typ, amount, err := checkNote(note)
+	if err != nil {
+		return db.CreditDebitNote{}, err
+	}
+	note.Type, note.Amount = typ, amount.StringFixed(amountScale)
+
+	inv, err := s.invoices.get(ctx, note.InvoiceID)
+	if err != nil {
+		return db.CreditDebitNote{}, err
+	}
+	if err := checkNoteInvoice(inv, typ); err != nil {
+		return db.CreditDebitNote{}, err
+	}
+	by := note.CreatedBy.String
+	rule, journal, err := s.noteJournal(ctx, inv, typ, amount, by)
+	if err != nil {
+		return db.CreditDebitNote{}, err
+	}
+
+	var before db.Invoice
+	applied, after, posted, err := s.repo.Apply(ctx, note, by, func(n db.CreditDebitNote, locked db.Invoice, dues []db.PaymentDue) (ports.NoteApplication, error) {
+		before = locked
+		if err := checkNoteInvoice(locked, typ); err != nil {
+			return ports.NoteApplication{}, err
+		}
+		app, err := applyNote(n, locked, dues, amount, rule)
+		if err != nil {
+			return ports.NoteApplication{}, err
+		}
+		if journal != nil {
+			app.Journal, err = journal(n)
+		}
+		return app, err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.CreditDebitNote{}, fmt.Errorf("%w: invoice %s", ErrNotFound, note.InvoiceID)
+	}
 	if err != nil {
 		return db.CreditDebitNote{}, err
 	}
+	if posted != nil {
+		s.invoices.journals.publishCreated(posted)
+	}
+	if after.Status != before.Status {
+		s.invoices.transitioned(ctx, before, after, nil, fmt.Sprintf("%s note %s", strings.ToLower(typ), applied.ID))
+	}
 
 	// Publish typed event
-	if err := s.publisher.PublishCreditDebitNoteCreated(ctx, &created); err != nil {
+	if err := s.publisher.PublishCreditDebitNoteCreated(ctx, &applied); err != nil {
 		println("Kafka publish error:", err.Error())
 	}
 
-	return created, nil
+	return applied, nil
+}
+
+// checkNote returns the note's type in its short form and its amount, which
+// must be positive.
+func checkNote(note db.CreditDebitNote) (string, decimal.Decimal, error) {
+	typ := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(note.Type)), "NOTE_TYPE_")
+	if typ != NoteCredit && typ != NoteDebit {
+		return "", decimal.Zero, fmt.Errorf("%w: note type must be CREDIT or DEBIT, not %q", ErrInvalidInput, note.Type)
+	}
+	amount, ok := parseAmount(note.Amount)
+	if !ok || !amount.IsPositive() {
+		return "", decimal.Zero, fmt.Errorf("%w: note amount %q is not a positive amount", ErrInvalidInput, note.Amount)
+	}
+	return typ, amount.Round(amountScale), nil
+}
+
+func checkNoteInvoice(inv db.Invoice, typ string) error {
+	if typ == NoteDebit && inv.Status == InvoicePaid {
+		return nil
+	}
+	if !slices.Contains(noteStatuses, inv.Status) {
+		return fmt.Errorf("%w: invoice %s is %s; notes apply to ISSUED, PARTIALLY_PAID and OVERDUE invoices, and debit notes also to PAID ones", ErrConflict, inv.ID, inv.Status)
+	}
+	return nil
+}
+
+// applyNote works out what note does to its locked invoice and the
+// invoice's dues.
+func applyNote(note db.CreditDebitNote, inv db.Invoice, dues []db.PaymentDue, amount decimal.Decimal, rule *db.InvoicePostingRule) (ports.NoteApplication, error) {
+	app := ports.NoteApplication{Credited: "0", Debited: "0", Status: inv.Status}
+	balance, _ := parseAmount(NetBilled(inv))
+	var open []db.PaymentDue
+	for _, d := range dues {
+		if d.Status == PaymentDuePaid || d.Status == PaymentDueWriteOff {
+			settled, _ := parseAmount(d.AmountDue)
+			balance = balance.Sub(settled)
+			continue
+		}
+		open = append(open, d)
+	}
+	if note.Type == NoteDebit {
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		due := &db.PaymentDue{
+			InvoiceID:    inv.ID,
+			AmountDue:    note.Amount,
+			DueDate:      today,
+			Status:       PaymentDueOpen,
+			CurrencyCode: inv.CurrencyCode,
+			ExchangeRate: "1",
+		}
+		if inv.DueDate.Valid && inv.DueDate.Time.After(today) {
+			due.DueDate = inv.DueDate.Time
+		}
+		if len(open) > 0 {
+			due.ExchangeRate, due.AccountID = open[0].ExchangeRate, open[0].AccountID
+		} else if rule != nil {
+			due.AccountID = uuid.NullUUID{UUID: rule.ControlAccountID, Valid: true}
+		}
+		app.NewDue, app.Debited = due, note.Amount
+		if inv.Status == InvoicePaid {
+			app.Status = InvoicePartiallyPaid
+		}
+		return app, nil
+	}
+
+	if amount.GreaterThan(balance) {
+		return app, fmt.Errorf("%w: credit note of %s exceeds the %s open on invoice %s", ErrConflict,
+			note.Amount, balance.StringFixed(amountScale), inv.ID)
+	}
+	left := amount
+	for _, d := range open {
+		if !left.IsPositive() {
+			break
+		}
+		amt, _ := parseAmount(d.AmountDue)
+		take := decimal.Min(left, amt)
+		d.AmountDue = amt.Sub(take).StringFixed(amountScale)
+		if !amt.GreaterThan(take) {
+			d.Status = PaymentDuePaid
+		}
+		app.Dues = append(app.Dues, d)
+		left = left.Sub(take)
+	}
+	app.Credited = note.Amount
+	if amount.Equal(balance) {
+		app.Status = InvoicePaid
+	}
+	return app, nil
+}
+
+// noteJournal returns the posting rule of an invoice that posted a journal
+// and what builds the POSTED journal a note of amount against it books. The
+// invoice's posting is scaled to the note's share of its grand total and,
+// for a credit note, reversed. Both are nil when the invoice posted none or
+// the service has no journal service to post with.
+func (s *creditDebitNoteService) noteJournal(ctx context.Context, inv db.Invoice, typ string, amount decimal.Decimal, by string) (*db.InvoicePostingRule, func(db.CreditDebitNote) (*db.JournalEntry, error), error) {
+	if s.invoices.journals == nil || !inv.JournalID.Valid {
+		return nil, nil, nil
+	}
+	invoiceType := strings.ToUpper(inv.Type)
+	rule, err := s.invoices.repo.GetPostingRule(ctx, inv.OrganizationID, invoiceType)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, fmt.Errorf("%w: no posting accounts configured for %s invoices of %s", ErrInvalidInput, invoiceType, inv.OrganizationID)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	lines, err := invoicePostingLines(inv, rule)
+	if err != nil {
+		return nil, nil, err
+	}
+	total, _ := parseAmount(inv.GrandTotal)
+	if !total.IsPositive() {
+		return nil, nil, fmt.Errorf("%w: invoice %s has no grand total to apportion the note over", ErrConflict, inv.ID)
+	}
+	lines = apportionPosting(lines, amount, total, typ == NoteCredit)
+
+	return &rule, func(note db.CreditDebitNote) (*db.JournalEntry, error) {
+		memo := fmt.Sprintf("%s note against invoice %s", strings.ToLower(typ), inv.InvoiceNumber)
+		if note.Reason.Valid && strings.TrimSpace(note.Reason.String) != "" {
+			memo += ": " + strings.TrimSpace(note.Reason.String)
+		}
+		j := &db.JournalEntry{
+			JournalDate:    time.Now().UTC().Truncate(24 * time.Hour),
+			Reference:      sql.NullString{String: inv.InvoiceNumber, Valid: inv.InvoiceNumber != ""},
+			Memo:           sql.NullString{String: memo, Valid: true},
+			SourceType:     sql.NullString{String: SourceCreditDebitNote, Valid: true},
+			SourceID:       sql.NullString{String: note.ID.String(), Valid: true},
+			CreatedBy:      sql.NullString{String: by, Valid: by != ""},
+			UpdatedBy:      sql.NullString{String: by, Valid: by != ""},
+			OrganizationID: sql.NullString{String: inv.OrganizationID, Valid: inv.OrganizationID != ""},
+			Status:         JournalPosted,
+			Lines:          lines,
+		}
+		if err := s.invoices.journals.prepareCreate(ctx, j); err != nil {
+			return nil, fmt.Errorf("post note against invoice %s: %w", inv.ID, err)
+		}
+		return j, nil
+	}, nil
+}
+
+// apportionPosting scales an invoice's posting lines to a note of amount
+// out of the invoice's total. The control line, first, takes amount itself
+// and every other line its share, the largest taking what rounding leaves
+// so that the lines balance. Reversed lines change sides.
+func apportionPosting(lines []db.JournalLine, amount, total decimal.Decimal, reverse bool) []db.JournalLine {
+	signed := make([]decimal.Decimal, len(lines))
+	balance := decimal.Zero
+	largest := 0
+	for i, l := range lines {
+		amt := amount
+		if i > 0 {
+			amt, _ = parseAmount(l.Amount)
+			amt = amt.Mul(amount).Div(total).Round(amountScale)
+		}
+		if l.Side == SideCredit {
+			amt = amt.Neg()
+		}
+		signed[i] = amt
+		balance = balance.Add(amt)
+		if i > 0 && (largest == 0 || amt.Abs().GreaterThan(signed[largest].Abs())) {
+			largest = i
+		}
+	}
+	if largest > 0 {
+		signed[largest] = signed[largest].Sub(balance)
+	}
+
+	out := make([]db.JournalLine, 0, len(lines))
+	for i, l := range lines {
+		if signed[i].IsZero() {
+			continue
+		}
+		l.Side = SideDebit
+		if signed[i].IsNegative() != reverse {
+			l.Side = SideCredit
+		}
+		l.Amount = signed[i].Abs().StringFixed(amountScale)
+		out = append(out, l)
+	}
+	return out
+}
+
+// NetBilled is what the invoice bills once its notes are applied: its
+// grand total less what credit notes took off plus what debit notes added.
+func NetBilled(inv db.Invoice) string {
+	total, ok := parseAmount(inv.GrandTotal)
+	if !ok {
+		return ""
+	}
+	credited, _ := parseAmount(inv.CreditedTotal)
+	debited, _ := parseAmount(inv.DebitedTotal)
+	return total.Sub(credited).Add(debited).StringFixed(amountScale)
+}
+
+// hasNotes reports whether a credit or debit note has been applied to inv.
+func hasNotes(inv db.Invoice) bool {
+	credited, _ := parseAmount(inv.CreditedTotal)
+	debited, _ := parseAmount(inv.DebitedTotal)
+	return !credited.IsZero() || !debited.IsZero()
 }
 
 // Get by ID (no event needed)
@@ -47,8 +331,26 @@ func (s *creditDebitNoteService) List(ctx context.Context, limit, offset int32)
 	return s.repo.List(ctx, limit, offset)
 }
 
-// Update a credit/debit note and publish event
+// Update a credit/debit note and publish event. An applied note keeps its
+// invoice, type and amount; it is corrected by another note.
 func (s *creditDebitNoteService) Update(ctx context.Context, note db.CreditDebitNote) (db.CreditDebitNote, error) {
+	typ, amount, err := checkNote(note)
+	if err != nil {
+		return db.CreditDebitNote{}, err
+	}
+	note.Type, note.Amount = typ, amount.StringFixed(amountScale)
+	current, err := s.stored(ctx, note.ID)
+	if err != nil {
+		return db.CreditDebitNote{}, err
+	}
+	if current.AppliedAt.Valid {
+		stored, _ := parseAmount(current.Amount)
+		if note.InvoiceID != current.InvoiceID || typ != current.Type || !amount.Equal(stored) {
+			return db.CreditDebitNote{}, fmt.Errorf("%w: note %s has been applied; only its reason can change", ErrConflict, note.ID)
+		}
+		note.Amount = current.Amount
+	}
+
 	updated, err := s.repo.Update(ctx, note)
 	if err != nil {
 		return db.CreditDebitNote{}, err
@@ -61,8 +363,15 @@ func (s *creditDebitNoteService) Update(ctx context.Context, note db.CreditDebit
 	return updated, nil
 }
 
-// Delete a credit/debit note and publish event
+// Delete a credit/debit note and publish event. Applied notes are kept.
 func (s *creditDebitNoteService) Delete(ctx context.Context, id uuid.UUID) error {
+	current, err := s.stored(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current.AppliedAt.Valid {
+		return fmt.Errorf("%w: note %s has been applied to its invoice and cannot be deleted", ErrConflict, id)
+	}
 	if err := s.repo.Delete(ctx, id); err != nil {
 		return err
 	}
@@ -73,3 +382,12 @@ func (s *creditDebitNoteService) Delete(ctx context.Context, id uuid.UUID) error
 
 	return nil
 }
+
+// stored loads the note a change is made to.
+func (s *creditDebitNoteService) stored(ctx context.Context, id uuid.UUID) (db.CreditDebitNote, error) {
+	note, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.CreditDebitNote{}, fmt.Errorf("%w: note %s", ErrNotFound, id)
+	}
+	return note, err
+}
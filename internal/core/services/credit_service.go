@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+// ErrCreditLimit rejects an invoice issue for a customer on credit hold or
+// past its credit threshold.
+var ErrCreditLimit = errors.New("credit limit exceeded")
+
+// What issuing past the credit threshold does.
+const (
+	CreditActionBlock    = "BLOCK"
+	CreditActionApproval = "APPROVAL"
+)
+
+// defaultCreditThreshold applies to organizations without a credit policy:
+// invoices are blocked once exposure passes the full credit limit.
+const defaultCreditThreshold = "100.00"
+
+// CreditService computes customer credit exposure and enforces credit limits
+// and holds when sales invoices are issued.
+type CreditService struct {
+	repo      ports.CreditRepository
+	parties   ports.PartyRepository
+	publisher ports.EventPublisher
+}
+
+var _ ports.CreditService = (*CreditService)(nil)
+
+func NewCreditService(repo ports.CreditRepository, parties ports.PartyRepository, publisher ports.EventPublisher) *CreditService {
+	return &CreditService{repo: repo, parties: parties, publisher: publisher}
+}
+
+// GetPolicy returns the organization's credit policy, or the default one
+// when it has not set any.
+func (s *CreditService) GetPolicy(ctx context.Context, orgID string) (db.CreditPolicy, error) {
+	p, err := s.repo.GetPolicy(ctx, orgID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.CreditPolicy{OrganizationID: orgID, ThresholdPercent: defaultCreditThreshold, Action: CreditActionBlock}, nil
+	}
+	return p, err
+}
+
+func (s *CreditService) SetPolicy(ctx context.Context, p db.CreditPolicy) (db.CreditPolicy, error) {
+	if p.OrganizationID == "" {
+		return db.CreditPolicy{}, fmt.Errorf("%w: organization_id is required", ErrInvalidInput)
+	}
+	if pct, ok := parseAmount(p.ThresholdPercent); !ok || !pct.IsPositive() {
+		return db.CreditPolicy{}, fmt.Errorf("%w: threshold percent %q must be a positive number", ErrInvalidInput, p.ThresholdPercent)
+	}
+	if p.Action == "" {
+		p.Action = CreditActionBlock
+	}
+	if p.Action != CreditActionBlock && p.Action != CreditActionApproval {
+		return db.CreditPolicy{}, fmt.Errorf("%w: credit action must be %s or %s", ErrInvalidInput, CreditActionBlock, CreditActionApproval)
+	}
+	return s.repo.SetPolicy(ctx, p)
+}
+
+func (s *CreditService) GetExposure(ctx context.Context, partyID uuid.UUID) (*ports.CreditExposure, error) {
+	party, err := s.party(ctx, partyID)
+	if err != nil {
+		return nil, err
+	}
+	e, _, err := s.exposure(ctx, party)
+	return e, err
+}
+
+// ApproveInvoice lets a DRAFT invoice be issued past its customer's credit
+// threshold under an APPROVAL policy. Changing the draft withdraws the
+// approval.
+func (s *CreditService) ApproveInvoice(ctx context.Context, invoiceID uuid.UUID, by string) error {
+	ok, err := s.repo.ApproveInvoice(ctx, invoiceID, by)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%w: invoice %s does not exist or is no longer a DRAFT", ErrConflict, invoiceID)
+	}
+	return nil
+}
+
+// CheckIssue rejects issuing a sales invoice to a customer on credit hold,
+// or whose exposure, this draft included, is past its credit threshold. A
+// breach is published as credit.limit.breached; under an APPROVAL policy an
+// invoice approved on credit is still let through.
+func (s *CreditService) CheckIssue(ctx context.Context, inv db.Invoice) error {
+	if inv.Type != InvoiceTypeSales || !inv.PartyID.Valid {
+		return nil
+	}
+	party, err := s.party(ctx, inv.PartyID.UUID)
+	if err != nil {
+		return err
+	}
+	if party.CreditHold {
+		if party.CreditHoldReason.Valid {
+			return fmt.Errorf("%w: %s is on credit hold: %s", ErrCreditLimit, party.Name, party.CreditHoldReason.String)
+		}
+		return fmt.Errorf("%w: %s is on credit hold", ErrCreditLimit, party.Name)
+	}
+	e, policy, err := s.exposure(ctx, party)
+	if err != nil || !e.Breached {
+		return err
+	}
+
+	approved := policy.Action == CreditActionApproval && inv.CreditApprovedAt.Valid
+	ev := &ports.CreditLimitBreachedEvent{
+		InvoiceID:      inv.ID.String(),
+		OrganizationID: inv.OrganizationID,
+		PartyID:        party.ID.String(),
+		PartyName:      party.Name,
+		CurrencyCode:   e.CurrencyCode,
+		CreditLimit:    e.CreditLimit,
+		Threshold:      e.Threshold,
+		Exposure:       e.Exposure,
+		Action:         policy.Action,
+		Approved:       approved,
+		ApprovedBy:     inv.CreditApprovedBy.String,
+		At:             time.Now(),
+	}
+	if err := s.publisher.PublishCreditLimitBreached(ctx, ev); err != nil {
+		log.Printf("credit: publish credit.limit.breached for invoice %s: %v", inv.ID, err)
+	}
+
+	switch {
+	case approved:
+		return nil
+	case policy.Action == CreditActionApproval:
+		return fmt.Errorf("%w: %s's exposure of %s is past its threshold of %s; the invoice needs credit approval", ErrCreditLimit, party.Name, e.Exposure, e.Threshold)
+	default:
+		return fmt.Errorf("%w: %s's exposure of %s is past its threshold of %s", ErrCreditLimit, party.Name, e.Exposure, e.Threshold)
+	}
+}
+
+func (s *CreditService) party(ctx context.Context, id uuid.UUID) (*ports.Party, error) {
+	p, err := s.parties.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: party %s", ErrNotFound, id)
+	}
+	return p, err
+}
+
+// exposure sums the party's open dues and draft sales invoices less its
+// unapplied credit notes, and compares that with its credit limit scaled by
+// the organization's threshold. Amounts are summed as recorded, in the
+// currency the customer is invoiced in.
+func (s *CreditService) exposure(ctx context.Context, party *ports.Party) (*ports.CreditExposure, db.CreditPolicy, error) {
+	policy, err := s.GetPolicy(ctx, party.OrganizationID)
+	if err != nil {
+		return nil, policy, err
+	}
+	row, err := s.repo.PartyExposure(ctx, party.ID)
+	if err != nil {
+		return nil, policy, err
+	}
+	var sums [3]decimal.Decimal
+	for i, v := range []string{row.OpenDues, row.DraftInvoices, row.UnappliedCredits} {
+		d, ok := parseAmount(v)
+		if !ok {
+			return nil, policy, fmt.Errorf("party %s exposure: %q is not an amount", party.ID, v)
+		}
+		sums[i] = d
+	}
+	total := sums[0].Add(sums[1]).Sub(sums[2])
+
+	e := &ports.CreditExposure{
+		PartyID:          party.ID,
+		OrganizationID:   party.OrganizationID,
+		CurrencyCode:     party.DefaultCurrency.String,
+		CreditHold:       party.CreditHold,
+		OpenDues:         sums[0].StringFixed(2),
+		DraftInvoices:    sums[1].StringFixed(2),
+		UnappliedCredits: sums[2].StringFixed(2),
+		Exposure:         total.StringFixed(2),
+	}
+	if !party.CreditLimit.Valid {
+		return e, policy, nil
+	}
+	limit, ok := parseAmount(party.CreditLimit.String)
+	if !ok {
+		return nil, policy, fmt.Errorf("party %s: credit limit %q is not an amount", party.ID, party.CreditLimit.String)
+	}
+	pct, ok := parseAmount(policy.ThresholdPercent)
+	if !ok {
+		return nil, policy, fmt.Errorf("credit policy of %s: threshold %q is not a number", policy.OrganizationID, policy.ThresholdPercent)
+	}
+	threshold := limit.Mul(pct).Div(decimal.NewFromInt(100)).Round(2)
+	e.CreditLimit = limit.StringFixed(2)
+	e.Threshold = threshold.StringFixed(2)
+	e.Breached = total.GreaterThan(threshold)
+	return e, policy, nil
+}
@@ -0,0 +1,310 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+// DunningUser is recorded as the user behind what the dunning job changes.
+const DunningUser = "dunning"
+
+// maxDunningDays bounds how far from the due date a stage can be.
+const maxDunningDays = 3650
+
+// DunningRunError lists what a Process run could not do. Everything else
+// the run did is kept.
+type DunningRunError struct {
+	Failures []error
+}
+
+func (e *DunningRunError) Error() string {
+	return errors.Join(e.Failures...).Error()
+}
+
+func (e *DunningRunError) Unwrap() []error { return e.Failures }
+
+// DunningService flags overdue invoices and payment dues and sends payment
+// reminders on each organization's schedule.
+type DunningService struct {
+	repo      ports.DunningRepository
+	invoices  *InvoiceService
+	audit     ports.AuditService
+	publisher ports.EventPublisher
+}
+
+var _ ports.DunningService = (*DunningService)(nil)
+
+func NewDunningService(repo ports.DunningRepository, invoices *InvoiceService, audit ports.AuditService, publisher ports.EventPublisher) *DunningService {
+	return &DunningService{repo: repo, invoices: invoices, audit: audit, publisher: publisher}
+}
+
+// SetSchedule replaces the organization's reminder stages. Stages are
+// ordered by their days from the due date, which must differ, and a later
+// stage cannot escalate less than an earlier one. No stages turns reminders
+// off.
+func (s *DunningService) SetSchedule(ctx context.Context, orgID string, stages []db.DunningStage, by string) ([]db.DunningStage, error) {
+	if orgID == "" {
+		return nil, fmt.Errorf("%w: organization_id is required", ErrInvalidInput)
+	}
+	stages = slices.Clone(stages)
+	slices.SortFunc(stages, func(a, b db.DunningStage) int { return int(a.DaysFromDue - b.DaysFromDue) })
+	for i := range stages {
+		st := &stages[i]
+		st.Name = strings.TrimSpace(st.Name)
+		switch {
+		case st.DaysFromDue < -maxDunningDays || st.DaysFromDue > maxDunningDays:
+			return nil, fmt.Errorf("%w: stage %d days from due is more than %d days away", ErrInvalidInput, st.DaysFromDue, maxDunningDays)
+		case st.EscalationLevel < 0:
+			return nil, fmt.Errorf("%w: stage %d days from due has a negative escalation level", ErrInvalidInput, st.DaysFromDue)
+		case i > 0 && st.DaysFromDue == stages[i-1].DaysFromDue:
+			return nil, fmt.Errorf("%w: two stages at %d days from due", ErrInvalidInput, st.DaysFromDue)
+		case i > 0 && st.EscalationLevel < stages[i-1].EscalationLevel:
+			return nil, fmt.Errorf("%w: stage %d days from due escalates less than the stage before it", ErrInvalidInput, st.DaysFromDue)
+		}
+	}
+	saved, err := s.repo.ReplaceStages(ctx, orgID, stages, by)
+	if err != nil {
+		return nil, err
+	}
+	s.logAudit(ctx, by, "dunning.schedule.set", "DunningSchedule", orgID, fmt.Sprintf("%d stages", len(saved)))
+	return saved, nil
+}
+
+func (s *DunningService) GetSchedule(ctx context.Context, orgID string) ([]db.DunningStage, error) {
+	return s.repo.ListStages(ctx, orgID)
+}
+
+// HoldInvoice stops reminders for the invoice until it is released.
+func (s *DunningService) HoldInvoice(ctx context.Context, invoiceID uuid.UUID, reason, by string) (db.DunningHold, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return db.DunningHold{}, fmt.Errorf("%w: a reason is required to hold dunning", ErrInvalidInput)
+	}
+	inv, err := s.invoices.get(ctx, invoiceID)
+	if err != nil {
+		return db.DunningHold{}, err
+	}
+	hold, err := s.repo.PlaceInvoiceHold(ctx, db.DunningHold{
+		OrganizationID: inv.OrganizationID,
+		InvoiceID:      uuid.NullUUID{UUID: invoiceID, Valid: true},
+		Reason:         reason,
+		CreatedBy:      sql.NullString{String: by, Valid: by != ""},
+	})
+	if err != nil {
+		return db.DunningHold{}, err
+	}
+	s.logAudit(ctx, by, "dunning.hold.placed", "Invoice", invoiceID.String(), reason)
+	return hold, nil
+}
+
+// HoldParty stops reminders for all of the party's invoices until it is
+// released.
+func (s *DunningService) HoldParty(ctx context.Context, orgID string, partyID uuid.UUID, reason, by string) (db.DunningHold, error) {
+	reason = strings.TrimSpace(reason)
+	switch {
+	case orgID == "":
+		return db.DunningHold{}, fmt.Errorf("%w: organization_id is required", ErrInvalidInput)
+	case partyID == uuid.Nil:
+		return db.DunningHold{}, fmt.Errorf("%w: party_id is required", ErrInvalidInput)
+	case reason == "":
+		return db.DunningHold{}, fmt.Errorf("%w: a reason is required to hold dunning", ErrInvalidInput)
+	}
+	if err := s.invoices.checkParty(ctx, db.Invoice{OrganizationID: orgID, PartyID: uuid.NullUUID{UUID: partyID, Valid: true}}); err != nil {
+		return db.DunningHold{}, err
+	}
+	hold, err := s.repo.PlacePartyHold(ctx, db.DunningHold{
+		OrganizationID: orgID,
+		PartyID:        uuid.NullUUID{UUID: partyID, Valid: true},
+		Reason:         reason,
+		CreatedBy:      sql.NullString{String: by, Valid: by != ""},
+	})
+	if err != nil {
+		return db.DunningHold{}, err
+	}
+	s.logAudit(ctx, by, "dunning.hold.placed", "Party", partyID.String(), reason)
+	return hold, nil
+}
+
+func (s *DunningService) ReleaseInvoice(ctx context.Context, invoiceID uuid.UUID, by string) error {
+	err := s.repo.ReleaseInvoiceHold(ctx, invoiceID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: invoice %s is not on dunning hold", ErrNotFound, invoiceID)
+	}
+	if err != nil {
+		return err
+	}
+	s.logAudit(ctx, by, "dunning.hold.released", "Invoice", invoiceID.String(), "")
+	return nil
+}
+
+func (s *DunningService) ReleaseParty(ctx context.Context, orgID string, partyID uuid.UUID, by string) error {
+	err := s.repo.ReleasePartyHold(ctx, orgID, partyID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: party %s of %s is not on dunning hold", ErrNotFound, partyID, orgID)
+	}
+	if err != nil {
+		return err
+	}
+	s.logAudit(ctx, by, "dunning.hold.released", "Party", partyID.String(), "")
+	return nil
+}
+
+func (s *DunningService) ListHolds(ctx context.Context, orgID string) ([]db.DunningHold, error) {
+	return s.repo.ListHolds(ctx, orgID)
+}
+
+// Process flags the payment dues and the ISSUED and PARTIALLY_PAID invoices
+// due before asOf as OVERDUE, then sends each open invoice the latest
+// reminder stage it has reached, unless the invoice or its party is on
+// hold. Each step publishes its event and records an audit event. Failures
+// do not stop the run; they are returned together as a *DunningRunError.
+//
+// A reminder is recorded before its event is published, so a reminder whose
+// publish fails is reported but not retried.
+func (s *DunningService) Process(ctx context.Context, asOf time.Time) (*ports.DunningRun, error) {
+	run := &ports.DunningRun{AsOf: asOf}
+	var errs []error
+
+	dues, err := s.repo.MarkPaymentDuesOverdue(ctx, asOf, DunningUser)
+	if err != nil {
+		return run, err
+	}
+	run.OverdueDues = dues
+	for _, d := range dues {
+		details := strings.TrimSpace(d.AmountDue+" "+d.CurrencyCode) + " due " + d.DueDate.Format(time.DateOnly)
+		if err := s.recordAudit(ctx, DunningUser, "payment.due.overdue", "PaymentDue", d.ID.String(), details); err != nil {
+			errs = append(errs, fmt.Errorf("payment due %s: %w", d.ID, err))
+		}
+	}
+
+	ids, err := s.repo.PastDueInvoices(ctx, asOf)
+	if err != nil {
+		return run, err
+	}
+	for _, id := range ids {
+		inv, err := s.invoices.MarkOverdue(ctx, id, asOf, DunningUser)
+		if errors.Is(err, ErrConflict) {
+			continue // paid or voided since it was listed
+		}
+		if err == nil {
+			run.OverdueInvoices = append(run.OverdueInvoices, inv)
+			details := fmt.Sprintf("%s due %s", inv.InvoiceNumber, inv.DueDate.Time.Format(time.DateOnly))
+			err = s.recordAudit(ctx, DunningUser, "invoice.overdue", "Invoice", id.String(), details)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invoice %s: %w", id, err))
+		}
+	}
+
+	candidates, err := s.repo.ReminderCandidates(ctx, asOf)
+	if err != nil {
+		return run, err
+	}
+	for _, c := range candidates {
+		ev, err := s.remind(ctx, c)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invoice %s reminder at %d days from due: %w", c.InvoiceID, c.DaysFromDue, err))
+		}
+		if ev != nil {
+			run.Reminders = append(run.Reminders, *ev)
+		}
+	}
+
+	if len(errs) > 0 {
+		return run, &DunningRunError{Failures: errs}
+	}
+	return run, nil
+}
+
+// remind records and publishes one reminder. It returns nil, nil when the
+// reminder went out in a concurrent run.
+func (s *DunningService) remind(ctx context.Context, c db.ListDunningReminderCandidatesRow) (*ports.DunningReminderEvent, error) {
+	sent, err := s.repo.RecordReminder(ctx, db.DunningReminder{
+		InvoiceID:       c.InvoiceID,
+		DaysFromDue:     c.DaysFromDue,
+		EscalationLevel: c.EscalationLevel,
+	})
+	if err != nil || sent == nil {
+		return nil, err
+	}
+	var partyID string
+	if c.PartyID.Valid {
+		partyID = c.PartyID.UUID.String()
+	}
+	ev := &ports.DunningReminderEvent{
+		InvoiceID:       c.InvoiceID.String(),
+		InvoiceNumber:   c.InvoiceNumber,
+		OrganizationID:  c.OrganizationID,
+		PartyID:         partyID,
+		Status:          c.Status,
+		DueDate:         c.DueDate.Time,
+		Amount:          c.GrandTotal,
+		CurrencyCode:    c.CurrencyCode,
+		Stage:           c.StageName,
+		DaysFromDue:     c.DaysFromDue,
+		EscalationLevel: c.EscalationLevel,
+		SentAt:          sent.SentAt,
+	}
+	details := fmt.Sprintf("%d days from due, escalation level %d", c.DaysFromDue, c.EscalationLevel)
+	if c.StageName != "" {
+		details = c.StageName + ": " + details
+	}
+	return ev, errors.Join(
+		s.publisher.PublishDunningReminder(ctx, ev),
+		s.recordAudit(ctx, DunningUser, "dunning.reminder", "Invoice", c.InvoiceID.String(), details),
+	)
+}
+
+// Run processes dunning immediately and then on every tick until ctx is
+// cancelled.
+func (s *DunningService) Run(ctx context.Context, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		run, err := s.Process(ctx, time.Now())
+		if err != nil {
+			log.Printf("dunning: %v", err)
+		}
+		if n := len(run.OverdueDues) + len(run.OverdueInvoices) + len(run.Reminders); n > 0 {
+			log.Printf("dunning: %d payment dues and %d invoices overdue, %d reminders sent",
+				len(run.OverdueDues), len(run.OverdueInvoices), len(run.Reminders))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *DunningService) recordAudit(ctx context.Context, by, action, resourceType, resourceID, details string) error {
+	_, err := s.audit.Record(ctx, &db.AuditEvent{
+		UserID:       by,
+		Action:       action,
+		Timestamp:    time.Now(),
+		Details:      sql.NullString{String: details, Valid: details != ""},
+		ResourceType: sql.NullString{String: resourceType, Valid: true},
+		ResourceID:   sql.NullString{String: resourceID, Valid: true},
+	})
+	return err
+}
+
+// logAudit records the audit event of a change already made, logging
+// rather than returning a failure.
+func (s *DunningService) logAudit(ctx context.Context, by, action, resourceType, resourceID, details string) {
+	if err := s.recordAudit(ctx, by, action, resourceType, resourceID, details); err != nil {
+		log.Printf("dunning: audit %s of %s %s: %v", action, resourceType, resourceID, err)
+	}
+}
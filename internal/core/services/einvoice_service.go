@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+// EInvoiceService supplies the business data of structured e-invoices and
+// stores inbound ones; the UBL syntax is the ubl adapter's.
+type EInvoiceService struct {
+	invoices *InvoiceService
+	parties  ports.PartyService
+}
+
+var _ ports.EInvoiceService = (*EInvoiceService)(nil)
+
+func NewEInvoiceService(invoices *InvoiceService, parties ports.PartyService) *EInvoiceService {
+	return &EInvoiceService{invoices: invoices, parties: parties}
+}
+
+// ExportDocument gathers an issued sales invoice with the organization as
+// seller and the invoice's party as buyer. Drafts and void invoices are not
+// exported.
+func (s *EInvoiceService) ExportDocument(ctx context.Context, id uuid.UUID) (ports.EInvoice, error) {
+	doc, err := s.invoices.InvoiceDocument(ctx, id)
+	if err != nil {
+		return ports.EInvoice{}, err
+	}
+	inv := doc.Invoice
+	if inv.Type != InvoiceTypeSales {
+		return ports.EInvoice{}, fmt.Errorf("%w: only %s invoices are exported as e-invoices, not %s", ErrInvalidInput, InvoiceTypeSales, inv.Type)
+	}
+	if inv.Status == InvoiceDraft || inv.Status == InvoiceVoid {
+		return ports.EInvoice{}, fmt.Errorf("%w: invoice %s is %s; only issued invoices are exported", ErrConflict, id, inv.Status)
+	}
+
+	e := ports.EInvoice{
+		Invoice:      inv,
+		Seller:       sellerParty(doc.Template, inv),
+		PaymentTerms: doc.Template.Terms.String,
+	}
+	if inv.PartyID.Valid {
+		buyer, err := s.parties.Get(ctx, inv.PartyID.UUID)
+		if err != nil {
+			return ports.EInvoice{}, err
+		}
+		e.Buyer = *buyer
+	}
+	return e, nil
+}
+
+// Import records e as a DRAFT purchase invoice of orgID. Its figures are
+// computed from its lines and checked against those the e-invoice states.
+func (s *EInvoiceService) Import(ctx context.Context, orgID string, e ports.EInvoice, by string) (db.Invoice, error) {
+	if orgID == "" {
+		return db.Invoice{}, fmt.Errorf("%w: organization_id is required", ErrInvalidInput)
+	}
+	vendor, err := s.vendor(ctx, orgID, e.Seller, by)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+
+	inv := e.Invoice
+	inv.OrganizationID = orgID
+	inv.Type = InvoiceTypePurchase
+	inv.Status = ""
+	inv.PartyID = uuid.NullUUID{UUID: vendor.ID, Valid: true}
+	inv.CreatedBy = optionalString(by)
+	inv.UpdatedBy = inv.CreatedBy
+	return s.invoices.CreateInvoice(ctx, inv)
+}
+
+// vendor finds the organization's vendor registered under one of the
+// seller's tax numbers, or else named as the seller, and creates one from
+// the seller when there is none.
+func (s *EInvoiceService) vendor(ctx context.Context, orgID string, seller ports.Party, by string) (*ports.Party, error) {
+	match := func(query string, ok func(p *ports.Party) bool) (*ports.Party, error) {
+		found, err := s.parties.List(ctx, ports.PartyFilter{OrganizationID: orgID, Kind: PartyVendor, Query: query}, 50, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range found {
+			if ok(p) {
+				return p, nil
+			}
+		}
+		return nil, nil
+	}
+
+	for _, reg := range seller.TaxRegistrations {
+		number := strings.ToUpper(strings.TrimSpace(reg.Number))
+		if number == "" {
+			continue
+		}
+		p, err := match(number, func(p *ports.Party) bool {
+			for _, r := range p.TaxRegistrations {
+				if r.Number == number {
+					return true
+				}
+			}
+			return false
+		})
+		if p != nil || err != nil {
+			return p, err
+		}
+	}
+	if name := strings.TrimSpace(seller.Name); name != "" {
+		p, err := match(name, func(p *ports.Party) bool { return strings.EqualFold(p.Name, name) })
+		if p != nil || err != nil {
+			return p, err
+		}
+	}
+
+	seller.OrganizationID = orgID
+	seller.Kind = PartyVendor
+	seller.Active = true
+	seller.CreatedBy = optionalString(by)
+	seller.UpdatedBy = seller.CreatedBy
+	created, err := s.parties.Create(ctx, &seller)
+	if err != nil {
+		return nil, fmt.Errorf("vendor %q: %w", seller.Name, err)
+	}
+	return created, nil
+}
+
+// sellerParty describes the organization as its invoice template does, with
+// the GSTIN the invoice was issued under. The template's first address line
+// is the street; the others follow it.
+func sellerParty(t db.InvoiceTemplate, inv db.Invoice) ports.Party {
+	p := ports.Party{Party: db.Party{
+		OrganizationID: t.OrganizationID,
+		Name:           t.CompanyName,
+		PeppolEndpoint: t.PeppolEndpoint,
+	}}
+	var lines []string
+	for _, l := range strings.Split(t.Address.String, "\n") {
+		if l = strings.TrimSpace(l); l != "" {
+			lines = append(lines, l)
+		}
+	}
+	addr := db.PartyAddress{Kind: AddressBilling, CountryCode: t.CountryCode, IsDefault: true}
+	if len(lines) > 0 {
+		addr.Line1 = lines[0]
+	}
+	if len(lines) > 1 {
+		addr.Line2 = sql.NullString{String: strings.Join(lines[1:], ", "), Valid: true}
+	}
+	p.Addresses = []db.PartyAddress{addr}
+	if inv.Gst != nil && inv.Gst.Gstin != "" {
+		p.TaxRegistrations = []db.PartyTaxRegistration{{TaxType: TaxRegistrationGSTIN, Number: inv.Gst.Gstin}}
+	}
+	return p
+}
@@ -5,6 +5,7 @@ import (
     "context"
     "time"
     "fmt"
+    "strings"
 
     "github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
@@ -24,6 +25,9 @@ func NewExchangeRateService(repo ports.ExchangeRateRepository, publisher ports.E
 }
 
 func (s *ExchangeRateSvc) Create(ctx context.Context, rate db.ExchangeRate) (db.ExchangeRate, error) {
+	if err := defaultRateType(&rate); err != nil {
+		return db.ExchangeRate{}, err
+	}
 	r, err := s.repo.Create(ctx, rate)
 	if err != nil {
 		return r, err
@@ -43,6 +47,9 @@ func (s *ExchangeRateSvc) Get(ctx context.Context, id uuid.UUID) (db.ExchangeRat
 }
 
 func (s *ExchangeRateSvc) Update(ctx context.Context, rate db.ExchangeRate) (db.ExchangeRate, error) {
+	if err := defaultRateType(&rate); err != nil {
+		return db.ExchangeRate{}, err
+	}
 	r, err := s.repo.Update(ctx, rate)
 	if err != nil {
 		return r, err
@@ -76,4 +83,20 @@ func (s *ExchangeRateSvc) List(ctx context.Context, base, quote *string, limit,
 
 func (s *ExchangeRateSvc) GetLatest(ctx context.Context, base, quote string, asOf time.Time) (db.ExchangeRate, error) {
     return s.repo.GetLatest(ctx, base, quote, asOf)
-}
\ No newline at end of file
+}
+
+func (s *ExchangeRateSvc) GetLatestOfType(ctx context.Context, base, quote, rateType string, asOf time.Time) (db.ExchangeRate, error) {
+    return s.repo.GetLatestOfType(ctx, base, quote, rateType, asOf)
+}
+
+// defaultRateType treats an untyped rate as a spot rate.
+func defaultRateType(rate *db.ExchangeRate) error {
+	rate.RateType = strings.ToUpper(rate.RateType)
+	if rate.RateType == "" {
+		rate.RateType = RateTypeSpot
+	}
+	if !IsRateType(rate.RateType) {
+		return fmt.Errorf("%w: unknown rate type %q", ErrInvalidInput, rate.RateType)
+	}
+	return nil
+}
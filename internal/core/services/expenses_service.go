@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
@@ -11,14 +12,18 @@ import (
 
 type ExpenseService struct {
 	repo      ports.ExpenseRepository
+	periods   ports.PeriodGuard
 	publisher ports.EventPublisher
 }
 
-func NewExpenseService(r ports.ExpenseRepository, publisher ports.EventPublisher) ports.ExpenseService {
-	return &ExpenseService{repo: r, publisher: publisher}
+func NewExpenseService(r ports.ExpenseRepository, periods ports.PeriodGuard, publisher ports.EventPublisher) ports.ExpenseService {
+	return &ExpenseService{repo: r, periods: periods, publisher: publisher}
 }
 
 func (s *ExpenseService) CreateExpense(ctx context.Context, exp db.Expense) (db.Expense, error) {
+	if err := s.ensureOpen(ctx, exp); err != nil {
+		return db.Expense{}, err
+	}
 	e, err := s.repo.Create(ctx, exp)
 	if err != nil {
 		return e, err
@@ -39,6 +44,9 @@ func (s *ExpenseService) ListExpenses(ctx context.Context, limit, offset int32)
 }
 
 func (s *ExpenseService) UpdateExpense(ctx context.Context, exp db.Expense) (db.Expense, error) {
+	if err := s.ensureStoredOpen(ctx, exp.ID, exp.ExpenseDate); err != nil {
+		return db.Expense{}, err
+	}
 	e, err := s.repo.Update(ctx, exp)
 	if err != nil {
 		return e, err
@@ -51,6 +59,9 @@ func (s *ExpenseService) UpdateExpense(ctx context.Context, exp db.Expense) (db.
 }
 
 func (s *ExpenseService) DeleteExpense(ctx context.Context, id uuid.UUID) error {
+	if err := s.ensureStoredOpen(ctx, id); err != nil {
+		return err
+	}
 	if err := s.repo.Delete(ctx, id); err != nil {
 		return err
 	}
@@ -61,6 +72,29 @@ func (s *ExpenseService) DeleteExpense(ctx context.Context, id uuid.UUID) error
 	return nil
 }
 
+// ensureOpen rejects expenses dated into a closed accounting period.
+func (s *ExpenseService) ensureOpen(ctx context.Context, exp db.Expense) error {
+	return ensureOpen(ctx, s.periods, exp.OrganizationID.String, exp.ExpenseDate, "")
+}
+
+// ensureStoredOpen rejects changes to an expense dated into a closed
+// accounting period, checking both the stored date and any new date.
+func (s *ExpenseService) ensureStoredOpen(ctx context.Context, id uuid.UUID, moveTo ...time.Time) error {
+	if s.periods == nil {
+		return nil
+	}
+	current, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, d := range append([]time.Time{current.ExpenseDate}, moveTo...) {
+		if err := ensureOpen(ctx, s.periods, current.OrganizationID.String, d, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type CostCenterService struct {
 	repo      ports.CostCenterRepository
 	publisher ports.EventPublisher
@@ -0,0 +1,317 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ErrPeriodClosed is returned when a write is dated into a period that does
+// not accept it.
+var ErrPeriodClosed = ports.ErrPeriodClosed
+
+// Period statuses.
+const (
+	PeriodOpen       = "OPEN"
+	PeriodSoftClosed = "SOFT_CLOSED"
+	PeriodClosed     = "CLOSED"
+)
+
+// Journal source types that may still be dated into a SOFT_CLOSED period.
+// Period-end FX revaluations (SourceFXRevaluation) are accepted too, and the
+// year-end close also into the CLOSED periods of a year not yet closed.
+const (
+	SourceAdjustment   = "ADJUSTMENT"
+	SourceYearEndClose = "YEAR_END_CLOSE"
+)
+
+const periodsPerYear = 12
+
+// PeriodGuard checks document dates against an organization's fiscal periods.
+// Organizations without a calendar, and dates outside any generated period,
+// are not restricted.
+type PeriodGuard struct {
+	repo ports.FiscalPeriodRepository
+}
+
+var _ ports.PeriodGuard = (*PeriodGuard)(nil)
+
+func NewPeriodGuard(repo ports.FiscalPeriodRepository) *PeriodGuard {
+	return &PeriodGuard{repo: repo}
+}
+
+func (g *PeriodGuard) EnsureOpen(ctx context.Context, orgID string, date time.Time, sourceType string) error {
+	if orgID == "" {
+		return nil
+	}
+	p, err := g.repo.GetPeriodForDate(ctx, orgID, date)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("look up fiscal period: %w", err)
+	}
+
+	switch p.Status {
+	case PeriodClosed:
+		if sourceType == SourceYearEndClose && !p.YearClosedAt.Valid {
+			return nil
+		}
+		return fmt.Errorf("%w: FY%d period %d is closed", ErrPeriodClosed, p.FiscalYear, p.PeriodNumber)
+	case PeriodSoftClosed:
+		if sourceType == SourceAdjustment || sourceType == SourceYearEndClose || sourceType == SourceFXRevaluation {
+			return nil
+		}
+		return fmt.Errorf("%w: FY%d period %d is soft-closed, only adjustments are accepted",
+			ErrPeriodClosed, p.FiscalYear, p.PeriodNumber)
+	}
+	return nil
+}
+
+// ensureOpen lets services run without a guard configured.
+func ensureOpen(ctx context.Context, g ports.PeriodGuard, orgID string, date time.Time, sourceType string) error {
+	if g == nil {
+		return nil
+	}
+	return g.EnsureOpen(ctx, orgID, date, sourceType)
+}
+
+// FiscalPeriodService manages fiscal calendars, period status and the
+// year-end close.
+type FiscalPeriodService struct {
+	repo     ports.FiscalPeriodRepository
+	journals *JournalService
+}
+
+func NewFiscalPeriodService(repo ports.FiscalPeriodRepository, journals *JournalService) *FiscalPeriodService {
+	return &FiscalPeriodService{repo: repo, journals: journals}
+}
+
+func (s *FiscalPeriodService) SetCalendar(ctx context.Context, c db.FiscalCalendar) (db.FiscalCalendar, error) {
+	if c.OrganizationID == "" {
+		return db.FiscalCalendar{}, fmt.Errorf("%w: organization_id is required", ErrInvalidInput)
+	}
+	if c.YearStartMonth < 1 || c.YearStartMonth > 12 {
+		return db.FiscalCalendar{}, fmt.Errorf("%w: year start month must be between 1 and 12", ErrInvalidInput)
+	}
+	if c.FunctionalCurrency.Valid {
+		c.FunctionalCurrency.String = strings.ToUpper(strings.TrimSpace(c.FunctionalCurrency.String))
+		if len(c.FunctionalCurrency.String) != 3 {
+			return db.FiscalCalendar{}, fmt.Errorf("%w: functional currency must be a 3-letter ISO code", ErrInvalidInput)
+		}
+	}
+	return s.repo.UpsertCalendar(ctx, c)
+}
+
+// CreateFiscalYear generates the twelve monthly periods of fiscalYear. It is
+// idempotent: periods that already exist are returned as they are.
+func (s *FiscalPeriodService) CreateFiscalYear(ctx context.Context, orgID string, fiscalYear int32) ([]db.FiscalPeriod, error) {
+	cal, err := s.calendar(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Date(int(fiscalYear), time.Month(cal.YearStartMonth), 1, 0, 0, 0, 0, time.UTC)
+	periods := make([]db.FiscalPeriod, periodsPerYear)
+	for i := range periods {
+		from := start.AddDate(0, i, 0)
+		periods[i] = db.FiscalPeriod{
+			OrganizationID: orgID,
+			FiscalYear:     fiscalYear,
+			PeriodNumber:   int16(i + 1),
+			StartDate:      from,
+			EndDate:        from.AddDate(0, 1, -1),
+		}
+	}
+	return s.repo.CreatePeriods(ctx, periods)
+}
+
+func (s *FiscalPeriodService) ListPeriods(ctx context.Context, orgID string, fiscalYear int32) ([]db.FiscalPeriod, error) {
+	return s.repo.ListPeriods(ctx, orgID, fiscalYear)
+}
+
+// OpenPeriod reopens a period. Periods of a year that has been closed stay
+// closed: what was posted to them would never reach retained earnings.
+func (s *FiscalPeriodService) OpenPeriod(ctx context.Context, id uuid.UUID, by string) (db.FiscalPeriod, error) {
+	p, err := s.period(ctx, id)
+	if err != nil {
+		return db.FiscalPeriod{}, err
+	}
+	return s.setStatus(ctx, p, PeriodOpen, by)
+}
+
+func (s *FiscalPeriodService) ClosePeriod(ctx context.Context, id uuid.UUID, soft bool, by string) (db.FiscalPeriod, error) {
+	p, err := s.period(ctx, id)
+	if err != nil {
+		return db.FiscalPeriod{}, err
+	}
+	status := PeriodClosed
+	if soft {
+		status = PeriodSoftClosed
+	}
+	if p.Status == status {
+		return p, nil
+	}
+	return s.setStatus(ctx, p, status, by)
+}
+
+func (s *FiscalPeriodService) setStatus(ctx context.Context, p db.FiscalPeriod, status, by string) (db.FiscalPeriod, error) {
+	yearClosed := fmt.Errorf("%w: FY%d has been closed, period %d cannot be changed", ErrConflict, p.FiscalYear, p.PeriodNumber)
+	if p.YearClosedAt.Valid {
+		return db.FiscalPeriod{}, yearClosed
+	}
+	updated, err := s.repo.UpdatePeriodStatus(ctx, p.ID, status, by)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The year was closed since the period was read.
+		return db.FiscalPeriod{}, yearClosed
+	}
+	return updated, err
+}
+
+// CloseFiscalYear posts the closing entry that zeroes every revenue and
+// expense account for the year into the calendar's retained earnings account
+// and hard-closes all twelve periods, in one transaction. The closing entry is
+// dated on the last day of the year; it is nil when there was nothing to
+// close.
+func (s *FiscalPeriodService) CloseFiscalYear(ctx context.Context, orgID string, fiscalYear int32, by string) (*db.JournalEntry, []db.FiscalPeriod, error) {
+	cal, err := s.calendar(ctx, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !cal.RetainedEarningsAccountID.Valid {
+		return nil, nil, fmt.Errorf("%w: no retained earnings account configured for %s", ErrInvalidInput, orgID)
+	}
+
+	// Checked here so a year that cannot close fails before anything is
+	// locked, and again once the periods are.
+	periods, err := s.repo.ListPeriods(ctx, orgID, fiscalYear)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkClosable(periods, fiscalYear); err != nil {
+		return nil, nil, err
+	}
+
+	closing, closed, err := s.repo.CloseYear(ctx, orgID, fiscalYear, by, func(locked []db.FiscalPeriod, balances []db.SumIncomeStatementBalancesRow) (*db.JournalEntry, error) {
+		if err := checkClosable(locked, fiscalYear); err != nil {
+			return nil, err
+		}
+		return s.closingEntry(ctx, cal, locked[len(locked)-1], balances, by)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if closing != nil {
+		s.journals.publishCreated(closing)
+	}
+	return closing, closed, nil
+}
+
+// checkClosable requires all twelve periods of a year not yet closed. Periods
+// already hard-closed month by month are closed again with the year.
+func checkClosable(periods []db.FiscalPeriod, fiscalYear int32) error {
+	if len(periods) != periodsPerYear {
+		return fmt.Errorf("%w: FY%d has %d periods, expected %d", ErrConflict, fiscalYear, len(periods), periodsPerYear)
+	}
+	for _, p := range periods {
+		if p.YearClosedAt.Valid {
+			return fmt.Errorf("%w: FY%d has already been closed", ErrConflict, fiscalYear)
+		}
+	}
+	return nil
+}
+
+// closingEntry builds the POSTED closing entry for the year's balances, dated
+// on the last day of its last period and checked as any new journal is. It is
+// nil when there is nothing to close.
+func (s *FiscalPeriodService) closingEntry(ctx context.Context, cal db.FiscalCalendar, last db.FiscalPeriod, balances []db.SumIncomeStatementBalancesRow, by string) (*db.JournalEntry, error) {
+	lines, err := closingLines(balances, cal.RetainedEarningsAccountID.UUID)
+	if err != nil || len(lines) == 0 {
+		return nil, err
+	}
+
+	fiscalYear := last.FiscalYear
+	j := &db.JournalEntry{
+		JournalDate:    last.EndDate,
+		Reference:      sql.NullString{String: fmt.Sprintf("FY%d-CLOSE", fiscalYear), Valid: true},
+		Memo:           sql.NullString{String: fmt.Sprintf("Year-end close FY%d", fiscalYear), Valid: true},
+		SourceType:     sql.NullString{String: SourceYearEndClose, Valid: true},
+		SourceID:       sql.NullString{String: fmt.Sprintf("FY%d", fiscalYear), Valid: true},
+		CreatedBy:      sql.NullString{String: by, Valid: by != ""},
+		UpdatedBy:      sql.NullString{String: by, Valid: by != ""},
+		OrganizationID: sql.NullString{String: cal.OrganizationID, Valid: true},
+		Status:         JournalPosted,
+		Lines:          lines,
+	}
+	if err := s.journals.prepareCreate(ctx, j); err != nil {
+		return nil, fmt.Errorf("post closing entry: %w", err)
+	}
+	return j, nil
+}
+
+// closingLines reverses each account's net movement and books the difference
+// to retained earnings, so the entry always balances.
+func closingLines(balances []db.SumIncomeStatementBalancesRow, retainedEarnings uuid.UUID) ([]db.JournalLine, error) {
+	var lines []db.JournalLine
+	total := decimal.Zero
+	for _, b := range balances {
+		net, err := decimal.NewFromString(b.NetAmount)
+		if err != nil {
+			return nil, fmt.Errorf("balance for account %s: %w", b.AccountID, err)
+		}
+		if net.IsZero() {
+			continue
+		}
+		side := SideCredit
+		if net.IsNegative() {
+			side = SideDebit
+		}
+		lines = append(lines, db.JournalLine{
+			AccountID:   b.AccountID,
+			Side:        side,
+			Amount:      net.Abs().StringFixed(amountScale),
+			Description: sql.NullString{String: "Year-end close", Valid: true},
+		})
+		total = total.Add(net)
+	}
+	if total.IsZero() {
+		return lines, nil
+	}
+
+	// Net profit (credits exceeded debits) is credited to retained earnings.
+	side := SideDebit
+	if total.IsNegative() {
+		side = SideCredit
+	}
+	lines = append(lines, db.JournalLine{
+		AccountID:   retainedEarnings,
+		Side:        side,
+		Amount:      total.Abs().StringFixed(amountScale),
+		Description: sql.NullString{String: "Year-end close", Valid: true},
+	})
+	return lines, nil
+}
+
+func (s *FiscalPeriodService) calendar(ctx context.Context, orgID string) (db.FiscalCalendar, error) {
+	cal, err := s.repo.GetCalendar(ctx, orgID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return cal, fmt.Errorf("%w: no fiscal calendar for %s", ErrNotFound, orgID)
+	}
+	return cal, err
+}
+
+func (s *FiscalPeriodService) period(ctx context.Context, id uuid.UUID) (db.FiscalPeriod, error) {
+	p, err := s.repo.GetPeriod(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return p, fmt.Errorf("%w: fiscal period %s", ErrNotFound, id)
+	}
+	return p, err
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/shopspring/decimal"
+)
+
+// ErrNoExchangeRate is returned when no rate is quoted for a currency pair on
+// or before the requested date.
+var ErrNoExchangeRate = errors.New("no exchange rate")
+
+// Exchange rate types. Spot rates translate transactions as they are booked,
+// closing rates revalue open balances at period end.
+const (
+	RateTypeSpot    = "SPOT"
+	RateTypeClosing = "CLOSING"
+	RateTypeAverage = "AVERAGE"
+)
+
+// IsRateType reports whether t is a known exchange rate type.
+func IsRateType(t string) bool {
+	switch t {
+	case RateTypeSpot, RateTypeClosing, RateTypeAverage:
+		return true
+	}
+	return false
+}
+
+// rateScale matches the NUMERIC(18,6) columns exchange rates are stored in.
+const rateScale = 6
+
+// FXConverter resolves an organization's functional currency and the rates
+// used to translate into it. The functional currency is taken from the
+// organization's fiscal calendar, falling back to defaultCurrency; rates come
+// from the exchange rate table.
+type FXConverter struct {
+	calendars       ports.FiscalPeriodRepository
+	rates           ports.ExchangeRateService
+	defaultCurrency string
+}
+
+var _ ports.CurrencyConverter = (*FXConverter)(nil)
+
+func NewFXConverter(calendars ports.FiscalPeriodRepository, rates ports.ExchangeRateService, defaultCurrency string) *FXConverter {
+	return &FXConverter{calendars: calendars, rates: rates, defaultCurrency: strings.ToUpper(defaultCurrency)}
+}
+
+func (c *FXConverter) FunctionalCurrency(ctx context.Context, orgID string) (string, error) {
+	if orgID == "" || c.calendars == nil {
+		return c.defaultCurrency, nil
+	}
+	cal, err := c.calendars.GetCalendar(ctx, orgID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return c.defaultCurrency, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("look up fiscal calendar: %w", err)
+	}
+	if cal.FunctionalCurrency.Valid && cal.FunctionalCurrency.String != "" {
+		return strings.ToUpper(cal.FunctionalCurrency.String), nil
+	}
+	return c.defaultCurrency, nil
+}
+
+// Rate returns how many units of to buy one unit of from, using the latest
+// spot rate quoted on or before asOf. When only the inverse pair is quoted its
+// reciprocal is used.
+func (c *FXConverter) Rate(ctx context.Context, from, to string, asOf time.Time) (string, error) {
+	return c.rate(ctx, from, to, RateTypeSpot, asOf, func(base, quote string) (db.ExchangeRate, error) {
+		return c.rates.GetLatest(ctx, base, quote, asOf)
+	})
+}
+
+// RateOfType is Rate using the latest rate of rateType.
+func (c *FXConverter) RateOfType(ctx context.Context, from, to, rateType string, asOf time.Time) (string, error) {
+	return c.rate(ctx, from, to, rateType, asOf, func(base, quote string) (db.ExchangeRate, error) {
+		return c.rates.GetLatestOfType(ctx, base, quote, rateType, asOf)
+	})
+}
+
+func (c *FXConverter) rate(ctx context.Context, from, to, rateType string, asOf time.Time, latest func(base, quote string) (db.ExchangeRate, error)) (string, error) {
+	if from == to {
+		return "1", nil
+	}
+	r, err := latest(from, to)
+	if err == nil {
+		return r.Rate, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("look up %s/%s rate: %w", from, to, err)
+	}
+
+	r, err = latest(to, from)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("%w: no %s %s/%s rate on or before %s", ErrNoExchangeRate,
+			strings.ToLower(rateType), from, to, asOf.Format(time.DateOnly))
+	}
+	if err != nil {
+		return "", fmt.Errorf("look up %s/%s rate: %w", to, from, err)
+	}
+	inverse, err := decimal.NewFromString(r.Rate)
+	if err != nil || !inverse.IsPositive() {
+		return "", fmt.Errorf("%s/%s rate %q is not a positive decimal", to, from, r.Rate)
+	}
+	return decimal.NewFromInt(1).DivRound(inverse, rateScale).String(), nil
+}
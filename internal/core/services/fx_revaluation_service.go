@@ -0,0 +1,336 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SourceFXRevaluation is the source type of period-end revaluation journals.
+const SourceFXRevaluation = "FX_REVALUATION"
+
+// Sources of revalued items.
+const (
+	RevaluationSourceAccount     = "ACCOUNT"
+	RevaluationSourceBankAccount = "BANK_ACCOUNT"
+	RevaluationSourcePaymentDue  = "PAYMENT_DUE"
+)
+
+// FXRevaluationService revalues an organization's open foreign-currency
+// balances at period-end rates. Three kinds of item are revalued: the ledger
+// accounts listed in the organization's settings, the ledger accounts of
+// foreign-currency bank accounts, and unpaid payment dues whose receivable or
+// payable account is not already covered by the first two. The unrealized
+// gain or loss is drafted as a journal that reverses on the first day of the
+// next period, so the books carry the booked amounts again once it is over.
+type FXRevaluationService struct {
+	repo     ports.FXRevaluationRepository
+	periods  ports.FiscalPeriodRepository
+	fx       ports.CurrencyConverter
+	journals ports.JournalService
+}
+
+var _ ports.FXRevaluationService = (*FXRevaluationService)(nil)
+
+func NewFXRevaluationService(repo ports.FXRevaluationRepository, periods ports.FiscalPeriodRepository, fx ports.CurrencyConverter, journals ports.JournalService) *FXRevaluationService {
+	return &FXRevaluationService{repo: repo, periods: periods, fx: fx, journals: journals}
+}
+
+func (s *FXRevaluationService) SetSettings(ctx context.Context, st db.FxRevaluationSetting) (db.FxRevaluationSetting, error) {
+	if st.OrganizationID == "" {
+		return st, fmt.Errorf("%w: organization_id is required", ErrInvalidInput)
+	}
+	if st.UnrealizedGainAccountID == uuid.Nil || st.UnrealizedLossAccountID == uuid.Nil {
+		return st, fmt.Errorf("%w: unrealized gain and loss accounts are required", ErrInvalidInput)
+	}
+	return s.repo.UpsertSettings(ctx, st)
+}
+
+func (s *FXRevaluationService) GetSettings(ctx context.Context, orgID string) (db.FxRevaluationSetting, error) {
+	st, err := s.repo.GetSettings(ctx, orgID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return st, fmt.Errorf("%w: no FX revaluation settings for %s", ErrNotFound, orgID)
+	}
+	return st, err
+}
+
+// Revalue revalues the organization's open foreign-currency items at the
+// latest rateType rate on or before asOf (CLOSING when empty) and drafts the
+// adjusting journal, dated asOf. Only one revaluation journal may exist per
+// organization and date; delete the draft to run it again.
+func (s *FXRevaluationService) Revalue(ctx context.Context, orgID string, asOf time.Time, rateType, by string) (*ports.FXRevaluation, error) {
+	if orgID == "" || asOf.IsZero() {
+		return nil, fmt.Errorf("%w: organization_id and as_of are required", ErrInvalidInput)
+	}
+	rateType = strings.ToUpper(rateType)
+	if rateType == "" {
+		rateType = RateTypeClosing
+	}
+	if !IsRateType(rateType) {
+		return nil, fmt.Errorf("%w: unknown rate type %q", ErrInvalidInput, rateType)
+	}
+	st, err := s.repo.GetSettings(ctx, orgID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no FX gain/loss accounts configured for %s", ErrInvalidInput, orgID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureNotRevalued(ctx, orgID, asOf); err != nil {
+		return nil, err
+	}
+	functional, err := s.fx.FunctionalCurrency(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.openItems(ctx, st, functional, asOf)
+	if err != nil {
+		return nil, err
+	}
+	rates := make(map[string]string)
+	for i := range items {
+		if err := s.revalueItem(ctx, &items[i], functional, rateType, asOf, rates); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &ports.FXRevaluation{
+		OrganizationID:     orgID,
+		AsOf:               asOf,
+		RateType:           rateType,
+		FunctionalCurrency: functional,
+		Items:              items,
+	}
+	lines, err := revaluationLines(items, st, functional)
+	if err != nil || len(lines) == 0 {
+		return res, err
+	}
+	reverseOn, err := s.nextPeriodStart(ctx, orgID, asOf)
+	if err != nil {
+		return nil, err
+	}
+	date := asOf.Format(time.DateOnly)
+	res.Journal, err = s.journals.Create(ctx, &db.JournalEntry{
+		JournalDate:     asOf,
+		Reference:       sql.NullString{String: "FXREV-" + date, Valid: true},
+		Memo:            sql.NullString{String: fmt.Sprintf("Unrealized FX revaluation at %s %s rates", date, strings.ToLower(rateType)), Valid: true},
+		SourceType:      sql.NullString{String: SourceFXRevaluation, Valid: true},
+		SourceID:        sql.NullString{String: date, Valid: true},
+		CreatedBy:       sql.NullString{String: by, Valid: by != ""},
+		UpdatedBy:       sql.NullString{String: by, Valid: by != ""},
+		OrganizationID:  sql.NullString{String: orgID, Valid: true},
+		Status:          JournalDraft,
+		AutoReverseDate: sql.NullTime{Time: reverseOn, Valid: true},
+		Lines:           lines,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("draft revaluation journal: %w", err)
+	}
+	return res, nil
+}
+
+func (s *FXRevaluationService) ensureNotRevalued(ctx context.Context, orgID string, asOf time.Time) error {
+	existing, err := s.journals.List(ctx, ports.EntryFilter{
+		SourceType:     SourceFXRevaluation,
+		OrganizationID: orgID,
+		FromDate:       asOf,
+		ToDate:         asOf,
+	}, 100, 0)
+	if err != nil {
+		return err
+	}
+	for _, j := range existing {
+		if !j.ReversalOf.Valid {
+			return fmt.Errorf("%w: %s is already revalued by journal %s (%s)", ErrConflict,
+				asOf.Format(time.DateOnly), j.ID, j.Status)
+		}
+	}
+	return nil
+}
+
+// openItems collects the items to revalue with their foreign and carrying
+// balances; rates are filled in by revalueItem.
+func (s *FXRevaluationService) openItems(ctx context.Context, st db.FxRevaluationSetting, functional string, asOf time.Time) ([]ports.FXRevaluationItem, error) {
+	// Ledger accounts are revalued in every foreign currency they hold; a
+	// bank account's ledger account only in the bank account's currency.
+	accounts := make(map[uuid.UUID]bool)
+	bankByAccount := make(map[uuid.UUID]db.BankAccount)
+	ids := make([]uuid.UUID, 0, len(st.AccountIds))
+	for _, id := range st.AccountIds {
+		if !accounts[id] {
+			accounts[id] = true
+			ids = append(ids, id)
+		}
+	}
+	banks, err := s.repo.ForeignBankAccounts(ctx, st.OrganizationID, functional)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range banks {
+		id, err := uuid.Parse(b.LedgerAccountID.String)
+		if err != nil {
+			return nil, fmt.Errorf("bank account %s: invalid ledger account %q", b.ID, b.LedgerAccountID.String)
+		}
+		if _, ok := bankByAccount[id]; !ok {
+			bankByAccount[id] = b
+		}
+		if !accounts[id] {
+			accounts[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	var items []ports.FXRevaluationItem
+	positions, err := s.repo.ForeignCurrencyPositions(ctx, st.OrganizationID, ids, functional, asOf)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range positions {
+		item := ports.FXRevaluationItem{
+			Source:         RevaluationSourceAccount,
+			SourceID:       p.AccountID.String(),
+			AccountID:      p.AccountID,
+			Currency:       p.CurrencyCode,
+			Balance:        p.Balance,
+			CarryingAmount: p.CarryingAmount,
+		}
+		if b, ok := bankByAccount[p.AccountID]; ok {
+			if b.CurrencyCode != p.CurrencyCode && !slices.Contains(st.AccountIds, p.AccountID) {
+				continue
+			}
+			item.Source, item.SourceID, item.Description = RevaluationSourceBankAccount, b.ID.String(), b.Name
+		}
+		items = append(items, item)
+	}
+
+	dues, err := s.repo.OpenPaymentDues(ctx, st.OrganizationID, functional, asOf)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range dues {
+		if !d.AccountID.Valid || accounts[d.AccountID.UUID] {
+			continue
+		}
+		balance, err := decimal.NewFromString(d.AmountDue)
+		if err != nil {
+			return nil, fmt.Errorf("payment due %s amount: %w", d.ID, err)
+		}
+		rate, err := decimal.NewFromString(d.ExchangeRate)
+		if err != nil {
+			return nil, fmt.Errorf("payment due %s exchange rate: %w", d.ID, err)
+		}
+		// A purchase invoice is owed by us, a credit balance.
+		if strings.HasSuffix(strings.ToUpper(d.InvoiceType), "PURCHASE") {
+			balance = balance.Neg()
+		}
+		items = append(items, ports.FXRevaluationItem{
+			Source:         RevaluationSourcePaymentDue,
+			SourceID:       d.ID.String(),
+			Description:    d.InvoiceNumber,
+			AccountID:      d.AccountID.UUID,
+			Currency:       d.CurrencyCode,
+			Balance:        balance.StringFixed(amountScale),
+			CarryingAmount: balance.Mul(rate).Round(amountScale).StringFixed(amountScale),
+		})
+	}
+	return items, nil
+}
+
+func (s *FXRevaluationService) revalueItem(ctx context.Context, item *ports.FXRevaluationItem, functional, rateType string, asOf time.Time, rates map[string]string) error {
+	rate, ok := rates[item.Currency]
+	if !ok {
+		var err error
+		if rate, err = s.fx.RateOfType(ctx, item.Currency, functional, rateType, asOf); err != nil {
+			return err
+		}
+		rates[item.Currency] = rate
+	}
+	balance, err := decimal.NewFromString(item.Balance)
+	if err != nil {
+		return fmt.Errorf("%s %s balance: %w", item.Source, item.SourceID, err)
+	}
+	carrying, err := decimal.NewFromString(item.CarryingAmount)
+	if err != nil {
+		return fmt.Errorf("%s %s carrying amount: %w", item.Source, item.SourceID, err)
+	}
+	r, err := decimal.NewFromString(rate)
+	if err != nil {
+		return fmt.Errorf("%s/%s rate %q: %w", item.Currency, functional, rate, err)
+	}
+	revalued := balance.Mul(r).Round(amountScale)
+	item.ClosingRate = rate
+	item.RevaluedAmount = revalued.StringFixed(amountScale)
+	item.Difference = revalued.Sub(carrying).StringFixed(amountScale)
+	return nil
+}
+
+// revaluationLines adjusts each item's account by its difference and books
+// the total gain and loss to the configured accounts.
+func revaluationLines(items []ports.FXRevaluationItem, st db.FxRevaluationSetting, functional string) ([]db.JournalLine, error) {
+	var lines []db.JournalLine
+	gain, loss := decimal.Zero, decimal.Zero
+	for _, item := range items {
+		diff, err := decimal.NewFromString(item.Difference)
+		if err != nil {
+			return nil, err
+		}
+		if diff.IsZero() {
+			continue
+		}
+		side := SideDebit
+		if diff.IsPositive() {
+			gain = gain.Add(diff)
+		} else {
+			side = SideCredit
+			loss = loss.Sub(diff)
+		}
+		desc := fmt.Sprintf("FX revaluation of %s %s", item.Currency, strings.ToLower(strings.ReplaceAll(item.Source, "_", " ")))
+		if item.Description != "" {
+			desc += " " + item.Description
+		}
+		lines = append(lines, revaluationLine(item.AccountID, side, diff.Abs(), functional, desc))
+	}
+	if gain.IsPositive() {
+		lines = append(lines, revaluationLine(st.UnrealizedGainAccountID, SideCredit, gain, functional, "Unrealized FX gain"))
+	}
+	if loss.IsPositive() {
+		lines = append(lines, revaluationLine(st.UnrealizedLossAccountID, SideDebit, loss, functional, "Unrealized FX loss"))
+	}
+	return lines, nil
+}
+
+func revaluationLine(account uuid.UUID, side string, amount decimal.Decimal, currency, desc string) db.JournalLine {
+	return db.JournalLine{
+		AccountID:    account,
+		Side:         side,
+		Amount:       amount.StringFixed(amountScale),
+		CurrencyCode: currency,
+		Description:  sql.NullString{String: desc, Valid: true},
+	}
+}
+
+// nextPeriodStart is the first day of the fiscal period after the one asOf
+// falls in, or of the next calendar month when the organization has no
+// period for asOf.
+func (s *FXRevaluationService) nextPeriodStart(ctx context.Context, orgID string, asOf time.Time) (time.Time, error) {
+	if s.periods != nil {
+		p, err := s.periods.GetPeriodForDate(ctx, orgID, asOf)
+		if err == nil {
+			return p.EndDate.AddDate(0, 0, 1), nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, fmt.Errorf("look up fiscal period: %w", err)
+		}
+	}
+	return time.Date(asOf.Year(), asOf.Month()+1, 1, 0, 0, 0, 0, asOf.Location()), nil
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// maxLogoSize caps the logo stored with an invoice template.
+const maxLogoSize = 512 << 10
+
+// SetTemplate stores what the organization prints on its invoices. The logo,
+// when given, must be a PNG or JPEG image.
+func (s *InvoiceService) SetTemplate(ctx context.Context, t db.InvoiceTemplate) (db.InvoiceTemplate, error) {
+	t.CompanyName = strings.TrimSpace(t.CompanyName)
+	if t.OrganizationID == "" {
+		return t, fmt.Errorf("%w: organization_id is required", ErrInvalidInput)
+	}
+	if t.CompanyName == "" {
+		return t, fmt.Errorf("%w: company_name is required", ErrInvalidInput)
+	}
+	t.CountryCode = strings.ToUpper(strings.TrimSpace(t.CountryCode))
+	if t.CountryCode == "" {
+		t.CountryCode = "IN"
+	}
+	if !countryPattern.MatchString(t.CountryCode) {
+		return t, fmt.Errorf("%w: %q is not a country code", ErrInvalidInput, t.CountryCode)
+	}
+	t.PeppolEndpoint = trimNull(t.PeppolEndpoint)
+	if err := validatePeppolEndpoint(t.PeppolEndpoint); err != nil {
+		return t, err
+	}
+	if len(t.Logo) > 0 {
+		if len(t.Logo) > maxLogoSize {
+			return t, fmt.Errorf("%w: logo is %d bytes, more than the %d allowed", ErrInvalidInput, len(t.Logo), maxLogoSize)
+		}
+		if ct := http.DetectContentType(t.Logo); ct != "image/png" && ct != "image/jpeg" {
+			return t, fmt.Errorf("%w: logo must be a PNG or JPEG image, not %s", ErrInvalidInput, ct)
+		}
+	}
+	return s.repo.UpsertTemplate(ctx, t)
+}
+
+func (s *InvoiceService) GetTemplate(ctx context.Context, orgID string) (db.InvoiceTemplate, error) {
+	t, err := s.repo.GetTemplate(ctx, orgID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return t, fmt.Errorf("%w: no invoice template for %s", ErrNotFound, orgID)
+	}
+	return t, err
+}
+
+// InvoiceDocument gathers what the customer-facing copy of an invoice is
+// printed from. Organizations without a template get an unbranded one.
+func (s *InvoiceService) InvoiceDocument(ctx context.Context, id uuid.UUID) (ports.InvoiceDocument, error) {
+	inv, err := s.get(ctx, id)
+	if err != nil {
+		return ports.InvoiceDocument{}, err
+	}
+	t, err := s.repo.GetTemplate(ctx, inv.OrganizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		t, err = db.InvoiceTemplate{OrganizationID: inv.OrganizationID, CountryCode: "IN"}, nil
+	}
+	if err != nil {
+		return ports.InvoiceDocument{}, err
+	}
+	currency := inv.CurrencyCode
+	if currency == "" {
+		currency = s.calc.defaultCurrency
+	}
+	words, err := AmountInWords(inv.GrandTotal, currency, s.calc.Rounding(currency).Scale)
+	if err != nil {
+		return ports.InvoiceDocument{}, fmt.Errorf("invoice %s grand_total: %w", id, err)
+	}
+	return ports.InvoiceDocument{Invoice: inv, Template: t, AmountInWords: words}, nil
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+// Invoice types that do not post to the ledger.
+const (
+	InvoiceTypeProforma = "PROFORMA"
+	InvoiceTypeChallan  = "CHALLAN"
+)
+
+// defaultYearStartMonth starts the fiscal year of organizations without a
+// fiscal calendar, as the Indian financial year does.
+const defaultYearStartMonth = time.April
+
+// maxSeqWidth caps the zero-padding of {SEQ:n}.
+const maxSeqWidth = 12
+
+var numberTokenRe = regexp.MustCompile(`\{([A-Z]+)(?::(\d+))?\}`)
+
+// SetNumberSeries stores the pattern the organization's invoices of the
+// series' type are numbered with when issued.
+func (s *InvoiceService) SetNumberSeries(ctx context.Context, series db.InvoiceNumberSeries) (db.InvoiceNumberSeries, error) {
+	series.InvoiceType = strings.ToUpper(strings.TrimSpace(series.InvoiceType))
+	series.Pattern = strings.TrimSpace(series.Pattern)
+	if series.OrganizationID == "" {
+		return series, fmt.Errorf("%w: organization_id is required", ErrInvalidInput)
+	}
+	if !slices.Contains([]string{InvoiceTypeSales, InvoiceTypeProforma, InvoiceTypeChallan, InvoiceTypePurchase}, series.InvoiceType) {
+		return series, fmt.Errorf("%w: unknown invoice type %q", ErrInvalidInput, series.InvoiceType)
+	}
+	if err := checkNumberPattern(series.Pattern); err != nil {
+		return series, err
+	}
+	return s.repo.UpsertNumberSeries(ctx, series)
+}
+
+func (s *InvoiceService) GetNumberSeries(ctx context.Context, orgID, invoiceType string) (db.InvoiceNumberSeries, error) {
+	invoiceType = strings.ToUpper(strings.TrimSpace(invoiceType))
+	series, err := s.repo.GetNumberSeries(ctx, orgID, invoiceType)
+	if errors.Is(err, sql.ErrNoRows) {
+		return series, fmt.Errorf("%w: no number series for %s invoices of %s", ErrNotFound, invoiceType, orgID)
+	}
+	return series, err
+}
+
+// checkNumber rejects a draft whose number does not fit its type's
+// numbering: invoices of a type with a series get their number at issue.
+func (s *InvoiceService) checkNumber(ctx context.Context, inv db.Invoice) error {
+	if inv.InvoiceNumber == "" {
+		return nil
+	}
+	_, err := s.repo.GetNumberSeries(ctx, inv.OrganizationID, strings.ToUpper(inv.Type))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: %s invoices are numbered from their series when issued; leave invoice_number empty", ErrInvalidInput, inv.Type)
+}
+
+// seriesNumber is how issuing inv numbers it: nil when the invoice already
+// has a number, otherwise the next number of its type's series in the
+// fiscal year the invoice is dated in.
+func (s *InvoiceService) seriesNumber(ctx context.Context, inv db.Invoice) (*ports.InvoiceSeriesNumber, error) {
+	if inv.InvoiceNumber != "" {
+		return nil, nil
+	}
+	series, err := s.repo.GetNumberSeries(ctx, inv.OrganizationID, strings.ToUpper(inv.Type))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: invoice %s has no number and %s invoices of %s have no number series", ErrInvalidInput, inv.ID, inv.Type, inv.OrganizationID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	startMonth, err := s.yearStartMonth(ctx, inv.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	fy := fiscalYearOf(inv.InvoiceDate, startMonth)
+	return &ports.InvoiceSeriesNumber{
+		FiscalYear: fy,
+		Format: func(seq int64) string {
+			return formatInvoiceNumber(series.Pattern, fy, startMonth, seq)
+		},
+	}, nil
+}
+
+// yearStartMonth is the first month of the organization's fiscal year.
+func (s *InvoiceService) yearStartMonth(ctx context.Context, orgID string) (time.Month, error) {
+	if s.calendars == nil {
+		return defaultYearStartMonth, nil
+	}
+	cal, err := s.calendars.GetCalendar(ctx, orgID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return defaultYearStartMonth, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return time.Month(cal.YearStartMonth), nil
+}
+
+// fiscalYearOf labels the fiscal year date falls in by the calendar year it
+// starts in.
+func fiscalYearOf(date time.Time, startMonth time.Month) int32 {
+	if date.Month() < startMonth {
+		return int32(date.Year() - 1)
+	}
+	return int32(date.Year())
+}
+
+// checkNumberPattern accepts patterns made of literal text, {FY}, {FY:2}
+// and exactly one {SEQ} or {SEQ:n}.
+func checkNumberPattern(pattern string) error {
+	if pattern == "" {
+		return fmt.Errorf("%w: pattern is required", ErrInvalidInput)
+	}
+	seqs := 0
+	for _, m := range numberTokenRe.FindAllStringSubmatch(pattern, -1) {
+		switch m[1] {
+		case "FY":
+			if m[2] != "" && m[2] != "2" {
+				return fmt.Errorf("%w: %s: the fiscal year is written as {FY} or {FY:2}", ErrInvalidInput, m[0])
+			}
+		case "SEQ":
+			seqs++
+			if m[2] != "" {
+				if n, _ := strconv.Atoi(m[2]); n < 1 || n > maxSeqWidth {
+					return fmt.Errorf("%w: %s: the sequence is padded to 1 to %d digits", ErrInvalidInput, m[0], maxSeqWidth)
+				}
+			}
+		default:
+			return fmt.Errorf("%w: unknown token %s in number pattern", ErrInvalidInput, m[0])
+		}
+	}
+	if seqs != 1 {
+		return fmt.Errorf("%w: number pattern must contain exactly one {SEQ} or {SEQ:n}", ErrInvalidInput)
+	}
+	if strings.ContainsAny(numberTokenRe.ReplaceAllString(pattern, ""), "{}") {
+		return fmt.Errorf("%w: number pattern %q has a malformed token", ErrInvalidInput, pattern)
+	}
+	return nil
+}
+
+// formatInvoiceNumber fills in a pattern checked by checkNumberPattern.
+// {FY} is the fiscal year as 2025-26, or 2025 when it is the calendar year;
+// {FY:2} is 25-26 or 25.
+func formatInvoiceNumber(pattern string, fiscalYear int32, startMonth time.Month, seq int64) string {
+	return numberTokenRe.ReplaceAllStringFunc(pattern, func(tok string) string {
+		m := numberTokenRe.FindStringSubmatch(tok)
+		switch m[1] {
+		case "FY":
+			start := int(fiscalYear)
+			if m[2] == "2" {
+				start %= 100
+			}
+			if startMonth == time.January {
+				return fmt.Sprintf("%02d", start)
+			}
+			return fmt.Sprintf("%02d-%02d", start, (int(fiscalYear)+1)%100)
+		default:
+			width, _ := strconv.Atoi(m[2])
+			return fmt.Sprintf("%0*d", width, seq)
+		}
+	})
+}
@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SourceInvoice is the source type of the journals issued invoices post.
+const SourceInvoice = "INVOICE"
+
+// Invoice types that post to the ledger when issued. Proforma invoices and
+// challans do not.
+const (
+	InvoiceTypeSales    = "SALES"
+	InvoiceTypePurchase = "PURCHASE"
+)
+
+// SetPostingRule stores the accounts the organization's invoices of the
+// rule's type post to when issued.
+func (s *InvoiceService) SetPostingRule(ctx context.Context, rule db.InvoicePostingRule) (db.InvoicePostingRule, error) {
+	rule.InvoiceType = strings.ToUpper(strings.TrimSpace(rule.InvoiceType))
+	if rule.OrganizationID == "" {
+		return rule, fmt.Errorf("%w: organization_id is required", ErrInvalidInput)
+	}
+	if rule.InvoiceType != InvoiceTypeSales && rule.InvoiceType != InvoiceTypePurchase {
+		return rule, fmt.Errorf("%w: only SALES and PURCHASE invoices post to the ledger, not %q", ErrInvalidInput, rule.InvoiceType)
+	}
+	if rule.ControlAccountID == uuid.Nil || rule.LineAccountID == uuid.Nil {
+		return rule, fmt.Errorf("%w: control and line accounts are required", ErrInvalidInput)
+	}
+	return s.repo.UpsertPostingRule(ctx, rule)
+}
+
+func (s *InvoiceService) GetPostingRule(ctx context.Context, orgID, invoiceType string) (db.InvoicePostingRule, error) {
+	invoiceType = strings.ToUpper(strings.TrimSpace(invoiceType))
+	rule, err := s.repo.GetPostingRule(ctx, orgID, invoiceType)
+	if errors.Is(err, sql.ErrNoRows) {
+		return rule, fmt.Errorf("%w: no posting rule for %s invoices of %s", ErrNotFound, invoiceType, orgID)
+	}
+	return rule, err
+}
+
+// postingJournal returns what builds the POSTED journal issuing inv books,
+// checked as any new journal is, once the invoice has its number. The
+// invoice's own figures and the posting rule are checked up front. It is nil
+// for invoice types that do not post, and when the service has no journal
+// service to post with.
+func (s *InvoiceService) postingJournal(ctx context.Context, inv db.Invoice, by string) (func(invoiceNumber string) (*db.JournalEntry, error), error) {
+	typ := strings.ToUpper(inv.Type)
+	if s.journals == nil || (typ != InvoiceTypeSales && typ != InvoiceTypePurchase) {
+		return nil, nil
+	}
+	rule, err := s.repo.GetPostingRule(ctx, inv.OrganizationID, typ)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no posting accounts configured for %s invoices of %s", ErrInvalidInput, typ, inv.OrganizationID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := invoicePostingLines(inv, rule); err != nil {
+		return nil, err
+	}
+
+	return func(invoiceNumber string) (*db.JournalEntry, error) {
+		inv.InvoiceNumber = invoiceNumber
+		lines, err := invoicePostingLines(inv, rule)
+		if err != nil {
+			return nil, err
+		}
+		j := &db.JournalEntry{
+			JournalDate:    inv.InvoiceDate,
+			Reference:      sql.NullString{String: inv.InvoiceNumber, Valid: inv.InvoiceNumber != ""},
+			Memo:           sql.NullString{String: fmt.Sprintf("%s invoice %s", strings.ToLower(typ), inv.InvoiceNumber), Valid: true},
+			SourceType:     sql.NullString{String: SourceInvoice, Valid: true},
+			SourceID:       sql.NullString{String: inv.ID.String(), Valid: true},
+			CreatedBy:      sql.NullString{String: by, Valid: by != ""},
+			UpdatedBy:      sql.NullString{String: by, Valid: by != ""},
+			OrganizationID: sql.NullString{String: inv.OrganizationID, Valid: inv.OrganizationID != ""},
+			Status:         JournalPosted,
+			Lines:          lines,
+		}
+		if err := s.journals.prepareCreate(ctx, j); err != nil {
+			return nil, fmt.Errorf("post invoice %s: %w", inv.ID, err)
+		}
+		return j, nil
+	}, nil
+}
+
+// voidReversal builds the reversal of the journal inv posted when issued,
+// dated as the journal. It is nil when inv posted none or the journal has
+// already been reversed.
+func (s *InvoiceService) voidReversal(ctx context.Context, inv db.Invoice, reason string) (*db.JournalEntry, error) {
+	if s.journals == nil || !inv.JournalID.Valid {
+		return nil, nil
+	}
+	posted, err := s.journals.get(ctx, inv.JournalID.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if posted.Status != JournalPosted {
+		return nil, nil
+	}
+	memo := fmt.Sprintf("Void of invoice %s: %s", inv.InvoiceNumber, reason)
+	return s.journals.prepareReverse(ctx, posted.ID, posted.JournalDate, memo)
+}
+
+// invoicePostingLines books a SALES invoice: grand_total is debited to the
+// control account, each item's subtotal net of its own discounts is credited
+// to the item's account or the rule's line account, invoice-level discounts
+// are debited and the taxes and round-off credited. A PURCHASE invoice gets
+// the mirror image. Amounts stay in the invoice currency.
+func invoicePostingLines(inv db.Invoice, rule db.InvoicePostingRule) ([]db.JournalLine, error) {
+	p := &invoicePosting{
+		currency: inv.CurrencyCode,
+		mirror:   strings.ToUpper(inv.Type) == InvoiceTypePurchase,
+	}
+	p.add(rule.ControlAccountID, SideDebit, p.amount("grand_total", inv.GrandTotal), "Invoice "+inv.InvoiceNumber, uuid.NullUUID{})
+
+	for i, item := range inv.Items {
+		net := p.amount(fmt.Sprintf("items[%d].line_subtotal", i), item.LineSubtotal)
+		for j, d := range item.Discounts {
+			net = net.Sub(p.amount(fmt.Sprintf("items[%d].discounts[%d].amount", i, j), d.Amount))
+		}
+		account := rule.LineAccountID
+		if item.AccountID.Valid {
+			account = item.AccountID.UUID
+		}
+		var costCenter uuid.NullUUID
+		if id, err := uuid.Parse(item.CostCenterID.String); err == nil {
+			costCenter = uuid.NullUUID{UUID: id, Valid: true}
+		}
+		p.add(account, SideCredit, net, item.Name, costCenter)
+	}
+
+	discounts := decimal.Zero
+	for i, d := range inv.Discounts {
+		discounts = discounts.Add(p.amount(fmt.Sprintf("discounts[%d].amount", i), d.Amount))
+	}
+	p.add(orAccount(rule.DiscountAccountID, rule.LineAccountID), SideDebit, discounts, "Invoice discounts", uuid.NullUUID{})
+
+	gst := decimal.Zero
+	for _, t := range []struct {
+		name    string
+		amount  sql.NullString
+		account uuid.NullUUID
+	}{
+		{TaxCGST, inv.GstCgst, rule.CgstAccountID},
+		{TaxSGST, inv.GstSgst, rule.SgstAccountID},
+		{TaxIGST, inv.GstIgst, rule.IgstAccountID},
+	} {
+		amt := decimal.Zero
+		if t.amount.Valid {
+			amt = p.amount("gst_"+strings.ToLower(t.name), t.amount.String)
+		}
+		gst = gst.Add(amt)
+		p.tax(t.name, t.account, amt)
+	}
+	p.tax("other tax", rule.OtherTaxAccountID, p.amount("tax_total", inv.TaxTotal).Sub(gst))
+
+	p.add(orAccount(rule.RoundOffAccountID, rule.LineAccountID), SideCredit, p.amount("round_off", inv.RoundOff), "Round-off", uuid.NullUUID{})
+
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.lines, nil
+}
+
+// invoicePosting collects journal lines, keeping the first error.
+type invoicePosting struct {
+	currency string
+	mirror   bool
+	lines    []db.JournalLine
+	err      error
+}
+
+func (p *invoicePosting) amount(field, s string) decimal.Decimal {
+	if strings.TrimSpace(s) == "" {
+		return decimal.Zero
+	}
+	d, ok := parseAmount(s)
+	if !ok && p.err == nil {
+		p.err = fmt.Errorf("%w: invoice %s %q is not an amount", ErrInvalidInput, field, s)
+	}
+	return d
+}
+
+// tax credits a tax amount to its account, which must be configured once
+// the invoice carries that tax.
+func (p *invoicePosting) tax(name string, account uuid.NullUUID, amt decimal.Decimal) {
+	if amt.IsZero() {
+		return
+	}
+	if !account.Valid {
+		if p.err == nil {
+			p.err = fmt.Errorf("%w: the invoice carries %s but its posting rule has no account for it", ErrInvalidInput, name)
+		}
+		return
+	}
+	p.add(account.UUID, SideCredit, amt, name, uuid.NullUUID{})
+}
+
+// add appends a line for a non-zero amount, on the other side when the
+// amount is negative or the invoice is mirrored.
+func (p *invoicePosting) add(account uuid.UUID, side string, amt decimal.Decimal, desc string, costCenter uuid.NullUUID) {
+	if amt.IsZero() {
+		return
+	}
+	if amt.IsNegative() != p.mirror {
+		side = oppositeSide(side)
+	}
+	p.lines = append(p.lines, db.JournalLine{
+		AccountID:    account,
+		Side:         side,
+		Amount:       amt.Abs().StringFixed(amountScale),
+		CostCenterID: costCenter,
+		CurrencyCode: p.currency,
+		Description:  sql.NullString{String: desc, Valid: desc != ""},
+	})
+}
+
+func orAccount(id uuid.NullUUID, fallback uuid.UUID) uuid.UUID {
+	if id.Valid {
+		return id.UUID
+	}
+	return fallback
+}
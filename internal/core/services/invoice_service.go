@@ -2,37 +2,148 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
 )
 
+// Invoice lifecycle. Only DRAFT invoices can be changed or deleted; an
+// issued invoice is corrected through credit/debit notes.
+const (
+	InvoiceDraft         = "DRAFT"
+	InvoiceIssued        = "ISSUED"
+	InvoicePartiallyPaid = "PARTIALLY_PAID"
+	InvoicePaid          = "PAID"
+	InvoiceVoid          = "VOID"
+	InvoiceOverdue       = "OVERDUE"
+)
+
+// invoiceTransitions lists the statuses each status can move to. Payments
+// move an invoice to PARTIALLY_PAID and PAID; VOID and PAID are final, except
+// that a debit note reopens a PAID invoice as PARTIALLY_PAID.
+var invoiceTransitions = map[string][]string{
+	InvoiceDraft:         {InvoiceIssued},
+	InvoiceIssued:        {InvoicePartiallyPaid, InvoicePaid, InvoiceVoid, InvoiceOverdue},
+	InvoicePartiallyPaid: {InvoicePaid, InvoiceOverdue},
+	InvoiceOverdue:       {InvoicePartiallyPaid, InvoicePaid, InvoiceVoid},
+}
+
 type InvoiceService struct {
 	repo      ports.InvoiceRepository
 	eventSvc  *FinanceEventService
+	periods   ports.PeriodGuard
+	calendars ports.FiscalPeriodRepository
+	calc      *InvoiceCalculator
+	journals  *JournalService
 	publisher    ports.EventPublisher
+	credit    ports.CreditGuard
+	parties   ports.PartyRepository
 }
 
-// Updated constructor to include publisher
-func NewInvoiceService(repo ports.InvoiceRepository,eventSvc *FinanceEventService, publisher ports.EventPublisher) *InvoiceService {
+// Updated constructor to include publisher. A nil calculator rounds every
+// currency half up to two decimals, with INR as the default currency. With
+// nil journals issued invoices are not posted to the ledger. Number series
+// count fiscal years from the organization's fiscal calendar, or from April
+// when it has none or calendars is nil. With a nil credit guard customer
+// credit limits are not enforced, and with nil parties invoice parties are
+// not checked.
+func NewInvoiceService(repo ports.InvoiceRepository,eventSvc *FinanceEventService, periods ports.PeriodGuard, calendars ports.FiscalPeriodRepository, calc *InvoiceCalculator, journals *JournalService, publisher ports.EventPublisher, credit ports.CreditGuard, parties ports.PartyRepository) *InvoiceService {
+	if calc == nil {
+		calc = NewInvoiceCalculator("INR", nil)
+	}
 	return &InvoiceService{
 		repo:      repo,
 		eventSvc: eventSvc,
+		periods:   periods,
+		calendars: calendars,
+		calc:      calc,
+		journals:  journals,
 		publisher:    publisher,
+		credit:    credit,
+		parties:   parties,
 	}
 }
 
 // ---------- Invoice CRUD ----------
+
+// CreateInvoice stores the invoice and its lines with figures computed from
+// those lines, rejecting client totals that disagree with them.
 func (s *InvoiceService) CreateInvoice(ctx context.Context, inv db.Invoice) (db.Invoice, error) {
+	computed, err := s.prepareCreate(ctx, inv)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+
 	// Step 1: Create invoice in DB
-	i, err := s.repo.CreateInvoice(ctx, inv)
+	i, err := s.repo.CreateInvoice(ctx, computed)
 	if err != nil {
 		return i, err
 	}
 
-	// Step 2: Build event from invoice row
+	// Step 2: Record + publish event
+	s.recordCreated(ctx, i)
+	return i, nil
+}
+
+// prepareCreate checks a new DRAFT invoice and computes its figures.
+func (s *InvoiceService) prepareCreate(ctx context.Context, inv db.Invoice) (db.Invoice, error) {
+	if inv.Status != "" && inv.Status != InvoiceDraft {
+		return db.Invoice{}, fmt.Errorf("%w: invoice cannot be created as %s; create a DRAFT and issue it", ErrInvalidInput, inv.Status)
+	}
+	inv.Status = InvoiceDraft
+	if err := s.ensureOpen(ctx, inv); err != nil {
+		return db.Invoice{}, err
+	}
+	if err := s.checkNumber(ctx, inv); err != nil {
+		return db.Invoice{}, err
+	}
+	if err := s.checkParty(ctx, inv); err != nil {
+		return db.Invoice{}, err
+	}
+	return s.computeTotals(inv, inv)
+}
+
+// checkParty rejects an invoice whose party belongs to another organization,
+// or cannot be on its side of the invoice: SALES invoices are raised for
+// customers and PURCHASE invoices received from vendors.
+func (s *InvoiceService) checkParty(ctx context.Context, inv db.Invoice) error {
+	if s.parties == nil || !inv.PartyID.Valid {
+		return nil
+	}
+	p, err := s.parties.Get(ctx, inv.PartyID.UUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: party %s does not exist", ErrInvalidInput, inv.PartyID.UUID)
+	}
+	if err != nil {
+		return err
+	}
+	if p.OrganizationID != inv.OrganizationID {
+		return fmt.Errorf("%w: party %s belongs to another organization", ErrInvalidInput, p.ID)
+	}
+	var want string
+	switch strings.ToUpper(inv.Type) {
+	case InvoiceTypeSales:
+		want = PartyCustomer
+	case InvoiceTypePurchase:
+		want = PartyVendor
+	}
+	if want != "" && p.Kind != want && p.Kind != PartyBoth {
+		return fmt.Errorf("%w: %s invoices need a %s party; %s is a %s", ErrInvalidInput, strings.ToUpper(inv.Type), want, p.Name, p.Kind)
+	}
+	return nil
+}
+
+// recordCreated records and publishes the invoice.created event of a stored
+// invoice.
+func (s *InvoiceService) recordCreated(ctx context.Context, i db.Invoice) {
 	event := db.FinanceInvoiceCreatedEvent{
 		InvoiceID:      i.ID,
 		InvoiceNumber:  i.InvoiceNumber,
@@ -40,23 +151,44 @@ func (s *InvoiceService) CreateInvoice(ctx context.Context, inv db.Invoice) (db.
 		Total:          i.GrandTotal,
 		OrganizationID: i.OrganizationID,
 	}
-
-	// Step 3: Record + publish event
 	if _, err := s.eventSvc.RecordInvoiceCreated(ctx, event); err != nil {
 		fmt.Printf("Error recording invoice.created event: %v\n", err)
 	}
-
-	return i, nil
 }
 
 func (s *InvoiceService) GetInvoice(ctx context.Context, id uuid.UUID) (db.Invoice, error) {
 	return s.repo.GetInvoice(ctx, id)
 }
 
+// UpdateInvoice replaces a DRAFT invoice, lines included, with figures
+// computed from inv's lines, rejecting client totals that disagree with
+// them. The status only changes through the transitions below.
 func (s *InvoiceService) UpdateInvoice(ctx context.Context, inv db.Invoice) (db.Invoice, error) {
-	i, err := s.repo.UpdateInvoice(ctx, inv)
+	current, err := s.getDraft(ctx, inv.ID)
 	if err != nil {
-		return i, err
+		return db.Invoice{}, err
+	}
+	if inv.Status != "" && inv.Status != InvoiceDraft {
+		return db.Invoice{}, fmt.Errorf("%w: status cannot be changed to %s by an update", ErrInvalidInput, inv.Status)
+	}
+	inv.Status = InvoiceDraft
+	if err := s.ensureStoredOpen(ctx, current, inv.InvoiceDate); err != nil {
+		return db.Invoice{}, err
+	}
+	if err := s.checkNumber(ctx, inv); err != nil {
+		return db.Invoice{}, err
+	}
+	if err := s.checkParty(ctx, inv); err != nil {
+		return db.Invoice{}, err
+	}
+	computed, err := s.computeTotals(inv, inv)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+
+	i, err := s.repo.UpdateInvoice(ctx, computed)
+	if err != nil {
+		return i, s.draftError(inv.ID, err)
 	}
 
 	if err := s.publisher.PublishInvoiceUpdated(ctx, &i); err != nil {
@@ -65,10 +197,19 @@ func (s *InvoiceService) UpdateInvoice(ctx context.Context, inv db.Invoice) (db.
 	return i, nil
 }
 
+// DeleteInvoice removes a DRAFT invoice; issued ones are voided instead.
 func (s *InvoiceService) DeleteInvoice(ctx context.Context, id uuid.UUID) error {
-	if err := s.repo.DeleteInvoice(ctx, id); err != nil {
+	current, err := s.getDraft(ctx, id)
+	if err != nil {
 		return err
 	}
+	if err := s.ensureStoredOpen(ctx, current); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteInvoice(ctx, id); err != nil {
+		return s.draftError(id, err)
+	}
 
 	if err := s.publisher.PublishInvoiceDeleted(ctx, id.String()); err != nil {
 		fmt.Printf("Kafka publish error (invoice.deleted): %v\n", err)
@@ -76,20 +217,305 @@ func (s *InvoiceService) DeleteInvoice(ctx context.Context, id uuid.UUID) error
 	return nil
 }
 
+// ensureOpen rejects invoices dated into a closed accounting period.
+func (s *InvoiceService) ensureOpen(ctx context.Context, inv db.Invoice) error {
+	return ensureOpen(ctx, s.periods, inv.OrganizationID, inv.InvoiceDate, "")
+}
+
+// ensureStoredOpen rejects changes to an invoice dated into a closed
+// accounting period, checking both the stored date and any new date.
+func (s *InvoiceService) ensureStoredOpen(ctx context.Context, current db.Invoice, moveTo ...time.Time) error {
+	for _, d := range append([]time.Time{current.InvoiceDate}, moveTo...) {
+		if err := ensureOpen(ctx, s.periods, current.OrganizationID, d, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *InvoiceService) ListInvoices(ctx context.Context, limit, offset int32) ([]db.Invoice, error) {
 	return s.repo.ListInvoices(ctx, limit, offset)
 }
 
-func (s *InvoiceService) SearchInvoices(ctx context.Context, query string, limit, offset int32) ([]db.Invoice, error) {
-	return s.repo.SearchInvoices(ctx, query, limit, offset)
+// SearchInvoices finds an organization's invoices by full-text query and
+// structured filters. A query orders the results by relevance unless the
+// filter asks otherwise.
+func (s *InvoiceService) SearchInvoices(ctx context.Context, f ports.InvoiceFilter, limit, offset int32) ([]db.Invoice, error) {
+	f.Query = strings.TrimSpace(f.Query)
+	if f.OrderBy == "" && f.Query != "" {
+		f.OrderBy = OrderByRelevance
+	}
+	if err := validateInvoiceFilter(f); err != nil {
+		return nil, err
+	}
+	return s.repo.SearchInvoices(ctx, f, limit, offset)
+}
+
+// OrderByRelevance sorts invoice search results best match first.
+const OrderByRelevance = "relevance"
+
+func validateInvoiceFilter(f ports.InvoiceFilter) error {
+	if f.OrganizationID == "" {
+		return fmt.Errorf("%w: organization_id is required", ErrInvalidInput)
+	}
+	switch f.Status {
+	case "", InvoiceDraft, InvoiceIssued, InvoicePartiallyPaid, InvoicePaid, InvoiceVoid, InvoiceOverdue:
+	default:
+		return fmt.Errorf("%w: unknown invoice status %q", ErrInvalidInput, f.Status)
+	}
+	switch f.Type {
+	case "", InvoiceTypeSales, InvoiceTypePurchase, InvoiceTypeProforma, InvoiceTypeChallan:
+	default:
+		return fmt.Errorf("%w: unknown invoice type %q", ErrInvalidInput, f.Type)
+	}
+	if !f.FromDate.IsZero() && !f.ToDate.IsZero() && f.ToDate.Before(f.FromDate) {
+		return fmt.Errorf("%w: invoice date range ends before it starts", ErrInvalidInput)
+	}
+	if !f.DueFrom.IsZero() && !f.DueTo.IsZero() && f.DueTo.Before(f.DueFrom) {
+		return fmt.Errorf("%w: due date range ends before it starts", ErrInvalidInput)
+	}
+	var totals [2]decimal.Decimal
+	for i, v := range []string{f.MinTotal, f.MaxTotal} {
+		if v == "" {
+			continue
+		}
+		d, ok := parseAmount(v)
+		if !ok {
+			return fmt.Errorf("%w: grand total %q is not an amount", ErrInvalidInput, v)
+		}
+		totals[i] = d
+	}
+	if f.MinTotal != "" && f.MaxTotal != "" && totals[1].LessThan(totals[0]) {
+		return fmt.Errorf("%w: grand total range ends below its start", ErrInvalidInput)
+	}
+	switch f.OrderBy {
+	case "", OrderByDate, OrderByAmount:
+	case OrderByRelevance:
+		if f.Query == "" {
+			return fmt.Errorf("%w: ordering by relevance needs a query", ErrInvalidInput)
+		}
+	default:
+		return fmt.Errorf("%w: cannot order invoices by %q", ErrInvalidInput, f.OrderBy)
+	}
+	return nil
+}
+
+// ---------- Status transitions ----------
+
+// IssueInvoice moves a DRAFT invoice with at least one item to ISSUED,
+// after which it can no longer be changed. An invoice without a number takes
+// the next one of its type's number series, and SALES and PURCHASE invoices
+// post their journal to the ledger, with the move. A SALES invoice is
+// refused when its customer is on credit hold or past its credit threshold.
+// The checks and the journal use the draft as read; if it is updated before
+// the move, the issue fails with ErrConflict.
+func (s *InvoiceService) IssueInvoice(ctx context.Context, id uuid.UUID, by string) (db.Invoice, error) {
+	current, err := s.transitionFrom(ctx, id, InvoiceIssued)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	if len(current.Items) == 0 {
+		return db.Invoice{}, fmt.Errorf("%w: invoice %s has no items", ErrInvalidInput, id)
+	}
+	if err := s.ensureStoredOpen(ctx, current); err != nil {
+		return db.Invoice{}, err
+	}
+	if s.credit != nil {
+		if err := s.credit.CheckIssue(ctx, current); err != nil {
+			return db.Invoice{}, err
+		}
+	}
+	number, err := s.seriesNumber(ctx, current)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	journal, err := s.postingJournal(ctx, current, by)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	issued, posted, err := s.repo.IssueInvoice(ctx, id, by, ports.InvoiceIssue{
+		Revision: current.Revision.Int32,
+		Number:   number,
+		Journal:  journal,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.Invoice{}, fmt.Errorf("%w: invoice %s is no longer the DRAFT at revision %d it was checked at",
+			ErrConflict, id, current.Revision.Int32)
+	}
+	if posted != nil {
+		s.journals.publishCreated(posted)
+	}
+	return s.transitioned(ctx, current, issued, err, "")
+}
+
+// VoidInvoice cancels an ISSUED or OVERDUE invoice. The reason is required
+// and kept with the invoice. The journal the invoice posted is reversed.
+// Only that journal is, so an invoice a credit or debit note has been
+// applied to cannot be voided; a credit note for what it still bills
+// cancels it instead.
+func (s *InvoiceService) VoidInvoice(ctx context.Context, id uuid.UUID, reason, by string) (db.Invoice, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return db.Invoice{}, fmt.Errorf("%w: a reason is required to void an invoice", ErrInvalidInput)
+	}
+	current, err := s.transitionFrom(ctx, id, InvoiceVoid)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	if hasNotes(current) {
+		return db.Invoice{}, fmt.Errorf("%w: invoice %s has credit or debit notes applied and cannot be voided", ErrConflict, id)
+	}
+	if err := s.ensureStoredOpen(ctx, current); err != nil {
+		return db.Invoice{}, err
+	}
+	reversal, err := s.voidReversal(ctx, current, reason)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	voided, reversed, err := s.repo.VoidInvoice(ctx, id, reason, by, reversal)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.Invoice{}, fmt.Errorf("%w: invoice %s is no longer %s without notes applied", ErrConflict, id, current.Status)
+	}
+	if reversed != nil {
+		s.journals.publishCreated(reversed)
+	}
+	return s.transitioned(ctx, current, voided, err, reason)
+}
+
+// MarkOverdue flags an ISSUED or PARTIALLY_PAID invoice whose due date is
+// before asOf as OVERDUE.
+func (s *InvoiceService) MarkOverdue(ctx context.Context, id uuid.UUID, asOf time.Time, by string) (db.Invoice, error) {
+	current, err := s.transitionFrom(ctx, id, InvoiceOverdue)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	if !current.DueDate.Valid {
+		return db.Invoice{}, fmt.Errorf("%w: invoice %s has no due date", ErrConflict, id)
+	}
+	if !current.DueDate.Time.Before(asOf) {
+		return db.Invoice{}, fmt.Errorf("%w: invoice %s is not due until %s", ErrConflict, id, current.DueDate.Time.Format(time.DateOnly))
+	}
+	overdue, err := s.repo.MarkInvoiceOverdue(ctx, id, by)
+	return s.transitioned(ctx, current, overdue, err, "")
+}
+
+// transitionFrom loads the invoice and checks that its status can move to.
+func (s *InvoiceService) transitionFrom(ctx context.Context, id uuid.UUID, to string) (db.Invoice, error) {
+	current, err := s.get(ctx, id)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	if !slices.Contains(invoiceTransitions[current.Status], to) {
+		return db.Invoice{}, fmt.Errorf("%w: invoice %s is %s and cannot move to %s", ErrConflict, id, current.Status, to)
+	}
+	return current, nil
+}
+
+// transitioned publishes the status change the repo made, or reports the
+// status having moved on since it was checked as a conflict.
+func (s *InvoiceService) transitioned(ctx context.Context, from, to db.Invoice, err error, reason string) (db.Invoice, error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.Invoice{}, fmt.Errorf("%w: invoice %s is no longer %s", ErrConflict, from.ID, from.Status)
+	}
+	if err != nil {
+		return db.Invoice{}, err
+	}
+
+	ev := &ports.InvoiceStatusChangedEvent{
+		InvoiceID:      to.ID.String(),
+		InvoiceNumber:  to.InvoiceNumber,
+		OrganizationID: to.OrganizationID,
+		From:           from.Status,
+		To:             to.Status,
+		ChangedBy:      to.UpdatedBy.String,
+		ChangedAt:      to.UpdatedAt.Time,
+		Reason:         reason,
+	}
+	if err := s.publisher.PublishInvoiceStatusChanged(ctx, ev); err != nil {
+		fmt.Printf("Kafka publish error (invoice status %s): %v\n", to.Status, err)
+	}
+	return to, nil
+}
+
+func (s *InvoiceService) get(ctx context.Context, id uuid.UUID) (db.Invoice, error) {
+	inv, err := s.repo.GetInvoice(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.Invoice{}, fmt.Errorf("%w: invoice %s", ErrNotFound, id)
+	}
+	return inv, err
+}
+
+func (s *InvoiceService) getDraft(ctx context.Context, id uuid.UUID) (db.Invoice, error) {
+	inv, err := s.get(ctx, id)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	if inv.Status != InvoiceDraft {
+		return db.Invoice{}, fmt.Errorf("%w: invoice %s is %s, only DRAFT invoices can be changed", ErrConflict, id, inv.Status)
+	}
+	return inv, nil
+}
+
+// draftError reports a write that matched no draft, because the invoice was
+// issued after it was loaded, as a conflict.
+func (s *InvoiceService) draftError(id uuid.UUID, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: invoice %s is no longer a DRAFT", ErrConflict, id)
+	}
+	return err
+}
+
+// computeTotals computes inv's figures and checks those sent in client.
+func (s *InvoiceService) computeTotals(inv, client db.Invoice) (db.Invoice, error) {
+	if err := s.calc.Compute(&inv); err != nil {
+		return db.Invoice{}, err
+	}
+	if err := s.calc.Verify(client, inv); err != nil {
+		return db.Invoice{}, err
+	}
+	return inv, nil
+}
+
+// appendLine loads the stored invoice, lets add append a new line to it and
+// stores the recomputed invoice. Only the new line's figures are checked
+// against the client's.
+func (s *InvoiceService) appendLine(ctx context.Context, invoiceID uuid.UUID, add func(inv, client *db.Invoice)) (db.Invoice, error) {
+	inv, err := s.getDraft(ctx, invoiceID)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	if err := s.ensureStoredOpen(ctx, inv); err != nil {
+		return db.Invoice{}, err
+	}
+	inv.Items = slices.Clone(inv.Items)
+	inv.Taxes = slices.Clone(inv.Taxes)
+	inv.Discounts = slices.Clone(inv.Discounts)
+	client := db.Invoice{
+		Items: make([]db.InvoiceItem, len(inv.Items)),
+		Taxes: make([]db.InvoiceTax, len(inv.Taxes)),
+	}
+	add(&inv, &client)
+	computed, err := s.computeTotals(inv, client)
+	if err != nil {
+		return db.Invoice{}, err
+	}
+	saved, err := s.repo.AppendInvoiceLines(ctx, computed)
+	return saved, s.draftError(invoiceID, err)
 }
 
 // ---------- Invoice Items ----------
+
+// CreateInvoiceItem adds an item with its discounts and taxes, computing
+// the line amounts and the invoice's new totals.
 func (s *InvoiceService) CreateInvoiceItem(ctx context.Context, item db.InvoiceItem) (db.InvoiceItem, error) {
-	it, err := s.repo.CreateInvoiceItem(ctx, item)
+	inv, err := s.appendLine(ctx, item.InvoiceID, func(inv, client *db.Invoice) {
+		inv.Items = append(inv.Items, item)
+		client.Items = append(client.Items, item)
+	})
 	if err != nil {
-		return it, err
+		return db.InvoiceItem{}, err
 	}
+	it := inv.Items[len(inv.Items)-1]
 
 	if err := s.publisher.PublishInvoiceItemCreated(ctx, &it); err != nil {
 		fmt.Printf("Kafka publish error (invoice.item.created): %v\n", err)
@@ -102,11 +528,18 @@ func (s *InvoiceService) ListInvoiceItems(ctx context.Context, invoiceID uuid.UU
 }
 
 // ---------- Invoice Taxes & Discounts ----------
+
+// AddInvoiceTax adds an invoice-level tax, computing its amount from the
+// rate and the invoice's taxable value.
 func (s *InvoiceService) AddInvoiceTax(ctx context.Context, tax db.InvoiceTax) (db.InvoiceTax, error) {
-	t, err := s.repo.AddInvoiceTax(ctx, tax)
+	inv, err := s.appendLine(ctx, tax.InvoiceID, func(inv, client *db.Invoice) {
+		inv.Taxes = append(inv.Taxes, tax)
+		client.Taxes = append(client.Taxes, tax)
+	})
 	if err != nil {
-		return t, err
+		return db.InvoiceTax{}, err
 	}
+	t := inv.Taxes[len(inv.Taxes)-1]
 
 	if err := s.publisher.PublishInvoiceTaxAdded(ctx, &t); err != nil {
 		fmt.Printf("Kafka publish error (invoice.tax.added): %v\n", err)
@@ -114,11 +547,16 @@ func (s *InvoiceService) AddInvoiceTax(ctx context.Context, tax db.InvoiceTax) (
 	return t, nil
 }
 
+// AddInvoiceDiscount adds an invoice-level discount and recomputes the
+// invoice's totals, including its invoice-level taxes.
 func (s *InvoiceService) AddInvoiceDiscount(ctx context.Context, disc db.InvoiceDiscount) (db.InvoiceDiscount, error) {
-	d, err := s.repo.AddInvoiceDiscount(ctx, disc)
+	inv, err := s.appendLine(ctx, disc.InvoiceID, func(inv, _ *db.Invoice) {
+		inv.Discounts = append(inv.Discounts, disc)
+	})
 	if err != nil {
-		return d, err
+		return db.InvoiceDiscount{}, err
 	}
+	d := inv.Discounts[len(inv.Discounts)-1]
 
 	if err := s.publisher.PublishInvoiceDiscountAdded(ctx, &d); err != nil {
 		fmt.Printf("Kafka publish error (invoice.discount.added): %v\n", err)
@@ -0,0 +1,387 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/shopspring/decimal"
+)
+
+// Rounding modes for CurrencyRounding.
+const (
+	RoundHalfUp   = "HALF_UP"   // half away from zero
+	RoundHalfEven = "HALF_EVEN" // banker's rounding
+)
+
+// GST tax line names. Taxes with any other name count towards tax_total
+// but not the GST breakup.
+const (
+	TaxCGST = "CGST"
+	TaxSGST = "SGST"
+	TaxIGST = "IGST"
+)
+
+// CurrencyRounding is how invoice figures in one currency are rounded.
+// Scale is the number of decimal places every figure is rounded to, at most
+// 2 as amounts are stored as NUMERIC(18,2). CashIncrement, when set, rounds
+// the grand total to a multiple of itself (e.g. "1" or "0.05"); the
+// difference is kept as round_off. Client figures within Tolerance of the
+// computed ones are accepted; an empty Tolerance allows one unit of the last
+// decimal place.
+type CurrencyRounding struct {
+	Scale         int32
+	Mode          string
+	CashIncrement string
+	Tolerance     string
+}
+
+// DefaultCurrencyRounding rounds half up to two decimal places.
+var DefaultCurrencyRounding = CurrencyRounding{Scale: 2, Mode: RoundHalfUp}
+
+// TotalMismatch is a client-supplied figure that disagrees with the computed
+// one. Field is the figure's path, e.g. "grand_total" or
+// "items[0].taxes[1].amount".
+type TotalMismatch struct {
+	Field    string
+	Client   string
+	Computed string
+}
+
+// InvoiceTotalsError lists every client figure that disagrees with the
+// computed totals, or every line that cannot be computed. It wraps
+// ErrInvalidInput.
+type InvoiceTotalsError struct {
+	Mismatches []TotalMismatch
+}
+
+func (e *InvoiceTotalsError) Error() string {
+	parts := make([]string, len(e.Mismatches))
+	for i, m := range e.Mismatches {
+		if m.Computed == "" {
+			parts[i] = fmt.Sprintf("%s: %s", m.Field, m.Client)
+			continue
+		}
+		parts[i] = fmt.Sprintf("%s is %s, expected %s", m.Field, m.Client, m.Computed)
+	}
+	return "invoice totals do not match its lines: " + strings.Join(parts, "; ")
+}
+
+func (e *InvoiceTotalsError) Unwrap() error { return ErrInvalidInput }
+
+// InvoiceCalculator derives an invoice's figures from its lines:
+//
+//	line_subtotal = quantity × unit_price
+//	item tax      = (line_subtotal − item discounts) × rate%
+//	line_total    = line_subtotal − item discounts + item taxes
+//	subtotal      = Σ line_subtotal
+//	invoice tax   = (Σ line_subtotal − item discounts − invoice discounts) × rate%
+//	grand_total   = Σ line_total − invoice discounts + invoice taxes ± round_off
+//
+// Every figure is rounded as it is computed, so the totals always add up to
+// the rounded lines they are made of.
+type InvoiceCalculator struct {
+	defaultCurrency string
+	rules           map[string]CurrencyRounding
+}
+
+// NewInvoiceCalculator builds a calculator. Invoices without a currency are
+// in defaultCurrency; currencies without a rule use DefaultCurrencyRounding.
+func NewInvoiceCalculator(defaultCurrency string, rules map[string]CurrencyRounding) *InvoiceCalculator {
+	upper := make(map[string]CurrencyRounding, len(rules))
+	for cur, r := range rules {
+		upper[strings.ToUpper(cur)] = r
+	}
+	return &InvoiceCalculator{defaultCurrency: strings.ToUpper(defaultCurrency), rules: upper}
+}
+
+// Rounding returns the rule for currency.
+func (c *InvoiceCalculator) Rounding(currency string) CurrencyRounding {
+	if r, ok := c.rules[strings.ToUpper(currency)]; ok {
+		return r
+	}
+	return DefaultCurrencyRounding
+}
+
+// Compute fills in every derived figure of inv and its lines, replacing what
+// the client sent. Lines that cannot be computed are reported as an
+// *InvoiceTotalsError and inv is left unchanged.
+func (c *InvoiceCalculator) Compute(inv *db.Invoice) error {
+	cur := strings.ToUpper(strings.TrimSpace(inv.CurrencyCode))
+	if cur == "" {
+		cur = c.defaultCurrency
+	}
+	rule := c.Rounding(cur)
+	r, err := newRounder(rule)
+	if err != nil {
+		return fmt.Errorf("rounding for %s: %w", cur, err)
+	}
+
+	out := *inv
+	out.CurrencyCode = cur
+	out.Items = make([]db.InvoiceItem, len(inv.Items))
+	out.Taxes = make([]db.InvoiceTax, len(inv.Taxes))
+	out.Discounts = make([]db.InvoiceDiscount, len(inv.Discounts))
+	errs := &InvoiceTotalsError{}
+	bad := func(field, reason string) {
+		errs.Mismatches = append(errs.Mismatches, TotalMismatch{Field: field, Client: reason})
+	}
+
+	var subtotal, itemDiscounts, lineTotals, taxTotal decimal.Decimal
+	gst := make(map[string]decimal.Decimal)
+	for i, item := range inv.Items {
+		path := fmt.Sprintf("items[%d]", i)
+		if item.Quantity <= 0 {
+			bad(path+".quantity", "must be positive")
+		}
+		price, ok := parseAmount(item.UnitPrice)
+		if !ok || price.IsNegative() {
+			bad(path+".unit_price", fmt.Sprintf("%q is not a non-negative amount", item.UnitPrice))
+		}
+		lineSubtotal := r.round(price.Mul(decimal.NewFromInt32(item.Quantity)))
+
+		discounts := decimal.Zero
+		item.Discounts = append([]db.InvoiceItemDiscount(nil), item.Discounts...)
+		for j, d := range item.Discounts {
+			amt, ok := parseAmount(d.Amount)
+			if !ok || amt.IsNegative() {
+				bad(fmt.Sprintf("%s.discounts[%d].amount", path, j), fmt.Sprintf("%q is not a non-negative amount", d.Amount))
+				continue
+			}
+			amt = r.round(amt)
+			item.Discounts[j].Amount = r.format(amt)
+			discounts = discounts.Add(amt)
+		}
+		if discounts.GreaterThan(lineSubtotal) {
+			bad(path+".discounts", "exceed the line subtotal")
+		}
+		taxable := lineSubtotal.Sub(discounts)
+
+		taxes := decimal.Zero
+		item.Taxes = append([]db.InvoiceItemTax(nil), item.Taxes...)
+		for j, t := range item.Taxes {
+			tpath := fmt.Sprintf("%s.taxes[%d]", path, j)
+			rate, ok := parseRate(t.Rate)
+			if !ok {
+				bad(tpath+".rate", fmt.Sprintf("%q is not a non-negative rate", t.Rate))
+				continue
+			}
+			amt := r.round(taxable.Mul(rate).Div(hundred))
+			item.Taxes[j].Name = normalizeTaxName(t.Name)
+			item.Taxes[j].Rate = rate.String()
+			item.Taxes[j].Amount = r.format(amt)
+			taxes = taxes.Add(amt)
+			addGST(gst, item.Taxes[j].Name, amt)
+		}
+		lineTotal := taxable.Add(taxes)
+		item.LineSubtotal = r.format(lineSubtotal)
+		item.LineTotal = r.format(lineTotal)
+		out.Items[i] = item
+
+		subtotal = subtotal.Add(lineSubtotal)
+		itemDiscounts = itemDiscounts.Add(discounts)
+		lineTotals = lineTotals.Add(lineTotal)
+		taxTotal = taxTotal.Add(taxes)
+	}
+
+	invoiceDiscounts := decimal.Zero
+	for i, d := range inv.Discounts {
+		amt, ok := parseAmount(d.Amount)
+		if !ok || amt.IsNegative() {
+			bad(fmt.Sprintf("discounts[%d].amount", i), fmt.Sprintf("%q is not a non-negative amount", d.Amount))
+			continue
+		}
+		amt = r.round(amt)
+		d.Amount = r.format(amt)
+		out.Discounts[i] = d
+		invoiceDiscounts = invoiceDiscounts.Add(amt)
+	}
+	taxable := subtotal.Sub(itemDiscounts).Sub(invoiceDiscounts)
+	if taxable.IsNegative() {
+		bad("discounts", "exceed the invoice subtotal")
+	}
+
+	invoiceTaxes := decimal.Zero
+	for i, t := range inv.Taxes {
+		path := fmt.Sprintf("taxes[%d]", i)
+		rate, ok := parseRate(t.Rate)
+		if !ok {
+			bad(path+".rate", fmt.Sprintf("%q is not a non-negative rate", t.Rate))
+			continue
+		}
+		amt := r.round(taxable.Mul(rate).Div(hundred))
+		t.Name = normalizeTaxName(t.Name)
+		t.Rate = rate.String()
+		t.Amount = r.format(amt)
+		out.Taxes[i] = t
+		invoiceTaxes = invoiceTaxes.Add(amt)
+		addGST(gst, t.Name, amt)
+	}
+	taxTotal = taxTotal.Add(invoiceTaxes)
+
+	total := lineTotals.Sub(invoiceDiscounts).Add(invoiceTaxes)
+	grand := total
+	if rule.CashIncrement != "" {
+		inc, err := decimal.NewFromString(rule.CashIncrement)
+		if err != nil || !inc.IsPositive() {
+			return fmt.Errorf("rounding for %s: cash increment %q is not a positive amount", cur, rule.CashIncrement)
+		}
+		grand = r.round(r.roundTo(total.Div(inc), 0).Mul(inc))
+	}
+
+	totalGST := gst[TaxCGST].Add(gst[TaxSGST]).Add(gst[TaxIGST])
+	gstRate := decimal.Zero
+	if taxable.IsPositive() {
+		gstRate = totalGST.Mul(hundred).DivRound(taxable, 3)
+	}
+	if len(errs.Mismatches) > 0 {
+		return errs
+	}
+
+	out.Subtotal = r.format(subtotal)
+	out.DiscountTotal = r.format(itemDiscounts.Add(invoiceDiscounts))
+	out.TaxTotal = r.format(taxTotal)
+	out.GstRate = optionalString(gstRate.StringFixed(3))
+	out.GstCgst = optionalString(r.format(gst[TaxCGST]))
+	out.GstSgst = optionalString(r.format(gst[TaxSGST]))
+	out.GstIgst = optionalString(r.format(gst[TaxIGST]))
+	out.GstBreakup = &db.GstBreakup{
+		InvoiceID:     inv.ID,
+		TaxableAmount: r.format(taxable),
+		Cgst:          out.GstCgst,
+		Sgst:          out.GstSgst,
+		Igst:          out.GstIgst,
+		TotalGst:      optionalString(r.format(totalGST)),
+	}
+	out.RoundOff = r.format(grand.Sub(total))
+	out.GrandTotal = r.format(grand)
+	*inv = out
+	return nil
+}
+
+// Verify compares the figures the client sent with computed, the result of
+// Compute on the same invoice. Lines are matched by position and figures left
+// empty are not checked. Differences beyond the currency's tolerance are
+// reported as an *InvoiceTotalsError.
+func (c *InvoiceCalculator) Verify(client, computed db.Invoice) error {
+	r, err := newRounder(c.Rounding(computed.CurrencyCode))
+	if err != nil {
+		return fmt.Errorf("rounding for %s: %w", computed.CurrencyCode, err)
+	}
+	errs := &InvoiceTotalsError{}
+	for i, item := range client.Items {
+		if i >= len(computed.Items) {
+			break
+		}
+		want := computed.Items[i]
+		path := fmt.Sprintf("items[%d]", i)
+		r.compare(errs, path+".line_subtotal", item.LineSubtotal, want.LineSubtotal)
+		for j, t := range item.Taxes {
+			if j < len(want.Taxes) {
+				r.compare(errs, fmt.Sprintf("%s.taxes[%d].amount", path, j), t.Amount, want.Taxes[j].Amount)
+			}
+		}
+		r.compare(errs, path+".line_total", item.LineTotal, want.LineTotal)
+	}
+	for i, t := range client.Taxes {
+		if i < len(computed.Taxes) {
+			r.compare(errs, fmt.Sprintf("taxes[%d].amount", i), t.Amount, computed.Taxes[i].Amount)
+		}
+	}
+	r.compare(errs, "subtotal", client.Subtotal, computed.Subtotal)
+	r.compare(errs, "discount_total", client.DiscountTotal, computed.DiscountTotal)
+	r.compare(errs, "tax_total", client.TaxTotal, computed.TaxTotal)
+	r.compare(errs, "gst_cgst", client.GstCgst.String, computed.GstCgst.String)
+	r.compare(errs, "gst_sgst", client.GstSgst.String, computed.GstSgst.String)
+	r.compare(errs, "gst_igst", client.GstIgst.String, computed.GstIgst.String)
+	r.compare(errs, "round_off", client.RoundOff, computed.RoundOff)
+	r.compare(errs, "grand_total", client.GrandTotal, computed.GrandTotal)
+	if len(errs.Mismatches) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var hundred = decimal.NewFromInt(100)
+
+type rounder struct {
+	rule      CurrencyRounding
+	tolerance decimal.Decimal
+}
+
+func newRounder(rule CurrencyRounding) (rounder, error) {
+	if rule.Scale < 0 || rule.Scale > amountScale {
+		return rounder{}, fmt.Errorf("scale %d is outside 0-%d", rule.Scale, amountScale)
+	}
+	switch rule.Mode {
+	case "", RoundHalfUp, RoundHalfEven:
+	default:
+		return rounder{}, fmt.Errorf("unknown rounding mode %q", rule.Mode)
+	}
+	tol := decimal.New(1, -rule.Scale)
+	if rule.Tolerance != "" {
+		t, err := decimal.NewFromString(rule.Tolerance)
+		if err != nil || t.IsNegative() {
+			return rounder{}, fmt.Errorf("tolerance %q is not a non-negative amount", rule.Tolerance)
+		}
+		tol = t
+	}
+	return rounder{rule: rule, tolerance: tol}, nil
+}
+
+func (r rounder) round(d decimal.Decimal) decimal.Decimal {
+	return r.roundTo(d, r.rule.Scale)
+}
+
+func (r rounder) roundTo(d decimal.Decimal, places int32) decimal.Decimal {
+	if r.rule.Mode == RoundHalfEven {
+		return d.RoundBank(places)
+	}
+	return d.Round(places)
+}
+
+// format renders d at the storage scale, whatever the currency's scale.
+func (r rounder) format(d decimal.Decimal) string {
+	return d.StringFixed(amountScale)
+}
+
+// compare records a mismatch when the client sent a figure that differs from
+// the computed one by more than the tolerance. Empty figures were not sent.
+func (r rounder) compare(errs *InvoiceTotalsError, field, client, computed string) {
+	client = strings.TrimSpace(client)
+	if client == "" {
+		return
+	}
+	want, _ := parseAmount(computed)
+	v, ok := parseAmount(client)
+	if !ok || v.Sub(want).Abs().GreaterThan(r.tolerance) {
+		errs.Mismatches = append(errs.Mismatches, TotalMismatch{Field: field, Client: client, Computed: computed})
+	}
+}
+
+func parseAmount(s string) (decimal.Decimal, bool) {
+	d, err := decimal.NewFromString(strings.TrimSpace(s))
+	return d, err == nil
+}
+
+// parseRate accepts a percentage with or without a trailing "%".
+func parseRate(s string) (decimal.Decimal, bool) {
+	d, ok := parseAmount(strings.TrimSuffix(strings.TrimSpace(s), "%"))
+	return d, ok && !d.IsNegative()
+}
+
+func normalizeTaxName(name string) string {
+	n := strings.ToUpper(strings.TrimSpace(name))
+	switch n {
+	case TaxCGST, TaxSGST, TaxIGST:
+		return n
+	}
+	return strings.TrimSpace(name)
+}
+
+func addGST(gst map[string]decimal.Decimal, name string, amt decimal.Decimal) {
+	switch name {
+	case TaxCGST, TaxSGST, TaxIGST:
+		gst[name] = gst[name].Add(amt)
+	}
+}
@@ -0,0 +1,352 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SourceImport is the source type of imported journals whose file has no
+// source_type column. Imported journals are not manual, so they may post to
+// control accounts when migrating sub-ledger history.
+const SourceImport = "IMPORT"
+
+// Import file columns, matched case-insensitively against the header row.
+// Each row is one line; amounts come either from debit/credit or from
+// side/amount, in the row's currency (the functional currency when blank)
+// at its exchange_rate (the latest quoted rate when blank). memo and
+// source_type apply to the whole entry; the first row of the reference that
+// sets them wins.
+const (
+	ImportColReference    = "reference"
+	ImportColDate         = "date"
+	ImportColAccountCode  = "account_code"
+	ImportColDebit        = "debit"
+	ImportColCredit       = "credit"
+	ImportColSide         = "side"
+	ImportColAmount       = "amount"
+	ImportColDescription  = "description"
+	ImportColCostCenter   = "cost_center_id"
+	ImportColMemo         = "memo"
+	ImportColSourceType   = "source_type"
+	ImportColCurrency     = "currency"
+	ImportColExchangeRate = "exchange_rate"
+)
+
+// excelEpoch is day zero of spreadsheet serial dates.
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// JournalImportService creates journals in bulk from spreadsheet rows.
+type JournalImportService struct {
+	journals *JournalService
+}
+
+var _ ports.JournalImportService = (*JournalImportService)(nil)
+
+func NewJournalImportService(journals *JournalService) *JournalImportService {
+	return &JournalImportService{journals: journals}
+}
+
+// importEntry is one journal being assembled from the rows sharing a
+// reference. rows[i] is the file row of journal.Lines[i].
+type importEntry struct {
+	journal   *db.JournalEntry
+	firstRow  int
+	dateRow   int // first row with a valid date; 0 until there is one
+	rows      []int
+	sourceSet bool
+	broken    bool
+}
+
+// Import groups rows into journals by reference and validates each one as
+// Create would. Nothing is written when any row has an error: the file is
+// imported all or nothing, in one transaction.
+func (s *JournalImportService) Import(ctx context.Context, rows [][]string, opts ports.JournalImportOptions) (*ports.JournalImportReport, error) {
+	report := &ports.JournalImportReport{}
+	fail := func(row int, ref, field, msg string) {
+		report.Errors = append(report.Errors, ports.JournalImportError{Row: row, Reference: ref, Field: field, Message: msg})
+	}
+	if len(rows) == 0 {
+		fail(1, "", "", "the file is empty")
+		return report, nil
+	}
+	cols, err := importColumns(rows[0])
+	if err != nil {
+		fail(1, "", "", err.Error())
+		return report, nil
+	}
+
+	all, err := s.journals.accounts.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byCode := make(map[string]*db.Account, len(all))
+	accounts := make(map[uuid.UUID]*db.Account, len(all))
+	for _, a := range all {
+		byCode[strings.ToUpper(a.Code)] = a
+		accounts[a.ID] = a
+	}
+
+	status := JournalDraft
+	if opts.Post {
+		status = JournalPosted
+	}
+
+	var entries []*importEntry
+	byRef := make(map[string]*importEntry)
+	for i, row := range rows[1:] {
+		rowNo := i + 2
+		get := func(col string) string {
+			idx, ok := cols[col]
+			if !ok || idx >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[idx])
+		}
+		if blankRow(row) {
+			continue
+		}
+
+		ref := get(ImportColReference)
+		if ref == "" {
+			fail(rowNo, "", ImportColReference, "reference is required")
+			continue
+		}
+		date, err := parseImportDate(get(ImportColDate))
+		if err != nil {
+			fail(rowNo, ref, ImportColDate, err.Error())
+		}
+
+		e := byRef[ref]
+		if e == nil {
+			e = &importEntry{firstRow: rowNo, journal: &db.JournalEntry{
+				Reference:      sql.NullString{String: ref, Valid: true},
+				SourceType:     sql.NullString{String: SourceImport, Valid: true},
+				CreatedBy:      optionalString(opts.ImportedBy),
+				UpdatedBy:      optionalString(opts.ImportedBy),
+				OrganizationID: optionalString(opts.OrganizationID),
+				Status:         status,
+			}}
+			byRef[ref] = e
+			entries = append(entries, e)
+		}
+		report.Lines++
+		switch {
+		case err != nil:
+			e.broken = true
+		case e.dateRow == 0:
+			e.journal.JournalDate, e.dateRow = date, rowNo
+		case !date.Equal(e.journal.JournalDate):
+			fail(rowNo, ref, ImportColDate, fmt.Sprintf("date differs from row %d of the same reference", e.dateRow))
+			e.broken = true
+		}
+		if memo := get(ImportColMemo); memo != "" && !e.journal.Memo.Valid {
+			e.journal.Memo = sql.NullString{String: memo, Valid: true}
+		}
+		if src := get(ImportColSourceType); src != "" && !e.sourceSet {
+			e.journal.SourceType = sql.NullString{String: strings.ToUpper(src), Valid: true}
+			e.sourceSet = true
+		}
+
+		line, field, err := importLine(get, cols, byCode)
+		if err != nil {
+			fail(rowNo, ref, field, err.Error())
+			e.broken = true
+			continue
+		}
+		e.journal.Lines = append(e.journal.Lines, line)
+		e.rows = append(e.rows, rowNo)
+	}
+	report.Entries = len(entries)
+
+	type periodKey struct {
+		date   time.Time
+		source string
+	}
+	periods := make(map[periodKey]error)
+	for _, e := range entries {
+		if e.broken {
+			continue
+		}
+		j := e.journal
+		err := s.journals.validateJournalWith(ctx, j, accounts)
+		if err == nil {
+			key := periodKey{j.JournalDate, j.SourceType.String}
+			var seen bool
+			if err, seen = periods[key]; !seen {
+				err = s.journals.ensureOpen(ctx, j)
+				periods[key] = err
+			}
+		}
+
+		var verr *JournalValidationError
+		switch {
+		case err == nil:
+		case errors.As(err, &verr):
+			for _, v := range verr.Violations {
+				row, field := e.firstRow, v.Field
+				if v.Line >= 0 {
+					row = e.rows[v.Line]
+				}
+				switch field {
+				case "account_id":
+					field = ImportColAccountCode
+				case "currency_code":
+					field = ImportColCurrency
+				}
+				fail(row, j.Reference.String, field, v.Reason)
+			}
+		case errors.Is(err, ErrPeriodClosed), errors.Is(err, ErrInvalidInput):
+			fail(e.firstRow, j.Reference.String, ImportColDate, err.Error())
+		default:
+			return nil, err
+		}
+	}
+
+	slices.SortStableFunc(report.Errors, func(a, b ports.JournalImportError) int { return a.Row - b.Row })
+	if len(report.Errors) > 0 || opts.DryRun {
+		return report, nil
+	}
+
+	journals := make([]*db.JournalEntry, len(entries))
+	for i, e := range entries {
+		journals[i] = e.journal
+	}
+	if err := s.journals.repo.BulkCreate(ctx, journals); err != nil {
+		return nil, err
+	}
+	report.Committed = true
+	for _, j := range journals {
+		s.journals.publishCreated(j)
+	}
+	return report, nil
+}
+
+// importColumns maps the known header names to their column index.
+func importColumns(header []string) (map[string]int, error) {
+	cols := make(map[string]int)
+	for i, h := range header {
+		name := strings.ToLower(strings.TrimSpace(h))
+		name = strings.ReplaceAll(name, " ", "_")
+		if name == "" {
+			continue
+		}
+		if _, dup := cols[name]; dup {
+			return nil, fmt.Errorf("column %q appears more than once", name)
+		}
+		cols[name] = i
+	}
+
+	var missing []string
+	for _, c := range []string{ImportColReference, ImportColDate, ImportColAccountCode} {
+		if _, ok := cols[c]; !ok {
+			missing = append(missing, c)
+		}
+	}
+	_, debit := cols[ImportColDebit]
+	_, credit := cols[ImportColCredit]
+	_, side := cols[ImportColSide]
+	_, amount := cols[ImportColAmount]
+	if !(debit || credit) && !(side && amount) {
+		missing = append(missing, "debit/credit or side/amount")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing columns: %s", strings.Join(missing, ", "))
+	}
+	return cols, nil
+}
+
+// importLine builds the journal line for a row. On error it also returns the
+// column at fault.
+func importLine(get func(string) string, cols map[string]int, byCode map[string]*db.Account) (db.JournalLine, string, error) {
+	var line db.JournalLine
+
+	code := get(ImportColAccountCode)
+	if code == "" {
+		return line, ImportColAccountCode, errors.New("account_code is required")
+	}
+	acc := byCode[strings.ToUpper(code)]
+	if acc == nil {
+		return line, ImportColAccountCode, fmt.Errorf("account %s does not exist", code)
+	}
+	line.AccountID = acc.ID
+
+	if _, ok := cols[ImportColSide]; ok && get(ImportColSide) != "" {
+		line.Side = strings.ToUpper(get(ImportColSide))
+		line.Amount = importAmount(get(ImportColAmount))
+	} else {
+		debit, credit := importAmount(get(ImportColDebit)), importAmount(get(ImportColCredit))
+		switch {
+		case debit != "" && credit != "":
+			return line, ImportColDebit, errors.New("only one of debit and credit may be set")
+		case debit != "":
+			line.Side, line.Amount = SideDebit, debit
+		case credit != "":
+			line.Side, line.Amount = SideCredit, credit
+		default:
+			return line, ImportColDebit, errors.New("debit or credit is required")
+		}
+	}
+
+	if cc := get(ImportColCostCenter); cc != "" {
+		id, err := uuid.Parse(cc)
+		if err != nil {
+			return line, ImportColCostCenter, fmt.Errorf("%q is not a valid id", cc)
+		}
+		line.CostCenterID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+	line.Description = optionalString(get(ImportColDescription))
+	line.CurrencyCode = get(ImportColCurrency)
+	line.ExchangeRate = get(ImportColExchangeRate)
+	return line, "", nil
+}
+
+// importAmount strips thousands separators and treats zero as empty, since
+// exports often fill the unused debit or credit column with 0.
+func importAmount(s string) string {
+	s = strings.ReplaceAll(s, ",", "")
+	if d, err := decimal.NewFromString(s); err == nil && d.IsZero() {
+		return ""
+	}
+	return s
+}
+
+// parseImportDate accepts ISO dates, RFC 3339 timestamps and spreadsheet
+// serial day numbers.
+func parseImportDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, errors.New("date is required")
+	}
+	for _, layout := range []string{time.DateOnly, time.RFC3339} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return dateOnly(t), nil
+		}
+	}
+	if serial, err := strconv.ParseFloat(s, 64); err == nil && serial >= 1 {
+		return excelEpoch.AddDate(0, 0, int(serial)), nil
+	}
+	return time.Time{}, fmt.Errorf("%q is not a date (want YYYY-MM-DD)", s)
+}
+
+func optionalString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func blankRow(row []string) bool {
+	for _, v := range row {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
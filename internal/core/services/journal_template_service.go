@@ -0,0 +1,312 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SourceRecurring is the source type of journals generated from a template;
+// the source id is the template id.
+const SourceRecurring = "RECURRING"
+
+// Template frequencies.
+const (
+	FrequencyMonthly   = "MONTHLY"
+	FrequencyQuarterly = "QUARTERLY"
+	FrequencyYearly    = "YEARLY"
+)
+
+var frequencyMonths = map[string]int{
+	FrequencyMonthly:   1,
+	FrequencyQuarterly: 3,
+	FrequencyYearly:    12,
+}
+
+// RecurringRunError lists the templates a Generate run had to stop early.
+// The occurrences generated before each failure are kept.
+type RecurringRunError struct {
+	Failures []error
+}
+
+func (e *RecurringRunError) Error() string {
+	return errors.Join(e.Failures...).Error()
+}
+
+func (e *RecurringRunError) Unwrap() []error { return e.Failures }
+
+// JournalTemplateService manages recurring journal templates and generates
+// the journals that fall due from them.
+type JournalTemplateService struct {
+	repo     ports.JournalTemplateRepository
+	journals *JournalService
+}
+
+var _ ports.JournalTemplateService = (*JournalTemplateService)(nil)
+
+func NewJournalTemplateService(repo ports.JournalTemplateRepository, journals *JournalService) *JournalTemplateService {
+	return &JournalTemplateService{repo: repo, journals: journals}
+}
+
+func (s *JournalTemplateService) Create(ctx context.Context, t *ports.JournalTemplate) (*ports.JournalTemplate, error) {
+	if err := s.validate(ctx, t); err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, t)
+}
+
+func (s *JournalTemplateService) Get(ctx context.Context, id uuid.UUID) (*ports.JournalTemplate, error) {
+	t, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: journal template %s", ErrNotFound, id)
+	}
+	return t, err
+}
+
+// Update replaces the template's schedule and lines. Occurrences already
+// generated are not regenerated, even if the schedule moves over them.
+func (s *JournalTemplateService) Update(ctx context.Context, t *ports.JournalTemplate) (*ports.JournalTemplate, error) {
+	current, err := s.Get(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	t.OrganizationID = current.OrganizationID
+	if err := s.validate(ctx, t); err != nil {
+		return nil, err
+	}
+	return s.repo.Update(ctx, t)
+}
+
+func (s *JournalTemplateService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.Get(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *JournalTemplateService) List(ctx context.Context, limit, offset int32) ([]*ports.JournalTemplate, error) {
+	return s.repo.List(ctx, limit, offset)
+}
+
+// Generate creates the journals for every occurrence due on or before asOf,
+// as drafts or posted per template. Occurrences generated by an earlier run
+// are skipped. A template whose next occurrence cannot be created, e.g.
+// because its period is closed or an account was archived, stops at that
+// occurrence; the others still run and the failures are returned together as
+// a *RecurringRunError.
+func (s *JournalTemplateService) Generate(ctx context.Context, asOf time.Time) ([]*db.JournalEntry, error) {
+	templates, err := s.repo.ListDue(ctx, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	var generated []*db.JournalEntry
+	var errs []error
+	for _, t := range templates {
+		for _, date := range occurrences(t, asOf) {
+			j, err := s.occurrence(t, date)
+			if err == nil {
+				err = s.journals.prepareCreate(ctx, j)
+			}
+			var created *db.JournalEntry
+			if err == nil {
+				created, err = s.repo.CreateOccurrence(ctx, t.ID, date, j)
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("template %q on %s: %w", t.Name, date.Format(time.DateOnly), err))
+				break
+			}
+			if created == nil {
+				continue
+			}
+			s.journals.publishCreated(created)
+			generated = append(generated, created)
+		}
+	}
+	if len(errs) > 0 {
+		return generated, &RecurringRunError{Failures: errs}
+	}
+	return generated, nil
+}
+
+// Run generates due journals immediately and then on every tick until ctx is
+// cancelled.
+func (s *JournalTemplateService) Run(ctx context.Context, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		generated, err := s.Generate(ctx, time.Now())
+		if err != nil {
+			log.Printf("recurring journals: %v", err)
+		}
+		if len(generated) > 0 {
+			log.Printf("recurring journals: generated %d entries", len(generated))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *JournalTemplateService) validate(ctx context.Context, t *ports.JournalTemplate) error {
+	switch {
+	case t.Name == "":
+		return fmt.Errorf("%w: name is required", ErrInvalidInput)
+	case frequencyMonths[t.Frequency] == 0:
+		return fmt.Errorf("%w: frequency must be %s, %s or %s", ErrInvalidInput, FrequencyMonthly, FrequencyQuarterly, FrequencyYearly)
+	case t.DayOfMonth < 1 || t.DayOfMonth > 31:
+		return fmt.Errorf("%w: day of month must be between 1 and 31", ErrInvalidInput)
+	case t.StartDate.IsZero():
+		return fmt.Errorf("%w: start date is required", ErrInvalidInput)
+	case t.EndDate.Valid && t.EndDate.Time.Before(t.StartDate):
+		return fmt.Errorf("%w: end date is before start date", ErrInvalidInput)
+	}
+
+	if t.AdjustmentPercent == "" {
+		t.AdjustmentPercent = "0"
+	}
+	pct, err := decimal.NewFromString(t.AdjustmentPercent)
+	if err != nil || pct.LessThanOrEqual(decimal.NewFromInt(-100)) {
+		return fmt.Errorf("%w: adjustment percent %q must be a number above -100", ErrInvalidInput, t.AdjustmentPercent)
+	}
+
+	// The lines must make a valid journal on their own.
+	return s.journals.validateJournal(ctx, &db.JournalEntry{
+		SourceType: sql.NullString{String: SourceRecurring, Valid: true},
+		Lines:      templateJournalLines(t.Lines),
+	})
+}
+
+// occurrence builds the journal for t on date, with line amounts escalated by
+// the template's adjustment.
+func (s *JournalTemplateService) occurrence(t *ports.JournalTemplate, date time.Time) (*db.JournalEntry, error) {
+	lines, err := adjustedLines(t, date)
+	if err != nil {
+		return nil, err
+	}
+	status := JournalDraft
+	if t.AutoPost {
+		status = JournalPosted
+	}
+	reference := t.Reference
+	if !reference.Valid {
+		reference = sql.NullString{String: t.Name, Valid: true}
+	}
+	return &db.JournalEntry{
+		JournalDate:    date,
+		Reference:      reference,
+		Memo:           t.Memo,
+		SourceType:     sql.NullString{String: SourceRecurring, Valid: true},
+		SourceID:       sql.NullString{String: t.ID.String(), Valid: true},
+		CreatedBy:      t.UpdatedBy,
+		UpdatedBy:      t.UpdatedBy,
+		OrganizationID: t.OrganizationID,
+		Status:         status,
+		Lines:          lines,
+	}, nil
+}
+
+// occurrences lists t's scheduled dates after its last generated occurrence,
+// up to asOf or the end date, whichever is earlier.
+func occurrences(t *ports.JournalTemplate, asOf time.Time) []time.Time {
+	step := frequencyMonths[t.Frequency]
+	if step == 0 {
+		return nil
+	}
+	start := dateOnly(t.StartDate)
+	limit := dateOnly(asOf)
+	if t.EndDate.Valid && t.EndDate.Time.Before(limit) {
+		limit = dateOnly(t.EndDate.Time)
+	}
+
+	var dates []time.Time
+	for i := 0; ; i++ {
+		month := time.Date(start.Year(), start.Month()+time.Month(i*step), 1, 0, 0, 0, 0, time.UTC)
+		if month.After(limit) {
+			return dates
+		}
+		day := min(int(t.DayOfMonth), month.AddDate(0, 1, -1).Day())
+		d := month.AddDate(0, 0, day-1)
+		if d.Before(start) || d.After(limit) {
+			continue
+		}
+		if t.LastOccurrence.Valid && !d.After(dateOnly(t.LastOccurrence.Time)) {
+			continue
+		}
+		dates = append(dates, d)
+	}
+}
+
+// adjustedLines scales each line by the adjustment compounded over the full
+// years between the start date and date. Rounding differences are settled on
+// the last credit line so the entry still balances.
+func adjustedLines(t *ports.JournalTemplate, date time.Time) ([]db.JournalLine, error) {
+	lines := templateJournalLines(t.Lines)
+	pct, err := decimal.NewFromString(t.AdjustmentPercent)
+	if err != nil || pct.IsZero() {
+		return lines, nil
+	}
+
+	years := date.Year() - t.StartDate.Year()
+	if date.Before(t.StartDate.AddDate(years, 0, 0)) {
+		years--
+	}
+	if years <= 0 {
+		return lines, nil
+	}
+	rate := decimal.NewFromInt(1).Add(pct.Div(decimal.NewFromInt(100)))
+	factor := decimal.NewFromInt(1)
+	for range years {
+		factor = factor.Mul(rate)
+	}
+
+	debits, credits, lastCredit := decimal.Zero, decimal.Zero, -1
+	for i := range lines {
+		amount, err := decimal.NewFromString(lines[i].Amount)
+		if err != nil {
+			return nil, fmt.Errorf("line %d amount: %w", i+1, err)
+		}
+		amount = amount.Mul(factor).Round(amountScale)
+		lines[i].Amount = amount.StringFixed(amountScale)
+		if lines[i].Side == SideDebit {
+			debits = debits.Add(amount)
+		} else {
+			credits = credits.Add(amount)
+			lastCredit = i
+		}
+	}
+	if diff := debits.Sub(credits); !diff.IsZero() && lastCredit >= 0 {
+		amount, _ := decimal.NewFromString(lines[lastCredit].Amount)
+		lines[lastCredit].Amount = amount.Add(diff).StringFixed(amountScale)
+	}
+	return lines, nil
+}
+
+func templateJournalLines(in []db.JournalTemplateLine) []db.JournalLine {
+	out := make([]db.JournalLine, len(in))
+	for i, l := range in {
+		out[i] = db.JournalLine{
+			AccountID:    l.AccountID,
+			Side:         l.Side,
+			Amount:       l.Amount,
+			CostCenterID: l.CostCenterID,
+			Description:  l.Description,
+		}
+	}
+	return out
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
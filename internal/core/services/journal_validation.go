@@ -0,0 +1,284 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	SideDebit  = "DEBIT"
+	SideCredit = "CREDIT"
+)
+
+// amountScale matches the NUMERIC(18,2) columns journal amounts are stored in.
+const amountScale = 2
+
+// LineViolation is a single broken rule on a journal entry. Line is the
+// zero-based index into JournalEntry.Lines, or -1 when the rule applies to the
+// entry as a whole (e.g. the entry does not balance).
+type LineViolation struct {
+	Line   int
+	Field  string
+	Reason string
+}
+
+// Path returns the location of the violation relative to the journal entry,
+// e.g. "lines[2].amount" or "lines".
+func (v LineViolation) Path() string {
+	if v.Line < 0 {
+		return v.Field
+	}
+	return fmt.Sprintf("lines[%d].%s", v.Line, v.Field)
+}
+
+// JournalValidationError is returned when a journal entry breaks double-entry
+// or account rules. It unwraps to ErrInvalidInput.
+type JournalValidationError struct {
+	Violations []LineViolation
+}
+
+func (e *JournalValidationError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = v.Path() + ": " + v.Reason
+	}
+	return "invalid journal entry: " + strings.Join(parts, "; ")
+}
+
+func (e *JournalValidationError) Unwrap() error { return ErrInvalidInput }
+
+func (e *JournalValidationError) add(line int, field, reason string) {
+	e.Violations = append(e.Violations, LineViolation{Line: line, Field: field, Reason: reason})
+}
+
+// IsManualJournalSource reports whether a journal with the given source type
+// was keyed in by a user, directly or through a recurring template, rather
+// than posted by a sub-ledger. Only manual journals are subject to the
+// account's allow_manual_journal flag.
+func IsManualJournalSource(sourceType string) bool {
+	switch strings.ToUpper(strings.TrimSpace(sourceType)) {
+	case "", "MANUAL", "ADJUSTMENT", SourceRecurring:
+		return true
+	default:
+		return false
+	}
+}
+
+// validateJournal converts the entry's lines into the functional currency and
+// checks that the entry has at least two lines, that every line has a valid
+// side and a positive amount on an active account, and that total debits
+// equal total credits exactly in the functional currency.
+func (s *JournalService) validateJournal(ctx context.Context, j *db.JournalEntry) error {
+	return s.validateJournalWith(ctx, j, make(map[uuid.UUID]*db.Account))
+}
+
+// validateJournalWith is validateJournal with an account cache shared across
+// entries, so a batch loads each account once. Accounts missing from the
+// cache are loaded into it; a nil value records that the account does not
+// exist.
+func (s *JournalService) validateJournalWith(ctx context.Context, j *db.JournalEntry, accounts map[uuid.UUID]*db.Account) error {
+	verr := &JournalValidationError{}
+	if len(j.Lines) < 2 {
+		verr.add(-1, "lines", "a journal entry needs at least two lines")
+	}
+
+	converted, err := s.convertLines(ctx, j, verr)
+	if err != nil {
+		return err
+	}
+
+	manual := IsManualJournalSource(j.SourceType.String)
+	debits, credits := decimal.Zero, decimal.Zero
+
+	for i, l := range j.Lines {
+		// The amount as entered is checked; the balance is struck on the
+		// functional-currency amount derived from it.
+		entered, err := decimal.NewFromString(l.TransactionAmount)
+		switch {
+		case err != nil:
+			verr.add(i, "amount", fmt.Sprintf("%q is not a decimal amount", l.TransactionAmount))
+		case !entered.IsPositive():
+			verr.add(i, "amount", "amount must be greater than zero")
+		case !entered.Equal(entered.Truncate(amountScale)):
+			verr.add(i, "amount", fmt.Sprintf("amount has more than %d decimal places", amountScale))
+		}
+		amount, aerr := decimal.NewFromString(l.Amount)
+
+		switch l.Side {
+		case SideDebit:
+			if aerr == nil {
+				debits = debits.Add(amount)
+			}
+		case SideCredit:
+			if aerr == nil {
+				credits = credits.Add(amount)
+			}
+		default:
+			verr.add(i, "side", fmt.Sprintf("side must be %s or %s", SideDebit, SideCredit))
+		}
+
+		if l.AccountID == uuid.Nil {
+			verr.add(i, "account_id", "account_id is required")
+			continue
+		}
+		acc, seen := accounts[l.AccountID]
+		if !seen {
+			acc, err = s.accounts.Get(ctx, l.AccountID)
+			if errors.Is(err, sql.ErrNoRows) {
+				acc = nil
+			} else if err != nil {
+				return fmt.Errorf("load account %s: %w", l.AccountID, err)
+			}
+			accounts[l.AccountID] = acc
+		}
+		switch {
+		case acc == nil:
+			verr.add(i, "account_id", fmt.Sprintf("account %s does not exist", l.AccountID))
+		case acc.Status != "ACTIVE":
+			verr.add(i, "account_id", fmt.Sprintf("account %s is %s", acc.Code, acc.Status))
+		case manual && !acc.AllowManualJournal:
+			verr.add(i, "account_id", fmt.Sprintf("account %s does not allow manual journals", acc.Code))
+		}
+	}
+
+	// Lines that could not be converted would make the totals meaningless.
+	if converted && !debits.Equal(credits) {
+		verr.add(-1, "lines", fmt.Sprintf("debits %s do not equal credits %s",
+			debits.StringFixed(amountScale), credits.StringFixed(amountScale)))
+	}
+
+	if len(verr.Violations) > 0 {
+		return verr
+	}
+	return nil
+}
+
+// convertLines fills in each line's transaction currency, transaction amount,
+// exchange rate and functional currency, and sets Amount to the
+// functional-currency amount. A line without a currency is in the functional
+// currency; a foreign line without a rate uses the converter's rate on the
+// journal date. Lines that cannot be converted are recorded in verr and
+// converted is false.
+func (s *JournalService) convertLines(ctx context.Context, j *db.JournalEntry, verr *JournalValidationError) (converted bool, err error) {
+	functional := ""
+	if s.fx != nil {
+		if functional, err = s.fx.FunctionalCurrency(ctx, j.OrganizationID.String); err != nil {
+			return false, err
+		}
+	}
+
+	converted = true
+	rates := make(map[string]string)
+	for i := range j.Lines {
+		l := &j.Lines[i]
+		if l.TransactionAmount == "" {
+			l.TransactionAmount = l.Amount
+		}
+		l.CurrencyCode = strings.ToUpper(strings.TrimSpace(l.CurrencyCode))
+		if l.CurrencyCode == "" {
+			l.CurrencyCode = functional
+		}
+		l.FunctionalCurrency = functional
+		l.Amount = l.TransactionAmount
+
+		if l.CurrencyCode == functional {
+			if l.ExchangeRate != "" {
+				if r, err := decimal.NewFromString(l.ExchangeRate); err != nil || !r.Equal(decimal.NewFromInt(1)) {
+					verr.add(i, "exchange_rate", "a line in the functional currency must have rate 1")
+					converted = false
+					continue
+				}
+			}
+			l.ExchangeRate = "1"
+			continue
+		}
+		if len(l.CurrencyCode) != 3 {
+			verr.add(i, "currency_code", fmt.Sprintf("%q is not a 3-letter currency code", l.CurrencyCode))
+			converted = false
+			continue
+		}
+		if functional == "" {
+			verr.add(i, "currency_code", "no functional currency is configured to convert into")
+			converted = false
+			continue
+		}
+
+		if l.ExchangeRate == "" {
+			rate, ok := rates[l.CurrencyCode]
+			if !ok {
+				rate, err = s.fx.Rate(ctx, l.CurrencyCode, functional, j.JournalDate)
+				if errors.Is(err, ErrNoExchangeRate) {
+					verr.add(i, "exchange_rate", err.Error())
+					converted = false
+					continue
+				}
+				if err != nil {
+					return false, err
+				}
+				rates[l.CurrencyCode] = rate
+			}
+			l.ExchangeRate = rate
+		}
+		rate, err := decimal.NewFromString(l.ExchangeRate)
+		if err != nil || !rate.IsPositive() {
+			verr.add(i, "exchange_rate", fmt.Sprintf("%q is not a positive rate", l.ExchangeRate))
+			converted = false
+			continue
+		}
+		// A bad amount is left as entered for validateJournalWith to report.
+		if amount, err := decimal.NewFromString(l.TransactionAmount); err == nil {
+			l.Amount = amount.Mul(rate).Round(amountScale).StringFixed(amountScale)
+		}
+	}
+	if converted {
+		settleRounding(j.Lines)
+	}
+	return converted, nil
+}
+
+// settleRounding keeps an entry that balances in a single foreign currency
+// balanced after conversion: rounding each line separately can leave the
+// functional amounts a cent apart, so the difference is moved onto the last
+// credit line. Entries mixing currencies or rates are left alone.
+func settleRounding(lines []db.JournalLine) {
+	if len(lines) == 0 || lines[0].CurrencyCode == lines[0].FunctionalCurrency {
+		return
+	}
+	txDebits, txCredits := decimal.Zero, decimal.Zero
+	debits, credits := decimal.Zero, decimal.Zero
+	last := -1
+	for i, l := range lines {
+		if l.CurrencyCode != lines[0].CurrencyCode || l.ExchangeRate != lines[0].ExchangeRate {
+			return
+		}
+		tx, err := decimal.NewFromString(l.TransactionAmount)
+		if err != nil {
+			return
+		}
+		amount, err := decimal.NewFromString(l.Amount)
+		if err != nil {
+			return
+		}
+		switch l.Side {
+		case SideDebit:
+			txDebits, debits = txDebits.Add(tx), debits.Add(amount)
+		case SideCredit:
+			txCredits, credits = txCredits.Add(tx), credits.Add(amount)
+			last = i
+		default:
+			return
+		}
+	}
+	if last < 0 || !txDebits.Equal(txCredits) || debits.Equal(credits) {
+		return
+	}
+	amount, _ := decimal.NewFromString(lines[last].Amount)
+	lines[last].Amount = amount.Add(debits.Sub(credits)).StringFixed(amountScale)
+}
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+// Party kinds.
+const (
+	PartyCustomer = "CUSTOMER"
+	PartyVendor   = "VENDOR"
+	PartyBoth     = "BOTH"
+)
+
+// Party address kinds.
+const (
+	AddressBilling  = "BILLING"
+	AddressShipping = "SHIPPING"
+)
+
+// TaxRegistrationGSTIN is the tax type of a GST identification number.
+// Registrations of other types are stored as given.
+const TaxRegistrationGSTIN = "GSTIN"
+
+var (
+	panPattern       = regexp.MustCompile(`^[A-Z]{5}[0-9]{4}[A-Z]$`)
+	gstinPattern     = regexp.MustCompile(`^[0-9]{2}[A-Z]{5}[0-9]{4}[A-Z][1-9A-Z]Z[0-9A-Z]$`)
+	ifscPattern      = regexp.MustCompile(`^[A-Z]{4}0[A-Z0-9]{6}$`)
+	stateCodePattern = regexp.MustCompile(`^[0-9]{2}$`)
+	countryPattern   = regexp.MustCompile(`^[A-Z]{2}$`)
+	currencyPattern  = regexp.MustCompile(`^[A-Z]{3}$`)
+	// A PEPPOL endpoint is an ISO 6523 scheme code and an identifier.
+	peppolEndpointPattern = regexp.MustCompile(`^[0-9]{4}:[^\s:]\S*$`)
+)
+
+// PartyService manages the customers and vendors that invoices and vendor
+// bills are raised for.
+type PartyService struct {
+	repo ports.PartyRepository
+}
+
+var _ ports.PartyService = (*PartyService)(nil)
+
+func NewPartyService(repo ports.PartyRepository) *PartyService {
+	return &PartyService{repo: repo}
+}
+
+func (s *PartyService) Create(ctx context.Context, p *ports.Party) (*ports.Party, error) {
+	if strings.TrimSpace(p.OrganizationID) == "" {
+		return nil, fmt.Errorf("%w: organization_id is required", ErrInvalidInput)
+	}
+	if err := validateParty(p); err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, p)
+}
+
+func (s *PartyService) Get(ctx context.Context, id uuid.UUID) (*ports.Party, error) {
+	p, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: party %s", ErrNotFound, id)
+	}
+	return p, err
+}
+
+// Update replaces the party, contacts, addresses, tax registrations and bank
+// accounts included. Its organization does not change.
+func (s *PartyService) Update(ctx context.Context, p *ports.Party) (*ports.Party, error) {
+	current, err := s.Get(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	p.OrganizationID = current.OrganizationID
+	if err := validateParty(p); err != nil {
+		return nil, err
+	}
+	return s.repo.Update(ctx, p)
+}
+
+// Delete removes a party no invoice or vendor bill refers to. One that is
+// referred to can only be deactivated.
+func (s *PartyService) Delete(ctx context.Context, id uuid.UUID) error {
+	p, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	n, err := s.repo.CountDocuments(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return fmt.Errorf("%w: party %q is referred to by %d invoices or vendor bills; deactivate it instead", ErrConflict, p.Name, n)
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// List returns an organization's parties by name. A CUSTOMER or VENDOR kind
+// also lists parties that are both.
+func (s *PartyService) List(ctx context.Context, f ports.PartyFilter, limit, offset int32) ([]*ports.Party, error) {
+	if f.OrganizationID == "" {
+		return nil, fmt.Errorf("%w: organization_id is required", ErrInvalidInput)
+	}
+	if f.Kind != "" && !validPartyKind(f.Kind) {
+		return nil, fmt.Errorf("%w: unknown party kind %q", ErrInvalidInput, f.Kind)
+	}
+	f.Query = strings.TrimSpace(f.Query)
+	return s.repo.List(ctx, f, limit, offset)
+}
+
+// validateParty normalizes p in place (trimmed names, upper-case codes, a
+// GSTIN's state code) and checks it. Every GSTIN must embed the party's PAN
+// when one is given, and the same PAN as the party's other GSTINs.
+func validateParty(p *ports.Party) error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return fmt.Errorf("%w: party name is required", ErrInvalidInput)
+	}
+	if !validPartyKind(p.Kind) {
+		return fmt.Errorf("%w: party kind must be %s, %s or %s", ErrInvalidInput, PartyCustomer, PartyVendor, PartyBoth)
+	}
+	p.LegalName = trimNull(p.LegalName)
+	p.Pan = upperNull(p.Pan)
+	if p.Pan.Valid && !panPattern.MatchString(p.Pan.String) {
+		return fmt.Errorf("%w: %q is not a PAN", ErrInvalidInput, p.Pan.String)
+	}
+	p.DefaultCurrency = upperNull(p.DefaultCurrency)
+	if p.DefaultCurrency.Valid && !currencyPattern.MatchString(p.DefaultCurrency.String) {
+		return fmt.Errorf("%w: %q is not a currency code", ErrInvalidInput, p.DefaultCurrency.String)
+	}
+	if p.PaymentTermsDays.Valid && p.PaymentTermsDays.Int32 < 0 {
+		return fmt.Errorf("%w: payment terms cannot be negative", ErrInvalidInput)
+	}
+	p.CreditLimit = trimNull(p.CreditLimit)
+	if p.CreditLimit.Valid {
+		if limit, ok := parseAmount(p.CreditLimit.String); !ok || limit.IsNegative() {
+			return fmt.Errorf("%w: credit limit %q must be an amount of zero or more", ErrInvalidInput, p.CreditLimit.String)
+		}
+	}
+	p.CreditHoldReason = trimNull(p.CreditHoldReason)
+	p.PeppolEndpoint = trimNull(p.PeppolEndpoint)
+	if err := validatePeppolEndpoint(p.PeppolEndpoint); err != nil {
+		return err
+	}
+
+	primary := 0
+	for i := range p.Contacts {
+		c := &p.Contacts[i]
+		c.Name = strings.TrimSpace(c.Name)
+		if c.Name == "" {
+			return fmt.Errorf("%w: contacts[%d]: name is required", ErrInvalidInput, i)
+		}
+		c.Email = trimNull(c.Email)
+		if c.Email.Valid {
+			if _, err := mail.ParseAddress(c.Email.String); err != nil {
+				return fmt.Errorf("%w: contacts[%d]: %q is not an email address", ErrInvalidInput, i, c.Email.String)
+			}
+		}
+		c.Phone = trimNull(c.Phone)
+		c.Designation = trimNull(c.Designation)
+		if c.IsPrimary {
+			primary++
+		}
+	}
+	if primary > 1 {
+		return fmt.Errorf("%w: at most one contact can be primary", ErrInvalidInput)
+	}
+
+	defaults := map[string]int{}
+	for i := range p.Addresses {
+		a := &p.Addresses[i]
+		if a.Kind != AddressBilling && a.Kind != AddressShipping {
+			return fmt.Errorf("%w: addresses[%d]: kind must be %s or %s", ErrInvalidInput, i, AddressBilling, AddressShipping)
+		}
+		a.Line1 = strings.TrimSpace(a.Line1)
+		if a.Line1 == "" {
+			return fmt.Errorf("%w: addresses[%d]: line1 is required", ErrInvalidInput, i)
+		}
+		a.Line2, a.City, a.State, a.PostalCode = trimNull(a.Line2), trimNull(a.City), trimNull(a.State), trimNull(a.PostalCode)
+		a.StateCode = trimNull(a.StateCode)
+		if a.StateCode.Valid && !stateCodePattern.MatchString(a.StateCode.String) {
+			return fmt.Errorf("%w: addresses[%d]: %q is not a state code", ErrInvalidInput, i, a.StateCode.String)
+		}
+		a.CountryCode = strings.ToUpper(strings.TrimSpace(a.CountryCode))
+		if a.CountryCode == "" {
+			a.CountryCode = "IN"
+		}
+		if !countryPattern.MatchString(a.CountryCode) {
+			return fmt.Errorf("%w: addresses[%d]: %q is not a country code", ErrInvalidInput, i, a.CountryCode)
+		}
+		if a.IsDefault {
+			defaults[a.Kind]++
+			if defaults[a.Kind] > 1 {
+				return fmt.Errorf("%w: at most one %s address can be the default", ErrInvalidInput, a.Kind)
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+	gstPAN := ""
+	for i := range p.TaxRegistrations {
+		t := &p.TaxRegistrations[i]
+		t.TaxType = strings.ToUpper(strings.TrimSpace(t.TaxType))
+		if t.TaxType == "" {
+			t.TaxType = TaxRegistrationGSTIN
+		}
+		t.Number = strings.ToUpper(strings.TrimSpace(t.Number))
+		if t.Number == "" {
+			return fmt.Errorf("%w: tax_registrations[%d]: number is required", ErrInvalidInput, i)
+		}
+		if seen[t.TaxType+" "+t.Number] {
+			return fmt.Errorf("%w: %s %s is registered twice", ErrInvalidInput, t.TaxType, t.Number)
+		}
+		seen[t.TaxType+" "+t.Number] = true
+		t.StateCode = trimNull(t.StateCode)
+		if t.TaxType != TaxRegistrationGSTIN {
+			continue
+		}
+
+		if err := ValidateGSTIN(t.Number); err != nil {
+			return fmt.Errorf("tax_registrations[%d]: %w", i, err)
+		}
+		state, pan := t.Number[:2], t.Number[2:12]
+		switch {
+		case !t.StateCode.Valid:
+			t.StateCode = sql.NullString{String: state, Valid: true}
+		case t.StateCode.String != state:
+			return fmt.Errorf("%w: GSTIN %s is registered in state %s, not %s", ErrInvalidInput, t.Number, state, t.StateCode.String)
+		}
+		if p.Pan.Valid && pan != p.Pan.String {
+			return fmt.Errorf("%w: GSTIN %s does not belong to PAN %s", ErrInvalidInput, t.Number, p.Pan.String)
+		}
+		if gstPAN != "" && pan != gstPAN {
+			return fmt.Errorf("%w: GSTIN %s belongs to a different PAN than the party's other GSTINs", ErrInvalidInput, t.Number)
+		}
+		gstPAN = pan
+	}
+
+	primary = 0
+	for i := range p.BankAccounts {
+		b := &p.BankAccounts[i]
+		b.AccountName = strings.TrimSpace(b.AccountName)
+		b.AccountNumber = strings.TrimSpace(b.AccountNumber)
+		if b.AccountName == "" || b.AccountNumber == "" {
+			return fmt.Errorf("%w: bank_accounts[%d]: account name and number are required", ErrInvalidInput, i)
+		}
+		b.Ifsc = upperNull(b.Ifsc)
+		if b.Ifsc.Valid && !ifscPattern.MatchString(b.Ifsc.String) {
+			return fmt.Errorf("%w: bank_accounts[%d]: %q is not an IFSC", ErrInvalidInput, i, b.Ifsc.String)
+		}
+		b.BankName, b.Branch = trimNull(b.BankName), trimNull(b.Branch)
+		if b.IsPrimary {
+			primary++
+		}
+	}
+	if primary > 1 {
+		return fmt.Errorf("%w: at most one bank account can be primary", ErrInvalidInput)
+	}
+	return nil
+}
+
+// ValidateGSTIN checks a GST identification number's format and its check
+// character: two state digits, the holder's PAN, an entity number, a Z and
+// a mod-36 check character over the first fourteen.
+func ValidateGSTIN(gstin string) error {
+	if !gstinPattern.MatchString(gstin) {
+		return fmt.Errorf("%w: %q is not a GSTIN", ErrInvalidInput, gstin)
+	}
+	const alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	sum := 0
+	for i := 0; i < 14; i++ {
+		v := strings.IndexByte(alphabet, gstin[i]) * (i%2 + 1)
+		sum += v/36 + v%36
+	}
+	if want := alphabet[(36-sum%36)%36]; gstin[14] != want {
+		return fmt.Errorf("%w: GSTIN %s has an invalid check character", ErrInvalidInput, gstin)
+	}
+	return nil
+}
+
+func validPartyKind(kind string) bool {
+	return kind == PartyCustomer || kind == PartyVendor || kind == PartyBoth
+}
+
+func trimNull(s sql.NullString) sql.NullString {
+	v := strings.TrimSpace(s.String)
+	return sql.NullString{String: v, Valid: v != ""}
+}
+
+func upperNull(s sql.NullString) sql.NullString {
+	s = trimNull(s)
+	s.String = strings.ToUpper(s.String)
+	return s
+}
+
+func validatePeppolEndpoint(e sql.NullString) error {
+	if e.Valid && !peppolEndpointPattern.MatchString(e.String) {
+		return fmt.Errorf("%w: %q is not a PEPPOL endpoint; give it as <scheme>:<identifier>, e.g. 0088:5790000435975", ErrInvalidInput, e.String)
+	}
+	return nil
+}
@@ -0,0 +1,343 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+)
+
+// RecurringInvoiceService manages recurring invoices and bills the periods
+// that fall due from them.
+type RecurringInvoiceService struct {
+	repo     ports.RecurringInvoiceRepository
+	invoices *InvoiceService
+}
+
+var _ ports.RecurringInvoiceService = (*RecurringInvoiceService)(nil)
+
+func NewRecurringInvoiceService(repo ports.RecurringInvoiceRepository, invoices *InvoiceService) *RecurringInvoiceService {
+	return &RecurringInvoiceService{repo: repo, invoices: invoices}
+}
+
+// Create stores a recurring invoice. It belongs to its template invoice's
+// organization.
+func (s *RecurringInvoiceService) Create(ctx context.Context, r db.RecurringInvoice) (db.RecurringInvoice, error) {
+	if err := s.validate(ctx, &r); err != nil {
+		return db.RecurringInvoice{}, err
+	}
+	return s.repo.Create(ctx, r)
+}
+
+func (s *RecurringInvoiceService) Get(ctx context.Context, id uuid.UUID) (db.RecurringInvoice, error) {
+	r, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return r, fmt.Errorf("%w: recurring invoice %s", ErrNotFound, id)
+	}
+	return r, err
+}
+
+// Update replaces the recurring invoice's template and schedule. Periods
+// already billed are not billed again, even if the schedule moves over them.
+func (s *RecurringInvoiceService) Update(ctx context.Context, r db.RecurringInvoice) (db.RecurringInvoice, error) {
+	current, err := s.Get(ctx, r.ID)
+	if err != nil {
+		return db.RecurringInvoice{}, err
+	}
+	r.OrganizationID = current.OrganizationID
+	if err := s.validate(ctx, &r); err != nil {
+		return db.RecurringInvoice{}, err
+	}
+	return s.repo.Update(ctx, r)
+}
+
+func (s *RecurringInvoiceService) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.Get(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *RecurringInvoiceService) List(ctx context.Context, limit, offset int32) ([]db.RecurringInvoice, error) {
+	return s.repo.List(ctx, limit, offset)
+}
+
+// Generate bills every period that starts on or before asOf, creating a copy
+// of the template invoice dated on the period's first day, issued when the
+// recurring invoice auto-issues. Periods billed by an earlier run are
+// skipped. A recurring invoice whose next period cannot be billed, e.g.
+// because its accounting period is closed, stops at that period; the others
+// still run and the failures are returned together as a *RecurringRunError.
+//
+// An invoice that is created but cannot be issued stays a draft and is
+// reported; later runs do not retry the issue.
+func (s *RecurringInvoiceService) Generate(ctx context.Context, asOf time.Time) ([]ports.GeneratedInvoice, error) {
+	due, err := s.repo.ListDue(ctx, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	var generated []ports.GeneratedInvoice
+	var errs []error
+	for _, r := range due {
+		template, err := s.invoices.get(ctx, r.TemplateInvoiceID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("recurring invoice %q: template: %w", r.Name, err))
+			continue
+		}
+		for _, p := range billingPeriods(r, asOf) {
+			inv, err := s.occurrence(r, template, p)
+			if err == nil {
+				inv, err = s.invoices.prepareCreate(ctx, inv)
+			}
+			var created *db.Invoice
+			if err == nil {
+				created, err = s.repo.CreateOccurrence(ctx, r.ID, p.billed, inv)
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("recurring invoice %q for %s: %w", r.Name, p.billed.Start.Format(time.DateOnly), err))
+				break
+			}
+			if created == nil {
+				continue
+			}
+			s.invoices.recordCreated(ctx, *created)
+			if r.AutoIssue {
+				issued, err := s.invoices.IssueInvoice(ctx, created.ID, r.UpdatedBy.String)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("recurring invoice %q for %s: issue %s: %w", r.Name, p.billed.Start.Format(time.DateOnly), created.ID, err))
+				} else {
+					created = &issued
+				}
+			}
+			generated = append(generated, ports.GeneratedInvoice{RecurringInvoiceID: r.ID, Period: p.billed, Invoice: *created})
+		}
+	}
+	if len(errs) > 0 {
+		return generated, &RecurringRunError{Failures: errs}
+	}
+	return generated, nil
+}
+
+// Run generates due invoices immediately and then on every tick until ctx is
+// cancelled.
+func (s *RecurringInvoiceService) Run(ctx context.Context, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		generated, err := s.Generate(ctx, time.Now())
+		if err != nil {
+			log.Printf("recurring invoices: %v", err)
+		}
+		if len(generated) > 0 {
+			log.Printf("recurring invoices: generated %d invoices", len(generated))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *RecurringInvoiceService) validate(ctx context.Context, r *db.RecurringInvoice) error {
+	r.Name = strings.TrimSpace(r.Name)
+	switch {
+	case r.Name == "":
+		return fmt.Errorf("%w: name is required", ErrInvalidInput)
+	case frequencyMonths[r.Frequency] == 0:
+		return fmt.Errorf("%w: frequency must be %s, %s or %s", ErrInvalidInput, FrequencyMonthly, FrequencyQuarterly, FrequencyYearly)
+	case r.BillingDay < 1 || r.BillingDay > 28:
+		return fmt.Errorf("%w: billing day must be between 1 and 28", ErrInvalidInput)
+	case r.StartDate.IsZero():
+		return fmt.Errorf("%w: start date is required", ErrInvalidInput)
+	case r.EndDate.Valid && r.EndDate.Time.Before(r.StartDate):
+		return fmt.Errorf("%w: end date is before start date", ErrInvalidInput)
+	}
+
+	template, err := s.invoices.get(ctx, r.TemplateInvoiceID)
+	if err != nil {
+		return err
+	}
+	switch {
+	case r.OrganizationID == "":
+		r.OrganizationID = template.OrganizationID
+	case r.OrganizationID != template.OrganizationID:
+		return fmt.Errorf("%w: template invoice %s belongs to another organization", ErrInvalidInput, template.ID)
+	}
+	if len(template.Items) == 0 {
+		return fmt.Errorf("%w: template invoice %s has no items", ErrInvalidInput, template.ID)
+	}
+	if r.AutoIssue {
+		// Generated invoices have no number of their own.
+		_, err := s.invoices.repo.GetNumberSeries(ctx, template.OrganizationID, strings.ToUpper(template.Type))
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: auto-issued %s invoices need a number series", ErrInvalidInput, template.Type)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// occurrence builds the invoice r bills for p from its template: the same
+// party, lines and terms, dated on the first day billed and due as
+// many days later as the template was. A prorated period bills its lines and
+// fixed discounts for the share of the period's days it covers.
+func (s *RecurringInvoiceService) occurrence(r db.RecurringInvoice, t db.Invoice, p billingPeriod) (db.Invoice, error) {
+	inv := db.Invoice{
+		Type:           t.Type,
+		InvoiceDate:    p.billed.Start,
+		OrganizationID: r.OrganizationID,
+		PoNumber:       t.PoNumber,
+		Status:         InvoiceDraft,
+		CurrencyCode:   t.CurrencyCode,
+		PartyID:        t.PartyID,
+		CreatedBy:      r.UpdatedBy,
+		UpdatedBy:      r.UpdatedBy,
+		Items:          make([]db.InvoiceItem, len(t.Items)),
+		Discounts:      make([]db.InvoiceDiscount, len(t.Discounts)),
+		Taxes:          make([]db.InvoiceTax, len(t.Taxes)),
+	}
+	if t.DueDate.Valid {
+		terms := dateOnly(t.DueDate.Time).Sub(dateOnly(t.InvoiceDate))
+		inv.DueDate = sql.NullTime{Time: p.billed.Start.Add(terms), Valid: true}
+	}
+	for i, item := range t.Items {
+		inv.Items[i] = db.InvoiceItem{
+			Name:         item.Name,
+			Description:  item.Description,
+			Hsn:          item.Hsn,
+			Quantity:     item.Quantity,
+			UnitPrice:    item.UnitPrice,
+			CostCenterID: item.CostCenterID,
+			AccountID:    item.AccountID,
+			Discounts:    make([]db.InvoiceItemDiscount, len(item.Discounts)),
+			Taxes:        make([]db.InvoiceItemTax, len(item.Taxes)),
+		}
+		for j, d := range item.Discounts {
+			inv.Items[i].Discounts[j] = db.InvoiceItemDiscount{Description: d.Description, Amount: d.Amount}
+		}
+		for j, tax := range item.Taxes {
+			inv.Items[i].Taxes[j] = db.InvoiceItemTax{Name: tax.Name, Rate: tax.Rate}
+		}
+	}
+	for i, d := range t.Discounts {
+		inv.Discounts[i] = db.InvoiceDiscount{Description: d.Description, Amount: d.Amount}
+	}
+	for i, tax := range t.Taxes {
+		inv.Taxes[i] = db.InvoiceTax{Name: tax.Name, Rate: tax.Rate}
+	}
+	if t.Gst != nil {
+		inv.Gst = &db.GstRegime{Gstin: t.Gst.Gstin, PlaceOfSupply: t.Gst.PlaceOfSupply, ReverseCharge: t.Gst.ReverseCharge}
+	}
+
+	if r.Prorate && p.billed != p.full {
+		if err := s.prorate(&inv, p.share()); err != nil {
+			return db.Invoice{}, err
+		}
+	}
+	return inv, nil
+}
+
+// prorate scales inv's unit prices and fixed discounts by share, rounded to
+// the currency's scale. Taxes follow, as they are computed from rates.
+func (s *RecurringInvoiceService) prorate(inv *db.Invoice, share decimal.Decimal) error {
+	currency := inv.CurrencyCode
+	if currency == "" {
+		currency = s.invoices.calc.defaultCurrency
+	}
+	r, err := newRounder(s.invoices.calc.Rounding(currency))
+	if err != nil {
+		return fmt.Errorf("%w: %s rounding: %v", ErrInvalidInput, currency, err)
+	}
+	scale := func(field string, amount *string) error {
+		d, ok := parseAmount(*amount)
+		if !ok {
+			return fmt.Errorf("%w: template %s %q is not an amount", ErrInvalidInput, field, *amount)
+		}
+		*amount = r.format(r.round(d.Mul(share)))
+		return nil
+	}
+	for i := range inv.Items {
+		item := &inv.Items[i]
+		if err := scale(fmt.Sprintf("items[%d].unit_price", i), &item.UnitPrice); err != nil {
+			return err
+		}
+		for j := range item.Discounts {
+			if err := scale(fmt.Sprintf("items[%d].discounts[%d].amount", i, j), &item.Discounts[j].Amount); err != nil {
+				return err
+			}
+		}
+	}
+	for i := range inv.Discounts {
+		if err := scale(fmt.Sprintf("discounts[%d].amount", i), &inv.Discounts[i].Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// billingPeriod is one period of a recurring invoice: full is the whole
+// period, billed the part of it between the start and end dates.
+type billingPeriod struct {
+	full   ports.BillingPeriod
+	billed ports.BillingPeriod
+}
+
+// share is the fraction of the period's days that are billed.
+func (p billingPeriod) share() decimal.Decimal {
+	days := func(bp ports.BillingPeriod) int64 { return int64(bp.End.Sub(bp.Start).Hours()/24) + 1 }
+	return decimal.NewFromInt(days(p.billed)).Div(decimal.NewFromInt(days(p.full)))
+}
+
+// billingPeriods lists r's periods after the ones already billed whose first
+// billed day is on or before asOf and the end date.
+func billingPeriods(r db.RecurringInvoice, asOf time.Time) []billingPeriod {
+	step := frequencyMonths[r.Frequency]
+	if step == 0 || r.BillingDay < 1 || r.BillingDay > 28 {
+		return nil
+	}
+	start := dateOnly(r.StartDate)
+	limit := dateOnly(asOf)
+	if r.EndDate.Valid && r.EndDate.Time.Before(limit) {
+		limit = dateOnly(r.EndDate.Time)
+	}
+	anchor := time.Date(start.Year(), start.Month(), int(r.BillingDay), 0, 0, 0, 0, time.UTC)
+	if anchor.After(start) {
+		anchor = anchor.AddDate(0, -1, 0)
+	}
+
+	var periods []billingPeriod
+	for i := 0; ; i++ {
+		full := ports.BillingPeriod{
+			Start: anchor.AddDate(0, i*step, 0),
+			End:   anchor.AddDate(0, (i+1)*step, -1),
+		}
+		billed := full
+		if billed.Start.Before(start) {
+			billed.Start = start
+		}
+		if r.EndDate.Valid && billed.End.After(dateOnly(r.EndDate.Time)) {
+			billed.End = dateOnly(r.EndDate.Time)
+		}
+		if billed.Start.After(limit) {
+			return periods
+		}
+		if r.BilledThrough.Valid && !billed.End.After(dateOnly(r.BilledThrough.Time)) {
+			continue
+		}
+		periods = append(periods, billingPeriod{full: full, billed: billed})
+	}
+}
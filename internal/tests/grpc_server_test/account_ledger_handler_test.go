@@ -11,13 +11,18 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	money "google.golang.org/genproto/googleapis/type/money"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
 	grpcserver "github.com/ShristiRnr/Finance_mierp/internal/core/ports/grpc_server"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
 )
 
 //
@@ -86,30 +91,96 @@ func (m *MockJournalService) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
-func (m *MockJournalService) List(ctx context.Context, limit, offset int32) ([]*db.JournalEntry, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockJournalService) List(ctx context.Context, f ports.EntryFilter, limit, offset int32) ([]*db.JournalEntry, error) {
+	args := m.Called(ctx, f, limit, offset)
 	if res := args.Get(0); res != nil {
 		return res.([]*db.JournalEntry), args.Error(1)
 	}
 	return nil, args.Error(1)
 }
+func (m *MockJournalService) Reverse(ctx context.Context, id uuid.UUID, reversalDate time.Time, memo string) (*db.JournalEntry, error) {
+	args := m.Called(ctx, id, reversalDate, memo)
+	if res := args.Get(0); res != nil {
+		return res.(*db.JournalEntry), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
 
 type MockLedgerService struct{ mock.Mock }
 
-func (m *MockLedgerService) List(ctx context.Context, limit, offset int32) ([]*db.LedgerEntry, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockLedgerService) List(ctx context.Context, f ports.EntryFilter, limit, offset int32) ([]*db.LedgerEntry, error) {
+	args := m.Called(ctx, f, limit, offset)
 	if res := args.Get(0); res != nil {
 		return res.([]*db.LedgerEntry), args.Error(1)
 	}
 	return nil, args.Error(1)
 }
 
+func (m *MockLedgerService) AccountTree(ctx context.Context, orgID string, from, to time.Time) ([]*ports.AccountTreeNode, error) {
+	args := m.Called(ctx, orgID, from, to)
+	if res := args.Get(0); res != nil {
+		return res.([]*ports.AccountTreeNode), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockLedgerService) Balances(ctx context.Context, orgID string, accountIDs []uuid.UUID, from, to time.Time) ([]ports.AccountBalance, error) {
+	args := m.Called(ctx, orgID, accountIDs, from, to)
+	if res := args.Get(0); res != nil {
+		return res.([]ports.AccountBalance), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+type MockJournalTemplateService struct{ mock.Mock }
+
+func (m *MockJournalTemplateService) Create(ctx context.Context, t *ports.JournalTemplate) (*ports.JournalTemplate, error) {
+	args := m.Called(ctx, t)
+	if res := args.Get(0); res != nil {
+		return res.(*ports.JournalTemplate), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockJournalTemplateService) Get(ctx context.Context, id uuid.UUID) (*ports.JournalTemplate, error) {
+	args := m.Called(ctx, id)
+	if res := args.Get(0); res != nil {
+		return res.(*ports.JournalTemplate), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockJournalTemplateService) Update(ctx context.Context, t *ports.JournalTemplate) (*ports.JournalTemplate, error) {
+	args := m.Called(ctx, t)
+	if res := args.Get(0); res != nil {
+		return res.(*ports.JournalTemplate), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockJournalTemplateService) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockJournalTemplateService) List(ctx context.Context, limit, offset int32) ([]*ports.JournalTemplate, error) {
+	args := m.Called(ctx, limit, offset)
+	return args.Get(0).([]*ports.JournalTemplate), args.Error(1)
+}
+
+func (m *MockJournalTemplateService) Generate(ctx context.Context, asOf time.Time) ([]*db.JournalEntry, error) {
+	args := m.Called(ctx, asOf)
+	if res := args.Get(0); res != nil {
+		return res.([]*db.JournalEntry), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 // --------------------- Tests ---------------------
 
 func TestLedgerHandler_Accounts(t *testing.T) {
 	ctx := context.Background()
 	mockAcc := new(MockAccountService)
-	h := grpcserver.NewLedgerHandler(mockAcc, nil, nil)
+	h := grpcserver.NewLedgerHandler(mockAcc, nil, nil, nil, nil)
 
 	// --- CreateAccount success ---
 	req := &pb.CreateAccountRequest{Account: &pb.Account{
@@ -152,7 +223,7 @@ func TestLedgerHandler_Accounts(t *testing.T) {
 func TestLedgerHandler_Journals(t *testing.T) {
 	ctx := context.Background()
 	mockJnl := new(MockJournalService)
-	h := grpcserver.NewLedgerHandler(nil, mockJnl, nil)
+	h := grpcserver.NewLedgerHandler(nil, mockJnl, nil, nil, nil)
 
 	// --- CreateJournalEntry ---
 	expected := &db.JournalEntry{
@@ -187,27 +258,116 @@ func TestLedgerHandler_Journals(t *testing.T) {
 	assert.NoError(t, err)
 
 	// --- ListJournalEntries ---
-	mockJnl.On("List", mock.Anything, int32(50), int32(0)).
+	mockJnl.On("List", mock.Anything, ports.EntryFilter{}, int32(50), int32(0)).
 		Return([]*db.JournalEntry{expected}, nil)
 	listResp, err := h.ListJournalEntries(ctx, &pb.ListJournalEntriesRequest{})
 	assert.NoError(t, err)
 	assert.Len(t, listResp.Entries, 1)
+
+	// --- DeleteJournalEntry on a posted entry ---
+	postedID := uuid.New()
+	mockJnl.On("Delete", mock.Anything, postedID).
+		Return(fmt.Errorf("%w: journal is POSTED", services.ErrConflict))
+	_, err = h.DeleteJournalEntry(ctx, &pb.DeleteJournalEntryRequest{Id: postedID.String()})
+	st, _ = status.FromError(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+
+	// --- DeleteJournalEntry in a closed period ---
+	lockedID := uuid.New()
+	mockJnl.On("Delete", mock.Anything, lockedID).
+		Return(fmt.Errorf("%w: FY2024 period 12 is closed", services.ErrPeriodClosed))
+	_, err = h.DeleteJournalEntry(ctx, &pb.DeleteJournalEntryRequest{Id: lockedID.String()})
+	st, _ = status.FromError(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+
+	// --- ReverseJournalEntry ---
+	revDate := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	reversal := &db.JournalEntry{
+		ID:          uuid.New(),
+		JournalDate: revDate,
+		Status:      "POSTED",
+		ReversalOf:  uuid.NullUUID{UUID: postedID, Valid: true},
+	}
+	mockJnl.On("Reverse", mock.Anything, postedID, revDate, "undo").Return(reversal, nil)
+	revResp, err := h.ReverseJournalEntry(ctx, &pb.ReverseJournalEntryRequest{
+		Id: postedID.String(), ReversalDate: timestamppb.New(revDate), Memo: "undo",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.JournalStatus_JOURNAL_POSTED, revResp.Status)
+	assert.Equal(t, postedID.String(), revResp.ReversalOfId)
+
+	_, err = h.ReverseJournalEntry(ctx, &pb.ReverseJournalEntryRequest{Id: postedID.String()})
+	st, _ = status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestLedgerHandler_JournalValidationError(t *testing.T) {
+	ctx := context.Background()
+	mockJnl := new(MockJournalService)
+	h := grpcserver.NewLedgerHandler(nil, mockJnl, nil, nil, nil)
+
+	cashID, revID := uuid.New(), uuid.New()
+	verr := &services.JournalValidationError{Violations: []services.LineViolation{
+		{Line: 1, Field: "amount", Reason: "amount must be greater than zero"},
+		{Line: -1, Field: "lines", Reason: "debits 100.00 do not equal credits 0.00"},
+	}}
+	mockJnl.On("Create", mock.Anything, mock.MatchedBy(func(j *db.JournalEntry) bool {
+		return len(j.Lines) == 2 &&
+			j.Lines[0].Side == "DEBIT" && j.Lines[0].Amount == "100.5" && j.Lines[0].CurrencyCode == "USD" &&
+			j.Lines[1].Side == "CREDIT" && j.Lines[1].Amount == "0"
+	})).Return(nil, verr)
+
+	_, err := h.CreateJournalEntry(ctx, &pb.CreateJournalEntryRequest{
+		Entry: &pb.JournalEntry{
+			JournalDate: timestamppb.New(time.Now()),
+			Lines: []*pb.JournalLine{
+				{AccountId: cashID.String(), Side: pb.LedgerSide_LEDGER_SIDE_DEBIT,
+					Amount: &money.Money{CurrencyCode: "USD", Units: 100, Nanos: 500_000_000}},
+				{AccountId: revID.String(), Side: pb.LedgerSide_LEDGER_SIDE_CREDIT,
+					Amount: &money.Money{CurrencyCode: "USD"}},
+			},
+		},
+	})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	var fields []string
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, fv := range br.FieldViolations {
+				fields = append(fields, fv.Field)
+			}
+		}
+	}
+	assert.Equal(t, []string{"entry.lines[1].amount", "entry.lines"}, fields)
+
+	// Malformed account ids never reach the service.
+	_, err = h.CreateJournalEntry(ctx, &pb.CreateJournalEntryRequest{
+		Entry: &pb.JournalEntry{Lines: []*pb.JournalLine{{AccountId: "bad"}}},
+	})
+	st, _ = status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	mockJnl.AssertNumberOfCalls(t, "Create", 1)
 }
 
 func TestLedgerHandler_Ledger(t *testing.T) {
 	ctx := context.Background()
 	mockLgr := new(MockLedgerService)
-	h := grpcserver.NewLedgerHandler(nil, nil, mockLgr)
+	h := grpcserver.NewLedgerHandler(nil, nil, mockLgr, nil, nil)
 
 	entry := &db.LedgerEntry{
-		EntryID:         uuid.New(),
-		AccountID:       uuid.New(),
-		Side:            "Debit", // or "Credit"
-		Amount:          "1000",
-		TransactionDate: time.Now(),
+		EntryID:            uuid.New(),
+		AccountID:          uuid.New(),
+		Side:               "Debit", // or "Credit"
+		Amount:             "83000",
+		TransactionDate:    time.Now(),
+		CurrencyCode:       "USD",
+		TransactionAmount:  "1000",
+		ExchangeRate:       "83.000000",
+		FunctionalCurrency: "INR",
 	}
 
-	mockLgr.On("List", mock.Anything, int32(100), int32(0)).
+	mockLgr.On("List", mock.Anything, ports.EntryFilter{}, int32(100), int32(0)).
 		Return([]*db.LedgerEntry{entry}, nil)
 
 	resp, err := h.ListLedgerEntries(ctx, &pb.ListLedgerEntriesRequest{})
@@ -234,6 +394,168 @@ func TestLedgerHandler_Ledger(t *testing.T) {
 
 	// --- Convert money.Money to string for comparison ---
 	amountStr := fmt.Sprintf("%d", entryResp.Amount.Units)
-	assert.Equal(t, entry.Amount, amountStr)
+	assert.Equal(t, entry.TransactionAmount, amountStr)
+	assert.Equal(t, "USD", entryResp.Amount.CurrencyCode)
+	assert.EqualValues(t, 83000, entryResp.FunctionalAmount.Units)
+	assert.Equal(t, "INR", entryResp.FunctionalAmount.CurrencyCode)
+	assert.Equal(t, "83.000000", entryResp.ExchangeRate)
+}
+
+func TestLedgerHandler_LedgerFilters(t *testing.T) {
+	ctx := context.Background()
+	mockLgr := new(MockLedgerService)
+	mockJnl := new(MockJournalService)
+	h := grpcserver.NewLedgerHandler(nil, mockJnl, mockLgr, nil, nil)
+
+	accountID := uuid.New()
+	want := ports.EntryFilter{
+		AccountID:     uuid.NullUUID{UUID: accountID, Valid: true},
+		FromDate:      time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+		ToDate:        time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC).Add(-time.Microsecond),
+		Side:          "DEBIT",
+		ReferenceType: "INVOICE",
+		OrderBy:       services.OrderByAmount,
+		Descending:    true,
+	}
+	mockLgr.On("List", mock.Anything, want, int32(100), int32(0)).Return([]*db.LedgerEntry{}, nil)
+
+	_, err := h.ListLedgerEntries(ctx, &pb.ListLedgerEntriesRequest{Page: &pb.PageRequest{
+		Filter: fmt.Sprintf("account_id = '%s' AND transaction_date >= '2025-04-01' AND date <= '2025-04-30' "+
+			"and side = debit AND reference_type = 'INVOICE'", accountID),
+		OrderBy: "amount desc",
+	}})
+	assert.NoError(t, err)
+	mockLgr.AssertExpectations(t)
+
+	// Unknown fields, operators and sort keys are rejected, not ignored.
+	for _, page := range []*pb.PageRequest{
+		{Filter: "status = 'POSTED'"},
+		{Filter: "side >= 'DEBIT'"},
+		{Filter: "account_id = 'not-a-uuid'"},
+		{OrderBy: "memo"},
+	} {
+		_, err = h.ListJournalEntries(ctx, &pb.ListJournalEntriesRequest{Page: page})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), page.String())
+	}
+	mockJnl.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
+func TestLedgerHandler_AccountBalances(t *testing.T) {
+	ctx := context.Background()
+	mockLgr := new(MockLedgerService)
+	h := grpcserver.NewLedgerHandler(nil, nil, mockLgr, nil, nil)
+
+	accID := uuid.New()
+	asOf := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	mockLgr.On("Balances", mock.Anything, "org-1", []uuid.UUID{accID}, time.Time{}, asOf).
+		Return([]ports.AccountBalance{{
+			AccountID: accID, Code: "1000", Name: "Cash", Type: "ASSET", To: asOf, Currency: "INR",
+			Opening: "0.00", TotalDebits: "1500.00", TotalCredits: "250.50", Closing: "1249.50",
+			Currencies: []ports.CurrencyBalance{
+				{Currency: "USD", Opening: "0.00", TotalDebits: "10.00", TotalCredits: "0.00", Closing: "10.00"},
+			},
+		}}, nil)
+
+	resp, err := h.GetAccountBalance(ctx, &pb.GetAccountBalanceRequest{
+		AccountId:      accID.String(),
+		ToDate:         timestamppb.New(asOf),
+		OrganizationId: "org-1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.AccountType_ACCOUNT_ASSET, resp.AccountType)
+	assert.Equal(t, int64(1249), resp.ClosingBalance.Units)
+	assert.Equal(t, int32(500_000_000), resp.ClosingBalance.Nanos)
+	assert.Equal(t, "INR", resp.ClosingBalance.CurrencyCode)
+	require.Len(t, resp.Currencies, 1)
+	assert.Equal(t, "USD", resp.Currencies[0].ClosingBalance.CurrencyCode)
+	assert.EqualValues(t, 10, resp.Currencies[0].ClosingBalance.Units)
+	assert.Nil(t, resp.FromDate)
+
+	// to_date is the "as of" date and must be given.
+	_, err = h.GetAccountBalance(ctx, &pb.GetAccountBalanceRequest{AccountId: accID.String()})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	_, err = h.ListAccountBalances(ctx, &pb.ListAccountBalancesRequest{
+		AccountIds: []string{"bad"},
+		ToDate:     timestamppb.New(asOf),
+	})
+	st, _ = status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestLedgerHandler_GetAccountTree(t *testing.T) {
+	ctx := context.Background()
+	mockLgr := new(MockLedgerService)
+	h := grpcserver.NewLedgerHandler(nil, nil, mockLgr, nil, nil)
+
+	assetsID, cashID := uuid.New(), uuid.New()
+	asOf := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	cash := &ports.AccountTreeNode{
+		Account: &db.Account{ID: cashID, Code: "1100", Name: "Cash", Type: "ASSET"},
+		Balance: ports.AccountBalance{AccountID: cashID, Type: "ASSET", Currency: "INR", Closing: "200.00"},
+	}
+	mockLgr.On("AccountTree", mock.Anything, "org-1", time.Time{}, asOf).
+		Return([]*ports.AccountTreeNode{{
+			Account:  &db.Account{ID: assetsID, Code: "1000", Name: "Assets", Type: "ASSET"},
+			Balance:  ports.AccountBalance{AccountID: assetsID, Type: "ASSET", Currency: "INR", Closing: "200.00"},
+			Children: []*ports.AccountTreeNode{cash},
+		}}, nil)
+
+	resp, err := h.GetAccountTree(ctx, &pb.GetAccountTreeRequest{
+		ToDate:         timestamppb.New(asOf),
+		OrganizationId: "org-1",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Roots, 1)
+	assert.Equal(t, assetsID.String(), resp.Roots[0].Account.Id)
+	require.Len(t, resp.Roots[0].Children, 1)
+	assert.Equal(t, "1100", resp.Roots[0].Children[0].Account.Code)
+	assert.EqualValues(t, 200, resp.Roots[0].Balance.ClosingBalance.Units)
+	mockLgr.AssertExpectations(t)
+}
+
+func TestLedgerHandler_JournalTemplates(t *testing.T) {
+	ctx := context.Background()
+	mockTpl := new(MockJournalTemplateService)
+	h := grpcserver.NewLedgerHandler(nil, nil, nil, mockTpl, nil)
+
+	rentID, cashID := uuid.New(), uuid.New()
+	mockTpl.On("Create", mock.Anything, mock.MatchedBy(func(t *ports.JournalTemplate) bool {
+		return t.Frequency == services.FrequencyQuarterly && t.DayOfMonth == 31 && t.AutoPost &&
+			t.OrganizationID.String == "org" && len(t.Lines) == 2 && t.Lines[0].Amount == "250"
+	})).Return(&ports.JournalTemplate{
+		JournalTemplate: db.JournalTemplate{ID: uuid.New(), Name: "Depreciation", Frequency: services.FrequencyQuarterly},
+	}, nil)
+
+	created, err := h.CreateJournalTemplate(ctx, &pb.CreateJournalTemplateRequest{
+		Meta: &pb.RequestMetadata{OrganizationId: "org"},
+		Template: &pb.JournalTemplate{
+			Name:       "Depreciation",
+			Frequency:  pb.TemplateFrequency_TEMPLATE_QUARTERLY,
+			StartDate:  timestamppb.New(time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)),
+			DayOfMonth: 31,
+			AutoPost:   true,
+			Lines: []*pb.JournalLine{
+				{AccountId: rentID.String(), Side: pb.LedgerSide_LEDGER_SIDE_DEBIT, Amount: &money.Money{Units: 250}},
+				{AccountId: cashID.String(), Side: pb.LedgerSide_LEDGER_SIDE_CREDIT, Amount: &money.Money{Units: 250}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.TemplateFrequency_TEMPLATE_QUARTERLY, created.Frequency)
+
+	// Generated entries are returned alongside the templates that failed.
+	asOf := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	mockTpl.On("Generate", mock.Anything, asOf).Return([]*db.JournalEntry{{ID: uuid.New()}},
+		&services.RecurringRunError{Failures: []error{fmt.Errorf("template \"Rent\" on 2025-04-01: %w", services.ErrPeriodClosed)}})
+
+	resp, err := h.GenerateRecurringJournals(ctx, &pb.GenerateRecurringJournalsRequest{AsOf: timestamppb.New(asOf)})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Entries, 1)
+	assert.Len(t, resp.Failures, 1)
+
+	mockTpl.On("Get", mock.Anything, mock.Anything).Return(nil, services.ErrNotFound)
+	_, err = h.GetJournalTemplate(ctx, &pb.GetJournalTemplateRequest{Id: uuid.NewString()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
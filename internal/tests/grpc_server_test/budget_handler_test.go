@@ -2,6 +2,7 @@ package grpc_server_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 
 	"github.com/google/uuid"
@@ -11,7 +12,10 @@ import (
 	financepb "github.com/ShristiRnr/Finance_mierp/api/pb"
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	grpcserver "github.com/ShristiRnr/Finance_mierp/internal/core/ports/grpc_server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 // --- Mock Service ---
@@ -231,21 +235,61 @@ func TestGetBudgetAllocation(t *testing.T) {
 
 func TestUpdateBudgetAllocation(t *testing.T) {
 	mockSvc := new(MockBudgetService)
-	handler := grpcserver.NewBudgetHandler(mockSvc)
+	handler := grpcserver.NewBudgetAllocationHandler(mockSvc)
 
-	id := makeUUID()
-	expected := &db.BudgetAllocation{ID: id, BudgetID: makeUUID(), AllocatedAmount: "800"}
+	id, budgetID := makeUUID(), makeUUID()
+	stored := &db.BudgetAllocation{ID: id, BudgetID: budgetID, DepartmentID: "OPS", AllocatedAmount: "800",
+		SpentAmount: sql.NullString{String: "100", Valid: true}}
+	expected := &db.BudgetAllocation{ID: id, BudgetID: budgetID, DepartmentID: "OPS", AllocatedAmount: "900",
+		SpentAmount: sql.NullString{String: "100", Valid: true}}
 
+	mockSvc.On("GetBudget", mock.Anything, budgetID).Return(&db.Budget{ID: budgetID, CurrencyCode: "INR"}, nil)
+	mockSvc.On("GetBudgetAllocation", mock.Anything, id).Return(stored, nil)
 	mockSvc.On("UpdateBudgetAllocation", mock.Anything, mock.MatchedBy(func(ba *db.BudgetAllocation) bool {
-		return ba.ID == id && ba.AllocatedAmount == "800"
+		return ba.ID == id && ba.AllocatedAmount == "900" && ba.DepartmentID == "OPS" && ba.SpentAmount.String == "100"
 	})).Return(expected, nil)
 
-	req := &db.BudgetAllocation{ID: id, AllocatedAmount: "800"}
+	// Only allocated_amount is masked, so the department and spent amount the
+	// request leaves out keep their stored values.
+	resp, err := handler.UpdateBudgetAllocation(context.Background(), &financepb.UpdateBudgetAllocationRequest{
+		Allocation: &financepb.BudgetAllocation{
+			Id:              id.String(),
+			AllocatedAmount: &money.Money{Units: 900},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"allocated_amount"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, id.String(), resp.Id)
+	assert.Equal(t, int64(900), resp.AllocatedAmount.Units)
+	assert.Equal(t, "INR", resp.AllocatedAmount.CurrencyCode)
+	mockSvc.AssertExpectations(t)
+
+	_, err = handler.UpdateBudgetAllocation(context.Background(), &financepb.UpdateBudgetAllocationRequest{
+		Allocation: &financepb.BudgetAllocation{Id: id.String(), BudgetId: makeUUID().String()},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"budget_id"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockSvc.AssertNumberOfCalls(t, "UpdateBudgetAllocation", 1)
+}
 
-	resp, err := handler.UpdateBudgetAllocation(context.Background(), req)
+func TestUpdateBudget_KeepsStoredCurrency(t *testing.T) {
+	mockSvc := new(MockBudgetService)
+	handler := grpcserver.NewBudgetHandler(mockSvc)
+
+	id := makeUUID()
+	mockSvc.On("GetBudget", mock.Anything, id).
+		Return(&db.Budget{ID: id, Name: "Old", TotalAmount: "300", CurrencyCode: "EUR"}, nil)
+	mockSvc.On("UpdateBudget", mock.Anything, mock.MatchedBy(func(b *db.Budget) bool {
+		return b.Name == "Renamed" && b.TotalAmount == "300" && b.CurrencyCode == "EUR"
+	})).Return(&db.Budget{ID: id, Name: "Renamed", TotalAmount: "300", CurrencyCode: "EUR"}, nil)
+
+	resp, err := handler.UpdateBudget(context.Background(), &financepb.UpdateBudgetRequest{
+		Budget:     &financepb.Budget{Id: id.String(), Name: "Renamed"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
 	assert.NoError(t, err)
-	assert.Equal(t, expected.ID, resp.ID)
-	assert.Equal(t, expected.AllocatedAmount, resp.AllocatedAmount)
+	assert.Equal(t, "EUR", resp.TotalAmount.CurrencyCode)
+	mockSvc.AssertExpectations(t)
 }
 
 func TestListBudgetAllocations(t *testing.T) {
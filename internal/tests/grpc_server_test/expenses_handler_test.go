@@ -133,10 +133,13 @@ func TestUpdateExpense(t *testing.T) {
 
 	id := uuid.New()
 	exp := db.Expense{ID: id, Category: "Health", Amount: "1200"}
+	mockSvc.On("GetExpense", mock.Anything, id).Return(db.Expense{ID: id, Category: "Travel", Amount: "1200"}, nil)
 	mockSvc.On("UpdateExpense", mock.Anything, exp).Return(exp, nil)
 
-	body, _ := json.Marshal(exp)
-	req, _ := http.NewRequest("PUT", "/expenses/"+id.String(), bytes.NewBuffer(body))
+	// Only the masked category is taken from the body; the amount it leaves
+	// at zero keeps its stored value.
+	body, _ := json.Marshal(db.Expense{Category: "Health"})
+	req, _ := http.NewRequest("PUT", "/expenses/"+id.String()+"?update_mask=category", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
 	router.ServeHTTP(w, req)
@@ -146,6 +149,15 @@ func TestUpdateExpense(t *testing.T) {
 	_ = json.Unmarshal(w.Body.Bytes(), &got)
 	assert.Equal(t, exp.Category, got.Category)
 	mockSvc.AssertExpectations(t)
+
+	// Unknown and audit fields cannot be masked.
+	for _, mask := range []string{"colour", "CreatedBy"} {
+		req, _ = http.NewRequest("PUT", "/expenses/"+id.String()+"?update_mask="+mask, bytes.NewBuffer(body))
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code, mask)
+	}
+	mockSvc.AssertNumberOfCalls(t, "UpdateExpense", 1)
 }
 
 func TestDeleteExpense(t *testing.T) {
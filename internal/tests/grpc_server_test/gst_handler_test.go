@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/ports/grpc_server"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
 )
@@ -346,6 +347,19 @@ func (m *MockPublisher) PublishInvoiceDiscountAdded(ctx context.Context, disc *d
 	args := m.Called(ctx, disc)
 	return args.Error(0)
 }
+func (m *MockPublisher) PublishInvoiceStatusChanged(ctx context.Context, ev *ports.InvoiceStatusChangedEvent) error {
+	args := m.Called(ctx, ev)
+	return args.Error(0)
+}
+func (m *MockPublisher) PublishDunningReminder(ctx context.Context, ev *ports.DunningReminderEvent) error {
+	args := m.Called(ctx, ev)
+	return args.Error(0)
+}
+
+func (m *MockPublisher) PublishCreditLimitBreached(ctx context.Context, ev *ports.CreditLimitBreachedEvent) error {
+	args := m.Called(ctx, ev)
+	return args.Error(0)
+}
 
 // ---------------- Bank Transaction events ----------------
 func (m *MockPublisher) PublishBankTransactionImported(ctx context.Context, ev *db.BankAccount) error {
@@ -0,0 +1,642 @@
+package grpc_server_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	money "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/ubl"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	grpcserver "github.com/ShristiRnr/Finance_mierp/internal/core/ports/grpc_server"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+func TestInvoiceGRPCHandler_CreateInvoice(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	var sent db.Invoice
+	h := grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{
+		CreateFn: func(ctx context.Context, inv db.Invoice) (db.Invoice, error) {
+			sent = inv
+			if inv.GrandTotal != "" {
+				return db.Invoice{}, &services.InvoiceTotalsError{Mismatches: []services.TotalMismatch{
+					{Field: "grand_total", Client: inv.GrandTotal, Computed: "1180.00"},
+				}}
+			}
+			inv.Status = services.InvoiceDraft
+			inv.Subtotal, inv.GrandTotal = "1000.00", "1180.00"
+			inv.Items[0].Taxes[0].Amount = "90.00"
+			return inv, nil
+		},
+	}, nil)
+
+	req := &pb.CreateInvoiceRequest{
+		Meta: &pb.RequestMetadata{OrganizationId: "org-1"},
+		Invoice: &pb.Invoice{
+			InvoiceNumber: "INV-1",
+			Type:          pb.InvoiceType_INVOICE_TYPE_SALES,
+			InvoiceDate:   timestamppb.New(date),
+			Items: []*pb.InvoiceItem{{
+				Name:      "Widget",
+				Quantity:  2,
+				UnitPrice: &money.Money{CurrencyCode: "INR", Units: 500},
+				Taxes: []*pb.TaxLine{
+					{Type: pb.TaxType_TAX_TYPE_CGST, RatePercent: 9},
+					{Type: pb.TaxType_TAX_TYPE_SGST, RatePercent: 9},
+				},
+			}},
+		},
+	}
+	got, err := h.CreateInvoice(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "SALES", sent.Type)
+	assert.Equal(t, "org-1", sent.OrganizationID)
+	assert.Equal(t, "INR", sent.CurrencyCode)
+	assert.Equal(t, "500", sent.Items[0].UnitPrice)
+	assert.Equal(t, "CGST", sent.Items[0].Taxes[0].Name)
+	assert.Equal(t, "9", sent.Items[0].Taxes[0].Rate)
+	assert.Empty(t, sent.Subtotal, "no client figure to check")
+
+	assert.Equal(t, pb.InvoiceStatus_INVOICE_STATUS_DRAFT, got.Status)
+	assert.Equal(t, pb.InvoiceType_INVOICE_TYPE_SALES, got.Type)
+	assert.Equal(t, int64(1180), got.GrandTotal.Units)
+	assert.Equal(t, "INR", got.GrandTotal.CurrencyCode)
+	assert.Equal(t, pb.TaxType_TAX_TYPE_CGST, got.Items[0].Taxes[0].Type)
+	assert.Equal(t, int64(90), got.Items[0].Taxes[0].Amount.Units)
+
+	// Client totals that disagree come back per field.
+	req.Invoice.GrandTotal = &money.Money{CurrencyCode: "INR", Units: 1000}
+	_, err = h.CreateInvoice(ctx, req)
+	st, _ := status.FromError(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	br := st.Details()[0].(*errdetails.BadRequest)
+	assert.Equal(t, "invoice.grand_total", br.FieldViolations[0].Field)
+}
+
+func TestInvoiceGRPCHandler_StatusTransitions(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	issuedAt := time.Date(2025, 4, 2, 10, 0, 0, 0, time.UTC)
+
+	h := grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{
+		IssueFn: func(ctx context.Context, got uuid.UUID, by string) (db.Invoice, error) {
+			return db.Invoice{ID: got, Status: services.InvoiceIssued,
+				IssuedAt: sql.NullTime{Time: issuedAt, Valid: true}}, nil
+		},
+		VoidFn: func(ctx context.Context, got uuid.UUID, reason, by string) (db.Invoice, error) {
+			return db.Invoice{}, fmt.Errorf("%w: invoice %s is DRAFT and cannot move to VOID", services.ErrConflict, got)
+		},
+		MarkOverdueFn: func(ctx context.Context, got uuid.UUID, asOf time.Time, by string) (db.Invoice, error) {
+			return db.Invoice{}, fmt.Errorf("%w: invoice %s", services.ErrNotFound, got)
+		},
+	}, nil)
+
+	issued, err := h.IssueInvoice(ctx, &pb.IssueInvoiceRequest{Id: id.String()})
+	require.NoError(t, err)
+	assert.Equal(t, pb.InvoiceStatus_INVOICE_STATUS_ISSUED, issued.Status)
+	assert.Equal(t, issuedAt, issued.IssuedAt.AsTime())
+
+	_, err = h.VoidInvoice(ctx, &pb.VoidInvoiceRequest{Id: id.String(), Reason: "Duplicate"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = h.MarkOverdue(ctx, &pb.MarkOverdueRequest{Id: id.String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = h.IssueInvoice(ctx, &pb.IssueInvoiceRequest{Id: "nope"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestInvoiceGRPCHandler_UpdateMask(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	stored := db.Invoice{
+		ID:             id,
+		InvoiceNumber:  "INV-1",
+		Type:           services.InvoiceTypeSales,
+		Status:         services.InvoiceDraft,
+		OrganizationID: "org-1",
+		InvoiceDate:    time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+		CurrencyCode:   "INR",
+		PoNumber:       sql.NullString{String: "PO-9", Valid: true},
+		Subtotal:       "1000.00",
+		GrandTotal:     "1180.00",
+		Items:          []db.InvoiceItem{{Name: "Widget", Quantity: 2, UnitPrice: "500", LineSubtotal: "1000.00", LineTotal: "1000.00"}},
+	}
+
+	var sent db.Invoice
+	h := grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{
+		GetFn: func(ctx context.Context, got uuid.UUID) (db.Invoice, error) {
+			if got != id {
+				return db.Invoice{}, sql.ErrNoRows
+			}
+			return stored, nil
+		},
+		UpdateFn: func(ctx context.Context, inv db.Invoice) (db.Invoice, error) {
+			sent = inv
+			return inv, nil
+		},
+	}, nil)
+
+	// Only the masked field changes; the rest is kept as stored and the
+	// stored figures are left for the service to recompute.
+	_, err := h.UpdateInvoice(ctx, &pb.UpdateInvoiceRequest{
+		Invoice:    &pb.Invoice{Id: id.String(), InvoiceNumber: "INV-2"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"invoice_number"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "INV-2", sent.InvoiceNumber)
+	assert.Equal(t, "PO-9", sent.PoNumber.String)
+	assert.Equal(t, "org-1", sent.OrganizationID)
+	require.Len(t, sent.Items, 1)
+	assert.Equal(t, "Widget", sent.Items[0].Name)
+	assert.Empty(t, sent.GrandTotal)
+
+	// A masked field the request leaves unset is cleared.
+	_, err = h.UpdateInvoice(ctx, &pb.UpdateInvoiceRequest{
+		Invoice:    &pb.Invoice{Id: id.String()},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"po_number"}},
+	})
+	require.NoError(t, err)
+	assert.False(t, sent.PoNumber.Valid)
+	assert.Equal(t, "INV-1", sent.InvoiceNumber)
+
+	// An empty mask replaces the whole invoice.
+	_, err = h.UpdateInvoice(ctx, &pb.UpdateInvoiceRequest{
+		Invoice: &pb.Invoice{Id: id.String(), InvoiceNumber: "INV-3", Type: pb.InvoiceType_INVOICE_TYPE_SALES,
+			InvoiceDate: timestamppb.New(stored.InvoiceDate)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "INV-3", sent.InvoiceNumber)
+	assert.False(t, sent.PoNumber.Valid)
+	assert.Empty(t, sent.Items)
+
+	for _, path := range []string{"no_such_field", "status", "audit.created_by", "*,invoice_number"} {
+		_, err = h.UpdateInvoice(ctx, &pb.UpdateInvoiceRequest{
+			Invoice:    &pb.Invoice{Id: id.String()},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: strings.Split(path, ",")},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), path)
+	}
+
+	_, err = h.UpdateInvoice(ctx, &pb.UpdateInvoiceRequest{
+		Invoice:    &pb.Invoice{Id: uuid.NewString()},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"invoice_number"}},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestInvoiceGRPCHandler_GetInvoiceNetBilled(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	h := grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{
+		GetFn: func(ctx context.Context, got uuid.UUID) (db.Invoice, error) {
+			return db.Invoice{
+				ID: id, Type: services.InvoiceTypeSales, Status: services.InvoicePartiallyPaid, CurrencyCode: "INR",
+				GrandTotal: "1180.00", CreditedTotal: "200.00", DebitedTotal: "50.50",
+			}, nil
+		},
+	}, nil)
+
+	got, err := h.GetInvoice(ctx, &pb.GetInvoiceRequest{Id: id.String()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), got.CreditedTotal.Units)
+	assert.Equal(t, int64(50), got.DebitedTotal.Units)
+	assert.Equal(t, int64(1030), got.NetBilled.Units)
+	assert.Equal(t, int32(500000000), got.NetBilled.Nanos)
+	assert.Equal(t, "INR", got.NetBilled.CurrencyCode)
+}
+
+func TestInvoiceGRPCHandler_PostingRule(t *testing.T) {
+	ctx := context.Background()
+	arID, salesID, cgstID := uuid.New(), uuid.New(), uuid.New()
+
+	var sent db.InvoicePostingRule
+	h := grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{
+		SetRuleFn: func(ctx context.Context, rule db.InvoicePostingRule) (db.InvoicePostingRule, error) {
+			sent = rule
+			return rule, nil
+		},
+		GetRuleFn: func(ctx context.Context, orgID, invoiceType string) (db.InvoicePostingRule, error) {
+			return db.InvoicePostingRule{}, fmt.Errorf("%w: no posting rule for %s invoices of %s", services.ErrNotFound, invoiceType, orgID)
+		},
+	}, nil)
+
+	got, err := h.SetInvoicePostingRule(ctx, &pb.SetInvoicePostingRuleRequest{
+		Meta: &pb.RequestMetadata{OrganizationId: "org-1"},
+		Rule: &pb.InvoicePostingRule{
+			InvoiceType:      pb.InvoiceType_INVOICE_TYPE_SALES,
+			ControlAccountId: arID.String(),
+			LineAccountId:    salesID.String(),
+			CgstAccountId:    cgstID.String(),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "org-1", sent.OrganizationID)
+	assert.Equal(t, "SALES", sent.InvoiceType)
+	assert.Equal(t, uuid.NullUUID{UUID: cgstID, Valid: true}, sent.CgstAccountID)
+	assert.False(t, sent.SgstAccountID.Valid)
+	assert.Equal(t, pb.InvoiceType_INVOICE_TYPE_SALES, got.InvoiceType)
+	assert.Equal(t, cgstID.String(), got.CgstAccountId)
+	assert.Empty(t, got.SgstAccountId)
+
+	_, err = h.SetInvoicePostingRule(ctx, &pb.SetInvoicePostingRuleRequest{Rule: &pb.InvoicePostingRule{
+		ControlAccountId: arID.String(), LineAccountId: salesID.String(), IgstAccountId: "igst",
+	}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = h.GetInvoicePostingRule(ctx, &pb.GetInvoicePostingRuleRequest{InvoiceType: pb.InvoiceType_INVOICE_TYPE_PURCHASE})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestInvoiceGRPCHandler_NumberSeries(t *testing.T) {
+	ctx := context.Background()
+
+	var sent db.InvoiceNumberSeries
+	h := grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{
+		SetSeriesFn: func(ctx context.Context, series db.InvoiceNumberSeries) (db.InvoiceNumberSeries, error) {
+			sent = series
+			if series.Pattern == "INV" {
+				return db.InvoiceNumberSeries{}, fmt.Errorf("%w: number pattern must contain exactly one {SEQ} or {SEQ:n}", services.ErrInvalidInput)
+			}
+			return series, nil
+		},
+		GetSeriesFn: func(ctx context.Context, orgID, invoiceType string) (db.InvoiceNumberSeries, error) {
+			return db.InvoiceNumberSeries{}, fmt.Errorf("%w: no number series for %s invoices of %s", services.ErrNotFound, invoiceType, orgID)
+		},
+	}, nil)
+
+	got, err := h.SetInvoiceNumberSeries(ctx, &pb.SetInvoiceNumberSeriesRequest{
+		Meta:   &pb.RequestMetadata{OrganizationId: "org-1"},
+		Series: &pb.InvoiceNumberSeries{InvoiceType: pb.InvoiceType_INVOICE_TYPE_SALES, Pattern: "INV/{FY}/{SEQ:5}"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, db.InvoiceNumberSeries{OrganizationID: "org-1", InvoiceType: "SALES", Pattern: "INV/{FY}/{SEQ:5}"}, sent)
+	assert.Equal(t, pb.InvoiceType_INVOICE_TYPE_SALES, got.InvoiceType)
+	assert.Equal(t, "INV/{FY}/{SEQ:5}", got.Pattern)
+
+	_, err = h.SetInvoiceNumberSeries(ctx, &pb.SetInvoiceNumberSeriesRequest{Series: &pb.InvoiceNumberSeries{Pattern: "INV"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = h.SetInvoiceNumberSeries(ctx, &pb.SetInvoiceNumberSeriesRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = h.GetInvoiceNumberSeries(ctx, &pb.GetInvoiceNumberSeriesRequest{InvoiceType: pb.InvoiceType_INVOICE_TYPE_CHALLAN})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestInvoiceGRPCHandler_RenderInvoice(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	var logo bytes.Buffer
+	require.NoError(t, png.Encode(&logo, image.NewRGBA(image.Rect(0, 0, 40, 20))))
+	updated := time.Date(2025, 4, 2, 10, 0, 0, 0, time.UTC)
+	doc := ports.InvoiceDocument{
+		Invoice: db.Invoice{
+			ID: id, InvoiceNumber: "INV/2025-26/00001", Type: "SALES", Status: services.InvoiceIssued,
+			InvoiceDate: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), CurrencyCode: "INR",
+			Subtotal: "100000.00", TaxTotal: "18000.00", GrandTotal: "118000.00", RoundOff: "0",
+			UpdatedAt: sql.NullTime{Time: updated, Valid: true}, Revision: sql.NullInt32{Int32: 3, Valid: true},
+			Items: []db.InvoiceItem{{
+				Name: "Consulting – April", Hsn: sql.NullString{String: "998311", Valid: true}, Quantity: 1,
+				UnitPrice: "100000.00", LineSubtotal: "100000.00", LineTotal: "118000.00",
+				Taxes: []db.InvoiceItemTax{{Name: "CGST", Rate: "9", Amount: "9000.00"}, {Name: "SGST", Rate: "9", Amount: "9000.00"}},
+			}},
+			Gst:     &db.GstRegime{Gstin: "29ABCDE1234F1Z5", PlaceOfSupply: "29"},
+			GstDocs: &db.GstDocStatus{Irn: sql.NullString{String: "a1b2c3", Valid: true}, SignedQrCode: sql.NullString{String: "eyJhbGciOi.signed.qr", Valid: true}},
+		},
+		Template:      db.InvoiceTemplate{OrganizationID: "org-1", CompanyName: "Acme Pvt Ltd", Logo: logo.Bytes(), Terms: sql.NullString{String: "Due in 30 days", Valid: true}},
+		AmountInWords: "Rupees One Lakh Eighteen Thousand Only",
+	}
+	h := grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{
+		DocumentFn: func(ctx context.Context, got uuid.UUID) (ports.InvoiceDocument, error) {
+			if got != id {
+				return ports.InvoiceDocument{}, fmt.Errorf("%w: invoice %s", services.ErrNotFound, got)
+			}
+			return doc, nil
+		},
+	}, nil)
+
+	first, err := h.RenderInvoice(ctx, &pb.RenderInvoiceRequest{Id: id.String()})
+	require.NoError(t, err)
+	assert.Equal(t, "INV-2025-26-00001.pdf", first.FileName)
+	assert.Equal(t, "application/pdf", first.ContentType)
+	assert.Equal(t, int32(3), first.Revision)
+	assert.True(t, bytes.HasPrefix(first.Content, []byte("%PDF-")))
+
+	// The same revision renders to the same bytes.
+	second, err := h.RenderInvoice(ctx, &pb.RenderInvoiceRequest{Id: id.String()})
+	require.NoError(t, err)
+	assert.Equal(t, first.Content, second.Content)
+
+	_, err = h.RenderInvoice(ctx, &pb.RenderInvoiceRequest{Id: uuid.NewString()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestInvoiceGRPCHandler_SearchInvoices(t *testing.T) {
+	ctx := context.Background()
+	var sent ports.InvoiceFilter
+	h := grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{
+		SearchFn: func(ctx context.Context, f ports.InvoiceFilter, limit, offset int32) ([]db.Invoice, error) {
+			sent = f
+			return []db.Invoice{{ID: uuid.New(), OrganizationID: f.OrganizationID}}, nil
+		},
+	}, nil)
+
+	resp, err := h.SearchInvoices(ctx, &pb.SearchInvoicesRequest{
+		Meta:  &pb.RequestMetadata{OrganizationId: "org-1"},
+		Query: "acme",
+		Page: &pb.PageRequest{
+			Filter:  "status = 'INVOICE_STATUS_ISSUED' AND type = sales AND invoice_date >= '2025-04-01' AND due_date <= '2025-09-01' AND grand_total >= 10000",
+			OrderBy: "invoice_date desc",
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Invoices, 1)
+	assert.Equal(t, "org-1", sent.OrganizationID)
+	assert.Equal(t, "acme", sent.Query)
+	assert.Equal(t, services.InvoiceIssued, sent.Status)
+	assert.Equal(t, services.InvoiceTypeSales, sent.Type)
+	assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), sent.FromDate)
+	assert.True(t, sent.ToDate.IsZero())
+	assert.Equal(t, time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC).Add(-time.Microsecond), sent.DueTo)
+	assert.Equal(t, "10000", sent.MinTotal)
+	assert.Empty(t, sent.MaxTotal)
+	assert.Equal(t, services.OrderByDate, sent.OrderBy)
+	assert.True(t, sent.Descending)
+
+	for _, page := range []*pb.PageRequest{
+		{Filter: "status >= 'ISSUED'"},
+		{Filter: "party = 'Acme'"},
+		{Filter: "due_date = 'tomorrow'"},
+		{OrderBy: "invoice_number asc"},
+	} {
+		_, err := h.SearchInvoices(ctx, &pb.SearchInvoicesRequest{Page: page})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), page.String())
+	}
+}
+
+type mockEInvoiceService struct {
+	ExportFn func(ctx context.Context, id uuid.UUID) (ports.EInvoice, error)
+	ImportFn func(ctx context.Context, orgID string, e ports.EInvoice, by string) (db.Invoice, error)
+}
+
+func (m *mockEInvoiceService) ExportDocument(ctx context.Context, id uuid.UUID) (ports.EInvoice, error) {
+	return m.ExportFn(ctx, id)
+}
+
+func (m *mockEInvoiceService) Import(ctx context.Context, orgID string, e ports.EInvoice, by string) (db.Invoice, error) {
+	return m.ImportFn(ctx, orgID, e, by)
+}
+
+func exportableInvoice() ports.EInvoice {
+	valid := func(s string) sql.NullString { return sql.NullString{String: s, Valid: true} }
+	party := func(name, endpoint, country, gstin string) ports.Party {
+		return ports.Party{
+			Party:            db.Party{Name: name, PeppolEndpoint: valid(endpoint)},
+			Addresses:        []db.PartyAddress{{Kind: services.AddressBilling, Line1: "1 Main Road", City: valid("Pune"), CountryCode: country, IsDefault: true}},
+			TaxRegistrations: []db.PartyTaxRegistration{{TaxType: services.TaxRegistrationGSTIN, Number: gstin}},
+		}
+	}
+	return ports.EInvoice{
+		Invoice: db.Invoice{
+			ID:            uuid.New(),
+			InvoiceNumber: "INV/2025/001",
+			Type:          services.InvoiceTypeSales,
+			Status:        services.InvoiceIssued,
+			InvoiceDate:   time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+			DueDate:       sql.NullTime{Time: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+			PoNumber:      valid("PO-7"),
+			CurrencyCode:  "INR",
+			Subtotal:      "1200.00",
+			DiscountTotal: "100.00",
+			TaxTotal:      "172.00",
+			RoundOff:      "0.00",
+			GrandTotal:    "1272.00",
+			Revision:      sql.NullInt32{Int32: 2, Valid: true},
+			Items: []db.InvoiceItem{
+				{
+					Name: "Widget", Hsn: valid("8471"), Quantity: 2, UnitPrice: "500.00", LineSubtotal: "1000.00", LineTotal: "1062.00",
+					Discounts: []db.InvoiceItemDiscount{{Description: valid("Launch offer"), Amount: "100.00"}},
+					Taxes: []db.InvoiceItemTax{
+						{Name: services.TaxCGST, Rate: "9", Amount: "81.00"},
+						{Name: services.TaxSGST, Rate: "9", Amount: "81.00"},
+					},
+				},
+				{
+					Name: "Manual", Quantity: 1, UnitPrice: "200.00", LineSubtotal: "200.00", LineTotal: "210.00",
+					Taxes: []db.InvoiceItemTax{{Name: services.TaxIGST, Rate: "5", Amount: "10.00"}},
+				},
+			},
+		},
+		Seller:       party("Acme Exports", "0088:5790000435975", "IN", "27AAAAA0000A1Z5"),
+		Buyer:        party("Globex", "0192:987654321", "IN", "29BBBBB1111B1Z3"),
+		PaymentTerms: "Net 30",
+	}
+}
+
+func TestInvoiceGRPCHandler_ExportImportInvoice(t *testing.T) {
+	ctx := context.Background()
+	e := exportableInvoice()
+	var imported ports.EInvoice
+	h := grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{}, &mockEInvoiceService{
+		ExportFn: func(ctx context.Context, id uuid.UUID) (ports.EInvoice, error) {
+			if id != e.Invoice.ID {
+				return ports.EInvoice{}, fmt.Errorf("%w: invoice %s", services.ErrNotFound, id)
+			}
+			return e, nil
+		},
+		ImportFn: func(ctx context.Context, orgID string, in ports.EInvoice, by string) (db.Invoice, error) {
+			imported = in
+			inv := in.Invoice
+			inv.ID, inv.OrganizationID, inv.Type = uuid.New(), orgID, services.InvoiceTypePurchase
+			return inv, nil
+		},
+	})
+
+	out, err := h.ExportInvoice(ctx, &pb.ExportInvoiceRequest{Id: e.Invoice.ID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, "INV-2025-001.xml", out.FileName)
+	assert.Equal(t, "application/xml", out.ContentType)
+	assert.Equal(t, int32(2), out.Revision)
+	for _, want := range []string{
+		`<cbc:EndpointID schemeID="0088">5790000435975</cbc:EndpointID>`,
+		`<cbc:LineExtensionAmount currencyID="INR">900.00</cbc:LineExtensionAmount>`,
+		`<cbc:TaxAmount currencyID="INR">162.00</cbc:TaxAmount>`,
+		`<cbc:ItemClassificationCode listID="HS">8471</cbc:ItemClassificationCode>`,
+		`<cbc:PayableAmount currencyID="INR">1272.00</cbc:PayableAmount>`,
+	} {
+		assert.Contains(t, string(out.Content), want)
+	}
+
+	// The export reads back as the same invoice, bought from the seller.
+	inv, err := h.ImportInvoice(ctx, &pb.ImportInvoiceRequest{Meta: &pb.RequestMetadata{OrganizationId: "org-2"}, Content: out.Content})
+	require.NoError(t, err)
+	assert.Equal(t, pb.InvoiceType_INVOICE_TYPE_PURCHASE, inv.Type)
+	got := imported.Invoice
+	assert.Equal(t, "INV/2025/001", got.InvoiceNumber)
+	assert.Equal(t, "PO-7", got.PoNumber.String)
+	assert.Equal(t, "1272.00", got.GrandTotal)
+	assert.Equal(t, "172.00", got.TaxTotal)
+	require.Len(t, got.Items, 2)
+	assert.Equal(t, int32(2), got.Items[0].Quantity)
+	assert.Equal(t, "8471", got.Items[0].Hsn.String)
+	assert.Equal(t, "100.00", got.Items[0].Discounts[0].Amount)
+	assert.Equal(t, "18", got.Items[0].Taxes[0].Rate)
+	assert.Equal(t, "5", got.Items[1].Taxes[0].Rate)
+	assert.Empty(t, got.Taxes)
+	assert.Equal(t, "Acme Exports", imported.Seller.Name)
+	assert.Equal(t, "0088:5790000435975", imported.Seller.PeppolEndpoint.String)
+	require.Len(t, imported.Seller.TaxRegistrations, 1)
+	assert.Equal(t, services.TaxRegistrationGSTIN, imported.Seller.TaxRegistrations[0].TaxType)
+	assert.Equal(t, "27AAAAA0000A1Z5", imported.Seller.TaxRegistrations[0].Number)
+
+	// Unknown invoices are not found; malformed ids are rejected.
+	_, err = h.ExportInvoice(ctx, &pb.ExportInvoiceRequest{Id: uuid.NewString()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = h.ExportInvoice(ctx, &pb.ExportInvoiceRequest{Id: "nope"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestInvoiceGRPCHandler_EInvoiceViolations(t *testing.T) {
+	ctx := context.Background()
+	e := exportableInvoice()
+	// An invoice discount over lines of two VAT rates has no UBL category,
+	// and the buyer has no electronic address.
+	e.Invoice.Discounts = []db.InvoiceDiscount{{Amount: "10.00"}}
+	e.Buyer.PeppolEndpoint = sql.NullString{}
+	h := grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{}, &mockEInvoiceService{
+		ExportFn: func(ctx context.Context, id uuid.UUID) (ports.EInvoice, error) { return e, nil },
+	})
+
+	_, err := h.ExportInvoice(ctx, &pb.ExportInvoiceRequest{Id: uuid.NewString()})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	violations := fieldViolations(err)
+	assert.Contains(t, violations["Invoice/cac:AllowanceCharge[1]/cac:TaxCategory"], "BR-32")
+	assert.Contains(t, violations["Invoice/cac:AccountingCustomerParty/cac:Party/cbc:EndpointID"], "PEPPOL-EN16931-R010")
+
+	// Inbound documents are checked the same way.
+	for _, content := range []string{
+		`<CreditNote xmlns="urn:oasis:names:specification:ubl:schema:xsd:CreditNote-2"/>`,
+		`<Invoice xmlns="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"><cbc:ID xmlns:cbc="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2">X</cbc:ID></Invoice>`,
+		`not xml`,
+	} {
+		_, err := h.ImportInvoice(ctx, &pb.ImportInvoiceRequest{Content: []byte(content)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), content)
+	}
+}
+
+// fieldViolations collects the BadRequest details of err by field.
+func fieldViolations(err error) map[string]string {
+	violations := map[string]string{}
+	for _, d := range status.Convert(err).Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				violations[v.Field] = v.Description
+			}
+		}
+	}
+	return violations
+}
+
+func TestInvoiceGRPCHandler_EInvoiceSchemas(t *testing.T) {
+	ctx := context.Background()
+	e := exportableInvoice()
+	einvoices := &mockEInvoiceService{
+		ExportFn: func(ctx context.Context, id uuid.UUID) (ports.EInvoice, error) { return e, nil },
+	}
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		return path
+	}
+
+	// The Schematron's fatal assertions are violations under the element
+	// they locate; its warnings are not.
+	svrl := write("report.svrl", `<svrl:schematron-output xmlns:svrl="http://purl.oclc.org/dsdl/svrl">
+  <svrl:failed-assert id="PEPPOL-EN16931-R008" flag="fatal"
+      location="/*:Invoice[namespace-uri()='urn:oasis:names:specification:ubl:schema:xsd:Invoice-2'][1]/*:InvoiceLine[namespace-uri()='urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2'][2]/*:Item[namespace-uri()='urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2'][1]">
+    <svrl:text>Document MUST not contain
+      empty elements.</svrl:text>
+  </svrl:failed-assert>
+  <svrl:failed-assert id="PEPPOL-EN16931-R002" flag="warning" location="/*:Invoice[namespace-uri()='urn:oasis:names:specification:ubl:schema:xsd:Invoice-2'][1]">
+    <svrl:text>No more than one note is allowed.</svrl:text>
+  </svrl:failed-assert>
+</svrl:schematron-output>`)
+	// The processor is handed the document in place of "{}".
+	schematron := ubl.NewSchematron("sh", "-c", `test -s "$1" && cat "$2"`, "sh", "{}", svrl)
+	h := grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{}, einvoices, schematron)
+
+	_, err := h.ExportInvoice(ctx, &pb.ExportInvoiceRequest{Id: uuid.NewString()})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, map[string]string{
+		"Invoice/cac:InvoiceLine[2]/cac:Item": "PEPPOL-EN16931-R008: Document MUST not contain empty elements.",
+	}, fieldViolations(err))
+
+	// Inbound documents go through the same schemas.
+	content, err := ubl.Export(e)
+	require.NoError(t, err)
+	_, err = h.ImportInvoice(ctx, &pb.ImportInvoiceRequest{Content: content})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, fieldViolations(err), "Invoice/cac:InvoiceLine[2]/cac:Item")
+
+	// Schemas that cannot be run fail the export rather than skip it.
+	h = grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{}, einvoices, ubl.Unavailable(fmt.Errorf("schemas not installed")))
+	_, err = h.ExportInvoice(ctx, &pb.ExportInvoiceRequest{Id: uuid.NewString()})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	_, err = ubl.LoadSchemas(dir, "saxon")
+	assert.Error(t, err)
+
+	if _, err := exec.LookPath("xmllint"); err != nil {
+		t.Skip("xmllint is not installed")
+	}
+	// The XSD reports an element it rejects by its path.
+	write("cbc.xsd", `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"
+    targetNamespace="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2" elementFormDefault="qualified">
+  <xs:element name="CustomizationID">
+    <xs:simpleType><xs:restriction base="xs:string"><xs:pattern value="urn:example"/></xs:restriction></xs:simpleType>
+  </xs:element>
+</xs:schema>`)
+	xsd := write("invoice.xsd", `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"
+    targetNamespace="urn:oasis:names:specification:ubl:schema:xsd:Invoice-2" elementFormDefault="qualified">
+  <xs:import namespace="urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2" schemaLocation="cbc.xsd"/>
+  <xs:element name="Invoice">
+    <xs:complexType><xs:sequence>
+      <xs:any namespace="##other" processContents="lax" maxOccurs="unbounded"/>
+    </xs:sequence></xs:complexType>
+  </xs:element>
+</xs:schema>`)
+	h = grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{}, einvoices, ubl.NewXSD(xsd))
+	_, err = h.ExportInvoice(ctx, &pb.ExportInvoiceRequest{Id: uuid.NewString()})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	violations := fieldViolations(err)
+	assert.Len(t, violations, 1)
+	assert.Contains(t, violations["Invoice/cbc:CustomizationID"], "UBL: Element")
+
+	_, err = grpcserver.NewInvoiceGRPCHandler(&mockInvoiceService{}, einvoices, ubl.NewXSD(filepath.Join(dir, "missing.xsd"))).
+		ExportInvoice(ctx, &pb.ExportInvoiceRequest{Id: uuid.NewString()})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
@@ -5,8 +5,10 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,7 +17,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/ports/grpc_server"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
 )
 
 
@@ -25,12 +29,16 @@ type InvoiceServiceInterface interface {
     UpdateInvoice(ctx context.Context, inv db.Invoice) (db.Invoice, error)
     DeleteInvoice(ctx context.Context, id uuid.UUID) error
     ListInvoices(ctx context.Context, limit, offset int32) ([]db.Invoice, error)
-    SearchInvoices(ctx context.Context, query string, limit, offset int32) ([]db.Invoice, error)
+    SearchInvoices(ctx context.Context, f ports.InvoiceFilter, limit, offset int32) ([]db.Invoice, error)
 
     CreateInvoiceItem(ctx context.Context, item db.InvoiceItem) (db.InvoiceItem, error)
     ListInvoiceItems(ctx context.Context, invoiceID uuid.UUID) ([]db.InvoiceItem, error)
     AddInvoiceTax(ctx context.Context, tax db.InvoiceTax) (db.InvoiceTax, error)
     AddInvoiceDiscount(ctx context.Context, discount db.InvoiceDiscount) (db.InvoiceDiscount, error)
+
+    IssueInvoice(ctx context.Context, id uuid.UUID, by string) (db.Invoice, error)
+    VoidInvoice(ctx context.Context, id uuid.UUID, reason, by string) (db.Invoice, error)
+    MarkOverdue(ctx context.Context, id uuid.UUID, asOf time.Time, by string) (db.Invoice, error)
 }
 
 
@@ -40,11 +48,21 @@ type mockInvoiceService struct {
 	UpdateFn         func(ctx context.Context, inv db.Invoice) (db.Invoice, error)
 	DeleteFn         func(ctx context.Context, id uuid.UUID) error
 	ListFn           func(ctx context.Context, limit, offset int32) ([]db.Invoice, error)
-	SearchFn         func(ctx context.Context, query string, limit, offset int32) ([]db.Invoice, error)
+	SearchFn         func(ctx context.Context, f ports.InvoiceFilter, limit, offset int32) ([]db.Invoice, error)
 	CreateItemFn     func(ctx context.Context, item db.InvoiceItem) (db.InvoiceItem, error)
 	ListItemsFn      func(ctx context.Context, invoiceID uuid.UUID) ([]db.InvoiceItem, error)
 	AddTaxFn         func(ctx context.Context, tax db.InvoiceTax) (db.InvoiceTax, error)
 	AddDiscountFn    func(ctx context.Context, discount db.InvoiceDiscount) (db.InvoiceDiscount, error)
+	IssueFn          func(ctx context.Context, id uuid.UUID, by string) (db.Invoice, error)
+	VoidFn           func(ctx context.Context, id uuid.UUID, reason, by string) (db.Invoice, error)
+	MarkOverdueFn    func(ctx context.Context, id uuid.UUID, asOf time.Time, by string) (db.Invoice, error)
+	SetRuleFn        func(ctx context.Context, rule db.InvoicePostingRule) (db.InvoicePostingRule, error)
+	GetRuleFn        func(ctx context.Context, orgID, invoiceType string) (db.InvoicePostingRule, error)
+	SetSeriesFn      func(ctx context.Context, series db.InvoiceNumberSeries) (db.InvoiceNumberSeries, error)
+	GetSeriesFn      func(ctx context.Context, orgID, invoiceType string) (db.InvoiceNumberSeries, error)
+	SetTemplateFn    func(ctx context.Context, t db.InvoiceTemplate) (db.InvoiceTemplate, error)
+	GetTemplateFn    func(ctx context.Context, orgID string) (db.InvoiceTemplate, error)
+	DocumentFn       func(ctx context.Context, id uuid.UUID) (ports.InvoiceDocument, error)
 }
 
 // Safe mock methods
@@ -83,11 +101,11 @@ func (m *mockInvoiceService) ListInvoices(ctx context.Context, limit, offset int
 	return m.ListFn(ctx, limit, offset)
 }
 
-func (m *mockInvoiceService) SearchInvoices(ctx context.Context, query string, limit, offset int32) ([]db.Invoice, error) {
+func (m *mockInvoiceService) SearchInvoices(ctx context.Context, f ports.InvoiceFilter, limit, offset int32) ([]db.Invoice, error) {
 	if m.SearchFn == nil {
 		return nil, nil
 	}
-	return m.SearchFn(ctx, query, limit, offset)
+	return m.SearchFn(ctx, f, limit, offset)
 }
 
 func (m *mockInvoiceService) CreateInvoiceItem(ctx context.Context, item db.InvoiceItem) (db.InvoiceItem, error) {
@@ -118,6 +136,76 @@ func (m *mockInvoiceService) AddInvoiceDiscount(ctx context.Context, discount db
 	return m.AddDiscountFn(ctx, discount)
 }
 
+func (m *mockInvoiceService) IssueInvoice(ctx context.Context, id uuid.UUID, by string) (db.Invoice, error) {
+	if m.IssueFn == nil {
+		return db.Invoice{}, nil
+	}
+	return m.IssueFn(ctx, id, by)
+}
+
+func (m *mockInvoiceService) VoidInvoice(ctx context.Context, id uuid.UUID, reason, by string) (db.Invoice, error) {
+	if m.VoidFn == nil {
+		return db.Invoice{}, nil
+	}
+	return m.VoidFn(ctx, id, reason, by)
+}
+
+func (m *mockInvoiceService) MarkOverdue(ctx context.Context, id uuid.UUID, asOf time.Time, by string) (db.Invoice, error) {
+	if m.MarkOverdueFn == nil {
+		return db.Invoice{}, nil
+	}
+	return m.MarkOverdueFn(ctx, id, asOf, by)
+}
+
+func (m *mockInvoiceService) SetPostingRule(ctx context.Context, rule db.InvoicePostingRule) (db.InvoicePostingRule, error) {
+	if m.SetRuleFn == nil {
+		return rule, nil
+	}
+	return m.SetRuleFn(ctx, rule)
+}
+
+func (m *mockInvoiceService) GetPostingRule(ctx context.Context, orgID, invoiceType string) (db.InvoicePostingRule, error) {
+	if m.GetRuleFn == nil {
+		return db.InvoicePostingRule{}, nil
+	}
+	return m.GetRuleFn(ctx, orgID, invoiceType)
+}
+
+func (m *mockInvoiceService) SetNumberSeries(ctx context.Context, series db.InvoiceNumberSeries) (db.InvoiceNumberSeries, error) {
+	if m.SetSeriesFn == nil {
+		return series, nil
+	}
+	return m.SetSeriesFn(ctx, series)
+}
+
+func (m *mockInvoiceService) GetNumberSeries(ctx context.Context, orgID, invoiceType string) (db.InvoiceNumberSeries, error) {
+	if m.GetSeriesFn == nil {
+		return db.InvoiceNumberSeries{}, nil
+	}
+	return m.GetSeriesFn(ctx, orgID, invoiceType)
+}
+
+func (m *mockInvoiceService) SetTemplate(ctx context.Context, t db.InvoiceTemplate) (db.InvoiceTemplate, error) {
+	if m.SetTemplateFn == nil {
+		return t, nil
+	}
+	return m.SetTemplateFn(ctx, t)
+}
+
+func (m *mockInvoiceService) GetTemplate(ctx context.Context, orgID string) (db.InvoiceTemplate, error) {
+	if m.GetTemplateFn == nil {
+		return db.InvoiceTemplate{}, nil
+	}
+	return m.GetTemplateFn(ctx, orgID)
+}
+
+func (m *mockInvoiceService) InvoiceDocument(ctx context.Context, id uuid.UUID) (ports.InvoiceDocument, error) {
+	if m.DocumentFn == nil {
+		return ports.InvoiceDocument{}, nil
+	}
+	return m.DocumentFn(ctx, id)
+}
+
 //  Tests
 func newRouter(h *grpc_server.InvoiceHandler) *chi.Mux {
 	r := chi.NewRouter()
@@ -139,6 +227,11 @@ func newRouter(h *grpc_server.InvoiceHandler) *chi.Mux {
 	r.Post("/invoices/{id}/taxes", h.AddInvoiceTax)
 	r.Post("/invoices/{id}/discounts", h.AddInvoiceDiscount)
 
+	// Status transitions
+	r.Post("/invoices/{id}/issue", h.IssueInvoice)
+	r.Post("/invoices/{id}/void", h.VoidInvoice)
+	r.Post("/invoices/{id}/overdue", h.MarkOverdue)
+
 	return r
 }
 
@@ -179,14 +272,15 @@ func TestInvoiceHandler(t *testing.T) {
 
 	t.Run("SearchInvoices", func(t *testing.T) {
 		mockSvc := &mockInvoiceService{
-			SearchFn: func(ctx context.Context, query string, limit, offset int32) ([]db.Invoice, error) {
+			SearchFn: func(ctx context.Context, f ports.InvoiceFilter, limit, offset int32) ([]db.Invoice, error) {
+				require.Equal(t, ports.InvoiceFilter{OrganizationID: "org-1", Query: "INV", Status: "ISSUED"}, f)
 				return []db.Invoice{inv}, nil
 			},
 		}
 		handler := grpc_server.NewInvoiceHandler(mockSvc)
 		router := newRouter(handler)
 
-		req := httptest.NewRequest(http.MethodGet, "/invoices/search?q=INV", nil)
+		req := httptest.NewRequest(http.MethodGet, "/invoices/search?q=INV&org_id=org-1&filter=status+%3D+ISSUED", nil)
 		w := httptest.NewRecorder()
 		router.ServeHTTP(w, req)
 
@@ -272,4 +366,49 @@ func TestInvoiceHandler(t *testing.T) {
 		require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
 		require.Equal(t, discount.ID, got.ID)
 	})
+
+	t.Run("VoidInvoice", func(t *testing.T) {
+		mockSvc := &mockInvoiceService{
+			VoidFn: func(ctx context.Context, id uuid.UUID, reason, by string) (db.Invoice, error) {
+				if reason == "" {
+					return db.Invoice{}, fmt.Errorf("%w: a reason is required", services.ErrInvalidInput)
+				}
+				voided := inv
+				voided.Status = services.InvoiceVoid
+				voided.VoidReason = sql.NullString{String: reason, Valid: true}
+				voided.VoidedBy = sql.NullString{String: by, Valid: true}
+				return voided, nil
+			},
+		}
+		router := newRouter(grpc_server.NewInvoiceHandler(mockSvc))
+
+		req := httptest.NewRequest(http.MethodPost, "/invoices/"+invID.String()+"/void", strings.NewReader(`{"Reason":"Duplicate"}`))
+		req.Header.Set("X-User-ID", "clerk")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		var got db.Invoice
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+		require.Equal(t, "VOID", got.Status)
+		require.Equal(t, "clerk", got.VoidedBy.String)
+
+		req = httptest.NewRequest(http.MethodPost, "/invoices/"+invID.String()+"/void", strings.NewReader(`{}`))
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("IssueInvoice conflict", func(t *testing.T) {
+		mockSvc := &mockInvoiceService{
+			IssueFn: func(ctx context.Context, id uuid.UUID, by string) (db.Invoice, error) {
+				return db.Invoice{}, fmt.Errorf("%w: invoice is PAID", services.ErrConflict)
+			},
+		}
+		router := newRouter(grpc_server.NewInvoiceHandler(mockSvc))
+
+		req := httptest.NewRequest(http.MethodPost, "/invoices/"+invID.String()+"/issue", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusConflict, w.Code)
+	})
 }
@@ -0,0 +1,111 @@
+package grpc_server_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	grpcserver "github.com/ShristiRnr/Finance_mierp/internal/core/ports/grpc_server"
+)
+
+type MockJournalImportService struct{ mock.Mock }
+
+func (m *MockJournalImportService) Import(ctx context.Context, rows [][]string, opts ports.JournalImportOptions) (*ports.JournalImportReport, error) {
+	args := m.Called(ctx, rows, opts)
+	if res := args.Get(0); res != nil {
+		return res.(*ports.JournalImportReport), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// testWorkbook builds a one-sheet XLSX with a shared string, an inline
+// string, a skipped column and a skipped row.
+func testWorkbook(t *testing.T) []byte {
+	files := map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+  xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+  <sheets><sheet name="Journals" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+</Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <si><t>reference</t></si><si><r><t>acc</t></r><r><t>ount_code</t></r></si><si><t>OB-1</t></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+  <row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="inlineStr"><is><t>date</t></is></c><c r="C1" t="s"><v>1</v></c><c r="D1" t="inlineStr"><is><t>debit</t></is></c></row>
+  <row r="3"><c r="A3" t="s"><v>2</v></c><c r="B3"><v>45748</v></c><c r="D3"><v>125.5</v></c></row>
+</sheetData></worksheet>`,
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, body := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(body))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestLedgerHandler_ImportJournalEntries(t *testing.T) {
+	ctx := context.Background()
+	mockImp := new(MockJournalImportService)
+	h := grpcserver.NewLedgerHandler(nil, nil, nil, nil, mockImp)
+
+	// CSV, committed.
+	csvRows := [][]string{
+		{"reference", "date", "account_code", "debit", "credit"},
+		{"OB-1", "2025-04-01", "1000", "100", ""},
+		{"OB-1", "2025-04-01", "3000", "", "100"},
+	}
+	mockImp.On("Import", mock.Anything, csvRows, ports.JournalImportOptions{OrganizationID: "org", Post: true}).
+		Return(&ports.JournalImportReport{Entries: 1, Lines: 2, Committed: true}, nil).Once()
+
+	resp, err := h.ImportJournalEntries(ctx, &pb.ImportJournalEntriesRequest{
+		Meta:     &pb.RequestMetadata{OrganizationId: "org"},
+		Filename: "opening.csv",
+		Content:  []byte("\xef\xbb\xbfreference,date,account_code,debit,credit\nOB-1,2025-04-01,1000,100,\nOB-1,2025-04-01,3000,,100\n"),
+		Post:     true,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Committed)
+	assert.EqualValues(t, 2, resp.Lines)
+
+	// XLSX dry run with row errors.
+	xlsxRows := [][]string{
+		{"reference", "date", "account_code", "debit"},
+		nil,
+		{"OB-1", "45748", "", "125.5"},
+	}
+	mockImp.On("Import", mock.Anything, xlsxRows, ports.JournalImportOptions{DryRun: true}).
+		Return(&ports.JournalImportReport{Entries: 1, Lines: 1, Errors: []ports.JournalImportError{
+			{Row: 3, Reference: "OB-1", Field: "account_code", Message: "account_code is required"},
+		}}, nil).Once()
+
+	resp, err = h.ImportJournalEntries(ctx, &pb.ImportJournalEntriesRequest{
+		Filename: "OPENING.XLSX",
+		Content:  testWorkbook(t),
+		DryRun:   true,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Committed)
+	require.Len(t, resp.Errors, 1)
+	assert.EqualValues(t, 3, resp.Errors[0].Row)
+	assert.Equal(t, "account_code", resp.Errors[0].Field)
+
+	// Unreadable files never reach the service.
+	_, err = h.ImportJournalEntries(ctx, &pb.ImportJournalEntriesRequest{Filename: "opening.ods", Content: []byte("x")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = h.ImportJournalEntries(ctx, &pb.ImportJournalEntriesRequest{Filename: "opening.xlsx", Content: []byte("not a zip")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockImp.AssertNumberOfCalls(t, "Import", 2)
+}
@@ -0,0 +1,199 @@
+package grpc_server_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	money "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	grpcserver "github.com/ShristiRnr/Finance_mierp/internal/core/ports/grpc_server"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+// mockPartyService calls the Fn set for each method. Create, Delete and List
+// succeed without one.
+type mockPartyService struct {
+	CreateFn func(ctx context.Context, p *ports.Party) (*ports.Party, error)
+	GetFn    func(ctx context.Context, id uuid.UUID) (*ports.Party, error)
+	UpdateFn func(ctx context.Context, p *ports.Party) (*ports.Party, error)
+	DeleteFn func(ctx context.Context, id uuid.UUID) error
+	ListFn   func(ctx context.Context, f ports.PartyFilter, limit, offset int32) ([]*ports.Party, error)
+}
+
+func (m *mockPartyService) Create(ctx context.Context, p *ports.Party) (*ports.Party, error) {
+	if m.CreateFn == nil {
+		return p, nil
+	}
+	return m.CreateFn(ctx, p)
+}
+func (m *mockPartyService) Get(ctx context.Context, id uuid.UUID) (*ports.Party, error) {
+	return m.GetFn(ctx, id)
+}
+func (m *mockPartyService) Update(ctx context.Context, p *ports.Party) (*ports.Party, error) {
+	return m.UpdateFn(ctx, p)
+}
+func (m *mockPartyService) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFn == nil {
+		return nil
+	}
+	return m.DeleteFn(ctx, id)
+}
+func (m *mockPartyService) List(ctx context.Context, f ports.PartyFilter, limit, offset int32) ([]*ports.Party, error) {
+	if m.ListFn == nil {
+		return nil, nil
+	}
+	return m.ListFn(ctx, f, limit, offset)
+}
+
+func TestPartyHandler_CreateParty(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-id", "alice"))
+	var sent *ports.Party
+	createErr := error(nil)
+	h := grpcserver.NewPartyHandler(&mockPartyService{
+		CreateFn: func(ctx context.Context, p *ports.Party) (*ports.Party, error) {
+			sent = p
+			if createErr != nil {
+				return nil, createErr
+			}
+			out := *p
+			out.ID = uuid.New()
+			return &out, nil
+		},
+	})
+
+	resp, err := h.CreateParty(ctx, &pb.CreatePartyRequest{
+		Meta: &pb.RequestMetadata{OrganizationId: "org-1"},
+		Party: &pb.Party{
+			Kind:             pb.PartyKind_PARTY_BOTH,
+			Name:             "Globex",
+			DefaultCurrency:  "INR",
+			PaymentTermsDays: 45,
+			CreditLimit:      &money.Money{CurrencyCode: "INR", Units: 250000},
+			PeppolEndpoint:   "0192:987654321",
+			Contacts:         []*pb.PartyContact{{Name: "Hank", Email: "hank@globex.test", IsPrimary: true}},
+			Addresses: []*pb.PartyAddress{{
+				Kind: pb.AddressKind_ADDRESS_BILLING, Line1: "1 Main Road", City: "Pune", CountryCode: "IN", IsDefault: true,
+			}},
+			TaxRegistrations: []*pb.PartyTaxRegistration{{TaxType: services.TaxRegistrationGSTIN, Number: "27AAAAA0000A1Z5"}},
+			BankAccounts:     []*pb.PartyBankAccount{{AccountName: "Globex", AccountNumber: "001122", Ifsc: "HDFC0000001"}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "org-1", sent.OrganizationID)
+	assert.Equal(t, services.PartyBoth, sent.Kind)
+	assert.Equal(t, "alice", sent.CreatedBy.String)
+	assert.Equal(t, "alice", sent.UpdatedBy.String)
+	assert.Equal(t, int32(45), sent.PaymentTermsDays.Int32)
+	assert.Equal(t, "250000", sent.CreditLimit.String)
+	assert.Equal(t, "hank@globex.test", sent.Contacts[0].Email.String)
+	assert.Equal(t, services.AddressBilling, sent.Addresses[0].Kind)
+	assert.Equal(t, "Pune", sent.Addresses[0].City.String)
+	assert.Equal(t, "27AAAAA0000A1Z5", sent.TaxRegistrations[0].Number)
+	assert.Equal(t, "HDFC0000001", sent.BankAccounts[0].Ifsc.String)
+
+	assert.NotEmpty(t, resp.Id)
+	assert.Equal(t, pb.PartyKind_PARTY_BOTH, resp.Kind)
+	assert.Equal(t, int64(250000), resp.CreditLimit.Units)
+	assert.Equal(t, "INR", resp.CreditLimit.CurrencyCode)
+	assert.Equal(t, pb.AddressKind_ADDRESS_BILLING, resp.Addresses[0].Kind)
+
+	sent = nil
+	_, err = h.CreateParty(ctx, &pb.CreatePartyRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Nil(t, sent)
+
+	for want, svcErr := range map[codes.Code]error{
+		codes.InvalidArgument:    fmt.Errorf("%w: name is required", services.ErrInvalidInput),
+		codes.FailedPrecondition: fmt.Errorf("%w: GSTIN already registered", services.ErrConflict),
+		codes.Internal:           errors.New("connection reset"),
+	} {
+		createErr = svcErr
+		_, err = h.CreateParty(ctx, &pb.CreatePartyRequest{Party: &pb.Party{Name: "Globex"}})
+		assert.Equal(t, want, status.Code(err), svcErr.Error())
+	}
+}
+
+func TestPartyHandler_GetDeleteParty(t *testing.T) {
+	ctx := context.Background()
+	id, missing := uuid.New(), uuid.New()
+	h := grpcserver.NewPartyHandler(&mockPartyService{
+		GetFn: func(ctx context.Context, got uuid.UUID) (*ports.Party, error) {
+			if got != id {
+				return nil, fmt.Errorf("%w: party %s", services.ErrNotFound, got)
+			}
+			return &ports.Party{Party: db.Party{ID: id, Kind: services.PartyVendor, Name: "Initech"}}, nil
+		},
+		DeleteFn: func(ctx context.Context, got uuid.UUID) error {
+			return fmt.Errorf("%w: party has invoices", services.ErrConflict)
+		},
+	})
+
+	p, err := h.GetParty(ctx, &pb.GetPartyRequest{Id: id.String()})
+	require.NoError(t, err)
+	assert.Equal(t, pb.PartyKind_PARTY_VENDOR, p.Kind)
+	assert.Equal(t, "Initech", p.Name)
+
+	_, err = h.GetParty(ctx, &pb.GetPartyRequest{Id: missing.String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = h.GetParty(ctx, &pb.GetPartyRequest{Id: "bad"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = h.DeleteParty(ctx, &pb.DeletePartyRequest{Id: id.String()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = h.DeleteParty(ctx, &pb.DeletePartyRequest{Id: "bad"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A masked update of a party that does not exist fails before anything
+	// is sent.
+	_, err = h.UpdateParty(ctx, &pb.UpdatePartyRequest{
+		Party:      &pb.Party{Id: missing.String(), Name: "X"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = h.UpdateParty(ctx, &pb.UpdatePartyRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPartyHandler_ListParties(t *testing.T) {
+	ctx := context.Background()
+	var filter ports.PartyFilter
+	var limit, offset int32
+	h := grpcserver.NewPartyHandler(&mockPartyService{
+		ListFn: func(ctx context.Context, f ports.PartyFilter, l, o int32) ([]*ports.Party, error) {
+			filter, limit, offset = f, l, o
+			return []*ports.Party{
+				{Party: db.Party{ID: uuid.New(), Kind: services.PartyCustomer, Name: "Acme"}},
+				{Party: db.Party{ID: uuid.New(), Kind: services.PartyBoth, Name: "Acme Exports"}},
+			}, nil
+		},
+	})
+
+	resp, err := h.ListParties(ctx, &pb.ListPartiesRequest{
+		Meta:  &pb.RequestMetadata{OrganizationId: "org-1"},
+		Kind:  pb.PartyKind_PARTY_CUSTOMER,
+		Query: "acme",
+		Page:  &pb.PageRequest{PageSize: 2, PageToken: "2"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ports.PartyFilter{OrganizationID: "org-1", Kind: services.PartyCustomer, Query: "acme"}, filter)
+	assert.Equal(t, int32(2), limit)
+	assert.Equal(t, int32(2), offset)
+	require.Len(t, resp.Parties, 2)
+	assert.Equal(t, pb.PartyKind_PARTY_BOTH, resp.Parties[1].Kind)
+	assert.Equal(t, "4", resp.Page.NextPageToken)
+
+	_, err = h.ListParties(ctx, &pb.ListPartiesRequest{Page: &pb.PageRequest{PageToken: "x"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,229 @@
+package grpc_server_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	grpcserver "github.com/ShristiRnr/Finance_mierp/internal/core/ports/grpc_server"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+type MockRecurringInvoiceService struct{ mock.Mock }
+
+func (m *MockRecurringInvoiceService) Create(ctx context.Context, r db.RecurringInvoice) (db.RecurringInvoice, error) {
+	args := m.Called(ctx, r)
+	return args.Get(0).(db.RecurringInvoice), args.Error(1)
+}
+func (m *MockRecurringInvoiceService) Get(ctx context.Context, id uuid.UUID) (db.RecurringInvoice, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(db.RecurringInvoice), args.Error(1)
+}
+func (m *MockRecurringInvoiceService) Update(ctx context.Context, r db.RecurringInvoice) (db.RecurringInvoice, error) {
+	args := m.Called(ctx, r)
+	return args.Get(0).(db.RecurringInvoice), args.Error(1)
+}
+func (m *MockRecurringInvoiceService) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+func (m *MockRecurringInvoiceService) List(ctx context.Context, limit, offset int32) ([]db.RecurringInvoice, error) {
+	args := m.Called(ctx, limit, offset)
+	return args.Get(0).([]db.RecurringInvoice), args.Error(1)
+}
+func (m *MockRecurringInvoiceService) Generate(ctx context.Context, asOf time.Time) ([]ports.GeneratedInvoice, error) {
+	args := m.Called(ctx, asOf)
+	return args.Get(0).([]ports.GeneratedInvoice), args.Error(1)
+}
+
+func TestRecurringInvoiceHandler_Create(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-id", "alice"))
+	templateID := uuid.New()
+	start := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	svc := new(MockRecurringInvoiceService)
+	h := grpcserver.NewRecurringInvoiceHandler(svc)
+	svc.On("Create", mock.Anything, mock.Anything).Return(db.RecurringInvoice{
+		ID: uuid.New(), OrganizationID: "org-1", TemplateInvoiceID: templateID, Frequency: services.FrequencyQuarterly,
+	}, nil).Once()
+
+	resp, err := h.CreateRecurringInvoice(ctx, &pb.CreateRecurringInvoiceRequest{
+		Meta: &pb.RequestMetadata{OrganizationId: "org-1"},
+		RecurringInvoice: &pb.RecurringInvoice{
+			Name:              "Hosting",
+			TemplateInvoiceId: templateID.String(),
+			Frequency:         pb.TemplateFrequency_TEMPLATE_QUARTERLY,
+			StartDate:         timestamppb.New(start),
+			EndDate:           timestamppb.New(end),
+			BillingDay:        15,
+			Prorate:           true,
+			Active:            true,
+		},
+	})
+	require.NoError(t, err)
+	sent := svc.Calls[0].Arguments.Get(1).(db.RecurringInvoice)
+	assert.Equal(t, "org-1", sent.OrganizationID)
+	assert.Equal(t, templateID, sent.TemplateInvoiceID)
+	assert.Equal(t, services.FrequencyQuarterly, sent.Frequency)
+	assert.Equal(t, start, sent.StartDate)
+	assert.Equal(t, sql.NullTime{Time: end, Valid: true}, sent.EndDate)
+	assert.Equal(t, int16(15), sent.BillingDay)
+	assert.True(t, sent.Prorate)
+	assert.Equal(t, "alice", sent.CreatedBy.String)
+	assert.Equal(t, "alice", sent.UpdatedBy.String)
+	assert.Equal(t, pb.TemplateFrequency_TEMPLATE_QUARTERLY, resp.Frequency)
+	assert.Equal(t, "org-1", resp.OrganizationId)
+
+	// Malformed requests never reach the service.
+	for _, in := range []*pb.RecurringInvoice{nil, {TemplateInvoiceId: "not-a-uuid"}} {
+		_, err = h.CreateRecurringInvoice(ctx, &pb.CreateRecurringInvoiceRequest{RecurringInvoice: in})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	svc.AssertNumberOfCalls(t, "Create", 1)
+
+	for want, svcErr := range map[codes.Code]error{
+		codes.InvalidArgument:    fmt.Errorf("%w: billing_day must be 1-28", services.ErrInvalidInput),
+		codes.NotFound:           fmt.Errorf("%w: invoice %s", services.ErrNotFound, templateID),
+		codes.FailedPrecondition: fmt.Errorf("%w: template is void", services.ErrConflict),
+		codes.Internal:           errors.New("connection reset"),
+	} {
+		svc.On("Create", mock.Anything, mock.Anything).Return(db.RecurringInvoice{}, svcErr).Once()
+		_, err = h.CreateRecurringInvoice(ctx, &pb.CreateRecurringInvoiceRequest{
+			RecurringInvoice: &pb.RecurringInvoice{TemplateInvoiceId: templateID.String()},
+		})
+		assert.Equal(t, want, status.Code(err), svcErr.Error())
+	}
+}
+
+func TestRecurringInvoiceHandler_GetUpdateDelete(t *testing.T) {
+	ctx := context.Background()
+	id, templateID := uuid.New(), uuid.New()
+	billed := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	stored := db.RecurringInvoice{
+		ID:                id,
+		OrganizationID:    "org-1",
+		Name:              "Hosting",
+		TemplateInvoiceID: templateID,
+		Frequency:         services.FrequencyMonthly,
+		StartDate:         time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+		BillingDay:        1,
+		Active:            true,
+		BilledThrough:     sql.NullTime{Time: billed, Valid: true},
+	}
+
+	svc := new(MockRecurringInvoiceService)
+	h := grpcserver.NewRecurringInvoiceHandler(svc)
+	svc.On("Get", mock.Anything, id).Return(stored, nil)
+
+	got, err := h.GetRecurringInvoice(ctx, &pb.GetRecurringInvoiceRequest{Id: id.String()})
+	require.NoError(t, err)
+	assert.Equal(t, templateID.String(), got.TemplateInvoiceId)
+	assert.Equal(t, pb.TemplateFrequency_TEMPLATE_MONTHLY, got.Frequency)
+	assert.Equal(t, billed, got.BilledThrough.AsTime())
+
+	missing := uuid.New()
+	svc.On("Get", mock.Anything, missing).Return(db.RecurringInvoice{}, fmt.Errorf("%w: recurring invoice %s", services.ErrNotFound, missing))
+	_, err = h.GetRecurringInvoice(ctx, &pb.GetRecurringInvoiceRequest{Id: missing.String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = h.GetRecurringInvoice(ctx, &pb.GetRecurringInvoiceRequest{Id: "bad"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A masked update keeps the stored schedule and changes only the name.
+	svc.On("Update", mock.Anything, mock.Anything).Return(stored, nil).Once()
+	_, err = h.UpdateRecurringInvoice(ctx, &pb.UpdateRecurringInvoiceRequest{
+		RecurringInvoice: &pb.RecurringInvoice{Id: id.String(), Name: "Managed hosting"},
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	require.NoError(t, err)
+	sent := svc.Calls[len(svc.Calls)-1].Arguments.Get(1).(db.RecurringInvoice)
+	assert.Equal(t, id, sent.ID)
+	assert.Equal(t, "Managed hosting", sent.Name)
+	assert.Equal(t, templateID, sent.TemplateInvoiceID)
+	assert.Equal(t, services.FrequencyMonthly, sent.Frequency)
+	assert.True(t, sent.Active)
+
+	// billed_through is the scheduler's to move.
+	_, err = h.UpdateRecurringInvoice(ctx, &pb.UpdateRecurringInvoiceRequest{
+		RecurringInvoice: &pb.RecurringInvoice{Id: id.String()},
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"billed_through"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = h.UpdateRecurringInvoice(ctx, &pb.UpdateRecurringInvoiceRequest{
+		RecurringInvoice: &pb.RecurringInvoice{Id: missing.String()},
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	svc.AssertNumberOfCalls(t, "Update", 1)
+
+	svc.On("Delete", mock.Anything, id).Return(nil).Once()
+	_, err = h.DeleteRecurringInvoice(ctx, &pb.DeleteRecurringInvoiceRequest{Id: id.String()})
+	require.NoError(t, err)
+	svc.On("Delete", mock.Anything, id).Return(fmt.Errorf("%w: already billed", services.ErrConflict)).Once()
+	_, err = h.DeleteRecurringInvoice(ctx, &pb.DeleteRecurringInvoiceRequest{Id: id.String()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestRecurringInvoiceHandler_List(t *testing.T) {
+	ctx := context.Background()
+	svc := new(MockRecurringInvoiceService)
+	h := grpcserver.NewRecurringInvoiceHandler(svc)
+	svc.On("List", mock.Anything, int32(2), int32(4)).Return([]db.RecurringInvoice{
+		{ID: uuid.New(), Name: "A"}, {ID: uuid.New(), Name: "B"},
+	}, nil)
+
+	resp, err := h.ListRecurringInvoices(ctx, &pb.ListRecurringInvoicesRequest{
+		Page: &pb.PageRequest{PageSize: 2, PageToken: "4"},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.RecurringInvoices, 2)
+	assert.Equal(t, "6", resp.Page.NextPageToken)
+
+	_, err = h.ListRecurringInvoices(ctx, &pb.ListRecurringInvoicesRequest{Page: &pb.PageRequest{PageToken: "x"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRecurringInvoiceHandler_Generate(t *testing.T) {
+	ctx := context.Background()
+	asOf := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	recurringID, invoiceID := uuid.New(), uuid.New()
+	period := ports.BillingPeriod{
+		Start: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	svc := new(MockRecurringInvoiceService)
+	h := grpcserver.NewRecurringInvoiceHandler(svc)
+
+	// Failures of single recurring invoices are reported next to the
+	// invoices that were generated, not as an RPC error.
+	svc.On("Generate", mock.Anything, asOf).Return([]ports.GeneratedInvoice{
+		{RecurringInvoiceID: recurringID, Period: period, Invoice: db.Invoice{ID: invoiceID, Status: "DRAFT"}},
+	}, &services.RecurringRunError{Failures: []error{errors.New("recurring invoice 42: template is void")}}).Once()
+	resp, err := h.GenerateRecurringInvoices(ctx, &pb.GenerateRecurringInvoicesRequest{AsOf: timestamppb.New(asOf)})
+	require.NoError(t, err)
+	require.Len(t, resp.Invoices, 1)
+	assert.Equal(t, recurringID.String(), resp.Invoices[0].RecurringInvoiceId)
+	assert.Equal(t, period.End, resp.Invoices[0].PeriodEnd.AsTime())
+	assert.Equal(t, invoiceID.String(), resp.Invoices[0].Invoice.Id)
+	assert.Equal(t, []string{"recurring invoice 42: template is void"}, resp.Failures)
+
+	svc.On("Generate", mock.Anything, asOf).Return([]ports.GeneratedInvoice(nil), errors.New("connection reset")).Once()
+	_, err = h.GenerateRecurringInvoices(ctx, &pb.GenerateRecurringInvoicesRequest{AsOf: timestamppb.New(asOf)})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
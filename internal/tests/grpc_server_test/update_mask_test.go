@@ -0,0 +1,149 @@
+package grpc_server_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	money "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ShristiRnr/Finance_mierp/api/pb"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	grpcserver "github.com/ShristiRnr/Finance_mierp/internal/core/ports/grpc_server"
+)
+
+func TestLedgerHandler_UpdateJournalEntryMask(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	date := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	reverseOn := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	stored := &db.JournalEntry{
+		ID:              id,
+		JournalDate:     date,
+		Reference:       sql.NullString{String: "ACR-1", Valid: true},
+		Memo:            sql.NullString{String: "March accrual", Valid: true},
+		Status:          "DRAFT",
+		AutoReverseDate: sql.NullTime{Time: reverseOn, Valid: true},
+	}
+
+	mockJnl := new(MockJournalService)
+	h := grpcserver.NewLedgerHandler(nil, mockJnl, nil, nil, nil)
+	mockJnl.On("Get", mock.Anything, id).Return(stored, nil)
+	var sent *db.JournalEntry
+	mockJnl.On("Update", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		sent = args.Get(1).(*db.JournalEntry)
+	}).Return(stored, nil)
+
+	update := func(entry *pb.JournalEntry, paths ...string) error {
+		entry.Id = id.String()
+		_, err := h.UpdateJournalEntry(ctx, &pb.UpdateJournalEntryRequest{
+			Entry: entry, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+		return err
+	}
+
+	// The auto-reverse date is updated like any other mutable field.
+	moved := reverseOn.AddDate(0, 0, 1)
+	require.NoError(t, update(&pb.JournalEntry{AutoReverseDate: timestamppb.New(moved)}, "auto_reverse_date"))
+	assert.Equal(t, moved, sent.AutoReverseDate.Time)
+	assert.Equal(t, "March accrual", sent.Memo.String)
+	assert.Equal(t, date, sent.JournalDate)
+
+	// A masked field the request leaves unset is cleared.
+	require.NoError(t, update(&pb.JournalEntry{}, "auto_reverse_date"))
+	assert.False(t, sent.AutoReverseDate.Valid)
+	assert.Equal(t, "ACR-1", sent.Reference.String)
+
+	// Clearing a field the stored entry never had leaves it unset.
+	require.NoError(t, update(&pb.JournalEntry{}, "source_id"))
+	assert.False(t, sent.SourceID.Valid)
+	assert.True(t, sent.AutoReverseDate.Valid)
+
+	// "*" replaces the whole entry, auto-reverse date included.
+	require.NoError(t, update(&pb.JournalEntry{JournalDate: timestamppb.New(date), Memo: "replaced"}, "*"))
+	assert.Equal(t, "replaced", sent.Memo.String)
+	assert.False(t, sent.Reference.Valid)
+	assert.False(t, sent.AutoReverseDate.Valid)
+
+	for _, path := range []string{
+		"no_such_field", "organization_id", "memo.text", "id", "reversal_of_id", "reversed_by_id",
+		"audit", "audit.created_by", "*,memo",
+	} {
+		err := update(&pb.JournalEntry{}, strings.Split(path, ",")...)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), path)
+	}
+	mockJnl.AssertNumberOfCalls(t, "Get", 3)
+}
+
+func TestPartyHandler_UpdatePartyMask(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	stored := &ports.Party{Party: db.Party{
+		ID:               id,
+		OrganizationID:   "org-1",
+		Kind:             "CUSTOMER",
+		Name:             "Acme Traders",
+		DefaultCurrency:  sql.NullString{String: "INR", Valid: true},
+		CreditLimit:      sql.NullString{String: "50000", Valid: true},
+		CreditHold:       true,
+		CreditHoldReason: sql.NullString{String: "overdue", Valid: true},
+	}}
+
+	var gets int
+	var sent *ports.Party
+	h := grpcserver.NewPartyHandler(&mockPartyService{
+		GetFn: func(ctx context.Context, got uuid.UUID) (*ports.Party, error) {
+			gets++
+			return stored, nil
+		},
+		UpdateFn: func(ctx context.Context, p *ports.Party) (*ports.Party, error) {
+			sent = p
+			return p, nil
+		},
+	})
+
+	update := func(party *pb.Party, paths ...string) error {
+		party.Id = id.String()
+		_, err := h.UpdateParty(ctx, &pb.UpdatePartyRequest{
+			Party: party, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+		return err
+	}
+
+	// A nested path changes only that part of the stored message.
+	require.NoError(t, update(&pb.Party{CreditLimit: &money.Money{CurrencyCode: "USD", Units: 75000}}, "credit_limit.units"))
+	assert.Equal(t, "75000", sent.CreditLimit.String)
+	assert.Equal(t, "INR", sent.DefaultCurrency.String)
+	assert.Equal(t, "Acme Traders", sent.Name)
+
+	// Masked fields the request leaves unset are cleared, set or not.
+	require.NoError(t, update(&pb.Party{}, "credit_hold", "credit_hold_reason", "legal_name"))
+	assert.False(t, sent.CreditHold)
+	assert.False(t, sent.CreditHoldReason.Valid)
+	assert.False(t, sent.LegalName.Valid)
+	assert.Equal(t, "50000", sent.CreditLimit.String)
+
+	// "*" replaces the whole party.
+	require.NoError(t, update(&pb.Party{Name: "Acme Exports"}, "*"))
+	assert.Equal(t, "Acme Exports", sent.Name)
+	assert.False(t, sent.CreditLimit.Valid)
+
+	for _, path := range []string{
+		"no_such_field", "credit_limit.no_such_field", "id", "organization_id", "audit.updated_by", "*,name",
+	} {
+		err := update(&pb.Party{}, strings.Split(path, ",")...)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), path)
+	}
+	assert.Equal(t, 2, gets)
+}
@@ -3,6 +3,9 @@ package repository_test
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +14,7 @@ import (
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/repository"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
 	"github.com/google/uuid"
 )
 
@@ -153,6 +157,7 @@ func TestJournalRepository_Create_Get_Update_Delete_List(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "journal_date", "reference", "memo", "source_type", "source_id",
 			"created_at", "created_by", "updated_at", "updated_by", "revision",
+			"status", "posted_at", "posted_by", "reversal_of", "reversed_by", "organization_id", "auto_reverse_date",
 		}).AddRow(
 			journalID, now,
 			sql.NullString{String: "REF-1", Valid: true},
@@ -161,7 +166,7 @@ func TestJournalRepository_Create_Get_Update_Delete_List(t *testing.T) {
 			sql.NullString{String: "SRC123", Valid: true},
 			now, sql.NullString{String: "tester", Valid: true},
 			now, sql.NullString{String: "tester", Valid: true},
-			1,
+			1, "POSTED", now, sql.NullString{String: "tester", Valid: true}, nil, nil, nil, nil,
 		))
 
 	mock.ExpectExec(`DELETE FROM journal_lines WHERE journal_id = \$1`).
@@ -171,11 +176,20 @@ func TestJournalRepository_Create_Get_Update_Delete_List(t *testing.T) {
 	mock.ExpectQuery(`INSERT INTO journal_lines`).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "journal_id", "account_id", "side", "amount", "cost_center_id", "description", "created_at",
+			"currency_code", "transaction_amount", "exchange_rate", "functional_currency",
 		}).AddRow(
 			lineID, journalID, accountID, "Debit", "100", nil,
-			sql.NullString{String: "Line 1", Valid: true}, now,
+			sql.NullString{String: "Line 1", Valid: true}, now, "", "100", "1", "",
 		))
 
+	expectPeriodLock(mock, journalID)
+	mock.ExpectExec(`INSERT INTO ledger_entries`). // posted on create
+		WithArgs(journalID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO account_period_balances`).
+		WithArgs(journalID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
 	mock.ExpectCommit()
 
 	journal := &db.JournalEntry{
@@ -186,6 +200,7 @@ func TestJournalRepository_Create_Get_Update_Delete_List(t *testing.T) {
 		SourceID:    sql.NullString{String: "SRC123", Valid: true},
 		CreatedBy:   sql.NullString{String: "tester", Valid: true},
 		UpdatedBy:   sql.NullString{String: "tester", Valid: true},
+		Status:      "POSTED",
 		Lines: []db.JournalLine{{
 			AccountID:   accountID,
 			Side:        "Debit",
@@ -204,6 +219,7 @@ func TestJournalRepository_Create_Get_Update_Delete_List(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "journal_date", "reference", "memo", "source_type", "source_id",
 			"created_at", "created_by", "updated_at", "updated_by", "revision",
+			"status", "posted_at", "posted_by", "reversal_of", "reversed_by", "organization_id", "auto_reverse_date",
 		}).AddRow(
 			journalID, now,
 			sql.NullString{String: "REF-1", Valid: true},
@@ -212,16 +228,17 @@ func TestJournalRepository_Create_Get_Update_Delete_List(t *testing.T) {
 			sql.NullString{String: "SRC123", Valid: true},
 			now, sql.NullString{String: "tester", Valid: true},
 			now, sql.NullString{String: "tester", Valid: true},
-			1,
+			1, "POSTED", now, sql.NullString{String: "tester", Valid: true}, nil, nil, nil, nil,
 		))
 
 	mock.ExpectQuery(`SELECT .* FROM journal_lines WHERE journal_id = \$1`).
 		WithArgs(journalID).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "journal_id", "account_id", "side", "amount", "cost_center_id", "description", "created_at",
+			"currency_code", "transaction_amount", "exchange_rate", "functional_currency",
 		}).AddRow(
 			lineID, journalID, accountID, "Debit", "100", nil,
-			sql.NullString{String: "Line 1", Valid: true}, now,
+			sql.NullString{String: "Line 1", Valid: true}, now, "", "100", "1", "",
 		))
 
 	got, err := repo.Get(ctx, journalID)
@@ -239,10 +256,12 @@ func TestJournalRepository_Create_Get_Update_Delete_List(t *testing.T) {
 			sql.NullString{String: "System", Valid: true},
 			sql.NullString{String: "SRC123", Valid: true},
 			sql.NullString{String: "tester", Valid: true},
+			sql.NullTime{},
 		).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "journal_date", "reference", "memo", "source_type", "source_id",
 			"created_at", "created_by", "updated_at", "updated_by", "revision",
+			"status", "posted_at", "posted_by", "reversal_of", "reversed_by", "organization_id", "auto_reverse_date",
 		}).AddRow(
 			journalID, now,
 			sql.NullString{String: "REF-2", Valid: true},
@@ -251,7 +270,7 @@ func TestJournalRepository_Create_Get_Update_Delete_List(t *testing.T) {
 			sql.NullString{String: "SRC123", Valid: true},
 			now, sql.NullString{String: "tester", Valid: true},
 			time.Now(), sql.NullString{String: "tester", Valid: true},
-			2,
+			2, "DRAFT", nil, nil, nil, nil, nil, nil,
 		))
 
 	mock.ExpectExec(`DELETE FROM journal_lines WHERE journal_id = \$1`).
@@ -261,9 +280,10 @@ func TestJournalRepository_Create_Get_Update_Delete_List(t *testing.T) {
 	mock.ExpectQuery(`INSERT INTO journal_lines`).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "journal_id", "account_id", "side", "amount", "cost_center_id", "description", "created_at",
+			"currency_code", "transaction_amount", "exchange_rate", "functional_currency",
 		}).AddRow(
 			uuid.New(), journalID, accountID, "Credit", "200", nil,
-			sql.NullString{String: "Line 2", Valid: true}, now,
+			sql.NullString{String: "Line 2", Valid: true}, now, "", "200", "1", "",
 		))
 
 	mock.ExpectCommit()
@@ -287,11 +307,13 @@ func TestJournalRepository_Create_Get_Update_Delete_List(t *testing.T) {
 	require.Equal(t, "REF-2", updated.Reference.String)
 
 	// ---------- LIST ----------
-	mock.ExpectQuery(`SELECT .* FROM journal_entries ORDER BY journal_date.*LIMIT \$1 OFFSET \$2`).
-		WithArgs(int32(10), int32(0)).
+	mock.ExpectQuery(`(?s)SELECT je\.id, .* FROM journal_entries je.*LIMIT \$13 OFFSET \$12`).
+		WithArgs(sql.NullTime{}, sql.NullTime{}, sql.NullString{}, sql.NullString{}, sql.NullString{},
+			uuid.NullUUID{}, sql.NullString{}, uuid.NullUUID{}, sql.NullString{}, "", false, int32(0), int32(10)).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "journal_date", "reference", "memo", "source_type", "source_id",
 			"created_at", "created_by", "updated_at", "updated_by", "revision",
+			"status", "posted_at", "posted_by", "reversal_of", "reversed_by", "organization_id", "auto_reverse_date",
 		}).AddRow(
 			journalID, now,
 			sql.NullString{String: "REF-2", Valid: true},
@@ -300,15 +322,16 @@ func TestJournalRepository_Create_Get_Update_Delete_List(t *testing.T) {
 			sql.NullString{String: "SRC123", Valid: true},
 			now, sql.NullString{String: "tester", Valid: true},
 			now, sql.NullString{String: "tester", Valid: true}, 2,
+			"DRAFT", nil, nil, nil, nil, nil, nil,
 		))
 
-	list, err := repo.List(ctx, 10, 0)
+	list, err := repo.List(ctx, ports.EntryFilter{}, 10, 0)
 	require.NoError(t, err)
 	require.Len(t, list, 1)
 	require.Equal(t, "REF-2", list[0].Reference.String)
 
 	// ---------- DELETE ----------
-	mock.ExpectExec(`DELETE FROM journal_entries WHERE id = \$1`).
+	mock.ExpectExec(`DELETE FROM journal_entries WHERE id = \$1 AND status = 'DRAFT'`).
 		WithArgs(journalID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -319,6 +342,297 @@ func TestJournalRepository_Create_Get_Update_Delete_List(t *testing.T) {
 }
 
 
+func TestJournalRepository_Reverse(t *testing.T) {
+	dbConn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	queries := db.New(dbConn)
+	repo := repository.NewJournalRepository(dbConn, queries, nil)
+
+	ctx := context.Background()
+	originalID := uuid.New()
+	reversalID := uuid.New()
+	accountID := uuid.New()
+	now := time.Now()
+	cols := []string{
+		"id", "journal_date", "reference", "memo", "source_type", "source_id",
+		"created_at", "created_by", "updated_at", "updated_by", "revision",
+		"status", "posted_at", "posted_by", "reversal_of", "reversed_by", "organization_id", "auto_reverse_date",
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO journal_entries`).
+		WillReturnRows(sqlmock.NewRows(cols).AddRow(
+			reversalID, now, nil, sql.NullString{String: "Reversal", Valid: true}, nil, nil,
+			now, nil, now, nil, 1, "POSTED", now, nil, originalID, nil, nil, nil,
+		))
+	mock.ExpectExec(`DELETE FROM journal_lines WHERE journal_id = \$1`).
+		WithArgs(reversalID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO journal_lines`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "journal_id", "account_id", "side", "amount", "cost_center_id", "description", "created_at",
+			"currency_code", "transaction_amount", "exchange_rate", "functional_currency",
+		}).AddRow(uuid.New(), reversalID, accountID, "CREDIT", "100.00", nil, nil, now, "", "100.00", "1", ""))
+	expectPeriodLock(mock, reversalID)
+	mock.ExpectExec(`INSERT INTO ledger_entries`).
+		WithArgs(reversalID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO account_period_balances`).
+		WithArgs(reversalID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE journal_entries\s+SET status = 'REVERSED'`).
+		WithArgs(originalID, uuid.NullUUID{UUID: reversalID, Valid: true}, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(cols).AddRow(
+			originalID, now, nil, nil, nil, nil,
+			now, nil, now, nil, 2, "REVERSED", now, nil, nil, reversalID, nil, nil,
+		))
+	mock.ExpectCommit()
+
+	reversal, err := repo.Reverse(ctx, originalID, &db.JournalEntry{
+		JournalDate: now,
+		Memo:        sql.NullString{String: "Reversal", Valid: true},
+		Lines:       []db.JournalLine{{AccountID: accountID, Side: "CREDIT", Amount: "100.00"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, reversalID, reversal.ID)
+	require.Equal(t, "POSTED", reversal.Status)
+	require.Equal(t, originalID, reversal.ReversalOf.UUID)
+	require.Len(t, reversal.Lines, 1)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJournalRepository_AutoReverse(t *testing.T) {
+	dbConn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	repo := repository.NewJournalRepository(dbConn, db.New(dbConn), nil)
+
+	ctx := context.Background()
+	journalID, reversalID, accountID := uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	reverseOn := date.AddDate(0, 0, 1)
+	by := sql.NullString{String: "tester", Valid: true}
+	cols := []string{
+		"id", "journal_date", "reference", "memo", "source_type", "source_id",
+		"created_at", "created_by", "updated_at", "updated_by", "revision",
+		"status", "posted_at", "posted_by", "reversal_of", "reversed_by", "organization_id", "auto_reverse_date",
+	}
+	lineCols := []string{
+		"id", "journal_id", "account_id", "side", "amount", "cost_center_id", "description", "created_at",
+		"currency_code", "transaction_amount", "exchange_rate", "functional_currency",
+	}
+	journal := func() *db.JournalEntry {
+		return &db.JournalEntry{
+			JournalDate:     date,
+			CreatedBy:       by,
+			Status:          "POSTED",
+			OrganizationID:  sql.NullString{String: "org-1", Valid: true},
+			AutoReverseDate: sql.NullTime{Time: reverseOn, Valid: true},
+			Lines:           []db.JournalLine{{AccountID: accountID, Side: "DEBIT", Amount: "100.00"}},
+		}
+	}
+	// expectPosted expects the entry itself to be written and projected.
+	expectPosted := func() {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO journal_entries`).
+			WillReturnRows(sqlmock.NewRows(cols).AddRow(
+				journalID, date, nil, nil, nil, nil,
+				date, by, date, nil, 1, "POSTED", date, by, nil, nil, "org-1", reverseOn,
+			))
+		mock.ExpectExec(`DELETE FROM journal_lines WHERE journal_id = \$1`).WithArgs(journalID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`INSERT INTO journal_lines`).
+			WillReturnRows(sqlmock.NewRows(lineCols).
+				AddRow(uuid.New(), journalID, accountID, "DEBIT", "100.00", nil, nil, date, "", "100.00", "1", ""))
+		expectPeriodLock(mock, journalID)
+		mock.ExpectExec(`INSERT INTO ledger_entries`).WithArgs(journalID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO account_period_balances`).WithArgs(journalID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		// The reversal is dated on the auto-reverse date and mirrors the lines.
+		mock.ExpectQuery(`INSERT INTO journal_entries`).
+			WithArgs(reverseOn, sql.NullString{}, sql.NullString{String: "Auto-reversal of journal " + journalID.String(), Valid: true},
+				sql.NullString{}, sql.NullString{}, by, by, "POSTED", sqlmock.AnyArg(), by,
+				uuid.NullUUID{UUID: journalID, Valid: true}, sql.NullString{String: "org-1", Valid: true}, sql.NullTime{}).
+			WillReturnRows(sqlmock.NewRows(cols).AddRow(
+				reversalID, reverseOn, nil, "Auto-reversal", nil, nil,
+				date, by, date, by, 1, "POSTED", date, by, journalID, nil, "org-1", nil,
+			))
+		mock.ExpectExec(`DELETE FROM journal_lines WHERE journal_id = \$1`).WithArgs(reversalID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`INSERT INTO journal_lines`).
+			WithArgs(reversalID, accountID, "CREDIT", "100.00", sqlmock.AnyArg(), sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows(lineCols).
+				AddRow(uuid.New(), reversalID, accountID, "CREDIT", "100.00", nil, nil, date, "", "100.00", "1", ""))
+	}
+
+	// The entry, its reversal and the REVERSED mark commit together.
+	expectPosted()
+	expectPeriodLock(mock, reversalID)
+	mock.ExpectExec(`INSERT INTO ledger_entries`).WithArgs(reversalID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO account_period_balances`).WithArgs(reversalID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE journal_entries\s+SET status = 'REVERSED'`).
+		WithArgs(journalID, uuid.NullUUID{UUID: reversalID, Valid: true}, by).
+		WillReturnRows(sqlmock.NewRows(cols).AddRow(
+			journalID, date, nil, nil, nil, nil,
+			date, by, date, by, 2, "REVERSED", date, by, nil, reversalID, "org-1", reverseOn,
+		))
+	mock.ExpectCommit()
+
+	created, err := repo.Create(ctx, journal())
+	require.NoError(t, err)
+	require.Equal(t, "REVERSED", created.Status)
+	require.Equal(t, reversalID, created.ReversedBy.UUID)
+	require.Len(t, created.Lines, 1)
+
+	// A reversal that cannot be posted rolls the entry back with it.
+	expectPosted()
+	expectPeriodLock(mock, reversalID)
+	mock.ExpectExec(`INSERT INTO ledger_entries`).WithArgs(reversalID).
+		WillReturnError(errors.New("connection reset"))
+	mock.ExpectRollback()
+
+	_, err = repo.Create(ctx, journal())
+	require.ErrorContains(t, err, "connection reset")
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// arrayOf matches a Postgres array parameter holding exactly the given elements.
+type arrayOf []string
+
+func (a arrayOf) Match(v driver.Value) bool {
+	s, ok := v.(string)
+	return ok && s == "{"+strings.Join(a, ",")+"}"
+}
+
+func TestJournalRepository_PostIntoClosedPeriod(t *testing.T) {
+	dbConn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	repo := repository.NewJournalRepository(dbConn, db.New(dbConn), nil)
+	ctx := context.Background()
+	journalID, accountID := uuid.New(), uuid.New()
+	date := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	periodCols := []string{"journal_id", "source_type", "fiscal_year", "period_number", "status", "year_closed_at"}
+
+	post := func(sourceType string, period []driver.Value, then func()) error {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO journal_entries`).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "journal_date", "reference", "memo", "source_type", "source_id",
+				"created_at", "created_by", "updated_at", "updated_by", "revision",
+				"status", "posted_at", "posted_by", "reversal_of", "reversed_by", "organization_id", "auto_reverse_date",
+			}).AddRow(
+				journalID, date, nil, nil, sourceType, nil,
+				date, nil, date, nil, 1, "POSTED", date, nil, nil, nil, "org-1", nil,
+			))
+		mock.ExpectExec(`DELETE FROM journal_lines WHERE journal_id = \$1`).WithArgs(journalID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`INSERT INTO journal_lines`).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "journal_id", "account_id", "side", "amount", "cost_center_id", "description", "created_at",
+				"currency_code", "transaction_amount", "exchange_rate", "functional_currency",
+			}).AddRow(uuid.New(), journalID, accountID, "DEBIT", "10.00", nil, nil, date, "", "10.00", "1", ""))
+		mock.ExpectQuery(`FOR SHARE OF fp`).WithArgs(arrayOf{`"` + journalID.String() + `"`}).
+			WillReturnRows(sqlmock.NewRows(periodCols).AddRow(period...))
+		then()
+		_, err := repo.Create(ctx, &db.JournalEntry{
+			JournalDate:    date,
+			Status:         "POSTED",
+			SourceType:     sql.NullString{String: sourceType, Valid: true},
+			OrganizationID: sql.NullString{String: "org-1", Valid: true},
+			Lines:          []db.JournalLine{{AccountID: accountID, Side: "DEBIT", Amount: "10.00"}},
+		})
+		return err
+	}
+
+	// The period was hard-closed by a year-end close the posting waited for.
+	closedYear := []driver.Value{journalID, "ADJUSTMENT", 2025, 12, "CLOSED", date}
+	err = post("ADJUSTMENT", closedYear, func() { mock.ExpectRollback() })
+	require.ErrorIs(t, err, ports.ErrPeriodClosed)
+
+	// The close's own entry goes into periods closed month by month.
+	err = post("YEAR_END_CLOSE", []driver.Value{journalID, "YEAR_END_CLOSE", 2025, 12, "CLOSED", nil}, func() {
+		mock.ExpectExec(`INSERT INTO ledger_entries`).WithArgs(journalID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO account_period_balances`).WithArgs(journalID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// expectPeriodLock expects a posting to share-lock the fiscal periods of the
+// given journals, or of any journals when none are given, and find none closed.
+func expectPeriodLock(mock sqlmock.Sqlmock, journalIDs ...uuid.UUID) {
+	var ids driver.Value = sqlmock.AnyArg()
+	if len(journalIDs) > 0 {
+		quoted := make(arrayOf, len(journalIDs))
+		for i, id := range journalIDs {
+			quoted[i] = `"` + id.String() + `"`
+		}
+		ids = quoted
+	}
+	mock.ExpectQuery(`FROM journal_entries je\s+JOIN fiscal_periods fp.*FOR SHARE OF fp`).WithArgs(ids).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"journal_id", "source_type", "fiscal_year", "period_number", "status", "year_closed_at",
+		}))
+}
+
+func TestJournalRepository_BulkCreate(t *testing.T) {
+	dbConn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	repo := repository.NewJournalRepository(dbConn, db.New(dbConn), nil)
+	ctx := context.Background()
+	cashID, equityID := uuid.New(), uuid.New()
+	date := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	lines := func(amount string) []db.JournalLine {
+		return []db.JournalLine{
+			{AccountID: cashID, Side: "DEBIT", Amount: amount},
+			{AccountID: equityID, Side: "CREDIT", Amount: amount, Description: sql.NullString{String: "Opening", Valid: true}},
+		}
+	}
+	posted := &db.JournalEntry{JournalDate: date, Reference: sql.NullString{String: "OB-1", Valid: true}, Status: "POSTED", Lines: lines("100.00")}
+	draft := &db.JournalEntry{JournalDate: date, Reference: sql.NullString{String: "OB-2", Valid: true}, Lines: lines("5.00")}
+
+	// One statement per table however many journals and lines there are.
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO journal_entries`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), arrayOf{`"OB-1"`, `"OB-2"`}, sqlmock.AnyArg(),
+			sqlmock.AnyArg(), arrayOf{`"POSTED"`, `"DRAFT"`}, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO journal_lines`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), arrayOf{`"DEBIT"`, `"CREDIT"`, `"DEBIT"`, `"CREDIT"`},
+			arrayOf{`"100.00"`, `"100.00"`, `"5.00"`, `"5.00"`}, sqlmock.AnyArg(), arrayOf{`""`, `"Opening"`, `""`, `"Opening"`},
+			sqlmock.AnyArg(), arrayOf{`"100.00"`, `"100.00"`, `"5.00"`, `"5.00"`}, arrayOf{`"1"`, `"1"`, `"1"`, `"1"`}, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+	expectPeriodLock(mock)
+	mock.ExpectExec(`INSERT INTO ledger_entries`).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO account_period_balances`).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.BulkCreate(ctx, []*db.JournalEntry{posted, draft}))
+	require.NotEqual(t, uuid.Nil, posted.ID)
+	require.NotEqual(t, posted.ID, draft.ID)
+	require.Equal(t, "DRAFT", draft.Status)
+	require.Equal(t, draft.ID, draft.Lines[1].JournalID)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 // ---------------- Ledger Repository ----------------
 
 func TestLedgerRepository_List(t *testing.T) {
@@ -332,17 +646,72 @@ func TestLedgerRepository_List(t *testing.T) {
 	ctx := context.Background()
 	now := time.Now()
 
-	// mock select (must match sqlc return columns)
-	mock.ExpectQuery(`(?s)SELECT (.+) FROM ledger_entries`).
+	accountID := uuid.New()
+	from := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	filter := ports.EntryFilter{
+		AccountID:      uuid.NullUUID{UUID: accountID, Valid: true},
+		FromDate:       from,
+		ReferenceType:  "INVOICE",
+		OrganizationID: "org-1",
+		OrderBy:        "amount",
+		Descending:     true,
+	}
+
+	// mock select (must match sqlc return columns); unset filters bind NULL
+	mock.ExpectQuery(`(?s)SELECT (.+) FROM ledger_entries.*je\.organization_id = \$9`).
+		WithArgs(filter.AccountID, sql.NullTime{Time: from, Valid: true}, sql.NullTime{}, sql.NullString{},
+			uuid.NullUUID{}, sql.NullString{String: "INVOICE", Valid: true}, sql.NullString{}, sql.NullString{},
+			sql.NullString{String: "org-1", Valid: true}, "amount", true, int32(0), int32(10)).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"entry_id", "account_id", "side", "amount", "transaction_date",
+			"journal_id", "cost_center_id", "description", "reference_type", "reference_id", "created_at",
+			"currency_code", "transaction_amount", "exchange_rate", "functional_currency",
 		}).AddRow(
 			uuid.New(), uuid.New(), "Debit", "500", now,
+			uuid.New(), nil, sql.NullString{String: "Line 1", Valid: true},
+			sql.NullString{String: "INVOICE", Valid: true}, sql.NullString{String: "INV-1", Valid: true}, now,
+			"USD", "6.00", "83.333333", "INR",
 		))
 
-	list, err := repo.List(ctx, 10, 0)
+	list, err := repo.List(ctx, filter, 10, 0)
 	require.NoError(t, err)
 	require.NotEmpty(t, list)
+	require.Equal(t, "INVOICE", list[0].ReferenceType.String)
+	require.Equal(t, "6.00", list[0].TransactionAmount)
 
 	require.NoError(t, mock.ExpectationsWereMet())
-}
\ No newline at end of file
+}
+func TestLedgerRepository_Balances(t *testing.T) {
+	dbConn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	repo := repository.NewLedgerRepository(db.New(dbConn))
+	ctx := context.Background()
+	accountID := uuid.New()
+	from := time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 20, 0, 0, 0, 0, time.UTC)
+
+	// Both the monthly totals and the partial-month entries are limited to
+	// the organization's journals.
+	mock.ExpectQuery(`(?s)FROM account_period_balances pb, bounds\s+WHERE pb\.organization_id = \$4::text.*COALESCE\(je\.organization_id, ''\) = \$4::text`).
+		WithArgs(sqlmock.AnyArg(), from, to, "org-1").
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "code", "name", "type",
+			"opening_debit", "opening_credit", "period_debit", "period_credit"}).
+			AddRow(accountID, "1000", "Cash", "ASSET", "100.00", "0.00", "50.00", "20.00"))
+	rows, err := repo.Balances(ctx, "org-1", nil, from, to)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, "50.00", rows[0].PeriodDebit)
+
+	mock.ExpectQuery(`(?s)FROM ledger_entries le\s+LEFT JOIN journal_entries je ON je\.id = le\.journal_id\s+WHERE COALESCE\(je\.organization_id, ''\) = \$2::text`).
+		WithArgs(from, "org-1", to, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "currency_code",
+			"opening_debit", "opening_credit", "period_debit", "period_credit"}).
+			AddRow(accountID, "USD", "1.00", "0.00", "0.50", "0.00"))
+	currencies, err := repo.CurrencyBalances(ctx, "org-1", []uuid.UUID{accountID}, from, to)
+	require.NoError(t, err)
+	require.Len(t, currencies, 1)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
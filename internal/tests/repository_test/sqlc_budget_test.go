@@ -28,14 +28,15 @@ func TestBudgetRepository_CRUD(t *testing.T) {
 	now := time.Now()
 
 	budget := &db.Budget{
-		ID:          budgetID,
-		Name:        "Marketing Budget",
-		TotalAmount: "10000",
-		Status:      "DRAFT",
-		CreatedBy:   sql.NullString{String: "admin", Valid: true},
-		UpdatedBy:   sql.NullString{String: "admin", Valid: true},
-		CreatedAt:   sql.NullTime{Time: now, Valid: true},
-		UpdatedAt:   sql.NullTime{Time: now, Valid: true},
+		ID:           budgetID,
+		Name:         "Marketing Budget",
+		TotalAmount:  "10000",
+		Status:       "DRAFT",
+		CurrencyCode: "INR",
+		CreatedBy:    sql.NullString{String: "admin", Valid: true},
+		UpdatedBy:    sql.NullString{String: "admin", Valid: true},
+		CreatedAt:    sql.NullTime{Time: now, Valid: true},
+		UpdatedAt:    sql.NullTime{Time: now, Valid: true},
 	}
 
 	allocation := &db.BudgetAllocation{
@@ -53,12 +54,12 @@ func TestBudgetRepository_CRUD(t *testing.T) {
 
 	// ================== Budget: Create ==================
 	mock.ExpectQuery(`INSERT INTO budgets`).
-		WithArgs(budget.Name, budget.TotalAmount, sql.NullString{}, budget.CreatedBy, budget.UpdatedBy).
+		WithArgs(budget.Name, budget.TotalAmount, sql.NullString{}, budget.CreatedBy, budget.UpdatedBy, budget.CurrencyCode).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"id", "name", "total_amount", "status", "created_at", "created_by", "updated_at", "updated_by", "revision",
+			"id", "name", "total_amount", "status", "created_at", "created_by", "updated_at", "updated_by", "revision", "currency_code",
 		}).AddRow(
 			budgetID, budget.Name, budget.TotalAmount, budget.Status,
-			budget.CreatedAt, budget.CreatedBy, budget.UpdatedAt, budget.UpdatedBy, 1,
+			budget.CreatedAt, budget.CreatedBy, budget.UpdatedAt, budget.UpdatedBy, 1, budget.CurrencyCode,
 		))
 
 	created, err := repo.Create(ctx, budget)
@@ -69,24 +70,25 @@ func TestBudgetRepository_CRUD(t *testing.T) {
 	mock.ExpectQuery(`SELECT .* FROM budgets WHERE id = \$1`).
 		WithArgs(budgetID).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"id", "name", "total_amount", "status", "created_at", "created_by", "updated_at", "updated_by", "revision",
+			"id", "name", "total_amount", "status", "created_at", "created_by", "updated_at", "updated_by", "revision", "currency_code",
 		}).AddRow(
 			budgetID, budget.Name, budget.TotalAmount, budget.Status,
-			budget.CreatedAt, budget.CreatedBy, budget.UpdatedAt, budget.UpdatedBy, 1,
+			budget.CreatedAt, budget.CreatedBy, budget.UpdatedAt, budget.UpdatedBy, 1, budget.CurrencyCode,
 		))
 
 	got, err := repo.Get(ctx, budgetID)
 	require.NoError(t, err)
 	require.Equal(t, budgetID, got.ID)
+	require.Equal(t, "INR", got.CurrencyCode)
 
 	// ================== Budget: List ==================
 	mock.ExpectQuery(`SELECT .* FROM budgets ORDER BY created_at DESC LIMIT \$1 OFFSET \$2`).
 		WithArgs(int32(10), int32(0)).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"id", "name", "total_amount", "status", "created_at", "created_by", "updated_at", "updated_by", "revision",
+			"id", "name", "total_amount", "status", "created_at", "created_by", "updated_at", "updated_by", "revision", "currency_code",
 		}).AddRow(
 			budgetID, budget.Name, budget.TotalAmount, budget.Status,
-			budget.CreatedAt, budget.CreatedBy, budget.UpdatedAt, budget.UpdatedBy, 1,
+			budget.CreatedAt, budget.CreatedBy, budget.UpdatedAt, budget.UpdatedBy, 1, budget.CurrencyCode,
 		))
 
 	list, err := repo.List(ctx, 10, 0)
@@ -97,12 +99,12 @@ func TestBudgetRepository_CRUD(t *testing.T) {
 	// ================== Budget: Update ==================
 	budget.Status = "APPROVED"
 	mock.ExpectQuery(`UPDATE budgets`).
-		WithArgs(budgetID, budget.Name, budget.TotalAmount, budget.Status, budget.UpdatedBy).
+		WithArgs(budgetID, budget.Name, budget.TotalAmount, budget.Status, budget.UpdatedBy, budget.CurrencyCode).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"id", "name", "total_amount", "status", "created_at", "created_by", "updated_at", "updated_by", "revision",
+			"id", "name", "total_amount", "status", "created_at", "created_by", "updated_at", "updated_by", "revision", "currency_code",
 		}).AddRow(
 			budgetID, budget.Name, budget.TotalAmount, budget.Status,
-			budget.CreatedAt, budget.CreatedBy, budget.UpdatedAt, budget.UpdatedBy, 2,
+			budget.CreatedAt, budget.CreatedBy, budget.UpdatedAt, budget.UpdatedBy, 2, budget.CurrencyCode,
 		))
 
 	updated, err := repo.Update(ctx, budget)
@@ -20,7 +20,7 @@ func newTestRepo(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *repository.CreditDebi
 	require.NoError(t, err)
 
 	queries := db.New(dbConn)
-	repo := repository.NewCreditDebitNoteRepo(queries).(*repository.CreditDebitNoteRepo)
+	repo := repository.NewCreditDebitNoteRepo(dbConn, queries).(*repository.CreditDebitNoteRepo)
 
 	return dbConn, mock, repo
 }
@@ -46,7 +46,8 @@ func TestCreditDebitNoteRepo_Create(t *testing.T) {
 	rows := sqlmock.NewRows([]string{
 		"id", "invoice_id", "type", "amount", "reason",
 		"created_at", "created_by", "updated_at", "updated_by", "revision",
-	}).AddRow(id, invoiceID, "credit", "1000.00", "discount", now, "user1", now, "user1", 1)
+		"journal_id", "payment_due_id", "applied_at",
+	}).AddRow(id, invoiceID, "credit", "1000.00", "discount", now, "user1", now, "user1", 1, nil, nil, nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO credit_debit_notes`)).
 		WithArgs(note.InvoiceID, note.Type, note.Amount, note.Reason, note.CreatedBy, note.UpdatedBy).
@@ -72,10 +73,11 @@ func TestCreditDebitNoteRepo_Get(t *testing.T) {
 	rows := sqlmock.NewRows([]string{
 		"id", "invoice_id", "type", "amount", "reason",
 		"created_at", "created_by", "updated_at", "updated_by", "revision",
-	}).AddRow(id, invoiceID, "debit", "500.00", "adjustment", now, "user1", now, "user2", 1)
+		"journal_id", "payment_due_id", "applied_at",
+	}).AddRow(id, invoiceID, "debit", "500.00", "adjustment", now, "user1", now, "user2", 1, nil, nil, nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision 
+		`SELECT id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision, journal_id, payment_due_id, applied_at 
  FROM credit_debit_notes WHERE id = $1`)).
 		WithArgs(id).
 		WillReturnRows(rows)
@@ -100,11 +102,12 @@ func TestCreditDebitNoteRepo_List(t *testing.T) {
 	rows := sqlmock.NewRows([]string{
 		"id", "invoice_id", "type", "amount", "reason",
 		"created_at", "created_by", "updated_at", "updated_by", "revision",
-	}).AddRow(id1, invoiceID, "credit", "100.00", "promo", now, "u1", now, "u1", 1).
-		AddRow(id2, invoiceID, "debit", "200.00", "adjustment", now, "u2", now, "u2", 1)
+		"journal_id", "payment_due_id", "applied_at",
+	}).AddRow(id1, invoiceID, "credit", "100.00", "promo", now, "u1", now, "u1", 1, nil, nil, nil).
+		AddRow(id2, invoiceID, "debit", "200.00", "adjustment", now, "u2", now, "u2", 1, nil, nil, nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(
-		`SELECT id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision 
+		`SELECT id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision, journal_id, payment_due_id, applied_at 
  FROM credit_debit_notes
  ORDER BY created_at DESC
  LIMIT $1 OFFSET $2`)).
@@ -139,13 +142,14 @@ func TestCreditDebitNoteRepo_Update(t *testing.T) {
 	rows := sqlmock.NewRows([]string{
 		"id", "invoice_id", "type", "amount", "reason",
 		"created_at", "created_by", "updated_at", "updated_by", "revision",
-	}).AddRow(id, invoiceID, "credit", "1500.00", "correction", now, "u1", now, "user2", 2)
+		"journal_id", "payment_due_id", "applied_at",
+	}).AddRow(id, invoiceID, "credit", "1500.00", "correction", now, "u1", now, "user2", 2, nil, nil, nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(
 		`UPDATE credit_debit_notes
  SET invoice_id = $2, type = $3, amount = $4, reason = $5, updated_by = $6, revision = revision + 1, updated_at = now()
  WHERE id = $1
- RETURNING id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision`)).
+ RETURNING id, invoice_id, type, amount, reason, created_at, created_by, updated_at, updated_by, revision, journal_id, payment_due_id, applied_at`)).
 		WithArgs(note.ID, note.InvoiceID, note.Type, note.Amount, note.Reason, note.UpdatedBy).
 		WillReturnRows(rows)
 
@@ -0,0 +1,69 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/repository"
+)
+
+func TestFiscalPeriodRepository_CloseYear(t *testing.T) {
+	dbConn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	repo := repository.NewFiscalPeriodRepository(dbConn, db.New(dbConn))
+	ctx := context.Background()
+	start := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+	accountID := uuid.New()
+	periodCols := []string{"id", "organization_id", "fiscal_year", "period_number", "start_date", "end_date",
+		"status", "closed_at", "closed_by", "updated_at", "year_closed_at"}
+	periods := func(status string) *sqlmock.Rows {
+		return sqlmock.NewRows(periodCols).
+			AddRow(uuid.New(), "org-1", 2025, 1, start, start.AddDate(0, 1, -1), status, nil, nil, now, nil).
+			AddRow(uuid.New(), "org-1", 2025, 12, end.AddDate(0, 0, -30), end, status, nil, nil, now, nil)
+	}
+
+	// The balances are read inside the transaction holding the periods, over
+	// the whole year.
+	expectRead := func() {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM fiscal_periods\s+WHERE organization_id = \$1 AND fiscal_year = \$2\s+ORDER BY period_number\s+FOR UPDATE`).
+			WithArgs("org-1", 2025).WillReturnRows(periods("OPEN"))
+		mock.ExpectQuery(`FROM ledger_entries le`).
+			WithArgs("org-1", start, end.AddDate(0, 0, 1)).
+			WillReturnRows(sqlmock.NewRows([]string{"account_id", "type", "net_amount"}).AddRow(accountID, "EXPENSE", "120.00"))
+	}
+
+	expectRead()
+	mock.ExpectQuery(`UPDATE fiscal_periods`).WithArgs("org-1", 2025, "controller").WillReturnRows(periods("CLOSED"))
+	mock.ExpectCommit()
+	var seen []db.SumIncomeStatementBalancesRow
+	_, closed, err := repo.CloseYear(ctx, "org-1", 2025, "controller", func(locked []db.FiscalPeriod, balances []db.SumIncomeStatementBalancesRow) (*db.JournalEntry, error) {
+		require.Len(t, locked, 2)
+		seen = balances
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, closed, 2)
+	require.Equal(t, []db.SumIncomeStatementBalancesRow{{AccountID: accountID, Type: "EXPENSE", NetAmount: "120.00"}}, seen)
+
+	// A failed closing entry leaves the year open.
+	expectRead()
+	mock.ExpectRollback()
+	_, _, err = repo.CloseYear(ctx, "org-1", 2025, "controller", func([]db.FiscalPeriod, []db.SumIncomeStatementBalancesRow) (*db.JournalEntry, error) {
+		return nil, errors.New("no retained earnings account")
+	})
+	require.Error(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,6 +3,7 @@ package repository_test
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/repository"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
 )
 
 // helpers to convert Null types for sqlmock
@@ -45,7 +47,7 @@ func TestUpdateInvoice(t *testing.T) {
 	defer dbConn.Close()
 
 	queries := db.New(dbConn)
-	repo := repository.NewInvoiceRepo(queries)
+	repo := repository.NewInvoiceRepo(dbConn, queries)
 
 	invoiceID := uuid.New()
 	now := time.Now()
@@ -119,7 +121,7 @@ func TestUpdateInvoice(t *testing.T) {
 				inv.VehicleNumber, inv.AgainstInvoiceNumber, inv.AgainstInvoiceDate,
 				inv.Subtotal, inv.GrandTotal, inv.GstRate, inv.GstCgst,
 				inv.GstSgst, inv.GstIgst,
-				inv.UpdatedBy, inv.Revision,
+				inv.UpdatedBy,
 			).
 			WillReturnRows(rows)
 
@@ -142,7 +144,7 @@ func TestUpdateInvoice(t *testing.T) {
 				inv.VehicleNumber, inv.AgainstInvoiceNumber, inv.AgainstInvoiceDate,
 				inv.Subtotal, inv.GrandTotal, inv.GstRate, inv.GstCgst,
 				inv.GstSgst, inv.GstIgst,
-				inv.UpdatedBy, inv.Revision,
+				inv.UpdatedBy,
 			).WillReturnError(sql.ErrNoRows)
 
 		_, err := repo.UpdateInvoice(ctx, inv)
@@ -161,7 +163,7 @@ func TestUpdateInvoice(t *testing.T) {
 				inv.VehicleNumber, inv.AgainstInvoiceNumber, inv.AgainstInvoiceDate,
 				inv.Subtotal, inv.GrandTotal, inv.GstRate, inv.GstCgst,
 				inv.GstSgst, inv.GstIgst,
-				inv.UpdatedBy, inv.Revision,
+				inv.UpdatedBy,
 			).WillReturnError(sql.ErrConnDone)
 
 		_, err := repo.UpdateInvoice(ctx, inv)
@@ -169,3 +171,257 @@ func TestUpdateInvoice(t *testing.T) {
 		require.Equal(t, sql.ErrConnDone, err)
 	})
 }
+
+var invoiceColumns = []string{
+	"id", "invoice_number", "type", "invoice_date", "due_date", "delivery_date",
+	"organization_id", "po_number", "eway_number_legacy", "status_note", "status",
+	"payment_reference", "challan_number", "challan_date", "lr_number",
+	"transporter_name", "transporter_id", "vehicle_number",
+	"against_invoice_number", "against_invoice_date",
+	"subtotal", "grand_total", "gst_rate", "gst_cgst", "gst_sgst", "gst_igst",
+	"created_at", "created_by", "updated_at", "updated_by", "revision",
+	"currency_code", "discount_total", "tax_total", "round_off",
+	"issued_at", "issued_by", "voided_at", "voided_by", "void_reason", "overdue_at", "overdue_by",
+	"journal_id", "party_id", "credit_approved_by", "credit_approved_at",
+	"credited_total", "debited_total",
+}
+
+func TestInvoiceRepository_CreateInvoiceAggregate(t *testing.T) {
+	dbConn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	repo := repository.NewInvoiceRepo(dbConn, db.New(dbConn))
+	ctx := context.Background()
+	invoiceID, itemID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	inv := db.Invoice{
+		InvoiceNumber: "INV-9", Type: "SALES", InvoiceDate: now, Status: "DRAFT",
+		Subtotal: "100.00", DiscountTotal: "10.00", TaxTotal: "16.20", GrandTotal: "106.20",
+		Items: []db.InvoiceItem{{Name: "Widget", Quantity: 1, UnitPrice: "100.00", LineSubtotal: "100.00", LineTotal: "118.00",
+			Taxes: []db.InvoiceItemTax{{Name: "IGST", Rate: "18", Amount: "18.00"}}}},
+		Discounts: []db.InvoiceDiscount{{Amount: "10.00"}},
+	}
+	header := func() *sqlmock.Rows {
+		return sqlmock.NewRows(invoiceColumns).AddRow(
+			invoiceID, "INV-9", "SALES", now, nil, nil, "", nil, nil, nil, "DRAFT",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			"100.00", "106.20", nil, nil, nil, nil,
+			now, nil, now, nil, 1, "INR", "10.00", "16.20", "0.00",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			"0", "0",
+		)
+	}
+	itemRow := sqlmock.NewRows([]string{
+		"id", "invoice_id", "name", "description", "hsn", "quantity", "unit_price", "line_subtotal", "line_total",
+		"cost_center_id", "created_at", "created_by", "updated_at", "updated_by", "revision", "line_no", "account_id",
+	}).AddRow(itemID, invoiceID, "Widget", nil, nil, 1, "100.00", "100.00", "118.00", nil, now, nil, nil, nil, 1, 0, nil)
+
+	// ---------- every row is written in one transaction ----------
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO invoices`).WillReturnRows(header())
+	mock.ExpectQuery(`INSERT INTO invoice_items`).WillReturnRows(itemRow)
+	mock.ExpectQuery(`INSERT INTO invoice_item_taxes`).
+		WithArgs(itemID, "IGST", "18", "18.00", sql.NullString{}, int32(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "name", "rate", "amount", "created_at", "created_by", "revision", "line_no"}).
+			AddRow(uuid.New(), itemID, "IGST", "18", "18.00", now, nil, 1, 0))
+	mock.ExpectQuery(`INSERT INTO invoice_discounts`).
+		WithArgs(invoiceID, sql.NullString{}, "10.00", int32(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "invoice_id", "description", "amount", "created_at", "created_by", "revision", "line_no"}).
+			AddRow(uuid.New(), invoiceID, nil, "10.00", now, nil, 1, 0))
+	mock.ExpectCommit()
+
+	created, err := repo.CreateInvoice(ctx, inv)
+	require.NoError(t, err)
+	require.Equal(t, invoiceID, created.ID)
+	require.Len(t, created.Items, 1)
+	require.Len(t, created.Items[0].Taxes, 1)
+	require.Len(t, created.Discounts, 1)
+
+	// ---------- a failing line rolls the whole invoice back ----------
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO invoices`).WillReturnRows(header())
+	mock.ExpectQuery(`INSERT INTO invoice_items`).WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	_, err = repo.CreateInvoice(ctx, inv)
+	require.ErrorIs(t, err, sql.ErrConnDone)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepository_GetInvoiceHydrated(t *testing.T) {
+	dbConn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	repo := repository.NewInvoiceRepo(dbConn, db.New(dbConn))
+	ctx := context.Background()
+	invoiceID, itemID := uuid.New(), uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(`FROM invoices WHERE id = \$1`).WithArgs(invoiceID).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns).AddRow(
+			invoiceID, "INV-9", "SALES", now, nil, nil, "", nil, nil, nil, "DRAFT",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			"100.00", "118.00", "18.000", "0.00", "0.00", "18.00",
+			now, nil, now, nil, 1, "INR", "0.00", "18.00", "0.00",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			"0", "0",
+		))
+	mock.ExpectQuery(`FROM invoice_items WHERE invoice_id = \$1`).WithArgs(invoiceID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "invoice_id", "name", "description", "hsn", "quantity", "unit_price", "line_subtotal", "line_total",
+			"cost_center_id", "created_at", "created_by", "updated_at", "updated_by", "revision", "line_no", "account_id",
+		}).AddRow(itemID, invoiceID, "Widget", nil, nil, 1, "100.00", "100.00", "118.00", nil, now, nil, nil, nil, 1, 0, nil))
+	mock.ExpectQuery(`FROM invoice_item_discounts`).WithArgs(invoiceID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "description", "amount", "created_at", "created_by", "revision", "line_no"}))
+	mock.ExpectQuery(`FROM invoice_item_taxes`).WithArgs(invoiceID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "name", "rate", "amount", "created_at", "created_by", "revision", "line_no"}).
+			AddRow(uuid.New(), itemID, "IGST", "18", "18.00", now, nil, 1, 0))
+	mock.ExpectQuery(`FROM invoice_discounts`).WithArgs(invoiceID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "invoice_id", "description", "amount", "created_at", "created_by", "revision", "line_no"}))
+	mock.ExpectQuery(`FROM invoice_taxes`).WithArgs(invoiceID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "invoice_id", "name", "rate", "amount", "created_at", "created_by", "revision", "line_no"}))
+	mock.ExpectQuery(`FROM gst_breakups`).WithArgs(invoiceID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "invoice_id", "taxable_amount", "cgst", "sgst", "igst", "total_gst", "created_at", "created_by", "revision"}).
+			AddRow(uuid.New(), invoiceID, "100.00", "0.00", "0.00", "18.00", "18.00", now, nil, 1))
+	mock.ExpectQuery(`FROM gst_regimes`).WithArgs(invoiceID).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(`FROM gst_doc_statuses`).WithArgs(invoiceID).WillReturnError(sql.ErrNoRows)
+
+	inv, err := repo.GetInvoice(ctx, invoiceID)
+	require.NoError(t, err)
+	require.Len(t, inv.Items, 1)
+	require.Equal(t, "IGST", inv.Items[0].Taxes[0].Name)
+	require.NotNil(t, inv.GstBreakup)
+	require.Equal(t, "18.00", inv.GstBreakup.TotalGst.String)
+	require.Nil(t, inv.Gst)
+	require.Nil(t, inv.GstDocs)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepository_StatusGuards(t *testing.T) {
+	dbConn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	repo := repository.NewInvoiceRepo(dbConn, db.New(dbConn))
+	ctx := context.Background()
+	invoiceID := uuid.New()
+
+	// Only drafts are deleted.
+	mock.ExpectExec(`DELETE FROM invoices WHERE id = \$1 AND status = 'DRAFT'`).WithArgs(invoiceID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.ErrorIs(t, repo.DeleteInvoice(ctx, invoiceID), sql.ErrNoRows)
+
+	// A transition from the wrong status, or a void after a note, matches no
+	// row.
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SET status = 'VOID'.*WHERE id = \$1 AND status IN \('ISSUED', 'OVERDUE'\)\s+AND credited_total = 0 AND debited_total = 0`).
+		WithArgs(invoiceID, sql.NullString{String: "clerk", Valid: true}, sql.NullString{String: "Duplicate", Valid: true}).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns))
+	mock.ExpectRollback()
+	_, _, err = repo.VoidInvoice(ctx, invoiceID, "Duplicate", "clerk", nil)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// Appending lines moves the draft's revision on and withdraws its credit
+	// approval, so an issue against the revision read before the append is
+	// refused and the grown draft needs approving again.
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE invoices\s+SET subtotal\s+= \$2,.*credit_approved_by = NULL,\s+credit_approved_at = NULL,.*revision\s+= revision \+ 1\s+WHERE id = \$1 AND status = 'DRAFT'`).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns))
+	mock.ExpectRollback()
+	_, err = repo.AppendInvoiceLines(ctx, db.Invoice{ID: invoiceID})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceRepository_IssueNumbersAndWritesJournal(t *testing.T) {
+	dbConn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	repo := repository.NewInvoiceRepo(dbConn, db.New(dbConn))
+	ctx := context.Background()
+	invoiceID, journalID, accountID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	var numbered string
+	issue := ports.InvoiceIssue{
+		Revision: 1,
+		Number: &ports.InvoiceSeriesNumber{FiscalYear: 2025, Format: func(seq int64) string {
+			return fmt.Sprintf("INV/%05d", seq)
+		}},
+		Journal: func(invoiceNumber string) (*db.JournalEntry, error) {
+			numbered = invoiceNumber
+			return &db.JournalEntry{
+				JournalDate: now,
+				Reference:   sql.NullString{String: invoiceNumber, Valid: true},
+				SourceType:  sql.NullString{String: "INVOICE", Valid: true},
+				SourceID:    sql.NullString{String: invoiceID.String(), Valid: true},
+				Status:      "POSTED",
+				Lines:       []db.JournalLine{{AccountID: accountID, Side: "DEBIT", Amount: "118.00", CurrencyCode: "INR"}},
+			}, nil
+		},
+	}
+
+	// An invoice that is no longer a draft draws no number.
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SET status = 'ISSUED'.*WHERE id = \$1 AND status = 'DRAFT' AND revision = \$3`).
+		WithArgs(invoiceID, sql.NullString{String: "clerk", Valid: true}, sql.NullInt32{Int32: 1, Valid: true}).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns))
+	mock.ExpectRollback()
+	_, _, err = repo.IssueInvoice(ctx, invoiceID, "clerk", issue)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.Empty(t, numbered)
+
+	// The number and the journal are written in the invoice's transaction, so
+	// an issue that fails gives the number back.
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SET status = 'ISSUED'.*WHERE id = \$1 AND status = 'DRAFT' AND revision = \$3`).
+		WithArgs(invoiceID, sql.NullString{String: "clerk", Valid: true}, sql.NullInt32{Int32: 1, Valid: true}).
+		WillReturnRows(sqlmock.NewRows(invoiceColumns).AddRow(
+			invoiceID, "", "SALES", now, nil, nil, "org-1", nil, nil, nil, "ISSUED",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			"100.00", "118.00", nil, nil, nil, nil,
+			now, nil, now, nil, 2, "INR", "0.00", "18.00", "0.00",
+			now, "clerk", nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			"0", "0",
+		))
+	mock.ExpectQuery(`INSERT INTO invoice_number_counters`).WithArgs("org-1", "SALES", int32(2025)).
+		WillReturnRows(sqlmock.NewRows([]string{"last_number"}).AddRow(42))
+	mock.ExpectQuery(`INSERT INTO journal_entries`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "journal_date", "reference", "memo", "source_type", "source_id",
+			"created_at", "created_by", "updated_at", "updated_by", "revision",
+			"status", "posted_at", "posted_by", "reversal_of", "reversed_by", "organization_id", "auto_reverse_date",
+		}).AddRow(
+			journalID, now, "INV/00042", nil, "INVOICE", invoiceID.String(),
+			now, nil, now, nil, 1, "POSTED", now, nil, nil, nil, "org-1", nil,
+		))
+	mock.ExpectExec(`DELETE FROM journal_lines WHERE journal_id = \$1`).WithArgs(journalID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO journal_lines`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "journal_id", "account_id", "side", "amount", "cost_center_id", "description", "created_at",
+			"currency_code", "transaction_amount", "exchange_rate", "functional_currency",
+		}).AddRow(uuid.New(), journalID, accountID, "DEBIT", "118.00", nil, nil, now, "INR", "118.00", "1", "INR"))
+	expectPeriodLock(mock, journalID)
+	mock.ExpectExec(`INSERT INTO ledger_entries`).WithArgs(journalID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO account_period_balances`).WithArgs(journalID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE invoices SET invoice_number = \$2, journal_id = \$3`).
+		WithArgs(invoiceID, "INV/00042", uuid.NullUUID{UUID: journalID, Valid: true}).
+		WillReturnError(errors.New(`duplicate key value violates unique constraint "uq_invoices_org_number"`))
+	mock.ExpectRollback()
+
+	_, posted, err := repo.IssueInvoice(ctx, invoiceID, "clerk", issue)
+	require.ErrorContains(t, err, "uq_invoices_org_number")
+	require.Nil(t, posted)
+	require.Equal(t, "INV/00042", numbered)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/repository"
+)
+
+func TestJournalTemplateRepository_CreateOccurrence(t *testing.T) {
+	dbConn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	repo := repository.NewJournalTemplateRepository(dbConn, db.New(dbConn))
+	ctx := context.Background()
+	templateID, journalID, accountID := uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	journal := &db.JournalEntry{
+		JournalDate: date,
+		SourceType:  sql.NullString{String: "RECURRING", Valid: true},
+		SourceID:    sql.NullString{String: templateID.String(), Valid: true},
+		Status:      "DRAFT",
+		Lines: []db.JournalLine{
+			{AccountID: accountID, Side: "DEBIT", Amount: "1000.00"},
+		},
+	}
+
+	// ---------- first run: claims the occurrence and writes the journal ----------
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO journal_template_runs`).
+		WithArgs(templateID, date).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO journal_entries`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "journal_date", "reference", "memo", "source_type", "source_id",
+			"created_at", "created_by", "updated_at", "updated_by", "revision",
+			"status", "posted_at", "posted_by", "reversal_of", "reversed_by", "organization_id", "auto_reverse_date",
+		}).AddRow(
+			journalID, date, nil, nil, "RECURRING", templateID.String(),
+			now, nil, now, nil, 1, "DRAFT", nil, nil, nil, nil, nil, nil,
+		))
+	mock.ExpectExec(`DELETE FROM journal_lines WHERE journal_id = \$1`).
+		WithArgs(journalID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO journal_lines`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "journal_id", "account_id", "side", "amount", "cost_center_id", "description", "created_at",
+			"currency_code", "transaction_amount", "exchange_rate", "functional_currency",
+		}).AddRow(uuid.New(), journalID, accountID, "DEBIT", "1000.00", nil, nil, now, "", "1000.00", "1", ""))
+	mock.ExpectExec(`UPDATE journal_template_runs SET journal_id`).
+		WithArgs(templateID, date, uuid.NullUUID{UUID: journalID, Valid: true}).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE journal_templates\s+SET last_occurrence`).
+		WithArgs(date, templateID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	created, err := repo.CreateOccurrence(ctx, templateID, date, journal)
+	require.NoError(t, err)
+	require.Equal(t, journalID, created.ID)
+
+	// ---------- rerun: the occurrence is already claimed, nothing is written ----------
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO journal_template_runs`).
+		WithArgs(templateID, date).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	created, err = repo.CreateOccurrence(ctx, templateID, date, journal)
+	require.NoError(t, err)
+	require.Nil(t, created)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
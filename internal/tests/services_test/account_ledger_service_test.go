@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
 )
 
@@ -44,6 +45,16 @@ func (m *MockAccountRepo) List(ctx context.Context, limit, offset int32) ([]*db.
 	return args.Get(0).([]*db.Account), args.Error(1)
 }
 
+func (m *MockAccountRepo) ListAll(ctx context.Context) ([]*db.Account, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*db.Account), args.Error(1)
+}
+
+func (m *MockAccountRepo) Usage(ctx context.Context, id uuid.UUID) (db.GetAccountUsageRow, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(db.GetAccountUsageRow), args.Error(1)
+}
+
 
 type MockJournalRepo struct{ mock.Mock }
 
@@ -62,17 +73,33 @@ func (m *MockJournalRepo) Update(ctx context.Context, j *db.JournalEntry) (*db.J
 func (m *MockJournalRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	return m.Called(ctx, id).Error(0)
 }
-func (m *MockJournalRepo) List(ctx context.Context, limit, offset int32) ([]*db.JournalEntry, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockJournalRepo) List(ctx context.Context, f ports.EntryFilter, limit, offset int32) ([]*db.JournalEntry, error) {
+	args := m.Called(ctx, f, limit, offset)
 	return args.Get(0).([]*db.JournalEntry), args.Error(1)
 }
+func (m *MockJournalRepo) Reverse(ctx context.Context, originalID uuid.UUID, reversal *db.JournalEntry) (*db.JournalEntry, error) {
+	args := m.Called(ctx, originalID, reversal)
+	return args.Get(0).(*db.JournalEntry), args.Error(1)
+}
+
+func (m *MockJournalRepo) BulkCreate(ctx context.Context, journals []*db.JournalEntry) error {
+	return m.Called(ctx, journals).Error(0)
+}
 
 type MockLedgerRepo struct{ mock.Mock }
 
-func (m *MockLedgerRepo) List(ctx context.Context, limit, offset int32) ([]*db.LedgerEntry, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockLedgerRepo) List(ctx context.Context, f ports.EntryFilter, limit, offset int32) ([]*db.LedgerEntry, error) {
+	args := m.Called(ctx, f, limit, offset)
 	return args.Get(0).([]*db.LedgerEntry), args.Error(1)
 }
+func (m *MockLedgerRepo) Balances(ctx context.Context, orgID string, accountIDs []uuid.UUID, from, to time.Time) ([]db.ListAccountBalancesRow, error) {
+	args := m.Called(ctx, orgID, accountIDs, from, to)
+	return args.Get(0).([]db.ListAccountBalancesRow), args.Error(1)
+}
+func (m *MockLedgerRepo) CurrencyBalances(ctx context.Context, orgID string, accountIDs []uuid.UUID, from, to time.Time) ([]db.ListAccountCurrencyBalancesRow, error) {
+	args := m.Called(ctx, orgID, accountIDs, from, to)
+	return args.Get(0).([]db.ListAccountCurrencyBalancesRow), args.Error(1)
+}
 
 // ---------------- Mock Publisher ----------------
 type MockPublisher struct {
@@ -276,6 +303,16 @@ func (m *MockPublisher) PublishInvoiceTaxAdded(ctx context.Context, tax *db.Invo
 func (m *MockPublisher) PublishInvoiceDiscountAdded(ctx context.Context, disc *db.InvoiceDiscount) error {
 	return m.Called(ctx, disc).Error(0)
 }
+func (m *MockPublisher) PublishInvoiceStatusChanged(ctx context.Context, ev *ports.InvoiceStatusChangedEvent) error {
+	return m.Called(ctx, ev).Error(0)
+}
+func (m *MockPublisher) PublishDunningReminder(ctx context.Context, ev *ports.DunningReminderEvent) error {
+	return m.Called(ctx, ev).Error(0)
+}
+
+func (m *MockPublisher) PublishCreditLimitBreached(ctx context.Context, ev *ports.CreditLimitBreachedEvent) error {
+	return m.Called(ctx, ev).Error(0)
+}
 
 // ---------------- Bank Transaction events ----------------
 func (m *MockPublisher) PublishBankTransactionImported(ctx context.Context, ev *db.BankAccount) error {
@@ -333,12 +370,18 @@ func TestServices_CRUD(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, listAcc, 1)
 
+	accRepo.On("Usage", ctx, accID).Return(db.GetAccountUsageRow{}, nil)
 	accRepo.On("Delete", ctx, accID).Return(nil)
 	pub.On("PublishAccountDeleted", mock.Anything, accID.String()).Return(nil)
 	err = accService.Delete(ctx, accID)
 	assert.NoError(t, err)
 
 	// ---------------- JournalService Test ----------------
+	revID := uuid.New()
+	accRepo.On("Get", ctx, revID).Return(&db.Account{
+		ID: revID, Code: "4000", Type: "REVENUE", Status: "ACTIVE", AllowManualJournal: true,
+	}, nil)
+
 	journal := &db.JournalEntry{
 		ID:         jID,
 		JournalDate: now,
@@ -348,10 +391,14 @@ func TestServices_CRUD(t *testing.T) {
 		CreatedBy:  sql.NullString{String: "admin", Valid: true},
 		UpdatedAt:  now, 
 		UpdatedBy:  sql.NullString{String: "admin", Valid: true},
+		Lines: []db.JournalLine{
+			{AccountID: accID, Side: "DEBIT", Amount: "100.10"},
+			{AccountID: revID, Side: "CREDIT", Amount: "100.1"},
+		},
 	}
 
 	jRepo := new(MockJournalRepo)
-	jService := services.NewJournalService(jRepo, pub)
+	jService := services.NewJournalService(jRepo, accRepo, nil, nil, pub)
 
 	jRepo.On("Create", ctx, journal).Return(journal, nil)
 	pub.On("PublishJournalCreated", mock.Anything, journal).Return(nil)
@@ -371,8 +418,8 @@ func TestServices_CRUD(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Updated Memo", updatedJournal.Memo.String)
 
-	jRepo.On("List", ctx, int32(10), int32(0)).Return([]*db.JournalEntry{journal}, nil)
-	listJournal, err := jService.List(ctx, 10, 0)
+	jRepo.On("List", ctx, ports.EntryFilter{}, int32(10), int32(0)).Return([]*db.JournalEntry{journal}, nil)
+	listJournal, err := jService.List(ctx, ports.EntryFilter{}, 10, 0)
 	assert.NoError(t, err)
 	assert.Len(t, listJournal, 1)
 
@@ -390,11 +437,297 @@ func TestServices_CRUD(t *testing.T) {
 		TransactionDate: now,
 	}
 	lRepo := new(MockLedgerRepo)
-	lService := services.NewLedgerService(lRepo)
+	lService := services.NewLedgerService(lRepo, nil, nil)
 
-	lRepo.On("List", ctx, int32(10), int32(0)).Return([]*db.LedgerEntry{ledgerEntry}, nil)
-	listLedger, err := lService.List(ctx, 10, 0)
+	lRepo.On("List", ctx, ports.EntryFilter{}, int32(10), int32(0)).Return([]*db.LedgerEntry{ledgerEntry}, nil)
+	listLedger, err := lService.List(ctx, ports.EntryFilter{}, 10, 0)
 	assert.NoError(t, err)
 	assert.Len(t, listLedger, 1)
 	assert.Equal(t, ledgerEntry.EntryID, listLedger[0].EntryID)
 }
+
+func TestJournalService_RejectsInvalidJournals(t *testing.T) {
+	ctx := context.Background()
+	cashID, revID, lockedID, missingID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	accRepo := new(MockAccountRepo)
+	accRepo.On("Get", ctx, cashID).Return(&db.Account{ID: cashID, Code: "1000", Status: "ACTIVE", AllowManualJournal: true}, nil)
+	accRepo.On("Get", ctx, revID).Return(&db.Account{ID: revID, Code: "4000", Status: "ACTIVE", AllowManualJournal: true}, nil)
+	accRepo.On("Get", ctx, lockedID).Return(&db.Account{ID: lockedID, Code: "1200", Status: "ACTIVE", AllowManualJournal: false}, nil)
+	accRepo.On("Get", ctx, missingID).Return((*db.Account)(nil), sql.ErrNoRows)
+
+	jRepo := new(MockJournalRepo)
+	jService := services.NewJournalService(jRepo, accRepo, nil, nil, nil)
+
+	tests := []struct {
+		name  string
+		entry *db.JournalEntry
+		paths []string
+	}{
+		{
+			name: "unbalanced",
+			entry: &db.JournalEntry{Lines: []db.JournalLine{
+				{AccountID: cashID, Side: "DEBIT", Amount: "100.00"},
+				{AccountID: revID, Side: "CREDIT", Amount: "99.99"},
+			}},
+			paths: []string{"lines"},
+		},
+		{
+			name: "single line",
+			entry: &db.JournalEntry{Lines: []db.JournalLine{
+				{AccountID: cashID, Side: "DEBIT", Amount: "10"},
+			}},
+			paths: []string{"lines", "lines"},
+		},
+		{
+			name: "bad side and amount",
+			entry: &db.JournalEntry{Lines: []db.JournalLine{
+				{AccountID: cashID, Side: "Debit", Amount: "10"},
+				{AccountID: revID, Side: "CREDIT", Amount: "-10"},
+			}},
+			paths: []string{"lines[0].side", "lines[1].amount", "lines"},
+		},
+		{
+			name: "missing account and manual journal not allowed",
+			entry: &db.JournalEntry{Lines: []db.JournalLine{
+				{AccountID: missingID, Side: "DEBIT", Amount: "5"},
+				{AccountID: lockedID, Side: "CREDIT", Amount: "5"},
+			}},
+			paths: []string{"lines[0].account_id", "lines[1].account_id"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := jService.Create(ctx, tc.entry)
+			assert.ErrorIs(t, err, services.ErrInvalidInput)
+
+			var verr *services.JournalValidationError
+			if assert.ErrorAs(t, err, &verr) {
+				paths := make([]string, len(verr.Violations))
+				for i, v := range verr.Violations {
+					paths[i] = v.Path()
+				}
+				assert.Equal(t, tc.paths, paths)
+			}
+		})
+	}
+
+	// System-posted journals may use accounts closed to manual entry.
+	posted := &db.JournalEntry{
+		SourceType: sql.NullString{String: "INVOICE", Valid: true},
+		Lines: []db.JournalLine{
+			{AccountID: lockedID, Side: "DEBIT", Amount: "5"},
+			{AccountID: revID, Side: "CREDIT", Amount: "5"},
+		},
+	}
+	jRepo.On("Create", ctx, posted).Return(posted, nil)
+	_, err := jService.Create(ctx, posted)
+	assert.NoError(t, err)
+	jRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestJournalService_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	cashID, revID := uuid.New(), uuid.New()
+
+	accRepo := new(MockAccountRepo)
+	accRepo.On("Get", ctx, cashID).Return(&db.Account{ID: cashID, Code: "1000", Status: "ACTIVE", AllowManualJournal: true}, nil)
+	accRepo.On("Get", ctx, revID).Return(&db.Account{ID: revID, Code: "4000", Status: "ACTIVE", AllowManualJournal: true}, nil)
+
+	jRepo := new(MockJournalRepo)
+	jService := services.NewJournalService(jRepo, accRepo, nil, nil, nil)
+
+	postedID := uuid.New()
+	posted := &db.JournalEntry{
+		ID:        postedID,
+		Status:    services.JournalPosted,
+		Reference: sql.NullString{String: "INV-1", Valid: true},
+		Lines: []db.JournalLine{
+			{AccountID: cashID, Side: "DEBIT", Amount: "50.00"},
+			{AccountID: revID, Side: "CREDIT", Amount: "50.00"},
+		},
+	}
+	jRepo.On("Get", ctx, postedID).Return(posted, nil)
+
+	// Posted entries are immutable.
+	_, err := jService.Update(ctx, &db.JournalEntry{ID: postedID, Lines: posted.Lines})
+	assert.ErrorIs(t, err, services.ErrConflict)
+	err = jService.Delete(ctx, postedID)
+	assert.ErrorIs(t, err, services.ErrConflict)
+	jRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	jRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+
+	// A draft's auto-reverse date is stored as updated, and must still fall
+	// after the journal date.
+	draftID := uuid.New()
+	draftDate := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	jRepo.On("Get", ctx, draftID).Return(&db.JournalEntry{
+		ID: draftID, Status: services.JournalDraft, JournalDate: draftDate,
+		AutoReverseDate: sql.NullTime{Time: draftDate.AddDate(0, 0, 1), Valid: true},
+	}, nil)
+	reverseOn := draftDate.AddDate(0, 0, 2)
+	jRepo.On("Update", ctx, mock.MatchedBy(func(j *db.JournalEntry) bool {
+		return j.ID == draftID && j.AutoReverseDate.Time.Equal(reverseOn)
+	})).Return(&db.JournalEntry{ID: draftID, Status: services.JournalDraft}, nil).Once()
+	_, err = jService.Update(ctx, &db.JournalEntry{ID: draftID, JournalDate: draftDate, Lines: posted.Lines,
+		AutoReverseDate: sql.NullTime{Time: reverseOn, Valid: true}})
+	assert.NoError(t, err)
+	_, err = jService.Update(ctx, &db.JournalEntry{ID: draftID, JournalDate: draftDate, Lines: posted.Lines,
+		AutoReverseDate: sql.NullTime{Time: draftDate, Valid: true}})
+	assert.ErrorIs(t, err, services.ErrInvalidInput)
+
+	// Reversal mirrors every line on the chosen date.
+	revDate := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	jRepo.On("Reverse", ctx, postedID, mock.MatchedBy(func(r *db.JournalEntry) bool {
+		return r.JournalDate.Equal(revDate) && r.Status == services.JournalPosted &&
+			r.Lines[0].Side == "CREDIT" && r.Lines[1].Side == "DEBIT" &&
+			r.Reference.String == "INV-1"
+	})).Return(&db.JournalEntry{ID: uuid.New(), Status: services.JournalPosted}, nil)
+	_, err = jService.Reverse(ctx, postedID, revDate, "")
+	assert.NoError(t, err)
+
+	// Only posted entries can be reversed.
+	reversedID := uuid.New()
+	jRepo.On("Get", ctx, reversedID).Return(&db.JournalEntry{ID: reversedID, Status: services.JournalReversed}, nil)
+	_, err = jService.Reverse(ctx, reversedID, revDate, "")
+	assert.ErrorIs(t, err, services.ErrConflict)
+
+	missingID := uuid.New()
+	jRepo.On("Get", ctx, missingID).Return((*db.JournalEntry)(nil), sql.ErrNoRows)
+	_, err = jService.Reverse(ctx, missingID, revDate, "")
+	assert.ErrorIs(t, err, services.ErrNotFound)
+}
+
+func TestLedgerService_Balances(t *testing.T) {
+	ctx := context.Background()
+	cashID, revID := uuid.New(), uuid.New()
+	from := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)
+
+	lRepo := new(MockLedgerRepo)
+	lService := services.NewLedgerService(lRepo, nil, nil)
+	lRepo.On("Balances", ctx, "org-1", []uuid.UUID(nil), from, to).Return([]db.ListAccountBalancesRow{
+		{AccountID: cashID, Code: "1000", Type: "ASSET",
+			OpeningDebit: "500.00", OpeningCredit: "100.00", PeriodDebit: "250.00", PeriodCredit: "50.00"},
+		{AccountID: revID, Code: "4000", Type: "REVENUE",
+			OpeningDebit: "0.00", OpeningCredit: "300.00", PeriodDebit: "20.00", PeriodCredit: "200.00"},
+	}, nil)
+	lRepo.On("CurrencyBalances", ctx, "org-1", []uuid.UUID(nil), from, to).Return([]db.ListAccountCurrencyBalancesRow{
+		{AccountID: revID, CurrencyCode: "USD",
+			OpeningDebit: "0.00", OpeningCredit: "2.00", PeriodDebit: "0.00", PeriodCredit: "1.50"},
+	}, nil)
+
+	balances, err := lService.Balances(ctx, "org-1", nil, from, to)
+	assert.NoError(t, err)
+	assert.Len(t, balances, 2)
+
+	// Debit-normal: opening 400, +250 -50.
+	assert.Equal(t, "400.00", balances[0].Opening)
+	assert.Equal(t, "250.00", balances[0].TotalDebits)
+	assert.Equal(t, "50.00", balances[0].TotalCredits)
+	assert.Equal(t, "600.00", balances[0].Closing)
+
+	// Credit-normal: opening 300, +200 -20.
+	assert.Equal(t, "300.00", balances[1].Opening)
+	assert.Equal(t, "480.00", balances[1].Closing)
+	assert.Equal(t, to, balances[1].To)
+	assert.Empty(t, balances[0].Currencies)
+	assert.Equal(t, []ports.CurrencyBalance{
+		{Currency: "USD", Opening: "2.00", TotalDebits: "0.00", TotalCredits: "1.50", Closing: "3.50"},
+	}, balances[1].Currencies)
+
+	_, err = lService.Balances(ctx, "org-1", nil, to, from)
+	assert.ErrorIs(t, err, services.ErrInvalidInput)
+}
+
+func TestAccountService_Hierarchy(t *testing.T) {
+	ctx := context.Background()
+	assetsID, cashID, bankID, revID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	assets := &db.Account{ID: assetsID, Code: "1000", Type: "ASSET", Status: "ACTIVE"}
+	cash := &db.Account{ID: cashID, Code: "1100", Type: "ASSET", Status: "ACTIVE",
+		ParentID: uuid.NullUUID{UUID: assetsID, Valid: true}}
+	bank := &db.Account{ID: bankID, Code: "1110", Type: "ASSET", Status: "ACTIVE",
+		ParentID: uuid.NullUUID{UUID: cashID, Valid: true}}
+	rev := &db.Account{ID: revID, Code: "4000", Type: "REVENUE", Status: "ACTIVE"}
+
+	accRepo := new(MockAccountRepo)
+	for _, a := range []*db.Account{assets, cash, bank, rev} {
+		accRepo.On("Get", ctx, a.ID).Return(a, nil)
+	}
+	accService := services.NewAccountService(accRepo, nil)
+
+	// A child must share its parent's type.
+	_, err := accService.Create(ctx, &db.Account{Code: "1200", Type: "REVENUE",
+		ParentID: uuid.NullUUID{UUID: assetsID, Valid: true}})
+	assert.ErrorIs(t, err, services.ErrInvalidInput)
+
+	// Re-parenting 1000 under its grandchild 1110 would close a loop.
+	_, err = accService.Update(ctx, &db.Account{ID: assetsID, Code: "1000", Type: "ASSET", Status: "ACTIVE",
+		ParentID: uuid.NullUUID{UUID: bankID, Valid: true}})
+	assert.ErrorIs(t, err, services.ErrInvalidInput)
+	accRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+
+	// Accounts with children or ledger lines can be neither deleted nor archived.
+	accRepo.On("Usage", ctx, assetsID).Return(db.GetAccountUsageRow{ChildCount: 1}, nil)
+	accRepo.On("Usage", ctx, revID).Return(db.GetAccountUsageRow{HasLedgerEntries: true}, nil)
+	assert.ErrorIs(t, accService.Delete(ctx, assetsID), services.ErrConflict)
+	assert.ErrorIs(t, accService.Delete(ctx, revID), services.ErrConflict)
+	_, err = accService.Update(ctx, &db.Account{ID: revID, Code: "4000", Type: "REVENUE", Status: services.AccountArchived})
+	assert.ErrorIs(t, err, services.ErrConflict)
+	accRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestLedgerService_AccountTree(t *testing.T) {
+	ctx := context.Background()
+	assetsID, cashID, bankID := uuid.New(), uuid.New(), uuid.New()
+	to := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	accRepo := new(MockAccountRepo)
+	accRepo.On("ListAll", ctx).Return([]*db.Account{
+		{ID: assetsID, Code: "1000", Type: "ASSET"},
+		{ID: cashID, Code: "1100", Type: "ASSET", ParentID: uuid.NullUUID{UUID: assetsID, Valid: true}},
+		{ID: bankID, Code: "1200", Type: "ASSET", ParentID: uuid.NullUUID{UUID: assetsID, Valid: true}},
+	}, nil)
+	lRepo := new(MockLedgerRepo)
+	lRepo.On("Balances", ctx, "org-1", []uuid.UUID(nil), time.Time{}, to).Return([]db.ListAccountBalancesRow{
+		{AccountID: assetsID, Code: "1000", Type: "ASSET", OpeningDebit: "0", OpeningCredit: "0", PeriodDebit: "0", PeriodCredit: "0"},
+		{AccountID: cashID, Code: "1100", Type: "ASSET", OpeningDebit: "0", OpeningCredit: "0", PeriodDebit: "300.00", PeriodCredit: "100.00"},
+		{AccountID: bankID, Code: "1200", Type: "ASSET", OpeningDebit: "0", OpeningCredit: "0", PeriodDebit: "50.00", PeriodCredit: "0"},
+	}, nil)
+	lRepo.On("CurrencyBalances", ctx, "org-1", []uuid.UUID(nil), time.Time{}, to).Return([]db.ListAccountCurrencyBalancesRow{
+		{AccountID: cashID, CurrencyCode: "USD", OpeningDebit: "0", OpeningCredit: "0", PeriodDebit: "2.00", PeriodCredit: "0"},
+		{AccountID: bankID, CurrencyCode: "EUR", OpeningDebit: "0", OpeningCredit: "0", PeriodDebit: "0.50", PeriodCredit: "0"},
+		{AccountID: bankID, CurrencyCode: "USD", OpeningDebit: "0", OpeningCredit: "0", PeriodDebit: "1.00", PeriodCredit: "0.25"},
+	}, nil)
+	lService := services.NewLedgerService(lRepo, accRepo, nil)
+
+	roots, err := lService.AccountTree(ctx, "org-1", time.Time{}, to)
+	assert.NoError(t, err)
+	assert.Len(t, roots, 1)
+	assert.Len(t, roots[0].Children, 2)
+	assert.Equal(t, "250.00", roots[0].Balance.Closing)
+	assert.Equal(t, "350.00", roots[0].Balance.TotalDebits)
+	assert.Equal(t, "200.00", roots[0].Children[0].Balance.Closing)
+	assert.Equal(t, []ports.CurrencyBalance{
+		{Currency: "EUR", Opening: "0.00", TotalDebits: "0.50", TotalCredits: "0.00", Closing: "0.50"},
+		{Currency: "USD", Opening: "0.00", TotalDebits: "3.00", TotalCredits: "0.25", Closing: "2.75"},
+	}, roots[0].Balance.Currencies)
+	assert.Len(t, roots[0].Children[0].Balance.Currencies, 1)
+}
+
+func TestLedgerService_ListRejectsInvalidFilters(t *testing.T) {
+	ctx := context.Background()
+	lRepo := new(MockLedgerRepo)
+	lService := services.NewLedgerService(lRepo, nil, nil)
+
+	for _, f := range []ports.EntryFilter{
+		{Side: "LEFT"},
+		{FromDate: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), ToDate: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{OrderBy: "memo"},
+	} {
+		_, err := lService.List(ctx, f, 10, 0)
+		assert.ErrorIs(t, err, services.ErrInvalidInput)
+	}
+	lRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -3,20 +3,22 @@ package services_test
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"testing"
 	"time"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // --- Mock Repository ---
 type MockCreditDebitNoteRepo struct {
 	mock.Mock
+	applied ports.NoteApplication
 }
 
 func (m *MockCreditDebitNoteRepo) Create(ctx context.Context, note db.CreditDebitNote) (db.CreditDebitNote, error) {
@@ -44,6 +46,21 @@ func (m *MockCreditDebitNoteRepo) Delete(ctx context.Context, id uuid.UUID) erro
 	return args.Error(0)
 }
 
+// Apply hands plan the invoice and dues the test gives and returns the
+// note and invoice as the plan leaves them.
+func (m *MockCreditDebitNoteRepo) Apply(ctx context.Context, note db.CreditDebitNote, by string, plan ports.NotePlan) (db.CreditDebitNote, db.Invoice, *db.JournalEntry, error) {
+	args := m.Called(ctx, note, by)
+	inv, dues := args.Get(0).(db.Invoice), args.Get(1).([]db.PaymentDue)
+	app, err := plan(note, inv, dues)
+	m.applied = app
+	if err != nil {
+		return db.CreditDebitNote{}, db.Invoice{}, nil, err
+	}
+	note.AppliedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	inv.Status = app.Status
+	return note, inv, app.Journal, nil
+}
+
 // --- Mock Publisher ---
 type MockePublisher struct {
 	mock.Mock
@@ -70,50 +87,306 @@ func makeTestNote() db.CreditDebitNote {
 		Type:      "CREDIT",
 		Amount:    "500.00",
 		Reason:    sql.NullString{String: "test", Valid: true},
-		CreatedAt: sql.NullTime{Time: time.Now(), Valid: true}, 
+		CreatedAt: sql.NullTime{Time: time.Now(), Valid: true},
 		CreatedBy: sql.NullString{String: "tester", Valid: true},
 	}
 }
 
-func TestCreditDebitNoteService_Create_Success(t *testing.T) {
+func issuedInvoice(id uuid.UUID) db.Invoice {
+	return db.Invoice{ID: id, InvoiceNumber: "INV/00042", Type: "SALES", Status: "ISSUED", GrandTotal: "500.00", CurrencyCode: "INR"}
+}
+
+func TestCreditDebitNoteService_Create_CreditReducesDues(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := new(MockCreditDebitNoteRepo)
 	mockPub := new(MockPublisher)
-	svc := services.NewCreditDebitNoteService(mockRepo, mockPub)
+	invoices := new(MockInvoiceRepo)
+	svc := services.NewCreditDebitNoteService(mockRepo, services.NewInvoiceService(invoices, nil, nil, nil, nil, nil, mockPub, nil, nil), mockPub)
 
 	input := makeTestNote()
-	expected := input
+	input.Type, input.Amount = "note_type_credit", "400"
+	inv := issuedInvoice(input.InvoiceID)
+	invoices.On("GetInvoice", ctx, inv.ID).Return(inv, nil)
+	open := []db.PaymentDue{
+		{ID: uuid.New(), InvoiceID: inv.ID, AmountDue: "300.00", Status: "DUE"},
+		{ID: uuid.New(), InvoiceID: inv.ID, AmountDue: "200.00", Status: "OVERDUE"},
+	}
+	want := input
+	want.Type, want.Amount = services.NoteCredit, "400.00"
+	mockRepo.On("Apply", ctx, want, "tester").Return(inv, open).Once()
+	mockPub.On("PublishCreditDebitNoteCreated", ctx, mock.Anything).Return(nil).Once()
+
+	got, err := svc.Create(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, got.AppliedAt.Valid)
+
+	// The earliest due is taken to nothing and the next one reduced; the
+	// invoice stays open.
+	app := mockRepo.applied
+	require.Len(t, app.Dues, 2)
+	assert.Equal(t, "0.00", app.Dues[0].AmountDue)
+	assert.Equal(t, services.PaymentDuePaid, app.Dues[0].Status)
+	assert.Equal(t, "100.00", app.Dues[1].AmountDue)
+	assert.Equal(t, "OVERDUE", app.Dues[1].Status)
+	assert.Nil(t, app.NewDue)
+	assert.Equal(t, "400.00", app.Credited)
+	assert.Equal(t, "ISSUED", app.Status)
+	mockPub.AssertNotCalled(t, "PublishInvoiceStatusChanged", mock.Anything, mock.Anything)
+
+	// Crediting what is left pays the invoice.
+	input.Amount = "100.00"
+	want.Amount = "100.00"
+	credited := inv
+	credited.CreditedTotal = "400.00"
+	rest := []db.PaymentDue{app.Dues[0], app.Dues[1]}
+	mockRepo.On("Apply", ctx, want, "tester").Return(credited, rest).Once()
+	mockPub.On("PublishInvoiceStatusChanged", ctx, mock.MatchedBy(func(ev *ports.InvoiceStatusChangedEvent) bool {
+		return ev.From == "ISSUED" && ev.To == "PAID"
+	})).Return(nil).Once()
+	mockPub.On("PublishCreditDebitNoteCreated", ctx, mock.Anything).Return(nil).Once()
+
+	_, err = svc.Create(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, "PAID", mockRepo.applied.Status)
+	require.Len(t, mockRepo.applied.Dues, 1)
+	assert.Equal(t, services.PaymentDuePaid, mockRepo.applied.Dues[0].Status)
 
-	mockRepo.On("Create", mock.Anything, input).Return(expected, nil).Once()
-	mockPub.On("PublishCreditDebitNoteCreated", mock.Anything, &expected).Return(nil).Once()
+	mockRepo.AssertExpectations(t)
+	mockPub.AssertExpectations(t)
+}
 
-	got, err := svc.Create(context.Background(), input)
+func TestCreditDebitNoteService_Create_OverCredit(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockCreditDebitNoteRepo)
+	mockPub := new(MockPublisher)
+	invoices := new(MockInvoiceRepo)
+	svc := services.NewCreditDebitNoteService(mockRepo, services.NewInvoiceService(invoices, nil, nil, nil, nil, nil, mockPub, nil, nil), mockPub)
 
-	assert.NoError(t, err)
-	assert.Equal(t, expected.ID, got.ID)
+	input := makeTestNote()
+	inv := issuedInvoice(input.InvoiceID)
+	invoices.On("GetInvoice", ctx, inv.ID).Return(inv, nil)
+	mockRepo.On("Apply", ctx, input, "tester").Return(inv, []db.PaymentDue{
+		{InvoiceID: inv.ID, AmountDue: "0.01", Status: "PAID"},
+		{InvoiceID: inv.ID, AmountDue: "499.99", Status: "DUE"},
+	}).Once()
+
+	_, err := svc.Create(ctx, input)
+	require.ErrorIs(t, err, services.ErrConflict)
+	assert.Contains(t, err.Error(), "499.99")
+	mockPub.AssertNotCalled(t, "PublishCreditDebitNoteCreated", mock.Anything, mock.Anything)
+}
+
+func TestCreditDebitNoteService_Create_CreditWithoutDues(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockCreditDebitNoteRepo)
+	mockPub := new(MockPublisher)
+	invoices := new(MockInvoiceRepo)
+	svc := services.NewCreditDebitNoteService(mockRepo, services.NewInvoiceService(invoices, nil, nil, nil, nil, nil, mockPub, nil, nil), mockPub)
+
+	// Issuing raises no dues, so the whole invoice is open.
+	input := makeTestNote()
+	input.Amount = "200.00"
+	inv := issuedInvoice(input.InvoiceID)
+	invoices.On("GetInvoice", ctx, inv.ID).Return(inv, nil)
+	mockRepo.On("Apply", ctx, input, "tester").Return(inv, []db.PaymentDue(nil)).Once()
+	mockPub.On("PublishCreditDebitNoteCreated", ctx, mock.Anything).Return(nil).Once()
+
+	_, err := svc.Create(ctx, input)
+	require.NoError(t, err)
+	app := mockRepo.applied
+	assert.Empty(t, app.Dues)
+	assert.Equal(t, "200.00", app.Credited)
+	assert.Equal(t, "ISSUED", app.Status)
+
+	// What is left after that note and a paid due can be credited, and no
+	// more.
+	credited := inv
+	credited.CreditedTotal = "200.00"
+	paid := []db.PaymentDue{{InvoiceID: inv.ID, AmountDue: "250.00", Status: "PAID"}}
+	input.Amount = "50.01"
+	mockRepo.On("Apply", ctx, input, "tester").Return(credited, paid).Once()
+	_, err = svc.Create(ctx, input)
+	require.ErrorIs(t, err, services.ErrConflict)
+	assert.Contains(t, err.Error(), "exceeds the 50.00 open")
+
+	input.Amount = "50.00"
+	mockRepo.On("Apply", ctx, input, "tester").Return(credited, paid).Once()
+	mockPub.On("PublishInvoiceStatusChanged", ctx, mock.MatchedBy(func(ev *ports.InvoiceStatusChangedEvent) bool {
+		return ev.From == "ISSUED" && ev.To == "PAID"
+	})).Return(nil).Once()
+	mockPub.On("PublishCreditDebitNoteCreated", ctx, mock.Anything).Return(nil).Once()
+	_, err = svc.Create(ctx, input)
+	require.NoError(t, err)
+	assert.Empty(t, mockRepo.applied.Dues)
+	assert.Equal(t, "PAID", mockRepo.applied.Status)
 
 	mockRepo.AssertExpectations(t)
 	mockPub.AssertExpectations(t)
 }
 
-func TestCreditDebitNoteService_Create_RepoError(t *testing.T) {
+func TestCreditDebitNoteService_Create_DebitRaisesDue(t *testing.T) {
+	ctx := context.Background()
 	mockRepo := new(MockCreditDebitNoteRepo)
 	mockPub := new(MockPublisher)
-	svc := services.NewCreditDebitNoteService(mockRepo, mockPub)
+	invoices := new(MockInvoiceRepo)
+	svc := services.NewCreditDebitNoteService(mockRepo, services.NewInvoiceService(invoices, nil, nil, nil, nil, nil, mockPub, nil, nil), mockPub)
 
 	input := makeTestNote()
+	input.Type = "debit"
+	inv := issuedInvoice(input.InvoiceID)
+	inv.Status = "OVERDUE"
+	inv.DueDate = sql.NullTime{Time: time.Now().AddDate(0, 0, -10), Valid: true}
+	account := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+	invoices.On("GetInvoice", ctx, inv.ID).Return(inv, nil)
+	want := input
+	want.Type = services.NoteDebit
+	mockRepo.On("Apply", ctx, want, "tester").
+		Return(inv, []db.PaymentDue{{InvoiceID: inv.ID, AmountDue: "50.00", Status: "OVERDUE", ExchangeRate: "1.000000", AccountID: account}}).Once()
+	mockPub.On("PublishCreditDebitNoteCreated", ctx, mock.Anything).Return(nil).Once()
+
+	_, err := svc.Create(ctx, input)
+	require.NoError(t, err)
+
+	// The new due is payable today, the invoice's own due date having passed.
+	app := mockRepo.applied
+	require.NotNil(t, app.NewDue)
+	assert.Empty(t, app.Dues)
+	assert.Equal(t, "500.00", app.NewDue.AmountDue)
+	assert.Equal(t, services.PaymentDueOpen, app.NewDue.Status)
+	assert.Equal(t, account, app.NewDue.AccountID)
+	assert.Equal(t, time.Now().UTC().Truncate(24*time.Hour), app.NewDue.DueDate)
+	assert.Equal(t, "500.00", app.Debited)
+	assert.Equal(t, "OVERDUE", app.Status)
+
+	// A PAID invoice can still be debited, and owes again.
+	paid := issuedInvoice(uuid.New())
+	paid.Status = services.InvoicePaid
+	input.InvoiceID = paid.ID
+	invoices.On("GetInvoice", ctx, paid.ID).Return(paid, nil)
+	want.InvoiceID = paid.ID
+	mockRepo.On("Apply", ctx, want, "tester").
+		Return(paid, []db.PaymentDue{{InvoiceID: paid.ID, AmountDue: "1180.00", Status: services.PaymentDuePaid}}).Once()
+	mockPub.On("PublishCreditDebitNoteCreated", ctx, mock.Anything).Return(nil).Once()
+	mockPub.On("PublishInvoiceStatusChanged", ctx, mock.MatchedBy(func(ev *ports.InvoiceStatusChangedEvent) bool {
+		return ev.From == services.InvoicePaid && ev.To == services.InvoicePartiallyPaid
+	})).Return(nil).Once()
+
+	_, err = svc.Create(ctx, input)
+	require.NoError(t, err)
+	app = mockRepo.applied
+	require.NotNil(t, app.NewDue)
+	assert.Equal(t, "500.00", app.NewDue.AmountDue)
+	assert.Equal(t, services.InvoicePartiallyPaid, app.Status)
 
-	mockRepo.On("Create", mock.Anything, input).Return(db.CreditDebitNote{}, errors.New("db error")).Once()
+	mockRepo.AssertExpectations(t)
+	mockPub.AssertExpectations(t)
+}
 
-	_, err := svc.Create(context.Background(), input)
-	assert.Error(t, err)
+func TestCreditDebitNoteService_Create_Refused(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockCreditDebitNoteRepo)
+	mockPub := new(MockPublisher)
+	invoices := new(MockInvoiceRepo)
+	svc := services.NewCreditDebitNoteService(mockRepo, services.NewInvoiceService(invoices, nil, nil, nil, nil, nil, mockPub, nil, nil), mockPub)
+
+	bad := makeTestNote()
+	bad.Amount = "-5"
+	_, err := svc.Create(ctx, bad)
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+
+	bad = makeTestNote()
+	bad.Type = "refund"
+	_, err = svc.Create(ctx, bad)
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+
+	for _, status := range []string{"DRAFT", "PAID", "VOID"} {
+		input := makeTestNote()
+		inv := issuedInvoice(input.InvoiceID)
+		inv.Status = status
+		invoices.On("GetInvoice", ctx, inv.ID).Return(inv, nil)
+		_, err := svc.Create(ctx, input)
+		require.ErrorIs(t, err, services.ErrConflict, status)
+	}
 
-	mockRepo.AssertExpectations(t)
+	missing := makeTestNote()
+	invoices.On("GetInvoice", ctx, missing.InvoiceID).Return(db.Invoice{}, sql.ErrNoRows)
+	_, err = svc.Create(ctx, missing)
+	require.ErrorIs(t, err, services.ErrNotFound)
+
+	mockRepo.AssertNotCalled(t, "Apply", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreditDebitNoteService_Create_PostsJournal(t *testing.T) {
+	ctx := context.Background()
+	arID, salesID, cgstID, sgstID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	accRepo := new(MockAccountRepo)
+	for _, id := range []uuid.UUID{arID, salesID, cgstID, sgstID} {
+		accRepo.On("Get", ctx, id).Return(&db.Account{ID: id, Status: "ACTIVE"}, nil)
+	}
+	journals := services.NewJournalService(new(MockJournalRepo), accRepo, nil, services.NewFXConverter(nil, new(MockExchangeRateService), "INR"), nil)
+	invoices := new(MockInvoiceRepo)
+	mockRepo := new(MockCreditDebitNoteRepo)
+	mockPub := new(MockPublisher)
+	svc := services.NewCreditDebitNoteService(mockRepo, services.NewInvoiceService(invoices, nil, nil, nil, nil, journals, mockPub, nil, nil), mockPub)
+
+	invoices.On("GetPostingRule", ctx, "org-1", services.InvoiceTypeSales).Return(db.InvoicePostingRule{
+		ControlAccountID: arID, LineAccountID: salesID,
+		CgstAccountID: uuid.NullUUID{UUID: cgstID, Valid: true},
+		SgstAccountID: uuid.NullUUID{UUID: sgstID, Valid: true},
+	}, nil)
+	// 1000.00 taxed at 9% + 9%.
+	inv := db.Invoice{
+		ID: uuid.New(), InvoiceNumber: "INV-7", Type: services.InvoiceTypeSales, Status: services.InvoiceIssued,
+		OrganizationID: "org-1", CurrencyCode: "INR", JournalID: uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		Items:    []db.InvoiceItem{{Name: "Widget", LineSubtotal: "1000.00"}},
+		GstCgst:  sql.NullString{String: "90.00", Valid: true},
+		GstSgst:  sql.NullString{String: "90.00", Valid: true},
+		TaxTotal: "180.00", RoundOff: "0.00", GrandTotal: "1180.00",
+	}
+	invoices.On("GetInvoice", ctx, inv.ID).Return(inv, nil)
+
+	note := makeTestNote()
+	note.InvoiceID, note.Amount = inv.ID, "118.00"
+	mockRepo.On("Apply", ctx, note, "tester").Return(inv, []db.PaymentDue{{InvoiceID: inv.ID, AmountDue: "1180.00", Status: "DUE"}}).Once()
+	mockPub.On("PublishCreditDebitNoteCreated", ctx, mock.Anything).Return(nil).Once()
+
+	_, err := svc.Create(ctx, note)
+	require.NoError(t, err)
+
+	// A tenth of the invoice is credited back: the receivable, the revenue
+	// and the GST are each reversed by a tenth.
+	j := mockRepo.applied.Journal
+	require.NotNil(t, j)
+	assert.Equal(t, services.JournalPosted, j.Status)
+	assert.Equal(t, services.SourceCreditDebitNote, j.SourceType.String)
+	assert.Equal(t, note.ID.String(), j.SourceID.String)
+	assert.Equal(t, "credit note against invoice INV-7: test", j.Memo.String)
+	type posting struct {
+		account uuid.UUID
+		side    string
+		amount  string
+	}
+	var got []posting
+	for _, l := range j.Lines {
+		got = append(got, posting{l.AccountID, l.Side, l.Amount})
+	}
+	assert.Equal(t, []posting{
+		{arID, services.SideCredit, "118.00"},
+		{salesID, services.SideDebit, "100.00"},
+		{cgstID, services.SideDebit, "9.00"},
+		{sgstID, services.SideDebit, "9.00"},
+	}, got)
+}
+
+func TestNetBilled(t *testing.T) {
+	inv := db.Invoice{GrandTotal: "1180.00", CreditedTotal: "200.00", DebitedTotal: "50.5"}
+	assert.Equal(t, "1030.50", services.NetBilled(inv))
 }
 
 func TestCreditDebitNoteService_Get(t *testing.T) {
 	mockRepo := new(MockCreditDebitNoteRepo)
 	mockPub := new(MockPublisher)
-	svc := services.NewCreditDebitNoteService(mockRepo, mockPub)
+	svc := services.NewCreditDebitNoteService(mockRepo, nil, mockPub)
 
 	note := makeTestNote()
 	mockRepo.On("Get", mock.Anything, note.ID).Return(note, nil).Once()
@@ -128,7 +401,7 @@ func TestCreditDebitNoteService_Get(t *testing.T) {
 func TestCreditDebitNoteService_List(t *testing.T) {
 	mockRepo := new(MockCreditDebitNoteRepo)
 	mockPub := new(MockPublisher)
-	svc := services.NewCreditDebitNoteService(mockRepo, mockPub)
+	svc := services.NewCreditDebitNoteService(mockRepo, nil, mockPub)
 
 	notes := []db.CreditDebitNote{makeTestNote(), makeTestNote()}
 	mockRepo.On("List", mock.Anything, int32(10), int32(0)).Return(notes, nil).Once()
@@ -143,12 +416,13 @@ func TestCreditDebitNoteService_List(t *testing.T) {
 func TestCreditDebitNoteService_Update(t *testing.T) {
 	mockRepo := new(MockCreditDebitNoteRepo)
 	mockPub := new(MockPublisher)
-	svc := services.NewCreditDebitNoteService(mockRepo, mockPub)
+	svc := services.NewCreditDebitNoteService(mockRepo, nil, mockPub)
 
 	input := makeTestNote()
 	updated := input
 	updated.Reason = sql.NullString{String: "updated reason", Valid: true}
 
+	mockRepo.On("Get", mock.Anything, input.ID).Return(input, nil).Once()
 	mockRepo.On("Update", mock.Anything, input).Return(updated, nil).Once()
 	mockPub.On("PublishCreditDebitNoteUpdated", mock.Anything, &updated).Return(nil).Once()
 
@@ -160,19 +434,55 @@ func TestCreditDebitNoteService_Update(t *testing.T) {
 	mockPub.AssertExpectations(t)
 }
 
+func TestCreditDebitNoteService_UpdateApplied(t *testing.T) {
+	mockRepo := new(MockCreditDebitNoteRepo)
+	mockPub := new(MockPublisher)
+	svc := services.NewCreditDebitNoteService(mockRepo, nil, mockPub)
+
+	stored := makeTestNote()
+	stored.AppliedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	mockRepo.On("Get", mock.Anything, stored.ID).Return(stored, nil)
+
+	changed := stored
+	changed.Amount = "600.00"
+	_, err := svc.Update(context.Background(), changed)
+	require.ErrorIs(t, err, services.ErrConflict)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+
+	// Its reason can still be corrected.
+	changed = stored
+	changed.Amount = "500"
+	changed.Reason = sql.NullString{String: "pricing error", Valid: true}
+	want := changed
+	want.Amount = stored.Amount
+	mockRepo.On("Update", mock.Anything, want).Return(want, nil).Once()
+	mockPub.On("PublishCreditDebitNoteUpdated", mock.Anything, &want).Return(nil).Once()
+	_, err = svc.Update(context.Background(), changed)
+	require.NoError(t, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
 func TestCreditDebitNoteService_Delete(t *testing.T) {
 	mockRepo := new(MockCreditDebitNoteRepo)
 	mockPub := new(MockPublisher)
-	svc := services.NewCreditDebitNoteService(mockRepo, mockPub)
+	svc := services.NewCreditDebitNoteService(mockRepo, nil, mockPub)
 
-	id := uuid.New()
+	note := makeTestNote()
+	id := note.ID
 
+	mockRepo.On("Get", mock.Anything, id).Return(note, nil).Once()
 	mockRepo.On("Delete", mock.Anything, id).Return(nil).Once()
 	mockPub.On("PublishCreditDebitNoteDeleted", mock.Anything, id.String()).Return(nil).Once()
 
 	err := svc.Delete(context.Background(), id)
 	assert.NoError(t, err)
 
+	// Applied notes are kept.
+	note.AppliedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	mockRepo.On("Get", mock.Anything, id).Return(note, nil).Once()
+	require.ErrorIs(t, svc.Delete(context.Background(), id), services.ErrConflict)
+
 	mockRepo.AssertExpectations(t)
 	mockPub.AssertExpectations(t)
 }
@@ -0,0 +1,162 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+type MockCreditRepo struct{ mock.Mock }
+
+func (m *MockCreditRepo) GetPolicy(ctx context.Context, orgID string) (db.CreditPolicy, error) {
+	args := m.Called(ctx, orgID)
+	return args.Get(0).(db.CreditPolicy), args.Error(1)
+}
+func (m *MockCreditRepo) SetPolicy(ctx context.Context, p db.CreditPolicy) (db.CreditPolicy, error) {
+	args := m.Called(ctx, p)
+	return p, args.Error(0)
+}
+func (m *MockCreditRepo) PartyExposure(ctx context.Context, partyID uuid.UUID) (db.GetPartyCreditExposureRow, error) {
+	args := m.Called(ctx, partyID)
+	return args.Get(0).(db.GetPartyCreditExposureRow), args.Error(1)
+}
+func (m *MockCreditRepo) ApproveInvoice(ctx context.Context, invoiceID uuid.UUID, by string) (bool, error) {
+	args := m.Called(ctx, invoiceID, by)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestCreditService_Exposure(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockCreditRepo)
+	parties := new(MockPartyRepo)
+	svc := services.NewCreditService(repo, parties, nil)
+
+	limited, unlimited := uuid.New(), uuid.New()
+	parties.On("Get", ctx, limited).Return(&ports.Party{Party: db.Party{ID: limited, OrganizationID: "org-1", Name: "Acme",
+		DefaultCurrency: sql.NullString{String: "INR", Valid: true},
+		CreditLimit:     sql.NullString{String: "1000", Valid: true}}}, nil)
+	parties.On("Get", ctx, unlimited).Return(&ports.Party{Party: db.Party{ID: unlimited, OrganizationID: "org-1", Name: "Globex"}}, nil)
+	repo.On("GetPolicy", ctx, "org-1").Return(db.CreditPolicy{OrganizationID: "org-1", ThresholdPercent: "90", Action: services.CreditActionBlock}, nil)
+	sums := db.GetPartyCreditExposureRow{OpenDues: "500.00", DraftInvoices: "450.50", UnappliedCredits: "50"}
+	repo.On("PartyExposure", ctx, limited).Return(sums, nil)
+	repo.On("PartyExposure", ctx, unlimited).Return(sums, nil)
+
+	e, err := svc.GetExposure(ctx, limited)
+	require.NoError(t, err)
+	require.Equal(t, "900.50", e.Exposure)
+	require.Equal(t, "1000.00", e.CreditLimit)
+	require.Equal(t, "900.00", e.Threshold)
+	require.Equal(t, "INR", e.CurrencyCode)
+	require.True(t, e.Breached)
+
+	// Without a credit limit there is nothing to breach.
+	e, err = svc.GetExposure(ctx, unlimited)
+	require.NoError(t, err)
+	require.Equal(t, "900.50", e.Exposure)
+	require.Empty(t, e.Threshold)
+	require.False(t, e.Breached)
+
+	// Organizations without a policy block at the full limit.
+	repo.On("GetPolicy", ctx, "org-2").Return(db.CreditPolicy{}, sql.ErrNoRows)
+	p, err := svc.GetPolicy(ctx, "org-2")
+	require.NoError(t, err)
+	require.Equal(t, services.CreditActionBlock, p.Action)
+	require.Equal(t, "100.00", p.ThresholdPercent)
+
+	_, err = svc.SetPolicy(ctx, db.CreditPolicy{OrganizationID: "org-1", ThresholdPercent: "0"})
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+	_, err = svc.SetPolicy(ctx, db.CreditPolicy{OrganizationID: "org-1", ThresholdPercent: "80", Action: "WARN"})
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+}
+
+func TestCreditService_CheckIssue(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockCreditRepo)
+	parties := new(MockPartyRepo)
+	pub := new(MockmPublisher)
+	svc := services.NewCreditService(repo, parties, pub)
+
+	partyID, heldID := uuid.New(), uuid.New()
+	parties.On("Get", ctx, partyID).Return(&ports.Party{Party: db.Party{ID: partyID, OrganizationID: "org-1", Name: "Acme",
+		CreditLimit: sql.NullString{String: "1000.00", Valid: true}}}, nil)
+	parties.On("Get", ctx, heldID).Return(&ports.Party{Party: db.Party{ID: heldID, OrganizationID: "org-1", Name: "Initech",
+		CreditHold: true, CreditHoldReason: sql.NullString{String: "cheque bounced", Valid: true}}}, nil)
+	repo.On("PartyExposure", ctx, partyID).Return(db.GetPartyCreditExposureRow{OpenDues: "800.00", DraftInvoices: "300.00", UnappliedCredits: "0"}, nil)
+	policy := repo.On("GetPolicy", ctx, "org-1").Return(db.CreditPolicy{OrganizationID: "org-1", ThresholdPercent: "100", Action: services.CreditActionBlock}, nil)
+	pub.On("PublishCreditLimitBreached", ctx, mock.Anything).Return(nil)
+
+	inv := db.Invoice{ID: uuid.New(), OrganizationID: "org-1", Type: services.InvoiceTypeSales, Status: services.InvoiceDraft,
+		PartyID: uuid.NullUUID{UUID: partyID, Valid: true}}
+
+	// Purchase invoices and invoices without a party are not checked.
+	require.NoError(t, svc.CheckIssue(ctx, db.Invoice{Type: services.InvoiceTypePurchase, PartyID: inv.PartyID}))
+	require.NoError(t, svc.CheckIssue(ctx, db.Invoice{Type: services.InvoiceTypeSales}))
+
+	held := inv
+	held.PartyID = uuid.NullUUID{UUID: heldID, Valid: true}
+	require.ErrorIs(t, svc.CheckIssue(ctx, held), services.ErrCreditLimit)
+	repo.AssertNotCalled(t, "PartyExposure", ctx, heldID)
+
+	// 1100.00 is past the 1000.00 limit.
+	require.ErrorIs(t, svc.CheckIssue(ctx, inv), services.ErrCreditLimit)
+	ev := pub.Calls[len(pub.Calls)-1].Arguments.Get(1).(*ports.CreditLimitBreachedEvent)
+	require.Equal(t, "1100.00", ev.Exposure)
+	require.Equal(t, "1000.00", ev.Threshold)
+	require.Equal(t, services.CreditActionBlock, ev.Action)
+	require.False(t, ev.Approved)
+
+	// Under an APPROVAL policy an approved draft goes through.
+	policy.Return(db.CreditPolicy{OrganizationID: "org-1", ThresholdPercent: "100", Action: services.CreditActionApproval}, nil)
+	require.ErrorIs(t, svc.CheckIssue(ctx, inv), services.ErrCreditLimit)
+	approved := inv
+	approved.CreditApprovedBy = sql.NullString{String: "controller", Valid: true}
+	approved.CreditApprovedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	require.NoError(t, svc.CheckIssue(ctx, approved))
+	ev = pub.Calls[len(pub.Calls)-1].Arguments.Get(1).(*ports.CreditLimitBreachedEvent)
+	require.True(t, ev.Approved)
+	require.Equal(t, "controller", ev.ApprovedBy)
+	pub.AssertNumberOfCalls(t, "PublishCreditLimitBreached", 3)
+
+	// The invoice service refuses the issue before numbering or posting it.
+	invoices := new(MockInvoiceRepo)
+	inv.Items = []db.InvoiceItem{{Name: "Widget", Quantity: 1, UnitPrice: "300.00"}}
+	invoices.On("GetInvoice", ctx, inv.ID).Return(inv, nil)
+	invoiceSvc := services.NewInvoiceService(invoices, nil, nil, nil, nil, nil, pub, svc, nil)
+	_, err := invoiceSvc.IssueInvoice(ctx, inv.ID, "clerk")
+	require.ErrorIs(t, err, services.ErrCreditLimit)
+	invoices.AssertNotCalled(t, "IssueInvoice", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// Appending a line to an approved draft withdraws the approval: the repo
+	// stores the grown draft without it, and the issue is refused again.
+	approved.ID = uuid.New()
+	approved.Items = inv.Items
+	approved.Revision = sql.NullInt32{Int32: 1, Valid: true}
+	invoices.On("GetInvoice", ctx, approved.ID).Return(approved, nil).Once()
+	var stored db.Invoice
+	invoices.On("AppendInvoiceLines", ctx, mock.Anything).Return(func(_ context.Context, grown db.Invoice) db.Invoice {
+		grown.CreditApprovedBy, grown.CreditApprovedAt = sql.NullString{}, sql.NullTime{}
+		grown.Revision.Int32++
+		stored = grown
+		return grown
+	}, nil)
+	pub.On("PublishInvoiceItemCreated", ctx, mock.Anything).Return(nil)
+	_, err = invoiceSvc.CreateInvoiceItem(ctx, db.InvoiceItem{InvoiceID: approved.ID, Name: "Gadget", Quantity: 1, UnitPrice: "50.00"})
+	require.NoError(t, err)
+	require.Len(t, stored.Items, 2)
+	invoices.On("GetInvoice", ctx, approved.ID).Return(stored, nil)
+	_, err = invoiceSvc.IssueInvoice(ctx, approved.ID, "clerk")
+	require.ErrorIs(t, err, services.ErrCreditLimit)
+	invoices.AssertNotCalled(t, "IssueInvoice", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	repo.On("ApproveInvoice", ctx, inv.ID, "controller").Return(false, nil)
+	require.ErrorIs(t, svc.ApproveInvoice(ctx, inv.ID, "controller"), services.ErrConflict)
+}
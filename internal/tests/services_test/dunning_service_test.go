@@ -0,0 +1,238 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+type MockDunningRepo struct{ mock.Mock }
+
+func (m *MockDunningRepo) ReplaceStages(ctx context.Context, orgID string, stages []db.DunningStage, by string) ([]db.DunningStage, error) {
+	args := m.Called(ctx, orgID, stages, by)
+	return args.Get(0).([]db.DunningStage), args.Error(1)
+}
+func (m *MockDunningRepo) ListStages(ctx context.Context, orgID string) ([]db.DunningStage, error) {
+	args := m.Called(ctx, orgID)
+	return args.Get(0).([]db.DunningStage), args.Error(1)
+}
+func (m *MockDunningRepo) PlaceInvoiceHold(ctx context.Context, hold db.DunningHold) (db.DunningHold, error) {
+	args := m.Called(ctx, hold)
+	return args.Get(0).(db.DunningHold), args.Error(1)
+}
+func (m *MockDunningRepo) PlacePartyHold(ctx context.Context, hold db.DunningHold) (db.DunningHold, error) {
+	args := m.Called(ctx, hold)
+	return args.Get(0).(db.DunningHold), args.Error(1)
+}
+func (m *MockDunningRepo) ReleaseInvoiceHold(ctx context.Context, invoiceID uuid.UUID) error {
+	return m.Called(ctx, invoiceID).Error(0)
+}
+func (m *MockDunningRepo) ReleasePartyHold(ctx context.Context, orgID string, partyID uuid.UUID) error {
+	return m.Called(ctx, orgID, partyID).Error(0)
+}
+func (m *MockDunningRepo) ListHolds(ctx context.Context, orgID string) ([]db.DunningHold, error) {
+	args := m.Called(ctx, orgID)
+	return args.Get(0).([]db.DunningHold), args.Error(1)
+}
+func (m *MockDunningRepo) PastDueInvoices(ctx context.Context, asOf time.Time) ([]uuid.UUID, error) {
+	args := m.Called(ctx, asOf)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+func (m *MockDunningRepo) MarkPaymentDuesOverdue(ctx context.Context, asOf time.Time, by string) ([]db.PaymentDue, error) {
+	args := m.Called(ctx, asOf, by)
+	return args.Get(0).([]db.PaymentDue), args.Error(1)
+}
+func (m *MockDunningRepo) ReminderCandidates(ctx context.Context, asOf time.Time) ([]db.ListDunningReminderCandidatesRow, error) {
+	args := m.Called(ctx, asOf)
+	return args.Get(0).([]db.ListDunningReminderCandidatesRow), args.Error(1)
+}
+func (m *MockDunningRepo) RecordReminder(ctx context.Context, rem db.DunningReminder) (*db.DunningReminder, error) {
+	args := m.Called(ctx, rem)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*db.DunningReminder), args.Error(1)
+}
+
+type MockDunningAudit struct{ mock.Mock }
+
+func (m *MockDunningAudit) Record(ctx context.Context, event *db.AuditEvent) (*db.AuditEvent, error) {
+	args := m.Called(ctx, event)
+	return event, args.Error(0)
+}
+func (m *MockDunningAudit) List(ctx context.Context, page db.Pagination) ([]db.AuditEvent, error) {
+	return nil, nil
+}
+func (m *MockDunningAudit) GetByID(ctx context.Context, id string) (*db.AuditEvent, error) {
+	return nil, nil
+}
+func (m *MockDunningAudit) Filter(ctx context.Context, filter db.FilterParams, page db.Pagination) ([]db.AuditEvent, error) {
+	return nil, nil
+}
+
+// auditActions lists the actions recorded with audit, in order.
+func auditActions(audit *MockDunningAudit) []string {
+	var actions []string
+	for _, c := range audit.Calls {
+		actions = append(actions, c.Arguments.Get(1).(*db.AuditEvent).Action)
+	}
+	return actions
+}
+
+func TestDunningService_Schedule(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockDunningRepo)
+	audit := new(MockDunningAudit)
+	audit.On("Record", ctx, mock.Anything).Return(nil)
+	svc := services.NewDunningService(repo, nil, audit, nil)
+
+	// Stages are stored in order of their days from due.
+	want := []db.DunningStage{
+		{DaysFromDue: -3, Name: "Friendly nudge"},
+		{DaysFromDue: 7, EscalationLevel: 1, Name: "First reminder"},
+		{DaysFromDue: 30, EscalationLevel: 2, Name: "Final notice"},
+	}
+	repo.On("ReplaceStages", ctx, "org-1", want, "clerk").Return(want, nil)
+	got, err := svc.SetSchedule(ctx, "org-1", []db.DunningStage{want[2], want[0], {DaysFromDue: 7, EscalationLevel: 1, Name: " First reminder "}}, "clerk")
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+	require.Equal(t, []string{"dunning.schedule.set"}, auditActions(audit))
+
+	for name, stages := range map[string][]db.DunningStage{
+		"same day":       {{DaysFromDue: 7}, {DaysFromDue: 7, EscalationLevel: 1}},
+		"de-escalates":   {{DaysFromDue: 7, EscalationLevel: 2}, {DaysFromDue: 30, EscalationLevel: 1}},
+		"negative level": {{DaysFromDue: 7, EscalationLevel: -1}},
+		"too far":        {{DaysFromDue: 4000}},
+	} {
+		_, err := svc.SetSchedule(ctx, "org-1", stages, "clerk")
+		require.ErrorIs(t, err, services.ErrInvalidInput, name)
+	}
+	_, err = svc.SetSchedule(ctx, "", want, "clerk")
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+	repo.AssertNumberOfCalls(t, "ReplaceStages", 1)
+}
+
+func TestDunningService_Holds(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockDunningRepo)
+	invoices := new(MockInvoiceRepo)
+	audit := new(MockDunningAudit)
+	audit.On("Record", ctx, mock.Anything).Return(nil)
+	parties := new(MockPartyRepo)
+	svc := services.NewDunningService(repo, services.NewInvoiceService(invoices, nil, nil, nil, nil, nil, nil, nil, parties), audit, nil)
+
+	invoiceID := uuid.New()
+	invoices.On("GetInvoice", ctx, invoiceID).Return(db.Invoice{ID: invoiceID, OrganizationID: "org-1"}, nil)
+	hold := db.DunningHold{
+		OrganizationID: "org-1",
+		InvoiceID:      uuid.NullUUID{UUID: invoiceID, Valid: true},
+		Reason:         "Disputed",
+		CreatedBy:      sql.NullString{String: "clerk", Valid: true},
+	}
+	repo.On("PlaceInvoiceHold", ctx, hold).Return(hold, nil)
+	_, err := svc.HoldInvoice(ctx, invoiceID, " Disputed ", "clerk")
+	require.NoError(t, err)
+
+	// Holds need a reason, and party holds a party.
+	_, err = svc.HoldInvoice(ctx, invoiceID, " ", "clerk")
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+	_, err = svc.HoldParty(ctx, "org-1", uuid.Nil, "Disputed", "clerk")
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+
+	repo.On("ReleaseInvoiceHold", ctx, invoiceID).Return(nil).Once()
+	require.NoError(t, svc.ReleaseInvoice(ctx, invoiceID, "clerk"))
+	repo.On("ReleaseInvoiceHold", ctx, invoiceID).Return(sql.ErrNoRows)
+	require.ErrorIs(t, svc.ReleaseInvoice(ctx, invoiceID, "clerk"), services.ErrNotFound)
+	// A party is held in its own organization only.
+	partyID, foreignID := uuid.New(), uuid.New()
+	parties.On("Get", ctx, partyID).Return(&ports.Party{Party: db.Party{ID: partyID, OrganizationID: "org-1", Kind: services.PartyCustomer}}, nil)
+	parties.On("Get", ctx, foreignID).Return(&ports.Party{Party: db.Party{ID: foreignID, OrganizationID: "org-2", Kind: services.PartyCustomer}}, nil)
+	partyHold := db.DunningHold{
+		OrganizationID: "org-1",
+		PartyID:        uuid.NullUUID{UUID: partyID, Valid: true},
+		Reason:         "Disputed",
+		CreatedBy:      sql.NullString{String: "clerk", Valid: true},
+	}
+	repo.On("PlacePartyHold", ctx, partyHold).Return(partyHold, nil)
+	_, err = svc.HoldParty(ctx, "org-1", partyID, "Disputed", "clerk")
+	require.NoError(t, err)
+	_, err = svc.HoldParty(ctx, "org-1", foreignID, "Disputed", "clerk")
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+	repo.AssertNumberOfCalls(t, "PlacePartyHold", 1)
+
+	repo.On("ReleasePartyHold", ctx, "org-1", partyID).Return(sql.ErrNoRows)
+	require.ErrorIs(t, svc.ReleaseParty(ctx, "org-1", partyID, "clerk"), services.ErrNotFound)
+
+	require.Equal(t, []string{"dunning.hold.placed", "dunning.hold.released", "dunning.hold.placed"}, auditActions(audit))
+}
+
+func TestDunningService_Process(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockDunningRepo)
+	invoices := new(MockInvoiceRepo)
+	audit := new(MockDunningAudit)
+	pub := new(MockmPublisher)
+	svc := services.NewDunningService(repo, services.NewInvoiceService(invoices, nil, nil, nil, nil, nil, pub, nil, nil), audit, pub)
+
+	asOf := time.Date(2025, 5, 10, 6, 0, 0, 0, time.UTC)
+	due := time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)
+	dueID, overdueID, paidID, remindedID, raceID, partyID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	repo.On("MarkPaymentDuesOverdue", ctx, asOf, services.DunningUser).
+		Return([]db.PaymentDue{{ID: dueID, AmountDue: "500.00", CurrencyCode: "INR", DueDate: due}}, nil)
+
+	// An invoice paid after it was listed is skipped.
+	repo.On("PastDueInvoices", ctx, asOf).Return([]uuid.UUID{overdueID, paidID}, nil)
+	invoices.On("GetInvoice", ctx, overdueID).Return(db.Invoice{ID: overdueID, InvoiceNumber: "INV-1",
+		Status: services.InvoiceIssued, DueDate: sql.NullTime{Time: due, Valid: true}}, nil)
+	invoices.On("GetInvoice", ctx, paidID).Return(db.Invoice{ID: paidID, Status: services.InvoicePaid}, nil)
+	invoices.On("MarkInvoiceOverdue", ctx, overdueID, services.DunningUser).Return(db.Invoice{ID: overdueID,
+		InvoiceNumber: "INV-1", Status: services.InvoiceOverdue, DueDate: sql.NullTime{Time: due, Valid: true}}, nil)
+	pub.On("PublishInvoiceStatusChanged", ctx, mock.Anything).Return(nil)
+
+	// The reminder for raceID went out in a concurrent run.
+	candidate := db.ListDunningReminderCandidatesRow{
+		InvoiceID: remindedID, OrganizationID: "org-1", InvoiceNumber: "INV-2",
+		PartyID: uuid.NullUUID{UUID: partyID, Valid: true}, DueDate: sql.NullTime{Time: due, Valid: true},
+		GrandTotal: "118.00", CurrencyCode: "INR", Status: services.InvoiceOverdue,
+		DaysFromDue: 7, EscalationLevel: 1, StageName: "First reminder",
+	}
+	raced := candidate
+	raced.InvoiceID = raceID
+	repo.On("ReminderCandidates", ctx, asOf).Return([]db.ListDunningReminderCandidatesRow{candidate, raced}, nil)
+	repo.On("RecordReminder", ctx, db.DunningReminder{InvoiceID: remindedID, DaysFromDue: 7, EscalationLevel: 1}).
+		Return(&db.DunningReminder{InvoiceID: remindedID, DaysFromDue: 7, EscalationLevel: 1, SentAt: asOf}, nil)
+	repo.On("RecordReminder", ctx, db.DunningReminder{InvoiceID: raceID, DaysFromDue: 7, EscalationLevel: 1}).Return(nil, nil)
+	reminder := &ports.DunningReminderEvent{
+		InvoiceID: remindedID.String(), InvoiceNumber: "INV-2", OrganizationID: "org-1", PartyID: partyID.String(),
+		Status: services.InvoiceOverdue, DueDate: due, Amount: "118.00", CurrencyCode: "INR",
+		Stage: "First reminder", DaysFromDue: 7, EscalationLevel: 1, SentAt: asOf,
+	}
+	pub.On("PublishDunningReminder", ctx, reminder).Return(errors.New("broker down"))
+	audit.On("Record", ctx, mock.Anything).Return(nil)
+
+	run, err := svc.Process(ctx, asOf)
+	var runErr *services.DunningRunError
+	require.ErrorAs(t, err, &runErr)
+	require.Len(t, runErr.Failures, 1)
+	require.ErrorContains(t, runErr, "broker down")
+
+	require.Len(t, run.OverdueDues, 1)
+	require.Len(t, run.OverdueInvoices, 1)
+	require.Equal(t, overdueID, run.OverdueInvoices[0].ID)
+	require.Equal(t, []ports.DunningReminderEvent{*reminder}, run.Reminders)
+	require.Equal(t, []string{"payment.due.overdue", "invoice.overdue", "dunning.reminder"}, auditActions(audit))
+	require.Equal(t, "500.00 INR due 2025-04-30", audit.Calls[0].Arguments.Get(1).(*db.AuditEvent).Details.String)
+	invoices.AssertNotCalled(t, "MarkInvoiceOverdue", ctx, paidID, mock.Anything)
+	pub.AssertNumberOfCalls(t, "PublishDunningReminder", 1)
+}
@@ -0,0 +1,145 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+func TestEInvoiceService_ExportDocument(t *testing.T) {
+	ctx := context.Background()
+	invoices := new(MockInvoiceRepo)
+	parties := new(MockPartyRepo)
+	svc := services.NewEInvoiceService(services.NewInvoiceService(invoices, nil, nil, nil, nil, nil, nil, nil, nil), services.NewPartyService(parties))
+
+	buyerID := uuid.New()
+	inv := db.Invoice{
+		ID:             uuid.New(),
+		InvoiceNumber:  "INV-1",
+		Type:           services.InvoiceTypeSales,
+		Status:         services.InvoiceIssued,
+		OrganizationID: "org-1",
+		CurrencyCode:   "INR",
+		GrandTotal:     "1180.00",
+		PartyID:        uuid.NullUUID{UUID: buyerID, Valid: true},
+		Gst:            &db.GstRegime{Gstin: "27AAPFU0939F1ZV"},
+	}
+	invoices.On("GetInvoice", ctx, inv.ID).Return(inv, nil)
+	invoices.On("GetTemplate", ctx, "org-1").Return(db.InvoiceTemplate{
+		OrganizationID: "org-1",
+		CompanyName:    "Acme Traders",
+		Address:        sql.NullString{String: "Plot 4\nMIDC\nPune 411001", Valid: true},
+		Terms:          sql.NullString{String: "Net 30", Valid: true},
+		CountryCode:    "IN",
+		PeppolEndpoint: sql.NullString{String: "0088:5790000435975", Valid: true},
+	}, nil)
+	buyer := &ports.Party{Party: db.Party{ID: buyerID, Name: "Globex"}}
+	parties.On("Get", ctx, buyerID).Return(buyer, nil)
+
+	e, err := svc.ExportDocument(ctx, inv.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Acme Traders", e.Seller.Name)
+	require.Equal(t, "0088:5790000435975", e.Seller.PeppolEndpoint.String)
+	require.Equal(t, "Plot 4", e.Seller.Addresses[0].Line1)
+	require.Equal(t, "MIDC, Pune 411001", e.Seller.Addresses[0].Line2.String)
+	require.Equal(t, "IN", e.Seller.Addresses[0].CountryCode)
+	require.Equal(t, "27AAPFU0939F1ZV", e.Seller.TaxRegistrations[0].Number)
+	require.Equal(t, "Globex", e.Buyer.Name)
+	require.Equal(t, "Net 30", e.PaymentTerms)
+
+	// Drafts are not e-invoiced, nor are purchases.
+	draft := inv
+	draft.ID, draft.Status = uuid.New(), services.InvoiceDraft
+	invoices.On("GetInvoice", ctx, draft.ID).Return(draft, nil)
+	_, err = svc.ExportDocument(ctx, draft.ID)
+	require.ErrorIs(t, err, services.ErrConflict)
+	purchase := inv
+	purchase.ID, purchase.Type = uuid.New(), services.InvoiceTypePurchase
+	invoices.On("GetInvoice", ctx, purchase.ID).Return(purchase, nil)
+	_, err = svc.ExportDocument(ctx, purchase.ID)
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+}
+
+func TestEInvoiceService_Import(t *testing.T) {
+	ctx := context.Background()
+	invoices := new(MockInvoiceRepo)
+	parties := new(MockPartyRepo)
+	events := new(MockFinanceEventRepo)
+	pub := new(MockmPublisher)
+	pub.On("PublishFinanceInvoiceCreated", ctx, mock.Anything).Return(nil)
+	events.On("InsertInvoiceCreated", ctx, mock.Anything).Return(nil)
+	invoiceSvc := services.NewInvoiceService(invoices, services.NewFinanceEventService(events, pub), nil, nil, nil, nil, pub, nil, nil)
+	svc := services.NewEInvoiceService(invoiceSvc, services.NewPartyService(parties))
+
+	invoices.On("GetNumberSeries", ctx, "org-1", services.InvoiceTypePurchase).Return(db.InvoiceNumberSeries{}, sql.ErrNoRows)
+	create := invoices.On("CreateInvoice", ctx, mock.Anything)
+	create.Run(func(args mock.Arguments) { create.Return(args.Get(1), nil) })
+
+	inbound := ports.EInvoice{
+		Invoice: db.Invoice{
+			InvoiceNumber: "V-77",
+			InvoiceDate:   time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+			CurrencyCode:  "INR",
+			GrandTotal:    "1180.00",
+			Items:         []db.InvoiceItem{{Name: "Paper", Quantity: 10, UnitPrice: "100.00"}},
+			Taxes:         []db.InvoiceTax{{Name: "VAT", Rate: "18"}},
+		},
+		Seller: ports.Party{
+			Party:            db.Party{Name: "Paper Mills"},
+			Addresses:        []db.PartyAddress{{Kind: services.AddressBilling, Line1: "2 Mill Road", CountryCode: "IN", IsDefault: true}},
+			TaxRegistrations: []db.PartyTaxRegistration{{TaxType: services.TaxRegistrationGSTIN, Number: "27AAPFU0939F1ZV"}},
+		},
+	}
+
+	// A vendor registered under the seller's GSTIN supplies the invoice.
+	vendor := &ports.Party{
+		Party:            db.Party{ID: uuid.New(), OrganizationID: "org-1", Kind: services.PartyVendor, Name: "Paper Mills Pvt Ltd"},
+		TaxRegistrations: []db.PartyTaxRegistration{{TaxType: services.TaxRegistrationGSTIN, Number: "27AAPFU0939F1ZV"}},
+	}
+	parties.On("List", ctx, ports.PartyFilter{OrganizationID: "org-1", Kind: services.PartyVendor, Query: "27AAPFU0939F1ZV"}, int32(50), int32(0)).
+		Return([]*ports.Party{vendor}, nil)
+
+	inv, err := svc.Import(ctx, "org-1", inbound, "clerk")
+	require.NoError(t, err)
+	require.Equal(t, services.InvoiceTypePurchase, inv.Type)
+	require.Equal(t, services.InvoiceDraft, inv.Status)
+	require.Equal(t, "org-1", inv.OrganizationID)
+	require.Equal(t, vendor.ID, inv.PartyID.UUID)
+	require.Equal(t, "180.00", inv.TaxTotal)
+	require.Equal(t, "clerk", inv.CreatedBy.String)
+	parties.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+
+	// An unknown seller becomes a new vendor.
+	parties.On("List", ctx, ports.PartyFilter{OrganizationID: "org-2", Kind: services.PartyVendor, Query: "27AAPFU0939F1ZV"}, int32(50), int32(0)).
+		Return([]*ports.Party{}, nil)
+	parties.On("List", ctx, ports.PartyFilter{OrganizationID: "org-2", Kind: services.PartyVendor, Query: "Paper Mills"}, int32(50), int32(0)).
+		Return([]*ports.Party{}, nil)
+	parties.On("Create", ctx, mock.Anything).Return(nil)
+	invoices.On("GetNumberSeries", ctx, "org-2", services.InvoiceTypePurchase).Return(db.InvoiceNumberSeries{}, sql.ErrNoRows)
+
+	_, err = svc.Import(ctx, "org-2", inbound, "clerk")
+	require.NoError(t, err)
+	created := parties.Calls[len(parties.Calls)-1].Arguments.Get(1).(*ports.Party)
+	require.Equal(t, "org-2", created.OrganizationID)
+	require.Equal(t, services.PartyVendor, created.Kind)
+	require.Equal(t, "Paper Mills", created.Name)
+	require.True(t, created.Active)
+
+	// Stated figures that do not add up are refused.
+	wrong := inbound
+	wrong.Invoice.GrandTotal = "1200.00"
+	_, err = svc.Import(ctx, "org-1", wrong, "clerk")
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+
+	_, err = svc.Import(ctx, "", inbound, "clerk")
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+}
@@ -111,7 +111,7 @@ func TestExpenseService_CRUD(t *testing.T) {
 	ctx := context.Background()
 	expRepo := new(MockExpenseRepo)
 	pub := new(MockPublisher)
-	svc := services.NewExpenseService(expRepo, pub)
+	svc := services.NewExpenseService(expRepo, nil, pub)
 
 	exp := db.Expense{ID: uuid.New(), Category: "Travel", Amount: "1000", ExpenseDate: time.Now()}
 
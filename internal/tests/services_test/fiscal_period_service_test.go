@@ -0,0 +1,271 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+// ---------------- Mock Repository ----------------
+type MockFiscalPeriodRepo struct{ mock.Mock }
+
+func (m *MockFiscalPeriodRepo) UpsertCalendar(ctx context.Context, c db.FiscalCalendar) (db.FiscalCalendar, error) {
+	args := m.Called(ctx, c)
+	return args.Get(0).(db.FiscalCalendar), args.Error(1)
+}
+func (m *MockFiscalPeriodRepo) GetCalendar(ctx context.Context, orgID string) (db.FiscalCalendar, error) {
+	args := m.Called(ctx, orgID)
+	return args.Get(0).(db.FiscalCalendar), args.Error(1)
+}
+func (m *MockFiscalPeriodRepo) CreatePeriods(ctx context.Context, periods []db.FiscalPeriod) ([]db.FiscalPeriod, error) {
+	args := m.Called(ctx, periods)
+	return args.Get(0).([]db.FiscalPeriod), args.Error(1)
+}
+func (m *MockFiscalPeriodRepo) GetPeriod(ctx context.Context, id uuid.UUID) (db.FiscalPeriod, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(db.FiscalPeriod), args.Error(1)
+}
+func (m *MockFiscalPeriodRepo) GetPeriodForDate(ctx context.Context, orgID string, date time.Time) (db.FiscalPeriod, error) {
+	args := m.Called(ctx, orgID, date)
+	return args.Get(0).(db.FiscalPeriod), args.Error(1)
+}
+func (m *MockFiscalPeriodRepo) ListPeriods(ctx context.Context, orgID string, fiscalYear int32) ([]db.FiscalPeriod, error) {
+	args := m.Called(ctx, orgID, fiscalYear)
+	return args.Get(0).([]db.FiscalPeriod), args.Error(1)
+}
+func (m *MockFiscalPeriodRepo) UpdatePeriodStatus(ctx context.Context, id uuid.UUID, status, by string) (db.FiscalPeriod, error) {
+	args := m.Called(ctx, id, status, by)
+	return args.Get(0).(db.FiscalPeriod), args.Error(1)
+}
+
+// CloseYear hands the closing callback the periods and balances set up as
+// read under the lock and, when it succeeds, returns the periods closed along
+// with the journal it built.
+func (m *MockFiscalPeriodRepo) CloseYear(ctx context.Context, orgID string, fiscalYear int32, by string, closing func(locked []db.FiscalPeriod, balances []db.SumIncomeStatementBalancesRow) (*db.JournalEntry, error)) (*db.JournalEntry, []db.FiscalPeriod, error) {
+	args := m.Called(ctx, orgID, fiscalYear, by)
+	if err := args.Error(2); err != nil {
+		return nil, nil, err
+	}
+	locked := args.Get(0).([]db.FiscalPeriod)
+	balances, _ := args.Get(1).([]db.SumIncomeStatementBalancesRow)
+	journal, err := closing(locked, balances)
+	if err != nil {
+		return nil, nil, err
+	}
+	if journal != nil {
+		journal.ID = uuid.New()
+	}
+	closed := make([]db.FiscalPeriod, len(locked))
+	for i, p := range locked {
+		p.Status = services.PeriodClosed
+		p.YearClosedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		closed[i] = p
+	}
+	return journal, closed, nil
+}
+
+// ---------------- Tests ----------------
+func TestFiscalPeriodService_CreateFiscalYear(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockFiscalPeriodRepo)
+	svc := services.NewFiscalPeriodService(repo, nil)
+
+	repo.On("GetCalendar", ctx, "org-in").Return(db.FiscalCalendar{OrganizationID: "org-in", YearStartMonth: 4}, nil)
+	repo.On("CreatePeriods", ctx, mock.Anything).Return([]db.FiscalPeriod{}, nil)
+
+	_, err := svc.CreateFiscalYear(ctx, "org-in", 2025)
+	assert.NoError(t, err)
+
+	periods := repo.Calls[1].Arguments.Get(1).([]db.FiscalPeriod)
+	assert.Len(t, periods, 12)
+	assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), periods[0].StartDate)
+	assert.Equal(t, time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC), periods[0].EndDate)
+	assert.Equal(t, time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC), periods[10].EndDate)
+	assert.Equal(t, time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), periods[11].EndDate)
+	assert.Equal(t, int16(12), periods[11].PeriodNumber)
+
+	repo.On("GetCalendar", ctx, "org-none").Return(db.FiscalCalendar{}, sql.ErrNoRows)
+	_, err = svc.CreateFiscalYear(ctx, "org-none", 2025)
+	assert.ErrorIs(t, err, services.ErrNotFound)
+}
+
+func TestPeriodGuard_BlocksClosedPeriods(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockFiscalPeriodRepo)
+	guard := services.NewPeriodGuard(repo)
+
+	open := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	soft := time.Date(2025, 5, 10, 0, 0, 0, 0, time.UTC)
+	closed := time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)
+	outside := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo.On("GetPeriodForDate", ctx, "org", open).Return(db.FiscalPeriod{Status: services.PeriodOpen}, nil)
+	repo.On("GetPeriodForDate", ctx, "org", soft).Return(db.FiscalPeriod{Status: services.PeriodSoftClosed}, nil)
+	repo.On("GetPeriodForDate", ctx, "org", closed).Return(db.FiscalPeriod{Status: services.PeriodClosed}, nil)
+	repo.On("GetPeriodForDate", ctx, "org", outside).Return(db.FiscalPeriod{}, sql.ErrNoRows)
+
+	assert.NoError(t, guard.EnsureOpen(ctx, "org", open, ""))
+	assert.NoError(t, guard.EnsureOpen(ctx, "org", outside, ""))
+	assert.NoError(t, guard.EnsureOpen(ctx, "", closed, ""))
+	assert.ErrorIs(t, guard.EnsureOpen(ctx, "org", soft, ""), services.ErrPeriodClosed)
+	assert.NoError(t, guard.EnsureOpen(ctx, "org", soft, services.SourceAdjustment))
+	assert.ErrorIs(t, guard.EnsureOpen(ctx, "org", closed, services.SourceAdjustment), services.ErrPeriodClosed)
+	// Only the year-end close is dated into a hard-closed period, and only
+	// until its year is closed.
+	assert.NoError(t, guard.EnsureOpen(ctx, "org", closed, services.SourceYearEndClose))
+	yearClosed := time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)
+	repo.On("GetPeriodForDate", ctx, "org", yearClosed).Return(db.FiscalPeriod{Status: services.PeriodClosed,
+		YearClosedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+	assert.ErrorIs(t, guard.EnsureOpen(ctx, "org", yearClosed, services.SourceYearEndClose), services.ErrPeriodClosed)
+
+	// Journals dated into a closed period never reach the repository.
+	cashID, revID := uuid.New(), uuid.New()
+	accRepo := new(MockAccountRepo)
+	accRepo.On("Get", ctx, cashID).Return(&db.Account{ID: cashID, Status: "ACTIVE", AllowManualJournal: true}, nil)
+	accRepo.On("Get", ctx, revID).Return(&db.Account{ID: revID, Status: "ACTIVE", AllowManualJournal: true}, nil)
+	jRepo := new(MockJournalRepo)
+	jService := services.NewJournalService(jRepo, accRepo, guard, nil, nil)
+
+	_, err := jService.Create(ctx, &db.JournalEntry{
+		JournalDate:    closed,
+		OrganizationID: sql.NullString{String: "org", Valid: true},
+		Lines: []db.JournalLine{
+			{AccountID: cashID, Side: "DEBIT", Amount: "10.00"},
+			{AccountID: revID, Side: "CREDIT", Amount: "10.00"},
+		},
+	})
+	assert.ErrorIs(t, err, services.ErrPeriodClosed)
+	jRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestFiscalPeriodService_CloseFiscalYear(t *testing.T) {
+	ctx := context.Background()
+	retainedID, revID, expID := uuid.New(), uuid.New(), uuid.New()
+
+	repo := new(MockFiscalPeriodRepo)
+	accRepo := new(MockAccountRepo)
+	for _, id := range []uuid.UUID{retainedID, revID, expID} {
+		accRepo.On("Get", ctx, id).Return(&db.Account{ID: id, Status: "ACTIVE"}, nil)
+	}
+	jRepo := new(MockJournalRepo)
+	jService := services.NewJournalService(jRepo, accRepo, services.NewPeriodGuard(repo), nil, nil)
+	svc := services.NewFiscalPeriodService(repo, jService)
+
+	repo.On("GetCalendar", ctx, "org").Return(db.FiscalCalendar{
+		OrganizationID:            "org",
+		YearStartMonth:            4,
+		RetainedEarningsAccountID: uuid.NullUUID{UUID: retainedID, Valid: true},
+	}, nil)
+
+	start := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	periods := make([]db.FiscalPeriod, 12)
+	for i := range periods {
+		from := start.AddDate(0, i, 0)
+		periods[i] = db.FiscalPeriod{
+			ID: uuid.New(), OrganizationID: "org", FiscalYear: 2025, PeriodNumber: int16(i + 1),
+			StartDate: from, EndDate: from.AddDate(0, 1, -1), Status: services.PeriodSoftClosed,
+		}
+	}
+	yearEnd := periods[11].EndDate
+	repo.On("ListPeriods", ctx, "org", int32(2025)).Return(periods, nil)
+	balances := []db.SumIncomeStatementBalancesRow{
+		{AccountID: revID, Type: "REVENUE", NetAmount: "-1000.00"},
+		{AccountID: expID, Type: "EXPENSE", NetAmount: "600.00"},
+	}
+	yearEndPeriod := repo.On("GetPeriodForDate", ctx, "org", yearEnd).Return(periods[11], nil)
+	repo.On("CloseYear", ctx, "org", int32(2025), "controller").Return(periods, balances, nil).Once()
+
+	closing, closed, err := svc.CloseFiscalYear(ctx, "org", 2025, "controller")
+	assert.NoError(t, err)
+	if assert.NotNil(t, closing) {
+		l := closing.Lines
+		assert.Equal(t, services.JournalPosted, closing.Status)
+		assert.Equal(t, services.SourceYearEndClose, closing.SourceType.String)
+		assert.True(t, closing.JournalDate.Equal(yearEnd))
+		if assert.Len(t, l, 3) {
+			assert.True(t, l[0].AccountID == revID && l[0].Side == "DEBIT" && l[0].Amount == "1000.00")
+			assert.True(t, l[1].AccountID == expID && l[1].Side == "CREDIT" && l[1].Amount == "600.00")
+			assert.True(t, l[2].AccountID == retainedID && l[2].Side == "CREDIT" && l[2].Amount == "400.00")
+		}
+	}
+	assert.Len(t, closed, 12)
+	// The closing journal is written by the repository's close, not on its own.
+	jRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "UpdatePeriodStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// A closing entry that fails its checks leaves every period as it was.
+	repo.On("CloseYear", ctx, "org", int32(2025), "controller").Return(periods, balances, nil).Once()
+	accRepo.ExpectedCalls = nil
+	accRepo.On("Get", ctx, revID).Return(&db.Account{ID: revID, Status: "INACTIVE"}, nil)
+	accRepo.On("Get", ctx, mock.Anything).Return(&db.Account{Status: "ACTIVE"}, nil)
+	_, _, err = svc.CloseFiscalYear(ctx, "org", 2025, "controller")
+	assert.ErrorIs(t, err, services.ErrInvalidInput)
+
+	// A period closed by a concurrent close is seen once the periods are
+	// locked.
+	locked := append([]db.FiscalPeriod(nil), periods...)
+	locked[3].Status = services.PeriodClosed
+	locked[3].YearClosedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	repo.On("CloseYear", ctx, "org", int32(2025), "controller").Return(locked, balances, nil).Once()
+	_, _, err = svc.CloseFiscalYear(ctx, "org", 2025, "controller")
+	assert.ErrorIs(t, err, services.ErrConflict)
+	accRepo.ExpectedCalls = nil
+	accRepo.On("Get", ctx, mock.Anything).Return(&db.Account{Status: "ACTIVE"}, nil)
+
+	// Periods hard-closed month by month close with the year, the closing
+	// entry dated into the last of them.
+	for i := range periods {
+		periods[i].Status = services.PeriodClosed
+	}
+	yearEndPeriod.Return(periods[11], nil)
+	repo.On("CloseYear", ctx, "org", int32(2025), "controller").Return(periods, balances, nil).Once()
+	closing, closed, err = svc.CloseFiscalYear(ctx, "org", 2025, "controller")
+	assert.NoError(t, err)
+	assert.NotNil(t, closing)
+	assert.Len(t, closed, 12)
+
+	// A closed year cannot be closed again.
+	for i := range periods {
+		periods[i].YearClosedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+	_, _, err = svc.CloseFiscalYear(ctx, "org", 2025, "controller")
+	assert.ErrorIs(t, err, services.ErrConflict)
+	repo.AssertNumberOfCalls(t, "CloseYear", 4)
+}
+
+func TestFiscalPeriodService_PeriodsOfClosedYearStayClosed(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockFiscalPeriodRepo)
+	svc := services.NewFiscalPeriodService(repo, nil)
+
+	closedID, raceID, openID := uuid.New(), uuid.New(), uuid.New()
+	repo.On("GetPeriod", ctx, closedID).Return(db.FiscalPeriod{
+		ID: closedID, FiscalYear: 2025, PeriodNumber: 4, Status: services.PeriodClosed,
+		YearClosedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	}, nil)
+	repo.On("GetPeriod", ctx, raceID).Return(db.FiscalPeriod{ID: raceID, FiscalYear: 2025, Status: services.PeriodSoftClosed}, nil)
+	repo.On("GetPeriod", ctx, openID).Return(db.FiscalPeriod{ID: openID, FiscalYear: 2026, Status: services.PeriodClosed}, nil)
+	// The year of raceID is closed between the read and the update.
+	repo.On("UpdatePeriodStatus", ctx, raceID, services.PeriodOpen, "controller").Return(db.FiscalPeriod{}, sql.ErrNoRows)
+	repo.On("UpdatePeriodStatus", ctx, openID, services.PeriodOpen, "controller").
+		Return(db.FiscalPeriod{ID: openID, Status: services.PeriodOpen}, nil)
+
+	_, err := svc.OpenPeriod(ctx, closedID, "controller")
+	assert.ErrorIs(t, err, services.ErrConflict)
+	_, err = svc.ClosePeriod(ctx, closedID, true, "controller")
+	assert.ErrorIs(t, err, services.ErrConflict)
+	_, err = svc.OpenPeriod(ctx, raceID, "controller")
+	assert.ErrorIs(t, err, services.ErrConflict)
+
+	p, err := svc.OpenPeriod(ctx, openID, "controller")
+	assert.NoError(t, err)
+	assert.Equal(t, services.PeriodOpen, p.Status)
+	repo.AssertNotCalled(t, "UpdatePeriodStatus", ctx, closedID, mock.Anything, mock.Anything)
+}
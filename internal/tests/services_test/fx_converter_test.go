@@ -0,0 +1,161 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+type MockExchangeRateService struct{ mock.Mock }
+
+func (m *MockExchangeRateService) Create(ctx context.Context, rate db.ExchangeRate) (db.ExchangeRate, error) {
+	args := m.Called(ctx, rate)
+	return args.Get(0).(db.ExchangeRate), args.Error(1)
+}
+func (m *MockExchangeRateService) Get(ctx context.Context, id uuid.UUID) (db.ExchangeRate, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(db.ExchangeRate), args.Error(1)
+}
+func (m *MockExchangeRateService) Update(ctx context.Context, rate db.ExchangeRate) (db.ExchangeRate, error) {
+	args := m.Called(ctx, rate)
+	return args.Get(0).(db.ExchangeRate), args.Error(1)
+}
+func (m *MockExchangeRateService) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+func (m *MockExchangeRateService) List(ctx context.Context, base, quote *string, limit, offset int32) ([]db.ExchangeRate, error) {
+	args := m.Called(ctx, base, quote, limit, offset)
+	return args.Get(0).([]db.ExchangeRate), args.Error(1)
+}
+func (m *MockExchangeRateService) GetLatest(ctx context.Context, base, quote string, asOf time.Time) (db.ExchangeRate, error) {
+	args := m.Called(ctx, base, quote, asOf)
+	return args.Get(0).(db.ExchangeRate), args.Error(1)
+}
+func (m *MockExchangeRateService) GetLatestOfType(ctx context.Context, base, quote, rateType string, asOf time.Time) (db.ExchangeRate, error) {
+	args := m.Called(ctx, base, quote, rateType, asOf)
+	return args.Get(0).(db.ExchangeRate), args.Error(1)
+}
+
+func TestFXConverter(t *testing.T) {
+	ctx := context.Background()
+	asOf := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	calendars := new(MockFiscalPeriodRepo)
+	calendars.On("GetCalendar", ctx, "org-eu").Return(db.FiscalCalendar{
+		OrganizationID: "org-eu", FunctionalCurrency: sql.NullString{String: "eur", Valid: true}}, nil)
+	calendars.On("GetCalendar", ctx, "org-new").Return(db.FiscalCalendar{}, sql.ErrNoRows)
+	rates := new(MockExchangeRateService)
+	rates.On("GetLatest", ctx, "USD", "INR", asOf).Return(db.ExchangeRate{Rate: "83.250000"}, nil)
+	rates.On("GetLatest", ctx, "INR", "USD", asOf).Return(db.ExchangeRate{}, sql.ErrNoRows)
+	rates.On("GetLatest", ctx, "GBP", "INR", asOf).Return(db.ExchangeRate{}, sql.ErrNoRows)
+	rates.On("GetLatest", ctx, "INR", "GBP", asOf).Return(db.ExchangeRate{Rate: "0.0096"}, nil)
+	rates.On("GetLatest", ctx, "JPY", "INR", asOf).Return(db.ExchangeRate{}, sql.ErrNoRows)
+	rates.On("GetLatest", ctx, "INR", "JPY", asOf).Return(db.ExchangeRate{}, sql.ErrNoRows)
+	fx := services.NewFXConverter(calendars, rates, "INR")
+
+	cur, err := fx.FunctionalCurrency(ctx, "org-eu")
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", cur)
+	cur, err = fx.FunctionalCurrency(ctx, "org-new")
+	require.NoError(t, err)
+	assert.Equal(t, "INR", cur)
+
+	rate, err := fx.Rate(ctx, "USD", "INR", asOf)
+	require.NoError(t, err)
+	assert.Equal(t, "83.250000", rate)
+
+	// Only INR/GBP is quoted, so GBP/INR is its reciprocal.
+	rate, err = fx.Rate(ctx, "GBP", "INR", asOf)
+	require.NoError(t, err)
+	assert.Equal(t, "104.166667", rate)
+
+	_, err = fx.Rate(ctx, "JPY", "INR", asOf)
+	assert.ErrorIs(t, err, services.ErrNoExchangeRate)
+
+	rate, err = fx.Rate(ctx, "INR", "INR", asOf)
+	require.NoError(t, err)
+	assert.Equal(t, "1", rate)
+}
+
+func TestJournalService_ConvertsForeignCurrencyLines(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	bankID, revID := uuid.New(), uuid.New()
+	accRepo := new(MockAccountRepo)
+	accRepo.On("Get", ctx, bankID).Return(&db.Account{ID: bankID, Code: "1100", Status: "ACTIVE", AllowManualJournal: true}, nil)
+	accRepo.On("Get", ctx, revID).Return(&db.Account{ID: revID, Code: "4000", Status: "ACTIVE", AllowManualJournal: true}, nil)
+	rates := new(MockExchangeRateService)
+	rates.On("GetLatest", ctx, "USD", "INR", date).Return(db.ExchangeRate{Rate: "83.333333"}, nil)
+	rates.On("GetLatest", ctx, "JPY", "INR", date).Return(db.ExchangeRate{}, sql.ErrNoRows)
+	rates.On("GetLatest", ctx, "INR", "JPY", date).Return(db.ExchangeRate{}, sql.ErrNoRows)
+	jRepo := new(MockJournalRepo)
+	jRepo.On("Create", ctx, mock.Anything).Return(&db.JournalEntry{}, nil)
+	jService := services.NewJournalService(jRepo, accRepo, nil, services.NewFXConverter(nil, rates, "INR"), nil)
+
+	// 0.10 + 0.10 + 0.10 USD each round to 8.33 INR, but the 0.30 USD credit
+	// rounds to 25.00; the credit absorbs the cent so the entry still balances.
+	j := &db.JournalEntry{JournalDate: date, Lines: []db.JournalLine{
+		{AccountID: bankID, Side: services.SideDebit, Amount: "0.10", CurrencyCode: "usd"},
+		{AccountID: bankID, Side: services.SideDebit, Amount: "0.10", CurrencyCode: "USD"},
+		{AccountID: bankID, Side: services.SideDebit, Amount: "0.10", CurrencyCode: "USD"},
+		{AccountID: revID, Side: services.SideCredit, Amount: "0.30", CurrencyCode: "USD"},
+	}}
+	_, err := jService.Create(ctx, j)
+	require.NoError(t, err)
+	first := j.Lines[0]
+	assert.Equal(t, "USD", first.CurrencyCode)
+	assert.Equal(t, "0.10", first.TransactionAmount)
+	assert.Equal(t, "83.333333", first.ExchangeRate)
+	assert.Equal(t, "INR", first.FunctionalCurrency)
+	assert.Equal(t, "8.33", first.Amount)
+	assert.Equal(t, "24.99", j.Lines[3].Amount)
+	rates.AssertNumberOfCalls(t, "GetLatest", 1)
+
+	// A functional-currency line needs no rate; a given rate is used as is.
+	j = &db.JournalEntry{JournalDate: date, Lines: []db.JournalLine{
+		{AccountID: bankID, Side: services.SideDebit, Amount: "500.00", CurrencyCode: "EUR", ExchangeRate: "90"},
+		{AccountID: revID, Side: services.SideCredit, Amount: "45000.00"},
+	}}
+	_, err = jService.Create(ctx, j)
+	require.NoError(t, err)
+	assert.Equal(t, "45000.00", j.Lines[0].Amount)
+	assert.Equal(t, "INR", j.Lines[1].CurrencyCode)
+	assert.Equal(t, "1", j.Lines[1].ExchangeRate)
+
+	// Balance is checked in the functional currency.
+	j = &db.JournalEntry{JournalDate: date, Lines: []db.JournalLine{
+		{AccountID: bankID, Side: services.SideDebit, Amount: "500.00", CurrencyCode: "EUR", ExchangeRate: "90"},
+		{AccountID: revID, Side: services.SideCredit, Amount: "500.00"},
+	}}
+	_, err = jService.Create(ctx, j)
+	assertViolations(t, err, "lines")
+
+	// Missing rates and bad currencies are reported per line.
+	j = &db.JournalEntry{JournalDate: date, Lines: []db.JournalLine{
+		{AccountID: bankID, Side: services.SideDebit, Amount: "100", CurrencyCode: "JPY"},
+		{AccountID: bankID, Side: services.SideDebit, Amount: "100", CurrencyCode: "DOLLAR"},
+		{AccountID: revID, Side: services.SideCredit, Amount: "100", ExchangeRate: "2"},
+	}}
+	_, err = jService.Create(ctx, j)
+	assertViolations(t, err, "lines[0].exchange_rate", "lines[1].currency_code", "lines[2].exchange_rate")
+	jRepo.AssertNumberOfCalls(t, "Create", 2)
+}
+
+func assertViolations(t *testing.T, err error, paths ...string) {
+	t.Helper()
+	var verr *services.JournalValidationError
+	require.ErrorAs(t, err, &verr)
+	var got []string
+	for _, v := range verr.Violations {
+		got = append(got, v.Path())
+	}
+	assert.Equal(t, paths, got)
+}
@@ -0,0 +1,194 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+type MockFXRevaluationRepo struct{ mock.Mock }
+
+func (m *MockFXRevaluationRepo) UpsertSettings(ctx context.Context, s db.FxRevaluationSetting) (db.FxRevaluationSetting, error) {
+	args := m.Called(ctx, s)
+	return args.Get(0).(db.FxRevaluationSetting), args.Error(1)
+}
+func (m *MockFXRevaluationRepo) GetSettings(ctx context.Context, orgID string) (db.FxRevaluationSetting, error) {
+	args := m.Called(ctx, orgID)
+	return args.Get(0).(db.FxRevaluationSetting), args.Error(1)
+}
+func (m *MockFXRevaluationRepo) ForeignBankAccounts(ctx context.Context, orgID, functionalCurrency string) ([]db.BankAccount, error) {
+	args := m.Called(ctx, orgID, functionalCurrency)
+	return args.Get(0).([]db.BankAccount), args.Error(1)
+}
+func (m *MockFXRevaluationRepo) ForeignCurrencyPositions(ctx context.Context, orgID string, accountIDs []uuid.UUID, functionalCurrency string, asOf time.Time) ([]db.ListForeignCurrencyPositionsRow, error) {
+	args := m.Called(ctx, orgID, accountIDs, functionalCurrency, asOf)
+	return args.Get(0).([]db.ListForeignCurrencyPositionsRow), args.Error(1)
+}
+func (m *MockFXRevaluationRepo) OpenPaymentDues(ctx context.Context, orgID, functionalCurrency string, asOf time.Time) ([]db.ListOpenForeignPaymentDuesRow, error) {
+	args := m.Called(ctx, orgID, functionalCurrency, asOf)
+	return args.Get(0).([]db.ListOpenForeignPaymentDuesRow), args.Error(1)
+}
+
+func TestFXRevaluationService_Revalue(t *testing.T) {
+	ctx := context.Background()
+	asOf := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	cashID, bankID, arID, apID, gainID, lossID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	bankAccountID := uuid.New()
+
+	accRepo := new(MockAccountRepo)
+	for _, id := range []uuid.UUID{cashID, bankID, arID, apID, gainID, lossID} {
+		accRepo.On("Get", ctx, id).Return(&db.Account{ID: id, Status: "ACTIVE"}, nil)
+	}
+	rates := new(MockExchangeRateService)
+	rates.On("GetLatestOfType", ctx, "USD", "INR", services.RateTypeClosing, asOf).Return(db.ExchangeRate{Rate: "83.500000"}, nil)
+	rates.On("GetLatestOfType", ctx, "EUR", "INR", services.RateTypeClosing, asOf).Return(db.ExchangeRate{Rate: "88.000000"}, nil)
+	fx := services.NewFXConverter(nil, rates, "INR")
+
+	settings := db.FxRevaluationSetting{
+		OrganizationID:          "org-1",
+		UnrealizedGainAccountID: gainID,
+		UnrealizedLossAccountID: lossID,
+		AccountIds:              []uuid.UUID{cashID},
+	}
+	repo := new(MockFXRevaluationRepo)
+	repo.On("GetSettings", ctx, "org-1").Return(settings, nil)
+	repo.On("GetSettings", ctx, "org-2").Return(db.FxRevaluationSetting{}, sql.ErrNoRows)
+	repo.On("ForeignBankAccounts", ctx, "org-1", "INR").Return([]db.BankAccount{{
+		ID: bankAccountID, Name: "Euro current", CurrencyCode: "EUR",
+		LedgerAccountID: sql.NullString{String: bankID.String(), Valid: true},
+	}}, nil)
+	repo.On("ForeignCurrencyPositions", ctx, "org-1", []uuid.UUID{cashID, bankID}, "INR", asOf).Return([]db.ListForeignCurrencyPositionsRow{
+		{AccountID: cashID, CurrencyCode: "USD", Balance: "1000.00", CarryingAmount: "82000.00"},
+		{AccountID: bankID, CurrencyCode: "EUR", Balance: "500.00", CarryingAmount: "45000.00"},
+	}, nil)
+	repo.On("OpenPaymentDues", ctx, "org-1", "INR", asOf).Return([]db.ListOpenForeignPaymentDuesRow{
+		{ID: uuid.New(), InvoiceNumber: "INV-1", InvoiceType: "SALES", CurrencyCode: "USD", AmountDue: "200.00", ExchangeRate: "82", AccountID: uuid.NullUUID{UUID: arID, Valid: true}},
+		{ID: uuid.New(), InvoiceNumber: "BILL-7", InvoiceType: "PURCHASE", CurrencyCode: "USD", AmountDue: "100.00", ExchangeRate: "83", AccountID: uuid.NullUUID{UUID: apID, Valid: true}},
+		// Already revalued through the cash account's ledger balance.
+		{ID: uuid.New(), InvoiceNumber: "INV-2", InvoiceType: "SALES", CurrencyCode: "USD", AmountDue: "50.00", ExchangeRate: "82", AccountID: uuid.NullUUID{UUID: cashID, Valid: true}},
+	}, nil)
+
+	periods := new(MockFiscalPeriodRepo)
+	periods.On("GetPeriodForDate", ctx, "org-1", asOf).Return(db.FiscalPeriod{
+		StartDate: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), EndDate: asOf}, nil)
+
+	jRepo := new(MockJournalRepo)
+	// Only the organization's own revaluations are looked for.
+	jRepo.On("List", ctx, ports.EntryFilter{
+		SourceType: services.SourceFXRevaluation, OrganizationID: "org-1", FromDate: asOf, ToDate: asOf,
+	}, int32(100), int32(0)).
+		Return([]*db.JournalEntry{}, nil).Once()
+	var drafted *db.JournalEntry
+	jRepo.On("Create", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		drafted = args.Get(1).(*db.JournalEntry)
+	}).Return(&db.JournalEntry{ID: uuid.New(), Status: services.JournalDraft}, nil)
+	svc := services.NewFXRevaluationService(repo, periods, fx, services.NewJournalService(jRepo, accRepo, nil, fx, nil))
+
+	res, err := svc.Revalue(ctx, "org-1", asOf, "", "closer")
+	require.NoError(t, err)
+	assert.Equal(t, services.RateTypeClosing, res.RateType)
+	assert.Equal(t, "INR", res.FunctionalCurrency)
+	require.Len(t, res.Items, 4)
+	assert.Equal(t, "1500.00", res.Items[0].Difference)
+	assert.Equal(t, services.RevaluationSourceBankAccount, res.Items[1].Source)
+	assert.Equal(t, bankAccountID.String(), res.Items[1].SourceID)
+	assert.Equal(t, "-1000.00", res.Items[1].Difference)
+	assert.Equal(t, "300.00", res.Items[2].Difference)
+	// A payable is a credit balance: the rate rising makes it a loss.
+	assert.Equal(t, "-100.00", res.Items[3].Balance)
+	assert.Equal(t, "-8350.00", res.Items[3].RevaluedAmount)
+	assert.Equal(t, "-50.00", res.Items[3].Difference)
+	require.NotNil(t, res.Journal)
+
+	require.NotNil(t, drafted)
+	assert.Equal(t, services.JournalDraft, drafted.Status)
+	assert.Equal(t, services.SourceFXRevaluation, drafted.SourceType.String)
+	assert.Equal(t, "FXREV-2025-03-31", drafted.Reference.String)
+	assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), drafted.AutoReverseDate.Time)
+	type line struct {
+		account      uuid.UUID
+		side, amount string
+	}
+	var got []line
+	for _, l := range drafted.Lines {
+		got = append(got, line{l.AccountID, l.Side, l.Amount})
+	}
+	assert.Equal(t, []line{
+		{cashID, services.SideDebit, "1500.00"},
+		{bankID, services.SideCredit, "1000.00"},
+		{arID, services.SideDebit, "300.00"},
+		{apID, services.SideCredit, "50.00"},
+		{gainID, services.SideCredit, "1800.00"},
+		{lossID, services.SideDebit, "1050.00"},
+	}, got)
+
+	// A date already revalued is rejected until its draft is deleted.
+	jRepo.On("List", ctx, mock.Anything, int32(100), int32(0)).
+		Return([]*db.JournalEntry{{ID: uuid.New(), Status: services.JournalDraft, OrganizationID: sql.NullString{String: "org-1", Valid: true}}}, nil)
+	_, err = svc.Revalue(ctx, "org-1", asOf, services.RateTypeClosing, "closer")
+	assert.ErrorIs(t, err, services.ErrConflict)
+
+	_, err = svc.Revalue(ctx, "org-2", asOf, "", "closer")
+	assert.ErrorIs(t, err, services.ErrInvalidInput)
+	_, err = svc.Revalue(ctx, "org-1", asOf, "HISTORIC", "closer")
+	assert.ErrorIs(t, err, services.ErrInvalidInput)
+	jRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestJournalService_AutoReverse(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	reverseOn := date.AddDate(0, 0, 1)
+	cashID, revID := uuid.New(), uuid.New()
+	accRepo := new(MockAccountRepo)
+	accRepo.On("Get", ctx, cashID).Return(&db.Account{ID: cashID, Status: "ACTIVE", AllowManualJournal: true}, nil)
+	accRepo.On("Get", ctx, revID).Return(&db.Account{ID: revID, Status: "ACTIVE", AllowManualJournal: true}, nil)
+	lines := []db.JournalLine{
+		{AccountID: cashID, Side: services.SideDebit, Amount: "10.00"},
+		{AccountID: revID, Side: services.SideCredit, Amount: "10.00"},
+	}
+
+	jRepo := new(MockJournalRepo)
+	jService := services.NewJournalService(jRepo, accRepo, nil, nil, nil)
+
+	// The date must come after the journal's own.
+	_, err := jService.Create(ctx, &db.JournalEntry{JournalDate: date, Lines: lines,
+		AutoReverseDate: sql.NullTime{Time: date, Valid: true}})
+	assert.ErrorIs(t, err, services.ErrInvalidInput)
+	jRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+
+	// Posting an auto-reversing draft leaves its reversal to the repository,
+	// which posts both in one transaction.
+	draftID, reversalID := uuid.New(), uuid.New()
+	draft := &db.JournalEntry{ID: draftID, JournalDate: date, Status: services.JournalDraft, Lines: lines,
+		AutoReverseDate: sql.NullTime{Time: reverseOn, Valid: true}}
+	reversed := *draft
+	reversed.Status = services.JournalReversed
+	reversed.ReversedBy = uuid.NullUUID{UUID: reversalID, Valid: true}
+	jRepo.On("Get", ctx, draftID).Return(draft, nil)
+	update := jRepo.On("Update", ctx, mock.Anything).Return(&reversed, nil)
+
+	got, err := jService.Update(ctx, &db.JournalEntry{ID: draftID, JournalDate: date, Status: services.JournalPosted, Lines: lines,
+		AutoReverseDate: sql.NullTime{Time: reverseOn, Valid: true}})
+	require.NoError(t, err)
+	assert.Equal(t, services.JournalReversed, got.Status)
+	assert.Equal(t, reversalID, got.ReversedBy.UUID)
+
+	// A reversal that cannot be posted fails the post with it.
+	update.Return((*db.JournalEntry)(nil), errors.New("insert reversal: connection reset"))
+	_, err = jService.Update(ctx, &db.JournalEntry{ID: draftID, JournalDate: date, Status: services.JournalPosted, Lines: lines,
+		AutoReverseDate: sql.NullTime{Time: reverseOn, Valid: true}})
+	assert.ErrorContains(t, err, "insert reversal")
+	jRepo.AssertNotCalled(t, "Reverse", mock.Anything, mock.Anything, mock.Anything)
+}
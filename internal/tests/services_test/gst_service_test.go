@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
 
 )
@@ -335,6 +336,19 @@ func (m *MockaPublisher) PublishInvoiceDiscountAdded(ctx context.Context, disc *
 	args := m.Called(ctx, disc)
 	return args.Error(0)
 }
+func (m *MockaPublisher) PublishInvoiceStatusChanged(ctx context.Context, ev *ports.InvoiceStatusChangedEvent) error {
+	args := m.Called(ctx, ev)
+	return args.Error(0)
+}
+func (m *MockaPublisher) PublishDunningReminder(ctx context.Context, ev *ports.DunningReminderEvent) error {
+	args := m.Called(ctx, ev)
+	return args.Error(0)
+}
+
+func (m *MockaPublisher) PublishCreditLimitBreached(ctx context.Context, ev *ports.CreditLimitBreachedEvent) error {
+	args := m.Called(ctx, ev)
+	return args.Error(0)
+}
 
 // ---------------- Bank Transaction events ----------------
 func (m *MockaPublisher) PublishBankTransactionImported(ctx context.Context, ev *db.BankAccount) error {
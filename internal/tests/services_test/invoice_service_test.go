@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
 	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -45,8 +46,8 @@ func (m *MockInvoiceRepo) ListInvoices(ctx context.Context, limit, offset int32)
 	return args.Get(0).([]db.Invoice), args.Error(1)
 }
 
-func (m *MockInvoiceRepo) SearchInvoices(ctx context.Context, query string, limit, offset int32) ([]db.Invoice, error) {
-	args := m.Called(ctx, query, limit, offset)
+func (m *MockInvoiceRepo) SearchInvoices(ctx context.Context, f ports.InvoiceFilter, limit, offset int32) ([]db.Invoice, error) {
+	args := m.Called(ctx, f, limit, offset)
 	return args.Get(0).([]db.Invoice), args.Error(1)
 }
 
@@ -70,6 +71,74 @@ func (m *MockInvoiceRepo) AddInvoiceDiscount(ctx context.Context, disc db.Invoic
 	return args.Get(0).(db.InvoiceDiscount), args.Error(1)
 }
 
+func (m *MockInvoiceRepo) IssueInvoice(ctx context.Context, id uuid.UUID, by string, issue ports.InvoiceIssue) (db.Invoice, *db.JournalEntry, error) {
+	args := m.Called(ctx, id, by, issue)
+	posted, _ := args.Get(1).(*db.JournalEntry)
+	return args.Get(0).(db.Invoice), posted, args.Error(2)
+}
+
+func (m *MockInvoiceRepo) VoidInvoice(ctx context.Context, id uuid.UUID, reason, by string, reversal *db.JournalEntry) (db.Invoice, *db.JournalEntry, error) {
+	args := m.Called(ctx, id, reason, by, reversal)
+	reversed, _ := args.Get(1).(*db.JournalEntry)
+	return args.Get(0).(db.Invoice), reversed, args.Error(2)
+}
+
+func (m *MockInvoiceRepo) MarkInvoiceOverdue(ctx context.Context, id uuid.UUID, by string) (db.Invoice, error) {
+	args := m.Called(ctx, id, by)
+	return args.Get(0).(db.Invoice), args.Error(1)
+}
+
+func (m *MockInvoiceRepo) AppendInvoiceLines(ctx context.Context, inv db.Invoice) (db.Invoice, error) {
+	args := m.Called(ctx, inv)
+	if fn, ok := args.Get(0).(func(context.Context, db.Invoice) db.Invoice); ok {
+		return fn(ctx, inv), args.Error(1)
+	}
+	return args.Get(0).(db.Invoice), args.Error(1)
+}
+
+func (m *MockInvoiceRepo) UpsertPostingRule(ctx context.Context, rule db.InvoicePostingRule) (db.InvoicePostingRule, error) {
+	args := m.Called(ctx, rule)
+	return args.Get(0).(db.InvoicePostingRule), args.Error(1)
+}
+
+func (m *MockInvoiceRepo) GetPostingRule(ctx context.Context, orgID, invoiceType string) (db.InvoicePostingRule, error) {
+	args := m.Called(ctx, orgID, invoiceType)
+	return args.Get(0).(db.InvoicePostingRule), args.Error(1)
+}
+
+func (m *MockInvoiceRepo) UpsertNumberSeries(ctx context.Context, series db.InvoiceNumberSeries) (db.InvoiceNumberSeries, error) {
+	args := m.Called(ctx, series)
+	return args.Get(0).(db.InvoiceNumberSeries), args.Error(1)
+}
+
+func (m *MockInvoiceRepo) GetNumberSeries(ctx context.Context, orgID, invoiceType string) (db.InvoiceNumberSeries, error) {
+	args := m.Called(ctx, orgID, invoiceType)
+	return args.Get(0).(db.InvoiceNumberSeries), args.Error(1)
+}
+
+func (m *MockInvoiceRepo) UpsertTemplate(ctx context.Context, t db.InvoiceTemplate) (db.InvoiceTemplate, error) {
+	args := m.Called(ctx, t)
+	return args.Get(0).(db.InvoiceTemplate), args.Error(1)
+}
+
+func (m *MockInvoiceRepo) GetTemplate(ctx context.Context, orgID string) (db.InvoiceTemplate, error) {
+	args := m.Called(ctx, orgID)
+	return args.Get(0).(db.InvoiceTemplate), args.Error(1)
+}
+
+// issueJournal builds the journal an IssueInvoice call was asked to post,
+// for an invoice numbered number.
+func issueJournal(issue ports.InvoiceIssue, number string) *db.JournalEntry {
+	if issue.Journal == nil {
+		return nil
+	}
+	j, err := issue.Journal(number)
+	if err != nil {
+		return nil
+	}
+	return j
+}
+
 // ---- Mock FinanceEventService ----
 type MockFinanceEventService struct {
 	mock.Mock
@@ -106,7 +175,6 @@ func (m *MockFinanceEventService) RecordBudgetDeleted(ctx context.Context, id uu
 	return args.Error(0)
 }
 
-
 // ---- Mock Publisher ----
 
 // MockPublisher implements ports.EventPublisher fully for testing
@@ -395,6 +463,19 @@ func (m *MockmPublisher) PublishInvoiceDiscountAdded(ctx context.Context, disc *
 	args := m.Called(ctx, disc)
 	return args.Error(0)
 }
+func (m *MockmPublisher) PublishInvoiceStatusChanged(ctx context.Context, ev *ports.InvoiceStatusChangedEvent) error {
+	args := m.Called(ctx, ev)
+	return args.Error(0)
+}
+func (m *MockmPublisher) PublishDunningReminder(ctx context.Context, ev *ports.DunningReminderEvent) error {
+	args := m.Called(ctx, ev)
+	return args.Error(0)
+}
+
+func (m *MockmPublisher) PublishCreditLimitBreached(ctx context.Context, ev *ports.CreditLimitBreachedEvent) error {
+	args := m.Called(ctx, ev)
+	return args.Error(0)
+}
 
 // ---------------- Bank Transaction events ----------------
 func (m *MockmPublisher) PublishBankTransactionImported(ctx context.Context, ev *db.BankAccount) error {
@@ -407,7 +488,6 @@ func (m *MockmPublisher) PublishBankTransactionReconciled(ctx context.Context, e
 	return args.Error(0)
 }
 
-
 // ----------- Tests -----------
 
 func TestInvoiceService_CreateInvoice(t *testing.T) {
@@ -417,7 +497,7 @@ func TestInvoiceService_CreateInvoice(t *testing.T) {
 	eventSvc := new(MockFinanceEventService)
 	pub := new(MockPublisher)
 
-	service := services.NewInvoiceService(repo, nil, pub)
+	service := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, pub, nil, nil)
 
 	inv := db.Invoice{
 		ID:             uuid.New(),
@@ -425,6 +505,7 @@ func TestInvoiceService_CreateInvoice(t *testing.T) {
 		InvoiceDate:    time.Now(),
 		GrandTotal:     "1000",
 		OrganizationID: "ORG-123",
+		Items:          []db.InvoiceItem{{Name: "Item1", Quantity: 4, UnitPrice: "250"}},
 	}
 
 	event := db.FinanceInvoiceCreatedEvent{
@@ -435,72 +516,198 @@ func TestInvoiceService_CreateInvoice(t *testing.T) {
 		OrganizationID: inv.OrganizationID,
 	}
 
-	repo.On("CreateInvoice", ctx, inv).Return(inv, nil)
+	repo.On("GetNumberSeries", ctx, "ORG-123", mock.Anything).Return(db.InvoiceNumberSeries{}, sql.ErrNoRows)
+	repo.On("CreateInvoice", ctx, mock.MatchedBy(func(got db.Invoice) bool {
+		return got.GrandTotal == "1000.00" && got.Items[0].LineTotal == "1000.00"
+	})).Return(inv, nil)
 	eventSvc.On("RecordInvoiceCreated", ctx, event).Return(event, nil)
 
 	res, err := service.CreateInvoice(ctx, inv)
 	require.NoError(t, err)
 	require.Equal(t, inv.ID, res.ID)
 
-	repo.AssertCalled(t, "CreateInvoice", ctx, inv)
+	repo.AssertNumberOfCalls(t, "CreateInvoice", 1)
 	eventSvc.AssertCalled(t, "RecordInvoiceCreated", ctx, event)
 }
 
-
 func TestInvoiceService_UpdateInvoice(t *testing.T) {
 	ctx := context.Background()
 	repo := new(MockInvoiceRepo)
 	pub := new(MockmPublisher)
-	svc := services.NewInvoiceService(repo, nil, pub)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, pub, nil, nil)
 
-	inv := db.Invoice{ID: uuid.New(), InvoiceNumber: "INV-123"}
+	inv := db.Invoice{ID: uuid.New(), InvoiceNumber: "INV-123", GrandTotal: "1180",
+		Items: []db.InvoiceItem{{Quantity: 2, UnitPrice: "500"}},
+		Taxes: []db.InvoiceTax{{Name: "igst", Rate: "18"}}}
+	repo.On("GetInvoice", ctx, inv.ID).Return(db.Invoice{ID: inv.ID, Status: services.InvoiceDraft}, nil)
+	repo.On("GetNumberSeries", ctx, mock.Anything, mock.Anything).Return(db.InvoiceNumberSeries{}, sql.ErrNoRows)
 	updated := inv
-	updated.Status = "Paid"
-
-	repo.On("UpdateInvoice", ctx, inv).Return(updated, nil)
+	updated.Status = services.InvoiceDraft
+	// The whole aggregate goes to the repo, lines and breakup included.
+	repo.On("UpdateInvoice", ctx, mock.MatchedBy(func(got db.Invoice) bool {
+		return got.Status == services.InvoiceDraft && got.Subtotal == "1000.00" && got.TaxTotal == "180.00" && got.GstIgst.String == "180.00" &&
+			got.GstRate.String == "18.000" && got.GrandTotal == "1180.00" &&
+			got.Items[0].LineTotal == "1000.00" && got.Taxes[0].Amount == "180.00" &&
+			got.GstBreakup.TaxableAmount == "1000.00" && got.GstBreakup.TotalGst.String == "180.00"
+	})).Return(updated, nil)
 	pub.On("PublishInvoiceUpdated", ctx, &updated).Return(nil)
 
 	got, err := svc.UpdateInvoice(ctx, inv)
 	require.NoError(t, err)
 	require.Equal(t, updated, got)
 
-	repo.AssertExpectations(t)
+	// Client totals that disagree with the lines are rejected.
+	inv.GrandTotal = "1000"
+	_, err = svc.UpdateInvoice(ctx, inv)
+	var totalsErr *services.InvoiceTotalsError
+	require.ErrorAs(t, err, &totalsErr)
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+	require.Equal(t, "grand_total", totalsErr.Mismatches[0].Field)
+
+	// The status only moves through the transitions.
+	inv.GrandTotal = ""
+	inv.Status = services.InvoicePaid
+	_, err = svc.UpdateInvoice(ctx, inv)
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+
+	// Issued invoices are immutable.
+	issuedID := uuid.New()
+	repo.On("GetInvoice", ctx, issuedID).Return(db.Invoice{ID: issuedID, Status: services.InvoiceIssued}, nil)
+	_, err = svc.UpdateInvoice(ctx, db.Invoice{ID: issuedID})
+	require.ErrorIs(t, err, services.ErrConflict)
+
+	repo.AssertNumberOfCalls(t, "UpdateInvoice", 1)
 	pub.AssertExpectations(t)
 }
 
+func TestInvoiceService_InvoiceParty(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockInvoiceRepo)
+	parties := new(MockPartyRepo)
+	pub := new(MockmPublisher)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, pub, nil, parties)
+
+	party := func(org, kind string) uuid.NullUUID {
+		id := uuid.New()
+		parties.On("Get", ctx, id).Return(&ports.Party{Party: db.Party{ID: id, OrganizationID: org, Kind: kind, Name: "Acme"}}, nil)
+		return uuid.NullUUID{UUID: id, Valid: true}
+	}
+	customer, vendor, both := party("org-1", services.PartyCustomer), party("org-1", services.PartyVendor), party("org-1", services.PartyBoth)
+	foreign := party("org-2", services.PartyCustomer)
+	missing := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+	parties.On("Get", ctx, missing.UUID).Return(nil, sql.ErrNoRows)
+	repo.On("GetNumberSeries", ctx, mock.Anything, mock.Anything).Return(db.InvoiceNumberSeries{}, sql.ErrNoRows)
+
+	for name, tc := range map[string]struct {
+		typ   string
+		party uuid.NullUUID
+	}{
+		"vendor on a sale":       {services.InvoiceTypeSales, vendor},
+		"customer on a purchase": {services.InvoiceTypePurchase, customer},
+		"another organization's": {services.InvoiceTypeSales, foreign},
+		"missing":                {services.InvoiceTypeSales, missing},
+	} {
+		_, err := svc.CreateInvoice(ctx, db.Invoice{OrganizationID: "org-1", Type: tc.typ, PartyID: tc.party,
+			Items: []db.InvoiceItem{{Name: "Widget", Quantity: 1, UnitPrice: "100"}}})
+		require.ErrorIs(t, err, services.ErrInvalidInput, name)
+	}
+	repo.AssertNotCalled(t, "CreateInvoice", mock.Anything, mock.Anything)
+
+	// An update is checked the same way; a party that is both can be billed.
+	draft := db.Invoice{ID: uuid.New(), OrganizationID: "org-1", Type: services.InvoiceTypeSales, PartyID: both,
+		Items: []db.InvoiceItem{{Name: "Widget", Quantity: 1, UnitPrice: "100"}}}
+	repo.On("GetInvoice", ctx, draft.ID).Return(db.Invoice{ID: draft.ID, OrganizationID: "org-1", Status: services.InvoiceDraft}, nil)
+	repo.On("UpdateInvoice", ctx, mock.Anything).Return(draft, nil).Once()
+	pub.On("PublishInvoiceUpdated", ctx, mock.Anything).Return(nil)
+	_, err := svc.UpdateInvoice(ctx, draft)
+	require.NoError(t, err)
+	draft.PartyID = vendor
+	_, err = svc.UpdateInvoice(ctx, draft)
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+	repo.AssertNumberOfCalls(t, "UpdateInvoice", 1)
+}
+
 func TestInvoiceService_DeleteInvoice(t *testing.T) {
 	ctx := context.Background()
 	repo := new(MockInvoiceRepo)
 	pub := new(MockmPublisher)
-	svc := services.NewInvoiceService(repo, nil, pub)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, pub, nil, nil)
 
-	id := uuid.New()
+	id, issuedID := uuid.New(), uuid.New()
 
+	repo.On("GetInvoice", ctx, id).Return(db.Invoice{ID: id, Status: services.InvoiceDraft}, nil)
 	repo.On("DeleteInvoice", ctx, id).Return(nil)
 	pub.On("PublishInvoiceDeleted", ctx, id.String()).Return(nil)
 
 	err := svc.DeleteInvoice(ctx, id)
 	require.NoError(t, err)
 
+	// Only drafts can be deleted; issued invoices are voided instead.
+	repo.On("GetInvoice", ctx, issuedID).Return(db.Invoice{ID: issuedID, Status: services.InvoiceIssued}, nil)
+	err = svc.DeleteInvoice(ctx, issuedID)
+	require.ErrorIs(t, err, services.ErrConflict)
+
+	repo.AssertNumberOfCalls(t, "DeleteInvoice", 1)
 	repo.AssertExpectations(t)
 	pub.AssertExpectations(t)
 }
 
+// echoAppended has the repo echo the appended invoice back, giving the new
+// line of each kind an id.
+func echoAppended(repo *MockInvoiceRepo, ctx context.Context, check func(db.Invoice) bool) {
+	repo.On("AppendInvoiceLines", ctx, mock.MatchedBy(check)).Return(func(_ context.Context, inv db.Invoice) db.Invoice {
+		for i := range inv.Items {
+			if inv.Items[i].ID == uuid.Nil {
+				inv.Items[i].ID = uuid.New()
+			}
+		}
+		for i := range inv.Taxes {
+			if inv.Taxes[i].ID == uuid.Nil {
+				inv.Taxes[i].ID = uuid.New()
+			}
+		}
+		for i := range inv.Discounts {
+			if inv.Discounts[i].ID == uuid.Nil {
+				inv.Discounts[i].ID = uuid.New()
+			}
+		}
+		return inv
+	}, nil)
+}
+
 func TestInvoiceService_CreateInvoiceItem(t *testing.T) {
 	ctx := context.Background()
 	repo := new(MockInvoiceRepo)
 	pub := new(MockmPublisher)
-	svc := services.NewInvoiceService(repo, nil, pub)
-
-	item := db.InvoiceItem{ID: uuid.New(), InvoiceID: uuid.New(), Name: "Item1"}
-	repo.On("CreateInvoiceItem", ctx, item).Return(item, nil)
-	pub.On("PublishInvoiceItemCreated", ctx, &item).Return(nil)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, pub, nil, nil)
+
+	invoiceID := uuid.New()
+	repo.On("GetInvoice", ctx, invoiceID).Return(db.Invoice{ID: invoiceID, Status: services.InvoiceDraft}, nil)
+
+	item := db.InvoiceItem{InvoiceID: invoiceID, Name: "Item1", Quantity: 3, UnitPrice: "33.33",
+		Discounts: []db.InvoiceItemDiscount{{Amount: "9.99"}},
+		Taxes:     []db.InvoiceItemTax{{Name: "cgst", Rate: "9%"}, {Name: "SGST", Rate: "9", Amount: "8.10"}}}
+	echoAppended(repo, ctx, func(got db.Invoice) bool {
+		return got.Subtotal == "99.99" && got.DiscountTotal == "9.99" && got.TaxTotal == "16.20" &&
+			got.GstCgst.String == "8.10" && got.GrandTotal == "106.20" &&
+			got.Items[0].LineSubtotal == "99.99" && got.Items[0].LineTotal == "106.20" &&
+			got.Items[0].Taxes[0].Name == "CGST" && got.Items[0].Taxes[0].Amount == "8.10"
+	})
+	pub.On("PublishInvoiceItemCreated", ctx, mock.Anything).Return(nil)
 
 	got, err := svc.CreateInvoiceItem(ctx, item)
 	require.NoError(t, err)
-	require.Equal(t, item, got)
+	require.NotEqual(t, uuid.Nil, got.ID)
+	require.Equal(t, "106.20", got.LineTotal)
+	require.Len(t, got.Discounts, 1)
+	require.Len(t, got.Taxes, 2)
 
-	repo.AssertExpectations(t)
+	// A line total that disagrees with the line is rejected.
+	item.LineTotal = "110.00"
+	_, err = svc.CreateInvoiceItem(ctx, item)
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+
+	repo.AssertNumberOfCalls(t, "AppendInvoiceLines", 1)
 	pub.AssertExpectations(t)
 }
 
@@ -508,15 +715,24 @@ func TestInvoiceService_AddInvoiceTax(t *testing.T) {
 	ctx := context.Background()
 	repo := new(MockInvoiceRepo)
 	pub := new(MockmPublisher)
-	svc := services.NewInvoiceService(repo, nil, pub)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, pub, nil, nil)
+
+	invoiceID, itemID := uuid.New(), uuid.New()
+	repo.On("GetInvoice", ctx, invoiceID).Return(db.Invoice{ID: invoiceID, Status: services.InvoiceDraft,
+		Items: []db.InvoiceItem{{ID: itemID, Quantity: 1, UnitPrice: "1000", LineSubtotal: "1000.00", LineTotal: "1000.00"}}}, nil)
 
-	tax := db.InvoiceTax{ID: uuid.New(), InvoiceID: uuid.New(), Name: "GST", Rate: "18%", Amount: "180"}
-	repo.On("AddInvoiceTax", ctx, tax).Return(tax, nil)
-	pub.On("PublishInvoiceTaxAdded", ctx, &tax).Return(nil)
+	tax := db.InvoiceTax{InvoiceID: invoiceID, Name: "GST", Rate: "18%", Amount: "180"}
+	echoAppended(repo, ctx, func(got db.Invoice) bool {
+		// GST is not one of CGST, SGST or IGST, so it is not in the breakup.
+		return got.TaxTotal == "180.00" && got.GstIgst.String == "0.00" && got.GrandTotal == "1180.00" &&
+			got.Items[0].ID == itemID && got.Taxes[0].Rate == "18" && got.Taxes[0].Amount == "180.00"
+	})
+	pub.On("PublishInvoiceTaxAdded", ctx, mock.Anything).Return(nil)
 
 	got, err := svc.AddInvoiceTax(ctx, tax)
 	require.NoError(t, err)
-	require.Equal(t, tax, got)
+	require.Equal(t, "180.00", got.Amount)
+	require.NotEqual(t, uuid.Nil, got.ID)
 
 	repo.AssertExpectations(t)
 	pub.AssertExpectations(t)
@@ -526,24 +742,152 @@ func TestInvoiceService_AddInvoiceDiscount(t *testing.T) {
 	ctx := context.Background()
 	repo := new(MockInvoiceRepo)
 	pub := new(MockmPublisher)
-	svc := services.NewInvoiceService(repo, nil, pub)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, pub, nil, nil)
 
-	disc := db.InvoiceDiscount{ID: uuid.New(), InvoiceID: uuid.New(), Description: sql.NullString{String: "Promo", Valid: true}, Amount: "100"}
-	repo.On("AddInvoiceDiscount", ctx, disc).Return(disc, nil)
-	pub.On("PublishInvoiceDiscountAdded", ctx, &disc).Return(nil)
+	invoiceID, taxID := uuid.New(), uuid.New()
+	repo.On("GetInvoice", ctx, invoiceID).Return(db.Invoice{ID: invoiceID, Status: services.InvoiceDraft,
+		Items: []db.InvoiceItem{{ID: uuid.New(), Quantity: 1, UnitPrice: "1000", LineSubtotal: "1000.00", LineTotal: "1000.00"}},
+		Taxes: []db.InvoiceTax{{ID: taxID, InvoiceID: invoiceID, Name: "IGST", Rate: "18", Amount: "180.00"}}}, nil)
+
+	disc := db.InvoiceDiscount{InvoiceID: invoiceID, Description: sql.NullString{String: "Promo", Valid: true}, Amount: "100"}
+	// The discount lowers the taxable value, and with it the stored tax.
+	echoAppended(repo, ctx, func(got db.Invoice) bool {
+		return got.DiscountTotal == "100.00" && got.GstIgst.String == "162.00" && got.GrandTotal == "1062.00" &&
+			got.Taxes[0].ID == taxID && got.Taxes[0].Amount == "162.00"
+	})
+	pub.On("PublishInvoiceDiscountAdded", ctx, mock.Anything).Return(nil)
 
 	got, err := svc.AddInvoiceDiscount(ctx, disc)
 	require.NoError(t, err)
-	require.Equal(t, disc, got)
+	require.Equal(t, "100.00", got.Amount)
+	require.Equal(t, "Promo", got.Description.String)
 
 	repo.AssertExpectations(t)
 	pub.AssertExpectations(t)
 }
 
+func TestInvoiceService_StatusTransitions(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockInvoiceRepo)
+	pub := new(MockmPublisher)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, pub, nil, nil)
+
+	due := time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)
+	changedAt := time.Date(2025, 5, 2, 9, 0, 0, 0, time.UTC)
+	draftID, emptyID, issuedID, paidID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	items := []db.InvoiceItem{{Quantity: 1, UnitPrice: "100"}}
+	repo.On("GetInvoice", ctx, draftID).Return(db.Invoice{ID: draftID, InvoiceNumber: "INV-1", Status: services.InvoiceDraft, Items: items}, nil)
+	repo.On("GetInvoice", ctx, emptyID).Return(db.Invoice{ID: emptyID, Status: services.InvoiceDraft}, nil)
+	repo.On("GetInvoice", ctx, issuedID).Return(db.Invoice{ID: issuedID, Status: services.InvoiceIssued, Items: items,
+		DueDate: sql.NullTime{Time: due, Valid: true}}, nil)
+	repo.On("GetInvoice", ctx, paidID).Return(db.Invoice{ID: paidID, Status: services.InvoicePaid}, nil)
+
+	stamped := func(id uuid.UUID, to string) db.Invoice {
+		return db.Invoice{ID: id, InvoiceNumber: "INV-1", Status: to,
+			UpdatedBy: sql.NullString{String: "clerk", Valid: true}, UpdatedAt: sql.NullTime{Time: changedAt, Valid: true}}
+	}
+	repo.On("IssueInvoice", ctx, draftID, "clerk", mock.MatchedBy(func(issue ports.InvoiceIssue) bool {
+		return issue.Number == nil && issueJournal(issue, "INV-1") == nil
+	})).Return(stamped(draftID, services.InvoiceIssued), nil, nil)
+	pub.On("PublishInvoiceStatusChanged", ctx, &ports.InvoiceStatusChangedEvent{
+		InvoiceID: draftID.String(), InvoiceNumber: "INV-1", From: services.InvoiceDraft, To: services.InvoiceIssued,
+		ChangedBy: "clerk", ChangedAt: changedAt,
+	}).Return(nil)
+
+	got, err := svc.IssueInvoice(ctx, draftID, "clerk")
+	require.NoError(t, err)
+	require.Equal(t, services.InvoiceIssued, got.Status)
+
+	// A draft without items cannot be issued, nor can anything but a draft.
+	_, err = svc.IssueInvoice(ctx, emptyID, "clerk")
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+	_, err = svc.IssueInvoice(ctx, issuedID, "clerk")
+	require.ErrorIs(t, err, services.ErrConflict)
+
+	// Voiding needs a reason, and paid invoices are final.
+	_, err = svc.VoidInvoice(ctx, issuedID, "  ", "clerk")
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+	_, err = svc.VoidInvoice(ctx, paidID, "Duplicate", "clerk")
+	require.ErrorIs(t, err, services.ErrConflict)
+	repo.On("VoidInvoice", ctx, issuedID, "Duplicate", "clerk", (*db.JournalEntry)(nil)).Return(stamped(issuedID, services.InvoiceVoid), nil, nil)
+	pub.On("PublishInvoiceStatusChanged", ctx, mock.MatchedBy(func(ev *ports.InvoiceStatusChangedEvent) bool {
+		return ev.To == services.InvoiceVoid && ev.From == services.InvoiceIssued && ev.Reason == "Duplicate"
+	})).Return(nil)
+	got, err = svc.VoidInvoice(ctx, issuedID, " Duplicate ", "clerk")
+	require.NoError(t, err)
+	require.Equal(t, services.InvoiceVoid, got.Status)
+
+	// An invoice is overdue once its due date has passed.
+	_, err = svc.MarkOverdue(ctx, issuedID, due, "job")
+	require.ErrorIs(t, err, services.ErrConflict)
+	_, err = svc.MarkOverdue(ctx, draftID, changedAt, "job")
+	require.ErrorIs(t, err, services.ErrConflict)
+	// The status moved on between the check and the update.
+	repo.On("MarkInvoiceOverdue", ctx, issuedID, "job").Return(db.Invoice{}, sql.ErrNoRows)
+	_, err = svc.MarkOverdue(ctx, issuedID, changedAt, "job")
+	require.ErrorIs(t, err, services.ErrConflict)
+
+	repo.On("GetInvoice", ctx, mock.Anything).Return(db.Invoice{}, sql.ErrNoRows)
+	_, err = svc.IssueInvoice(ctx, uuid.New(), "clerk")
+	require.ErrorIs(t, err, services.ErrNotFound)
+
+	repo.AssertNumberOfCalls(t, "IssueInvoice", 1)
+	pub.AssertExpectations(t)
+}
+
+func TestInvoiceService_VoidInvoice_AfterNote(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockInvoiceRepo)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, new(MockmPublisher), nil, nil)
+
+	creditedID, debitedID, plainID := uuid.New(), uuid.New(), uuid.New()
+	repo.On("GetInvoice", ctx, creditedID).Return(db.Invoice{ID: creditedID, Status: services.InvoiceIssued,
+		GrandTotal: "1180.00", CreditedTotal: "200.00", DebitedTotal: "0.00"}, nil)
+	repo.On("GetInvoice", ctx, debitedID).Return(db.Invoice{ID: debitedID, Status: services.InvoiceOverdue,
+		GrandTotal: "1180.00", CreditedTotal: "0.00", DebitedTotal: "50.00"}, nil)
+	repo.On("GetInvoice", ctx, plainID).Return(db.Invoice{ID: plainID, Status: services.InvoiceIssued,
+		GrandTotal: "1180.00", CreditedTotal: "0.00", DebitedTotal: "0.00"}, nil)
+
+	// Voiding would reverse the invoice's journal but not the notes'.
+	_, err := svc.VoidInvoice(ctx, creditedID, "Duplicate", "clerk")
+	require.ErrorIs(t, err, services.ErrConflict)
+	_, err = svc.VoidInvoice(ctx, debitedID, "Duplicate", "clerk")
+	require.ErrorIs(t, err, services.ErrConflict)
+	repo.AssertNotCalled(t, "VoidInvoice", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// A note applied between the read and the void stops it too.
+	repo.On("VoidInvoice", ctx, plainID, "Duplicate", "clerk", (*db.JournalEntry)(nil)).
+		Return(db.Invoice{}, (*db.JournalEntry)(nil), sql.ErrNoRows)
+	_, err = svc.VoidInvoice(ctx, plainID, "Duplicate", "clerk")
+	require.ErrorIs(t, err, services.ErrConflict)
+	require.ErrorContains(t, err, "without notes")
+}
+
+func TestInvoiceService_IssueInvoice_DraftChangedSinceRead(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockInvoiceRepo)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, new(MockmPublisher), nil, nil)
+
+	id := uuid.New()
+	repo.On("GetInvoice", ctx, id).Return(db.Invoice{
+		ID: id, InvoiceNumber: "INV-1", Status: services.InvoiceDraft,
+		Items: []db.InvoiceItem{{Quantity: 1, UnitPrice: "100"}}, Revision: sql.NullInt32{Int32: 3, Valid: true},
+	}, nil)
+	// An update between the read and the issue moved the draft to revision 4.
+	repo.On("IssueInvoice", ctx, id, "clerk", mock.MatchedBy(func(issue ports.InvoiceIssue) bool {
+		return issue.Revision == 3
+	})).Return(db.Invoice{}, (*db.JournalEntry)(nil), sql.ErrNoRows)
+
+	_, err := svc.IssueInvoice(ctx, id, "clerk")
+	require.ErrorIs(t, err, services.ErrConflict)
+	require.ErrorContains(t, err, "revision 3")
+	repo.AssertExpectations(t)
+}
+
 func TestInvoiceService_GetInvoice_Error(t *testing.T) {
 	ctx := context.Background()
 	repo := new(MockInvoiceRepo)
-	svc := services.NewInvoiceService(repo, nil, nil)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	id := uuid.New()
 	repo.On("GetInvoice", ctx, id).Return(db.Invoice{}, errors.New("not found"))
@@ -552,3 +896,299 @@ func TestInvoiceService_GetInvoice_Error(t *testing.T) {
 	require.Error(t, err)
 	repo.AssertExpectations(t)
 }
+
+func TestInvoiceService_NumberSeries(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockInvoiceRepo)
+	calendars := new(MockFiscalPeriodRepo)
+	pub := new(MockmPublisher)
+	pub.On("PublishInvoiceStatusChanged", ctx, mock.Anything).Return(nil)
+	svc := services.NewInvoiceService(repo, nil, nil, calendars, nil, nil, pub, nil, nil)
+
+	repo.On("GetNumberSeries", ctx, "org-1", services.InvoiceTypeSales).Return(db.InvoiceNumberSeries{Pattern: "INV/{FY}/{SEQ:5}"}, nil)
+	repo.On("GetNumberSeries", ctx, "org-2", services.InvoiceTypeSales).Return(db.InvoiceNumberSeries{Pattern: "S{FY:2}-{SEQ}"}, nil)
+	repo.On("GetNumberSeries", ctx, mock.Anything, mock.Anything).Return(db.InvoiceNumberSeries{}, sql.ErrNoRows)
+	calendars.On("GetCalendar", ctx, "org-1").Return(db.FiscalCalendar{}, sql.ErrNoRows)
+	calendars.On("GetCalendar", ctx, "org-2").Return(db.FiscalCalendar{YearStartMonth: 1}, nil)
+
+	// Drafts of a numbered type take their number at issue, from the fiscal
+	// year they are dated in: FY 2024-25 runs to March without a calendar.
+	items := []db.InvoiceItem{{Quantity: 1, UnitPrice: "100"}}
+	draft := db.Invoice{ID: uuid.New(), Type: services.InvoiceTypeSales, Status: services.InvoiceDraft, OrganizationID: "org-1",
+		InvoiceDate: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), Items: items}
+	repo.On("GetInvoice", ctx, draft.ID).Return(draft, nil)
+	repo.On("IssueInvoice", ctx, draft.ID, "clerk", mock.MatchedBy(func(issue ports.InvoiceIssue) bool {
+		return issue.Number != nil && issue.Number.FiscalYear == 2024 && issue.Number.Format(42) == "INV/2024-25/00042"
+	})).Return(db.Invoice{ID: draft.ID, InvoiceNumber: "INV/2024-25/00042", Status: services.InvoiceIssued}, nil, nil)
+	issued, err := svc.IssueInvoice(ctx, draft.ID, "clerk")
+	require.NoError(t, err)
+	require.Equal(t, "INV/2024-25/00042", issued.InvoiceNumber)
+
+	calendarYear := draft
+	calendarYear.ID, calendarYear.OrganizationID = uuid.New(), "org-2"
+	repo.On("GetInvoice", ctx, calendarYear.ID).Return(calendarYear, nil)
+	repo.On("IssueInvoice", ctx, calendarYear.ID, "clerk", mock.MatchedBy(func(issue ports.InvoiceIssue) bool {
+		return issue.Number.FiscalYear == 2025 && issue.Number.Format(7) == "S25-7"
+	})).Return(db.Invoice{ID: calendarYear.ID, Status: services.InvoiceIssued}, nil, nil)
+	_, err = svc.IssueInvoice(ctx, calendarYear.ID, "clerk")
+	require.NoError(t, err)
+
+	// A number of the caller's own is refused where a series applies, and
+	// required where none does.
+	_, err = svc.CreateInvoice(ctx, db.Invoice{InvoiceNumber: "INV-9", Type: services.InvoiceTypeSales, OrganizationID: "org-1", Items: items})
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+	unnumbered := draft
+	unnumbered.ID, unnumbered.Type = uuid.New(), services.InvoiceTypePurchase
+	repo.On("GetInvoice", ctx, unnumbered.ID).Return(unnumbered, nil)
+	_, err = svc.IssueInvoice(ctx, unnumbered.ID, "clerk")
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+
+	// Patterns need exactly one sequence and known tokens only.
+	for _, pattern := range []string{"INV/{FY}", "{SEQ}-{SEQ:3}", "INV/{YEAR}/{SEQ}", "INV/{SEQ:0}", "INV/{SEQ", "{FY:3}/{SEQ}"} {
+		_, err = svc.SetNumberSeries(ctx, db.InvoiceNumberSeries{OrganizationID: "org-1", InvoiceType: "SALES", Pattern: pattern})
+		require.ErrorIs(t, err, services.ErrInvalidInput, pattern)
+	}
+	repo.On("UpsertNumberSeries", ctx, mock.Anything).Return(db.InvoiceNumberSeries{Pattern: "CH/{SEQ:4}"}, nil)
+	_, err = svc.SetNumberSeries(ctx, db.InvoiceNumberSeries{OrganizationID: "org-1", InvoiceType: "challan", Pattern: " CH/{SEQ:4} "})
+	require.NoError(t, err)
+	repo.AssertCalled(t, "UpsertNumberSeries", ctx, db.InvoiceNumberSeries{OrganizationID: "org-1", InvoiceType: services.InvoiceTypeChallan, Pattern: "CH/{SEQ:4}"})
+
+	_, err = svc.GetNumberSeries(ctx, "org-3", "SALES")
+	require.ErrorIs(t, err, services.ErrNotFound)
+
+	repo.AssertNumberOfCalls(t, "IssueInvoice", 2)
+	repo.AssertNotCalled(t, "CreateInvoice", mock.Anything, mock.Anything)
+}
+
+func TestInvoiceService_PostsToLedger(t *testing.T) {
+	ctx := context.Background()
+	date := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	arID, apID, salesID, servicesID, expenseID := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	cgstID, sgstID, cessID, roundID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	accRepo := new(MockAccountRepo)
+	for _, id := range []uuid.UUID{salesID, servicesID, expenseID, cgstID, sgstID, cessID, roundID} {
+		accRepo.On("Get", ctx, id).Return(&db.Account{ID: id, Status: "ACTIVE", AllowManualJournal: true}, nil)
+	}
+	// Control accounts take no manual journals; invoice postings are not manual.
+	accRepo.On("Get", ctx, arID).Return(&db.Account{ID: arID, Status: "ACTIVE"}, nil)
+	accRepo.On("Get", ctx, apID).Return(&db.Account{ID: apID, Status: "ACTIVE"}, nil)
+	jRepo := new(MockJournalRepo)
+	journals := services.NewJournalService(jRepo, accRepo, nil, services.NewFXConverter(nil, new(MockExchangeRateService), "INR"), nil)
+
+	repo := new(MockInvoiceRepo)
+	pub := new(MockmPublisher)
+	pub.On("PublishInvoiceStatusChanged", ctx, mock.Anything).Return(nil)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, journals, pub, nil, nil)
+
+	repo.On("GetPostingRule", ctx, "org-1", services.InvoiceTypeSales).Return(db.InvoicePostingRule{
+		ControlAccountID: arID, LineAccountID: salesID,
+		CgstAccountID:     uuid.NullUUID{UUID: cgstID, Valid: true},
+		SgstAccountID:     uuid.NullUUID{UUID: sgstID, Valid: true},
+		OtherTaxAccountID: uuid.NullUUID{UUID: cessID, Valid: true},
+		RoundOffAccountID: uuid.NullUUID{UUID: roundID, Valid: true},
+	}, nil)
+	repo.On("GetPostingRule", ctx, "org-1", services.InvoiceTypePurchase).Return(db.InvoicePostingRule{
+		ControlAccountID: apID, LineAccountID: expenseID,
+	}, nil)
+	repo.On("GetPostingRule", ctx, "org-2", mock.Anything).Return(db.InvoicePostingRule{}, sql.ErrNoRows)
+
+	// 1200.00 less 150.00 of discounts is taxed at 9% + 9% with a 10.60 cess,
+	// and 1249.60 is rounded to 1250.00.
+	salesInv := db.Invoice{
+		ID: uuid.New(), InvoiceNumber: "INV-7", Type: services.InvoiceTypeSales, Status: services.InvoiceDraft,
+		InvoiceDate: date, OrganizationID: "org-1", CurrencyCode: "INR",
+		Items: []db.InvoiceItem{
+			{Name: "Support", LineSubtotal: "1000.00", AccountID: uuid.NullUUID{UUID: servicesID, Valid: true},
+				Discounts: []db.InvoiceItemDiscount{{Amount: "100.00"}}},
+			{Name: "Widget", LineSubtotal: "200.00"},
+		},
+		Discounts: []db.InvoiceDiscount{{Amount: "50.00"}},
+		GstCgst:   sql.NullString{String: "94.50", Valid: true},
+		GstSgst:   sql.NullString{String: "94.50", Valid: true},
+		GstIgst:   sql.NullString{String: "0.00", Valid: true},
+		TaxTotal:  "199.60", RoundOff: "0.40", GrandTotal: "1250.00",
+	}
+	repo.On("GetInvoice", ctx, salesInv.ID).Return(salesInv, nil)
+	journalID := uuid.New()
+	repo.On("IssueInvoice", ctx, salesInv.ID, "clerk", mock.Anything).
+		Return(db.Invoice{ID: salesInv.ID, Status: services.InvoiceIssued, JournalID: uuid.NullUUID{UUID: journalID, Valid: true}},
+			&db.JournalEntry{ID: journalID, Status: services.JournalPosted}, nil)
+
+	issued, err := svc.IssueInvoice(ctx, salesInv.ID, "clerk")
+	require.NoError(t, err)
+	require.Equal(t, journalID, issued.JournalID.UUID)
+	j := issueJournal(repo.Calls[len(repo.Calls)-1].Arguments.Get(3).(ports.InvoiceIssue), "INV-7")
+	require.Equal(t, services.JournalPosted, j.Status)
+	require.Equal(t, services.SourceInvoice, j.SourceType.String)
+	require.Equal(t, salesInv.ID.String(), j.SourceID.String)
+	require.Equal(t, "INV-7", j.Reference.String)
+	type posting struct {
+		account uuid.UUID
+		side    string
+		amount  string
+	}
+	var got []posting
+	for _, l := range j.Lines {
+		got = append(got, posting{l.AccountID, l.Side, l.Amount})
+	}
+	require.Equal(t, []posting{
+		{arID, services.SideDebit, "1250.00"},
+		{servicesID, services.SideCredit, "900.00"},
+		{salesID, services.SideCredit, "200.00"},
+		{salesID, services.SideDebit, "50.00"}, // no discount account
+		{cgstID, services.SideCredit, "94.50"},
+		{sgstID, services.SideCredit, "94.50"},
+		{cessID, services.SideCredit, "10.60"},
+		{roundID, services.SideCredit, "0.40"},
+	}, got)
+
+	// A purchase invoice books the mirror image.
+	purchase := db.Invoice{
+		ID: uuid.New(), InvoiceNumber: "BILL-3", Type: services.InvoiceTypePurchase, Status: services.InvoiceDraft,
+		InvoiceDate: date, OrganizationID: "org-1", CurrencyCode: "INR",
+		Items:    []db.InvoiceItem{{Name: "Paper", LineSubtotal: "500.00"}},
+		TaxTotal: "0.00", RoundOff: "0.00", GrandTotal: "500.00",
+	}
+	repo.On("GetInvoice", ctx, purchase.ID).Return(purchase, nil)
+	repo.On("IssueInvoice", ctx, purchase.ID, "clerk", mock.Anything).
+		Return(db.Invoice{ID: purchase.ID, Status: services.InvoiceIssued}, &db.JournalEntry{ID: uuid.New()}, nil)
+	_, err = svc.IssueInvoice(ctx, purchase.ID, "clerk")
+	require.NoError(t, err)
+	bill := issueJournal(repo.Calls[len(repo.Calls)-1].Arguments.Get(3).(ports.InvoiceIssue), "BILL-3")
+	require.Len(t, bill.Lines, 2)
+	require.Equal(t, posting{apID, services.SideCredit, "500.00"}, posting{bill.Lines[0].AccountID, bill.Lines[0].Side, bill.Lines[0].Amount})
+	require.Equal(t, expenseID, bill.Lines[1].AccountID)
+	require.Equal(t, services.SideDebit, bill.Lines[1].Side)
+
+	// Without accounts configured, or one for a tax the invoice carries, the
+	// invoice is not issued.
+	unmapped := salesInv
+	unmapped.ID, unmapped.OrganizationID = uuid.New(), "org-2"
+	repo.On("GetInvoice", ctx, unmapped.ID).Return(unmapped, nil)
+	_, err = svc.IssueInvoice(ctx, unmapped.ID, "clerk")
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+	igst := purchase
+	igst.ID, igst.GstIgst, igst.TaxTotal, igst.GrandTotal = uuid.New(), sql.NullString{String: "90.00", Valid: true}, "90.00", "590.00"
+	repo.On("GetInvoice", ctx, igst.ID).Return(igst, nil)
+	_, err = svc.IssueInvoice(ctx, igst.ID, "clerk")
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+
+	// Proforma invoices do not post.
+	proforma := db.Invoice{ID: uuid.New(), InvoiceNumber: "PF-1", Type: "PROFORMA", Status: services.InvoiceDraft, Items: purchase.Items}
+	repo.On("GetInvoice", ctx, proforma.ID).Return(proforma, nil)
+	repo.On("IssueInvoice", ctx, proforma.ID, "clerk", mock.MatchedBy(func(issue ports.InvoiceIssue) bool {
+		return issueJournal(issue, "PF-1") == nil
+	})).
+		Return(db.Invoice{ID: proforma.ID, Status: services.InvoiceIssued}, nil, nil)
+	_, err = svc.IssueInvoice(ctx, proforma.ID, "clerk")
+	require.NoError(t, err)
+
+	// Voiding reverses the journal on its own date.
+	issuedInv := salesInv
+	issuedInv.Status, issuedInv.JournalID = services.InvoiceIssued, uuid.NullUUID{UUID: journalID, Valid: true}
+	repo.On("GetInvoice", ctx, issuedInv.ID).Unset()
+	repo.On("GetInvoice", ctx, issuedInv.ID).Return(issuedInv, nil)
+	jRepo.On("Get", ctx, journalID).Return(&db.JournalEntry{
+		ID: journalID, JournalDate: date, Status: services.JournalPosted, Reference: j.Reference,
+		SourceType: j.SourceType, SourceID: j.SourceID, OrganizationID: j.OrganizationID, Lines: j.Lines,
+	}, nil)
+	repo.On("VoidInvoice", ctx, issuedInv.ID, "Duplicate", "clerk", mock.MatchedBy(func(r *db.JournalEntry) bool {
+		return r.Status == services.JournalPosted && r.JournalDate.Equal(date) &&
+			r.SourceType.String == services.SourceInvoice && len(r.Lines) == len(j.Lines) &&
+			r.Lines[0].AccountID == arID && r.Lines[0].Side == services.SideCredit
+	})).Return(db.Invoice{ID: issuedInv.ID, Status: services.InvoiceVoid}, &db.JournalEntry{ID: uuid.New()}, nil)
+	voided, err := svc.VoidInvoice(ctx, issuedInv.ID, "Duplicate", "clerk")
+	require.NoError(t, err)
+	require.Equal(t, services.InvoiceVoid, voided.Status)
+
+	repo.AssertNumberOfCalls(t, "IssueInvoice", 3)
+	repo.AssertExpectations(t)
+}
+
+func TestAmountInWords(t *testing.T) {
+	for _, tc := range []struct {
+		amount, currency, want string
+	}{
+		{"118000.50", "INR", "Rupees One Lakh Eighteen Thousand and Fifty Paise Only"},
+		{"12345678", "INR", "Rupees One Crore Twenty-Three Lakh Forty-Five Thousand Six Hundred Seventy-Eight Only"},
+		{"1234567.05", "USD", "US Dollars One Million Two Hundred Thirty-Four Thousand Five Hundred Sixty-Seven and Five Cents Only"},
+		{"0", "INR", "Rupees Zero Only"},
+		{"-100", "XYZ", "Minus XYZ One Hundred Only"},
+	} {
+		got, err := services.AmountInWords(tc.amount, tc.currency, 2)
+		require.NoError(t, err, tc.amount)
+		require.Equal(t, tc.want, got)
+	}
+	_, err := services.AmountInWords("12a", "INR", 2)
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+}
+
+func TestInvoiceService_Template(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockInvoiceRepo)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	repo.On("UpsertTemplate", ctx, mock.Anything).Return(db.InvoiceTemplate{OrganizationID: "org-1", CompanyName: "Acme Pvt Ltd", Logo: png}, nil)
+	saved, err := svc.SetTemplate(ctx, db.InvoiceTemplate{OrganizationID: "org-1", CompanyName: " Acme Pvt Ltd ", Logo: png})
+	require.NoError(t, err)
+	require.Equal(t, "Acme Pvt Ltd", saved.CompanyName)
+
+	for _, bad := range []db.InvoiceTemplate{
+		{CompanyName: "Acme"},
+		{OrganizationID: "org-1"},
+		{OrganizationID: "org-1", CompanyName: "Acme", Logo: []byte("GIF89a")},
+		{OrganizationID: "org-1", CompanyName: "Acme", Logo: append(png, make([]byte, 512<<10)...)},
+	} {
+		_, err := svc.SetTemplate(ctx, bad)
+		require.ErrorIs(t, err, services.ErrInvalidInput)
+	}
+	repo.AssertNumberOfCalls(t, "UpsertTemplate", 1)
+
+	// Organizations without a template print unbranded invoices.
+	inv := db.Invoice{ID: uuid.New(), OrganizationID: "org-2", CurrencyCode: "INR", GrandTotal: "1180.00"}
+	repo.On("GetInvoice", ctx, inv.ID).Return(inv, nil)
+	repo.On("GetTemplate", ctx, "org-2").Return(db.InvoiceTemplate{}, sql.ErrNoRows)
+	doc, err := svc.InvoiceDocument(ctx, inv.ID)
+	require.NoError(t, err)
+	require.Equal(t, "org-2", doc.Template.OrganizationID)
+	require.Equal(t, "Rupees One Thousand One Hundred Eighty Only", doc.AmountInWords)
+
+	_, err = svc.GetTemplate(ctx, "org-2")
+	require.ErrorIs(t, err, services.ErrNotFound)
+}
+
+func TestInvoiceService_SearchInvoices(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockInvoiceRepo)
+	svc := services.NewInvoiceService(repo, nil, nil, nil, nil, nil, nil, nil, nil)
+	repo.On("SearchInvoices", ctx, mock.Anything, int32(50), int32(0)).Return([]db.Invoice{}, nil)
+
+	// A query is ranked by relevance unless another order is asked for.
+	_, err := svc.SearchInvoices(ctx, ports.InvoiceFilter{OrganizationID: "org-1", Query: " acme widget "}, 50, 0)
+	require.NoError(t, err)
+	_, err = svc.SearchInvoices(ctx, ports.InvoiceFilter{OrganizationID: "org-1", Query: "acme", OrderBy: services.OrderByAmount}, 50, 0)
+	require.NoError(t, err)
+	_, err = svc.SearchInvoices(ctx, ports.InvoiceFilter{OrganizationID: "org-1", Status: services.InvoiceIssued}, 50, 0)
+	require.NoError(t, err)
+	require.Equal(t, ports.InvoiceFilter{OrganizationID: "org-1", Query: "acme widget", OrderBy: services.OrderByRelevance}, repo.Calls[0].Arguments.Get(1))
+	require.Equal(t, services.OrderByAmount, repo.Calls[1].Arguments.Get(1).(ports.InvoiceFilter).OrderBy)
+	require.Empty(t, repo.Calls[2].Arguments.Get(1).(ports.InvoiceFilter).OrderBy)
+
+	day := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	for name, f := range map[string]ports.InvoiceFilter{
+		"no organization":     {Query: "acme"},
+		"unknown status":      {OrganizationID: "org-1", Status: "OPEN"},
+		"unknown type":        {OrganizationID: "org-1", Type: "RETAIL"},
+		"inverted dates":      {OrganizationID: "org-1", FromDate: day, ToDate: day.AddDate(0, 0, -1)},
+		"inverted due dates":  {OrganizationID: "org-1", DueFrom: day, DueTo: day.AddDate(0, 0, -1)},
+		"bad total":           {OrganizationID: "org-1", MinTotal: "ten"},
+		"inverted totals":     {OrganizationID: "org-1", MinTotal: "500", MaxTotal: "100.50"},
+		"relevance, no query": {OrganizationID: "org-1", OrderBy: services.OrderByRelevance},
+		"unknown order":       {OrganizationID: "org-1", OrderBy: "invoice_number"},
+	} {
+		_, err := svc.SearchInvoices(ctx, f, 50, 0)
+		require.ErrorIs(t, err, services.ErrInvalidInput, name)
+	}
+	repo.AssertNumberOfCalls(t, "SearchInvoices", 3)
+}
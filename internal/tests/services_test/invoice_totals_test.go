@@ -0,0 +1,89 @@
+package services_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+func TestInvoiceCalculator_Compute(t *testing.T) {
+	calc := services.NewInvoiceCalculator("inr", map[string]services.CurrencyRounding{
+		"INR": {Scale: 2, Mode: services.RoundHalfUp, CashIncrement: "1"},
+		"JPY": {Scale: 0, Mode: services.RoundHalfEven},
+		"CHF": {Scale: 2, Mode: services.RoundHalfUp, CashIncrement: "0.05", Tolerance: "0.05"},
+	})
+
+	inv := db.Invoice{
+		Items: []db.InvoiceItem{
+			{Quantity: 3, UnitPrice: "333.33",
+				Discounts: []db.InvoiceItemDiscount{{Amount: "49.99"}},
+				Taxes:     []db.InvoiceItemTax{{Name: "cgst", Rate: "9%"}, {Name: "sgst", Rate: "9%"}}},
+			{Quantity: 1, UnitPrice: "100.45"},
+		},
+		Discounts: []db.InvoiceDiscount{{Amount: "50"}},
+		Taxes:     []db.InvoiceTax{{Name: "Cess", Rate: "1"}},
+	}
+	require.NoError(t, calc.Compute(&inv))
+	assert.Equal(t, "INR", inv.CurrencyCode)
+	assert.Equal(t, "999.99", inv.Items[0].LineSubtotal)
+	assert.Equal(t, "85.50", inv.Items[0].Taxes[0].Amount)
+	assert.Equal(t, "CGST", inv.Items[0].Taxes[0].Name)
+	assert.Equal(t, "1121.00", inv.Items[0].LineTotal)
+	assert.Equal(t, "100.45", inv.Items[1].LineTotal)
+	assert.Equal(t, "1100.44", inv.Subtotal)
+	assert.Equal(t, "99.99", inv.DiscountTotal)
+	// Cess is on the taxable value after every discount: 1000.45 × 1%.
+	assert.Equal(t, "10.00", inv.Taxes[0].Amount)
+	assert.Equal(t, "181.00", inv.TaxTotal)
+	assert.Equal(t, "85.50", inv.GstCgst.String)
+	assert.Equal(t, "85.50", inv.GstSgst.String)
+	assert.Equal(t, "0.00", inv.GstIgst.String)
+	assert.Equal(t, "17.092", inv.GstRate.String)
+	// 1181.45 is rounded to whole rupees.
+	assert.Equal(t, "-0.45", inv.RoundOff)
+	assert.Equal(t, "1181.00", inv.GrandTotal)
+
+	yen := db.Invoice{CurrencyCode: "jpy", Items: []db.InvoiceItem{{Quantity: 5, UnitPrice: "100.5"}}}
+	require.NoError(t, calc.Compute(&yen))
+	assert.Equal(t, "502.00", yen.GrandTotal, "502.5 rounds half to even")
+
+	franc := db.Invoice{CurrencyCode: "CHF", Items: []db.InvoiceItem{{Quantity: 1, UnitPrice: "10.03"}}}
+	require.NoError(t, calc.Compute(&franc))
+	assert.Equal(t, "10.05", franc.GrandTotal)
+	assert.Equal(t, "0.02", franc.RoundOff)
+	assert.NoError(t, calc.Verify(db.Invoice{GrandTotal: "10.00"}, franc), "within the franc's tolerance")
+
+	bad := db.Invoice{Items: []db.InvoiceItem{{Quantity: 0, UnitPrice: "ten"}}}
+	err := calc.Compute(&bad)
+	assert.ErrorIs(t, err, services.ErrInvalidInput)
+	assert.Empty(t, bad.Items[0].LineTotal)
+}
+
+func TestInvoiceCalculator_Verify(t *testing.T) {
+	calc := services.NewInvoiceCalculator("INR", nil)
+	inv := db.Invoice{Items: []db.InvoiceItem{{Quantity: 2, UnitPrice: "50",
+		Taxes: []db.InvoiceItemTax{{Name: "IGST", Rate: "18"}}}}}
+	require.NoError(t, calc.Compute(&inv))
+
+	client := db.Invoice{
+		Subtotal:   "100",
+		GrandTotal: "118.01",
+		GstIgst:    sql.NullString{String: "17", Valid: true},
+		Items:      []db.InvoiceItem{{LineTotal: "120", Taxes: []db.InvoiceItemTax{{Amount: "18.00"}}}},
+	}
+	err := calc.Verify(client, inv)
+	var totalsErr *services.InvoiceTotalsError
+	require.ErrorAs(t, err, &totalsErr)
+	fields := make([]string, len(totalsErr.Mismatches))
+	for i, m := range totalsErr.Mismatches {
+		fields[i] = m.Field
+	}
+	// A difference of one paisa is within the default tolerance.
+	assert.Equal(t, []string{"items[0].line_total", "gst_igst"}, fields)
+	assert.Equal(t, "118.00", totalsErr.Mismatches[0].Computed)
+}
@@ -0,0 +1,125 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+func importAccounts() []*db.Account {
+	return []*db.Account{
+		{ID: uuid.New(), Code: "1000", Status: "ACTIVE"},
+		{ID: uuid.New(), Code: "3000", Status: "ACTIVE"},
+		{ID: uuid.New(), Code: "4000", Status: "ACTIVE"},
+		{ID: uuid.New(), Code: "9000", Status: "ARCHIVED"},
+	}
+}
+
+func TestJournalImportService_Commit(t *testing.T) {
+	ctx := context.Background()
+	accounts := importAccounts()
+	accRepo := new(MockAccountRepo)
+	accRepo.On("ListAll", ctx).Return(accounts, nil)
+	jRepo := new(MockJournalRepo)
+	jRepo.On("BulkCreate", ctx, mock.Anything).Return(nil)
+	svc := services.NewJournalImportService(services.NewJournalService(jRepo, accRepo, nil, nil, nil))
+
+	rows := [][]string{
+		{"Reference", "Date", "Account Code", "Debit", "Credit", "Memo"},
+		{"OB-1", "2025-04-01", "1000", "1,500.00", "0", "Opening balances"},
+		{"OB-2", "45748", "1000", "200", ""}, // 2025-04-01 as a spreadsheet serial
+		{"OB-1", "2025-04-01", "3000", "", "1500.00"},
+		{},
+		{"OB-2", "2025-04-01", "4000", "", "200"},
+	}
+	report, err := svc.Import(ctx, rows, ports.JournalImportOptions{OrganizationID: "org", ImportedBy: "migrator", Post: true})
+	require.NoError(t, err)
+	assert.Empty(t, report.Errors)
+	assert.True(t, report.Committed)
+	assert.Equal(t, 2, report.Entries)
+	assert.Equal(t, 4, report.Lines)
+
+	journals := jRepo.Calls[0].Arguments.Get(1).([]*db.JournalEntry)
+	require.Len(t, journals, 2)
+	ob1 := journals[0]
+	assert.Equal(t, "OB-1", ob1.Reference.String)
+	assert.Equal(t, "Opening balances", ob1.Memo.String)
+	assert.Equal(t, services.SourceImport, ob1.SourceType.String)
+	assert.Equal(t, services.JournalPosted, ob1.Status)
+	assert.Equal(t, "org", ob1.OrganizationID.String)
+	assert.Equal(t, accounts[0].ID, ob1.Lines[0].AccountID)
+	assert.Equal(t, services.SideDebit, ob1.Lines[0].Side)
+	assert.Equal(t, "1500.00", ob1.Lines[0].Amount)
+	assert.Equal(t, services.SideCredit, ob1.Lines[1].Side)
+	assert.True(t, journals[1].JournalDate.Equal(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)))
+
+	// Accounts are resolved from one listing, not looked up per line.
+	accRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestJournalImportService_DryRunReportsRowErrors(t *testing.T) {
+	ctx := context.Background()
+	accRepo := new(MockAccountRepo)
+	accRepo.On("ListAll", ctx).Return(importAccounts(), nil)
+	jRepo := new(MockJournalRepo)
+	svc := services.NewJournalImportService(services.NewJournalService(jRepo, accRepo, nil, nil, nil))
+
+	rows := [][]string{
+		{"reference", "date", "account_code", "side", "amount"},
+		{"J-1", "2025-04-01", "1000", "DEBIT", "100"},
+		{"J-1", "2025-04-01", "3000", "CREDIT", "90"}, // does not balance
+		{"J-2", "01/04/2025", "1000", "DEBIT", "50"},  // bad date
+		{"J-2", "2025-04-01", "7777", "CREDIT", "50"}, // unknown account
+		{"J-3", "2025-04-01", "1000", "DEBIT", "10"},
+		{"J-3", "2025-04-01", "9000", "CREDIT", "10.005"}, // archived account, too many decimals
+		{"", "2025-04-01", "1000", "DEBIT", "1"},
+	}
+	report, err := svc.Import(ctx, rows, ports.JournalImportOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.False(t, report.Committed)
+	assert.Equal(t, 3, report.Entries)
+
+	type loc struct {
+		Row   int
+		Field string
+	}
+	var got []loc
+	for _, e := range report.Errors {
+		got = append(got, loc{e.Row, e.Field})
+	}
+	assert.Equal(t, []loc{
+		{2, "lines"},
+		{4, "date"},
+		{5, "account_code"},
+		{6, "lines"},
+		{7, "amount"},
+		{7, "account_code"},
+		{8, "reference"},
+	}, got)
+	jRepo.AssertNotCalled(t, "BulkCreate", mock.Anything, mock.Anything)
+
+	// Without dry run the same file is rejected as a whole.
+	report, err = svc.Import(ctx, rows, ports.JournalImportOptions{})
+	require.NoError(t, err)
+	assert.False(t, report.Committed)
+	jRepo.AssertNotCalled(t, "BulkCreate", mock.Anything, mock.Anything)
+}
+
+func TestJournalImportService_RejectsBadHeader(t *testing.T) {
+	svc := services.NewJournalImportService(services.NewJournalService(new(MockJournalRepo), new(MockAccountRepo), nil, nil, nil))
+
+	report, err := svc.Import(context.Background(), [][]string{{"reference", "date", "debit"}}, ports.JournalImportOptions{})
+	require.NoError(t, err)
+	require.Len(t, report.Errors, 1)
+	assert.Equal(t, 1, report.Errors[0].Row)
+	assert.Contains(t, report.Errors[0].Message, "account_code")
+}
@@ -0,0 +1,191 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+// ---------------- Mock Repository ----------------
+type MockJournalTemplateRepo struct{ mock.Mock }
+
+func (m *MockJournalTemplateRepo) Create(ctx context.Context, t *ports.JournalTemplate) (*ports.JournalTemplate, error) {
+	args := m.Called(ctx, t)
+	return args.Get(0).(*ports.JournalTemplate), args.Error(1)
+}
+func (m *MockJournalTemplateRepo) Get(ctx context.Context, id uuid.UUID) (*ports.JournalTemplate, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*ports.JournalTemplate), args.Error(1)
+}
+func (m *MockJournalTemplateRepo) Update(ctx context.Context, t *ports.JournalTemplate) (*ports.JournalTemplate, error) {
+	args := m.Called(ctx, t)
+	return args.Get(0).(*ports.JournalTemplate), args.Error(1)
+}
+func (m *MockJournalTemplateRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+func (m *MockJournalTemplateRepo) List(ctx context.Context, limit, offset int32) ([]*ports.JournalTemplate, error) {
+	args := m.Called(ctx, limit, offset)
+	return args.Get(0).([]*ports.JournalTemplate), args.Error(1)
+}
+func (m *MockJournalTemplateRepo) ListDue(ctx context.Context, asOf time.Time) ([]*ports.JournalTemplate, error) {
+	args := m.Called(ctx, asOf)
+	return args.Get(0).([]*ports.JournalTemplate), args.Error(1)
+}
+func (m *MockJournalTemplateRepo) CreateOccurrence(ctx context.Context, templateID uuid.UUID, date time.Time, j *db.JournalEntry) (*db.JournalEntry, error) {
+	args := m.Called(ctx, templateID, date, j)
+	if res := args.Get(0); res != nil {
+		return res.(*db.JournalEntry), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// ---------------- Tests ----------------
+func TestJournalTemplateService_Create(t *testing.T) {
+	ctx := context.Background()
+	rentID, cashID := uuid.New(), uuid.New()
+	accRepo := new(MockAccountRepo)
+	accRepo.On("Get", ctx, rentID).Return(&db.Account{ID: rentID, Status: "ACTIVE", AllowManualJournal: true}, nil)
+	accRepo.On("Get", ctx, cashID).Return(&db.Account{ID: cashID, Status: "ACTIVE", AllowManualJournal: true}, nil)
+	repo := new(MockJournalTemplateRepo)
+	svc := services.NewJournalTemplateService(repo, services.NewJournalService(new(MockJournalRepo), accRepo, nil, nil, nil))
+
+	valid := func() *ports.JournalTemplate {
+		return &ports.JournalTemplate{
+			JournalTemplate: db.JournalTemplate{
+				Name: "Office rent", Frequency: services.FrequencyMonthly, DayOfMonth: 1,
+				StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			Lines: []db.JournalTemplateLine{
+				{AccountID: rentID, Side: "DEBIT", Amount: "1000.00"},
+				{AccountID: cashID, Side: "CREDIT", Amount: "1000.00"},
+			},
+		}
+	}
+	repo.On("Create", ctx, mock.Anything).Return(&ports.JournalTemplate{}, nil)
+
+	_, err := svc.Create(ctx, valid())
+	assert.NoError(t, err)
+
+	bad := []func(*ports.JournalTemplate){
+		func(t *ports.JournalTemplate) { t.Frequency = "DAILY" },
+		func(t *ports.JournalTemplate) { t.DayOfMonth = 32 },
+		func(t *ports.JournalTemplate) {
+			t.EndDate = sql.NullTime{Time: t.StartDate.AddDate(0, 0, -1), Valid: true}
+		},
+		func(t *ports.JournalTemplate) { t.AdjustmentPercent = "-100" },
+		func(t *ports.JournalTemplate) { t.Lines[1].Amount = "900.00" },
+	}
+	for _, mutate := range bad {
+		tmpl := valid()
+		mutate(tmpl)
+		_, err := svc.Create(ctx, tmpl)
+		assert.ErrorIs(t, err, services.ErrInvalidInput)
+	}
+	repo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestJournalTemplateService_Generate(t *testing.T) {
+	ctx := context.Background()
+	rentID, cashID, templateID := uuid.New(), uuid.New(), uuid.New()
+	accRepo := new(MockAccountRepo)
+	accRepo.On("Get", ctx, rentID).Return(&db.Account{ID: rentID, Status: "ACTIVE", AllowManualJournal: true}, nil)
+	accRepo.On("Get", ctx, cashID).Return(&db.Account{ID: cashID, Status: "ACTIVE", AllowManualJournal: true}, nil)
+	repo := new(MockJournalTemplateRepo)
+	svc := services.NewJournalTemplateService(repo, services.NewJournalService(new(MockJournalRepo), accRepo, nil, nil, nil))
+
+	// Rent on the last day of each month from Nov 2024, escalating 5% a year.
+	tmpl := &ports.JournalTemplate{
+		JournalTemplate: db.JournalTemplate{
+			ID: templateID, Name: "Office rent", Frequency: services.FrequencyMonthly, DayOfMonth: 31,
+			StartDate: time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC), AutoPost: true, AdjustmentPercent: "5",
+			LastOccurrence: sql.NullTime{Time: time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC), Valid: true},
+		},
+		Lines: []db.JournalTemplateLine{
+			{AccountID: rentID, Side: "DEBIT", Amount: "1000.00"},
+			{AccountID: cashID, Side: "CREDIT", Amount: "1000.00"},
+		},
+	}
+	asOf := time.Date(2025, 12, 15, 10, 0, 0, 0, time.UTC)
+	repo.On("ListDue", ctx, asOf).Return([]*ports.JournalTemplate{tmpl}, nil)
+
+	dec31 := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	feb28 := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+	nov30 := time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC)
+	// Dec 2024 was generated by an earlier run that did not advance the cursor.
+	repo.On("CreateOccurrence", ctx, templateID, dec31, mock.Anything).Return(nil, nil)
+	repo.On("CreateOccurrence", ctx, templateID, mock.Anything, mock.Anything).
+		Return(&db.JournalEntry{ID: uuid.New()}, nil)
+
+	generated, err := svc.Generate(ctx, asOf)
+	assert.NoError(t, err)
+	assert.Len(t, generated, 11) // Jan..Nov 2025
+
+	for _, c := range repo.Calls {
+		if c.Method != "CreateOccurrence" {
+			continue
+		}
+		date := c.Arguments.Get(2).(time.Time)
+		j := c.Arguments.Get(3).(*db.JournalEntry)
+		assert.Equal(t, services.JournalPosted, j.Status)
+		assert.Equal(t, services.SourceRecurring, j.SourceType.String)
+		assert.Equal(t, templateID.String(), j.SourceID.String)
+		assert.True(t, j.JournalDate.Equal(date))
+		switch {
+		case date.Equal(feb28):
+			assert.Equal(t, "1000.00", j.Lines[0].Amount)
+		case date.Equal(nov30):
+			// First anniversary of the start date.
+			assert.Equal(t, "1050.00", j.Lines[0].Amount)
+			assert.Equal(t, "1050.00", j.Lines[1].Amount)
+		}
+	}
+	repo.AssertNumberOfCalls(t, "CreateOccurrence", 12)
+}
+
+func TestJournalTemplateService_GenerateStopsAtClosedPeriod(t *testing.T) {
+	ctx := context.Background()
+	rentID, cashID, templateID := uuid.New(), uuid.New(), uuid.New()
+	accRepo := new(MockAccountRepo)
+	accRepo.On("Get", ctx, rentID).Return(&db.Account{ID: rentID, Status: "ACTIVE", AllowManualJournal: true}, nil)
+	accRepo.On("Get", ctx, cashID).Return(&db.Account{ID: cashID, Status: "ACTIVE", AllowManualJournal: true}, nil)
+
+	periods := new(MockFiscalPeriodRepo)
+	jan := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	periods.On("GetPeriodForDate", ctx, "org", jan).Return(db.FiscalPeriod{Status: services.PeriodClosed}, nil)
+	periods.On("GetPeriodForDate", ctx, "org", mock.Anything).Return(db.FiscalPeriod{Status: services.PeriodOpen}, nil)
+
+	repo := new(MockJournalTemplateRepo)
+	jService := services.NewJournalService(new(MockJournalRepo), accRepo, services.NewPeriodGuard(periods), nil, nil)
+	svc := services.NewJournalTemplateService(repo, jService)
+
+	asOf := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	repo.On("ListDue", ctx, asOf).Return([]*ports.JournalTemplate{{
+		JournalTemplate: db.JournalTemplate{
+			ID: templateID, Name: "Subscription", Frequency: services.FrequencyMonthly, DayOfMonth: 15,
+			StartDate:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			OrganizationID: sql.NullString{String: "org", Valid: true},
+		},
+		Lines: []db.JournalTemplateLine{
+			{AccountID: rentID, Side: "DEBIT", Amount: "49.99"},
+			{AccountID: cashID, Side: "CREDIT", Amount: "49.99"},
+		},
+	}}, nil)
+
+	generated, err := svc.Generate(ctx, asOf)
+	assert.Empty(t, generated)
+	var runErr *services.RecurringRunError
+	assert.ErrorAs(t, err, &runErr)
+	assert.ErrorIs(t, err, services.ErrPeriodClosed)
+	// Later occurrences wait until the January one can be created.
+	repo.AssertNotCalled(t, "CreateOccurrence", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,130 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+type MockPartyRepo struct{ mock.Mock }
+
+func (m *MockPartyRepo) Create(ctx context.Context, p *ports.Party) (*ports.Party, error) {
+	args := m.Called(ctx, p)
+	return p, args.Error(0)
+}
+func (m *MockPartyRepo) Get(ctx context.Context, id uuid.UUID) (*ports.Party, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ports.Party), args.Error(1)
+}
+func (m *MockPartyRepo) Update(ctx context.Context, p *ports.Party) (*ports.Party, error) {
+	args := m.Called(ctx, p)
+	return p, args.Error(0)
+}
+func (m *MockPartyRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+func (m *MockPartyRepo) List(ctx context.Context, f ports.PartyFilter, limit, offset int32) ([]*ports.Party, error) {
+	args := m.Called(ctx, f, limit, offset)
+	return args.Get(0).([]*ports.Party), args.Error(1)
+}
+func (m *MockPartyRepo) CountDocuments(ctx context.Context, id uuid.UUID) (int, error) {
+	args := m.Called(ctx, id)
+	return args.Int(0), args.Error(1)
+}
+
+func validParty() *ports.Party {
+	return &ports.Party{
+		Party: db.Party{
+			OrganizationID: "org-1",
+			Kind:           services.PartyCustomer,
+			Name:           " Acme Traders ",
+			Pan:            sql.NullString{String: "aapfu0939f", Valid: true},
+		},
+		Contacts:         []db.PartyContact{{Name: "Asha", Email: sql.NullString{String: "asha@acme.in", Valid: true}, IsPrimary: true}},
+		Addresses:        []db.PartyAddress{{Kind: services.AddressBilling, Line1: "1 MG Road", IsDefault: true}},
+		TaxRegistrations: []db.PartyTaxRegistration{{Number: " 27aapfu0939f1zv "}},
+		BankAccounts:     []db.PartyBankAccount{{AccountName: "Acme Traders", AccountNumber: "001234", Ifsc: sql.NullString{String: "HDFC0000123", Valid: true}}},
+	}
+}
+
+func TestPartyService_Validate(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPartyRepo)
+	svc := services.NewPartyService(repo)
+	repo.On("Create", ctx, mock.Anything).Return(nil)
+
+	created, err := svc.Create(ctx, validParty())
+	require.NoError(t, err)
+	require.Equal(t, "Acme Traders", created.Name)
+	require.Equal(t, "AAPFU0939F", created.Pan.String)
+	require.Equal(t, "IN", created.Addresses[0].CountryCode)
+	// A GSTIN defaults to the GSTIN scheme and its own state.
+	gstin := created.TaxRegistrations[0]
+	require.Equal(t, services.TaxRegistrationGSTIN, gstin.TaxType)
+	require.Equal(t, "27AAPFU0939F1ZV", gstin.Number)
+	require.Equal(t, "27", gstin.StateCode.String)
+
+	for name, change := range map[string]func(p *ports.Party){
+		"no organization":    func(p *ports.Party) { p.OrganizationID = "" },
+		"no name":            func(p *ports.Party) { p.Name = " " },
+		"unknown kind":       func(p *ports.Party) { p.Kind = "PARTNER" },
+		"bad PAN":            func(p *ports.Party) { p.Pan.String = "AAPFU0939" },
+		"bad currency":       func(p *ports.Party) { p.DefaultCurrency = sql.NullString{String: "RUPEE", Valid: true} },
+		"negative terms":     func(p *ports.Party) { p.PaymentTermsDays = sql.NullInt32{Int32: -1, Valid: true} },
+		"bad email":          func(p *ports.Party) { p.Contacts[0].Email.String = "asha" },
+		"two primaries":      func(p *ports.Party) { p.Contacts = append(p.Contacts, db.PartyContact{Name: "Ravi", IsPrimary: true}) },
+		"address kind":       func(p *ports.Party) { p.Addresses[0].Kind = "HOME" },
+		"two defaults":       func(p *ports.Party) { p.Addresses = append(p.Addresses, p.Addresses[0]) },
+		"GSTIN check digit":  func(p *ports.Party) { p.TaxRegistrations[0].Number = "27AAPFU0939F1ZW" },
+		"GSTIN format":       func(p *ports.Party) { p.TaxRegistrations[0].Number = "27AAPFU0939F1Z" },
+		"GSTIN of other PAN": func(p *ports.Party) { p.Pan.String = "AAPFU0939G" },
+		"GSTIN state":        func(p *ports.Party) { p.TaxRegistrations[0].StateCode = sql.NullString{String: "29", Valid: true} },
+		"duplicate GSTIN":    func(p *ports.Party) { p.TaxRegistrations = append(p.TaxRegistrations, p.TaxRegistrations[0]) },
+		"bad IFSC":           func(p *ports.Party) { p.BankAccounts[0].Ifsc.String = "HDFC1000123" },
+	} {
+		p := validParty()
+		change(p)
+		_, err := svc.Create(ctx, p)
+		require.ErrorIs(t, err, services.ErrInvalidInput, name)
+	}
+	repo.AssertNumberOfCalls(t, "Create", 1)
+
+	// Registrations under other schemes are not checked as GSTINs.
+	p := validParty()
+	p.TaxRegistrations = append(p.TaxRegistrations, db.PartyTaxRegistration{TaxType: "vat", Number: "GB123456789"})
+	_, err = svc.Create(ctx, p)
+	require.NoError(t, err)
+}
+
+func TestPartyService_Delete(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockPartyRepo)
+	svc := services.NewPartyService(repo)
+
+	used, unused, missing := uuid.New(), uuid.New(), uuid.New()
+	repo.On("Get", ctx, used).Return(&ports.Party{Party: db.Party{ID: used, Name: "Acme"}}, nil)
+	repo.On("Get", ctx, unused).Return(&ports.Party{Party: db.Party{ID: unused, Name: "Globex"}}, nil)
+	repo.On("Get", ctx, missing).Return(nil, sql.ErrNoRows)
+	repo.On("CountDocuments", ctx, used).Return(2, nil)
+	repo.On("CountDocuments", ctx, unused).Return(0, nil)
+	repo.On("Delete", ctx, unused).Return(nil)
+
+	require.ErrorIs(t, svc.Delete(ctx, used), services.ErrConflict)
+	require.ErrorIs(t, svc.Delete(ctx, missing), services.ErrNotFound)
+	require.NoError(t, svc.Delete(ctx, unused))
+	repo.AssertNumberOfCalls(t, "Delete", 1)
+
+	_, err := svc.List(ctx, ports.PartyFilter{}, 10, 0)
+	require.ErrorIs(t, err, services.ErrInvalidInput)
+}
@@ -0,0 +1,216 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ShristiRnr/Finance_mierp/internal/adapters/database/db"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/ports"
+	"github.com/ShristiRnr/Finance_mierp/internal/core/services"
+)
+
+type MockRecurringInvoiceRepo struct{ mock.Mock }
+
+func (m *MockRecurringInvoiceRepo) Create(ctx context.Context, r db.RecurringInvoice) (db.RecurringInvoice, error) {
+	args := m.Called(ctx, r)
+	return args.Get(0).(db.RecurringInvoice), args.Error(1)
+}
+func (m *MockRecurringInvoiceRepo) Get(ctx context.Context, id uuid.UUID) (db.RecurringInvoice, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(db.RecurringInvoice), args.Error(1)
+}
+func (m *MockRecurringInvoiceRepo) Update(ctx context.Context, r db.RecurringInvoice) (db.RecurringInvoice, error) {
+	args := m.Called(ctx, r)
+	return args.Get(0).(db.RecurringInvoice), args.Error(1)
+}
+func (m *MockRecurringInvoiceRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.Called(ctx, id).Error(0)
+}
+func (m *MockRecurringInvoiceRepo) List(ctx context.Context, limit, offset int32) ([]db.RecurringInvoice, error) {
+	args := m.Called(ctx, limit, offset)
+	return args.Get(0).([]db.RecurringInvoice), args.Error(1)
+}
+func (m *MockRecurringInvoiceRepo) ListDue(ctx context.Context, asOf time.Time) ([]db.RecurringInvoice, error) {
+	args := m.Called(ctx, asOf)
+	return args.Get(0).([]db.RecurringInvoice), args.Error(1)
+}
+func (m *MockRecurringInvoiceRepo) CreateOccurrence(ctx context.Context, recurringID uuid.UUID, period ports.BillingPeriod, inv db.Invoice) (*db.Invoice, error) {
+	args := m.Called(ctx, recurringID, period, inv)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*db.Invoice), args.Error(1)
+}
+
+type MockFinanceEventRepo struct{ mock.Mock }
+
+func (m *MockFinanceEventRepo) InsertInvoiceCreated(ctx context.Context, e db.FinanceInvoiceCreatedEvent) (db.FinanceInvoiceCreatedEvent, error) {
+	args := m.Called(ctx, e)
+	return e, args.Error(0)
+}
+func (m *MockFinanceEventRepo) ListInvoiceCreated(ctx context.Context, orgID string, limit, offset int32) ([]db.FinanceInvoiceCreatedEvent, error) {
+	return nil, nil
+}
+func (m *MockFinanceEventRepo) InsertPaymentReceived(ctx context.Context, e db.FinancePaymentReceivedEvent) (db.FinancePaymentReceivedEvent, error) {
+	return e, nil
+}
+func (m *MockFinanceEventRepo) ListPaymentReceived(ctx context.Context, orgID string, limit, offset int32) ([]db.FinancePaymentReceivedEvent, error) {
+	return nil, nil
+}
+func (m *MockFinanceEventRepo) InsertInventoryCostPosted(ctx context.Context, e db.InventoryCostPostedEvent) (db.InventoryCostPostedEvent, error) {
+	return e, nil
+}
+func (m *MockFinanceEventRepo) ListInventoryCostPosted(ctx context.Context, orgID string, limit, offset int32) ([]db.InventoryCostPostedEvent, error) {
+	return nil, nil
+}
+func (m *MockFinanceEventRepo) InsertPayrollPosted(ctx context.Context, e db.PayrollPostedEvent) (db.PayrollPostedEvent, error) {
+	return e, nil
+}
+func (m *MockFinanceEventRepo) ListPayrollPosted(ctx context.Context, orgID string, limit, offset int32) ([]db.PayrollPostedEvent, error) {
+	return nil, nil
+}
+func (m *MockFinanceEventRepo) InsertVendorBillApproved(ctx context.Context, e db.VendorBillApprovedEvent) (db.VendorBillApprovedEvent, error) {
+	return e, nil
+}
+func (m *MockFinanceEventRepo) ListVendorBillApproved(ctx context.Context, orgID string, limit, offset int32) ([]db.VendorBillApprovedEvent, error) {
+	return nil, nil
+}
+
+func TestRecurringInvoiceService_Validate(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRecurringInvoiceRepo)
+	invoices := new(MockInvoiceRepo)
+	svc := services.NewRecurringInvoiceService(repo, services.NewInvoiceService(invoices, nil, nil, nil, nil, nil, nil, nil, nil))
+
+	templateID := uuid.New()
+	invoices.On("GetInvoice", ctx, templateID).Return(db.Invoice{ID: templateID, OrganizationID: "org-1",
+		Type: services.InvoiceTypeSales, Items: []db.InvoiceItem{{Quantity: 1, UnitPrice: "100"}}}, nil)
+	invoices.On("GetNumberSeries", ctx, "org-1", services.InvoiceTypeSales).Return(db.InvoiceNumberSeries{}, sql.ErrNoRows)
+
+	valid := db.RecurringInvoice{
+		Name:              " Support plan ",
+		TemplateInvoiceID: templateID,
+		Frequency:         services.FrequencyMonthly,
+		StartDate:         time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC),
+		BillingDay:        1,
+	}
+	// The recurring invoice takes its template's organization.
+	want := valid
+	want.Name, want.OrganizationID = "Support plan", "org-1"
+	repo.On("Create", ctx, want).Return(want, nil)
+	_, err := svc.Create(ctx, valid)
+	require.NoError(t, err)
+
+	for name, change := range map[string]func(r *db.RecurringInvoice){
+		"no name":           func(r *db.RecurringInvoice) { r.Name = " " },
+		"billing day 29":    func(r *db.RecurringInvoice) { r.BillingDay = 29 },
+		"unknown frequency": func(r *db.RecurringInvoice) { r.Frequency = "WEEKLY" },
+		"ends before start": func(r *db.RecurringInvoice) {
+			r.EndDate = sql.NullTime{Time: r.StartDate.AddDate(0, 0, -1), Valid: true}
+		},
+		"other organization": func(r *db.RecurringInvoice) { r.OrganizationID = "org-2" },
+		// Generated invoices can only be issued with numbers from a series.
+		"auto issue without series": func(r *db.RecurringInvoice) { r.AutoIssue = true },
+	} {
+		r := valid
+		change(&r)
+		_, err := svc.Create(ctx, r)
+		require.ErrorIs(t, err, services.ErrInvalidInput, name)
+	}
+	repo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestRecurringInvoiceService_Generate(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockRecurringInvoiceRepo)
+	invoices := new(MockInvoiceRepo)
+	events := new(MockFinanceEventRepo)
+	pub := new(MockmPublisher)
+	pub.On("PublishFinanceInvoiceCreated", ctx, mock.Anything).Return(nil)
+	events.On("InsertInvoiceCreated", ctx, mock.Anything).Return(nil)
+	invoiceSvc := services.NewInvoiceService(invoices, services.NewFinanceEventService(events, pub), nil, nil, nil, nil, pub, nil, nil)
+	svc := services.NewRecurringInvoiceService(repo, invoiceSvc)
+
+	templateID, partyID := uuid.New(), uuid.New()
+	invoices.On("GetInvoice", ctx, templateID).Return(db.Invoice{
+		ID:             templateID,
+		InvoiceNumber:  "INV-7",
+		Type:           services.InvoiceTypeSales,
+		InvoiceDate:    time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+		DueDate:        sql.NullTime{Time: time.Date(2024, 12, 16, 0, 0, 0, 0, time.UTC), Valid: true},
+		OrganizationID: "org-1",
+		Status:         services.InvoiceIssued,
+		CurrencyCode:   "INR",
+		PartyID:        uuid.NullUUID{UUID: partyID, Valid: true},
+		Items: []db.InvoiceItem{{ID: uuid.New(), Name: "Support", Quantity: 2, UnitPrice: "310.00",
+			Taxes: []db.InvoiceItemTax{{ID: uuid.New(), Name: services.TaxIGST, Rate: "18", Amount: "111.60"}}}},
+	}, nil)
+
+	// Billed monthly from the 1st, 20 January to 10 March: January and March
+	// are prorated, February is billed in full.
+	r := db.RecurringInvoice{
+		ID:                uuid.New(),
+		OrganizationID:    "org-1",
+		Name:              "Support plan",
+		TemplateInvoiceID: templateID,
+		Frequency:         services.FrequencyMonthly,
+		StartDate:         time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC),
+		EndDate:           sql.NullTime{Time: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), Valid: true},
+		BillingDay:        1,
+		Prorate:           true,
+		UpdatedBy:         sql.NullString{String: "clerk", Valid: true},
+	}
+	asOf := time.Date(2025, 3, 15, 9, 0, 0, 0, time.UTC)
+	repo.On("ListDue", ctx, asOf).Return([]db.RecurringInvoice{r}, nil)
+
+	day := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC) }
+	created := func(args mock.Arguments) *db.Invoice {
+		inv := args.Get(3).(db.Invoice)
+		inv.ID = uuid.New()
+		return &inv
+	}
+	// February went out in a concurrent run.
+	repo.On("CreateOccurrence", ctx, r.ID, ports.BillingPeriod{Start: day(2, 1), End: day(2, 28)}, mock.Anything).Return(nil, nil)
+	for _, p := range []ports.BillingPeriod{{Start: day(1, 20), End: day(1, 31)}, {Start: day(3, 1), End: day(3, 10)}} {
+		call := repo.On("CreateOccurrence", ctx, r.ID, p, mock.Anything)
+		call.Run(func(args mock.Arguments) { call.Return(created(args), nil) })
+	}
+
+	generated, err := svc.Generate(ctx, asOf)
+	require.NoError(t, err)
+	require.Len(t, generated, 2)
+
+	jan, mar := generated[0], generated[1]
+	require.Equal(t, ports.BillingPeriod{Start: day(1, 20), End: day(1, 31)}, jan.Period)
+	require.Equal(t, day(1, 20), jan.Invoice.InvoiceDate)
+	require.Equal(t, day(2, 4), jan.Invoice.DueDate.Time)
+	require.Equal(t, "", jan.Invoice.InvoiceNumber)
+	require.Equal(t, services.InvoiceDraft, jan.Invoice.Status)
+	require.Equal(t, partyID, jan.Invoice.PartyID.UUID)
+	// 12 of January's 31 days: 310.00 × 12/31, plus 18% IGST.
+	require.Equal(t, "120.00", jan.Invoice.Items[0].UnitPrice)
+	require.Equal(t, "283.20", jan.Invoice.GrandTotal)
+	require.Equal(t, uuid.Nil, jan.Invoice.Items[0].Taxes[0].ID)
+
+	// 10 of March's 31 days.
+	require.Equal(t, "100.00", mar.Invoice.Items[0].UnitPrice)
+	require.Equal(t, "236.00", mar.Invoice.GrandTotal)
+
+	events.AssertNumberOfCalls(t, "InsertInvoiceCreated", 2)
+	repo.AssertNumberOfCalls(t, "CreateOccurrence", 3)
+
+	// Billed through February, only March is left.
+	r.BilledThrough = sql.NullTime{Time: day(2, 28), Valid: true}
+	later := asOf.Add(time.Hour)
+	repo.On("ListDue", ctx, later).Return([]db.RecurringInvoice{r}, nil)
+	generated, err = svc.Generate(ctx, later)
+	require.NoError(t, err)
+	require.Len(t, generated, 1)
+	require.Equal(t, day(3, 1), generated[0].Period.Start)
+}